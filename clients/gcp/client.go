@@ -79,12 +79,12 @@ func (it *LogEntryIterator) Next() (*AuditLog, error) {
 	}, nil
 }
 
-// ListAuditLogsIterator returns an iterator for audit logs
+// ListAuditLogsIterator returns an iterator for audit logs, oldest first
 func (c *Client) ListAuditLogsIterator(ctx context.Context, filter string) *LogEntryIterator {
 	req := &loggingpb.ListLogEntriesRequest{
 		ResourceNames: []string{fmt.Sprintf("projects/%s", c.projectID)},
 		Filter:        filter,
-		OrderBy:       "timestamp desc",
+		OrderBy:       "timestamp asc",
 	}
 
 	it := c.logClient.ListLogEntries(ctx, req)
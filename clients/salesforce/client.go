@@ -14,7 +14,9 @@ const (
 	SFVersion         = "51.0"
 	SFTimestampFormat = "2006-01-02T15:04:05.000-0700"
 	SOQLEventLogFile  = "SELECT Id, ApiVersion, EventType, CreatedDate, LogDate, LogFile FROM EventLogFile WHERE EventType = '%s' AND LogDate >= %s"
-	DefaultClientID   = "simple-salesforce"
+	// SOQLEventLogFileRange selects the event log files of the days from one date to another.
+	SOQLEventLogFileRange = SOQLEventLogFile + " AND LogDate <= %s"
+	DefaultClientID       = "simple-salesforce"
 )
 
 var (
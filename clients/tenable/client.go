@@ -175,8 +175,14 @@ func (c *Client) GetAllFindingsFromExport(ctx context.Context, filters map[strin
 		return nil, err
 	}
 
+	return c.GetFindingsFromExport(ctx, exportUUID)
+}
+
+// GetFindingsFromExport waits for an export job to finish and retrieves all of its findings
+func (c *Client) GetFindingsFromExport(ctx context.Context, exportUUID string) ([]Finding, error) {
 	// Poll for export completion
 	var status *ExportStatusResponse
+	var err error
 	for {
 		status, err = c.GetExportStatus(ctx, exportUUID)
 		if err != nil {
@@ -5,6 +5,7 @@ import (
 	"reflect"
 	"strings"
 
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 	"github.com/apache/arrow-go/v18/arrow/array"
 	"github.com/apache/arrow-go/v18/arrow/memory"
@@ -395,11 +396,22 @@ func SliceToRecordBatch(slice interface{}, schema *arrow.Schema) (arrow.Record,
 	return rec, nil
 }
 
+// ArrowSchemaToIceberg converts an Arrow schema to an Iceberg schema, numbering its fields in order.
 func ArrowSchemaToIceberg(schema *arrow.Schema) (*iceberg.Schema, error) {
+	return ArrowSchemaToIcebergWithFieldIDs(schema, nil)
+}
+
+// ArrowSchemaToIcebergWithFieldIDs converts an Arrow schema to an Iceberg schema, taking the field
+// IDs from fieldIDs by column path (see the fieldids package). Columns missing from fieldIDs are
+// numbered after its highest ID. Without fieldIDs, fields are numbered in order.
+func ArrowSchemaToIcebergWithFieldIDs(schema *arrow.Schema, fieldIDs map[string]int) (*iceberg.Schema, error) {
 	var nextID int = 1
+	for _, id := range fieldIDs {
+		nextID = max(nextID, id+1)
+	}
 
-	var convertField func(field arrow.Field) (iceberg.NestedField, error)
-	convertField = func(field arrow.Field) (iceberg.NestedField, error) {
+	var convertField func(field arrow.Field, path string) (iceberg.NestedField, error)
+	convertField = func(field arrow.Field, path string) (iceberg.NestedField, error) {
 		var icebergType iceberg.Type
 		switch t := field.Type.(type) {
 
@@ -444,7 +456,7 @@ func ArrowSchemaToIceberg(schema *arrow.Schema) (*iceberg.Schema, error) {
 		case *arrow.StructType:
 			fields := make([]iceberg.NestedField, 0, len(t.Fields()))
 			for _, child := range t.Fields() {
-				nf, err := convertField(child)
+				nf, err := convertField(child, ocsf.JoinPath(path, child.Name))
 				if err != nil {
 					return iceberg.NestedField{}, err
 				}
@@ -454,7 +466,7 @@ func ArrowSchemaToIceberg(schema *arrow.Schema) (*iceberg.Schema, error) {
 			icebergType = structType
 		case *arrow.ListType:
 			elemField := t.ElemField()
-			nf, err := convertField(elemField)
+			nf, err := convertField(elemField, path+"[]")
 			if err != nil {
 				return iceberg.NestedField{}, err
 			}
@@ -467,11 +479,11 @@ func ArrowSchemaToIceberg(schema *arrow.Schema) (*iceberg.Schema, error) {
 		case *arrow.MapType:
 			keyField := t.KeyField()
 			valField := t.ItemField()
-			keyNF, err := convertField(keyField)
+			keyNF, err := convertField(keyField, path+".key")
 			if err != nil {
 				return iceberg.NestedField{}, err
 			}
-			valNF, err := convertField(valField)
+			valNF, err := convertField(valField, path+".value")
 			if err != nil {
 				return iceberg.NestedField{}, err
 			}
@@ -489,8 +501,11 @@ func ArrowSchemaToIceberg(schema *arrow.Schema) (*iceberg.Schema, error) {
 			return iceberg.NestedField{}, fmt.Errorf("unsupported Arrow type: %s", t)
 		}
 
-		fieldID := nextID
-		nextID++
+		fieldID, ok := fieldIDs[path]
+		if !ok {
+			fieldID = nextID
+			nextID++
+		}
 
 		isRequired := !field.Nullable
 		fieldName := field.Name
@@ -500,6 +515,7 @@ func ArrowSchemaToIceberg(schema *arrow.Schema) (*iceberg.Schema, error) {
 			Name:     fieldName,
 			Type:     icebergType,
 			Required: isRequired,
+			Doc:      ocsf.AttributeDoc(field.Metadata),
 		}
 		return nf, nil
 	}
@@ -507,7 +523,7 @@ func ArrowSchemaToIceberg(schema *arrow.Schema) (*iceberg.Schema, error) {
 	arrowFields := schema.Fields()
 	icebergFields := make([]iceberg.NestedField, 0, len(arrowFields))
 	for _, f := range arrowFields {
-		nf, err := convertField(f)
+		nf, err := convertField(f, f.Name)
 		if err != nil {
 			return nil, err
 		}
@@ -0,0 +1,129 @@
+package datastore
+
+import (
+	"maps"
+	"testing"
+
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
+	"github.com/Santiago-Labs/go-ocsf/ocsf/fieldids"
+	"github.com/Santiago-Labs/go-ocsf/ocsf/v1_4_0"
+	"github.com/Santiago-Labs/go-ocsf/ocsf/v1_5_0"
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/iceberg-go"
+)
+
+// icebergFieldIDs returns the field IDs of schema by column path, naming list elements and map
+// keys and values as the fieldids package does.
+func icebergFieldIDs(schema *iceberg.Schema) map[string]int {
+	ids := make(map[string]int)
+	var walk func(path string, id int, t iceberg.Type)
+	walk = func(path string, id int, t iceberg.Type) {
+		ids[path] = id
+		switch t := t.(type) {
+		case *iceberg.StructType:
+			for _, field := range t.Fields() {
+				walk(ocsf.JoinPath(path, field.Name), field.ID, field.Type)
+			}
+		case *iceberg.ListType:
+			walk(path+"[]", t.ElementID, t.Element)
+		case *iceberg.MapType:
+			walk(path+".key", t.KeyID, t.KeyType)
+			walk(path+".value", t.ValueID, t.ValueType)
+		}
+	}
+	for _, field := range schema.Fields() {
+		walk(field.Name, field.ID, field.Type)
+	}
+	return ids
+}
+
+func TestArrowSchemaToIcebergWithFieldIDs(t *testing.T) {
+	tag := arrow.StructOf(
+		arrow.Field{Name: "name", Type: arrow.BinaryTypes.String},
+		arrow.Field{Name: "values", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true},
+	)
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "uid", Type: arrow.BinaryTypes.String},
+		{Name: "tags", Type: arrow.ListOf(tag), Nullable: true},
+		{Name: "labels", Type: arrow.MapOf(arrow.BinaryTypes.String, tag), Nullable: true},
+		{Name: "time", Type: arrow.PrimitiveTypes.Int64},
+	}, nil)
+
+	var tests = []struct {
+		name     string
+		fieldIDs map[string]int
+		want     map[string]int
+	}{
+		{
+			name: "Test numbering in order",
+			want: map[string]int{
+				"uid": 1, "tags[].name": 2, "tags[].values[]": 3, "tags[].values": 4, "tags[]": 5, "tags": 6,
+				"labels.key": 7, "labels.value.name": 8, "labels.value.values[]": 9, "labels.value.values": 10, "labels.value": 11, "labels": 12, "time": 13,
+			},
+		},
+		{
+			name: "Test a complete map",
+			fieldIDs: map[string]int{
+				"time": 1, "uid": 2, "tags": 3, "tags[]": 4, "tags[].name": 5, "tags[].values": 6, "tags[].values[]": 7,
+				"labels": 8, "labels.key": 9, "labels.value": 10, "labels.value.name": 11, "labels.value.values": 12, "labels.value.values[]": 13,
+			},
+			want: map[string]int{
+				"time": 1, "uid": 2, "tags": 3, "tags[]": 4, "tags[].name": 5, "tags[].values": 6, "tags[].values[]": 7,
+				"labels": 8, "labels.key": 9, "labels.value": 10, "labels.value.name": 11, "labels.value.values": 12, "labels.value.values[]": 13,
+			},
+		},
+		{
+			name:     "Test columns missing from the map",
+			fieldIDs: map[string]int{"uid": 20, "tags": 3, "tags[]": 4, "tags[].name": 5, "labels": 30, "time": 1},
+			want: map[string]int{
+				"uid": 20, "tags": 3, "tags[]": 4, "tags[].name": 5, "tags[].values[]": 31, "tags[].values": 32,
+				"labels.key": 33, "labels.value.name": 34, "labels.value.values[]": 35, "labels.value.values": 36, "labels.value": 37, "labels": 30, "time": 1,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			iceSchema, err := ArrowSchemaToIcebergWithFieldIDs(schema, tt.fieldIDs)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := icebergFieldIDs(iceSchema); !maps.Equal(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestClassFieldIDs checks that the field ID maps in ocsf/fieldids cover every column of the
+// generated classes, so that each column keeps its ID across versions.
+func TestClassFieldIDs(t *testing.T) {
+	var tests = []struct {
+		name  string
+		class ocsf.Class
+	}{
+		{name: "Test a 1.4.0 finding", class: (&v1_4_0.VulnerabilityFinding{}).OCSFClass()},
+		{name: "Test a 1.5.0 finding", class: (&v1_5_0.VulnerabilityFinding{}).OCSFClass()},
+		{name: "Test a 1.4.0 activity", class: (&v1_4_0.APIActivity{}).OCSFClass()},
+		{name: "Test a 1.5.0 activity", class: (&v1_5_0.APIActivity{}).OCSFClass()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fieldIDs, err := fieldids.Lookup(tt.class.Name)
+			if err != nil || fieldIDs == nil {
+				t.Fatalf("got %v, %v, want the field IDs of %s", fieldIDs, err, tt.class.Name)
+			}
+
+			iceSchema, err := ArrowSchemaToIcebergWithFieldIDs(tt.class.Schema, fieldIDs)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			for path, id := range icebergFieldIDs(iceSchema) {
+				if fieldIDs[path] != id {
+					t.Errorf("got %s %d, want %d from the field ID map", path, id, fieldIDs[path])
+				}
+			}
+		})
+	}
+}
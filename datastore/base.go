@@ -4,7 +4,11 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"path/filepath"
+	"reflect"
+	"time"
 
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/s3tables"
@@ -16,6 +20,73 @@ type StorageOpts struct {
 	IsJSON         bool
 	BucketName     string
 	TableBucketArn string
+
+	// RejectInvalid drops events which fail OCSF schema validation instead of storing them.
+	RejectInvalid bool
+
+	// OCSFVersion is the OCSF version syncers store events as, e.g. "1.5.0". Events are stored as
+	// 1.4.0 when it is empty.
+	OCSFVersion string
+
+	// Partitioning is how the files of the local and S3 datastores are split into directories,
+	// PartitioningNone when empty.
+	Partitioning string
+
+	// StateNamespace separates the lifecycle state and checkpoints of syncers sharing the same
+	// storage, e.g. the syncers of two Snyk organizations.
+	StateNamespace string
+}
+
+// Partitionings of the files of the local and S3 datastores.
+const (
+	// PartitioningNone writes every file of a class into the class directory.
+	PartitioningNone = "none"
+	// PartitioningDay writes events into dt=YYYY-MM-DD directories of the UTC day of their event
+	// time, so that backfilled events land in the partition of the day they happened on.
+	PartitioningDay = "day"
+)
+
+// partitionable is implemented by the datastores which support Partitioning.
+type partitionable interface {
+	setPartitioning(partitioning string)
+}
+
+// partitionItems splits items into the partitions they are written to, keyed by the partition
+// directory relative to the class directory, which is empty without partitioning.
+func partitionItems[T any](partitioning string, items []T) (map[string][]T, error) {
+	if partitioning != PartitioningDay {
+		return map[string][]T{"": items}, nil
+	}
+
+	partitions := make(map[string][]T)
+	for i := range items {
+		eventTime, err := eventTimeOf(&items[i])
+		if err != nil {
+			return nil, err
+		}
+		partition := "dt=" + eventTime.UTC().Format(time.DateOnly)
+		partitions[partition] = append(partitions[partition], items[i])
+	}
+	return partitions, nil
+}
+
+// eventTimeOf returns the event time of an item, from GetTime for generated classes and from the
+// Time field of the classes of the ocsf package.
+func eventTimeOf[T any](item *T) (time.Time, error) {
+	if event, ok := any(item).(ocsf.Event); ok {
+		return time.UnixMilli(event.GetTime()), nil
+	}
+	field := reflect.ValueOf(item).Elem().FieldByName("Time")
+	if !field.IsValid() || field.Kind() != reflect.Int64 {
+		return time.Time{}, fmt.Errorf("%T has no event time to partition by", *item)
+	}
+	return time.UnixMilli(field.Int()), nil
+}
+
+// batchPath returns the path of a new file of a class under basepath written at now, in the
+// partition directory returned by partitionItems.
+func batchPath(basepath, partition, ext string, now time.Time) string {
+	return filepath.Join(basepath, partition, fmt.Sprintf("%s.%s", now.Format("20060102T150405Z"), ext))
 }
 
 type BaseDatastore[T any] struct {
@@ -23,7 +94,11 @@ type BaseDatastore[T any] struct {
 }
 
 // Save saves a batch of events to the datastore. Datastore implementations handle file formats.
+// Empty batches are skipped, so that syncs without new events do not write empty files.
 func (d *BaseDatastore[T]) Save(ctx context.Context, items []T) error {
+	if len(items) == 0 {
+		return nil
+	}
 	if err := d.store.WriteBatch(ctx, items); err != nil {
 		return err
 	}
@@ -33,6 +108,10 @@ func (d *BaseDatastore[T]) Save(ctx context.Context, items []T) error {
 }
 
 func SetupStorage[T any](ctx context.Context, opts StorageOpts) (Datastore[T], error) {
+	if opts.RejectInvalid && !validates[T]() {
+		return nil, fmt.Errorf("%s has no schema validation, regenerate its package to reject invalid events", reflect.TypeFor[T]())
+	}
+
 	var storage Datastore[T]
 	var s3Client *s3.Client
 	var err error
@@ -89,5 +168,21 @@ func SetupStorage[T any](ctx context.Context, opts StorageOpts) (Datastore[T], e
 		return nil, fmt.Errorf("no storage format specified, use --parquet or --json")
 	}
 
+	switch opts.Partitioning {
+	case "", PartitioningNone:
+	case PartitioningDay:
+		p, ok := storage.(partitionable)
+		if !ok {
+			return nil, fmt.Errorf("%s partitioning is not supported by S3 Tables datastores", opts.Partitioning)
+		}
+		p.setPartitioning(opts.Partitioning)
+	default:
+		return nil, fmt.Errorf("unsupported partitioning %q, use %s or %s", opts.Partitioning, PartitioningNone, PartitioningDay)
+	}
+
+	if opts.RejectInvalid {
+		storage = &validatingDatastore[T]{Datastore: storage}
+	}
+
 	return storage, nil
 }
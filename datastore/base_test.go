@@ -0,0 +1,122 @@
+package datastore
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
+)
+
+func TestBatchPath(t *testing.T) {
+	now := time.Date(2025, 1, 2, 23, 30, 0, 0, time.UTC)
+
+	var tests = []struct {
+		name      string
+		partition string
+		want      string
+	}{
+		{name: "Test unpartitioned files", partition: "", want: filepath.Join("data", "api_activity", "20250102T233000Z.json")},
+		{name: "Test day partitioned files", partition: "dt=2024-06-01", want: filepath.Join("data", "api_activity", "dt=2024-06-01", "20250102T233000Z.json")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := batchPath(filepath.Join("data", "api_activity"), tt.partition, "json", now)
+			if got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPartitionItems(t *testing.T) {
+	// 23:30 in UTC-8 is the next day in UTC, which names the partition.
+	late := time.Date(2024, 6, 1, 23, 30, 0, 0, time.FixedZone("PST", -8*60*60)).UnixMilli()
+	early := time.Date(2024, 6, 2, 8, 0, 0, 0, time.UTC).UnixMilli()
+	backfilled := time.Date(2023, 12, 31, 12, 0, 0, 0, time.UTC).UnixMilli()
+	items := []ocsf.APIActivity{{Time: late}, {Time: backfilled}, {Time: early}}
+
+	var tests = []struct {
+		name         string
+		partitioning string
+		want         map[string]int
+	}{
+		{name: "Test unpartitioned items", partitioning: PartitioningNone, want: map[string]int{"": 3}},
+		{name: "Test items partitioned by the day of their event time", partitioning: PartitioningDay, want: map[string]int{"dt=2024-06-02": 2, "dt=2023-12-31": 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			partitions, err := partitionItems(tt.partitioning, items)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			got := make(map[string]int)
+			for partition, partitionItems := range partitions {
+				got[partition] = len(partitionItems)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got partitions %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSetupStoragePartitioning(t *testing.T) {
+	ctx := context.Background()
+	basepath := Basepath
+	Basepath = t.TempDir()
+	defer func() { Basepath = basepath }()
+
+	var tests = []struct {
+		name         string
+		opts         StorageOpts
+		partitioned  bool
+		wantSetupErr bool
+	}{
+		{name: "Test local JSON files", opts: StorageOpts{IsJSON: true}},
+		{name: "Test day partitioned local JSON files", opts: StorageOpts{IsJSON: true, Partitioning: PartitioningDay}, partitioned: true},
+		{name: "Test day partitioned local Parquet files", opts: StorageOpts{IsParquet: true, Partitioning: PartitioningDay}, partitioned: true},
+		{name: "Test an unknown partitioning", opts: StorageOpts{IsJSON: true, Partitioning: "hour"}, wantSetupErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := filepath.Join(Basepath, ocsf.APIActivityClassname)
+			if err := os.RemoveAll(dir); err != nil {
+				t.Fatal(err)
+			}
+
+			storage, err := SetupStorage[ocsf.APIActivity](ctx, tt.opts)
+			if (err != nil) != tt.wantSetupErr {
+				t.Fatalf("got error %v, want error %t", err, tt.wantSetupErr)
+			}
+			if err != nil {
+				return
+			}
+			eventTime := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC).UnixMilli()
+			if err := storage.Save(ctx, []ocsf.APIActivity{{ClassUID: 6003, Time: eventTime}}); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			files, err := filepath.Glob(filepath.Join(dir, "*.*"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			partitions, err := filepath.Glob(filepath.Join(dir, "dt=2024-06-01", "*.*"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.partitioned && (len(files) != 0 || len(partitions) != 1) {
+				t.Errorf("got files %v and partitioned files %v, want one partitioned file", files, partitions)
+			}
+			if !tt.partitioned && (len(files) != 1 || len(partitions) != 0) {
+				t.Errorf("got files %v and partitioned files %v, want one unpartitioned file", files, partitions)
+			}
+		})
+	}
+}
@@ -0,0 +1,313 @@
+package datastore
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
+	"github.com/apache/arrow-go/v18/arrow"
+)
+
+// Warehouse dialects DDL can be generated for.
+const (
+	// DialectAthena creates Athena or Glue external tables over the files of the S3 datastores.
+	DialectAthena = "athena"
+	// DialectTrino creates Trino Iceberg tables.
+	DialectTrino = "trino"
+	// DialectBigQuery describes BigQuery tables as a JSON schema, as taken by bq mk.
+	DialectBigQuery = "bigquery"
+)
+
+// DDLOpts configures the generated DDL.
+type DDLOpts struct {
+	// Bucket is the S3 bucket the S3 datastores write to. It is required for Athena tables.
+	Bucket string
+	// Format is the format of the stored files, "parquet" or "json". Defaults to parquet.
+	Format string
+	// Partitioning is the Partitioning the S3 datastores write with. Athena tables over day
+	// partitioned files are partitioned by dt, with partition projection.
+	Partitioning string
+}
+
+// partitionColumn is the event time column tables are partitioned by day on.
+const partitionColumn = "time"
+
+// GenerateDDL returns the statement, or for BigQuery the JSON schema, creating the table of class
+// in dialect. Athena tables read the files the S3 datastores write under
+// s3://<bucket>/<class basepath>/, which are only split into partition directories with
+// PartitioningDay, so they are not partitioned otherwise. Trino tables are partitioned by the day
+// of the event time; BigQuery tables should be created with --time_partitioning_field time.
+func GenerateDDL(class ocsf.Class, dialect string, opts DDLOpts) (string, error) {
+	if class.Schema == nil {
+		return "", fmt.Errorf("class %s has no schema", class.Name)
+	}
+
+	switch dialect {
+	case DialectAthena:
+		return athenaDDL(class, opts)
+	case DialectTrino:
+		return trinoDDL(class)
+	case DialectBigQuery:
+		return bigQuerySchema(class)
+	default:
+		return "", fmt.Errorf("unsupported DDL dialect %q", dialect)
+	}
+}
+
+func athenaDDL(class ocsf.Class, opts DDLOpts) (string, error) {
+	if opts.Bucket == "" {
+		return "", fmt.Errorf("athena tables need the bucket of the S3 datastore")
+	}
+
+	var storage string
+	switch opts.Format {
+	case "", "parquet":
+		storage = "STORED AS PARQUET"
+	case "json":
+		storage = "ROW FORMAT SERDE 'org.openx.data.jsonserde.JsonSerDe'\nSTORED AS TEXTFILE"
+	default:
+		return "", fmt.Errorf("unsupported file format %q", opts.Format)
+	}
+
+	var columns []string
+	for _, field := range class.Schema.Fields() {
+		columnType, err := hiveType(field.Type, opts.Format == "json")
+		if err != nil {
+			return "", fmt.Errorf("column %s: %w", field.Name, err)
+		}
+		columns = append(columns, fmt.Sprintf("  `%s` %s%s", field.Name, columnType, hiveComment(field)))
+	}
+
+	location := fmt.Sprintf("s3://%s/%s/", opts.Bucket, classBasepath(class))
+
+	var partitions, properties string
+	switch opts.Partitioning {
+	case "", PartitioningNone:
+	case PartitioningDay:
+		// Project the dt partitions instead of requiring them to be added as files are written.
+		partitions = "\nPARTITIONED BY (`dt` string)"
+		properties = fmt.Sprintf(`
+TBLPROPERTIES (
+  'projection.enabled'='true',
+  'projection.dt.type'='date',
+  'projection.dt.format'='yyyy-MM-dd',
+  'projection.dt.range'='2020-01-01,NOW',
+  'projection.dt.interval'='1',
+  'projection.dt.interval.unit'='DAYS',
+  'storage.location.template'='%sdt=${dt}/'
+)`, location)
+	default:
+		return "", fmt.Errorf("unsupported partitioning %q", opts.Partitioning)
+	}
+
+	ident := ClassTable(class)
+	return fmt.Sprintf("CREATE EXTERNAL TABLE IF NOT EXISTS `%s`.`%s` (\n%s\n)%s\n%s\nLOCATION '%s'%s;\n",
+		ident[0], ident[1], strings.Join(columns, ",\n"), partitions, storage, location, properties), nil
+}
+
+// hiveType returns the Hive type of an Arrow type. JSON files hold timestamps as epoch
+// milliseconds, which Hive reads as bigint.
+func hiveType(t arrow.DataType, json bool) (string, error) {
+	switch t := t.(type) {
+	case *arrow.StringType:
+		return "string", nil
+	case *arrow.BinaryType:
+		return "binary", nil
+	case *arrow.BooleanType:
+		return "boolean", nil
+	case *arrow.Int32Type:
+		return "int", nil
+	case *arrow.Int64Type:
+		return "bigint", nil
+	case *arrow.Float32Type:
+		return "float", nil
+	case *arrow.Float64Type:
+		return "double", nil
+	case *arrow.Date32Type:
+		// Dates are written as plain int32 days.
+		return "int", nil
+	case *arrow.TimestampType:
+		if json {
+			return "bigint", nil
+		}
+		return "timestamp", nil
+	case *arrow.ListType:
+		elem, err := hiveType(t.Elem(), json)
+		if err != nil {
+			return "", err
+		}
+		return "array<" + elem + ">", nil
+	case *arrow.StructType:
+		fields := make([]string, 0, t.NumFields())
+		for _, field := range t.Fields() {
+			fieldType, err := hiveType(field.Type, json)
+			if err != nil {
+				return "", fmt.Errorf("field %s: %w", field.Name, err)
+			}
+			fields = append(fields, "`"+field.Name+"`:"+fieldType)
+		}
+		return "struct<" + strings.Join(fields, ",") + ">", nil
+	default:
+		return "", fmt.Errorf("unsupported Arrow type %s", t)
+	}
+}
+
+func hiveComment(field arrow.Field) string {
+	doc := ocsf.AttributeDoc(field.Metadata)
+	if doc == "" {
+		return ""
+	}
+	return " COMMENT '" + strings.ReplaceAll(doc, "'", "\\'") + "'"
+}
+
+func trinoDDL(class ocsf.Class) (string, error) {
+	var columns []string
+	var partitioned bool
+	for _, field := range class.Schema.Fields() {
+		columnType, err := trinoType(field.Type)
+		if err != nil {
+			return "", fmt.Errorf("column %s: %w", field.Name, err)
+		}
+
+		column := fmt.Sprintf("  %s %s", trinoIdent(field.Name), columnType)
+		if !field.Nullable {
+			column += " NOT NULL"
+		}
+		if doc := ocsf.AttributeDoc(field.Metadata); doc != "" {
+			column += " COMMENT '" + strings.ReplaceAll(doc, "'", "''") + "'"
+		}
+		columns = append(columns, column)
+
+		if field.Name == partitionColumn && field.Type.ID() == arrow.TIMESTAMP {
+			partitioned = true
+		}
+	}
+
+	properties := "format = 'PARQUET'"
+	if partitioned {
+		properties += fmt.Sprintf(", partitioning = ARRAY['day(%s)']", partitionColumn)
+	}
+
+	ident := ClassTable(class)
+	return fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s.%s (\n%s\n)\nWITH (%s);\n",
+		trinoIdent(ident[0]), trinoIdent(ident[1]), strings.Join(columns, ",\n"), properties), nil
+}
+
+func trinoIdent(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+// trinoType returns the Trino type of an Arrow type. Iceberg stores timestamps with microsecond
+// precision.
+func trinoType(t arrow.DataType) (string, error) {
+	switch t := t.(type) {
+	case *arrow.StringType:
+		return "varchar", nil
+	case *arrow.BinaryType:
+		return "varbinary", nil
+	case *arrow.BooleanType:
+		return "boolean", nil
+	case *arrow.Int32Type:
+		return "integer", nil
+	case *arrow.Int64Type:
+		return "bigint", nil
+	case *arrow.Float32Type:
+		return "real", nil
+	case *arrow.Float64Type:
+		return "double", nil
+	case *arrow.Date32Type:
+		return "date", nil
+	case *arrow.TimestampType:
+		return "timestamp(6)", nil
+	case *arrow.ListType:
+		elem, err := trinoType(t.Elem())
+		if err != nil {
+			return "", err
+		}
+		return "array(" + elem + ")", nil
+	case *arrow.StructType:
+		fields := make([]string, 0, t.NumFields())
+		for _, field := range t.Fields() {
+			fieldType, err := trinoType(field.Type)
+			if err != nil {
+				return "", fmt.Errorf("field %s: %w", field.Name, err)
+			}
+			fields = append(fields, trinoIdent(field.Name)+" "+fieldType)
+		}
+		return "row(" + strings.Join(fields, ", ") + ")", nil
+	default:
+		return "", fmt.Errorf("unsupported Arrow type %s", t)
+	}
+}
+
+// bigQueryField is a field of a BigQuery table schema.
+type bigQueryField struct {
+	Name        string          `json:"name"`
+	Type        string          `json:"type"`
+	Mode        string          `json:"mode"`
+	Description string          `json:"description,omitempty"`
+	Fields      []bigQueryField `json:"fields,omitempty"`
+}
+
+func bigQuerySchema(class ocsf.Class) (string, error) {
+	fields, err := bigQueryFields(class.Schema.Fields())
+	if err != nil {
+		return "", err
+	}
+
+	data, err := json.MarshalIndent(fields, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(data) + "\n", nil
+}
+
+func bigQueryFields(arrowFields []arrow.Field) ([]bigQueryField, error) {
+	fields := make([]bigQueryField, 0, len(arrowFields))
+	for _, arrowField := range arrowFields {
+		mode := "NULLABLE"
+		if !arrowField.Nullable {
+			mode = "REQUIRED"
+		}
+
+		t := arrowField.Type
+		if list, ok := t.(*arrow.ListType); ok {
+			mode = "REPEATED"
+			t = list.Elem()
+		}
+
+		field := bigQueryField{
+			Name:        arrowField.Name,
+			Mode:        mode,
+			Description: ocsf.AttributeDoc(arrowField.Metadata),
+		}
+		switch t := t.(type) {
+		case *arrow.StringType:
+			field.Type = "STRING"
+		case *arrow.BinaryType:
+			field.Type = "BYTES"
+		case *arrow.BooleanType:
+			field.Type = "BOOLEAN"
+		case *arrow.Int32Type, *arrow.Int64Type:
+			field.Type = "INTEGER"
+		case *arrow.Float32Type, *arrow.Float64Type:
+			field.Type = "FLOAT"
+		case *arrow.Date32Type:
+			field.Type = "DATE"
+		case *arrow.TimestampType:
+			field.Type = "TIMESTAMP"
+		case *arrow.StructType:
+			children, err := bigQueryFields(t.Fields())
+			if err != nil {
+				return nil, fmt.Errorf("field %s: %w", arrowField.Name, err)
+			}
+			field.Type = "RECORD"
+			field.Fields = children
+		default:
+			return nil, fmt.Errorf("field %s: unsupported Arrow type %s", arrowField.Name, t)
+		}
+		fields = append(fields, field)
+	}
+	return fields, nil
+}
@@ -0,0 +1,93 @@
+package datastore
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
+	"github.com/apache/arrow-go/v18/arrow"
+)
+
+var update = flag.Bool("update", false, "Rewrite the golden files of the tests")
+
+// ddlClass covers the column types, nesting and comments of the generated classes.
+var ddlClass = ocsf.Class{Name: "test_finding", Package: "v1_5_0", Version: "1.5.0", Schema: arrow.NewSchema([]arrow.Field{
+	{Name: "activity_id", Type: arrow.PrimitiveTypes.Int32, Metadata: ocsf.AttributeMetadata("Activity ID", "The activity.", "required", "1: Create; 2: Update")},
+	{Name: "time", Type: arrow.FixedWidthTypes.Timestamp_ms, Metadata: ocsf.AttributeMetadata("Event Time", "The event's time.", "required", "")},
+	{Name: "count", Type: arrow.PrimitiveTypes.Int64, Nullable: true},
+	{Name: "score", Type: arrow.PrimitiveTypes.Float64, Nullable: true},
+	{Name: "is_alert", Type: arrow.FixedWidthTypes.Boolean, Nullable: true},
+	{Name: "first_seen", Type: arrow.FixedWidthTypes.Date32, Nullable: true},
+	{Name: "labels", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true},
+	{Name: "finding_info", Type: arrow.StructOf(
+		arrow.Field{Name: "uid", Type: arrow.BinaryTypes.String, Metadata: ocsf.AttributeMetadata("Unique ID", "", "required", "")},
+		arrow.Field{Name: "types", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true},
+	), Metadata: ocsf.AttributeMetadata("Finding Information", "The finding.", "required", "")},
+	{Name: "resources", Type: arrow.ListOf(arrow.StructOf(
+		arrow.Field{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true},
+	)), Nullable: true},
+	{Name: "unmapped", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Unmapped Data", "Attributes that don't map to the schema.", "optional", "")},
+}, nil)}
+
+func TestGenerateDDL(t *testing.T) {
+	var tests = []struct {
+		name    string
+		dialect string
+		opts    DDLOpts
+		golden  string
+	}{
+		{name: "Test an Athena table over Parquet files", dialect: DialectAthena, opts: DDLOpts{Bucket: "my-lake"}, golden: "athena_parquet.sql"},
+		{name: "Test an Athena table over JSON files", dialect: DialectAthena, opts: DDLOpts{Bucket: "my-lake", Format: "json"}, golden: "athena_json.sql"},
+		{name: "Test an Athena table over day partitioned files", dialect: DialectAthena, opts: DDLOpts{Bucket: "my-lake", Partitioning: PartitioningDay}, golden: "athena_partitioned.sql"},
+		{name: "Test a Trino table", dialect: DialectTrino, golden: "trino.sql"},
+		{name: "Test a BigQuery schema", dialect: DialectBigQuery, golden: "bigquery.json"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GenerateDDL(ddlClass, tt.dialect, tt.opts)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			golden := filepath.Join("testdata", "ddl", tt.golden)
+			if *update {
+				if err := os.WriteFile(golden, []byte(got), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			want, err := os.ReadFile(golden)
+			if err != nil {
+				t.Fatalf("failed to read golden file, run with -update to create it: %v", err)
+			}
+			if got != string(want) {
+				t.Errorf("DDL does not match %s, run with -update to rewrite it:\n%s", golden, got)
+			}
+		})
+	}
+}
+
+func TestGenerateDDLInvalid(t *testing.T) {
+	var tests = []struct {
+		name    string
+		class   ocsf.Class
+		dialect string
+		opts    DDLOpts
+	}{
+		{name: "Test an unknown dialect", class: ddlClass, dialect: "hive"},
+		{name: "Test an Athena table without a bucket", class: ddlClass, dialect: DialectAthena},
+		{name: "Test an unknown file format", class: ddlClass, dialect: DialectAthena, opts: DDLOpts{Bucket: "my-lake", Format: "csv"}},
+		{name: "Test an unknown partitioning", class: ddlClass, dialect: DialectAthena, opts: DDLOpts{Bucket: "my-lake", Partitioning: "hour"}},
+		{name: "Test a class without a schema", class: ocsf.Class{Name: "test_finding"}, dialect: DialectTrino},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := GenerateDDL(tt.class, tt.dialect, tt.opts); err == nil {
+				t.Errorf("expected an error")
+			}
+		})
+	}
+}
@@ -3,11 +3,11 @@ package datastore
 import (
 	"context"
 	"encoding/json"
-	"fmt"
 	"log/slog"
+	"maps"
 	"os"
 	"path/filepath"
-	"reflect"
+	"slices"
 	"time"
 
 	"github.com/samsarahq/go/oops"
@@ -16,21 +16,28 @@ import (
 type localJsonDatastore[T any] struct {
 	BaseDatastore[T]
 
-	currentPath string
-	basepath    string
+	// currentPaths holds the file being appended to in each partition.
+	currentPaths map[string]string
+	basepath     string
+	partitioning string
 }
 
 // localJsonDatastore implements the Datastore interface using local JSON files for storage.
 // It provides methods to retrieve, save, and manage ocsf data in JSON format.
 func NewLocalJsonDatastore[T any](ctx context.Context) (Datastore[T], error) {
+	class, err := classOf[T]()
+	if err != nil {
+		return nil, err
+	}
 
-	typeName := reflect.TypeOf((*T)(nil)).Elem().Name()
-	if err := os.MkdirAll(basepaths[typeName], 0755); err != nil {
+	basepath := classBasepath(class)
+	if err := os.MkdirAll(basepath, 0755); err != nil {
 		return nil, oops.Wrapf(err, "failed to create directory")
 	}
 
 	s := &localJsonDatastore[T]{
-		basepath: basepaths[typeName],
+		currentPaths: make(map[string]string),
+		basepath:     basepath,
 	}
 
 	s.BaseDatastore = BaseDatastore[T]{
@@ -56,15 +63,34 @@ func (s *localJsonDatastore[T]) GetItemsFromFile(ctx context.Context, path strin
 	return items, nil
 }
 
-// WriteBatch creates a new JSON file for storing ocsf data.
+// WriteBatch creates a new JSON file for storing ocsf data in each partition of the batch.
 // It marshals the data into a JSON object and writes it to the specified file path.
 func (s *localJsonDatastore[T]) WriteBatch(ctx context.Context, items []T) error {
+	partitions, err := partitionItems(s.partitioning, items)
+	if err != nil {
+		return err
+	}
+
+	for _, partition := range slices.Sorted(maps.Keys(partitions)) {
+		if err := s.writePartition(ctx, partition, partitions[partition]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *localJsonDatastore[T]) writePartition(ctx context.Context, partition string, items []T) error {
 	allItems := items
 
-	if s.currentPath == "" {
-		s.currentPath = filepath.Join(s.basepath, fmt.Sprintf("%s.json", time.Now().Format("20060102T150405Z")))
+	currentPath := s.currentPaths[partition]
+	if currentPath == "" {
+		currentPath = batchPath(s.basepath, partition, "json", time.Now())
+		if err := os.MkdirAll(filepath.Dir(currentPath), 0755); err != nil {
+			return oops.Wrapf(err, "failed to create directory")
+		}
 	} else {
-		fileItems, err := s.GetItemsFromFile(ctx, s.currentPath)
+		fileItems, err := s.GetItemsFromFile(ctx, currentPath)
 		if err != nil {
 			return oops.Wrapf(err, "failed to get existing items from disk")
 		}
@@ -77,11 +103,16 @@ func (s *localJsonDatastore[T]) WriteBatch(ctx context.Context, items []T) error
 		return oops.Wrapf(err, "failed to marshal items to JSON")
 	}
 
-	if err := os.WriteFile(s.currentPath, jsonData, 0644); err != nil {
+	if err := os.WriteFile(currentPath, jsonData, 0644); err != nil {
 		return oops.Wrapf(err, "failed to write JSON to disk")
 	}
+	s.currentPaths[partition] = currentPath
 
-	slog.Info("Wrote JSON file to disk", "path", s.currentPath, "items", len(allItems))
+	slog.Info("Wrote JSON file to disk", "path", currentPath, "items", len(allItems))
 
 	return nil
 }
+
+func (s *localJsonDatastore[T]) setPartitioning(partitioning string) {
+	s.partitioning = partitioning
+}
@@ -2,11 +2,11 @@ package datastore
 
 import (
 	"context"
-	"fmt"
 	"log/slog"
+	"maps"
 	"os"
 	"path/filepath"
-	"reflect"
+	"slices"
 	"time"
 
 	goParquet "github.com/parquet-go/parquet-go"
@@ -16,20 +16,29 @@ import (
 type localParquetDatastore[T any] struct {
 	BaseDatastore[T]
 
-	currentPath string
-	basepath    string
+	// currentPaths holds the file being appended to in each partition.
+	currentPaths map[string]string
+	basepath     string
+	partitioning string
+	options      []goParquet.WriterOption
 }
 
 // NewLocalParquetDatastore creates a new local Parquet datastore.
 func NewLocalParquetDatastore[T any](ctx context.Context) (Datastore[T], error) {
+	class, err := classOf[T]()
+	if err != nil {
+		return nil, err
+	}
 
-	typeName := reflect.TypeOf((*T)(nil)).Elem().Name()
-	if err := os.MkdirAll(basepaths[typeName], 0755); err != nil {
+	basepath := classBasepath(class)
+	if err := os.MkdirAll(basepath, 0755); err != nil {
 		return nil, oops.Wrapf(err, "failed to create directory")
 	}
 
 	s := &localParquetDatastore[T]{
-		basepath: basepaths[typeName],
+		currentPaths: make(map[string]string),
+		basepath:     basepath,
+		options:      parquetWriterOptions(class),
 	}
 
 	s.BaseDatastore = BaseDatastore[T]{
@@ -50,30 +59,54 @@ func (s *localParquetDatastore[T]) GetItemsFromFile(ctx context.Context, path st
 	return items, nil
 }
 
-// createFile creates a new Parquet file for storing ocsf data.
+// createFile creates a new Parquet file for storing ocsf data in each partition of the batch.
 // It writes the data to the specified file path.
 func (s *localParquetDatastore[T]) WriteBatch(ctx context.Context, items []T) error {
+	partitions, err := partitionItems(s.partitioning, items)
+	if err != nil {
+		return err
+	}
+
+	for _, partition := range slices.Sorted(maps.Keys(partitions)) {
+		if err := s.writePartition(ctx, partition, partitions[partition]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *localParquetDatastore[T]) writePartition(ctx context.Context, partition string, items []T) error {
 	allItems := items
 
-	if s.currentPath == "" {
-		s.currentPath = filepath.Join(s.basepath, fmt.Sprintf("%s.parquet.gz", time.Now().Format("20060102T150405Z")))
+	currentPath := s.currentPaths[partition]
+	if currentPath == "" {
+		currentPath = batchPath(s.basepath, partition, "parquet.gz", time.Now())
+		if err := os.MkdirAll(filepath.Dir(currentPath), 0755); err != nil {
+			return oops.Wrapf(err, "failed to create directory")
+		}
 	} else {
-		fileItems, err := s.GetItemsFromFile(ctx, s.currentPath)
+		fileItems, err := s.GetItemsFromFile(ctx, currentPath)
 		if err != nil {
 			return oops.Wrapf(err, "failed to get existing items from disk")
 		}
 		allItems = append(allItems, fileItems...)
 	}
 
-	err := goParquet.WriteFile(s.currentPath, allItems, goParquet.Compression(&goParquet.Gzip))
+	err := goParquet.WriteFile(currentPath, allItems, s.options...)
 	if err != nil {
 		return oops.Wrapf(err, "failed to write to parquet")
 	}
+	s.currentPaths[partition] = currentPath
 
 	slog.Info("Wrote parquet file to disk",
-		"path", s.currentPath,
+		"path", currentPath,
 		"items", len(allItems),
 	)
 
 	return nil
 }
+
+func (s *localParquetDatastore[T]) setPartitioning(partitioning string) {
+	s.partitioning = partitioning
+}
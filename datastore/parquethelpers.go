@@ -258,3 +258,37 @@ func fieldByName(s *iceberg.StructType, name string) (iceberg.NestedField, bool)
 	}
 	return iceberg.NestedField{}, false
 }
+
+// parquetWriterOptions returns the options of the Parquet writers for class. Besides compressing
+// the file, they record the class and the OCSF description of every column as key-value metadata,
+// e.g. "ocsf.doc.finding_info.uid", so that the files describe themselves.
+func parquetWriterOptions(class ocsf.Class) []parquet.WriterOption {
+	options := []parquet.WriterOption{
+		parquet.Compression(&parquet.Gzip),
+		parquet.KeyValueMetadata("ocsf.class", class.Name),
+		parquet.KeyValueMetadata("ocsf.version", class.Version),
+	}
+
+	var addDocs func(path string, fields []arrow.Field)
+	addDocs = func(path string, fields []arrow.Field) {
+		for _, field := range fields {
+			fieldPath := ocsf.JoinPath(path, field.Name)
+			if doc := ocsf.AttributeDoc(field.Metadata); doc != "" {
+				options = append(options, parquet.KeyValueMetadata("ocsf.doc."+fieldPath, doc))
+			}
+
+			fieldType := field.Type
+			if list, ok := fieldType.(*arrow.ListType); ok {
+				fieldType = list.Elem()
+			}
+			if st, ok := fieldType.(*arrow.StructType); ok {
+				addDocs(fieldPath, st.Fields())
+			}
+		}
+	}
+	if class.Schema != nil {
+		addDocs("", class.Schema.Fields())
+	}
+
+	return options
+}
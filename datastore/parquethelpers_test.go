@@ -0,0 +1,57 @@
+package datastore
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/parquet-go/parquet-go"
+)
+
+func TestParquetWriterOptions(t *testing.T) {
+	resource := arrow.StructOf(arrow.Field{Name: "uid", Type: arrow.BinaryTypes.String, Metadata: ocsf.AttributeMetadata("Unique ID", "The resource ID.", "required", "")})
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "status_id", Type: arrow.PrimitiveTypes.Int32, Metadata: ocsf.AttributeMetadata("Status ID", "", "recommended", "1: New")},
+		{Name: "resources", Type: arrow.ListOf(resource), Nullable: true},
+	}, nil)
+	class := ocsf.Class{Name: "test_finding", Package: "v1_5_0", Version: "1.5.0", Schema: schema}
+
+	type row struct {
+		StatusId int32 `parquet:"status_id"`
+	}
+	var buf bytes.Buffer
+	writer := parquet.NewGenericWriter[row](&buf, parquetWriterOptions(class)...)
+	if _, err := writer.Write([]row{{StatusId: 1}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	file, err := parquet.OpenFile(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name string
+		key  string
+		want string
+	}{
+		{name: "Test the class", key: "ocsf.class", want: "test_finding"},
+		{name: "Test the version", key: "ocsf.version", want: "1.5.0"},
+		{name: "Test a column", key: "ocsf.doc.status_id", want: "Status ID (recommended) Values: 1: New."},
+		{name: "Test a column in a list", key: "ocsf.doc.resources.uid", want: "Unique ID: The resource ID. (required)"},
+		{name: "Test a column without metadata", key: "ocsf.doc.resources"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := file.Lookup(tt.key)
+			if ok != (tt.want != "") || got != tt.want {
+				t.Errorf("got %q, %v, want %q", got, ok, tt.want)
+			}
+		})
+	}
+}
@@ -4,11 +4,10 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
-	"fmt"
 	"io"
 	"log/slog"
-	"path/filepath"
-	"reflect"
+	"maps"
+	"slices"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -20,21 +19,26 @@ type s3JsonDatastore[T any] struct {
 	s3Bucket string
 	s3Client *s3.Client
 
-	currentPath string
-	basePath    string
+	// currentPaths holds the key being appended to in each partition.
+	currentPaths map[string]string
+	basePath     string
+	partitioning string
 
 	BaseDatastore[T]
 }
 
 // NewS3JsonDatastore creates a new S3 JSON datastore.
 func NewS3JsonDatastore[T any](ctx context.Context, bucketName string, s3Client *s3.Client) (Datastore[T], error) {
-
-	typeName := reflect.TypeOf((*T)(nil)).Elem().Name()
+	class, err := classOf[T]()
+	if err != nil {
+		return nil, err
+	}
 
 	s := &s3JsonDatastore[T]{
-		s3Bucket: bucketName,
-		s3Client: s3Client,
-		basePath: basepaths[typeName],
+		s3Bucket:     bucketName,
+		s3Client:     s3Client,
+		currentPaths: make(map[string]string),
+		basePath:     classBasepath(class),
 	}
 
 	s.BaseDatastore = BaseDatastore[T]{
@@ -69,15 +73,31 @@ func (s *s3JsonDatastore[T]) GetItemsFromFile(ctx context.Context, key string) (
 	return items, nil
 }
 
-// WriteBatch creates a new JSON file for storing ocsf data.
+// WriteBatch creates a new JSON file for storing ocsf data in each partition of the batch.
 // It marshals the data into a JSON object and writes it to the specified file path.
 func (s *s3JsonDatastore[T]) WriteBatch(ctx context.Context, items []T) error {
+	partitions, err := partitionItems(s.partitioning, items)
+	if err != nil {
+		return err
+	}
+
+	for _, partition := range slices.Sorted(maps.Keys(partitions)) {
+		if err := s.writePartition(ctx, partition, partitions[partition]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *s3JsonDatastore[T]) writePartition(ctx context.Context, partition string, items []T) error {
 	allItems := items
 
-	if s.currentPath == "" {
-		s.currentPath = filepath.Join(s.basePath, fmt.Sprintf("%s.json", time.Now().Format("20060102T150405Z")))
+	currentPath := s.currentPaths[partition]
+	if currentPath == "" {
+		currentPath = batchPath(s.basePath, partition, "json", time.Now())
 	} else {
-		fileItems, err := s.GetItemsFromFile(ctx, s.currentPath)
+		fileItems, err := s.GetItemsFromFile(ctx, currentPath)
 		if err != nil {
 			return oops.Wrapf(err, "failed to get existing items from s3")
 		}
@@ -92,19 +112,24 @@ func (s *s3JsonDatastore[T]) WriteBatch(ctx context.Context, items []T) error {
 
 	_, err = s.s3Client.PutObject(ctx, &s3.PutObjectInput{
 		Bucket:      &s.s3Bucket,
-		Key:         &s.currentPath,
+		Key:         &currentPath,
 		Body:        bytes.NewReader(jsonData),
 		ContentType: aws.String("application/json"),
 	})
 	if err != nil {
 		return oops.Wrapf(err, "failed to upload JSON to S3")
 	}
+	s.currentPaths[partition] = currentPath
 
 	slog.Info("Wrote JSON file to S3",
 		"bucket", s.s3Bucket,
-		"key", s.currentPath,
+		"key", currentPath,
 		"items", len(allItems),
 	)
 
 	return nil
 }
+
+func (s *s3JsonDatastore[T]) setPartitioning(partitioning string) {
+	s.partitioning = partitioning
+}
@@ -3,11 +3,10 @@ package datastore
 import (
 	"bytes"
 	"context"
-	"fmt"
 	"io"
 	"log/slog"
-	"path/filepath"
-	"reflect"
+	"maps"
+	"slices"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -20,19 +19,25 @@ type s3ParquetDatastore[T any] struct {
 	s3Bucket string
 	s3Client *s3.Client
 
-	basePath string
+	basePath     string
+	partitioning string
+	options      []goParquet.WriterOption
 
 	BaseDatastore[T]
 }
 
 // NewS3ParquetDatastore creates a new S3 Parquet datastore.
 func NewS3ParquetDatastore[T any](ctx context.Context, bucketName string, s3Client *s3.Client) (Datastore[T], error) {
-	typeName := reflect.TypeOf((*T)(nil)).Elem().Name()
+	class, err := classOf[T]()
+	if err != nil {
+		return nil, err
+	}
 
 	s := &s3ParquetDatastore[T]{
 		s3Bucket: bucketName,
 		s3Client: s3Client,
-		basePath: basepaths[typeName],
+		basePath: classBasepath(class),
+		options:  parquetWriterOptions(class),
 	}
 
 	s.BaseDatastore = BaseDatastore[T]{
@@ -42,32 +47,44 @@ func NewS3ParquetDatastore[T any](ctx context.Context, bucketName string, s3Clie
 	return s, nil
 }
 
-// WriteBatch creates a new Parquet file for storing ocsf data.
+// WriteBatch creates a new Parquet file for storing ocsf data in each partition of the batch.
 // It writes the data to the specified file path
 func (s *s3ParquetDatastore[T]) WriteBatch(ctx context.Context, items []T) error {
-	savePath := filepath.Join(s.basePath, fmt.Sprintf("%s.parquet.gz", time.Now().Format("20060102T150405Z")))
-
-	var buf bytes.Buffer
-	writer := io.Writer(&buf)
-	if err := goParquet.Write[T](writer, items, goParquet.Compression(&goParquet.Gzip)); err != nil {
-		return oops.Wrapf(err, "failed to write to parquet buffer")
-	}
-
-	_, err := s.s3Client.PutObject(ctx, &s3.PutObjectInput{
-		Bucket:          &s.s3Bucket,
-		Key:             &savePath,
-		Body:            bytes.NewReader(buf.Bytes()),
-		ContentType:     aws.String("application/octet-stream"),
-		ContentEncoding: aws.String("gzip"),
-	})
+	partitions, err := partitionItems(s.partitioning, items)
 	if err != nil {
-		return oops.Wrapf(err, "failed to upload Parquet to S3")
+		return err
 	}
 
-	slog.Info("Wrote Parquet file to S3",
-		"bucket", s.s3Bucket,
-		"key", savePath,
-		"items", len(items),
-	)
+	now := time.Now()
+	for _, partition := range slices.Sorted(maps.Keys(partitions)) {
+		savePath := batchPath(s.basePath, partition, "parquet.gz", now)
+
+		var buf bytes.Buffer
+		writer := io.Writer(&buf)
+		if err := goParquet.Write[T](writer, partitions[partition], s.options...); err != nil {
+			return oops.Wrapf(err, "failed to write to parquet buffer")
+		}
+
+		_, err := s.s3Client.PutObject(ctx, &s3.PutObjectInput{
+			Bucket:          &s.s3Bucket,
+			Key:             &savePath,
+			Body:            bytes.NewReader(buf.Bytes()),
+			ContentType:     aws.String("application/octet-stream"),
+			ContentEncoding: aws.String("gzip"),
+		})
+		if err != nil {
+			return oops.Wrapf(err, "failed to upload Parquet to S3")
+		}
+
+		slog.Info("Wrote Parquet file to S3",
+			"bucket", s.s3Bucket,
+			"key", savePath,
+			"items", len(partitions[partition]),
+		)
+	}
 	return nil
 }
+
+func (s *s3ParquetDatastore[T]) setPartitioning(partitioning string) {
+	s.partitioning = partitioning
+}
@@ -4,37 +4,24 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
-	"reflect"
+	"maps"
 	"strings"
 
-	ocsf "github.com/Santiago-Labs/go-ocsf/ocsf/v1_4_0"
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
+	"github.com/Santiago-Labs/go-ocsf/ocsf/fieldids"
 	"github.com/apache/arrow-go/v18/arrow"
 	"github.com/apache/arrow-go/v18/arrow/array"
 	"github.com/apache/iceberg-go"
 	"github.com/apache/iceberg-go/catalog"
 	"github.com/apache/iceberg-go/table"
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/s3tables"
 	"github.com/samsarahq/go/oops"
 
 	_ "github.com/apache/iceberg-go/catalog/rest"
 )
 
-var (
-	findingIdent  = table.Identifier([]string{"ocsf_data", "vulnerability_finding"})
-	activityIdent = table.Identifier([]string{"ocsf_data", "api_activity"})
-
-	tables = map[string]table.Identifier{
-		"VulnerabilityFinding": findingIdent,
-		"APIActivity":          activityIdent,
-	}
-
-	schemas = map[string]*arrow.Schema{
-		"VulnerabilityFinding": ocsf.VulnerabilityFindingSchema,
-		"APIActivity":          ocsf.APIActivitySchema,
-	}
-)
-
 type s3TablesDatastore[T any] struct {
 	s3Bucket string
 	table    *table.Table
@@ -44,59 +31,32 @@ type s3TablesDatastore[T any] struct {
 }
 
 // NewS3TablesDatastore creates a new S3 Tables datastore.
-func NewS3TablesDatastore[T any](ctx context.Context, bucketName string, s3Client *s3tables.Client) (Datastore[T], error) {
-	var bucketRegion string
-	var bucketArn string
-	var nextToken *string
-	for {
-		allbuckets, err := s3Client.ListTableBuckets(ctx, &s3tables.ListTableBucketsInput{
-			ContinuationToken: nextToken,
-		})
-		if err != nil {
-			return nil, oops.Wrapf(err, "failed to list buckets")
-		}
-		for _, bucket := range allbuckets.TableBuckets {
-			if *bucket.Name == bucketName {
-				bucketRegion = strings.Split(*bucket.Arn, ":")[3]
-				bucketArn = *bucket.Arn
-				break
-			}
-		}
-		if allbuckets.ContinuationToken == nil {
-			break
-		}
-		nextToken = allbuckets.ContinuationToken
-	}
-
-	props := iceberg.Properties{
-		"type":                "rest",
-		"warehouse":           bucketArn,
-		"uri":                 fmt.Sprintf("https://s3tables.%s.amazonaws.com/iceberg", bucketRegion),
-		"rest.sigv4-enabled":  "true",
-		"rest.signing-name":   "s3tables",
-		"rest.signing-region": bucketRegion,
+func NewS3TablesDatastore[T any](ctx context.Context, bucket string, s3Client *s3tables.Client) (Datastore[T], error) {
+	class, err := classOf[T]()
+	if err != nil {
+		return nil, err
 	}
 
-	cat, err := catalog.Load(ctx, "rest", props)
+	cat, err := NewS3TablesCatalog(ctx, bucket, s3Client)
 	if err != nil {
-		return nil, oops.Wrapf(err, "failed to create catalog")
+		return nil, err
 	}
 
-	err = setup(ctx, s3Client, cat, bucketArn)
+	ident := ClassTable(class)
+	err = cat.SetupTable(ctx, s3Client, class)
 	if err != nil {
 		return nil, oops.Wrapf(err, "failed to setup tables")
 	}
 
-	typeName := reflect.TypeOf((*T)(nil)).Elem().Name()
-	table, err := cat.LoadTable(ctx, tables[typeName], props)
+	table, err := cat.Catalog.LoadTable(ctx, ident, cat.Props)
 	if err != nil {
 		return nil, oops.Wrapf(err, "failed to load table")
 	}
 
 	s := &s3TablesDatastore[T]{
-		s3Bucket: bucketName,
+		s3Bucket: bucket,
 		table:    table,
-		schema:   schemas[typeName],
+		schema:   class.Schema,
 	}
 
 	s.BaseDatastore = BaseDatastore[T]{
@@ -106,7 +66,89 @@ func NewS3TablesDatastore[T any](ctx context.Context, bucketName string, s3Clien
 	return s, nil
 }
 
-func setup(ctx context.Context, s3TablesClient *s3tables.Client, cat catalog.Catalog, bucketArn string) error {
+// S3TablesCatalog is the Iceberg REST catalog of an S3 table bucket.
+type S3TablesCatalog struct {
+	Catalog   catalog.Catalog
+	Props     iceberg.Properties
+	BucketArn string
+}
+
+// NewS3TablesCatalog loads the Iceberg REST catalog of the S3 table bucket identified by bucket,
+// its ARN or its name.
+func NewS3TablesCatalog(ctx context.Context, bucket string, s3Client *s3tables.Client) (*S3TablesCatalog, error) {
+	bucketArn, err := findTableBucket(ctx, s3Client, bucket)
+	if err != nil {
+		return nil, err
+	}
+	parsed, err := arn.Parse(bucketArn)
+	if err != nil {
+		return nil, oops.Wrapf(err, "invalid table bucket ARN %s", bucketArn)
+	}
+	bucketRegion := parsed.Region
+
+	props := iceberg.Properties{
+		"type":                "rest",
+		"warehouse":           bucketArn,
+		"uri":                 fmt.Sprintf("https://s3tables.%s.amazonaws.com/iceberg", bucketRegion),
+		"rest.sigv4-enabled":  "true",
+		"rest.signing-name":   "s3tables",
+		"rest.signing-region": bucketRegion,
+	}
+
+	cat, err := catalog.Load(ctx, "rest", props)
+	if err != nil {
+		return nil, oops.Wrapf(err, "failed to create catalog")
+	}
+
+	return &S3TablesCatalog{Catalog: cat, Props: props, BucketArn: bucketArn}, nil
+}
+
+// tableBucketLister lists the table buckets of an account.
+type tableBucketLister interface {
+	ListTableBuckets(ctx context.Context, params *s3tables.ListTableBucketsInput, optFns ...func(*s3tables.Options)) (*s3tables.ListTableBucketsOutput, error)
+}
+
+// findTableBucket returns the ARN of the table bucket with the ARN or name bucket.
+func findTableBucket(ctx context.Context, s3Client tableBucketLister, bucket string) (string, error) {
+	byArn := arn.IsARN(bucket)
+	var nextToken *string
+	for {
+		allbuckets, err := s3Client.ListTableBuckets(ctx, &s3tables.ListTableBucketsInput{
+			ContinuationToken: nextToken,
+		})
+		if err != nil {
+			return "", oops.Wrapf(err, "failed to list buckets")
+		}
+		for _, tableBucket := range allbuckets.TableBuckets {
+			if (byArn && aws.ToString(tableBucket.Arn) == bucket) || (!byArn && aws.ToString(tableBucket.Name) == bucket) {
+				return aws.ToString(tableBucket.Arn), nil
+			}
+		}
+		if allbuckets.ContinuationToken == nil {
+			break
+		}
+		nextToken = allbuckets.ContinuationToken
+	}
+	return "", fmt.Errorf("table bucket %s not found", bucket)
+}
+
+// SetupTable creates the ocsf_data namespace and the table of class unless they exist.
+func (c *S3TablesCatalog) SetupTable(ctx context.Context, s3TablesClient *s3tables.Client, class ocsf.Class) error {
+	return setup(ctx, s3TablesClient, c.Catalog, c.BucketArn, ClassTable(class), class)
+}
+
+// tableLister creates namespaces and lists the tables of a table bucket.
+type tableLister interface {
+	CreateNamespace(ctx context.Context, params *s3tables.CreateNamespaceInput, optFns ...func(*s3tables.Options)) (*s3tables.CreateNamespaceOutput, error)
+	ListTables(ctx context.Context, params *s3tables.ListTablesInput, optFns ...func(*s3tables.Options)) (*s3tables.ListTablesOutput, error)
+}
+
+// setup creates the ocsf_data namespace and the table of a class unless they exist. Tables which
+// exist are left as they are: Iceberg data files refer to columns by field ID, so the columns of a
+// table created before the class had a field ID map keep the IDs the catalog assigned them, and
+// only tables created since get the stable field IDs. Writes look the IDs up in the table's schema,
+// so both kinds of tables are written correctly.
+func setup(ctx context.Context, s3TablesClient tableLister, cat catalog.Catalog, bucketArn string, ident table.Identifier, class ocsf.Class) error {
 	_, err := s3TablesClient.CreateNamespace(ctx, &s3tables.CreateNamespaceInput{
 		Namespace:      []string{"ocsf_data"},
 		TableBucketARN: aws.String(bucketArn),
@@ -115,7 +157,6 @@ func setup(ctx context.Context, s3TablesClient *s3tables.Client, cat catalog.Cat
 		return oops.Wrapf(err, "failed to create namespace")
 	}
 
-	existingTables := make(map[string]bool)
 	var nextToken *string
 	for {
 		tables, err := s3TablesClient.ListTables(ctx, &s3tables.ListTablesInput{
@@ -127,32 +168,33 @@ func setup(ctx context.Context, s3TablesClient *s3tables.Client, cat catalog.Cat
 			return oops.Wrapf(err, "failed to list tables")
 		}
 		for _, table := range tables.Tables {
-			existingTables[*table.Name] = true
+			if *table.Name == ident[1] {
+				return nil
+			}
 		}
 		if tables.ContinuationToken == nil {
 			break
 		}
+		nextToken = tables.ContinuationToken
 	}
 
-	if !existingTables[findingIdent[1]] {
-		err = createIcebergTable(ctx, cat, ocsf.VulnerabilityFindingSchema, findingIdent)
-		if err != nil {
-			return oops.Wrapf(err, "failed to create findings table")
-		}
-	}
-
-	if !existingTables[activityIdent[1]] {
-		err = createIcebergTable(ctx, cat, ocsf.APIActivitySchema, activityIdent)
-		if err != nil {
-			return oops.Wrapf(err, "failed to create api activity table")
-		}
+	err = createIcebergTable(ctx, cat, class, ident)
+	if err != nil {
+		return oops.Wrapf(err, "failed to create %s table", ident[1])
 	}
 
 	return nil
 }
 
-func createIcebergTable(ctx context.Context, cat catalog.Catalog, arrowSchema *arrow.Schema, tableName table.Identifier) error {
-	iceSchema, err := ArrowSchemaToIceberg(arrowSchema)
+// createIcebergTable creates the table of a class. Classes with a field ID map get their stable
+// field IDs, so that the table's columns keep their IDs when it moves to another OCSF version.
+func createIcebergTable(ctx context.Context, cat catalog.Catalog, class ocsf.Class, tableName table.Identifier) error {
+	fieldIDs, err := fieldids.Lookup(class.Name)
+	if err != nil {
+		return oops.Wrapf(err, "failed to load field IDs")
+	}
+
+	iceSchema, err := ArrowSchemaToIcebergWithFieldIDs(class.Schema, fieldIDs)
 	if err != nil {
 		return oops.Wrapf(err, "failed to create iceberg schema")
 	}
@@ -163,14 +205,54 @@ func createIcebergTable(ctx context.Context, cat catalog.Catalog, arrowSchema *a
 		}),
 	}
 
-	_, err = cat.CreateTable(ctx, tableName, iceSchema, options...)
+	tbl, err := cat.CreateTable(ctx, tableName, iceSchema, options...)
 	if err != nil {
 		return oops.Wrapf(err, "failed to create table")
 	}
 
+	if fieldIDs == nil {
+		return nil
+	}
+
+	// The catalog assigns fresh field IDs to new tables, so the stable field IDs are set by adding
+	// them as the table's current schema while the table is still empty.
+	current := tbl.Schema()
+	if sameFieldIDs(current, iceSchema) {
+		return nil
+	}
+
+	lastColumnID := max(tbl.Metadata().LastColumnID(), iceSchema.HighestFieldID())
+	stable := iceberg.NewSchema(current.ID+1, iceSchema.Fields()...)
+	_, _, err = cat.CommitTable(ctx, tbl,
+		[]table.Requirement{
+			table.AssertCurrentSchemaID(current.ID),
+			table.AssertLastAssignedFieldID(tbl.Metadata().LastColumnID()),
+		},
+		[]table.Update{
+			table.NewAddSchemaUpdate(stable, lastColumnID, false),
+			table.NewSetCurrentSchemaUpdate(-1),
+		},
+	)
+	if err != nil {
+		return oops.Wrapf(err, "failed to set stable field IDs")
+	}
+
 	return nil
 }
 
+// sameFieldIDs reports whether the columns of a and b have the same field IDs.
+func sameFieldIDs(a, b *iceberg.Schema) bool {
+	aIDs, err := iceberg.IndexByName(a)
+	if err != nil {
+		return false
+	}
+	bIDs, err := iceberg.IndexByName(b)
+	if err != nil {
+		return false
+	}
+	return maps.Equal(aIDs, bIDs)
+}
+
 // Note: iceberg-go does not support writing to tables with partition specs yet.
 func buildPartitionSpec(s *iceberg.Schema) (*iceberg.PartitionSpec, error) {
 	col, ok := s.FindFieldByName("event_day")
@@ -0,0 +1,224 @@
+package datastore
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"strconv"
+	"testing"
+
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
+	"github.com/Santiago-Labs/go-ocsf/ocsf/fieldids"
+	"github.com/Santiago-Labs/go-ocsf/ocsf/v1_5_0"
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/iceberg-go"
+	"github.com/apache/iceberg-go/catalog"
+	"github.com/apache/iceberg-go/table"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3tables"
+	"github.com/aws/aws-sdk-go-v2/service/s3tables/types"
+)
+
+// memoryCatalog keeps tables in memory. Like S3 Tables, it assigns fresh field IDs to new tables.
+type memoryCatalog struct {
+	catalog.Catalog
+	tables  map[string]*table.Table
+	commits int
+}
+
+func (c *memoryCatalog) CreateTable(ctx context.Context, ident table.Identifier, schema *iceberg.Schema, opts ...catalog.CreateTableOpt) (*table.Table, error) {
+	metadata, err := table.NewMetadata(schema, iceberg.UnpartitionedSpec, table.UnsortedSortOrder, "memory://"+ident[1], nil)
+	if err != nil {
+		return nil, err
+	}
+	tbl := table.New(ident, metadata, "", nil, nil)
+	c.tables[ident[1]] = tbl
+	return tbl, nil
+}
+
+func (c *memoryCatalog) CommitTable(ctx context.Context, tbl *table.Table, requirements []table.Requirement, updates []table.Update) (table.Metadata, string, error) {
+	for _, requirement := range requirements {
+		if err := requirement.Validate(tbl.Metadata()); err != nil {
+			return nil, "", err
+		}
+	}
+	builder, err := table.MetadataBuilderFromBase(tbl.Metadata())
+	if err != nil {
+		return nil, "", err
+	}
+	for _, update := range updates {
+		if err := update.Apply(builder); err != nil {
+			return nil, "", err
+		}
+	}
+	metadata, err := builder.Build()
+	if err != nil {
+		return nil, "", err
+	}
+	c.tables[tbl.Identifier()[1]] = table.New(tbl.Identifier(), metadata, "", nil, nil)
+	c.commits++
+	return metadata, "", nil
+}
+
+func TestCreateIcebergTable(t *testing.T) {
+	unmapped := ocsf.Class{Name: "unmapped_class", Schema: arrow.NewSchema([]arrow.Field{
+		{Name: "uid", Type: arrow.BinaryTypes.String},
+	}, nil)}
+
+	var tests = []struct {
+		name    string
+		class   ocsf.Class
+		commits int
+	}{
+		{name: "Test a class with a field ID map", class: (&v1_5_0.VulnerabilityFinding{}).OCSFClass(), commits: 1},
+		{name: "Test a class without a field ID map", class: unmapped},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cat := &memoryCatalog{tables: make(map[string]*table.Table)}
+			ident := ClassTable(tt.class)
+			if err := createIcebergTable(context.Background(), cat, tt.class, ident); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if cat.commits != tt.commits {
+				t.Errorf("got %d commits, want %d", cat.commits, tt.commits)
+			}
+
+			fieldIDs, err := fieldids.Lookup(tt.class.Name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if fieldIDs == nil {
+				return
+			}
+
+			// The table's current schema holds the mapped IDs, including those of list elements.
+			got := icebergFieldIDs(cat.tables[ident[1]].Schema())
+			for path, id := range got {
+				if fieldIDs[path] != id {
+					t.Errorf("got %s %d, want %d", path, id, fieldIDs[path])
+				}
+			}
+			if want, _ := ArrowSchemaToIcebergWithFieldIDs(tt.class.Schema, fieldIDs); !maps.Equal(got, icebergFieldIDs(want)) {
+				t.Errorf("got columns %v, want those of the class", got)
+			}
+		})
+	}
+}
+
+// namespaceTables is the ocsf_data namespace of a table bucket, which holds the tables named.
+type namespaceTables []string
+
+func (n namespaceTables) CreateNamespace(ctx context.Context, params *s3tables.CreateNamespaceInput, optFns ...func(*s3tables.Options)) (*s3tables.CreateNamespaceOutput, error) {
+	return nil, errors.New("A namespace with an identical name already exists in the bucket")
+}
+
+func (n namespaceTables) ListTables(ctx context.Context, params *s3tables.ListTablesInput, optFns ...func(*s3tables.Options)) (*s3tables.ListTablesOutput, error) {
+	output := &s3tables.ListTablesOutput{}
+	for _, name := range n {
+		output.Tables = append(output.Tables, types.TableSummary{Name: aws.String(name)})
+	}
+	return output, nil
+}
+
+func TestSetupExistingTable(t *testing.T) {
+	ctx := context.Background()
+	class := (&v1_5_0.VulnerabilityFinding{}).OCSFClass()
+	ident := ClassTable(class)
+
+	fieldIDs, err := fieldids.Lookup(class.Name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stable, err := ArrowSchemaToIcebergWithFieldIDs(class.Schema, fieldIDs)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The table was created before the class had a field ID map, with the IDs the catalog assigned.
+	schema, err := ArrowSchemaToIceberg(class.Schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cat := &memoryCatalog{tables: make(map[string]*table.Table)}
+	existing, err := cat.CreateTable(ctx, ident, schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assigned := icebergFieldIDs(existing.Schema())
+	if maps.Equal(assigned, icebergFieldIDs(stable)) {
+		t.Fatal("got the stable field IDs assigned to the existing table")
+	}
+
+	if err := setup(ctx, namespaceTables{ident[1]}, cat, "arn", ident, class); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cat.commits != 0 || cat.tables[ident[1]] != existing {
+		t.Fatalf("got the existing table changed by %d commits", cat.commits)
+	}
+	if !maps.Equal(icebergFieldIDs(cat.tables[ident[1]].Schema()), assigned) {
+		t.Errorf("got the columns of the existing table renumbered")
+	}
+
+	// A table which does not exist yet is created with the stable field IDs.
+	cat = &memoryCatalog{tables: make(map[string]*table.Table)}
+	if err := setup(ctx, namespaceTables{"other"}, cat, "arn", ident, class); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !maps.Equal(icebergFieldIDs(cat.tables[ident[1]].Schema()), icebergFieldIDs(stable)) {
+		t.Errorf("got a new table without the stable field IDs")
+	}
+}
+
+// tableBuckets lists table buckets one page at a time.
+type tableBuckets [][]types.TableBucketSummary
+
+func (b tableBuckets) ListTableBuckets(ctx context.Context, params *s3tables.ListTableBucketsInput, optFns ...func(*s3tables.Options)) (*s3tables.ListTableBucketsOutput, error) {
+	page := 0
+	if params.ContinuationToken != nil {
+		page, _ = strconv.Atoi(*params.ContinuationToken)
+	}
+	output := &s3tables.ListTableBucketsOutput{TableBuckets: b[page]}
+	if page+1 < len(b) {
+		output.ContinuationToken = aws.String(strconv.Itoa(page + 1))
+	}
+	return output, nil
+}
+
+func TestFindTableBucket(t *testing.T) {
+	const (
+		ocsfArn  = "arn:aws:s3tables:us-west-2:123456789012:bucket/ocsf"
+		otherArn = "arn:aws:s3tables:us-east-1:123456789012:bucket/other"
+	)
+	buckets := tableBuckets{
+		{{Name: aws.String("other"), Arn: aws.String(otherArn)}},
+		{{Name: aws.String("ocsf"), Arn: aws.String(ocsfArn)}},
+	}
+
+	var tests = []struct {
+		name    string
+		bucket  string
+		want    string
+		wantErr bool
+	}{
+		{name: "Test a bucket ARN", bucket: ocsfArn, want: ocsfArn},
+		{name: "Test a bucket name", bucket: "ocsf", want: ocsfArn},
+		{name: "Test a bucket on the first page", bucket: otherArn, want: otherArn},
+		{name: "Test an unknown bucket ARN", bucket: "arn:aws:s3tables:us-west-2:123456789012:bucket/missing", wantErr: true},
+		{name: "Test a bucket ARN of another account", bucket: "arn:aws:s3tables:us-west-2:210987654321:bucket/ocsf", wantErr: true},
+		{name: "Test an unknown bucket name", bucket: "missing", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := findTableBucket(context.Background(), buckets, tt.bucket)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got error %v, want error %t", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
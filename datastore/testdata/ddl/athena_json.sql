@@ -0,0 +1,15 @@
+CREATE EXTERNAL TABLE IF NOT EXISTS `ocsf_data`.`test_finding_v1_5_0` (
+  `activity_id` int COMMENT 'Activity ID: The activity. (required) Values: 1: Create; 2: Update.',
+  `time` bigint COMMENT 'Event Time: The event\'s time. (required)',
+  `count` bigint,
+  `score` double,
+  `is_alert` boolean,
+  `first_seen` int,
+  `labels` array<string>,
+  `finding_info` struct<`uid`:string,`types`:array<string>> COMMENT 'Finding Information: The finding. (required)',
+  `resources` array<struct<`name`:string>>,
+  `unmapped` string COMMENT 'Unmapped Data: Attributes that don\'t map to the schema. (optional)'
+)
+ROW FORMAT SERDE 'org.openx.data.jsonserde.JsonSerDe'
+STORED AS TEXTFILE
+LOCATION 's3://my-lake/data/v1_5_0/test_finding/';
@@ -0,0 +1,24 @@
+CREATE EXTERNAL TABLE IF NOT EXISTS `ocsf_data`.`test_finding_v1_5_0` (
+  `activity_id` int COMMENT 'Activity ID: The activity. (required) Values: 1: Create; 2: Update.',
+  `time` timestamp COMMENT 'Event Time: The event\'s time. (required)',
+  `count` bigint,
+  `score` double,
+  `is_alert` boolean,
+  `first_seen` int,
+  `labels` array<string>,
+  `finding_info` struct<`uid`:string,`types`:array<string>> COMMENT 'Finding Information: The finding. (required)',
+  `resources` array<struct<`name`:string>>,
+  `unmapped` string COMMENT 'Unmapped Data: Attributes that don\'t map to the schema. (optional)'
+)
+PARTITIONED BY (`dt` string)
+STORED AS PARQUET
+LOCATION 's3://my-lake/data/v1_5_0/test_finding/'
+TBLPROPERTIES (
+  'projection.enabled'='true',
+  'projection.dt.type'='date',
+  'projection.dt.format'='yyyy-MM-dd',
+  'projection.dt.range'='2020-01-01,NOW',
+  'projection.dt.interval'='1',
+  'projection.dt.interval.unit'='DAYS',
+  'storage.location.template'='s3://my-lake/data/v1_5_0/test_finding/dt=${dt}/'
+);
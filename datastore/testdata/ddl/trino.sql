@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS "ocsf_data"."test_finding_v1_5_0" (
+  "activity_id" integer NOT NULL COMMENT 'Activity ID: The activity. (required) Values: 1: Create; 2: Update.',
+  "time" timestamp(6) NOT NULL COMMENT 'Event Time: The event''s time. (required)',
+  "count" bigint,
+  "score" double,
+  "is_alert" boolean,
+  "first_seen" date,
+  "labels" array(varchar),
+  "finding_info" row("uid" varchar, "types" array(varchar)) NOT NULL COMMENT 'Finding Information: The finding. (required)',
+  "resources" array(row("name" varchar)),
+  "unmapped" varchar COMMENT 'Unmapped Data: Attributes that don''t map to the schema. (optional)'
+)
+WITH (format = 'PARQUET', partitioning = ARRAY['day(time)']);
@@ -3,17 +3,69 @@ package datastore
 import (
 	"context"
 	"errors"
+	"fmt"
 	"path/filepath"
+	"reflect"
+	"sync"
+
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
+	"github.com/apache/iceberg-go/table"
 )
 
 var Basepath = "data"
 
-var (
-	basepaths = map[string]string{
-		"VulnerabilityFinding": filepath.Join(Basepath, "vulnerability_finding"),
-		"APIActivity":          filepath.Join(Basepath, "api_activity"),
+// defaultPackage is the generated package whose classes are stored at the top level of the data
+// directory and table namespace, where they were stored before OCSF versions could be selected.
+const defaultPackage = "v1_4_0"
+
+// registeredClasses holds the classes of the types which do not implement ocsf.Event, by type.
+var registeredClasses sync.Map
+
+func init() {
+	// The classes of the ocsf package predate the generated packages, and are stored where they
+	// were stored before OCSF versions could be selected.
+	RegisterClass[ocsf.VulnerabilityFinding](ocsf.Class{Name: "vulnerability_finding", Package: defaultPackage, Version: "1.4.0", Schema: ocsf.VulnerabilityFindingSchema})
+	RegisterClass[ocsf.APIActivity](ocsf.Class{Name: "api_activity", Package: defaultPackage, Version: "1.4.0", Schema: ocsf.APIActivitySchema})
+}
+
+// RegisterClass sets the OCSF class stored by the datastores of T, for types which are not
+// generated classes and so do not implement ocsf.Event. It must be called before the datastores
+// of T are created.
+func RegisterClass[T any](class ocsf.Class) {
+	registeredClasses.Store(reflect.TypeFor[T](), class)
+}
+
+// classOf returns the OCSF class stored by a datastore of T, which must be a generated class or
+// registered with RegisterClass.
+func classOf[T any]() (ocsf.Class, error) {
+	if class, ok := registeredClasses.Load(reflect.TypeFor[T]()); ok {
+		return class.(ocsf.Class), nil
 	}
-)
+	event, ok := any(new(T)).(ocsf.Event)
+	if !ok {
+		return ocsf.Class{}, fmt.Errorf("%T is not a generated OCSF class, register it with RegisterClass", *new(T))
+	}
+	return event.OCSFClass(), nil
+}
+
+// classBasepath returns the directory or key prefix a class is stored under, e.g.
+// data/v1_5_0/vulnerability_finding.
+func classBasepath(class ocsf.Class) string {
+	if class.Package == defaultPackage {
+		return filepath.Join(Basepath, class.Name)
+	}
+	return filepath.Join(Basepath, class.Package, class.Name)
+}
+
+// ClassTable returns the Iceberg table a class is stored in, e.g.
+// ocsf_data.vulnerability_finding_v1_5_0.
+func ClassTable(class ocsf.Class) table.Identifier {
+	name := class.Name
+	if class.Package != defaultPackage {
+		name += "_" + class.Package
+	}
+	return table.Identifier([]string{"ocsf_data", name})
+}
 
 var ErrNotFound = errors.New("not found")
 
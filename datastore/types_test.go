@@ -0,0 +1,52 @@
+package datastore
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
+	"github.com/Santiago-Labs/go-ocsf/ocsf/v1_5_0"
+)
+
+type registeredEvent struct {
+	Uid string
+}
+
+func TestClassOf(t *testing.T) {
+	RegisterClass[registeredEvent](ocsf.Class{Name: "registered_event", Package: "v1_5_0"})
+
+	var tests = []struct {
+		name     string
+		classOf  func() (ocsf.Class, error)
+		basepath string
+		table    string
+		err      bool
+	}{
+		{name: "Test a generated class", classOf: classOf[v1_5_0.VulnerabilityFinding], basepath: filepath.Join(Basepath, "v1_5_0", "vulnerability_finding"), table: "vulnerability_finding_v1_5_0"},
+		{name: "Test a legacy finding", classOf: classOf[ocsf.VulnerabilityFinding], basepath: filepath.Join(Basepath, "vulnerability_finding"), table: "vulnerability_finding"},
+		{name: "Test a legacy activity", classOf: classOf[ocsf.APIActivity], basepath: filepath.Join(Basepath, "api_activity"), table: "api_activity"},
+		{name: "Test a registered type", classOf: classOf[registeredEvent], basepath: filepath.Join(Basepath, "v1_5_0", "registered_event"), table: "registered_event_v1_5_0"},
+		{name: "Test an unregistered type", classOf: classOf[testEvent], err: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			class, err := tt.classOf()
+			if tt.err {
+				if err == nil {
+					t.Errorf("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := classBasepath(class); got != tt.basepath {
+				t.Errorf("got basepath %s, want %s", got, tt.basepath)
+			}
+			if got := ClassTable(class)[1]; got != tt.table {
+				t.Errorf("got table %s, want %s", got, tt.table)
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package datastore
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
+)
+
+// validatingDatastore wraps a Datastore and drops items which fail OCSF schema validation before
+// they are written. T must implement ocsf.Validator, which SetupStorage checks with validates.
+type validatingDatastore[T any] struct {
+	Datastore[T]
+}
+
+// validates reports whether the items of a datastore of T can be validated.
+func validates[T any]() bool {
+	_, ok := any(new(T)).(ocsf.Validator)
+	return ok
+}
+
+// Save validates every item and saves the valid ones to the wrapped datastore.
+func (d *validatingDatastore[T]) Save(ctx context.Context, items []T) error {
+	valid := validItems(items)
+	if len(valid) == 0 {
+		return nil
+	}
+
+	return d.Datastore.Save(ctx, valid)
+}
+
+// WriteBatch validates every item and writes the valid ones to the wrapped datastore.
+func (d *validatingDatastore[T]) WriteBatch(ctx context.Context, items []T) error {
+	valid := validItems(items)
+	if len(valid) == 0 {
+		return nil
+	}
+
+	return d.Datastore.WriteBatch(ctx, valid)
+}
+
+// validItems returns the items which pass validation, logging the ones which do not.
+func validItems[T any](items []T) []T {
+	valid := make([]T, 0, len(items))
+	for i := range items {
+		if err := any(&items[i]).(ocsf.Validator).Validate(); err != nil {
+			slog.Warn("rejected invalid OCSF event", "error", err)
+			continue
+		}
+		valid = append(valid, items[i])
+	}
+
+	if len(valid) < len(items) {
+		slog.Warn("rejected invalid OCSF events", "rejected", len(items)-len(valid), "items", len(items))
+	}
+
+	return valid
+}
@@ -0,0 +1,81 @@
+package datastore
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Santiago-Labs/go-ocsf/ocsf/v1_5_0"
+)
+
+type testEvent struct {
+	Uid string
+}
+
+func (e *testEvent) Validate() error {
+	if e.Uid == "" {
+		return errors.New("uid: required attribute is not set")
+	}
+	return nil
+}
+
+type recordingDatastore[T any] struct {
+	saved []T
+}
+
+func (d *recordingDatastore[T]) Save(ctx context.Context, items []T) error {
+	d.saved = append(d.saved, items...)
+	return nil
+}
+
+func (d *recordingDatastore[T]) WriteBatch(ctx context.Context, items []T) error {
+	return d.Save(ctx, items)
+}
+
+func TestValidatingDatastore(t *testing.T) {
+	store := &recordingDatastore[testEvent]{}
+	validating := &validatingDatastore[testEvent]{Datastore: store}
+
+	err := validating.Save(context.Background(), []testEvent{{Uid: "a"}, {}, {Uid: "b"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(store.saved) != 2 || store.saved[0].Uid != "a" || store.saved[1].Uid != "b" {
+		t.Errorf("got saved %v, want a and b", store.saved)
+	}
+
+	if err := validating.Save(context.Background(), []testEvent{{}}); err != nil || len(store.saved) != 2 {
+		t.Errorf("got %v and %d saved, want an invalid batch dropped", err, len(store.saved))
+	}
+
+	if err := validating.WriteBatch(context.Background(), []testEvent{{}, {Uid: "c"}}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(store.saved) != 3 || store.saved[2].Uid != "c" {
+		t.Errorf("got saved %v, want c written by WriteBatch", store.saved)
+	}
+}
+
+func TestSetupStorageRejectInvalid(t *testing.T) {
+	type unvalidated struct{}
+
+	_, err := SetupStorage[unvalidated](context.Background(), StorageOpts{IsJSON: true, RejectInvalid: true})
+	if err == nil {
+		t.Errorf("expected an error for a type without validation")
+	}
+
+	basepath := Basepath
+	Basepath = t.TempDir()
+	defer func() { Basepath = basepath }()
+
+	storage, err := SetupStorage[v1_5_0.VulnerabilityFinding](context.Background(), StorageOpts{IsJSON: true, RejectInvalid: true})
+	if err != nil {
+		t.Fatalf("unexpected error for a generated class: %v", err)
+	}
+	if _, ok := storage.(*validatingDatastore[v1_5_0.VulnerabilityFinding]); !ok {
+		t.Errorf("got %T, want a validating datastore", storage)
+	}
+	if err := storage.WriteBatch(context.Background(), []v1_5_0.VulnerabilityFinding{{}}); err != nil {
+		t.Errorf("unexpected error writing an invalid finding: %v", err)
+	}
+}
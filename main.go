@@ -2,176 +2,319 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"flag"
 	"fmt"
 	"log"
+	"log/slog"
 	"os"
+	"strings"
+	"time"
 
-	"github.com/Santiago-Labs/go-ocsf/clients/snyk"
-	"github.com/Santiago-Labs/go-ocsf/clients/tenable"
 	"github.com/Santiago-Labs/go-ocsf/datastore"
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
+	"github.com/Santiago-Labs/go-ocsf/ocsf/convert"
+	"github.com/Santiago-Labs/go-ocsf/ocsf/v1_4_0"
+	"github.com/Santiago-Labs/go-ocsf/ocsf/v1_5_0"
 	"github.com/Santiago-Labs/go-ocsf/syncers"
-	"github.com/Santiago-Labs/go-ocsf/syncers/cloudtrail"
-	"github.com/Santiago-Labs/go-ocsf/syncers/gcpauditlog"
-
-	"github.com/aws/aws-sdk-go-v2/aws"
-	"github.com/aws/aws-sdk-go-v2/config"
-	"github.com/aws/aws-sdk-go-v2/service/inspector2"
-	"github.com/aws/aws-sdk-go-v2/service/s3"
-	"github.com/aws/aws-sdk-go-v2/service/securityhub"
+	"github.com/Santiago-Labs/go-ocsf/syncers/pipeline"
+
+	// Syncers in their own packages register themselves when imported.
+	_ "github.com/Santiago-Labs/go-ocsf/syncers/cloudtrail"
+	_ "github.com/Santiago-Labs/go-ocsf/syncers/gcpauditlog"
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "convert" {
+		if err := convertDataset(os.Args[2:]); err != nil {
+			log.Fatalf("Failed to convert dataset: %v", err)
+		}
+		return
+	}
+	if len(os.Args) > 1 && os.Args[1] == "diff" {
+		if err := diffEvents(os.Args[2:]); err != nil {
+			log.Fatalf("Failed to diff events: %v", err)
+		}
+		return
+	}
+	if len(os.Args) > 1 && os.Args[1] == "syncers" {
+		listSyncers(os.Args[2:])
+		return
+	}
+	if len(os.Args) > 1 && os.Args[1] == "run" {
+		if err := runPipeline(os.Args[2:]); err != nil {
+			log.Fatalf("Failed to run pipeline: %v", err)
+		}
+		return
+	}
+	if len(os.Args) > 1 && os.Args[1] == "ddl" {
+		if err := generateDDL(os.Args[2:]); err != nil {
+			log.Fatalf("Failed to generate DDL: %v", err)
+		}
+		return
+	}
+
 	isParquet := flag.Bool("parquet", false, "Use parquet format")
 	isJSON := flag.Bool("json", false, "Use JSON format")
 	bucketName := flag.String("bucket-name", "", "S3 bucket name")
-	tableBucketName := flag.String("table-bucket-name", "", "Table bucket name")
+	tableBucketName := flag.String("table-bucket-name", "", "Table bucket name or ARN")
+	rejectInvalid := flag.Bool("reject-invalid", false, "Drop events that fail OCSF schema validation")
+	ocsfVersion := flag.String("ocsf-version", syncers.OCSFVersion1_4_0, "OCSF version to store events as (1.4.0 or 1.5.0)")
+	partitioning := flag.String("partitioning", datastore.PartitioningNone, "Partitioning of local and S3 files (none, or day for dt=YYYY-MM-DD directories of the event time)")
 	// Sync data.
-	syncSnykOption := flag.Bool("sync-snyk", false, "Sync Snyk data.")
-	syncTenableOption := flag.Bool("sync-tenable", false, "Sync Tenable data.")
-	syncSecurityHubOption := flag.Bool("sync-security-hub", false, "Sync SecurityHub data.")
-	syncInspectorOption := flag.Bool("sync-inspector", false, "Sync Inspector data.")
-	syncGCPAuditLogOption := flag.Bool("sync-gcp-audit-log", false, "Sync GCP AuditLog data.")
-	syncCloudTrailOption := flag.Bool("sync-cloudtrail", false, "Sync CloudTrail data.")
-	cloudtrailBucketName := flag.String("cloudtrail-bucket-name", "", "CloudTrail bucket name")
+	enabled := make(map[string]*bool)
+	configs := make(map[string]syncers.Config)
+	for _, p := range syncers.Plugins() {
+		cfg := p.NewConfig()
+		if err := syncers.LoadEnv(cfg); err != nil {
+			log.Fatalf("Invalid %s configuration: %v", p.Name, err)
+		}
+		enabled[p.Name] = flag.Bool("sync-"+p.Name, false, fmt.Sprintf("Sync %s data.", p.Source))
+		syncers.BindFlags(flag.CommandLine, p.Name, cfg)
+		configs[p.Name] = cfg
+	}
+	// Time window of event syncers (CloudTrail, GCP AuditLog and Salesforce).
+	since := flag.String("since", "", "Sync events since this time: RFC 3339, a date or a duration before now such as 30d. Defaults to the last checkpoint")
+	until := flag.String("until", "", "Sync events until this time: RFC 3339, a date or a duration before now. Defaults to now")
+	backfill := flag.String("backfill", "", "Sync the time window in chunks of this duration, e.g. 7d, checkpointing after each one")
 	flag.Parse()
 
 	ctx := context.Background()
 
-	snykAPIKey := os.Getenv("SNYK_API_KEY")
-	snykOrganizationID := os.Getenv("SNYK_ORGANIZATION_ID")
-
-	tenableAPIKey := os.Getenv("TENABLE_API_KEY")
-	tenableSecretKey := os.Getenv("TENABLE_SECRET_KEY")
-
-	cfg, err := config.LoadDefaultConfig(ctx)
-	if err != nil {
-		log.Fatalf("Failed to load AWS config: %v", err)
-	}
-
 	storageOpts := datastore.StorageOpts{
 		IsParquet:      *isParquet,
 		IsJSON:         *isJSON,
 		BucketName:     *bucketName,
 		TableBucketArn: *tableBucketName,
+		RejectInvalid:  *rejectInvalid,
+		OCSFVersion:    *ocsfVersion,
+		Partitioning:   *partitioning,
 	}
 
-	if *syncSnykOption {
-		if snykAPIKey == "" || snykOrganizationID == "" {
-			log.Fatal("SNYK_API_KEY and SNYK_ORGANIZATION_ID must be set when --sync-snyk is set")
-		}
-
-		if err := syncSnyk(ctx, snykAPIKey, snykOrganizationID, storageOpts); err != nil {
-			log.Fatalf("Failed to sync Snyk data: %v", err)
-		}
+	window, err := syncers.ParseTimeWindow(*since, *until, *backfill, time.Now().UTC())
+	if err != nil {
+		log.Fatalf("Invalid time window: %v", err)
 	}
 
-	if *syncTenableOption {
-		if tenableAPIKey == "" || tenableSecretKey == "" {
-			log.Fatal("TENABLE_API_KEY and TENABLE_SECRET_KEY must be set when --sync-tenable is set")
-		}
-
-		if err := syncTenable(ctx, tenableAPIKey, tenableSecretKey, storageOpts); err != nil {
-			log.Fatalf("Failed to sync Tenable data: %v", err)
+	opts := syncers.Options{Storage: storageOpts, Window: window}
+	for _, p := range syncers.Plugins() {
+		if !*enabled[p.Name] {
+			continue
 		}
-	}
-
-	if *syncSecurityHubOption {
-		if err := syncSecurityHub(ctx, storageOpts, cfg); err != nil {
-			log.Fatalf("Failed to sync SecurityHub data: %v", err)
+		if err := runSyncer(ctx, p, configs[p.Name], opts); err != nil {
+			log.Fatalf("Failed to sync %s data: %v", p.Source, err)
 		}
 	}
+}
 
-	if *syncGCPAuditLogOption {
-		if err := syncGCPAuditLog(ctx, storageOpts); err != nil {
-			log.Fatalf("Failed to sync GCPAuditLog data: %v", err)
-		}
+// runSyncer validates the config of a registered syncer, creates it and syncs once.
+func runSyncer(ctx context.Context, p syncers.Plugin, cfg syncers.Config, opts syncers.Options) error {
+	if err := cfg.Validate(); err != nil {
+		return fmt.Errorf("invalid configuration: %w", err)
 	}
 
-	if *syncInspectorOption {
-		if err := inspectorSync(ctx, storageOpts, cfg); err != nil {
-			log.Fatalf("Failed to sync Inspector data: %v", err)
-		}
+	syncer, err := p.New(ctx, cfg, opts)
+	if err != nil {
+		return fmt.Errorf("failed to create %s syncer: %v", p.Name, err)
 	}
 
-	if *syncCloudTrailOption {
-		if err := syncCloudTrail(ctx, *cloudtrailBucketName, storageOpts, cfg); err != nil {
-			log.Fatalf("Failed to sync CloudTrail data: %v", err)
-		}
-	}
+	return syncer.Sync(ctx)
 }
 
-func syncSnyk(ctx context.Context, apiKey, orgID string, storageOpts datastore.StorageOpts) error {
-	snykClient, err := snyk.NewClient(apiKey, orgID)
-	if err != nil {
-		return fmt.Errorf("failed to create Snyk client: %v", err)
+// runPipeline validates a pipeline file and runs its sources, e.g.
+//
+//	go-ocsf run --config pipeline.yaml
+func runPipeline(args []string) error {
+	flags := flag.NewFlagSet("run", flag.ExitOnError)
+	configPath := flags.String("config", "", "Path of the YAML or JSON pipeline file")
+	check := flags.Bool("check", false, "Validate the pipeline without running it")
+	flags.Parse(args)
+
+	if *configPath == "" {
+		return fmt.Errorf("--config must be set")
 	}
 
-	snykSyncer, err := syncers.NewSnykOCSFSyncer(ctx, snykClient, storageOpts)
+	p, err := pipeline.Load(*configPath)
 	if err != nil {
-		return fmt.Errorf("failed to create Snyk syncer: %v", err)
+		return err
 	}
-
-	err = snykSyncer.Sync(ctx)
-	if err != nil {
-		log.Fatalf("Failed to sync Snyk data: %v", err)
+	if *check {
+		slog.Info("pipeline is valid", "sources", len(p.Sources), "sinks", len(p.Sinks))
+		return nil
 	}
 
-	return snykSyncer.Sync(ctx)
+	return p.Run(context.Background())
 }
 
-func inspectorSync(ctx context.Context, storageOpts datastore.StorageOpts, cfg aws.Config) error {
-	inspectorClient := inspector2.NewFromConfig(cfg)
-
-	inspectorSyncer, err := syncers.NewInspectorOCSFSyncer(ctx, inspectorClient, storageOpts)
-	if err != nil {
-		return fmt.Errorf("failed to create Inspector syncer: %v", err)
+// listSyncers prints the registered syncers, the OCSF classes they store and their settings.
+//
+//	go-ocsf syncers --ocsf-version 1.5.0
+func listSyncers(args []string) {
+	flags := flag.NewFlagSet("syncers", flag.ExitOnError)
+	ocsfVersion := flags.String("ocsf-version", syncers.OCSFVersion1_4_0, "OCSF version of the classes to list (1.4.0 or 1.5.0)")
+	flags.Parse(args)
+
+	for _, p := range syncers.Plugins() {
+		var classes []string
+		for _, class := range p.Classes(*ocsfVersion) {
+			classes = append(classes, class.Name+"@"+class.Version)
+		}
+		fmt.Printf("%s\t--sync-%s\t%s\n", p.Name, p.Name, strings.Join(classes, ","))
+
+		for _, setting := range syncers.Settings(p.NewConfig()) {
+			var bindings []string
+			if setting.Key != "" {
+				bindings = append(bindings, setting.Key+":")
+			}
+			if setting.Env != "" {
+				bindings = append(bindings, "$"+setting.Env)
+			}
+			if setting.Flag != "" {
+				bindings = append(bindings, "--"+p.Name+"-"+setting.Flag)
+			}
+			fmt.Printf("\t%s\t%s\n", strings.Join(bindings, " "), setting.Usage)
+		}
 	}
-
-	return inspectorSyncer.Sync(ctx)
 }
 
-func syncTenable(ctx context.Context, apiKey, secretKey string, storageOpts datastore.StorageOpts) error {
-	tenableClient, err := tenable.NewClient(apiKey, secretKey)
-	if err != nil {
-		return fmt.Errorf("failed to create Tenable client: %v", err)
+// convertDataset rewrites a local dataset of one OCSF class into another OCSF version, e.g.
+//
+//	go-ocsf convert --class vulnerability_finding --from 1.4.0 --to 1.5.0 --input data/vulnerability_finding --output data/v1_5_0/vulnerability_finding
+func convertDataset(args []string) error {
+	flags := flag.NewFlagSet("convert", flag.ExitOnError)
+	class := flags.String("class", "", "OCSF class name of the dataset, e.g. vulnerability_finding")
+	from := flags.String("from", convert.LegacyVersion, "OCSF version of the dataset, or \"legacy\" for data written by the ocsf package")
+	to := flags.String("to", "", "OCSF version to rewrite the dataset into")
+	input := flags.String("input", "", "Directory of the dataset's JSON or Parquet files")
+	output := flags.String("output", "", "Directory to write the rewritten files to")
+	reportPath := flags.String("report", "", "Write the dropped data report as JSON to this file")
+	flags.Parse(args)
+
+	if *class == "" || *to == "" || *input == "" || *output == "" {
+		return fmt.Errorf("--class, --to, --input and --output must be set")
 	}
 
-	tenableSyncer, err := syncers.NewTenableOCSFSyncer(ctx, tenableClient, storageOpts)
+	report, err := convert.RewriteDataset(*class, *from, *to, *input, *output)
 	if err != nil {
-		return fmt.Errorf("failed to create Tenable syncer: %v", err)
+		return err
 	}
 
-	return tenableSyncer.Sync(ctx)
+	slog.Info("converted dataset", "class", report.Class, "from", report.From, "to", report.To, "files", report.Files, "events", report.Events)
+	for _, path := range report.DroppedPaths() {
+		slog.Warn("dropped attribute", "path", path, "events", report.Dropped[path])
+	}
+
+	if *reportPath != "" {
+		data, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal report: %v", err)
+		}
+		if err := os.WriteFile(*reportPath, data, 0644); err != nil {
+			return fmt.Errorf("failed to write report: %v", err)
+		}
+	}
+
+	return nil
 }
 
-func syncSecurityHub(ctx context.Context, storageOpts datastore.StorageOpts, cfg aws.Config) error {
-	securityHubClient := securityhub.NewFromConfig(cfg)
+// generateDDL prints the warehouse DDL of the tables of one or all classes of an OCSF version, e.g.
+//
+//	go-ocsf ddl --dialect athena --version 1.5.0 --class vulnerability_finding --bucket my-bucket
+func generateDDL(args []string) error {
+	flags := flag.NewFlagSet("ddl", flag.ExitOnError)
+	dialect := flags.String("dialect", datastore.DialectAthena, "Warehouse dialect: athena, trino or bigquery")
+	version := flags.String("version", syncers.OCSFVersion1_4_0, "OCSF version of the tables (1.4.0 or 1.5.0)")
+	class := flags.String("class", "", "OCSF class name of the table, e.g. vulnerability_finding; all classes if empty")
+	bucket := flags.String("bucket", "", "S3 bucket the events are stored in, for Athena tables")
+	format := flags.String("format", "parquet", "Format of the stored files for Athena tables (parquet or json)")
+	partitioning := flags.String("partitioning", datastore.PartitioningNone, "Partitioning of the stored files for Athena tables (none or day)")
+	flags.Parse(args)
+
+	var classes []ocsf.Class
+	switch *version {
+	case syncers.OCSFVersion1_4_0:
+		classes = v1_4_0.Classes()
+	case syncers.OCSFVersion1_5_0:
+		classes = v1_5_0.Classes()
+	default:
+		return fmt.Errorf("unsupported OCSF version %q", *version)
+	}
 
-	securityHubSyncer, err := syncers.NewSecurityHubOCSFSyncer(ctx, securityHubClient, storageOpts)
-	if err != nil {
-		return fmt.Errorf("failed to create SecurityHub syncer: %v", err)
+	var found bool
+	for _, c := range classes {
+		if *class != "" && c.Name != *class {
+			continue
+		}
+		found = true
+
+		ddl, err := datastore.GenerateDDL(c, *dialect, datastore.DDLOpts{Bucket: *bucket, Format: *format, Partitioning: *partitioning})
+		if err != nil {
+			return fmt.Errorf("failed to generate DDL for %s: %v", c.Name, err)
+		}
+		fmt.Println(ddl)
+	}
+	if !found {
+		return fmt.Errorf("OCSF %s has no class %q", *version, *class)
 	}
 
-	return securityHubSyncer.Sync(ctx)
+	return nil
 }
 
-func syncGCPAuditLog(ctx context.Context, storageOpts datastore.StorageOpts) error {
-	gcpauditlogSyncer, err := gcpauditlog.NewGCPAuditLogSyncer(ctx, os.Getenv("GCP_PROJECT_ID"), storageOpts)
-	if err != nil {
-		return fmt.Errorf("failed to create GCPAuditLog syncer: %v", err)
+// diffEvents prints the attributes which changed between two OCSF JSON events, e.g.
+//
+//	go-ocsf diff --ignore-volatile old.json new.json
+func diffEvents(args []string) error {
+	flags := flag.NewFlagSet("diff", flag.ExitOnError)
+	ignoreVolatile := flags.Bool("ignore-volatile", false, "Ignore attributes which change on every sync, such as time")
+	ignore := flags.String("ignore", "", "Comma-separated attribute paths to ignore, e.g. vulnerabilities[].last_seen_time")
+	asJSON := flags.Bool("json", false, "Print the changes as JSON")
+	flags.Parse(args)
+
+	if flags.NArg() != 2 {
+		return fmt.Errorf("diff takes the files of the old and the new event")
 	}
 
-	return gcpauditlogSyncer.Sync(ctx)
-}
+	var events [2]json.RawMessage
+	var classUIDs [2]int32
+	for i, path := range flags.Args() {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("failed to read event: %v", err)
+		}
+		classUIDs[i], err = ocsf.ClassUID(data)
+		if err != nil {
+			return fmt.Errorf("failed to decode %s: %v", path, err)
+		}
+		events[i] = data
+	}
+	if classUIDs[0] != classUIDs[1] {
+		return fmt.Errorf("cannot diff an event of class_uid %d with one of class_uid %d", classUIDs[0], classUIDs[1])
+	}
 
-func syncCloudTrail(ctx context.Context, bucketName string, storageOpts datastore.StorageOpts, cfg aws.Config) error {
-	s3Client := s3.NewFromConfig(cfg)
+	var ignored []string
+	if *ignoreVolatile {
+		ignored = append(ignored, ocsf.VolatilePaths...)
+	}
+	if *ignore != "" {
+		ignored = append(ignored, strings.Split(*ignore, ",")...)
+	}
 
-	cloudtrailSyncer, err := cloudtrail.NewSyncer(ctx, s3Client, bucketName, storageOpts)
+	changes, err := ocsf.Diff(events[0], events[1], ignored...)
 	if err != nil {
-		return fmt.Errorf("failed to create CloudTrail syncer: %v", err)
+		return err
+	}
+
+	if *asJSON {
+		data, err := json.MarshalIndent(changes, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal changes: %v", err)
+		}
+		fmt.Println(string(data))
+		return nil
+	}
+	for _, change := range changes {
+		fmt.Println(change)
 	}
 
-	return cloudtrailSyncer.Sync(ctx)
+	return nil
 }
@@ -0,0 +1,58 @@
+package ocsf
+
+import (
+	"github.com/apache/arrow-go/v18/arrow"
+)
+
+// Arrow field metadata keys describing the OCSF attribute of a generated field.
+const (
+	MetadataCaption     = "ocsf.caption"
+	MetadataDescription = "ocsf.description"
+	MetadataRequirement = "ocsf.requirement"
+	// MetadataEnum lists the enum values of the attribute, e.g. "0: Unknown; 1: Create".
+	MetadataEnum = "ocsf.enum"
+)
+
+// AttributeMetadata returns the Arrow field metadata of an OCSF attribute. Empty values are left out.
+func AttributeMetadata(caption, description, requirement, enum string) arrow.Metadata {
+	var keys, values []string
+	for _, kv := range [][2]string{
+		{MetadataCaption, caption},
+		{MetadataDescription, description},
+		{MetadataRequirement, requirement},
+		{MetadataEnum, enum},
+	} {
+		if kv[1] == "" {
+			continue
+		}
+		keys = append(keys, kv[0])
+		values = append(values, kv[1])
+	}
+	return arrow.NewMetadata(keys, values)
+}
+
+// AttributeDoc describes an OCSF attribute from its Arrow field metadata, for use as a column
+// comment. It returns an empty string for fields without OCSF metadata.
+func AttributeDoc(md arrow.Metadata) string {
+	value := func(key string) string {
+		if i := md.FindKey(key); i >= 0 {
+			return md.Values()[i]
+		}
+		return ""
+	}
+
+	doc := value(MetadataCaption)
+	if description := value(MetadataDescription); description != "" {
+		if doc != "" {
+			doc += ": "
+		}
+		doc += description
+	}
+	if requirement := value(MetadataRequirement); requirement != "" && doc != "" {
+		doc += " (" + requirement + ")"
+	}
+	if enum := value(MetadataEnum); enum != "" {
+		doc += " Values: " + enum + "."
+	}
+	return doc
+}
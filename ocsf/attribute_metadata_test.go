@@ -0,0 +1,32 @@
+package ocsf
+
+import "testing"
+
+func TestAttributeDoc(t *testing.T) {
+	var tests = []struct {
+		name        string
+		caption     string
+		description string
+		requirement string
+		enum        string
+		keys        int
+		want        string
+	}{
+		{name: "Test an attribute", caption: "Name", description: "The name.", requirement: "required", keys: 3, want: "Name: The name. (required)"},
+		{name: "Test an enum attribute", caption: "Status ID", description: "The status.", requirement: "recommended", enum: "1: New; 2: Closed", keys: 4, want: "Status ID: The status. (recommended) Values: 1: New; 2: Closed."},
+		{name: "Test an attribute without description", caption: "Name", requirement: "optional", keys: 2, want: "Name (optional)"},
+		{name: "Test an attribute without metadata", keys: 0, want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			md := AttributeMetadata(tt.caption, tt.description, tt.requirement, tt.enum)
+			if md.Len() != tt.keys {
+				t.Errorf("got %d keys, want the empty values left out", md.Len())
+			}
+			if got := AttributeDoc(md); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,410 @@
+// Package convert converts OCSF events between the model packages of different OCSF versions: the
+// legacy hand-written ocsf package and the generated v1_4_0 and v1_5_0 packages.
+//
+// Events are converted through their JSON encoding. Class attributes which a newer version replaced
+// by a list are moved into it, and upgrading an event which sets a class attribute the newer version
+// removed without a replacement fails. Other attributes the target version has no place for are
+// dropped and listed in a Report. The pairs of classes which can be converted are registered by the
+// generated convert_gen.go and by legacy.go.
+package convert
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
+)
+
+// DroppedAttribute is a source attribute which has no place in the target version.
+type DroppedAttribute struct {
+	Path  string `json:"path"`
+	Value any    `json:"value"`
+}
+
+// Report lists the data lost while converting an event.
+type Report struct {
+	Class   string             `json:"class"`
+	From    string             `json:"from"`
+	To      string             `json:"to"`
+	Dropped []DroppedAttribute `json:"dropped,omitempty"`
+}
+
+// conversion converts a class from one version to the next older or newer one.
+type conversion struct {
+	class  string
+	from   string
+	to     string
+	target reflect.Type
+
+	rewriteFile func(inPath, outPath string, report *DatasetReport) error
+}
+
+// classVersion identifies the model of a class in one version.
+type classVersion struct {
+	class   string
+	version string
+}
+
+var (
+	conversionsByType = make(map[[2]reflect.Type]*conversion)
+	conversionsByName = make(map[string]*conversion)
+	versionsByType    = make(map[reflect.Type]classVersion)
+)
+
+// register makes events of type From convertible to type To. class is the OCSF class name and from
+// and to are the OCSF versions of the two model packages, which are consecutive versions.
+func register[From, To any](class, from, to string) {
+	c := &conversion{
+		class:  class,
+		from:   from,
+		to:     to,
+		target: reflect.TypeFor[To](),
+	}
+	c.rewriteFile = func(inPath, outPath string, report *DatasetReport) error {
+		return rewriteFile[From, To](c, inPath, outPath, report)
+	}
+
+	conversionsByType[typePair[From, To]()] = c
+	conversionsByName[conversionName(class, from, to)] = c
+	versionsByType[reflect.TypeFor[From]()] = classVersion{class: class, version: from}
+	versionsByType[reflect.TypeFor[To]()] = classVersion{class: class, version: to}
+}
+
+func typePair[From, To any]() [2]reflect.Type {
+	return [2]reflect.Type{reflect.TypeOf((*From)(nil)).Elem(), reflect.TypeOf((*To)(nil)).Elem()}
+}
+
+func conversionName(class, from, to string) string {
+	return class + "/" + from + "/" + to
+}
+
+// Convert converts an event to the model of another OCSF version, e.g. a
+// v1_4_0.VulnerabilityFinding into a v1_5_0.VulnerabilityFinding. Events are converted through
+// every version between the two, e.g. from the legacy package to 1.5.0 through 1.4.0.
+func Convert[From, To any](event From) (To, Report, error) {
+	var converted To
+
+	steps, ok := conversionSteps(reflect.TypeFor[From](), reflect.TypeFor[To]())
+	if !ok {
+		return converted, Report{}, fmt.Errorf("no conversion from %T to %T", event, converted)
+	}
+
+	var batch []To
+	reports, err := convertEvents(steps, []From{event}, &batch)
+	if err != nil {
+		return converted, Report{}, err
+	}
+	return batch[0], reports[0], nil
+}
+
+// ConvertBatch converts events like Convert, encoding and decoding the whole batch once, which is
+// faster than converting the events one by one. The reports are in the order of the events.
+func ConvertBatch[From, To any](events []From) ([]To, []Report, error) {
+	steps, ok := conversionSteps(reflect.TypeFor[From](), reflect.TypeFor[To]())
+	if !ok {
+		return nil, nil, fmt.Errorf("no conversion from %s to %s", reflect.TypeFor[From](), reflect.TypeFor[To]())
+	}
+
+	converted := make([]To, 0, len(events))
+	reports, err := convertEvents(steps, events, &converted)
+	if err != nil {
+		return nil, nil, err
+	}
+	return converted, reports, nil
+}
+
+// conversionSteps returns the conversions between consecutive versions which lead from one model
+// of a class to another.
+func conversionSteps(from, to reflect.Type) ([]*conversion, bool) {
+	if c, ok := conversionsByType[[2]reflect.Type{from, to}]; ok {
+		return []*conversion{c}, true
+	}
+
+	source, ok := versionsByType[from]
+	if !ok {
+		return nil, false
+	}
+	target, ok := versionsByType[to]
+	if !ok || target.class != source.class || target.version == source.version {
+		return nil, false
+	}
+
+	// Versions are linked in a chain, so the steps towards the target version are found by
+	// following the conversion in its direction until it is reached.
+	var steps []*conversion
+	for version := source.version; version != target.version; {
+		next := nextStep(source.class, version, target.version)
+		if next == nil {
+			return nil, false
+		}
+		steps = append(steps, next)
+		version = next.to
+	}
+	if steps[len(steps)-1].target != to {
+		return nil, false
+	}
+	return steps, true
+}
+
+// nextStep returns the conversion of class from version towards target. Of the conversions in that
+// direction, it picks the one to the nearest version which does not pass target, so that the steps
+// do not depend on the order in which the registered conversions are iterated.
+func nextStep(class, version, target string) *conversion {
+	upgrade := isUpgrade(version, target)
+
+	var next *conversion
+	for _, c := range conversionsByName {
+		if c.class != class || c.from != version || isUpgrade(c.from, c.to) != upgrade {
+			continue
+		}
+		if c.to != target && isUpgrade(c.to, target) != upgrade {
+			continue
+		}
+		if next == nil || isUpgrade(c.to, next.to) == upgrade {
+			next = c
+		}
+	}
+	return next
+}
+
+// Conversions lists the registered conversions as class/from/to, e.g.
+// "vulnerability_finding/1.4.0/1.5.0".
+func Conversions() []string {
+	names := make([]string, 0, len(conversionsByName))
+	for name := range conversionsByName {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// convertEvents converts a slice of events through the version steps in order, applying the
+// moves and fixups of each step and dropping the attributes each version cannot hold. It fails on
+// the first event which sets a class attribute an upgraded version removed without a replacement.
+// converted points to the slice of converted events. The batch is encoded and decoded once, rather
+// than each event, and a report is returned for each event.
+func convertEvents(steps []*conversion, events any, converted any) ([]Report, error) {
+	first, last := steps[0], steps[len(steps)-1]
+
+	data, err := json.Marshal(events)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode %s events: %w", first.class, err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var batch []map[string]any
+	if err := decoder.Decode(&batch); err != nil {
+		return nil, fmt.Errorf("failed to decode %s events: %w", first.class, err)
+	}
+
+	reports := make([]Report, len(batch))
+	for i, attributes := range batch {
+		reports[i] = Report{Class: first.class, From: first.from, To: last.to}
+		for _, c := range steps {
+			if err := checkRemovals(c, attributes); err != nil {
+				return reports, fmt.Errorf("failed to convert %s event %d: %w", first.class, i, err)
+			}
+			applyMoves(c, attributes)
+			if fixup, ok := fixups[[2]string{c.from, c.to}]; ok {
+				fixup(attributes)
+			}
+
+			if metadata, ok := attributes["metadata"].(map[string]any); ok && c.to != LegacyVersion {
+				metadata["version"] = c.to
+			}
+
+			pruned, _ := prune(attributes, c.target, "", &reports[i])
+			attributes, _ = pruned.(map[string]any)
+		}
+		batch[i] = attributes
+	}
+
+	data, err = json.Marshal(batch)
+	if err != nil {
+		return reports, fmt.Errorf("failed to encode %s events: %w", first.class, err)
+	}
+	if err := json.Unmarshal(data, converted); err != nil {
+		return reports, fmt.Errorf("failed to decode %s events as %s: %w", first.class, last.target, err)
+	}
+
+	return reports, nil
+}
+
+var rawJSONType = reflect.TypeOf(ocsf.RawJSON(""))
+
+// prune removes the attributes of value which do not fit the target type and records them as
+// dropped. It returns false when value as a whole does not fit.
+func prune(value any, t reflect.Type, path string, report *Report) (any, bool) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	if value == nil {
+		return nil, true
+	}
+
+	if t == rawJSONType {
+		// The hand-written classes store JSON attributes as encoded strings.
+		if s, ok := value.(string); ok && json.Valid([]byte(s)) {
+			return json.RawMessage(s), true
+		}
+		return value, true
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		attributes, ok := value.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+
+		fields := jsonFields(t)
+		for _, name := range sortedAttributeNames(attributes) {
+			attributePath := ocsf.JoinPath(path, name)
+
+			field, ok := fields[name]
+			if !ok && attributes[name] == nil {
+				delete(attributes, name)
+				continue
+			}
+			if !ok {
+				report.Dropped = append(report.Dropped, DroppedAttribute{Path: attributePath, Value: attributes[name]})
+				delete(attributes, name)
+				continue
+			}
+
+			pruned, ok := prune(attributes[name], field.Type, attributePath, report)
+			if !ok {
+				report.Dropped = append(report.Dropped, DroppedAttribute{Path: attributePath, Value: attributes[name]})
+				delete(attributes, name)
+				continue
+			}
+			attributes[name] = pruned
+		}
+		return attributes, true
+
+	case reflect.Slice, reflect.Array:
+		items, ok := value.([]any)
+		if !ok {
+			return nil, false
+		}
+
+		kept := make([]any, 0, len(items))
+		for i, item := range items {
+			itemPath := ocsf.IndexPath(path, i)
+			pruned, ok := prune(item, t.Elem(), itemPath, report)
+			if !ok {
+				report.Dropped = append(report.Dropped, DroppedAttribute{Path: itemPath, Value: item})
+				continue
+			}
+			kept = append(kept, pruned)
+		}
+		return kept, true
+
+	case reflect.String:
+		switch v := value.(type) {
+		case string:
+			return v, true
+		case map[string]any, []any:
+			// JSON attributes are stored as encoded strings.
+			data, err := json.Marshal(v)
+			if err != nil {
+				return nil, false
+			}
+			return string(data), true
+		default:
+			return nil, false
+		}
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		number, ok := value.(json.Number)
+		if !ok {
+			return nil, false
+		}
+		if _, err := strconv.ParseInt(number.String(), 10, t.Bits()); err != nil {
+			return nil, false
+		}
+		return number, true
+
+	case reflect.Float32, reflect.Float64:
+		_, ok := value.(json.Number)
+		return value, ok
+
+	case reflect.Bool:
+		_, ok := value.(bool)
+		return value, ok
+
+	default:
+		return value, true
+	}
+}
+
+// fieldsByType caches the result of jsonFields, which prune needs for every object of every event.
+var fieldsByType sync.Map
+
+// jsonFields returns the fields of a struct type by their JSON attribute name.
+func jsonFields(t reflect.Type) map[string]reflect.StructField {
+	if fields, ok := fieldsByType.Load(t); ok {
+		return fields.(map[string]reflect.StructField)
+	}
+
+	fields := make(map[string]reflect.StructField)
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		fields[name] = field
+	}
+	fieldsByType.Store(t, fields)
+	return fields
+}
+
+func sortedAttributeNames(attributes map[string]any) []string {
+	names := make([]string, 0, len(attributes))
+	for name := range attributes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// isUpgrade reports whether converting from one version to another moves to a newer version. The
+// legacy package predates every versioned package.
+func isUpgrade(from, to string) bool {
+	if from == LegacyVersion {
+		return true
+	}
+	if to == LegacyVersion {
+		return false
+	}
+	return compareVersions(from, to) < 0
+}
+
+func compareVersions(a, b string) int {
+	aParts, bParts := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(aParts) && i < len(bParts); i++ {
+		aPart, _ := strconv.Atoi(aParts[i])
+		bPart, _ := strconv.Atoi(bParts[i])
+		if aPart != bPart {
+			return aPart - bPart
+		}
+	}
+	return len(aParts) - len(bParts)
+}
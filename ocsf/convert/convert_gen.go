@@ -0,0 +1,168 @@
+// autogenerated by scripts/model_gen.go. DO NOT EDIT
+package convert
+
+import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf/v1_4_0"
+	"github.com/Santiago-Labs/go-ocsf/ocsf/v1_5_0"
+)
+
+func init() {
+	register[v1_4_0.AccountChange, v1_5_0.AccountChange]("account_change", "1.4.0", "1.5.0")
+	register[v1_5_0.AccountChange, v1_4_0.AccountChange]("account_change", "1.5.0", "1.4.0")
+	register[v1_4_0.AdminGroupQuery, v1_5_0.AdminGroupQuery]("admin_group_query", "1.4.0", "1.5.0")
+	register[v1_5_0.AdminGroupQuery, v1_4_0.AdminGroupQuery]("admin_group_query", "1.5.0", "1.4.0")
+	register[v1_4_0.AirborneBroadcastActivity, v1_5_0.AirborneBroadcastActivity]("airborne_broadcast_activity", "1.4.0", "1.5.0")
+	register[v1_5_0.AirborneBroadcastActivity, v1_4_0.AirborneBroadcastActivity]("airborne_broadcast_activity", "1.5.0", "1.4.0")
+	register[v1_4_0.APIActivity, v1_5_0.APIActivity]("api_activity", "1.4.0", "1.5.0")
+	register[v1_5_0.APIActivity, v1_4_0.APIActivity]("api_activity", "1.5.0", "1.4.0")
+	register[v1_4_0.ApplicationError, v1_5_0.ApplicationError]("application_error", "1.4.0", "1.5.0")
+	register[v1_5_0.ApplicationError, v1_4_0.ApplicationError]("application_error", "1.5.0", "1.4.0")
+	register[v1_4_0.ApplicationLifecycle, v1_5_0.ApplicationLifecycle]("application_lifecycle", "1.4.0", "1.5.0")
+	register[v1_5_0.ApplicationLifecycle, v1_4_0.ApplicationLifecycle]("application_lifecycle", "1.5.0", "1.4.0")
+	register[v1_4_0.Authentication, v1_5_0.Authentication]("authentication", "1.4.0", "1.5.0")
+	register[v1_5_0.Authentication, v1_4_0.Authentication]("authentication", "1.5.0", "1.4.0")
+	register[v1_4_0.AuthorizeSession, v1_5_0.AuthorizeSession]("authorize_session", "1.4.0", "1.5.0")
+	register[v1_5_0.AuthorizeSession, v1_4_0.AuthorizeSession]("authorize_session", "1.5.0", "1.4.0")
+	register[v1_4_0.BaseEvent, v1_5_0.BaseEvent]("base_event", "1.4.0", "1.5.0")
+	register[v1_5_0.BaseEvent, v1_4_0.BaseEvent]("base_event", "1.5.0", "1.4.0")
+	register[v1_4_0.CloudResourcesInventoryInfo, v1_5_0.CloudResourcesInventoryInfo]("cloud_resources_inventory_info", "1.4.0", "1.5.0")
+	register[v1_5_0.CloudResourcesInventoryInfo, v1_4_0.CloudResourcesInventoryInfo]("cloud_resources_inventory_info", "1.5.0", "1.4.0")
+	register[v1_4_0.ComplianceFinding, v1_5_0.ComplianceFinding]("compliance_finding", "1.4.0", "1.5.0")
+	register[v1_5_0.ComplianceFinding, v1_4_0.ComplianceFinding]("compliance_finding", "1.5.0", "1.4.0")
+	register[v1_4_0.DeviceConfigState, v1_5_0.DeviceConfigState]("config_state", "1.4.0", "1.5.0")
+	register[v1_5_0.DeviceConfigState, v1_4_0.DeviceConfigState]("config_state", "1.5.0", "1.4.0")
+	register[v1_4_0.DataSecurityFinding, v1_5_0.DataSecurityFinding]("data_security_finding", "1.4.0", "1.5.0")
+	register[v1_5_0.DataSecurityFinding, v1_4_0.DataSecurityFinding]("data_security_finding", "1.5.0", "1.4.0")
+	register[v1_4_0.DatastoreActivity, v1_5_0.DatastoreActivity]("datastore_activity", "1.4.0", "1.5.0")
+	register[v1_5_0.DatastoreActivity, v1_4_0.DatastoreActivity]("datastore_activity", "1.5.0", "1.4.0")
+	register[v1_4_0.DetectionFinding, v1_5_0.DetectionFinding]("detection_finding", "1.4.0", "1.5.0")
+	register[v1_5_0.DetectionFinding, v1_4_0.DetectionFinding]("detection_finding", "1.5.0", "1.4.0")
+	register[v1_4_0.DeviceConfigStateChange, v1_5_0.DeviceConfigStateChange]("device_config_state_change", "1.4.0", "1.5.0")
+	register[v1_5_0.DeviceConfigStateChange, v1_4_0.DeviceConfigStateChange]("device_config_state_change", "1.5.0", "1.4.0")
+	register[v1_4_0.DHCPActivity, v1_5_0.DHCPActivity]("dhcp_activity", "1.4.0", "1.5.0")
+	register[v1_5_0.DHCPActivity, v1_4_0.DHCPActivity]("dhcp_activity", "1.5.0", "1.4.0")
+	register[v1_4_0.DNSActivity, v1_5_0.DNSActivity]("dns_activity", "1.4.0", "1.5.0")
+	register[v1_5_0.DNSActivity, v1_4_0.DNSActivity]("dns_activity", "1.5.0", "1.4.0")
+	register[v1_4_0.DroneFlightsActivity, v1_5_0.DroneFlightsActivity]("drone_flights_activity", "1.4.0", "1.5.0")
+	register[v1_5_0.DroneFlightsActivity, v1_4_0.DroneFlightsActivity]("drone_flights_activity", "1.5.0", "1.4.0")
+	register[v1_4_0.EmailActivity, v1_5_0.EmailActivity]("email_activity", "1.4.0", "1.5.0")
+	register[v1_5_0.EmailActivity, v1_4_0.EmailActivity]("email_activity", "1.5.0", "1.4.0")
+	register[v1_4_0.EmailFileActivity, v1_5_0.EmailFileActivity]("email_file_activity", "1.4.0", "1.5.0")
+	register[v1_5_0.EmailFileActivity, v1_4_0.EmailFileActivity]("email_file_activity", "1.5.0", "1.4.0")
+	register[v1_4_0.EmailURLActivity, v1_5_0.EmailURLActivity]("email_url_activity", "1.4.0", "1.5.0")
+	register[v1_5_0.EmailURLActivity, v1_4_0.EmailURLActivity]("email_url_activity", "1.5.0", "1.4.0")
+	register[v1_4_0.EntityManagement, v1_5_0.EntityManagement]("entity_management", "1.4.0", "1.5.0")
+	register[v1_5_0.EntityManagement, v1_4_0.EntityManagement]("entity_management", "1.5.0", "1.4.0")
+	register[v1_4_0.EventLogActivity, v1_5_0.EventLogActivity]("event_log_actvity", "1.4.0", "1.5.0")
+	register[v1_5_0.EventLogActivity, v1_4_0.EventLogActivity]("event_log_actvity", "1.5.0", "1.4.0")
+	register[v1_4_0.FileSystemActivity, v1_5_0.FileSystemActivity]("file_activity", "1.4.0", "1.5.0")
+	register[v1_5_0.FileSystemActivity, v1_4_0.FileSystemActivity]("file_activity", "1.5.0", "1.4.0")
+	register[v1_4_0.FileHostingActivity, v1_5_0.FileHostingActivity]("file_hosting", "1.4.0", "1.5.0")
+	register[v1_5_0.FileHostingActivity, v1_4_0.FileHostingActivity]("file_hosting", "1.5.0", "1.4.0")
+	register[v1_4_0.FileQuery, v1_5_0.FileQuery]("file_query", "1.4.0", "1.5.0")
+	register[v1_5_0.FileQuery, v1_4_0.FileQuery]("file_query", "1.5.0", "1.4.0")
+	register[v1_4_0.FileRemediationActivity, v1_5_0.FileRemediationActivity]("file_remediation_activity", "1.4.0", "1.5.0")
+	register[v1_5_0.FileRemediationActivity, v1_4_0.FileRemediationActivity]("file_remediation_activity", "1.5.0", "1.4.0")
+	register[v1_4_0.FolderQuery, v1_5_0.FolderQuery]("folder_query", "1.4.0", "1.5.0")
+	register[v1_5_0.FolderQuery, v1_4_0.FolderQuery]("folder_query", "1.5.0", "1.4.0")
+	register[v1_4_0.FTPActivity, v1_5_0.FTPActivity]("ftp_activity", "1.4.0", "1.5.0")
+	register[v1_5_0.FTPActivity, v1_4_0.FTPActivity]("ftp_activity", "1.5.0", "1.4.0")
+	register[v1_4_0.GroupManagement, v1_5_0.GroupManagement]("group_management", "1.4.0", "1.5.0")
+	register[v1_5_0.GroupManagement, v1_4_0.GroupManagement]("group_management", "1.5.0", "1.4.0")
+	register[v1_4_0.HTTPActivity, v1_5_0.HTTPActivity]("http_activity", "1.4.0", "1.5.0")
+	register[v1_5_0.HTTPActivity, v1_4_0.HTTPActivity]("http_activity", "1.5.0", "1.4.0")
+	register[v1_4_0.IncidentFinding, v1_5_0.IncidentFinding]("incident_finding", "1.4.0", "1.5.0")
+	register[v1_5_0.IncidentFinding, v1_4_0.IncidentFinding]("incident_finding", "1.5.0", "1.4.0")
+	register[v1_4_0.DeviceInventoryInfo, v1_5_0.DeviceInventoryInfo]("inventory_info", "1.4.0", "1.5.0")
+	register[v1_5_0.DeviceInventoryInfo, v1_4_0.DeviceInventoryInfo]("inventory_info", "1.5.0", "1.4.0")
+	register[v1_4_0.JobQuery, v1_5_0.JobQuery]("job_query", "1.4.0", "1.5.0")
+	register[v1_5_0.JobQuery, v1_4_0.JobQuery]("job_query", "1.5.0", "1.4.0")
+	register[v1_4_0.KernelActivity, v1_5_0.KernelActivity]("kernel_activity", "1.4.0", "1.5.0")
+	register[v1_5_0.KernelActivity, v1_4_0.KernelActivity]("kernel_activity", "1.5.0", "1.4.0")
+	register[v1_4_0.KernelExtensionActivity, v1_5_0.KernelExtensionActivity]("kernel_extension_activity", "1.4.0", "1.5.0")
+	register[v1_5_0.KernelExtensionActivity, v1_4_0.KernelExtensionActivity]("kernel_extension_activity", "1.5.0", "1.4.0")
+	register[v1_4_0.KernelObjectQuery, v1_5_0.KernelObjectQuery]("kernel_object_query", "1.4.0", "1.5.0")
+	register[v1_5_0.KernelObjectQuery, v1_4_0.KernelObjectQuery]("kernel_object_query", "1.5.0", "1.4.0")
+	register[v1_4_0.MemoryActivity, v1_5_0.MemoryActivity]("memory_activity", "1.4.0", "1.5.0")
+	register[v1_5_0.MemoryActivity, v1_4_0.MemoryActivity]("memory_activity", "1.5.0", "1.4.0")
+	register[v1_4_0.ModuleActivity, v1_5_0.ModuleActivity]("module_activity", "1.4.0", "1.5.0")
+	register[v1_5_0.ModuleActivity, v1_4_0.ModuleActivity]("module_activity", "1.5.0", "1.4.0")
+	register[v1_4_0.ModuleQuery, v1_5_0.ModuleQuery]("module_query", "1.4.0", "1.5.0")
+	register[v1_5_0.ModuleQuery, v1_4_0.ModuleQuery]("module_query", "1.5.0", "1.4.0")
+	register[v1_4_0.NetworkActivity, v1_5_0.NetworkActivity]("network_activity", "1.4.0", "1.5.0")
+	register[v1_5_0.NetworkActivity, v1_4_0.NetworkActivity]("network_activity", "1.5.0", "1.4.0")
+	register[v1_4_0.NetworkConnectionQuery, v1_5_0.NetworkConnectionQuery]("network_connection_query", "1.4.0", "1.5.0")
+	register[v1_5_0.NetworkConnectionQuery, v1_4_0.NetworkConnectionQuery]("network_connection_query", "1.5.0", "1.4.0")
+	register[v1_4_0.NetworkFileActivity, v1_5_0.NetworkFileActivity]("network_file_activity", "1.4.0", "1.5.0")
+	register[v1_5_0.NetworkFileActivity, v1_4_0.NetworkFileActivity]("network_file_activity", "1.5.0", "1.4.0")
+	register[v1_4_0.NetworkRemediationActivity, v1_5_0.NetworkRemediationActivity]("network_remediation_activity", "1.4.0", "1.5.0")
+	register[v1_5_0.NetworkRemediationActivity, v1_4_0.NetworkRemediationActivity]("network_remediation_activity", "1.5.0", "1.4.0")
+	register[v1_4_0.NetworksQuery, v1_5_0.NetworksQuery]("networks_query", "1.4.0", "1.5.0")
+	register[v1_5_0.NetworksQuery, v1_4_0.NetworksQuery]("networks_query", "1.5.0", "1.4.0")
+	register[v1_4_0.NTPActivity, v1_5_0.NTPActivity]("ntp_activity", "1.4.0", "1.5.0")
+	register[v1_5_0.NTPActivity, v1_4_0.NTPActivity]("ntp_activity", "1.5.0", "1.4.0")
+	register[v1_4_0.OSINTInventoryInfo, v1_5_0.OSINTInventoryInfo]("osint_inventory_info", "1.4.0", "1.5.0")
+	register[v1_5_0.OSINTInventoryInfo, v1_4_0.OSINTInventoryInfo]("osint_inventory_info", "1.5.0", "1.4.0")
+	register[v1_4_0.OperatingSystemPatchState, v1_5_0.OperatingSystemPatchState]("patch_state", "1.4.0", "1.5.0")
+	register[v1_5_0.OperatingSystemPatchState, v1_4_0.OperatingSystemPatchState]("patch_state", "1.5.0", "1.4.0")
+	register[v1_4_0.PeripheralDeviceQuery, v1_5_0.PeripheralDeviceQuery]("peripheral_device_query", "1.4.0", "1.5.0")
+	register[v1_5_0.PeripheralDeviceQuery, v1_4_0.PeripheralDeviceQuery]("peripheral_device_query", "1.5.0", "1.4.0")
+	register[v1_4_0.PrefetchQuery, v1_5_0.PrefetchQuery]("prefetch_query", "1.4.0", "1.5.0")
+	register[v1_5_0.PrefetchQuery, v1_4_0.PrefetchQuery]("prefetch_query", "1.5.0", "1.4.0")
+	register[v1_4_0.ProcessActivity, v1_5_0.ProcessActivity]("process_activity", "1.4.0", "1.5.0")
+	register[v1_5_0.ProcessActivity, v1_4_0.ProcessActivity]("process_activity", "1.5.0", "1.4.0")
+	register[v1_4_0.ProcessQuery, v1_5_0.ProcessQuery]("process_query", "1.4.0", "1.5.0")
+	register[v1_5_0.ProcessQuery, v1_4_0.ProcessQuery]("process_query", "1.5.0", "1.4.0")
+	register[v1_4_0.ProcessRemediationActivity, v1_5_0.ProcessRemediationActivity]("process_remediation_activity", "1.4.0", "1.5.0")
+	register[v1_5_0.ProcessRemediationActivity, v1_4_0.ProcessRemediationActivity]("process_remediation_activity", "1.5.0", "1.4.0")
+	register[v1_4_0.RDPActivity, v1_5_0.RDPActivity]("rdp_activity", "1.4.0", "1.5.0")
+	register[v1_5_0.RDPActivity, v1_4_0.RDPActivity]("rdp_activity", "1.5.0", "1.4.0")
+	register[v1_4_0.RegistryKeyActivity, v1_5_0.RegistryKeyActivity]("registry_key_activity", "1.4.0", "1.5.0")
+	register[v1_5_0.RegistryKeyActivity, v1_4_0.RegistryKeyActivity]("registry_key_activity", "1.5.0", "1.4.0")
+	register[v1_4_0.RegistryKeyQuery, v1_5_0.RegistryKeyQuery]("registry_key_query", "1.4.0", "1.5.0")
+	register[v1_5_0.RegistryKeyQuery, v1_4_0.RegistryKeyQuery]("registry_key_query", "1.5.0", "1.4.0")
+	register[v1_4_0.RegistryValueActivity, v1_5_0.RegistryValueActivity]("registry_value_activity", "1.4.0", "1.5.0")
+	register[v1_5_0.RegistryValueActivity, v1_4_0.RegistryValueActivity]("registry_value_activity", "1.5.0", "1.4.0")
+	register[v1_4_0.RegistryValueQuery, v1_5_0.RegistryValueQuery]("registry_value_query", "1.4.0", "1.5.0")
+	register[v1_5_0.RegistryValueQuery, v1_4_0.RegistryValueQuery]("registry_value_query", "1.5.0", "1.4.0")
+	register[v1_4_0.RemediationActivity, v1_5_0.RemediationActivity]("remediation_activity", "1.4.0", "1.5.0")
+	register[v1_5_0.RemediationActivity, v1_4_0.RemediationActivity]("remediation_activity", "1.5.0", "1.4.0")
+	register[v1_4_0.ScanActivity, v1_5_0.ScanActivity]("scan_activity", "1.4.0", "1.5.0")
+	register[v1_5_0.ScanActivity, v1_4_0.ScanActivity]("scan_activity", "1.5.0", "1.4.0")
+	register[v1_4_0.ScheduledJobActivity, v1_5_0.ScheduledJobActivity]("scheduled_job_activity", "1.4.0", "1.5.0")
+	register[v1_5_0.ScheduledJobActivity, v1_4_0.ScheduledJobActivity]("scheduled_job_activity", "1.5.0", "1.4.0")
+	register[v1_4_0.ScriptActivity, v1_5_0.ScriptActivity]("script_activity", "1.4.0", "1.5.0")
+	register[v1_5_0.ScriptActivity, v1_4_0.ScriptActivity]("script_activity", "1.5.0", "1.4.0")
+	register[v1_4_0.SecurityFinding, v1_5_0.SecurityFinding]("security_finding", "1.4.0", "1.5.0")
+	register[v1_5_0.SecurityFinding, v1_4_0.SecurityFinding]("security_finding", "1.5.0", "1.4.0")
+	register[v1_4_0.ServiceQuery, v1_5_0.ServiceQuery]("service_query", "1.4.0", "1.5.0")
+	register[v1_5_0.ServiceQuery, v1_4_0.ServiceQuery]("service_query", "1.5.0", "1.4.0")
+	register[v1_4_0.UserSessionQuery, v1_5_0.UserSessionQuery]("session_query", "1.4.0", "1.5.0")
+	register[v1_5_0.UserSessionQuery, v1_4_0.UserSessionQuery]("session_query", "1.5.0", "1.4.0")
+	register[v1_4_0.SMBActivity, v1_5_0.SMBActivity]("smb_activity", "1.4.0", "1.5.0")
+	register[v1_5_0.SMBActivity, v1_4_0.SMBActivity]("smb_activity", "1.5.0", "1.4.0")
+	register[v1_4_0.SoftwareInventoryInfo, v1_5_0.SoftwareInventoryInfo]("software_info", "1.4.0", "1.5.0")
+	register[v1_5_0.SoftwareInventoryInfo, v1_4_0.SoftwareInventoryInfo]("software_info", "1.5.0", "1.4.0")
+	register[v1_4_0.SSHActivity, v1_5_0.SSHActivity]("ssh_activity", "1.4.0", "1.5.0")
+	register[v1_5_0.SSHActivity, v1_4_0.SSHActivity]("ssh_activity", "1.5.0", "1.4.0")
+	register[v1_4_0.StartupItemQuery, v1_5_0.StartupItemQuery]("startup_item_query", "1.4.0", "1.5.0")
+	register[v1_5_0.StartupItemQuery, v1_4_0.StartupItemQuery]("startup_item_query", "1.5.0", "1.4.0")
+	register[v1_4_0.TunnelActivity, v1_5_0.TunnelActivity]("tunnel_activity", "1.4.0", "1.5.0")
+	register[v1_5_0.TunnelActivity, v1_4_0.TunnelActivity]("tunnel_activity", "1.5.0", "1.4.0")
+	register[v1_4_0.UserAccessManagement, v1_5_0.UserAccessManagement]("user_access", "1.4.0", "1.5.0")
+	register[v1_5_0.UserAccessManagement, v1_4_0.UserAccessManagement]("user_access", "1.5.0", "1.4.0")
+	register[v1_4_0.UserInventoryInfo, v1_5_0.UserInventoryInfo]("user_inventory", "1.4.0", "1.5.0")
+	register[v1_5_0.UserInventoryInfo, v1_4_0.UserInventoryInfo]("user_inventory", "1.5.0", "1.4.0")
+	register[v1_4_0.UserQuery, v1_5_0.UserQuery]("user_query", "1.4.0", "1.5.0")
+	register[v1_5_0.UserQuery, v1_4_0.UserQuery]("user_query", "1.5.0", "1.4.0")
+	register[v1_4_0.VulnerabilityFinding, v1_5_0.VulnerabilityFinding]("vulnerability_finding", "1.4.0", "1.5.0")
+	register[v1_5_0.VulnerabilityFinding, v1_4_0.VulnerabilityFinding]("vulnerability_finding", "1.5.0", "1.4.0")
+	register[v1_4_0.WebResourceAccessActivity, v1_5_0.WebResourceAccessActivity]("web_resource_access_activity", "1.4.0", "1.5.0")
+	register[v1_5_0.WebResourceAccessActivity, v1_4_0.WebResourceAccessActivity]("web_resource_access_activity", "1.5.0", "1.4.0")
+	register[v1_4_0.WebResourcesActivity, v1_5_0.WebResourcesActivity]("web_resources_activity", "1.4.0", "1.5.0")
+	register[v1_5_0.WebResourcesActivity, v1_4_0.WebResourcesActivity]("web_resources_activity", "1.5.0", "1.4.0")
+	register[v1_4_0.WindowsResourceActivity, v1_5_0.WindowsResourceActivity]("windows_resource_activity", "1.4.0", "1.5.0")
+	register[v1_5_0.WindowsResourceActivity, v1_4_0.WindowsResourceActivity]("windows_resource_activity", "1.5.0", "1.4.0")
+	register[v1_4_0.WindowsServiceActivity, v1_5_0.WindowsServiceActivity]("windows_service_activity", "1.4.0", "1.5.0")
+	register[v1_5_0.WindowsServiceActivity, v1_4_0.WindowsServiceActivity]("windows_service_activity", "1.5.0", "1.4.0")
+}
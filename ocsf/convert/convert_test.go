@@ -0,0 +1,260 @@
+package convert
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	legacy "github.com/Santiago-Labs/go-ocsf/ocsf"
+	"github.com/Santiago-Labs/go-ocsf/ocsf/v1_4_0"
+	"github.com/Santiago-Labs/go-ocsf/ocsf/v1_5_0"
+	"github.com/aws/aws-sdk-go-v2/aws"
+)
+
+func TestUpgradeMovesDeprecatedAttributes(t *testing.T) {
+	session := v1_4_0.AuthorizeSession{
+		Group:    &v1_4_0.Group{Name: aws.String("admins")},
+		Metadata: v1_4_0.Metadata{Version: "1.4.0"},
+		User:     v1_4_0.User{Name: aws.String("jack")},
+	}
+
+	upgraded, report, err := Convert[v1_4_0.AuthorizeSession, v1_5_0.AuthorizeSession](session)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(report.Dropped) != 0 {
+		t.Errorf("expected no dropped attributes, got %v", report.Dropped)
+	}
+	if len(upgraded.User.Groups) != 1 || *upgraded.User.Groups[0].Name != "admins" {
+		t.Errorf("expected group to move to user.groups, got %v", upgraded.User.Groups)
+	}
+	if upgraded.Metadata.Version != "1.5.0" {
+		t.Errorf("expected metadata.version 1.5.0, got %q", upgraded.Metadata.Version)
+	}
+}
+
+func TestDowngradeReportsDroppedAttributes(t *testing.T) {
+	finding := v1_5_0.VulnerabilityFinding{
+		RawDataSize: aws.Int64(42),
+		Resources: []v1_5_0.ResourceDetails{
+			{Uid: aws.String("i-123"), Name: aws.String("web")},
+		},
+	}
+
+	downgraded, report, err := Convert[v1_5_0.VulnerabilityFinding, v1_4_0.VulnerabilityFinding](finding)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var dropped []string
+	for _, attribute := range report.Dropped {
+		dropped = append(dropped, attribute.Path)
+	}
+	if !reflect.DeepEqual(dropped, []string{"raw_data_size"}) {
+		t.Errorf("expected raw_data_size to be dropped, got %v", dropped)
+	}
+	if len(downgraded.Resources) != 1 || *downgraded.Resources[0].Uid != "i-123" {
+		t.Errorf("expected resources to be kept, got %v", downgraded.Resources)
+	}
+}
+
+func TestUpgradeFailsOnRemovedAttributes(t *testing.T) {
+	state := v1_4_0.DeviceConfigState{
+		CisBenchmarkResult: &v1_4_0.CISBenchmarkResult{Name: "Ensure MFA is enabled"},
+		Metadata:           v1_4_0.Metadata{Version: "1.4.0"},
+	}
+
+	_, _, err := Convert[v1_4_0.DeviceConfigState, v1_5_0.DeviceConfigState](state)
+	if err == nil || !strings.Contains(err.Error(), "config_state.cis_benchmark_result") {
+		t.Errorf("expected an error for the removed cis_benchmark_result, got %v", err)
+	}
+
+	state.CisBenchmarkResult = nil
+	if _, _, err := Convert[v1_4_0.DeviceConfigState, v1_5_0.DeviceConfigState](state); err != nil {
+		t.Errorf("unexpected error without cis_benchmark_result: %v", err)
+	}
+
+	// Downgrades keep dropping the attributes the older version has no place for.
+	if _, _, err := Convert[v1_5_0.DeviceConfigState, v1_4_0.DeviceConfigState](v1_5_0.DeviceConfigState{}); err != nil {
+		t.Errorf("unexpected error downgrading: %v", err)
+	}
+}
+
+func TestLegacyEventDay(t *testing.T) {
+	finding := v1_4_0.VulnerabilityFinding{Time: 1700000000000}
+
+	downgraded, _, err := Convert[v1_4_0.VulnerabilityFinding, legacy.VulnerabilityFinding](finding)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if downgraded.EventDay != 19675 {
+		t.Errorf("expected event_day 19675, got %d", downgraded.EventDay)
+	}
+
+	upgraded, report, err := Convert[legacy.VulnerabilityFinding, v1_4_0.VulnerabilityFinding](downgraded)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if upgraded.Time != finding.Time {
+		t.Errorf("expected time %d, got %d", finding.Time, upgraded.Time)
+	}
+	for _, attribute := range report.Dropped {
+		if attribute.Path == "event_day" {
+			t.Errorf("expected event_day not to be reported as dropped")
+		}
+	}
+}
+
+func TestDowngradeRevertsMoves(t *testing.T) {
+	session := v1_5_0.AuthorizeSession{
+		Metadata: v1_5_0.Metadata{Version: "1.5.0"},
+		User: v1_5_0.User{
+			Name:   aws.String("jack"),
+			Groups: []v1_5_0.Group{{Name: aws.String("users")}, {Name: aws.String("admins")}},
+		},
+	}
+
+	downgraded, _, err := Convert[v1_5_0.AuthorizeSession, v1_4_0.AuthorizeSession](session)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if downgraded.Group == nil || *downgraded.Group.Name != "admins" {
+		t.Errorf("expected the last group to move back to group, got %v", downgraded.Group)
+	}
+	if len(downgraded.User.Groups) != 2 {
+		t.Errorf("expected user.groups to be kept, got %v", downgraded.User.Groups)
+	}
+
+	upgraded, _, err := Convert[v1_4_0.AuthorizeSession, v1_5_0.AuthorizeSession](downgraded)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(upgraded.User.Groups) != 3 || *upgraded.User.Groups[2].Name != "admins" {
+		t.Errorf("expected group to move to the end of user.groups, got %v", upgraded.User.Groups)
+	}
+}
+
+func TestConvertWalksVersionSteps(t *testing.T) {
+	finding := legacy.VulnerabilityFinding{
+		Time:     1700000000000,
+		EventDay: 19675,
+		Metadata: legacy.Metadata{Version: "1.4.0"},
+	}
+
+	upgraded, report, err := Convert[legacy.VulnerabilityFinding, v1_5_0.VulnerabilityFinding](finding)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if report.From != LegacyVersion || report.To != "1.5.0" {
+		t.Errorf("expected a report from legacy to 1.5.0, got %s to %s", report.From, report.To)
+	}
+	if len(report.Dropped) != 0 {
+		t.Errorf("expected no dropped attributes, got %v", report.Dropped)
+	}
+	if upgraded.Time != finding.Time || upgraded.Metadata.Version != "1.5.0" {
+		t.Errorf("expected time %d and metadata.version 1.5.0, got %d and %q", finding.Time, upgraded.Time, upgraded.Metadata.Version)
+	}
+
+	upgraded.RawDataSize = aws.Int64(42)
+	downgraded, report, err := Convert[v1_5_0.VulnerabilityFinding, legacy.VulnerabilityFinding](upgraded)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if downgraded.EventDay != finding.EventDay {
+		t.Errorf("expected event_day %d, got %d", finding.EventDay, downgraded.EventDay)
+	}
+	var dropped []string
+	for _, attribute := range report.Dropped {
+		dropped = append(dropped, attribute.Path)
+	}
+	if !slices.Contains(dropped, "raw_data_size") {
+		t.Errorf("expected raw_data_size to be dropped by the 1.4.0 step, got %v", dropped)
+	}
+
+	if _, _, err := Convert[legacy.VulnerabilityFinding, v1_5_0.APIActivity](finding); err == nil {
+		t.Errorf("expected an error converting between classes")
+	}
+}
+
+func TestNextStepPicksNearestVersion(t *testing.T) {
+	// A conversion which skips 1.5.0 is only taken when no step to 1.5.0 is registered.
+	for _, c := range []*conversion{
+		{class: "test_class", from: "1.4.0", to: "1.6.0"},
+		{class: "test_class", from: "1.4.0", to: "1.5.0"},
+		{class: "test_class", from: "1.5.0", to: "1.6.0"},
+		{class: "test_class", from: "1.6.0", to: "1.4.0"},
+		{class: "test_class", from: "1.6.0", to: "1.5.0"},
+	} {
+		name := conversionName(c.class, c.from, c.to)
+		conversionsByName[name] = c
+		defer delete(conversionsByName, name)
+	}
+
+	for range 20 {
+		if next := nextStep("test_class", "1.4.0", "1.6.0"); next == nil || next.to != "1.5.0" {
+			t.Fatalf("expected the upgrade step to 1.5.0, got %+v", next)
+		}
+		if next := nextStep("test_class", "1.6.0", "1.4.0"); next == nil || next.to != "1.5.0" {
+			t.Fatalf("expected the downgrade step to 1.5.0, got %+v", next)
+		}
+	}
+	if next := nextStep("test_class", "1.4.0", "1.5.0"); next == nil || next.to != "1.5.0" {
+		t.Errorf("expected the step to the target, got %+v", next)
+	}
+	if next := nextStep("test_class", "1.5.0", "1.4.0"); next != nil {
+		t.Errorf("expected no step, got %+v", next)
+	}
+}
+
+func TestConvertBatch(t *testing.T) {
+	findings := []v1_5_0.VulnerabilityFinding{
+		{Time: 1, RawDataSize: aws.Int64(42)},
+		{Time: 2},
+	}
+
+	downgraded, reports, err := ConvertBatch[v1_5_0.VulnerabilityFinding, v1_4_0.VulnerabilityFinding](findings)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(downgraded) != 2 || downgraded[0].Time != 1 || downgraded[1].Time != 2 {
+		t.Fatalf("expected both findings in order, got %v", downgraded)
+	}
+	if len(reports) != 2 || len(reports[0].Dropped) != 1 || len(reports[1].Dropped) != 0 {
+		t.Errorf("expected raw_data_size dropped from the first finding only, got %v", reports)
+	}
+}
+
+func benchmarkFindings() []v1_4_0.VulnerabilityFinding {
+	findings := make([]v1_4_0.VulnerabilityFinding, 1000)
+	for i := range findings {
+		findings[i] = v1_4_0.VulnerabilityFinding{
+			Time:        int64(i),
+			FindingInfo: v1_4_0.FindingInformation{Uid: "finding", Title: aws.String("CVE-2024-1234 in openssl")},
+			Metadata:    v1_4_0.Metadata{Version: "1.4.0", Product: v1_4_0.Product{Name: aws.String("Snyk")}},
+			Resources:   []v1_4_0.ResourceDetails{{Uid: aws.String("i-123"), Name: aws.String("web")}},
+		}
+	}
+	return findings
+}
+
+func BenchmarkConvert(b *testing.B) {
+	findings := benchmarkFindings()
+	for b.Loop() {
+		for _, finding := range findings {
+			if _, _, err := Convert[v1_4_0.VulnerabilityFinding, v1_5_0.VulnerabilityFinding](finding); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+func BenchmarkConvertBatch(b *testing.B) {
+	findings := benchmarkFindings()
+	for b.Loop() {
+		if _, _, err := ConvertBatch[v1_4_0.VulnerabilityFinding, v1_5_0.VulnerabilityFinding](findings); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,159 @@
+package convert
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/parquet-go/parquet-go"
+)
+
+// DatasetReport summarizes the data lost while rewriting a dataset.
+type DatasetReport struct {
+	Class  string `json:"class"`
+	From   string `json:"from"`
+	To     string `json:"to"`
+	Files  int    `json:"files"`
+	Events int    `json:"events"`
+
+	// Dropped counts the dropped attributes by path, with list indexes removed.
+	Dropped map[string]int `json:"dropped,omitempty"`
+}
+
+func (r *DatasetReport) add(report Report) {
+	r.Events++
+	for _, dropped := range report.Dropped {
+		if r.Dropped == nil {
+			r.Dropped = make(map[string]int)
+		}
+		r.Dropped[stripIndexes(dropped.Path)]++
+	}
+}
+
+// DroppedPaths returns the paths of the dropped attributes in lexical order.
+func (r *DatasetReport) DroppedPaths() []string {
+	paths := make([]string, 0, len(r.Dropped))
+	for path := range r.Dropped {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// RewriteDataset converts every JSON and Parquet file of a local dataset, as written by the local
+// datastores, from one OCSF version to another. Files are written to outputDir under the same
+// names, in the same partition directories.
+func RewriteDataset(class, from, to, inputDir, outputDir string) (*DatasetReport, error) {
+	c, ok := conversionsByName[conversionName(class, from, to)]
+	if !ok {
+		return nil, fmt.Errorf("no conversion of %s from %s to %s", class, from, to)
+	}
+
+	if _, err := os.Stat(inputDir); err != nil {
+		return nil, fmt.Errorf("failed to read dataset directory: %w", err)
+	}
+
+	if err := os.MkdirAll(outputDir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create output directory: %w", err)
+	}
+
+	report := &DatasetReport{Class: class, From: from, To: to}
+	err := filepath.WalkDir(inputDir, func(inPath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return fmt.Errorf("failed to read dataset directory: %w", err)
+		}
+		if entry.IsDir() || datasetFormat(entry.Name()) == "" {
+			return nil
+		}
+
+		rel, err := filepath.Rel(inputDir, inPath)
+		if err != nil {
+			return err
+		}
+		outPath := filepath.Join(outputDir, rel)
+		if err := os.MkdirAll(filepath.Dir(outPath), 0755); err != nil {
+			return fmt.Errorf("failed to create output directory: %w", err)
+		}
+		if err := c.rewriteFile(inPath, outPath, report); err != nil {
+			return fmt.Errorf("failed to rewrite %s: %w", inPath, err)
+		}
+		report.Files++
+		return nil
+	})
+	if err != nil {
+		return report, err
+	}
+
+	return report, nil
+}
+
+func rewriteFile[From, To any](c *conversion, inPath, outPath string, report *DatasetReport) error {
+	var events []From
+	switch datasetFormat(inPath) {
+	case "json":
+		data, err := os.ReadFile(inPath)
+		if err != nil {
+			return err
+		}
+		if err := json.Unmarshal(data, &events); err != nil {
+			return fmt.Errorf("failed to parse JSON file: %w", err)
+		}
+	case "parquet":
+		var err error
+		events, err = parquet.ReadFile[From](inPath)
+		if err != nil {
+			return fmt.Errorf("failed to read parquet file: %w", err)
+		}
+	}
+
+	converted := make([]To, 0, len(events))
+	eventReports, err := convertEvents([]*conversion{c}, events, &converted)
+	if err != nil {
+		return err
+	}
+	for _, eventReport := range eventReports {
+		report.add(eventReport)
+	}
+
+	switch datasetFormat(inPath) {
+	case "json":
+		data, err := json.Marshal(converted)
+		if err != nil {
+			return err
+		}
+		return os.WriteFile(outPath, data, 0644)
+	default:
+		return parquet.WriteFile(outPath, converted, parquet.Compression(&parquet.Gzip))
+	}
+}
+
+func datasetFormat(path string) string {
+	switch {
+	case strings.HasSuffix(path, ".json"):
+		return "json"
+	case strings.HasSuffix(path, ".parquet"), strings.HasSuffix(path, ".parquet.gz"):
+		return "parquet"
+	default:
+		return ""
+	}
+}
+
+// stripIndexes removes list indexes from an attribute path, e.g. "resources[2].owner" becomes
+// "resources.owner".
+func stripIndexes(path string) string {
+	var b strings.Builder
+	for i := 0; i < len(path); i++ {
+		if path[i] == '[' {
+			for i < len(path) && path[i] != ']' {
+				i++
+			}
+			continue
+		}
+		b.WriteByte(path[i])
+	}
+	return b.String()
+}
@@ -0,0 +1,57 @@
+package convert
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Santiago-Labs/go-ocsf/ocsf/v1_4_0"
+	"github.com/Santiago-Labs/go-ocsf/ocsf/v1_5_0"
+)
+
+func TestRewriteDatasetPartitions(t *testing.T) {
+	inputDir, outputDir := t.TempDir(), t.TempDir()
+
+	// Day partitioned datasets keep their files in dt=YYYY-MM-DD directories.
+	files := []string{
+		"20250101T000000Z.json",
+		filepath.Join("dt=2025-01-02", "20250102T000000Z.json"),
+		filepath.Join("dt=2025-01-03", "20250103T000000Z.json"),
+	}
+	for _, file := range files {
+		data, err := json.Marshal([]v1_4_0.VulnerabilityFinding{{Metadata: v1_4_0.Metadata{Version: "1.4.0"}}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		path := filepath.Join(inputDir, file)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, data, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	report, err := RewriteDataset("vulnerability_finding", "1.4.0", "1.5.0", inputDir, outputDir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if report.Files != len(files) {
+		t.Errorf("got %d files rewritten, want %d", report.Files, len(files))
+	}
+
+	for _, file := range files {
+		data, err := os.ReadFile(filepath.Join(outputDir, file))
+		if err != nil {
+			t.Fatalf("expected %s in the output: %v", file, err)
+		}
+		var findings []v1_5_0.VulnerabilityFinding
+		if err := json.Unmarshal(data, &findings); err != nil {
+			t.Fatal(err)
+		}
+		if len(findings) != 1 || findings[0].Metadata.Version != "1.5.0" {
+			t.Errorf("got %s findings %+v, want one 1.5.0 finding", file, findings)
+		}
+	}
+}
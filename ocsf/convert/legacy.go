@@ -0,0 +1,39 @@
+package convert
+
+import (
+	"encoding/json"
+	"strconv"
+
+	legacy "github.com/Santiago-Labs/go-ocsf/ocsf"
+	"github.com/Santiago-Labs/go-ocsf/ocsf/v1_4_0"
+)
+
+// LegacyVersion names the hand-written ocsf package, which predates the generated packages and
+// models OCSF 1.4.0 with an additional event_day partition attribute.
+const LegacyVersion = "legacy"
+
+const millisecondsPerDay = 24 * 60 * 60 * 1000
+
+func init() {
+	register[legacy.VulnerabilityFinding, v1_4_0.VulnerabilityFinding]("vulnerability_finding", LegacyVersion, "1.4.0")
+	register[v1_4_0.VulnerabilityFinding, legacy.VulnerabilityFinding]("vulnerability_finding", "1.4.0", LegacyVersion)
+	register[legacy.APIActivity, v1_4_0.APIActivity]("api_activity", LegacyVersion, "1.4.0")
+	register[v1_4_0.APIActivity, legacy.APIActivity]("api_activity", "1.4.0", LegacyVersion)
+}
+
+// fixups rewrite attributes which are derived rather than moved, keyed by source and target version.
+var fixups = map[[2]string]func(attributes map[string]any){
+	// event_day only partitions the legacy tables and is derived from the event time.
+	{LegacyVersion, "1.4.0"}: func(attributes map[string]any) {
+		delete(attributes, "event_day")
+	},
+	{"1.4.0", LegacyVersion}: func(attributes map[string]any) {
+		eventTime, ok := attributes["time"].(json.Number)
+		if !ok {
+			return
+		}
+		if millis, err := eventTime.Int64(); err == nil {
+			attributes["event_day"] = json.Number(strconv.FormatInt(millis/millisecondsPerDay, 10))
+		}
+	},
+}
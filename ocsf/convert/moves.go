@@ -0,0 +1,170 @@
+package convert
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Move describes a class attribute which a newer OCSF version replaced by a list of the same
+// object. Paths are dotted attribute paths relative to the event, and a To path ending in "[]"
+// appends the value to the list.
+//
+// The moves are generated into moves_gen.go by scripts/model_gen.go from the schema diff of every two
+// consecutive versions: a class attribute of an object which the newer version removed or deprecated
+// moves to the single list of the same object which replaces it. Renamed attributes are not
+// detected, and removed attributes without such a list are Removals.
+//
+// Moves are applied when upgrading to the version they moved in, and reverted when downgrading
+// from it, so that converting across several versions applies the moves of every version between
+// them in order.
+type Move struct {
+	Class string
+	From  string
+	To    string
+}
+
+// Removal describes a class attribute which a newer OCSF version removed without a replacement.
+// Removals are generated into moves_gen.go along with the moves.
+type Removal struct {
+	Class     string
+	Attribute string
+}
+
+// checkRemovals returns an error when upgrading an event which sets a class attribute that the
+// newer version removed without a replacement, rather than dropping its value.
+func checkRemovals(c *conversion, attributes map[string]any) error {
+	if !isUpgrade(c.from, c.to) {
+		return nil
+	}
+	for _, removal := range removals[c.to] {
+		if removal.Class == c.class && attributes[removal.Attribute] != nil {
+			return fmt.Errorf("%s.%s was removed in OCSF %s without a replacement", c.class, removal.Attribute, c.to)
+		}
+	}
+	return nil
+}
+
+// applyMoves applies the moves of one version step: the moves of the newer version when upgrading,
+// and their reverts, in reverse order, when downgrading.
+func applyMoves(c *conversion, attributes map[string]any) {
+	if isUpgrade(c.from, c.to) {
+		for _, move := range moves[c.to] {
+			if move.Class == "" || move.Class == c.class {
+				move.apply(attributes)
+			}
+		}
+		return
+	}
+
+	versionMoves := moves[c.from]
+	for i := len(versionMoves) - 1; i >= 0; i-- {
+		if move := versionMoves[i]; move.Class == "" || move.Class == c.class {
+			move.revert(attributes)
+		}
+	}
+}
+
+func (m Move) apply(attributes map[string]any) {
+	value, ok := takeAttribute(attributes, strings.Split(m.From, "."))
+	if !ok {
+		return
+	}
+	setAttribute(attributes, strings.Split(strings.TrimSuffix(m.To, "[]"), "."), value, strings.HasSuffix(m.To, "[]"))
+}
+
+// revert moves the attribute back to where the older version keeps it. An attribute which was
+// moved into a list is copied back from the last item, which is where apply appends it, and the
+// list is kept as the older version has it as well.
+func (m Move) revert(attributes map[string]any) {
+	to := strings.Split(strings.TrimSuffix(m.To, "[]"), ".")
+
+	var value any
+	if strings.HasSuffix(m.To, "[]") {
+		list, _ := getAttribute(attributes, to).([]any)
+		if len(list) == 0 {
+			return
+		}
+		value = cloneValue(list[len(list)-1])
+	} else {
+		var ok bool
+		value, ok = takeAttribute(attributes, to)
+		if !ok {
+			return
+		}
+	}
+	setAttribute(attributes, strings.Split(m.From, "."), value, false)
+}
+
+// getAttribute returns the value of the attribute at path, or nil when it is not set.
+func getAttribute(attributes map[string]any, path []string) any {
+	for _, name := range path[:len(path)-1] {
+		child, ok := attributes[name].(map[string]any)
+		if !ok {
+			return nil
+		}
+		attributes = child
+	}
+	return attributes[path[len(path)-1]]
+}
+
+// setAttribute sets the attribute at path, creating the objects on the way, or appends value to
+// the list at path.
+func setAttribute(attributes map[string]any, path []string, value any, appendToList bool) {
+	parent := attributes
+	for _, name := range path[:len(path)-1] {
+		child, ok := parent[name].(map[string]any)
+		if !ok {
+			child = make(map[string]any)
+			parent[name] = child
+		}
+		parent = child
+	}
+
+	name := path[len(path)-1]
+	if appendToList {
+		list, _ := parent[name].([]any)
+		parent[name] = append(list, value)
+		return
+	}
+	parent[name] = value
+}
+
+// takeAttribute removes the attribute at path and returns its value.
+func takeAttribute(attributes map[string]any, path []string) (any, bool) {
+	for _, name := range path[:len(path)-1] {
+		child, ok := attributes[name].(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		attributes = child
+	}
+
+	name := path[len(path)-1]
+	value, ok := attributes[name]
+	if !ok || value == nil {
+		return nil, false
+	}
+	delete(attributes, name)
+
+	return value, true
+}
+
+// cloneValue copies decoded JSON, so that a value can be kept in two places and pruned in each.
+func cloneValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		clone := make(map[string]any, len(v))
+		for name, child := range v {
+			clone[name] = cloneValue(child)
+		}
+		return clone
+	case []any:
+		clone := make([]any, len(v))
+		for i, child := range v {
+			clone[i] = cloneValue(child)
+		}
+		return clone
+	default:
+		return value
+	}
+}
@@ -0,0 +1,57 @@
+package ocsf
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// ClassUID reads the class_uid of a raw OCSF event.
+func ClassUID(data []byte) (int32, error) {
+	var header struct {
+		ClassUID *int32 `json:"class_uid"`
+	}
+	if err := json.Unmarshal(data, &header); err != nil {
+		return 0, fmt.Errorf("failed to decode event: %w", err)
+	}
+	if header.ClassUID == nil {
+		return 0, fmt.Errorf("event has no class_uid")
+	}
+	return *header.ClassUID, nil
+}
+
+// Decoder reads a stream of OCSF events, such as newline-delimited JSON, decoding each event with
+// the Decode function of a generated package.
+type Decoder[E any] struct {
+	decoder *json.Decoder
+	decode  func([]byte) (E, error)
+	count   int
+}
+
+// NewDecoder returns a Decoder reading events from r. Generated packages wrap it as NewDecoder.
+func NewDecoder[E any](r io.Reader, decode func([]byte) (E, error)) *Decoder[E] {
+	return &Decoder[E]{
+		decoder: json.NewDecoder(r),
+		decode:  decode,
+	}
+}
+
+// Decode returns the next event of the stream, or io.EOF when the stream is exhausted.
+func (d *Decoder[E]) Decode() (E, error) {
+	var zero E
+
+	var raw json.RawMessage
+	if err := d.decoder.Decode(&raw); err != nil {
+		if err == io.EOF {
+			return zero, io.EOF
+		}
+		return zero, fmt.Errorf("failed to read event %d: %w", d.count+1, err)
+	}
+	d.count++
+
+	event, err := d.decode(raw)
+	if err != nil {
+		return zero, fmt.Errorf("failed to decode event %d: %w", d.count, err)
+	}
+	return event, nil
+}
@@ -0,0 +1,184 @@
+package ocsf
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"slices"
+	"sort"
+)
+
+// VolatilePaths are attributes which change on every sync of the same event or finding. Pass them
+// to Diff to ignore them.
+var VolatilePaths = []string{
+	"time",
+	"metadata.logged_time",
+	"metadata.processed_time",
+	"metadata.modified_time",
+	"finding_info.last_seen_time",
+	"finding_info.modified_time",
+}
+
+// Change is an attribute which differs between two events. From is nil for added attributes and
+// To is nil for removed ones.
+type Change struct {
+	Path string `json:"path"`
+	From any    `json:"from,omitempty"`
+	To   any    `json:"to,omitempty"`
+}
+
+func (c Change) String() string {
+	switch {
+	case c.From == nil:
+		return fmt.Sprintf("+ %s: %s", c.Path, changeValue(c.To))
+	case c.To == nil:
+		return fmt.Sprintf("- %s: %s", c.Path, changeValue(c.From))
+	default:
+		return fmt.Sprintf("~ %s: %s -> %s", c.Path, changeValue(c.From), changeValue(c.To))
+	}
+}
+
+func changeValue(v any) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(data)
+}
+
+var pathIndex = regexp.MustCompile(`\[\d+\]`)
+
+// Diff returns the attributes which differ between a and b, which are usually two versions of
+// the same event or finding, by attribute path, e.g. "vulnerabilities[0].severity". Changes of
+// attributes under the ignored paths are left out; list elements are ignored with "[]", e.g.
+// "vulnerabilities[].last_seen_time". Lists of objects which all have a distinct uid, such as
+// resources, are compared by uid, so that reordering them is not a change; their paths index the
+// elements of b, or of a for removed elements. Other lists are compared element by element.
+func Diff[T any](a, b T, ignore ...string) ([]Change, error) {
+	from, err := diffValue(a)
+	if err != nil {
+		return nil, err
+	}
+	to, err := diffValue(b)
+	if err != nil {
+		return nil, err
+	}
+
+	ignored := make(map[string]bool, len(ignore))
+	for _, path := range ignore {
+		ignored[path] = true
+	}
+
+	var changes []Change
+	diffValues("", from, to, ignored, &changes)
+	return changes, nil
+}
+
+// diffValue encodes v as JSON and decodes it into maps, so that every generated type, including
+// RawJSON attributes, is compared by its attribute names.
+func diffValue(v any) (any, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal event: %w", err)
+	}
+	return value, nil
+}
+
+func diffValues(path string, from, to any, ignored map[string]bool, changes *[]Change) {
+	if ignored[path] || ignored[pathIndex.ReplaceAllString(path, "[]")] {
+		return
+	}
+
+	fromObject, fromIsObject := from.(map[string]any)
+	toObject, toIsObject := to.(map[string]any)
+	if fromIsObject && toIsObject {
+		names := make(map[string]bool)
+		for name := range fromObject {
+			names[name] = true
+		}
+		for name := range toObject {
+			names[name] = true
+		}
+		sorted := make([]string, 0, len(names))
+		for name := range names {
+			sorted = append(sorted, name)
+		}
+		sort.Strings(sorted)
+
+		for _, name := range sorted {
+			diffValues(JoinPath(path, name), fromObject[name], toObject[name], ignored, changes)
+		}
+		return
+	}
+
+	fromList, fromIsList := from.([]any)
+	toList, toIsList := to.([]any)
+	if fromIsList && toIsList {
+		fromUIDs, fromKeyed := elementUIDs(fromList)
+		toUIDs, toKeyed := elementUIDs(toList)
+		if fromKeyed && toKeyed {
+			for i, uid := range toUIDs {
+				diffValues(IndexPath(path, i), elementByUID(fromList, fromUIDs, uid), toList[i], ignored, changes)
+			}
+			for i, uid := range fromUIDs {
+				if !slices.Contains(toUIDs, uid) {
+					diffValues(IndexPath(path, i), fromList[i], nil, ignored, changes)
+				}
+			}
+			return
+		}
+
+		for i := 0; i < max(len(fromList), len(toList)); i++ {
+			var fromElem, toElem any
+			if i < len(fromList) {
+				fromElem = fromList[i]
+			}
+			if i < len(toList) {
+				toElem = toList[i]
+			}
+			diffValues(IndexPath(path, i), fromElem, toElem, ignored, changes)
+		}
+		return
+	}
+
+	if !reflect.DeepEqual(from, to) {
+		*changes = append(*changes, Change{Path: path, From: from, To: to})
+	}
+}
+
+// elementUIDs returns the uid of every element of list. It reports false unless every element is
+// an object with a uid distinct from the others.
+func elementUIDs(list []any) ([]string, bool) {
+	uids := make([]string, len(list))
+	seen := make(map[string]bool, len(list))
+	for i, elem := range list {
+		object, ok := elem.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		uid, ok := object["uid"].(string)
+		if !ok || uid == "" || seen[uid] {
+			return nil, false
+		}
+		uids[i] = uid
+		seen[uid] = true
+	}
+	return uids, true
+}
+
+// elementByUID returns the element of list with uid, or nil.
+func elementByUID(list []any, uids []string, uid string) any {
+	if i := slices.Index(uids, uid); i >= 0 {
+		return list[i]
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+package ocsf
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	type vulnerability struct {
+		Title      string   `json:"title"`
+		FixedIn    []string `json:"fixed_in,omitempty"`
+		LastSeenAt int64    `json:"last_seen_time"`
+	}
+	type finding struct {
+		Time            int64           `json:"time"`
+		Status          *string         `json:"status,omitempty"`
+		Vulnerabilities []vulnerability `json:"vulnerabilities,omitempty"`
+	}
+
+	a := finding{
+		Time:            1000,
+		Status:          Ptr("New"),
+		Vulnerabilities: []vulnerability{{Title: "CVE-1", LastSeenAt: 1000}},
+	}
+	b := finding{
+		Time:            2000,
+		Vulnerabilities: []vulnerability{{Title: "CVE-1", FixedIn: []string{"1.2.3"}, LastSeenAt: 2000}},
+	}
+
+	changes, err := Diff(a, b, "time", "vulnerabilities[].last_seen_time")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []Change{
+		{Path: "status", From: "New"},
+		{Path: "vulnerabilities[0].fixed_in", To: []any{"1.2.3"}},
+	}
+	got, _ := json.Marshal(changes)
+	wanted, _ := json.Marshal(want)
+	if string(got) != string(wanted) {
+		t.Errorf("got %s, want %s", got, wanted)
+	}
+
+	if changes, _ := Diff(a, a); len(changes) != 0 {
+		t.Errorf("got %v, want no changes", changes)
+	}
+}
+
+func TestDiffLists(t *testing.T) {
+	type resource struct {
+		Uid  string `json:"uid,omitempty"`
+		Name string `json:"name"`
+	}
+	type finding struct {
+		Resources []resource `json:"resources"`
+	}
+
+	var tests = []struct {
+		name string
+		from []resource
+		to   []resource
+		want []Change
+	}{
+		{
+			name: "Test reordered elements",
+			from: []resource{{Uid: "a", Name: "bucket"}, {Uid: "b", Name: "queue"}},
+			to:   []resource{{Uid: "b", Name: "queue"}, {Uid: "a", Name: "bucket"}},
+		},
+		{
+			name: "Test a changed element after a reorder",
+			from: []resource{{Uid: "a", Name: "bucket"}, {Uid: "b", Name: "queue"}},
+			to:   []resource{{Uid: "b", Name: "topic"}, {Uid: "a", Name: "bucket"}},
+			want: []Change{{Path: "resources[0].name", From: "queue", To: "topic"}},
+		},
+		{
+			name: "Test an added and a removed element",
+			from: []resource{{Uid: "a", Name: "bucket"}, {Uid: "b", Name: "queue"}},
+			to:   []resource{{Uid: "c", Name: "topic"}, {Uid: "a", Name: "bucket"}},
+			want: []Change{
+				{Path: "resources[0]", To: map[string]any{"uid": "c", "name": "topic"}},
+				{Path: "resources[1]", From: map[string]any{"uid": "b", "name": "queue"}},
+			},
+		},
+		{
+			name: "Test elements without uid",
+			from: []resource{{Name: "bucket"}, {Name: "queue"}},
+			to:   []resource{{Name: "queue"}, {Name: "bucket"}},
+			want: []Change{
+				{Path: "resources[0].name", From: "bucket", To: "queue"},
+				{Path: "resources[1].name", From: "queue", To: "bucket"},
+			},
+		},
+		{
+			name: "Test elements with duplicate uids",
+			from: []resource{{Uid: "a", Name: "bucket"}, {Uid: "a", Name: "queue"}},
+			to:   []resource{{Uid: "a", Name: "queue"}, {Uid: "a", Name: "bucket"}},
+			want: []Change{
+				{Path: "resources[0].name", From: "bucket", To: "queue"},
+				{Path: "resources[1].name", From: "queue", To: "bucket"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changes, err := Diff(finding{tt.from}, finding{tt.to})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			got, _ := json.Marshal(changes)
+			wanted, _ := json.Marshal(tt.want)
+			if string(got) != string(wanted) {
+				t.Errorf("got %s, want %s", got, wanted)
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package ocsf
+
+import (
+	"github.com/apache/arrow-go/v18/arrow"
+)
+
+// Class describes a generated OCSF class.
+type Class struct {
+	// Name is the OCSF class name, e.g. "api_activity".
+	Name string
+	// Package is the generated package of the class, e.g. "v1_5_0" or "v1_5_0_acme".
+	Package string
+	// Version is the OCSF version the package was generated from, e.g. "1.5.0".
+	Version string
+	// Schema is the Arrow schema of the class.
+	Schema *arrow.Schema
+}
+
+// Event is implemented by the classes of every generated package, so that datastores,
+// partitioners and dedupers can handle any class and version without reflection. Each generated
+// package extends it with getters returning the package's own objects, e.g. GetMetadata.
+type Event interface {
+	OCSFClass() Class
+	GetActivityId() int32
+	GetCategoryUid() int32
+	GetClassUid() int32
+	GetSeverityId() int32
+	GetTime() int64
+	GetTypeUid() int64
+}
+
+// Finding is implemented by the classes of the Findings category.
+type Finding interface {
+	Event
+	// GetFindingUid returns finding_info.uid.
+	GetFindingUid() string
+}
@@ -0,0 +1,190 @@
+// Package eventuid computes stable event UIDs from the natural key of an event, the attributes which
+// identify its source record, so that the same source record gets the same metadata.uid on every
+// sync and can be deduplicated and joined across runs and OCSF versions.
+package eventuid
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
+)
+
+// productPaths identify the source product of an event, which is part of every natural key.
+var productPaths = []string{
+	"metadata.product.vendor_name",
+	"metadata.product.name",
+}
+
+var (
+	classPathsMu sync.RWMutex
+	classPaths   = map[string][]string{}
+)
+
+// SetClassPaths overrides the natural key of a class with attribute paths, by OCSF class name, e.g.
+// "api_activity". Events of the class are identified by the values of the paths alone. No paths
+// restore the natural key of the class.
+func SetClassPaths(class string, paths []string) {
+	classPathsMu.Lock()
+	defer classPathsMu.Unlock()
+
+	if len(paths) == 0 {
+		delete(classPaths, class)
+		return
+	}
+	classPaths[class] = append([]string(nil), paths...)
+}
+
+// ClassPaths returns a copy of the attribute paths set for a class by SetClassPaths, or nil when
+// its events are identified by the natural key of the class.
+func ClassPaths(class string) []string {
+	classPathsMu.RLock()
+	defer classPathsMu.RUnlock()
+
+	return append([]string(nil), classPaths[class]...)
+}
+
+// UID returns the UID of event, computed from the natural key of its class: findings are identified
+// by their source product and finding_info.uid, and other classes by their source product, the ID
+// of their source record and their time, e.g. a CloudTrail eventID. recordID is only required for
+// classes without a finding_info.uid, but findings without one fall back to it. Only the attributes
+// of the key are read, so other attributes may change without changing the UID.
+func UID(event ocsf.Event, recordID string) (string, error) {
+	if paths := ClassPaths(event.OCSFClass().Name); paths != nil {
+		return FromPaths(event, paths)
+	}
+
+	var fields []field
+	for _, path := range productPaths {
+		value, err := lookup(reflect.ValueOf(event), path)
+		if err != nil {
+			return "", err
+		}
+		fields = append(fields, field{path, value})
+	}
+
+	if finding, ok := event.(ocsf.Finding); ok && finding.GetFindingUid() != "" {
+		fields = append(fields, field{"finding_info.uid", finding.GetFindingUid()})
+		return hashFields(fields)
+	}
+	if recordID == "" {
+		return "", fmt.Errorf("%s events are identified by their source record, but its ID is empty", event.OCSFClass().Name)
+	}
+	fields = append(fields, field{"record_id", recordID}, field{"time", event.GetTime()})
+	return hashFields(fields)
+}
+
+// FromPaths returns a UID computed from the values of the attribute paths of event, which may be
+// any OCSF class or object. Attributes which are not set are hashed as null, but at least one of
+// them must be set.
+func FromPaths(event any, paths []string) (string, error) {
+	var fields []field
+	var set bool
+	for _, path := range paths {
+		value, err := lookup(reflect.ValueOf(event), path)
+		if err != nil {
+			return "", err
+		}
+		if value != nil {
+			set = true
+		}
+		fields = append(fields, field{path, value})
+	}
+	if !set {
+		return "", fmt.Errorf("none of the UID attributes %v is set", paths)
+	}
+	return hashFields(fields)
+}
+
+// field is an attribute of a natural key and its value.
+type field struct {
+	path  string
+	value any
+}
+
+// hashFields hashes the fields of a natural key, each value as JSON next to its path.
+func hashFields(fields []field) (string, error) {
+	hash := sha256.New()
+	for _, f := range fields {
+		encoded, err := json.Marshal(f.value)
+		if err != nil {
+			return "", fmt.Errorf("failed to marshal %s: %w", f.path, err)
+		}
+		hash.Write([]byte(f.path))
+		hash.Write([]byte{0})
+		hash.Write(encoded)
+		hash.Write([]byte{0})
+	}
+	return hex.EncodeToString(hash.Sum(nil)[:16]), nil
+}
+
+// lookup returns the value of an attribute path of a generated struct, or nil when it is not set,
+// as encoding/json would leave it out. Attributes the struct does not have are not set.
+func lookup(value reflect.Value, path string) (any, error) {
+	for path != "" {
+		name, index, rest, err := ocsf.SplitPath(path)
+		if err != nil {
+			return nil, err
+		}
+
+		value = indirect(value)
+		if value.Kind() != reflect.Struct {
+			return nil, nil
+		}
+		i, ok := jsonFields(value.Type())[name]
+		if !ok {
+			return nil, nil
+		}
+		value = value.Field(i)
+
+		if index >= 0 {
+			value = indirect(value)
+			if value.Kind() != reflect.Slice || index >= value.Len() {
+				return nil, nil
+			}
+			value = value.Index(index)
+		}
+		path = rest
+	}
+
+	value = indirect(value)
+	if !value.IsValid() || (value.Kind() == reflect.Slice && value.IsNil()) {
+		return nil, nil
+	}
+	return value.Interface(), nil
+}
+
+// indirect follows pointers and interfaces, returning the zero Value for nil.
+func indirect(value reflect.Value) reflect.Value {
+	for value.Kind() == reflect.Pointer || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return reflect.Value{}
+		}
+		value = value.Elem()
+	}
+	return value
+}
+
+// fieldsByType caches the field indexes of struct types by JSON name.
+var fieldsByType sync.Map
+
+func jsonFields(t reflect.Type) map[string]int {
+	if fields, ok := fieldsByType.Load(t); ok {
+		return fields.(map[string]int)
+	}
+
+	fields := make(map[string]int)
+	for i := range t.NumField() {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+		if name != "" && name != "-" {
+			fields[name] = i
+		}
+	}
+	fieldsByType.Store(t, fields)
+	return fields
+}
@@ -0,0 +1,158 @@
+package eventuid
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
+	"github.com/Santiago-Labs/go-ocsf/ocsf/v1_4_0"
+)
+
+func TestUID(t *testing.T) {
+	product := v1_4_0.Product{Name: ocsf.Ptr("CloudTrail"), VendorName: ocsf.Ptr("AWS")}
+	activity := func(time int64) *v1_4_0.APIActivity {
+		return &v1_4_0.APIActivity{
+			Time:     time,
+			Metadata: v1_4_0.Metadata{Product: product},
+		}
+	}
+	finding := func(uid string, time int64) *v1_4_0.VulnerabilityFinding {
+		return &v1_4_0.VulnerabilityFinding{
+			Time:        time,
+			Metadata:    v1_4_0.Metadata{Product: product},
+			FindingInfo: v1_4_0.FindingInformation{Uid: uid},
+		}
+	}
+
+	var tests = []struct {
+		name     string
+		event    ocsf.Event
+		recordID string
+		same     ocsf.Event
+		sameID   string
+		other    ocsf.Event
+		otherID  string
+		err      bool
+	}{
+		{
+			name:  "Test an activity",
+			event: activity(1000), recordID: "event-1",
+			same: activity(1000), sameID: "event-1",
+			other: activity(1000), otherID: "event-2",
+		},
+		{
+			name:  "Test an activity at another time",
+			event: activity(1000), recordID: "event-1",
+			other: activity(2000), otherID: "event-1",
+		},
+		{
+			name:  "Test an update of a finding",
+			event: finding("finding-1", 1000),
+			same:  finding("finding-1", 2000), sameID: "record-2",
+			other: finding("finding-2", 1000),
+		},
+		{
+			name:  "Test a finding without finding_info.uid",
+			event: finding("", 1000), recordID: "record-1",
+			same: finding("", 1000), sameID: "record-1",
+			other: finding("", 1000), otherID: "record-2",
+		},
+		{name: "Test an activity without a record ID", event: activity(1000), err: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uid, err := UID(tt.event, tt.recordID)
+			if tt.err {
+				if err == nil {
+					t.Errorf("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.same != nil {
+				if same, _ := UID(tt.same, tt.sameID); same != uid {
+					t.Errorf("got %q, want the stable UID %q", same, uid)
+				}
+			}
+			if tt.other != nil {
+				if other, _ := UID(tt.other, tt.otherID); other == uid {
+					t.Errorf("got the same UID for another source record")
+				}
+			}
+		})
+	}
+}
+
+func TestUIDReadsOnlyTheKey(t *testing.T) {
+	finding := &v1_4_0.VulnerabilityFinding{
+		Metadata:    v1_4_0.Metadata{Product: v1_4_0.Product{Name: ocsf.Ptr("Snyk")}},
+		FindingInfo: v1_4_0.FindingInformation{Uid: "finding-1"},
+	}
+	uid, err := UID(finding, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	finding.Message = ocsf.Ptr("changed")
+	finding.SeverityId = 4
+	finding.Metadata.CorrelationUid = ocsf.Ptr("other")
+	if changed, _ := UID(finding, ""); changed != uid {
+		t.Errorf("got %q after changing attributes outside the key, want %q", changed, uid)
+	}
+}
+
+func TestClassPaths(t *testing.T) {
+	paths := []string{"metadata.product.name", "api.request.uid"}
+	SetClassPaths("api_activity", paths)
+	defer SetClassPaths("api_activity", nil)
+
+	// The paths are copied, so changing them does not change the key.
+	paths[1] = "time"
+	ClassPaths("api_activity")[0] = "time"
+	if got := ClassPaths("api_activity"); !reflect.DeepEqual(got, []string{"metadata.product.name", "api.request.uid"}) {
+		t.Errorf("ClassPaths = %v", got)
+	}
+
+	activity := &v1_4_0.APIActivity{
+		Metadata: v1_4_0.Metadata{Product: v1_4_0.Product{Name: ocsf.Ptr("Event Monitoring")}},
+		Api:      v1_4_0.API{Request: &v1_4_0.RequestElements{Uid: "request-1"}},
+	}
+	uid, err := UID(activity, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	activity.Time = 1000
+	if again, _ := UID(activity, "ignored"); again != uid {
+		t.Errorf("got %q, want the UID of the configured paths %q", again, uid)
+	}
+}
+
+func TestFromPaths(t *testing.T) {
+	finding := &v1_4_0.VulnerabilityFinding{
+		Resources: []v1_4_0.ResourceDetails{{Uid: ocsf.Ptr("bucket")}},
+	}
+
+	var tests = []struct {
+		name  string
+		paths []string
+		err   bool
+	}{
+		{name: "Test a list element", paths: []string{"resources[0].uid"}},
+		{name: "Test an index out of range", paths: []string{"resources[1].uid"}, err: true},
+		{name: "Test an attribute which is not set", paths: []string{"finding_info.title"}, err: true},
+		{name: "Test an unknown attribute", paths: []string{"colour"}, err: true},
+		{name: "Test an invalid path", paths: []string{"resources[x].uid"}, err: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := FromPaths(finding, tt.paths)
+			if tt.err != (err != nil) {
+				t.Errorf("got error %v, want error %v", err, tt.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+// Package fieldids holds the stable Iceberg field IDs of OCSF classes. scripts/model_gen.go
+// maintains a map per class, <class>.txt, holding a field ID and a column path per line, e.g.
+// "12 actor.user.name". New column paths are appended with new IDs and IDs are never reused, so a
+// column keeps its field ID across OCSF versions.
+package fieldids
+
+import (
+	"bufio"
+	"bytes"
+	"embed"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+//go:embed *.txt
+var maps embed.FS
+
+// Lookup returns the field IDs of a class by column path. List elements are named with a "[]"
+// suffix, e.g. "resources[]". It returns nil for classes without a field ID map.
+func Lookup(class string) (map[string]int, error) {
+	data, err := maps.ReadFile(class + ".txt")
+	if err != nil {
+		return nil, nil
+	}
+
+	ids := make(map[string]int)
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			continue
+		}
+		id, path, ok := strings.Cut(line, " ")
+		n, err := strconv.Atoi(id)
+		if !ok || err != nil || n <= 0 {
+			return nil, fmt.Errorf("invalid field ID line %q for class %s", line, class)
+		}
+		ids[path] = n
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
@@ -0,0 +1,44 @@
+package fieldids
+
+import "testing"
+
+func TestLookup(t *testing.T) {
+	var tests = []struct {
+		name  string
+		class string
+		paths map[string]int
+	}{
+		{name: "Test a finding", class: "vulnerability_finding", paths: map[string]int{"activity_id": 1, "enrichments[]": 35, "enrichments[].data": 37}},
+		{name: "Test an activity", class: "api_activity", paths: map[string]int{"activity_id": 1, "actor": 3, "actor.app_name": 4}},
+		{name: "Test a class without a map", class: "http_activity"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ids, err := Lookup(tt.class)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.paths == nil {
+				if ids != nil {
+					t.Errorf("got %d field IDs, want none", len(ids))
+				}
+				return
+			}
+			for path, id := range tt.paths {
+				if ids[path] != id {
+					t.Errorf("got %s %d, want %d", path, ids[path], id)
+				}
+			}
+
+			// IDs are never reused.
+			seen := make(map[int]string)
+			for path, id := range ids {
+				if other, ok := seen[id]; ok {
+					t.Errorf("%s and %s share field ID %d", path, other, id)
+				}
+				seen[id] = path
+			}
+		})
+	}
+}
@@ -1,6 +1,10 @@
 package ocsf
 
-import "reflect"
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
 
 type hasObservable interface {
 	Observable() (*int, string)
@@ -73,3 +77,76 @@ func asObservable(v reflect.Value) (*int, string) {
 	}
 	return nil, ""
 }
+
+// Ptr returns a pointer to a copy of v. It is used by generated code to populate optional fields.
+func Ptr[T any](v T) *T {
+	return &v
+}
+
+// ObservableValue formats a scalar attribute value, such as a port number, as an observable value.
+func ObservableValue[T any](v T) string {
+	return fmt.Sprint(v)
+}
+
+// ObservableKey identifies an observable by its name, type and value. Generated code uses it to
+// avoid adding an observable twice.
+func ObservableKey(name *string, typeID int32, value *string) string {
+	var n, val string
+	if name != nil {
+		n = *name
+	}
+	if value != nil {
+		val = *value
+	}
+	return fmt.Sprintf("%s\x00%d\x00%s", n, typeID, val)
+}
+
+// RawJSON holds an attribute value as its JSON encoding. Generated classes use it for attributes of
+// the OCSF object and JSON types, such as unmapped, and reference structs use it to keep the objects
+// nested deeper than the configured recursion depth. It is stored as a string column and encoded as
+// the original JSON value, e.g. a nested object rather than an escaped string.
+type RawJSON string
+
+// MarshalJSON returns the JSON encoding held by r, or null when r is empty.
+func (r RawJSON) MarshalJSON() ([]byte, error) {
+	if r == "" {
+		return []byte("null"), nil
+	}
+	return []byte(r), nil
+}
+
+// UnmarshalJSON stores the JSON encoding of a value.
+func (r *RawJSON) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*r = ""
+		return nil
+	}
+	*r = RawJSON(data)
+	return nil
+}
+
+// NewRawJSON encodes v as RawJSON, e.g. a map[string]any of unmapped attributes.
+func NewRawJSON(v any) (RawJSON, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return RawJSON(data), nil
+}
+
+// Decode decodes the JSON encoding held by r into v.
+func (r RawJSON) Decode(v any) error {
+	if r == "" {
+		return nil
+	}
+	return json.Unmarshal([]byte(r), v)
+}
+
+// Object decodes r as a JSON object. It returns nil when r is empty.
+func (r RawJSON) Object() (map[string]any, error) {
+	var object map[string]any
+	if err := r.Decode(&object); err != nil {
+		return nil, err
+	}
+	return object, nil
+}
@@ -0,0 +1,69 @@
+package ocsf
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestRawJSON(t *testing.T) {
+	type event struct {
+		Unmapped *RawJSON `json:"unmapped,omitempty"`
+		Data     RawJSON  `json:"data"`
+	}
+
+	var tests = []struct {
+		name string
+		json string
+		want event
+	}{
+		{name: "Test an object", json: `{"unmapped":{"a":1,"b":["c"]},"data":{"d":true}}`, want: event{Unmapped: Ptr(RawJSON(`{"a":1,"b":["c"]}`)), Data: `{"d":true}`}},
+		{name: "Test a list", json: `{"unmapped":[1,2],"data":"text"}`, want: event{Unmapped: Ptr(RawJSON(`[1,2]`)), Data: `"text"`}},
+		{name: "Test a missing value", json: `{"data":null}`, want: event{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got event
+			if err := json.Unmarshal([]byte(tt.json), &got); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+
+			data, err := json.Marshal(got)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if string(data) != tt.json {
+				t.Errorf("got %s, want %s", data, tt.json)
+			}
+		})
+	}
+}
+
+func TestNewRawJSON(t *testing.T) {
+	raw, err := NewRawJSON(map[string]any{"user": "alice", "count": 2})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if raw != `{"count":2,"user":"alice"}` {
+		t.Errorf("got %s", raw)
+	}
+
+	object, err := raw.Object()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if object["user"] != "alice" || object["count"] != float64(2) {
+		t.Errorf("got %v", object)
+	}
+
+	if object, err := RawJSON("").Object(); object != nil || err != nil {
+		t.Errorf("got %v, %v for an empty value, want nil", object, err)
+	}
+	if _, err := RawJSON(`[1]`).Object(); err == nil {
+		t.Errorf("expected an error for a list")
+	}
+}
@@ -0,0 +1,188 @@
+package ocsf
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ErrUnknownPath is returned by the generated Set methods for paths which do not name an attribute.
+var ErrUnknownPath = errors.New("unknown attribute path")
+
+// UnknownPath returns an error wrapping ErrUnknownPath for path.
+func UnknownPath(path string) error {
+	return fmt.Errorf("%w %q", ErrUnknownPath, path)
+}
+
+// SplitPath splits the first attribute off a dotted attribute path, e.g. "resources[2].owner.name"
+// into the name "resources", the index 2 and the remaining path "owner.name". index is -1 when the
+// attribute is not indexed.
+func SplitPath(path string) (name string, index int, rest string, err error) {
+	name, rest, _ = strings.Cut(path, ".")
+
+	index = -1
+	if open := strings.IndexByte(name, '['); open >= 0 {
+		if !strings.HasSuffix(name, "]") {
+			return "", 0, "", fmt.Errorf("invalid attribute path %q", path)
+		}
+		index, err = strconv.Atoi(name[open+1 : len(name)-1])
+		if err != nil || index < 0 {
+			return "", 0, "", fmt.Errorf("invalid list index in attribute path %q", path)
+		}
+		name = name[:open]
+	}
+
+	if name == "" {
+		return "", 0, "", fmt.Errorf("invalid attribute path %q", path)
+	}
+
+	return name, index, rest, nil
+}
+
+// Index returns the element of list at index. It reports false when index is out of range.
+func Index[T any](list []T, index int) (any, bool) {
+	if index < 0 || index >= len(list) {
+		return nil, false
+	}
+	return list[index], true
+}
+
+// Element returns a pointer to the element of list at index. An index one past the end of the list
+// appends a zero element, so that lists can be built up with Set.
+func Element[T any](list *[]T, index int) (*T, error) {
+	if index == len(*list) {
+		var zero T
+		*list = append(*list, zero)
+	}
+	if index < 0 || index >= len(*list) {
+		return nil, fmt.Errorf("list index %d out of range for list of length %d", index, len(*list))
+	}
+	return &(*list)[index], nil
+}
+
+// SetRequired assigns value to a required attribute. value may be a T or a non-nil *T; numbers are
+// converted to the attribute's numeric type when they fit.
+func SetRequired[T any](dst *T, value any) error {
+	v, ok := coerce[T](value)
+	if !ok {
+		return fmt.Errorf("cannot assign %T to attribute of type %T", value, *dst)
+	}
+	*dst = v
+	return nil
+}
+
+// SetOptional assigns value to an optional attribute. value may be a T or a *T, and nil clears
+// the attribute. JSON attributes accept any value which encodes as JSON.
+func SetOptional[T any](dst **T, value any) error {
+	if value == nil {
+		*dst = nil
+		return nil
+	}
+	if v, ok := value.(*T); ok {
+		*dst = v
+		return nil
+	}
+
+	v, ok := coerce[T](value)
+	if !ok {
+		return fmt.Errorf("cannot assign %T to attribute of type %T", value, *dst)
+	}
+	*dst = &v
+	return nil
+}
+
+func coerce[T any](value any) (T, bool) {
+	var zero T
+	switch v := value.(type) {
+	case T:
+		return v, true
+	case *T:
+		if v == nil {
+			return zero, false
+		}
+		return *v, true
+	}
+
+	var converted any
+	switch any(zero).(type) {
+	case int32:
+		n, ok := integerValue(value)
+		if !ok || n < math.MinInt32 || n > math.MaxInt32 {
+			return zero, false
+		}
+		converted = int32(n)
+	case int64:
+		n, ok := integerValue(value)
+		if !ok {
+			return zero, false
+		}
+		converted = n
+	case float64:
+		f, ok := floatValue(value)
+		if !ok {
+			return zero, false
+		}
+		converted = f
+	case RawJSON:
+		// JSON attributes accept any value which encodes as JSON, e.g. a map[string]any.
+		r, err := NewRawJSON(value)
+		if err != nil {
+			return zero, false
+		}
+		converted = r
+	default:
+		return zero, false
+	}
+
+	return converted.(T), true
+}
+
+func integerValue(value any) (int64, bool) {
+	switch v := value.(type) {
+	case int:
+		return int64(v), true
+	case int8:
+		return int64(v), true
+	case int16:
+		return int64(v), true
+	case int32:
+		return int64(v), true
+	case int64:
+		return v, true
+	case uint8:
+		return int64(v), true
+	case uint16:
+		return int64(v), true
+	case uint32:
+		return int64(v), true
+	case float64:
+		// float64(math.MaxInt64) rounds up to 1<<63, which int64 cannot hold.
+		if v != math.Trunc(v) || v < math.MinInt64 || v >= math.MaxInt64 {
+			return 0, false
+		}
+		return int64(v), true
+	case json.Number:
+		n, err := v.Int64()
+		return n, err == nil
+	default:
+		return 0, false
+	}
+}
+
+func floatValue(value any) (float64, bool) {
+	switch v := value.(type) {
+	case float32:
+		return float64(v), true
+	case float64:
+		return v, true
+	case json.Number:
+		f, err := v.Float64()
+		return f, err == nil
+	default:
+		n, ok := integerValue(value)
+		return float64(n), ok
+	}
+}
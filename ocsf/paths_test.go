@@ -0,0 +1,87 @@
+package ocsf
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSplitPath(t *testing.T) {
+	var tests = []struct {
+		name  string
+		in    string
+		first string
+		index int
+		rest  string
+		err   bool
+	}{
+		{name: "Test a single attribute", in: "severity_id", first: "severity_id", index: -1},
+		{name: "Test a nested attribute", in: "finding_info.uid", first: "finding_info", index: -1, rest: "uid"},
+		{name: "Test an indexed attribute", in: "resources[2].owner.name", first: "resources", index: 2, rest: "owner.name"},
+		{name: "Test a negative index", in: "resources[-1].uid", err: true},
+		{name: "Test an unterminated index", in: "resources[1.uid", err: true},
+		{name: "Test an empty path", in: "", err: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			first, index, rest, err := SplitPath(tt.in)
+			if tt.err {
+				if err == nil {
+					t.Errorf("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if first != tt.first || index != tt.index || rest != tt.rest {
+				t.Errorf("got %q, %d, %q, want %q, %d, %q", first, index, rest, tt.first, tt.index, tt.rest)
+			}
+		})
+	}
+}
+
+func TestSet(t *testing.T) {
+	var severityID int32
+	if err := SetRequired(&severityID, 4); err != nil || severityID != 4 {
+		t.Errorf("got %d, %v, want 4", severityID, err)
+	}
+	if err := SetRequired(&severityID, 1<<40); err == nil {
+		t.Errorf("expected an error for an out of range value")
+	}
+
+	var typeUID int64
+	if err := SetRequired(&typeUID, float64(1<<63)); err == nil {
+		t.Errorf("expected an error for a float64 past the int64 range, got %d", typeUID)
+	}
+	if err := SetRequired(&typeUID, float64(-1<<63)); err != nil || typeUID != -1<<63 {
+		t.Errorf("got %d, %v, want the smallest int64", typeUID, err)
+	}
+
+	var title *string
+	if err := SetOptional(&title, "title"); err != nil || title == nil || *title != "title" {
+		t.Errorf("got %v, %v, want title", title, err)
+	}
+	if err := SetOptional(&title, nil); err != nil || title != nil {
+		t.Errorf("got %v, %v, want nil", title, err)
+	}
+
+	var unmapped *RawJSON
+	if err := SetOptional(&unmapped, map[string]any{"region": "us-east-1"}); err != nil || unmapped == nil || *unmapped != `{"region":"us-east-1"}` {
+		t.Errorf("got %v, %v, want a JSON object", unmapped, err)
+	}
+
+	var labels []string
+	elem, err := Element(&labels, 0)
+	if err != nil || len(labels) != 1 {
+		t.Fatalf("got %v, %v, want a list of one", labels, err)
+	}
+	*elem = "label"
+	if _, err := Element(&labels, 2); err == nil {
+		t.Errorf("expected an error for an index past the end of the list")
+	}
+
+	if err := UnknownPath("nope"); !errors.Is(err, ErrUnknownPath) {
+		t.Errorf("got %v, want ErrUnknownPath", err)
+	}
+}
@@ -2,6 +2,7 @@
 package v1_4_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -30,13 +31,154 @@ func (v *Account) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *Account) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *Account) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	for i := range v.Tags {
+		errs = append(errs, v.Tags[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "tags"), i))...)
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *Account) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "labels":
+		if rest == "" {
+			if index < 0 {
+				return v.Labels, v.Labels != nil
+			}
+			return ocsf.Index(v.Labels, index)
+		}
+		return nil, false
+	case "name":
+		if index >= 0 || rest != "" || v.Name == nil {
+			return nil, false
+		}
+		return *v.Name, true
+	case "tags":
+		if rest == "" {
+			if index < 0 {
+				return v.Tags, v.Tags != nil
+			}
+			return ocsf.Index(v.Tags, index)
+		}
+		if index < 0 || index >= len(v.Tags) {
+			return nil, false
+		}
+		return v.Tags[index].Get(rest)
+	case "type":
+		if index >= 0 || rest != "" || v.Type == nil {
+			return nil, false
+		}
+		return *v.Type, true
+	case "type_id":
+		if index >= 0 || rest != "" || v.TypeId == nil {
+			return nil, false
+		}
+		return *v.TypeId, true
+	case "uid":
+		if index >= 0 || rest != "" || v.Uid == nil {
+			return nil, false
+		}
+		return *v.Uid, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *Account) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "labels":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Labels, value)
+		}
+		elem, err := ocsf.Element(&v.Labels, index)
+		if err != nil {
+			return err
+		}
+		if rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(elem, value)
+	case "name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Name, value)
+	case "tags":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Tags, value)
+		}
+		elem, err := ocsf.Element(&v.Tags, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "type":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Type, value)
+	case "type_id":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.TypeId, value)
+	case "uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Uid, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *Account) AppendObservables(path string, observables []Observable) []Observable {
+	for i := range v.Tags {
+		observables = v.Tags[i].AppendObservables(ocsf.JoinPath(path, "tags"), observables)
+	}
+
+	return observables
+}
+
 var AccountFields = []arrow.Field{
-	{Name: "labels", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true},
-	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "tags", Type: arrow.ListOf(KeyValueobjectStruct), Nullable: true},
-	{Name: "type", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "type_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "labels", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true, Metadata: ocsf.AttributeMetadata("Labels", "The list of labels associated to the account.", "optional", "")},
+	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Name", "The name of the account (e.g. <code> GCP Project name </code>, <code> Linux Account name </code> or <code> AWS Account name</code>).", "optional", "")},
+	{Name: "tags", Type: arrow.ListOf(KeyValueobjectStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("Tags", "The list of tags; <code>{key:value}</code> pairs associated to the account.", "optional", "")},
+	{Name: "type", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Type", "The account type, normalized to the caption of 'account_type_id'. In the case of 'Other', it is defined by the event source.", "optional", "")},
+	{Name: "type_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Type ID", "The normalized account type identifier.", "optional", "")},
+	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Unique ID", "The unique identifier of the account (e.g. <code> AWS Account ID </code>, <code> OCID </code>, <code> GCP Project ID </code>, <code> Azure Subscription ID </code>, <code> Google Workspace Customer ID </code>, or <code> M365 Tenant UID</code>).", "optional", "")},
 }
 
 var AccountStruct = arrow.StructOf(AccountFields...)
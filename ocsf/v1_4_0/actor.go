@@ -2,6 +2,7 @@
 package v1_4_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -33,14 +34,212 @@ func (v *Actor) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *Actor) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *Actor) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	for i := range v.Authorizations {
+		errs = append(errs, v.Authorizations[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "authorizations"), i))...)
+	}
+	if v.Idp != nil {
+		errs = append(errs, v.Idp.ValidateAt(ocsf.JoinPath(path, "idp"))...)
+	}
+	if v.Process != nil {
+		errs = append(errs, v.Process.ValidateAt(ocsf.JoinPath(path, "process"))...)
+	}
+	if v.Session != nil {
+		errs = append(errs, v.Session.ValidateAt(ocsf.JoinPath(path, "session"))...)
+	}
+	if v.User != nil {
+		errs = append(errs, v.User.ValidateAt(ocsf.JoinPath(path, "user"))...)
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *Actor) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "app_name":
+		if index >= 0 || rest != "" || v.AppName == nil {
+			return nil, false
+		}
+		return *v.AppName, true
+	case "app_uid":
+		if index >= 0 || rest != "" || v.AppUid == nil {
+			return nil, false
+		}
+		return *v.AppUid, true
+	case "authorizations":
+		if rest == "" {
+			if index < 0 {
+				return v.Authorizations, v.Authorizations != nil
+			}
+			return ocsf.Index(v.Authorizations, index)
+		}
+		if index < 0 || index >= len(v.Authorizations) {
+			return nil, false
+		}
+		return v.Authorizations[index].Get(rest)
+	case "idp":
+		if index >= 0 || v.Idp == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Idp, true
+		}
+		return v.Idp.Get(rest)
+	case "process":
+		if index >= 0 || v.Process == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Process, true
+		}
+		return v.Process.Get(rest)
+	case "session":
+		if index >= 0 || v.Session == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Session, true
+		}
+		return v.Session.Get(rest)
+	case "user":
+		if index >= 0 || v.User == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.User, true
+		}
+		return v.User.Get(rest)
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *Actor) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "app_name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.AppName, value)
+	case "app_uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.AppUid, value)
+	case "authorizations":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Authorizations, value)
+		}
+		elem, err := ocsf.Element(&v.Authorizations, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "idp":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Idp, value)
+		}
+		if v.Idp == nil {
+			v.Idp = &IdentityProvider{}
+		}
+		return v.Idp.Set(rest, value)
+	case "process":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Process, value)
+		}
+		if v.Process == nil {
+			v.Process = &Process{}
+		}
+		return v.Process.Set(rest, value)
+	case "session":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Session, value)
+		}
+		if v.Session == nil {
+			v.Session = &Session{}
+		}
+		return v.Session.Set(rest, value)
+	case "user":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.User, value)
+		}
+		if v.User == nil {
+			v.User = &User{}
+		}
+		return v.User.Set(rest, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *Actor) AppendObservables(path string, observables []Observable) []Observable {
+	for i := range v.Authorizations {
+		observables = v.Authorizations[i].AppendObservables(ocsf.JoinPath(path, "authorizations"), observables)
+	}
+	if v.Idp != nil {
+		observables = v.Idp.AppendObservables(ocsf.JoinPath(path, "idp"), observables)
+	}
+	if v.Process != nil {
+		observables = v.Process.AppendObservables(ocsf.JoinPath(path, "process"), observables)
+	}
+	if v.Session != nil {
+		observables = v.Session.AppendObservables(ocsf.JoinPath(path, "session"), observables)
+	}
+	if v.User != nil {
+		observables = v.User.AppendObservables(ocsf.JoinPath(path, "user"), observables)
+	}
+
+	return observables
+}
+
 var ActorFields = []arrow.Field{
-	{Name: "app_name", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "app_uid", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "authorizations", Type: arrow.ListOf(AuthorizationResultStruct), Nullable: true},
-	{Name: "idp", Type: IdentityProviderStruct, Nullable: true},
-	{Name: "process", Type: ProcessStruct, Nullable: true},
-	{Name: "session", Type: SessionStruct, Nullable: true},
-	{Name: "user", Type: UserStruct, Nullable: true},
+	{Name: "app_name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Application Name", "The client application or service that initiated the activity. This can be in conjunction with the <code>user</code> if present.  Note that <code>app_name</code> is distinct from the <code>process</code> if present.", "optional", "")},
+	{Name: "app_uid", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Application ID", "The unique identifier of the client application or service that initiated the activity. This can be in conjunction with the <code>user</code> if present. Note that <code>app_name</code> is distinct from the <code>process.pid</code> or <code>process.uid</code> if present.", "optional", "")},
+	{Name: "authorizations", Type: arrow.ListOf(AuthorizationResultStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("Authorization Information", "Provides details about an authorization, such as authorization outcome, and any associated policies related to the activity/event.", "optional", "")},
+	{Name: "idp", Type: IdentityProviderStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Identity Provider", "This object describes details about the Identity Provider used.", "optional", "")},
+	{Name: "process", Type: ProcessStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Process", "The process that initiated the activity.", "optional", "")},
+	{Name: "session", Type: SessionStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Session", "The user session from which the activity was initiated.", "optional", "")},
+	{Name: "user", Type: UserStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("User", "The user that initiated the activity or the user context from which the activity was initiated.", "optional", "")},
 }
 
 var ActorStruct = arrow.StructOf(ActorFields...)
@@ -3,6 +3,7 @@ package v1_4_0
 
 import (
 	"fmt"
+	"slices"
 
 	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
@@ -104,7 +105,7 @@ type AdminGroupQuery struct {
 	TypeUid int64 `json:"type_uid" parquet:"type_uid"`
 
 	// Unmapped Data: The attributes that are not mapped to the event schema. The names and values of those attributes are specific to the event source.
-	Unmapped *string `json:"unmapped,omitempty" parquet:"unmapped,optional"`
+	Unmapped *ocsf.RawJSON `json:"unmapped,omitempty" parquet:"unmapped,optional"`
 
 	// Users: The users that belong to the administrative group.
 	Users []User `json:"users,omitempty" parquet:"users,list,optional"`
@@ -133,40 +134,609 @@ func (v *AdminGroupQuery) ValidateObservables() error {
 	return nil
 }
 
+func (v *AdminGroupQuery) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *AdminGroupQuery) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	if slices.Contains(v.Metadata.Profiles, "cloud") {
+		errs = append(errs, v.Cloud.ValidateAt(ocsf.JoinPath(path, "cloud"))...)
+	}
+	for i := range v.Enrichments {
+		errs = append(errs, v.Enrichments[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "enrichments"), i))...)
+	}
+	errs = append(errs, v.Group.ValidateAt(ocsf.JoinPath(path, "group"))...)
+	errs = append(errs, v.Metadata.ValidateAt(ocsf.JoinPath(path, "metadata"))...)
+	for i := range v.Observables {
+		errs = append(errs, v.Observables[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "observables"), i))...)
+	}
+	if slices.Contains(v.Metadata.Profiles, "osint") {
+		if len(v.Osint) == 0 {
+			errs.Add(ocsf.JoinPath(path, "osint"), "required attribute is missing")
+		}
+		for i := range v.Osint {
+			errs = append(errs, v.Osint[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "osint"), i))...)
+		}
+	}
+	if v.QueryInfo != nil {
+		errs = append(errs, v.QueryInfo.ValidateAt(ocsf.JoinPath(path, "query_info"))...)
+	}
+	if v.Time == 0 {
+		errs.Add(ocsf.JoinPath(path, "time"), "required attribute is missing")
+	}
+	for i := range v.Users {
+		errs = append(errs, v.Users[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "users"), i))...)
+	}
+	if int64(v.TypeUid) != int64(v.ClassUid)*100+int64(v.ActivityId) {
+		errs.Add(ocsf.JoinPath(path, "type_uid"), "%d does not equal class_uid*100+activity_id (%d)", v.TypeUid, int64(v.ClassUid)*100+int64(v.ActivityId))
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *AdminGroupQuery) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "activity_id":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.ActivityId, true
+	case "activity_name":
+		if index >= 0 || rest != "" || v.ActivityName == nil {
+			return nil, false
+		}
+		return *v.ActivityName, true
+	case "category_name":
+		if index >= 0 || rest != "" || v.CategoryName == nil {
+			return nil, false
+		}
+		return *v.CategoryName, true
+	case "category_uid":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.CategoryUid, true
+	case "class_name":
+		if index >= 0 || rest != "" || v.ClassName == nil {
+			return nil, false
+		}
+		return *v.ClassName, true
+	case "class_uid":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.ClassUid, true
+	case "cloud":
+		if index >= 0 {
+			return nil, false
+		}
+		if rest == "" {
+			return &v.Cloud, true
+		}
+		return v.Cloud.Get(rest)
+	case "count":
+		if index >= 0 || rest != "" || v.Count == nil {
+			return nil, false
+		}
+		return *v.Count, true
+	case "duration":
+		if index >= 0 || rest != "" || v.Duration == nil {
+			return nil, false
+		}
+		return *v.Duration, true
+	case "end_time":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.EndTime, true
+	case "enrichments":
+		if rest == "" {
+			if index < 0 {
+				return v.Enrichments, v.Enrichments != nil
+			}
+			return ocsf.Index(v.Enrichments, index)
+		}
+		if index < 0 || index >= len(v.Enrichments) {
+			return nil, false
+		}
+		return v.Enrichments[index].Get(rest)
+	case "group":
+		if index >= 0 {
+			return nil, false
+		}
+		if rest == "" {
+			return &v.Group, true
+		}
+		return v.Group.Get(rest)
+	case "message":
+		if index >= 0 || rest != "" || v.Message == nil {
+			return nil, false
+		}
+		return *v.Message, true
+	case "metadata":
+		if index >= 0 {
+			return nil, false
+		}
+		if rest == "" {
+			return &v.Metadata, true
+		}
+		return v.Metadata.Get(rest)
+	case "observables":
+		if rest == "" {
+			if index < 0 {
+				return v.Observables, v.Observables != nil
+			}
+			return ocsf.Index(v.Observables, index)
+		}
+		if index < 0 || index >= len(v.Observables) {
+			return nil, false
+		}
+		return v.Observables[index].Get(rest)
+	case "osint":
+		if rest == "" {
+			if index < 0 {
+				return v.Osint, v.Osint != nil
+			}
+			return ocsf.Index(v.Osint, index)
+		}
+		if index < 0 || index >= len(v.Osint) {
+			return nil, false
+		}
+		return v.Osint[index].Get(rest)
+	case "query_info":
+		if index >= 0 || v.QueryInfo == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.QueryInfo, true
+		}
+		return v.QueryInfo.Get(rest)
+	case "query_result":
+		if index >= 0 || rest != "" || v.QueryResult == nil {
+			return nil, false
+		}
+		return *v.QueryResult, true
+	case "query_result_id":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.QueryResultId, true
+	case "raw_data":
+		if index >= 0 || rest != "" || v.RawData == nil {
+			return nil, false
+		}
+		return *v.RawData, true
+	case "severity":
+		if index >= 0 || rest != "" || v.Severity == nil {
+			return nil, false
+		}
+		return *v.Severity, true
+	case "severity_id":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.SeverityId, true
+	case "start_time":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.StartTime, true
+	case "status":
+		if index >= 0 || rest != "" || v.Status == nil {
+			return nil, false
+		}
+		return *v.Status, true
+	case "status_code":
+		if index >= 0 || rest != "" || v.StatusCode == nil {
+			return nil, false
+		}
+		return *v.StatusCode, true
+	case "status_detail":
+		if index >= 0 || rest != "" || v.StatusDetail == nil {
+			return nil, false
+		}
+		return *v.StatusDetail, true
+	case "status_id":
+		if index >= 0 || rest != "" || v.StatusId == nil {
+			return nil, false
+		}
+		return *v.StatusId, true
+	case "time":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.Time, true
+	case "timezone_offset":
+		if index >= 0 || rest != "" || v.TimezoneOffset == nil {
+			return nil, false
+		}
+		return *v.TimezoneOffset, true
+	case "type_name":
+		if index >= 0 || rest != "" || v.TypeName == nil {
+			return nil, false
+		}
+		return *v.TypeName, true
+	case "type_uid":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.TypeUid, true
+	case "unmapped":
+		if index >= 0 || rest != "" || v.Unmapped == nil {
+			return nil, false
+		}
+		return *v.Unmapped, true
+	case "users":
+		if rest == "" {
+			if index < 0 {
+				return v.Users, v.Users != nil
+			}
+			return ocsf.Index(v.Users, index)
+		}
+		if index < 0 || index >= len(v.Users) {
+			return nil, false
+		}
+		return v.Users[index].Get(rest)
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *AdminGroupQuery) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "activity_id":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.ActivityId, value)
+	case "activity_name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.ActivityName, value)
+	case "category_name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.CategoryName, value)
+	case "category_uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.CategoryUid, value)
+	case "class_name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.ClassName, value)
+	case "class_uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.ClassUid, value)
+	case "cloud":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetRequired(&v.Cloud, value)
+		}
+		return v.Cloud.Set(rest, value)
+	case "count":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Count, value)
+	case "duration":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Duration, value)
+	case "end_time":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.EndTime, value)
+	case "enrichments":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Enrichments, value)
+		}
+		elem, err := ocsf.Element(&v.Enrichments, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "group":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetRequired(&v.Group, value)
+		}
+		return v.Group.Set(rest, value)
+	case "message":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Message, value)
+	case "metadata":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetRequired(&v.Metadata, value)
+		}
+		return v.Metadata.Set(rest, value)
+	case "observables":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Observables, value)
+		}
+		elem, err := ocsf.Element(&v.Observables, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "osint":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Osint, value)
+		}
+		elem, err := ocsf.Element(&v.Osint, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "query_info":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.QueryInfo, value)
+		}
+		if v.QueryInfo == nil {
+			v.QueryInfo = &QueryInformation{}
+		}
+		return v.QueryInfo.Set(rest, value)
+	case "query_result":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.QueryResult, value)
+	case "query_result_id":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.QueryResultId, value)
+	case "raw_data":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.RawData, value)
+	case "severity":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Severity, value)
+	case "severity_id":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.SeverityId, value)
+	case "start_time":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.StartTime, value)
+	case "status":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Status, value)
+	case "status_code":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.StatusCode, value)
+	case "status_detail":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.StatusDetail, value)
+	case "status_id":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.StatusId, value)
+	case "time":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.Time, value)
+	case "timezone_offset":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.TimezoneOffset, value)
+	case "type_name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.TypeName, value)
+	case "type_uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.TypeUid, value)
+	case "unmapped":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Unmapped, value)
+	case "users":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Users, value)
+		}
+		elem, err := ocsf.Element(&v.Users, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *AdminGroupQuery) AppendObservables(path string, observables []Observable) []Observable {
+	observables = v.Cloud.AppendObservables(ocsf.JoinPath(path, "cloud"), observables)
+	for i := range v.Enrichments {
+		observables = v.Enrichments[i].AppendObservables(ocsf.JoinPath(path, "enrichments"), observables)
+	}
+	observables = v.Group.AppendObservables(ocsf.JoinPath(path, "group"), observables)
+	observables = v.Metadata.AppendObservables(ocsf.JoinPath(path, "metadata"), observables)
+	for i := range v.Osint {
+		observables = v.Osint[i].AppendObservables(ocsf.JoinPath(path, "osint"), observables)
+	}
+	if v.QueryInfo != nil {
+		observables = v.QueryInfo.AppendObservables(ocsf.JoinPath(path, "query_info"), observables)
+	}
+	for i := range v.Users {
+		observables = v.Users[i].AppendObservables(ocsf.JoinPath(path, "users"), observables)
+	}
+
+	return observables
+}
+
+// PopulateObservables adds an observable for every observable attribute and object set on the
+// event to Observables. Observables which are already present are not added again.
+func (v *AdminGroupQuery) PopulateObservables() {
+	seen := make(map[string]bool)
+	for _, observable := range v.Observables {
+		seen[ocsf.ObservableKey(observable.Name, observable.TypeId, observable.Value)] = true
+	}
+
+	for _, observable := range v.AppendObservables("", nil) {
+		key := ocsf.ObservableKey(observable.Name, observable.TypeId, observable.Value)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		v.Observables = append(v.Observables, observable)
+	}
+}
+
+type AdminGroupQueryActivityId int32
+
+const (
+	AdminGroupQueryActivityIdUnknown AdminGroupQueryActivityId = 0
+	AdminGroupQueryActivityIdOther   AdminGroupQueryActivityId = 99
+)
+
+func (a AdminGroupQueryActivityId) Caption() string {
+	switch a {
+	case AdminGroupQueryActivityIdUnknown:
+		return "Unknown"
+	case AdminGroupQueryActivityIdOther:
+		return "Other"
+	}
+	return ""
+}
+
+const (
+	AdminGroupQueryClassUid    = 5009
+	AdminGroupQueryCategoryUid = 5
+)
+
+func NewAdminGroupQuery(activity AdminGroupQueryActivityId) AdminGroupQuery {
+	v := AdminGroupQuery{}
+	v.ActivityId = int32(activity)
+	v.CategoryUid = int32(AdminGroupQueryCategoryUid)
+	v.CategoryName = ocsf.Ptr("Discovery")
+	v.ClassUid = int32(AdminGroupQueryClassUid)
+	v.ClassName = ocsf.Ptr("Admin Group Query")
+	v.TypeUid = int64(AdminGroupQueryClassUid*100 + int(activity))
+	if caption := activity.Caption(); caption != "" {
+		v.ActivityName = ocsf.Ptr(caption)
+		v.TypeName = ocsf.Ptr("Admin Group Query: " + caption)
+	}
+	v.Metadata.Version = "1.4.0"
+	return v
+}
+
 var AdminGroupQueryFields = []arrow.Field{
-	{Name: "activity_id", Type: arrow.PrimitiveTypes.Int32, Nullable: false},
-	{Name: "activity_name", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "category_name", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "category_uid", Type: arrow.PrimitiveTypes.Int32, Nullable: false},
-	{Name: "class_name", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "class_uid", Type: arrow.PrimitiveTypes.Int32, Nullable: false},
-	{Name: "cloud", Type: CloudStruct, Nullable: false},
-	{Name: "count", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "duration", Type: arrow.PrimitiveTypes.Int64, Nullable: true},
-	{Name: "end_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
-	{Name: "enrichments", Type: arrow.ListOf(EnrichmentStruct), Nullable: true},
-	{Name: "group", Type: GroupStruct, Nullable: false},
-	{Name: "message", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "metadata", Type: MetadataStruct, Nullable: false},
-	{Name: "observables", Type: arrow.ListOf(ObservableStruct), Nullable: true},
-	{Name: "osint", Type: arrow.ListOf(OSINTStruct), Nullable: false},
-	{Name: "query_info", Type: QueryInformationStruct, Nullable: true},
-	{Name: "query_result", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "query_result_id", Type: arrow.PrimitiveTypes.Int32, Nullable: false},
-	{Name: "raw_data", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "severity", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "severity_id", Type: arrow.PrimitiveTypes.Int32, Nullable: false},
-	{Name: "start_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
-	{Name: "status", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "status_code", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "status_detail", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "status_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: false},
-	{Name: "timezone_offset", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "type_name", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "type_uid", Type: arrow.PrimitiveTypes.Int64, Nullable: false},
-	{Name: "unmapped", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "users", Type: arrow.ListOf(UserStruct), Nullable: true},
+	{Name: "activity_id", Type: arrow.PrimitiveTypes.Int32, Nullable: false, Metadata: ocsf.AttributeMetadata("Activity ID", "The normalized identifier of the activity that triggered the event.", "required", "")},
+	{Name: "activity_name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Activity", "The event activity name, as defined by the activity_id.", "optional", "")},
+	{Name: "category_name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Category", "The event category name, as defined by category_uid value: <code>Discovery</code>.", "optional", "")},
+	{Name: "category_uid", Type: arrow.PrimitiveTypes.Int32, Nullable: false, Metadata: ocsf.AttributeMetadata("Category ID", "The category unique identifier of the event.", "required", "5: Discovery")},
+	{Name: "class_name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Class", "The event class name, as defined by class_uid value: <code>Admin Group Query</code>.", "optional", "")},
+	{Name: "class_uid", Type: arrow.PrimitiveTypes.Int32, Nullable: false, Metadata: ocsf.AttributeMetadata("Class ID", "The unique identifier of a class. A class describes the attributes available in an event.", "required", "5009: Admin Group Query")},
+	{Name: "cloud", Type: CloudStruct, Nullable: false, Metadata: ocsf.AttributeMetadata("Cloud", "Describes details about the Cloud environment where the event was originally created or logged.", "required", "")},
+	{Name: "count", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Count", "The number of times that events in the same logical group occurred during the event <strong>Start Time</strong> to <strong>End Time</strong> period.", "optional", "")},
+	{Name: "duration", Type: arrow.PrimitiveTypes.Int64, Nullable: true, Metadata: ocsf.AttributeMetadata("Duration Milliseconds", "The event duration or aggregate time, the amount of time the event covers from <code>start_time</code> to <code>end_time</code> in milliseconds.", "optional", "")},
+	{Name: "end_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true, Metadata: ocsf.AttributeMetadata("End Time", "The end time of a time period, or the time of the most recent event included in the aggregate event.", "optional", "")},
+	{Name: "enrichments", Type: arrow.ListOf(EnrichmentStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("Enrichments", "The additional information from an external data source, which is associated with the event or a finding. For example add location information for the IP address in the DNS answers:</p><code>[{\"name\": \"answers.ip\", \"value\": \"92.24.47.250\", \"type\": \"location\", \"data\": {\"city\": \"Socotra\", \"continent\": \"Asia\", \"coordinates\": [-25.4153, 17.0743], \"country\": \"YE\", \"desc\": \"Yemen\"}}]</code>", "optional", "")},
+	{Name: "group", Type: GroupStruct, Nullable: false, Metadata: ocsf.AttributeMetadata("Group", "The administrative group.", "required", "")},
+	{Name: "message", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Message", "The description of the event/finding, as defined by the source.", "optional", "")},
+	{Name: "metadata", Type: MetadataStruct, Nullable: false, Metadata: ocsf.AttributeMetadata("Metadata", "The metadata associated with the event or a finding.", "required", "")},
+	{Name: "observables", Type: arrow.ListOf(ObservableStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("Observables", "The observables associated with the event or a finding.", "optional", "")},
+	{Name: "osint", Type: arrow.ListOf(OSINTStruct), Nullable: false, Metadata: ocsf.AttributeMetadata("OSINT", "The OSINT (Open Source Intelligence) object contains details related to an indicator such as the indicator itself, related indicators, geolocation, registrar information, subdomains, analyst commentary, and other contextual information. This information can be used to further enrich a detection or finding by providing decisioning support to other analysts and engineers.", "required", "")},
+	{Name: "query_info", Type: QueryInformationStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Query Info", "The search details associated with the query request.", "optional", "")},
+	{Name: "query_result", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Query Result", "The result of the query.", "optional", "")},
+	{Name: "query_result_id", Type: arrow.PrimitiveTypes.Int32, Nullable: false, Metadata: ocsf.AttributeMetadata("Query Result ID", "The normalized identifier of the query result.", "required", "")},
+	{Name: "raw_data", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Raw Data", "The raw event/finding data as received from the source.", "optional", "")},
+	{Name: "severity", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Severity", "The event/finding severity, normalized to the caption of the severity_id value. In the case of 'Other', it is defined by the source.", "optional", "")},
+	{Name: "severity_id", Type: arrow.PrimitiveTypes.Int32, Nullable: false, Metadata: ocsf.AttributeMetadata("Severity ID", "<p>The normalized identifier of the event/finding severity.</p>The normalized severity is a measurement the effort and expense required to manage and resolve an event or incident. Smaller numerical values represent lower impact events, and larger numerical values represent higher impact events.", "required", "")},
+	{Name: "start_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true, Metadata: ocsf.AttributeMetadata("Start Time", "The start time of a time period, or the time of the least recent event included in the aggregate event.", "optional", "")},
+	{Name: "status", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Status", "The event status, normalized to the caption of the status_id value. In the case of 'Other', it is defined by the event source.", "optional", "")},
+	{Name: "status_code", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Status Code", "The event status code, as reported by the event source.<br /><br />For example, in a Windows Failed Authentication event, this would be the value of 'Failure Code', e.g. 0x18.", "optional", "")},
+	{Name: "status_detail", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Status Detail", "The status detail contains additional information about the event/finding outcome.", "optional", "")},
+	{Name: "status_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Status ID", "The normalized identifier of the event status.", "optional", "")},
+	{Name: "time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: false, Metadata: ocsf.AttributeMetadata("Event Time", "The normalized event occurrence time or the finding creation time.", "required", "")},
+	{Name: "timezone_offset", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Timezone Offset", "The number of minutes that the reported event <code>time</code> is ahead or behind UTC, in the range -1,080 to +1,080.", "optional", "")},
+	{Name: "type_name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Type Name", "The event/finding type name, as defined by the type_uid.", "optional", "")},
+	{Name: "type_uid", Type: arrow.PrimitiveTypes.Int64, Nullable: false, Metadata: ocsf.AttributeMetadata("Type ID", "The event/finding type ID. It identifies the event's semantics and structure. The value is calculated by the logging system as: <code>class_uid * 100 + activity_id</code>.", "required", "")},
+	{Name: "unmapped", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Unmapped Data", "The attributes that are not mapped to the event schema. The names and values of those attributes are specific to the event source.", "optional", "")},
+	{Name: "users", Type: arrow.ListOf(UserStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("Users", "The users that belong to the administrative group.", "optional", "")},
 }
 
 var AdminGroupQueryStruct = arrow.StructOf(AdminGroupQueryFields...)
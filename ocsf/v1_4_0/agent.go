@@ -2,6 +2,7 @@
 package v1_4_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -36,15 +37,163 @@ func (v *Agent) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *Agent) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *Agent) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	for i := range v.Policies {
+		errs = append(errs, v.Policies[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "policies"), i))...)
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *Agent) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "name":
+		if index >= 0 || rest != "" || v.Name == nil {
+			return nil, false
+		}
+		return *v.Name, true
+	case "policies":
+		if rest == "" {
+			if index < 0 {
+				return v.Policies, v.Policies != nil
+			}
+			return ocsf.Index(v.Policies, index)
+		}
+		if index < 0 || index >= len(v.Policies) {
+			return nil, false
+		}
+		return v.Policies[index].Get(rest)
+	case "type":
+		if index >= 0 || rest != "" || v.Type == nil {
+			return nil, false
+		}
+		return *v.Type, true
+	case "type_id":
+		if index >= 0 || rest != "" || v.TypeId == nil {
+			return nil, false
+		}
+		return *v.TypeId, true
+	case "uid":
+		if index >= 0 || rest != "" || v.Uid == nil {
+			return nil, false
+		}
+		return *v.Uid, true
+	case "uid_alt":
+		if index >= 0 || rest != "" || v.UidAlt == nil {
+			return nil, false
+		}
+		return *v.UidAlt, true
+	case "vendor_name":
+		if index >= 0 || rest != "" || v.VendorName == nil {
+			return nil, false
+		}
+		return *v.VendorName, true
+	case "version":
+		if index >= 0 || rest != "" || v.Version == nil {
+			return nil, false
+		}
+		return *v.Version, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *Agent) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Name, value)
+	case "policies":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Policies, value)
+		}
+		elem, err := ocsf.Element(&v.Policies, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "type":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Type, value)
+	case "type_id":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.TypeId, value)
+	case "uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Uid, value)
+	case "uid_alt":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.UidAlt, value)
+	case "vendor_name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.VendorName, value)
+	case "version":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Version, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *Agent) AppendObservables(path string, observables []Observable) []Observable {
+	for i := range v.Policies {
+		observables = v.Policies[i].AppendObservables(ocsf.JoinPath(path, "policies"), observables)
+	}
+
+	return observables
+}
+
 var AgentFields = []arrow.Field{
-	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "policies", Type: arrow.ListOf(PolicyStruct), Nullable: true},
-	{Name: "type", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "type_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "uid_alt", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "vendor_name", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "version", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Agent Name", "The name of the agent or sensor. For example: <code>AWS SSM Agent</code>.", "optional", "")},
+	{Name: "policies", Type: arrow.ListOf(PolicyStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("Agent Policies", "Describes the various policies that may be applied or enforced by an agent or sensor. E.g., Conditional Access, prevention, auto-update, tamper protection, destination configuration, etc.", "optional", "")},
+	{Name: "type", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Agent Type", "The normalized caption of the type_id value for the agent or sensor. In the case of 'Other' or 'Unknown', it is defined by the event source.", "optional", "")},
+	{Name: "type_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Type ID", "The normalized representation of an agent or sensor. E.g., EDR, vulnerability management, APM, backup & recovery, etc.", "optional", "")},
+	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Agent ID", "The UID of the agent or sensor, sometimes known as a Sensor ID or <code>aid</code>.", "optional", "")},
+	{Name: "uid_alt", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Alternate Agent ID", "An alternative or contextual identifier for the agent or sensor, such as a configuration, organization, or license UID.", "optional", "")},
+	{Name: "vendor_name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Vendor Name", "The company or author who created the agent or sensor. For example: <code>Crowdstrike</code>.", "optional", "")},
+	{Name: "version", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Agent Version", "The semantic version of the agent or sensor, e.g., <code>7.101.50.0</code>.", "optional", "")},
 }
 
 var AgentStruct = arrow.StructOf(AgentFields...)
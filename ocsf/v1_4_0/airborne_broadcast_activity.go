@@ -3,6 +3,7 @@ package v1_4_0
 
 import (
 	"fmt"
+	"slices"
 
 	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
@@ -128,7 +129,7 @@ type AirborneBroadcastActivity struct {
 	UnmannedSystemOperator User `json:"unmanned_system_operator" parquet:"unmanned_system_operator"`
 
 	// Unmapped Data: The attributes that are not mapped to the event schema. The names and values of those attributes are specific to the event source.
-	Unmapped *string `json:"unmapped,omitempty" parquet:"unmapped,optional"`
+	Unmapped *ocsf.RawJSON `json:"unmapped,omitempty" parquet:"unmapped,optional"`
 }
 
 func (v *AirborneBroadcastActivity) Observable() (*int, string) {
@@ -154,47 +155,777 @@ func (v *AirborneBroadcastActivity) ValidateObservables() error {
 	return nil
 }
 
+func (v *AirborneBroadcastActivity) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *AirborneBroadcastActivity) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	if v.Aircraft != nil {
+		errs = append(errs, v.Aircraft.ValidateAt(ocsf.JoinPath(path, "aircraft"))...)
+	}
+	if slices.Contains(v.Metadata.Profiles, "cloud") {
+		errs = append(errs, v.Cloud.ValidateAt(ocsf.JoinPath(path, "cloud"))...)
+	}
+	if v.ConnectionInfo != nil {
+		errs = append(errs, v.ConnectionInfo.ValidateAt(ocsf.JoinPath(path, "connection_info"))...)
+	}
+	if v.DstEndpoint != nil {
+		errs = append(errs, v.DstEndpoint.ValidateAt(ocsf.JoinPath(path, "dst_endpoint"))...)
+	}
+	for i := range v.Enrichments {
+		errs = append(errs, v.Enrichments[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "enrichments"), i))...)
+	}
+	errs = append(errs, v.Metadata.ValidateAt(ocsf.JoinPath(path, "metadata"))...)
+	for i := range v.Observables {
+		errs = append(errs, v.Observables[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "observables"), i))...)
+	}
+	if slices.Contains(v.Metadata.Profiles, "osint") {
+		if len(v.Osint) == 0 {
+			errs.Add(ocsf.JoinPath(path, "osint"), "required attribute is missing")
+		}
+		for i := range v.Osint {
+			errs = append(errs, v.Osint[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "osint"), i))...)
+		}
+	}
+	if v.ProxyEndpoint != nil {
+		errs = append(errs, v.ProxyEndpoint.ValidateAt(ocsf.JoinPath(path, "proxy_endpoint"))...)
+	}
+	if v.SrcEndpoint != nil {
+		errs = append(errs, v.SrcEndpoint.ValidateAt(ocsf.JoinPath(path, "src_endpoint"))...)
+	}
+	if v.Time == 0 {
+		errs.Add(ocsf.JoinPath(path, "time"), "required attribute is missing")
+	}
+	if v.Tls != nil {
+		errs = append(errs, v.Tls.ValidateAt(ocsf.JoinPath(path, "tls"))...)
+	}
+	if v.Traffic != nil {
+		errs = append(errs, v.Traffic.ValidateAt(ocsf.JoinPath(path, "traffic"))...)
+	}
+	errs = append(errs, v.UnmannedAerialSystem.ValidateAt(ocsf.JoinPath(path, "unmanned_aerial_system"))...)
+	if v.UnmannedSystemOperatingArea != nil {
+		errs = append(errs, v.UnmannedSystemOperatingArea.ValidateAt(ocsf.JoinPath(path, "unmanned_system_operating_area"))...)
+	}
+	errs = append(errs, v.UnmannedSystemOperator.ValidateAt(ocsf.JoinPath(path, "unmanned_system_operator"))...)
+	if int64(v.TypeUid) != int64(v.ClassUid)*100+int64(v.ActivityId) {
+		errs.Add(ocsf.JoinPath(path, "type_uid"), "%d does not equal class_uid*100+activity_id (%d)", v.TypeUid, int64(v.ClassUid)*100+int64(v.ActivityId))
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *AirborneBroadcastActivity) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "activity_id":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.ActivityId, true
+	case "activity_name":
+		if index >= 0 || rest != "" || v.ActivityName == nil {
+			return nil, false
+		}
+		return *v.ActivityName, true
+	case "aircraft":
+		if index >= 0 || v.Aircraft == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Aircraft, true
+		}
+		return v.Aircraft.Get(rest)
+	case "category_name":
+		if index >= 0 || rest != "" || v.CategoryName == nil {
+			return nil, false
+		}
+		return *v.CategoryName, true
+	case "category_uid":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.CategoryUid, true
+	case "class_name":
+		if index >= 0 || rest != "" || v.ClassName == nil {
+			return nil, false
+		}
+		return *v.ClassName, true
+	case "class_uid":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.ClassUid, true
+	case "cloud":
+		if index >= 0 {
+			return nil, false
+		}
+		if rest == "" {
+			return &v.Cloud, true
+		}
+		return v.Cloud.Get(rest)
+	case "connection_info":
+		if index >= 0 || v.ConnectionInfo == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.ConnectionInfo, true
+		}
+		return v.ConnectionInfo.Get(rest)
+	case "count":
+		if index >= 0 || rest != "" || v.Count == nil {
+			return nil, false
+		}
+		return *v.Count, true
+	case "dst_endpoint":
+		if index >= 0 || v.DstEndpoint == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.DstEndpoint, true
+		}
+		return v.DstEndpoint.Get(rest)
+	case "duration":
+		if index >= 0 || rest != "" || v.Duration == nil {
+			return nil, false
+		}
+		return *v.Duration, true
+	case "end_time":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.EndTime, true
+	case "enrichments":
+		if rest == "" {
+			if index < 0 {
+				return v.Enrichments, v.Enrichments != nil
+			}
+			return ocsf.Index(v.Enrichments, index)
+		}
+		if index < 0 || index >= len(v.Enrichments) {
+			return nil, false
+		}
+		return v.Enrichments[index].Get(rest)
+	case "message":
+		if index >= 0 || rest != "" || v.Message == nil {
+			return nil, false
+		}
+		return *v.Message, true
+	case "metadata":
+		if index >= 0 {
+			return nil, false
+		}
+		if rest == "" {
+			return &v.Metadata, true
+		}
+		return v.Metadata.Get(rest)
+	case "observables":
+		if rest == "" {
+			if index < 0 {
+				return v.Observables, v.Observables != nil
+			}
+			return ocsf.Index(v.Observables, index)
+		}
+		if index < 0 || index >= len(v.Observables) {
+			return nil, false
+		}
+		return v.Observables[index].Get(rest)
+	case "osint":
+		if rest == "" {
+			if index < 0 {
+				return v.Osint, v.Osint != nil
+			}
+			return ocsf.Index(v.Osint, index)
+		}
+		if index < 0 || index >= len(v.Osint) {
+			return nil, false
+		}
+		return v.Osint[index].Get(rest)
+	case "protocol_name":
+		if index >= 0 || rest != "" || v.ProtocolName == nil {
+			return nil, false
+		}
+		return *v.ProtocolName, true
+	case "proxy_endpoint":
+		if index >= 0 || v.ProxyEndpoint == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.ProxyEndpoint, true
+		}
+		return v.ProxyEndpoint.Get(rest)
+	case "raw_data":
+		if index >= 0 || rest != "" || v.RawData == nil {
+			return nil, false
+		}
+		return *v.RawData, true
+	case "rssi":
+		if index >= 0 || rest != "" || v.Rssi == nil {
+			return nil, false
+		}
+		return *v.Rssi, true
+	case "severity":
+		if index >= 0 || rest != "" || v.Severity == nil {
+			return nil, false
+		}
+		return *v.Severity, true
+	case "severity_id":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.SeverityId, true
+	case "src_endpoint":
+		if index >= 0 || v.SrcEndpoint == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.SrcEndpoint, true
+		}
+		return v.SrcEndpoint.Get(rest)
+	case "start_time":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.StartTime, true
+	case "status":
+		if index >= 0 || rest != "" || v.Status == nil {
+			return nil, false
+		}
+		return *v.Status, true
+	case "status_code":
+		if index >= 0 || rest != "" || v.StatusCode == nil {
+			return nil, false
+		}
+		return *v.StatusCode, true
+	case "status_detail":
+		if index >= 0 || rest != "" || v.StatusDetail == nil {
+			return nil, false
+		}
+		return *v.StatusDetail, true
+	case "status_id":
+		if index >= 0 || rest != "" || v.StatusId == nil {
+			return nil, false
+		}
+		return *v.StatusId, true
+	case "time":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.Time, true
+	case "timezone_offset":
+		if index >= 0 || rest != "" || v.TimezoneOffset == nil {
+			return nil, false
+		}
+		return *v.TimezoneOffset, true
+	case "tls":
+		if index >= 0 || v.Tls == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Tls, true
+		}
+		return v.Tls.Get(rest)
+	case "traffic":
+		if index >= 0 || v.Traffic == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Traffic, true
+		}
+		return v.Traffic.Get(rest)
+	case "type_name":
+		if index >= 0 || rest != "" || v.TypeName == nil {
+			return nil, false
+		}
+		return *v.TypeName, true
+	case "type_uid":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.TypeUid, true
+	case "unmanned_aerial_system":
+		if index >= 0 {
+			return nil, false
+		}
+		if rest == "" {
+			return &v.UnmannedAerialSystem, true
+		}
+		return v.UnmannedAerialSystem.Get(rest)
+	case "unmanned_system_operating_area":
+		if index >= 0 || v.UnmannedSystemOperatingArea == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.UnmannedSystemOperatingArea, true
+		}
+		return v.UnmannedSystemOperatingArea.Get(rest)
+	case "unmanned_system_operator":
+		if index >= 0 {
+			return nil, false
+		}
+		if rest == "" {
+			return &v.UnmannedSystemOperator, true
+		}
+		return v.UnmannedSystemOperator.Get(rest)
+	case "unmapped":
+		if index >= 0 || rest != "" || v.Unmapped == nil {
+			return nil, false
+		}
+		return *v.Unmapped, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *AirborneBroadcastActivity) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "activity_id":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.ActivityId, value)
+	case "activity_name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.ActivityName, value)
+	case "aircraft":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Aircraft, value)
+		}
+		if v.Aircraft == nil {
+			v.Aircraft = &Aircraft{}
+		}
+		return v.Aircraft.Set(rest, value)
+	case "category_name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.CategoryName, value)
+	case "category_uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.CategoryUid, value)
+	case "class_name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.ClassName, value)
+	case "class_uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.ClassUid, value)
+	case "cloud":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetRequired(&v.Cloud, value)
+		}
+		return v.Cloud.Set(rest, value)
+	case "connection_info":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.ConnectionInfo, value)
+		}
+		if v.ConnectionInfo == nil {
+			v.ConnectionInfo = &NetworkConnectionInformation{}
+		}
+		return v.ConnectionInfo.Set(rest, value)
+	case "count":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Count, value)
+	case "dst_endpoint":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.DstEndpoint, value)
+		}
+		if v.DstEndpoint == nil {
+			v.DstEndpoint = &NetworkEndpoint{}
+		}
+		return v.DstEndpoint.Set(rest, value)
+	case "duration":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Duration, value)
+	case "end_time":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.EndTime, value)
+	case "enrichments":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Enrichments, value)
+		}
+		elem, err := ocsf.Element(&v.Enrichments, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "message":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Message, value)
+	case "metadata":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetRequired(&v.Metadata, value)
+		}
+		return v.Metadata.Set(rest, value)
+	case "observables":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Observables, value)
+		}
+		elem, err := ocsf.Element(&v.Observables, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "osint":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Osint, value)
+		}
+		elem, err := ocsf.Element(&v.Osint, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "protocol_name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.ProtocolName, value)
+	case "proxy_endpoint":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.ProxyEndpoint, value)
+		}
+		if v.ProxyEndpoint == nil {
+			v.ProxyEndpoint = &NetworkProxyEndpoint{}
+		}
+		return v.ProxyEndpoint.Set(rest, value)
+	case "raw_data":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.RawData, value)
+	case "rssi":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Rssi, value)
+	case "severity":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Severity, value)
+	case "severity_id":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.SeverityId, value)
+	case "src_endpoint":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.SrcEndpoint, value)
+		}
+		if v.SrcEndpoint == nil {
+			v.SrcEndpoint = &NetworkEndpoint{}
+		}
+		return v.SrcEndpoint.Set(rest, value)
+	case "start_time":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.StartTime, value)
+	case "status":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Status, value)
+	case "status_code":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.StatusCode, value)
+	case "status_detail":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.StatusDetail, value)
+	case "status_id":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.StatusId, value)
+	case "time":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.Time, value)
+	case "timezone_offset":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.TimezoneOffset, value)
+	case "tls":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Tls, value)
+		}
+		if v.Tls == nil {
+			v.Tls = &TransportLayerSecurityTLS{}
+		}
+		return v.Tls.Set(rest, value)
+	case "traffic":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Traffic, value)
+		}
+		if v.Traffic == nil {
+			v.Traffic = &NetworkTraffic{}
+		}
+		return v.Traffic.Set(rest, value)
+	case "type_name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.TypeName, value)
+	case "type_uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.TypeUid, value)
+	case "unmanned_aerial_system":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetRequired(&v.UnmannedAerialSystem, value)
+		}
+		return v.UnmannedAerialSystem.Set(rest, value)
+	case "unmanned_system_operating_area":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.UnmannedSystemOperatingArea, value)
+		}
+		if v.UnmannedSystemOperatingArea == nil {
+			v.UnmannedSystemOperatingArea = &UnmannedSystemOperatingArea{}
+		}
+		return v.UnmannedSystemOperatingArea.Set(rest, value)
+	case "unmanned_system_operator":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetRequired(&v.UnmannedSystemOperator, value)
+		}
+		return v.UnmannedSystemOperator.Set(rest, value)
+	case "unmapped":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Unmapped, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *AirborneBroadcastActivity) AppendObservables(path string, observables []Observable) []Observable {
+	if v.Aircraft != nil {
+		observables = v.Aircraft.AppendObservables(ocsf.JoinPath(path, "aircraft"), observables)
+	}
+	observables = v.Cloud.AppendObservables(ocsf.JoinPath(path, "cloud"), observables)
+	if v.ConnectionInfo != nil {
+		observables = v.ConnectionInfo.AppendObservables(ocsf.JoinPath(path, "connection_info"), observables)
+	}
+	if v.DstEndpoint != nil {
+		observables = v.DstEndpoint.AppendObservables(ocsf.JoinPath(path, "dst_endpoint"), observables)
+	}
+	for i := range v.Enrichments {
+		observables = v.Enrichments[i].AppendObservables(ocsf.JoinPath(path, "enrichments"), observables)
+	}
+	observables = v.Metadata.AppendObservables(ocsf.JoinPath(path, "metadata"), observables)
+	for i := range v.Osint {
+		observables = v.Osint[i].AppendObservables(ocsf.JoinPath(path, "osint"), observables)
+	}
+	if v.ProxyEndpoint != nil {
+		observables = v.ProxyEndpoint.AppendObservables(ocsf.JoinPath(path, "proxy_endpoint"), observables)
+	}
+	if v.SrcEndpoint != nil {
+		observables = v.SrcEndpoint.AppendObservables(ocsf.JoinPath(path, "src_endpoint"), observables)
+	}
+	if v.Tls != nil {
+		observables = v.Tls.AppendObservables(ocsf.JoinPath(path, "tls"), observables)
+	}
+	if v.Traffic != nil {
+		observables = v.Traffic.AppendObservables(ocsf.JoinPath(path, "traffic"), observables)
+	}
+	observables = v.UnmannedAerialSystem.AppendObservables(ocsf.JoinPath(path, "unmanned_aerial_system"), observables)
+	if v.UnmannedSystemOperatingArea != nil {
+		observables = v.UnmannedSystemOperatingArea.AppendObservables(ocsf.JoinPath(path, "unmanned_system_operating_area"), observables)
+	}
+	observables = v.UnmannedSystemOperator.AppendObservables(ocsf.JoinPath(path, "unmanned_system_operator"), observables)
+
+	return observables
+}
+
+// PopulateObservables adds an observable for every observable attribute and object set on the
+// event to Observables. Observables which are already present are not added again.
+func (v *AirborneBroadcastActivity) PopulateObservables() {
+	seen := make(map[string]bool)
+	for _, observable := range v.Observables {
+		seen[ocsf.ObservableKey(observable.Name, observable.TypeId, observable.Value)] = true
+	}
+
+	for _, observable := range v.AppendObservables("", nil) {
+		key := ocsf.ObservableKey(observable.Name, observable.TypeId, observable.Value)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		v.Observables = append(v.Observables, observable)
+	}
+}
+
+type AirborneBroadcastActivityActivityId int32
+
+const (
+	AirborneBroadcastActivityActivityIdUnknown AirborneBroadcastActivityActivityId = 0
+	AirborneBroadcastActivityActivityIdOther   AirborneBroadcastActivityActivityId = 99
+)
+
+func (a AirborneBroadcastActivityActivityId) Caption() string {
+	switch a {
+	case AirborneBroadcastActivityActivityIdUnknown:
+		return "Unknown"
+	case AirborneBroadcastActivityActivityIdOther:
+		return "Other"
+	}
+	return ""
+}
+
+const (
+	AirborneBroadcastActivityClassUid    = 8002
+	AirborneBroadcastActivityCategoryUid = 8
+)
+
+func NewAirborneBroadcastActivity(activity AirborneBroadcastActivityActivityId) AirborneBroadcastActivity {
+	v := AirborneBroadcastActivity{}
+	v.ActivityId = int32(activity)
+	v.CategoryUid = int32(AirborneBroadcastActivityCategoryUid)
+	v.CategoryName = ocsf.Ptr("Unmanned Systems")
+	v.ClassUid = int32(AirborneBroadcastActivityClassUid)
+	v.ClassName = ocsf.Ptr("Airborne Broadcast Activity")
+	v.TypeUid = int64(AirborneBroadcastActivityClassUid*100 + int(activity))
+	if caption := activity.Caption(); caption != "" {
+		v.ActivityName = ocsf.Ptr(caption)
+		v.TypeName = ocsf.Ptr("Airborne Broadcast Activity: " + caption)
+	}
+	v.Metadata.Version = "1.4.0"
+	return v
+}
+
 var AirborneBroadcastActivityFields = []arrow.Field{
-	{Name: "activity_id", Type: arrow.PrimitiveTypes.Int32, Nullable: false},
-	{Name: "activity_name", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "aircraft", Type: AircraftStruct, Nullable: true},
-	{Name: "category_name", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "category_uid", Type: arrow.PrimitiveTypes.Int32, Nullable: false},
-	{Name: "class_name", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "class_uid", Type: arrow.PrimitiveTypes.Int32, Nullable: false},
-	{Name: "cloud", Type: CloudStruct, Nullable: false},
-	{Name: "connection_info", Type: NetworkConnectionInformationStruct, Nullable: true},
-	{Name: "count", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "dst_endpoint", Type: NetworkEndpointStruct, Nullable: true},
-	{Name: "duration", Type: arrow.PrimitiveTypes.Int64, Nullable: true},
-	{Name: "end_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
-	{Name: "enrichments", Type: arrow.ListOf(EnrichmentStruct), Nullable: true},
-	{Name: "message", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "metadata", Type: MetadataStruct, Nullable: false},
-	{Name: "observables", Type: arrow.ListOf(ObservableStruct), Nullable: true},
-	{Name: "osint", Type: arrow.ListOf(OSINTStruct), Nullable: false},
-	{Name: "protocol_name", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "proxy_endpoint", Type: NetworkProxyEndpointStruct, Nullable: true},
-	{Name: "raw_data", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "rssi", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "severity", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "severity_id", Type: arrow.PrimitiveTypes.Int32, Nullable: false},
-	{Name: "src_endpoint", Type: NetworkEndpointStruct, Nullable: true},
-	{Name: "start_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
-	{Name: "status", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "status_code", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "status_detail", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "status_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: false},
-	{Name: "timezone_offset", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "tls", Type: TransportLayerSecurityTLSStruct, Nullable: true},
-	{Name: "traffic", Type: NetworkTrafficStruct, Nullable: true},
-	{Name: "type_name", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "type_uid", Type: arrow.PrimitiveTypes.Int64, Nullable: false},
-	{Name: "unmanned_aerial_system", Type: UnmannedAerialSystemStruct, Nullable: false},
-	{Name: "unmanned_system_operating_area", Type: UnmannedSystemOperatingAreaStruct, Nullable: true},
-	{Name: "unmanned_system_operator", Type: UserStruct, Nullable: false},
-	{Name: "unmapped", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "activity_id", Type: arrow.PrimitiveTypes.Int32, Nullable: false, Metadata: ocsf.AttributeMetadata("Activity ID", "The normalized identifier of the activity that triggered the event.", "required", "")},
+	{Name: "activity_name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Activity", "The event activity name, as defined by the activity_id.", "optional", "")},
+	{Name: "aircraft", Type: AircraftStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Aircraft", "The Aircraft object represents any aircraft or otherwise airborne asset such as an unmanned system, airplane, balloon, spacecraft, or otherwise. The Aircraft object is intended to normalized data captured or otherwise logged from active radar, passive radar, multi-spectral systems, or the Automatic Dependant Broadcast - Surveillance (ADS-B), and/or Mode S systems.", "optional", "")},
+	{Name: "category_name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Category", "The event category name, as defined by category_uid value: <code>Unmanned Systems</code>.", "optional", "")},
+	{Name: "category_uid", Type: arrow.PrimitiveTypes.Int32, Nullable: false, Metadata: ocsf.AttributeMetadata("Category ID", "The category unique identifier of the event.", "required", "8: Unmanned Systems")},
+	{Name: "class_name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Class", "The event class name, as defined by class_uid value: <code>Airborne Broadcast Activity</code>.", "optional", "")},
+	{Name: "class_uid", Type: arrow.PrimitiveTypes.Int32, Nullable: false, Metadata: ocsf.AttributeMetadata("Class ID", "The unique identifier of a class. A class describes the attributes available in an event.", "required", "8002: Airborne Broadcast Activity")},
+	{Name: "cloud", Type: CloudStruct, Nullable: false, Metadata: ocsf.AttributeMetadata("Cloud", "Describes details about the Cloud environment where the event was originally created or logged.", "required", "")},
+	{Name: "connection_info", Type: NetworkConnectionInformationStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Connection Info", "The network connection information.", "optional", "")},
+	{Name: "count", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Count", "The number of times that events in the same logical group occurred during the event <strong>Start Time</strong> to <strong>End Time</strong> period.", "optional", "")},
+	{Name: "dst_endpoint", Type: NetworkEndpointStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Destination Endpoint", "The destination network endpoint for the ADS-B system, if telemetry is being remotely broadcasted.", "optional", "")},
+	{Name: "duration", Type: arrow.PrimitiveTypes.Int64, Nullable: true, Metadata: ocsf.AttributeMetadata("Duration Milliseconds", "The event duration or aggregate time, the amount of time the event covers from <code>start_time</code> to <code>end_time</code> in milliseconds.", "optional", "")},
+	{Name: "end_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true, Metadata: ocsf.AttributeMetadata("End Time", "The end time of a time period, or the time of the most recent event included in the aggregate event.", "optional", "")},
+	{Name: "enrichments", Type: arrow.ListOf(EnrichmentStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("Enrichments", "The additional information from an external data source, which is associated with the event or a finding. For example add location information for the IP address in the DNS answers:</p><code>[{\"name\": \"answers.ip\", \"value\": \"92.24.47.250\", \"type\": \"location\", \"data\": {\"city\": \"Socotra\", \"continent\": \"Asia\", \"coordinates\": [-25.4153, 17.0743], \"country\": \"YE\", \"desc\": \"Yemen\"}}]</code>", "optional", "")},
+	{Name: "message", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Message", "The description of the event/finding, as defined by the source.", "optional", "")},
+	{Name: "metadata", Type: MetadataStruct, Nullable: false, Metadata: ocsf.AttributeMetadata("Metadata", "The metadata associated with the event or a finding.", "required", "")},
+	{Name: "observables", Type: arrow.ListOf(ObservableStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("Observables", "The observables associated with the event or a finding.", "optional", "")},
+	{Name: "osint", Type: arrow.ListOf(OSINTStruct), Nullable: false, Metadata: ocsf.AttributeMetadata("OSINT", "The OSINT (Open Source Intelligence) object contains details related to an indicator such as the indicator itself, related indicators, geolocation, registrar information, subdomains, analyst commentary, and other contextual information. This information can be used to further enrich a detection or finding by providing decisioning support to other analysts and engineers.", "required", "")},
+	{Name: "protocol_name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("ADS-B Protocol", "The specific protocol associated with the ADS-B system. E.g. <code>ADS-B UAT</code> or <code>ADS-B ES</code>.", "optional", "")},
+	{Name: "proxy_endpoint", Type: NetworkProxyEndpointStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Proxy Endpoint", "The proxy (server) in a network connection.", "optional", "")},
+	{Name: "raw_data", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Raw Data", "The raw event/finding data as received from the source.", "optional", "")},
+	{Name: "rssi", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("RSSI", "Recent average RSSI (signal power) measured in dbFS. This value will always be negative, e.g., <code>-87.13</code>.", "optional", "")},
+	{Name: "severity", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Severity", "The event/finding severity, normalized to the caption of the severity_id value. In the case of 'Other', it is defined by the source.", "optional", "")},
+	{Name: "severity_id", Type: arrow.PrimitiveTypes.Int32, Nullable: false, Metadata: ocsf.AttributeMetadata("Severity ID", "<p>The normalized identifier of the event/finding severity.</p>The normalized severity is a measurement the effort and expense required to manage and resolve an event or incident. Smaller numerical values represent lower impact events, and larger numerical values represent higher impact events.", "required", "")},
+	{Name: "src_endpoint", Type: NetworkEndpointStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Source Endpoint", "The source network endpoint for the ADS-B system.", "optional", "")},
+	{Name: "start_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true, Metadata: ocsf.AttributeMetadata("Start Time", "The start time of a time period, or the time of the least recent event included in the aggregate event.", "optional", "")},
+	{Name: "status", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Status", "The event status, normalized to the caption of the status_id value. In the case of 'Other', it is defined by the event source.", "optional", "")},
+	{Name: "status_code", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Status Code", "The event status code, as reported by the event source.<br /><br />For example, in a Windows Failed Authentication event, this would be the value of 'Failure Code', e.g. 0x18.", "optional", "")},
+	{Name: "status_detail", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Status Detail", "The status detail contains additional information about the event/finding outcome.", "optional", "")},
+	{Name: "status_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Status ID", "The normalized identifier of the event status.", "optional", "")},
+	{Name: "time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: false, Metadata: ocsf.AttributeMetadata("Event Time", "The normalized event occurrence time or the finding creation time.", "required", "")},
+	{Name: "timezone_offset", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Timezone Offset", "The number of minutes that the reported event <code>time</code> is ahead or behind UTC, in the range -1,080 to +1,080.", "optional", "")},
+	{Name: "tls", Type: TransportLayerSecurityTLSStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("TLS", "The Transport Layer Security (TLS) attributes.", "optional", "")},
+	{Name: "traffic", Type: NetworkTrafficStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Traffic", "Traffic refers to the amount of data transmitted from a ADS-B remote monitoring system at a given point of time. Ex: <code>bytes_in</code> and <code>bytes_out</code>.", "optional", "")},
+	{Name: "type_name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Type Name", "The event/finding type name, as defined by the type_uid.", "optional", "")},
+	{Name: "type_uid", Type: arrow.PrimitiveTypes.Int64, Nullable: false, Metadata: ocsf.AttributeMetadata("Type ID", "The event/finding type ID. It identifies the event's semantics and structure. The value is calculated by the logging system as: <code>class_uid * 100 + activity_id</code>.", "required", "")},
+	{Name: "unmanned_aerial_system", Type: UnmannedAerialSystemStruct, Nullable: false, Metadata: ocsf.AttributeMetadata("Unmanned Aerial System", "The Unmanned Aerial System object describes the characteristics, Position Location Information (PLI), and other metadata of Unmanned Aerial Systems (UAS) and other unmanned and drone systems used in Remote ID. Remote ID is defined in the Standard Specification for Remote ID and Tracking (ASTM Designation: F3411-22a) <a target='_blank' href='https://cdn.standards.iteh.ai/samples/112830/71297057ac42432880a203654f213709/ASTM-F3411-22a.pdf'>ASTM F3411-22a</a>.", "required", "")},
+	{Name: "unmanned_system_operating_area", Type: UnmannedSystemOperatingAreaStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("UAS Operating Area", "The UAS Operating Area object describes details about a precise area of operations for a UAS flight or mission.", "optional", "")},
+	{Name: "unmanned_system_operator", Type: UserStruct, Nullable: false, Metadata: ocsf.AttributeMetadata("Unmanned Systems Operator", "The human or machine operator of an Unmanned System.", "required", "")},
+	{Name: "unmapped", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Unmapped Data", "The attributes that are not mapped to the event schema. The names and values of those attributes are specific to the event source.", "optional", "")},
 }
 
 var AirborneBroadcastActivityStruct = arrow.StructOf(AirborneBroadcastActivityFields...)
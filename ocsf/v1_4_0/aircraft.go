@@ -2,6 +2,7 @@
 package v1_4_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -42,17 +43,178 @@ func (v *Aircraft) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *Aircraft) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *Aircraft) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	if v.Location != nil {
+		errs = append(errs, v.Location.ValidateAt(ocsf.JoinPath(path, "location"))...)
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *Aircraft) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "location":
+		if index >= 0 || v.Location == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Location, true
+		}
+		return v.Location.Get(rest)
+	case "model":
+		if index >= 0 || rest != "" || v.Model == nil {
+			return nil, false
+		}
+		return *v.Model, true
+	case "name":
+		if index >= 0 || rest != "" || v.Name == nil {
+			return nil, false
+		}
+		return *v.Name, true
+	case "serial_number":
+		if index >= 0 || rest != "" || v.SerialNumber == nil {
+			return nil, false
+		}
+		return *v.SerialNumber, true
+	case "speed":
+		if index >= 0 || rest != "" || v.Speed == nil {
+			return nil, false
+		}
+		return *v.Speed, true
+	case "speed_accuracy":
+		if index >= 0 || rest != "" || v.SpeedAccuracy == nil {
+			return nil, false
+		}
+		return *v.SpeedAccuracy, true
+	case "track_direction":
+		if index >= 0 || rest != "" || v.TrackDirection == nil {
+			return nil, false
+		}
+		return *v.TrackDirection, true
+	case "uid":
+		if index >= 0 || rest != "" || v.Uid == nil {
+			return nil, false
+		}
+		return *v.Uid, true
+	case "uid_alt":
+		if index >= 0 || rest != "" || v.UidAlt == nil {
+			return nil, false
+		}
+		return *v.UidAlt, true
+	case "vertical_speed":
+		if index >= 0 || rest != "" || v.VerticalSpeed == nil {
+			return nil, false
+		}
+		return *v.VerticalSpeed, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *Aircraft) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "location":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Location, value)
+		}
+		if v.Location == nil {
+			v.Location = &GeoLocation{}
+		}
+		return v.Location.Set(rest, value)
+	case "model":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Model, value)
+	case "name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Name, value)
+	case "serial_number":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.SerialNumber, value)
+	case "speed":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Speed, value)
+	case "speed_accuracy":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.SpeedAccuracy, value)
+	case "track_direction":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.TrackDirection, value)
+	case "uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Uid, value)
+	case "uid_alt":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.UidAlt, value)
+	case "vertical_speed":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.VerticalSpeed, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *Aircraft) AppendObservables(path string, observables []Observable) []Observable {
+	if v.Location != nil {
+		observables = v.Location.AppendObservables(ocsf.JoinPath(path, "location"), observables)
+	}
+
+	return observables
+}
+
 var AircraftFields = []arrow.Field{
-	{Name: "location", Type: GeoLocationStruct, Nullable: true},
-	{Name: "model", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "serial_number", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "speed", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "speed_accuracy", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "track_direction", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "uid_alt", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "vertical_speed", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "location", Type: GeoLocationStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Geo Location", "The detailed geographical location usually associated with an IP address.", "optional", "")},
+	{Name: "model", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Model", "The model name of the aircraft or unmanned system.", "optional", "")},
+	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Name", "The name of the aircraft, such as the such as the flight name or callsign.", "optional", "")},
+	{Name: "serial_number", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Serial Number", "The serial number of the aircraft.", "optional", "")},
+	{Name: "speed", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Speed", "Ground speed of flight. This value is provided in meters per second with a minimum resolution of 0.25 m/s. Special Values: <code>Invalid</code>, <code>No Value</code>, or <code>Unknown: 255 m/s</code>.", "optional", "")},
+	{Name: "speed_accuracy", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Speed Accuracy", "Provides quality/containment on horizontal ground speed. Measured in meters/second.", "optional", "")},
+	{Name: "track_direction", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Track Direction", "Direction of flight expressed as a “True North-based” ground track angle. This value is provided in clockwise degrees with a minimum resolution of 1 degree. If aircraft is not moving horizontally, use the “Unknown” value", "optional", "")},
+	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Unique ID", "The primary identification identifier for an aircraft, such as the 24-bit International Civil Aviation Organization (ICAO) identifier of the aircraft, as 6 hex digits.", "optional", "")},
+	{Name: "uid_alt", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Alternate ID", "A secondary identification identifier for an aircraft, such as the 4-digit squawk (octal representation).", "optional", "")},
+	{Name: "vertical_speed", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Vertical Speed", "Vertical speed upward relative to the WGS-84 datum, measured in meters per second. Special Values: <code>Invalid</code>, <code>No Value</code>, or <code>Unknown: 63 m/s</code>.", "optional", "")},
 }
 
 var AircraftStruct = arrow.StructOf(AircraftFields...)
@@ -2,6 +2,7 @@
 package v1_4_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -33,14 +34,130 @@ func (v *Analytic) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *Analytic) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *Analytic) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *Analytic) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "category":
+		if index >= 0 || rest != "" || v.Category == nil {
+			return nil, false
+		}
+		return *v.Category, true
+	case "desc":
+		if index >= 0 || rest != "" || v.Desc == nil {
+			return nil, false
+		}
+		return *v.Desc, true
+	case "name":
+		if index >= 0 || rest != "" || v.Name == nil {
+			return nil, false
+		}
+		return *v.Name, true
+	case "type":
+		if index >= 0 || rest != "" || v.Type == nil {
+			return nil, false
+		}
+		return *v.Type, true
+	case "type_id":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.TypeId, true
+	case "uid":
+		if index >= 0 || rest != "" || v.Uid == nil {
+			return nil, false
+		}
+		return *v.Uid, true
+	case "version":
+		if index >= 0 || rest != "" || v.Version == nil {
+			return nil, false
+		}
+		return *v.Version, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *Analytic) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "category":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Category, value)
+	case "desc":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Desc, value)
+	case "name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Name, value)
+	case "type":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Type, value)
+	case "type_id":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.TypeId, value)
+	case "uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Uid, value)
+	case "version":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Version, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *Analytic) AppendObservables(path string, observables []Observable) []Observable {
+
+	return observables
+}
+
 var AnalyticFields = []arrow.Field{
-	{Name: "category", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "desc", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "type", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "type_id", Type: arrow.PrimitiveTypes.Int32, Nullable: false},
-	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "version", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "category", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Category", "The analytic category.", "optional", "")},
+	{Name: "desc", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Description", "The description of the analytic that generated the finding.", "optional", "")},
+	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Name", "The name of the analytic that generated the finding.", "optional", "")},
+	{Name: "type", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Type", "The analytic type.", "optional", "")},
+	{Name: "type_id", Type: arrow.PrimitiveTypes.Int32, Nullable: false, Metadata: ocsf.AttributeMetadata("Type ID", "The analytic type ID.", "required", "")},
+	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Unique ID", "The unique identifier of the analytic that generated the finding.", "optional", "")},
+	{Name: "version", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Version", "The analytic version. For example: <code>1.1</code>.", "optional", "")},
 }
 
 var AnalyticStruct = arrow.StructOf(AnalyticFields...)
@@ -3,6 +3,7 @@ package v1_4_0
 
 import (
 	"fmt"
+	"slices"
 
 	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
@@ -113,7 +114,7 @@ type APIActivity struct {
 	TypeUid int64 `json:"type_uid" parquet:"type_uid"`
 
 	// Unmapped Data: The attributes that are not mapped to the event schema. The names and values of those attributes are specific to the event source.
-	Unmapped *string `json:"unmapped,omitempty" parquet:"unmapped,optional"`
+	Unmapped *ocsf.RawJSON `json:"unmapped,omitempty" parquet:"unmapped,optional"`
 }
 
 func (v *APIActivity) Observable() (*int, string) {
@@ -139,42 +140,697 @@ func (v *APIActivity) ValidateObservables() error {
 	return nil
 }
 
+var APIActivityActivityIdCaptions = map[int32]string{
+	0:  "Unknown",
+	1:  "Create",
+	2:  "Read",
+	3:  "Update",
+	4:  "Delete",
+	99: "Other",
+}
+
+func (v *APIActivity) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *APIActivity) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	ocsf.CheckEnum(&errs, ocsf.JoinPath(path, "activity_id"), v.ActivityId, APIActivityActivityIdCaptions)
+	errs = append(errs, v.Actor.ValidateAt(ocsf.JoinPath(path, "actor"))...)
+	errs = append(errs, v.Api.ValidateAt(ocsf.JoinPath(path, "api"))...)
+	if slices.Contains(v.Metadata.Profiles, "cloud") {
+		errs = append(errs, v.Cloud.ValidateAt(ocsf.JoinPath(path, "cloud"))...)
+	}
+	if v.DstEndpoint != nil {
+		errs = append(errs, v.DstEndpoint.ValidateAt(ocsf.JoinPath(path, "dst_endpoint"))...)
+	}
+	for i := range v.Enrichments {
+		errs = append(errs, v.Enrichments[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "enrichments"), i))...)
+	}
+	if v.HttpRequest != nil {
+		errs = append(errs, v.HttpRequest.ValidateAt(ocsf.JoinPath(path, "http_request"))...)
+	}
+	if v.HttpResponse != nil {
+		errs = append(errs, v.HttpResponse.ValidateAt(ocsf.JoinPath(path, "http_response"))...)
+	}
+	errs = append(errs, v.Metadata.ValidateAt(ocsf.JoinPath(path, "metadata"))...)
+	for i := range v.Observables {
+		errs = append(errs, v.Observables[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "observables"), i))...)
+	}
+	if slices.Contains(v.Metadata.Profiles, "osint") {
+		if len(v.Osint) == 0 {
+			errs.Add(ocsf.JoinPath(path, "osint"), "required attribute is missing")
+		}
+		for i := range v.Osint {
+			errs = append(errs, v.Osint[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "osint"), i))...)
+		}
+	}
+	for i := range v.Resources {
+		errs = append(errs, v.Resources[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "resources"), i))...)
+	}
+	errs = append(errs, v.SrcEndpoint.ValidateAt(ocsf.JoinPath(path, "src_endpoint"))...)
+	if v.Time == 0 {
+		errs.Add(ocsf.JoinPath(path, "time"), "required attribute is missing")
+	}
+	if int64(v.TypeUid) != int64(v.ClassUid)*100+int64(v.ActivityId) {
+		errs.Add(ocsf.JoinPath(path, "type_uid"), "%d does not equal class_uid*100+activity_id (%d)", v.TypeUid, int64(v.ClassUid)*100+int64(v.ActivityId))
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *APIActivity) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "activity_id":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.ActivityId, true
+	case "activity_name":
+		if index >= 0 || rest != "" || v.ActivityName == nil {
+			return nil, false
+		}
+		return *v.ActivityName, true
+	case "actor":
+		if index >= 0 {
+			return nil, false
+		}
+		if rest == "" {
+			return &v.Actor, true
+		}
+		return v.Actor.Get(rest)
+	case "api":
+		if index >= 0 {
+			return nil, false
+		}
+		if rest == "" {
+			return &v.Api, true
+		}
+		return v.Api.Get(rest)
+	case "category_name":
+		if index >= 0 || rest != "" || v.CategoryName == nil {
+			return nil, false
+		}
+		return *v.CategoryName, true
+	case "category_uid":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.CategoryUid, true
+	case "class_name":
+		if index >= 0 || rest != "" || v.ClassName == nil {
+			return nil, false
+		}
+		return *v.ClassName, true
+	case "class_uid":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.ClassUid, true
+	case "cloud":
+		if index >= 0 {
+			return nil, false
+		}
+		if rest == "" {
+			return &v.Cloud, true
+		}
+		return v.Cloud.Get(rest)
+	case "count":
+		if index >= 0 || rest != "" || v.Count == nil {
+			return nil, false
+		}
+		return *v.Count, true
+	case "dst_endpoint":
+		if index >= 0 || v.DstEndpoint == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.DstEndpoint, true
+		}
+		return v.DstEndpoint.Get(rest)
+	case "duration":
+		if index >= 0 || rest != "" || v.Duration == nil {
+			return nil, false
+		}
+		return *v.Duration, true
+	case "end_time":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.EndTime, true
+	case "enrichments":
+		if rest == "" {
+			if index < 0 {
+				return v.Enrichments, v.Enrichments != nil
+			}
+			return ocsf.Index(v.Enrichments, index)
+		}
+		if index < 0 || index >= len(v.Enrichments) {
+			return nil, false
+		}
+		return v.Enrichments[index].Get(rest)
+	case "http_request":
+		if index >= 0 || v.HttpRequest == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.HttpRequest, true
+		}
+		return v.HttpRequest.Get(rest)
+	case "http_response":
+		if index >= 0 || v.HttpResponse == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.HttpResponse, true
+		}
+		return v.HttpResponse.Get(rest)
+	case "message":
+		if index >= 0 || rest != "" || v.Message == nil {
+			return nil, false
+		}
+		return *v.Message, true
+	case "metadata":
+		if index >= 0 {
+			return nil, false
+		}
+		if rest == "" {
+			return &v.Metadata, true
+		}
+		return v.Metadata.Get(rest)
+	case "observables":
+		if rest == "" {
+			if index < 0 {
+				return v.Observables, v.Observables != nil
+			}
+			return ocsf.Index(v.Observables, index)
+		}
+		if index < 0 || index >= len(v.Observables) {
+			return nil, false
+		}
+		return v.Observables[index].Get(rest)
+	case "osint":
+		if rest == "" {
+			if index < 0 {
+				return v.Osint, v.Osint != nil
+			}
+			return ocsf.Index(v.Osint, index)
+		}
+		if index < 0 || index >= len(v.Osint) {
+			return nil, false
+		}
+		return v.Osint[index].Get(rest)
+	case "raw_data":
+		if index >= 0 || rest != "" || v.RawData == nil {
+			return nil, false
+		}
+		return *v.RawData, true
+	case "resources":
+		if rest == "" {
+			if index < 0 {
+				return v.Resources, v.Resources != nil
+			}
+			return ocsf.Index(v.Resources, index)
+		}
+		if index < 0 || index >= len(v.Resources) {
+			return nil, false
+		}
+		return v.Resources[index].Get(rest)
+	case "severity":
+		if index >= 0 || rest != "" || v.Severity == nil {
+			return nil, false
+		}
+		return *v.Severity, true
+	case "severity_id":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.SeverityId, true
+	case "src_endpoint":
+		if index >= 0 {
+			return nil, false
+		}
+		if rest == "" {
+			return &v.SrcEndpoint, true
+		}
+		return v.SrcEndpoint.Get(rest)
+	case "start_time":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.StartTime, true
+	case "status":
+		if index >= 0 || rest != "" || v.Status == nil {
+			return nil, false
+		}
+		return *v.Status, true
+	case "status_code":
+		if index >= 0 || rest != "" || v.StatusCode == nil {
+			return nil, false
+		}
+		return *v.StatusCode, true
+	case "status_detail":
+		if index >= 0 || rest != "" || v.StatusDetail == nil {
+			return nil, false
+		}
+		return *v.StatusDetail, true
+	case "status_id":
+		if index >= 0 || rest != "" || v.StatusId == nil {
+			return nil, false
+		}
+		return *v.StatusId, true
+	case "time":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.Time, true
+	case "timezone_offset":
+		if index >= 0 || rest != "" || v.TimezoneOffset == nil {
+			return nil, false
+		}
+		return *v.TimezoneOffset, true
+	case "type_name":
+		if index >= 0 || rest != "" || v.TypeName == nil {
+			return nil, false
+		}
+		return *v.TypeName, true
+	case "type_uid":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.TypeUid, true
+	case "unmapped":
+		if index >= 0 || rest != "" || v.Unmapped == nil {
+			return nil, false
+		}
+		return *v.Unmapped, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *APIActivity) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "activity_id":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.ActivityId, value)
+	case "activity_name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.ActivityName, value)
+	case "actor":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetRequired(&v.Actor, value)
+		}
+		return v.Actor.Set(rest, value)
+	case "api":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetRequired(&v.Api, value)
+		}
+		return v.Api.Set(rest, value)
+	case "category_name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.CategoryName, value)
+	case "category_uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.CategoryUid, value)
+	case "class_name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.ClassName, value)
+	case "class_uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.ClassUid, value)
+	case "cloud":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetRequired(&v.Cloud, value)
+		}
+		return v.Cloud.Set(rest, value)
+	case "count":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Count, value)
+	case "dst_endpoint":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.DstEndpoint, value)
+		}
+		if v.DstEndpoint == nil {
+			v.DstEndpoint = &NetworkEndpoint{}
+		}
+		return v.DstEndpoint.Set(rest, value)
+	case "duration":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Duration, value)
+	case "end_time":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.EndTime, value)
+	case "enrichments":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Enrichments, value)
+		}
+		elem, err := ocsf.Element(&v.Enrichments, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "http_request":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.HttpRequest, value)
+		}
+		if v.HttpRequest == nil {
+			v.HttpRequest = &HTTPRequest{}
+		}
+		return v.HttpRequest.Set(rest, value)
+	case "http_response":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.HttpResponse, value)
+		}
+		if v.HttpResponse == nil {
+			v.HttpResponse = &HTTPResponse{}
+		}
+		return v.HttpResponse.Set(rest, value)
+	case "message":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Message, value)
+	case "metadata":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetRequired(&v.Metadata, value)
+		}
+		return v.Metadata.Set(rest, value)
+	case "observables":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Observables, value)
+		}
+		elem, err := ocsf.Element(&v.Observables, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "osint":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Osint, value)
+		}
+		elem, err := ocsf.Element(&v.Osint, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "raw_data":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.RawData, value)
+	case "resources":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Resources, value)
+		}
+		elem, err := ocsf.Element(&v.Resources, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "severity":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Severity, value)
+	case "severity_id":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.SeverityId, value)
+	case "src_endpoint":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetRequired(&v.SrcEndpoint, value)
+		}
+		return v.SrcEndpoint.Set(rest, value)
+	case "start_time":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.StartTime, value)
+	case "status":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Status, value)
+	case "status_code":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.StatusCode, value)
+	case "status_detail":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.StatusDetail, value)
+	case "status_id":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.StatusId, value)
+	case "time":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.Time, value)
+	case "timezone_offset":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.TimezoneOffset, value)
+	case "type_name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.TypeName, value)
+	case "type_uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.TypeUid, value)
+	case "unmapped":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Unmapped, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *APIActivity) AppendObservables(path string, observables []Observable) []Observable {
+	observables = v.Actor.AppendObservables(ocsf.JoinPath(path, "actor"), observables)
+	observables = v.Api.AppendObservables(ocsf.JoinPath(path, "api"), observables)
+	observables = v.Cloud.AppendObservables(ocsf.JoinPath(path, "cloud"), observables)
+	if v.DstEndpoint != nil {
+		observables = v.DstEndpoint.AppendObservables(ocsf.JoinPath(path, "dst_endpoint"), observables)
+	}
+	for i := range v.Enrichments {
+		observables = v.Enrichments[i].AppendObservables(ocsf.JoinPath(path, "enrichments"), observables)
+	}
+	if v.HttpRequest != nil {
+		observables = v.HttpRequest.AppendObservables(ocsf.JoinPath(path, "http_request"), observables)
+	}
+	if v.HttpResponse != nil {
+		observables = v.HttpResponse.AppendObservables(ocsf.JoinPath(path, "http_response"), observables)
+	}
+	observables = v.Metadata.AppendObservables(ocsf.JoinPath(path, "metadata"), observables)
+	for i := range v.Osint {
+		observables = v.Osint[i].AppendObservables(ocsf.JoinPath(path, "osint"), observables)
+	}
+	for i := range v.Resources {
+		observables = v.Resources[i].AppendObservables(ocsf.JoinPath(path, "resources"), observables)
+	}
+	observables = v.SrcEndpoint.AppendObservables(ocsf.JoinPath(path, "src_endpoint"), observables)
+
+	return observables
+}
+
+// PopulateObservables adds an observable for every observable attribute and object set on the
+// event to Observables. Observables which are already present are not added again.
+func (v *APIActivity) PopulateObservables() {
+	seen := make(map[string]bool)
+	for _, observable := range v.Observables {
+		seen[ocsf.ObservableKey(observable.Name, observable.TypeId, observable.Value)] = true
+	}
+
+	for _, observable := range v.AppendObservables("", nil) {
+		key := ocsf.ObservableKey(observable.Name, observable.TypeId, observable.Value)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		v.Observables = append(v.Observables, observable)
+	}
+}
+
+type APIActivityActivityId int32
+
+const (
+	APIActivityActivityIdUnknown APIActivityActivityId = 0
+	APIActivityActivityIdCreate  APIActivityActivityId = 1
+	APIActivityActivityIdRead    APIActivityActivityId = 2
+	APIActivityActivityIdUpdate  APIActivityActivityId = 3
+	APIActivityActivityIdDelete  APIActivityActivityId = 4
+	APIActivityActivityIdOther   APIActivityActivityId = 99
+)
+
+func (a APIActivityActivityId) Caption() string {
+	switch a {
+	case APIActivityActivityIdUnknown:
+		return "Unknown"
+	case APIActivityActivityIdCreate:
+		return "Create"
+	case APIActivityActivityIdRead:
+		return "Read"
+	case APIActivityActivityIdUpdate:
+		return "Update"
+	case APIActivityActivityIdDelete:
+		return "Delete"
+	case APIActivityActivityIdOther:
+		return "Other"
+	}
+	return "Other"
+}
+
+const (
+	APIActivityClassUid    = 6003
+	APIActivityCategoryUid = 6
+)
+
+func NewAPIActivity(activity APIActivityActivityId) APIActivity {
+	v := APIActivity{}
+	v.ActivityId = int32(activity)
+	v.ActivityName = ocsf.Ptr(activity.Caption())
+	v.CategoryUid = int32(APIActivityCategoryUid)
+	v.CategoryName = ocsf.Ptr("Application Activity")
+	v.ClassUid = int32(APIActivityClassUid)
+	v.ClassName = ocsf.Ptr("API Activity")
+	v.TypeUid = int64(APIActivityClassUid*100 + int(activity))
+	v.TypeName = ocsf.Ptr("API Activity: " + activity.Caption())
+	v.Metadata.Version = "1.4.0"
+	return v
+}
+
 var APIActivityFields = []arrow.Field{
-	{Name: "activity_id", Type: arrow.PrimitiveTypes.Int32, Nullable: false},
-	{Name: "activity_name", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "actor", Type: ActorStruct, Nullable: false},
-	{Name: "api", Type: APIStruct, Nullable: false},
-	{Name: "category_name", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "category_uid", Type: arrow.PrimitiveTypes.Int32, Nullable: false},
-	{Name: "class_name", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "class_uid", Type: arrow.PrimitiveTypes.Int32, Nullable: false},
-	{Name: "cloud", Type: CloudStruct, Nullable: false},
-	{Name: "count", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "dst_endpoint", Type: NetworkEndpointStruct, Nullable: true},
-	{Name: "duration", Type: arrow.PrimitiveTypes.Int64, Nullable: true},
-	{Name: "end_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
-	{Name: "enrichments", Type: arrow.ListOf(EnrichmentStruct), Nullable: true},
-	{Name: "http_request", Type: HTTPRequestStruct, Nullable: true},
-	{Name: "http_response", Type: HTTPResponseStruct, Nullable: true},
-	{Name: "message", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "metadata", Type: MetadataStruct, Nullable: false},
-	{Name: "observables", Type: arrow.ListOf(ObservableStruct), Nullable: true},
-	{Name: "osint", Type: arrow.ListOf(OSINTStruct), Nullable: false},
-	{Name: "raw_data", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "resources", Type: arrow.ListOf(ResourceDetailsStruct), Nullable: true},
-	{Name: "severity", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "severity_id", Type: arrow.PrimitiveTypes.Int32, Nullable: false},
-	{Name: "src_endpoint", Type: NetworkEndpointStruct, Nullable: false},
-	{Name: "start_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
-	{Name: "status", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "status_code", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "status_detail", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "status_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: false},
-	{Name: "timezone_offset", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "type_name", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "type_uid", Type: arrow.PrimitiveTypes.Int64, Nullable: false},
-	{Name: "unmapped", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "activity_id", Type: arrow.PrimitiveTypes.Int32, Nullable: false, Metadata: ocsf.AttributeMetadata("Activity ID", "The normalized identifier of the activity that triggered the event.", "required", "0: Unknown; 1: Create; 2: Read; 3: Update; 4: Delete; 99: Other")},
+	{Name: "activity_name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Activity", "The event activity name, as defined by the activity_id.", "optional", "")},
+	{Name: "actor", Type: ActorStruct, Nullable: false, Metadata: ocsf.AttributeMetadata("Actor", "The actor object describes details about the user/role/process that was the source of the activity. Note that this is not the threat actor of a campaign but may be part of a campaign.", "required", "")},
+	{Name: "api", Type: APIStruct, Nullable: false, Metadata: ocsf.AttributeMetadata("API Details", "Describes details about a typical API (Application Programming Interface) call.", "required", "")},
+	{Name: "category_name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Category", "The event category name, as defined by category_uid value: <code>Application Activity</code>.", "optional", "")},
+	{Name: "category_uid", Type: arrow.PrimitiveTypes.Int32, Nullable: false, Metadata: ocsf.AttributeMetadata("Category ID", "The category unique identifier of the event.", "required", "6: Application Activity")},
+	{Name: "class_name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Class", "The event class name, as defined by class_uid value: <code>API Activity</code>.", "optional", "")},
+	{Name: "class_uid", Type: arrow.PrimitiveTypes.Int32, Nullable: false, Metadata: ocsf.AttributeMetadata("Class ID", "The unique identifier of a class. A class describes the attributes available in an event.", "required", "6003: API Activity")},
+	{Name: "cloud", Type: CloudStruct, Nullable: false, Metadata: ocsf.AttributeMetadata("Cloud", "Describes details about the Cloud environment where the event was originally created or logged.", "required", "")},
+	{Name: "count", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Count", "The number of times that events in the same logical group occurred during the event <strong>Start Time</strong> to <strong>End Time</strong> period.", "optional", "")},
+	{Name: "dst_endpoint", Type: NetworkEndpointStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Destination Endpoint", "The network destination endpoint.", "optional", "")},
+	{Name: "duration", Type: arrow.PrimitiveTypes.Int64, Nullable: true, Metadata: ocsf.AttributeMetadata("Duration Milliseconds", "The event duration or aggregate time, the amount of time the event covers from <code>start_time</code> to <code>end_time</code> in milliseconds.", "optional", "")},
+	{Name: "end_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true, Metadata: ocsf.AttributeMetadata("End Time", "The end time of a time period, or the time of the most recent event included in the aggregate event.", "optional", "")},
+	{Name: "enrichments", Type: arrow.ListOf(EnrichmentStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("Enrichments", "The additional information from an external data source, which is associated with the event or a finding. For example add location information for the IP address in the DNS answers:</p><code>[{\"name\": \"answers.ip\", \"value\": \"92.24.47.250\", \"type\": \"location\", \"data\": {\"city\": \"Socotra\", \"continent\": \"Asia\", \"coordinates\": [-25.4153, 17.0743], \"country\": \"YE\", \"desc\": \"Yemen\"}}]</code>", "optional", "")},
+	{Name: "http_request", Type: HTTPRequestStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("HTTP Request", "Details about the underlying http request.", "optional", "")},
+	{Name: "http_response", Type: HTTPResponseStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("HTTP Response", "Details about the underlying http response.", "optional", "")},
+	{Name: "message", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Message", "The description of the event/finding, as defined by the source.", "optional", "")},
+	{Name: "metadata", Type: MetadataStruct, Nullable: false, Metadata: ocsf.AttributeMetadata("Metadata", "The metadata associated with the event or a finding.", "required", "")},
+	{Name: "observables", Type: arrow.ListOf(ObservableStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("Observables", "The observables associated with the event or a finding.", "optional", "")},
+	{Name: "osint", Type: arrow.ListOf(OSINTStruct), Nullable: false, Metadata: ocsf.AttributeMetadata("OSINT", "The OSINT (Open Source Intelligence) object contains details related to an indicator such as the indicator itself, related indicators, geolocation, registrar information, subdomains, analyst commentary, and other contextual information. This information can be used to further enrich a detection or finding by providing decisioning support to other analysts and engineers.", "required", "")},
+	{Name: "raw_data", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Raw Data", "The raw event/finding data as received from the source.", "optional", "")},
+	{Name: "resources", Type: arrow.ListOf(ResourceDetailsStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("Resources Array", "Details about resources that were affected by the activity/event.", "optional", "")},
+	{Name: "severity", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Severity", "The event/finding severity, normalized to the caption of the severity_id value. In the case of 'Other', it is defined by the source.", "optional", "")},
+	{Name: "severity_id", Type: arrow.PrimitiveTypes.Int32, Nullable: false, Metadata: ocsf.AttributeMetadata("Severity ID", "<p>The normalized identifier of the event/finding severity.</p>The normalized severity is a measurement the effort and expense required to manage and resolve an event or incident. Smaller numerical values represent lower impact events, and larger numerical values represent higher impact events.", "required", "")},
+	{Name: "src_endpoint", Type: NetworkEndpointStruct, Nullable: false, Metadata: ocsf.AttributeMetadata("Source Endpoint", "Details about the source of the activity.", "required", "")},
+	{Name: "start_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true, Metadata: ocsf.AttributeMetadata("Start Time", "The start time of a time period, or the time of the least recent event included in the aggregate event.", "optional", "")},
+	{Name: "status", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Status", "The event status, normalized to the caption of the status_id value. In the case of 'Other', it is defined by the event source.", "optional", "")},
+	{Name: "status_code", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Status Code", "The event status code, as reported by the event source.<br /><br />For example, in a Windows Failed Authentication event, this would be the value of 'Failure Code', e.g. 0x18.", "optional", "")},
+	{Name: "status_detail", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Status Detail", "The status detail contains additional information about the event/finding outcome.", "optional", "")},
+	{Name: "status_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Status ID", "The normalized identifier of the event status.", "optional", "")},
+	{Name: "time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: false, Metadata: ocsf.AttributeMetadata("Event Time", "The normalized event occurrence time or the finding creation time.", "required", "")},
+	{Name: "timezone_offset", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Timezone Offset", "The number of minutes that the reported event <code>time</code> is ahead or behind UTC, in the range -1,080 to +1,080.", "optional", "")},
+	{Name: "type_name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Type Name", "The event/finding type name, as defined by the type_uid.", "optional", "")},
+	{Name: "type_uid", Type: arrow.PrimitiveTypes.Int64, Nullable: false, Metadata: ocsf.AttributeMetadata("Type ID", "The event/finding type ID. It identifies the event's semantics and structure. The value is calculated by the logging system as: <code>class_uid * 100 + activity_id</code>.", "required", "")},
+	{Name: "unmapped", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Unmapped Data", "The attributes that are not mapped to the event schema. The names and values of those attributes are specific to the event source.", "optional", "")},
 }
 
 var APIActivityStruct = arrow.StructOf(APIActivityFields...)
@@ -2,6 +2,7 @@
 package v1_4_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -24,11 +25,142 @@ func (v *MITREATTCK) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *MITREATTCK) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *MITREATTCK) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	if v.SubTechnique != nil {
+		errs = append(errs, v.SubTechnique.ValidateAt(ocsf.JoinPath(path, "sub_technique"))...)
+	}
+	if v.Tactic != nil {
+		errs = append(errs, v.Tactic.ValidateAt(ocsf.JoinPath(path, "tactic"))...)
+	}
+	if v.Technique != nil {
+		errs = append(errs, v.Technique.ValidateAt(ocsf.JoinPath(path, "technique"))...)
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *MITREATTCK) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "sub_technique":
+		if index >= 0 || v.SubTechnique == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.SubTechnique, true
+		}
+		return v.SubTechnique.Get(rest)
+	case "tactic":
+		if index >= 0 || v.Tactic == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Tactic, true
+		}
+		return v.Tactic.Get(rest)
+	case "technique":
+		if index >= 0 || v.Technique == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Technique, true
+		}
+		return v.Technique.Get(rest)
+	case "version":
+		if index >= 0 || rest != "" || v.Version == nil {
+			return nil, false
+		}
+		return *v.Version, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *MITREATTCK) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "sub_technique":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.SubTechnique, value)
+		}
+		if v.SubTechnique == nil {
+			v.SubTechnique = &MITREATTCKSubTechnique{}
+		}
+		return v.SubTechnique.Set(rest, value)
+	case "tactic":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Tactic, value)
+		}
+		if v.Tactic == nil {
+			v.Tactic = &MITREATTCKTactic{}
+		}
+		return v.Tactic.Set(rest, value)
+	case "technique":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Technique, value)
+		}
+		if v.Technique == nil {
+			v.Technique = &MITREATTCKTechnique{}
+		}
+		return v.Technique.Set(rest, value)
+	case "version":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Version, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *MITREATTCK) AppendObservables(path string, observables []Observable) []Observable {
+	if v.SubTechnique != nil {
+		observables = v.SubTechnique.AppendObservables(ocsf.JoinPath(path, "sub_technique"), observables)
+	}
+	if v.Tactic != nil {
+		observables = v.Tactic.AppendObservables(ocsf.JoinPath(path, "tactic"), observables)
+	}
+	if v.Technique != nil {
+		observables = v.Technique.AppendObservables(ocsf.JoinPath(path, "technique"), observables)
+	}
+
+	return observables
+}
+
 var MITREATTCKFields = []arrow.Field{
-	{Name: "sub_technique", Type: MITREATTCKSubTechniqueStruct, Nullable: true},
-	{Name: "tactic", Type: MITREATTCKTacticStruct, Nullable: true},
-	{Name: "technique", Type: MITREATTCKTechniqueStruct, Nullable: true},
-	{Name: "version", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "sub_technique", Type: MITREATTCKSubTechniqueStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Sub Technique", "The Sub Technique object describes the sub technique ID and/or name associated to an attack, as defined by <a target='_blank' href='https://attack.mitre.org/wiki/ATT&CK_Matrix'>ATT&CK® Matrix</a>.", "optional", "")},
+	{Name: "tactic", Type: MITREATTCKTacticStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Tactic", "The Tactic object describes the tactic ID and/or name that is associated to an attack, as defined by <a target='_blank' href='https://attack.mitre.org/wiki/ATT&CK_Matrix'>ATT&CK® Matrix</a>.", "optional", "")},
+	{Name: "technique", Type: MITREATTCKTechniqueStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Technique", "The Technique object describes the technique ID and/or name associated to an attack, as defined by <a target='_blank' href='https://attack.mitre.org/wiki/ATT&CK_Matrix'>ATT&CK® Matrix</a>.", "optional", "")},
+	{Name: "version", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Version", "The <a target='_blank' href='https://attack.mitre.org/wiki/ATT&CK_Matrix'>ATT&CK® Matrix</a> version.", "optional", "")},
 }
 
 var MITREATTCKStruct = arrow.StructOf(MITREATTCKFields...)
@@ -2,6 +2,7 @@
 package v1_4_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -39,16 +40,183 @@ func (v *AuthenticationFactor) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *AuthenticationFactor) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *AuthenticationFactor) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	if v.Device != nil {
+		errs = append(errs, v.Device.ValidateAt(ocsf.JoinPath(path, "device"))...)
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *AuthenticationFactor) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "device":
+		if index >= 0 || v.Device == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Device, true
+		}
+		return v.Device.Get(rest)
+	case "email_addr":
+		if index >= 0 || rest != "" || v.EmailAddr == nil {
+			return nil, false
+		}
+		return *v.EmailAddr, true
+	case "factor_type":
+		if index >= 0 || rest != "" || v.FactorType == nil {
+			return nil, false
+		}
+		return *v.FactorType, true
+	case "factor_type_id":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.FactorTypeId, true
+	case "is_hotp":
+		if index >= 0 || rest != "" || v.IsHotp == nil {
+			return nil, false
+		}
+		return *v.IsHotp, true
+	case "is_totp":
+		if index >= 0 || rest != "" || v.IsTotp == nil {
+			return nil, false
+		}
+		return *v.IsTotp, true
+	case "phone_number":
+		if index >= 0 || rest != "" || v.PhoneNumber == nil {
+			return nil, false
+		}
+		return *v.PhoneNumber, true
+	case "provider":
+		if index >= 0 || rest != "" || v.Provider == nil {
+			return nil, false
+		}
+		return *v.Provider, true
+	case "security_questions":
+		if rest == "" {
+			if index < 0 {
+				return v.SecurityQuestions, v.SecurityQuestions != nil
+			}
+			return ocsf.Index(v.SecurityQuestions, index)
+		}
+		return nil, false
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *AuthenticationFactor) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "device":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Device, value)
+		}
+		if v.Device == nil {
+			v.Device = &Device{}
+		}
+		return v.Device.Set(rest, value)
+	case "email_addr":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.EmailAddr, value)
+	case "factor_type":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.FactorType, value)
+	case "factor_type_id":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.FactorTypeId, value)
+	case "is_hotp":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.IsHotp, value)
+	case "is_totp":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.IsTotp, value)
+	case "phone_number":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.PhoneNumber, value)
+	case "provider":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Provider, value)
+	case "security_questions":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.SecurityQuestions, value)
+		}
+		elem, err := ocsf.Element(&v.SecurityQuestions, index)
+		if err != nil {
+			return err
+		}
+		if rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(elem, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *AuthenticationFactor) AppendObservables(path string, observables []Observable) []Observable {
+	if v.Device != nil {
+		observables = v.Device.AppendObservables(ocsf.JoinPath(path, "device"), observables)
+	}
+	if v.EmailAddr != nil {
+		observables = append(observables, Observable{Name: ocsf.Ptr(ocsf.JoinPath(path, "email_addr")), TypeId: 5, Value: ocsf.Ptr(*v.EmailAddr)})
+	}
+
+	return observables
+}
+
 var AuthenticationFactorFields = []arrow.Field{
-	{Name: "device", Type: DeviceStruct, Nullable: true},
-	{Name: "email_addr", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "factor_type", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "factor_type_id", Type: arrow.PrimitiveTypes.Int32, Nullable: false},
-	{Name: "is_hotp", Type: arrow.FixedWidthTypes.Boolean, Nullable: true},
-	{Name: "is_totp", Type: arrow.FixedWidthTypes.Boolean, Nullable: true},
-	{Name: "phone_number", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "provider", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "security_questions", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true},
+	{Name: "device", Type: DeviceStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Device", "Device used to complete an authentication request.", "optional", "")},
+	{Name: "email_addr", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Email Address", "The email address used in an email-based authentication factor.", "optional", "")},
+	{Name: "factor_type", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Factor Type", "The type of authentication factor used in an authentication attempt.", "optional", "")},
+	{Name: "factor_type_id", Type: arrow.PrimitiveTypes.Int32, Nullable: false, Metadata: ocsf.AttributeMetadata("Factor Type ID", "The normalized identifier for the authentication factor.", "required", "")},
+	{Name: "is_hotp", Type: arrow.FixedWidthTypes.Boolean, Nullable: true, Metadata: ocsf.AttributeMetadata("HMAC-based One-time Password (HOTP)", "Whether the authentication factor is an HMAC-based One-time Password (HOTP).", "optional", "")},
+	{Name: "is_totp", Type: arrow.FixedWidthTypes.Boolean, Nullable: true, Metadata: ocsf.AttributeMetadata("Time-based One-time Password (TOTP)", "Whether the authentication factor is a Time-based One-time Password (TOTP).", "optional", "")},
+	{Name: "phone_number", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Phone Number", "The phone number used for a telephony-based authentication request.", "optional", "")},
+	{Name: "provider", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Provider", "The name of provider for an authentication factor.", "optional", "")},
+	{Name: "security_questions", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true, Metadata: ocsf.AttributeMetadata("Security Questions", "The question(s) provided to user for a question-based authentication factor.", "optional", "")},
 }
 
 var AuthenticationFactorStruct = arrow.StructOf(AuthenticationFactorFields...)
@@ -2,6 +2,7 @@
 package v1_4_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -18,9 +19,90 @@ func (v *AuthorizationResult) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *AuthorizationResult) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *AuthorizationResult) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	if v.Policy != nil {
+		errs = append(errs, v.Policy.ValidateAt(ocsf.JoinPath(path, "policy"))...)
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *AuthorizationResult) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "decision":
+		if index >= 0 || rest != "" || v.Decision == nil {
+			return nil, false
+		}
+		return *v.Decision, true
+	case "policy":
+		if index >= 0 || v.Policy == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Policy, true
+		}
+		return v.Policy.Get(rest)
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *AuthorizationResult) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "decision":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Decision, value)
+	case "policy":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Policy, value)
+		}
+		if v.Policy == nil {
+			v.Policy = &Policy{}
+		}
+		return v.Policy.Set(rest, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *AuthorizationResult) AppendObservables(path string, observables []Observable) []Observable {
+	if v.Policy != nil {
+		observables = v.Policy.AppendObservables(ocsf.JoinPath(path, "policy"), observables)
+	}
+
+	return observables
+}
+
 var AuthorizationResultFields = []arrow.Field{
-	{Name: "decision", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "policy", Type: PolicyStruct, Nullable: true},
+	{Name: "decision", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Authorization Decision/Outcome", "Authorization Result/outcome, e.g. allowed, denied.", "optional", "")},
+	{Name: "policy", Type: PolicyStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Policy", "Details about the Identity/Access management policies that are applicable.", "optional", "")},
 }
 
 var AuthorizationResultStruct = arrow.StructOf(AuthorizationResultFields...)
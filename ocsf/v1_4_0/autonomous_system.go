@@ -2,6 +2,7 @@
 package v1_4_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -18,9 +19,75 @@ func (v *AutonomousSystem) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *AutonomousSystem) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *AutonomousSystem) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *AutonomousSystem) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "name":
+		if index >= 0 || rest != "" || v.Name == nil {
+			return nil, false
+		}
+		return *v.Name, true
+	case "number":
+		if index >= 0 || rest != "" || v.Number == nil {
+			return nil, false
+		}
+		return *v.Number, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *AutonomousSystem) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Name, value)
+	case "number":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Number, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *AutonomousSystem) AppendObservables(path string, observables []Observable) []Observable {
+
+	return observables
+}
+
 var AutonomousSystemFields = []arrow.Field{
-	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "number", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
+	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Name", "Organization name for the Autonomous System.", "optional", "")},
+	{Name: "number", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Number", "Unique number that the AS is identified by.", "optional", "")},
 }
 
 var AutonomousSystemStruct = arrow.StructOf(AutonomousSystemFields...)
@@ -2,6 +2,7 @@
 package v1_4_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -42,17 +43,213 @@ func (v *DigitalCertificate) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *DigitalCertificate) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *DigitalCertificate) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	for i := range v.Fingerprints {
+		errs = append(errs, v.Fingerprints[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "fingerprints"), i))...)
+	}
+	if v.Issuer == "" {
+		errs.Add(ocsf.JoinPath(path, "issuer"), "required attribute is missing")
+	}
+	for i := range v.Sans {
+		errs = append(errs, v.Sans[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "sans"), i))...)
+	}
+	if v.SerialNumber == "" {
+		errs.Add(ocsf.JoinPath(path, "serial_number"), "required attribute is missing")
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *DigitalCertificate) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "created_time":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.CreatedTime, true
+	case "expiration_time":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.ExpirationTime, true
+	case "fingerprints":
+		if rest == "" {
+			if index < 0 {
+				return v.Fingerprints, v.Fingerprints != nil
+			}
+			return ocsf.Index(v.Fingerprints, index)
+		}
+		if index < 0 || index >= len(v.Fingerprints) {
+			return nil, false
+		}
+		return v.Fingerprints[index].Get(rest)
+	case "is_self_signed":
+		if index >= 0 || rest != "" || v.IsSelfSigned == nil {
+			return nil, false
+		}
+		return *v.IsSelfSigned, true
+	case "issuer":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.Issuer, true
+	case "sans":
+		if rest == "" {
+			if index < 0 {
+				return v.Sans, v.Sans != nil
+			}
+			return ocsf.Index(v.Sans, index)
+		}
+		if index < 0 || index >= len(v.Sans) {
+			return nil, false
+		}
+		return v.Sans[index].Get(rest)
+	case "serial_number":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.SerialNumber, true
+	case "subject":
+		if index >= 0 || rest != "" || v.Subject == nil {
+			return nil, false
+		}
+		return *v.Subject, true
+	case "uid":
+		if index >= 0 || rest != "" || v.Uid == nil {
+			return nil, false
+		}
+		return *v.Uid, true
+	case "version":
+		if index >= 0 || rest != "" || v.Version == nil {
+			return nil, false
+		}
+		return *v.Version, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *DigitalCertificate) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "created_time":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.CreatedTime, value)
+	case "expiration_time":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.ExpirationTime, value)
+	case "fingerprints":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Fingerprints, value)
+		}
+		elem, err := ocsf.Element(&v.Fingerprints, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "is_self_signed":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.IsSelfSigned, value)
+	case "issuer":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.Issuer, value)
+	case "sans":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Sans, value)
+		}
+		elem, err := ocsf.Element(&v.Sans, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "serial_number":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.SerialNumber, value)
+	case "subject":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Subject, value)
+	case "uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Uid, value)
+	case "version":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Version, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *DigitalCertificate) AppendObservables(path string, observables []Observable) []Observable {
+	for i := range v.Fingerprints {
+		observables = v.Fingerprints[i].AppendObservables(ocsf.JoinPath(path, "fingerprints"), observables)
+	}
+	for i := range v.Sans {
+		observables = v.Sans[i].AppendObservables(ocsf.JoinPath(path, "sans"), observables)
+	}
+
+	return observables
+}
+
 var DigitalCertificateFields = []arrow.Field{
-	{Name: "created_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
-	{Name: "expiration_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
-	{Name: "fingerprints", Type: arrow.ListOf(FingerprintStruct), Nullable: true},
-	{Name: "is_self_signed", Type: arrow.FixedWidthTypes.Boolean, Nullable: true},
-	{Name: "issuer", Type: arrow.BinaryTypes.String, Nullable: false},
-	{Name: "sans", Type: arrow.ListOf(SubjectAlternativeNameStruct), Nullable: true},
-	{Name: "serial_number", Type: arrow.BinaryTypes.String, Nullable: false},
-	{Name: "subject", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "version", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "created_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true, Metadata: ocsf.AttributeMetadata("Created Time", "The time when the certificate was created.", "optional", "")},
+	{Name: "expiration_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true, Metadata: ocsf.AttributeMetadata("Expiration Time", "The expiration time of the certificate.", "optional", "")},
+	{Name: "fingerprints", Type: arrow.ListOf(FingerprintStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("Fingerprints", "The fingerprint list of the certificate.", "optional", "")},
+	{Name: "is_self_signed", Type: arrow.FixedWidthTypes.Boolean, Nullable: true, Metadata: ocsf.AttributeMetadata("Certificate Self-Signed", "Denotes whether a digital certificate is self-signed or signed by a known certificate authority (CA).", "optional", "")},
+	{Name: "issuer", Type: arrow.BinaryTypes.String, Nullable: false, Metadata: ocsf.AttributeMetadata("Issuer Distinguished Name", "The certificate issuer distinguished name.", "required", "")},
+	{Name: "sans", Type: arrow.ListOf(SubjectAlternativeNameStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("Subject Alternative Names", "The list of subject alternative names that are secured by a specific certificate.", "optional", "")},
+	{Name: "serial_number", Type: arrow.BinaryTypes.String, Nullable: false, Metadata: ocsf.AttributeMetadata("Certificate Serial Number", "The serial number of the certificate used to create the digital signature.", "required", "")},
+	{Name: "subject", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Subject Distinguished Name", "The certificate subject distinguished name.", "optional", "")},
+	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Unique ID", "The unique identifier of the certificate.", "optional", "")},
+	{Name: "version", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Version", "The certificate version.", "optional", "")},
 }
 
 var DigitalCertificateStruct = arrow.StructOf(DigitalCertificateFields...)
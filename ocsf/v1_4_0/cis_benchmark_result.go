@@ -2,6 +2,7 @@
 package v1_4_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -24,11 +25,130 @@ func (v *CISBenchmarkResult) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *CISBenchmarkResult) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *CISBenchmarkResult) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	if v.Name == "" {
+		errs.Add(ocsf.JoinPath(path, "name"), "required attribute is missing")
+	}
+	if v.Remediation != nil {
+		errs = append(errs, v.Remediation.ValidateAt(ocsf.JoinPath(path, "remediation"))...)
+	}
+	if v.Rule != nil {
+		errs = append(errs, v.Rule.ValidateAt(ocsf.JoinPath(path, "rule"))...)
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *CISBenchmarkResult) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "desc":
+		if index >= 0 || rest != "" || v.Desc == nil {
+			return nil, false
+		}
+		return *v.Desc, true
+	case "name":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.Name, true
+	case "remediation":
+		if index >= 0 || v.Remediation == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Remediation, true
+		}
+		return v.Remediation.Get(rest)
+	case "rule":
+		if index >= 0 || v.Rule == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Rule, true
+		}
+		return v.Rule.Get(rest)
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *CISBenchmarkResult) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "desc":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Desc, value)
+	case "name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.Name, value)
+	case "remediation":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Remediation, value)
+		}
+		if v.Remediation == nil {
+			v.Remediation = &Remediation{}
+		}
+		return v.Remediation.Set(rest, value)
+	case "rule":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Rule, value)
+		}
+		if v.Rule == nil {
+			v.Rule = &Rule{}
+		}
+		return v.Rule.Set(rest, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *CISBenchmarkResult) AppendObservables(path string, observables []Observable) []Observable {
+	if v.Remediation != nil {
+		observables = v.Remediation.AppendObservables(ocsf.JoinPath(path, "remediation"), observables)
+	}
+	if v.Rule != nil {
+		observables = v.Rule.AppendObservables(ocsf.JoinPath(path, "rule"), observables)
+	}
+
+	return observables
+}
+
 var CISBenchmarkResultFields = []arrow.Field{
-	{Name: "desc", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: false},
-	{Name: "remediation", Type: RemediationStruct, Nullable: true},
-	{Name: "rule", Type: RuleStruct, Nullable: true},
+	{Name: "desc", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Description", "The CIS benchmark description.", "optional", "")},
+	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: false, Metadata: ocsf.AttributeMetadata("Name", "The CIS benchmark name.", "required", "")},
+	{Name: "remediation", Type: RemediationStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Remediation Guidance", "Describes the recommended remediation steps to address identified issue(s).", "optional", "")},
+	{Name: "rule", Type: RuleStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Rule", "The CIS benchmark rule.", "optional", "")},
 }
 
 var CISBenchmarkResultStruct = arrow.StructOf(CISBenchmarkResultFields...)
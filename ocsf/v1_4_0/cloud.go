@@ -2,6 +2,7 @@
 package v1_4_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -30,13 +31,152 @@ func (v *Cloud) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *Cloud) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *Cloud) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	if v.Account != nil {
+		errs = append(errs, v.Account.ValidateAt(ocsf.JoinPath(path, "account"))...)
+	}
+	if v.Org != nil {
+		errs = append(errs, v.Org.ValidateAt(ocsf.JoinPath(path, "org"))...)
+	}
+	if v.Provider == "" {
+		errs.Add(ocsf.JoinPath(path, "provider"), "required attribute is missing")
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *Cloud) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "account":
+		if index >= 0 || v.Account == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Account, true
+		}
+		return v.Account.Get(rest)
+	case "cloud_partition":
+		if index >= 0 || rest != "" || v.CloudPartition == nil {
+			return nil, false
+		}
+		return *v.CloudPartition, true
+	case "org":
+		if index >= 0 || v.Org == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Org, true
+		}
+		return v.Org.Get(rest)
+	case "provider":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.Provider, true
+	case "region":
+		if index >= 0 || rest != "" || v.Region == nil {
+			return nil, false
+		}
+		return *v.Region, true
+	case "zone":
+		if index >= 0 || rest != "" || v.Zone == nil {
+			return nil, false
+		}
+		return *v.Zone, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *Cloud) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "account":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Account, value)
+		}
+		if v.Account == nil {
+			v.Account = &Account{}
+		}
+		return v.Account.Set(rest, value)
+	case "cloud_partition":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.CloudPartition, value)
+	case "org":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Org, value)
+		}
+		if v.Org == nil {
+			v.Org = &Organization{}
+		}
+		return v.Org.Set(rest, value)
+	case "provider":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.Provider, value)
+	case "region":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Region, value)
+	case "zone":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Zone, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *Cloud) AppendObservables(path string, observables []Observable) []Observable {
+	if v.Account != nil {
+		observables = v.Account.AppendObservables(ocsf.JoinPath(path, "account"), observables)
+	}
+	if v.Org != nil {
+		observables = v.Org.AppendObservables(ocsf.JoinPath(path, "org"), observables)
+	}
+
+	return observables
+}
+
 var CloudFields = []arrow.Field{
-	{Name: "account", Type: AccountStruct, Nullable: true},
-	{Name: "cloud_partition", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "org", Type: OrganizationStruct, Nullable: true},
-	{Name: "provider", Type: arrow.BinaryTypes.String, Nullable: false},
-	{Name: "region", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "zone", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "account", Type: AccountStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Account", "The account object describes details about the account that was the source or target of the activity.", "optional", "")},
+	{Name: "cloud_partition", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Cloud Partition", "The canonical cloud partition name to which the region is assigned (e.g. AWS Partitions: aws, aws-cn, aws-us-gov).", "optional", "")},
+	{Name: "org", Type: OrganizationStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Organization", "Organization and org unit relevant to the event or object.", "optional", "")},
+	{Name: "provider", Type: arrow.BinaryTypes.String, Nullable: false, Metadata: ocsf.AttributeMetadata("Provider", "The unique name of the Cloud services provider, such as AWS, MS Azure, GCP, etc.", "required", "")},
+	{Name: "region", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Region", "The name of the cloud region, as defined by the cloud provider.", "optional", "")},
+	{Name: "zone", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Network Zone", "The availability zone in the cloud region, as defined by the cloud provider.", "optional", "")},
 }
 
 var CloudStruct = arrow.StructOf(CloudFields...)
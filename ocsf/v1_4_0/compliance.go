@@ -2,6 +2,7 @@
 package v1_4_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -42,17 +43,271 @@ func (v *Compliance) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *Compliance) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *Compliance) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	for i := range v.ComplianceReferences {
+		errs = append(errs, v.ComplianceReferences[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "compliance_references"), i))...)
+	}
+	for i := range v.ComplianceStandards {
+		errs = append(errs, v.ComplianceStandards[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "compliance_standards"), i))...)
+	}
+	for i := range v.ControlParameters {
+		errs = append(errs, v.ControlParameters[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "control_parameters"), i))...)
+	}
+	if len(v.Standards) == 0 {
+		errs.Add(ocsf.JoinPath(path, "standards"), "required attribute is missing")
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *Compliance) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "compliance_references":
+		if rest == "" {
+			if index < 0 {
+				return v.ComplianceReferences, v.ComplianceReferences != nil
+			}
+			return ocsf.Index(v.ComplianceReferences, index)
+		}
+		if index < 0 || index >= len(v.ComplianceReferences) {
+			return nil, false
+		}
+		return v.ComplianceReferences[index].Get(rest)
+	case "compliance_standards":
+		if rest == "" {
+			if index < 0 {
+				return v.ComplianceStandards, v.ComplianceStandards != nil
+			}
+			return ocsf.Index(v.ComplianceStandards, index)
+		}
+		if index < 0 || index >= len(v.ComplianceStandards) {
+			return nil, false
+		}
+		return v.ComplianceStandards[index].Get(rest)
+	case "control":
+		if index >= 0 || rest != "" || v.Control == nil {
+			return nil, false
+		}
+		return *v.Control, true
+	case "control_parameters":
+		if rest == "" {
+			if index < 0 {
+				return v.ControlParameters, v.ControlParameters != nil
+			}
+			return ocsf.Index(v.ControlParameters, index)
+		}
+		if index < 0 || index >= len(v.ControlParameters) {
+			return nil, false
+		}
+		return v.ControlParameters[index].Get(rest)
+	case "requirements":
+		if rest == "" {
+			if index < 0 {
+				return v.Requirements, v.Requirements != nil
+			}
+			return ocsf.Index(v.Requirements, index)
+		}
+		return nil, false
+	case "standards":
+		if rest == "" {
+			if index < 0 {
+				return v.Standards, v.Standards != nil
+			}
+			return ocsf.Index(v.Standards, index)
+		}
+		return nil, false
+	case "status":
+		if index >= 0 || rest != "" || v.Status == nil {
+			return nil, false
+		}
+		return *v.Status, true
+	case "status_code":
+		if index >= 0 || rest != "" || v.StatusCode == nil {
+			return nil, false
+		}
+		return *v.StatusCode, true
+	case "status_details":
+		if rest == "" {
+			if index < 0 {
+				return v.StatusDetails, v.StatusDetails != nil
+			}
+			return ocsf.Index(v.StatusDetails, index)
+		}
+		return nil, false
+	case "status_id":
+		if index >= 0 || rest != "" || v.StatusId == nil {
+			return nil, false
+		}
+		return *v.StatusId, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *Compliance) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "compliance_references":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.ComplianceReferences, value)
+		}
+		elem, err := ocsf.Element(&v.ComplianceReferences, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "compliance_standards":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.ComplianceStandards, value)
+		}
+		elem, err := ocsf.Element(&v.ComplianceStandards, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "control":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Control, value)
+	case "control_parameters":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.ControlParameters, value)
+		}
+		elem, err := ocsf.Element(&v.ControlParameters, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "requirements":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Requirements, value)
+		}
+		elem, err := ocsf.Element(&v.Requirements, index)
+		if err != nil {
+			return err
+		}
+		if rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(elem, value)
+	case "standards":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Standards, value)
+		}
+		elem, err := ocsf.Element(&v.Standards, index)
+		if err != nil {
+			return err
+		}
+		if rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(elem, value)
+	case "status":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Status, value)
+	case "status_code":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.StatusCode, value)
+	case "status_details":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.StatusDetails, value)
+		}
+		elem, err := ocsf.Element(&v.StatusDetails, index)
+		if err != nil {
+			return err
+		}
+		if rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(elem, value)
+	case "status_id":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.StatusId, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *Compliance) AppendObservables(path string, observables []Observable) []Observable {
+	for i := range v.ComplianceReferences {
+		observables = v.ComplianceReferences[i].AppendObservables(ocsf.JoinPath(path, "compliance_references"), observables)
+	}
+	for i := range v.ComplianceStandards {
+		observables = v.ComplianceStandards[i].AppendObservables(ocsf.JoinPath(path, "compliance_standards"), observables)
+	}
+	for i := range v.ControlParameters {
+		observables = v.ControlParameters[i].AppendObservables(ocsf.JoinPath(path, "control_parameters"), observables)
+	}
+
+	return observables
+}
+
 var ComplianceFields = []arrow.Field{
-	{Name: "compliance_references", Type: arrow.ListOf(KBArticleStruct), Nullable: true},
-	{Name: "compliance_standards", Type: arrow.ListOf(KBArticleStruct), Nullable: true},
-	{Name: "control", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "control_parameters", Type: arrow.ListOf(KeyValueobjectStruct), Nullable: true},
-	{Name: "requirements", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true},
-	{Name: "standards", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: false},
-	{Name: "status", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "status_code", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "status_details", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true},
-	{Name: "status_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
+	{Name: "compliance_references", Type: arrow.ListOf(KBArticleStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("Compliance Standard References", "A list of reference KB articles that provide information to help organizations understand, interpret, and implement compliance standards. They provide guidance, best practices, and examples.", "optional", "")},
+	{Name: "compliance_standards", Type: arrow.ListOf(KBArticleStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("Compliance Standards", "Details: A list of established guidelines or criteria that define specific requirements an organization must follow.", "optional", "")},
+	{Name: "control", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Security Control", "A Control is prescriptive, prioritized, and simplified set of best practices that one can use to strengthen their cybersecurity posture. e.g. AWS SecurityHub Controls, CIS Controls.", "optional", "")},
+	{Name: "control_parameters", Type: arrow.ListOf(KeyValueobjectStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("Control Parameters", "The list of control parameters evaluated in a Compliance check.", "optional", "")},
+	{Name: "requirements", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true, Metadata: ocsf.AttributeMetadata("Compliance Requirements", "A list of requirements associated to a specific control in an industry or regulatory framework. e.g. <code>NIST.800-53.r5 AU-10</code>", "optional", "")},
+	{Name: "standards", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: false, Metadata: ocsf.AttributeMetadata("Compliance Standards", "List: Compliance standards are a set of criteria organizations can follow to protect sensitive and confidential information. e.g. <code>NIST SP 800-53, CIS AWS Foundations Benchmark v1.4.0, ISO/IEC 27001</code>", "required", "")},
+	{Name: "status", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Status", "The resultant status of the compliance check  normalized to the caption of the <code>status_id</code> value. In the case of 'Other', it is defined by the event source.", "optional", "")},
+	{Name: "status_code", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Status Code", "The resultant status code of the compliance check.", "optional", "")},
+	{Name: "status_details", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true, Metadata: ocsf.AttributeMetadata("Status Details", "A list of contextual descriptions of the <code>status, status_code</code> values.", "optional", "")},
+	{Name: "status_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Status ID", "The normalized status identifier of the compliance check.", "optional", "")},
 }
 
 var ComplianceStruct = arrow.StructOf(ComplianceFields...)
@@ -2,6 +2,7 @@
 package v1_4_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -46,18 +47,242 @@ func (v *Container) Observable() (*int, string) {
 	return &typeId, "container"
 }
 
+func (v *Container) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *Container) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	if v.Hash != nil {
+		errs = append(errs, v.Hash.ValidateAt(ocsf.JoinPath(path, "hash"))...)
+	}
+	if v.Image != nil {
+		errs = append(errs, v.Image.ValidateAt(ocsf.JoinPath(path, "image"))...)
+	}
+	for i := range v.Tags {
+		errs = append(errs, v.Tags[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "tags"), i))...)
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *Container) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "hash":
+		if index >= 0 || v.Hash == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Hash, true
+		}
+		return v.Hash.Get(rest)
+	case "image":
+		if index >= 0 || v.Image == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Image, true
+		}
+		return v.Image.Get(rest)
+	case "labels":
+		if rest == "" {
+			if index < 0 {
+				return v.Labels, v.Labels != nil
+			}
+			return ocsf.Index(v.Labels, index)
+		}
+		return nil, false
+	case "name":
+		if index >= 0 || rest != "" || v.Name == nil {
+			return nil, false
+		}
+		return *v.Name, true
+	case "network_driver":
+		if index >= 0 || rest != "" || v.NetworkDriver == nil {
+			return nil, false
+		}
+		return *v.NetworkDriver, true
+	case "orchestrator":
+		if index >= 0 || rest != "" || v.Orchestrator == nil {
+			return nil, false
+		}
+		return *v.Orchestrator, true
+	case "pod_uuid":
+		if index >= 0 || rest != "" || v.PodUuid == nil {
+			return nil, false
+		}
+		return *v.PodUuid, true
+	case "runtime":
+		if index >= 0 || rest != "" || v.Runtime == nil {
+			return nil, false
+		}
+		return *v.Runtime, true
+	case "size":
+		if index >= 0 || rest != "" || v.Size == nil {
+			return nil, false
+		}
+		return *v.Size, true
+	case "tags":
+		if rest == "" {
+			if index < 0 {
+				return v.Tags, v.Tags != nil
+			}
+			return ocsf.Index(v.Tags, index)
+		}
+		if index < 0 || index >= len(v.Tags) {
+			return nil, false
+		}
+		return v.Tags[index].Get(rest)
+	case "uid":
+		if index >= 0 || rest != "" || v.Uid == nil {
+			return nil, false
+		}
+		return *v.Uid, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *Container) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "hash":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Hash, value)
+		}
+		if v.Hash == nil {
+			v.Hash = &Fingerprint{}
+		}
+		return v.Hash.Set(rest, value)
+	case "image":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Image, value)
+		}
+		if v.Image == nil {
+			v.Image = &Image{}
+		}
+		return v.Image.Set(rest, value)
+	case "labels":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Labels, value)
+		}
+		elem, err := ocsf.Element(&v.Labels, index)
+		if err != nil {
+			return err
+		}
+		if rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(elem, value)
+	case "name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Name, value)
+	case "network_driver":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.NetworkDriver, value)
+	case "orchestrator":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Orchestrator, value)
+	case "pod_uuid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.PodUuid, value)
+	case "runtime":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Runtime, value)
+	case "size":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Size, value)
+	case "tags":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Tags, value)
+		}
+		elem, err := ocsf.Element(&v.Tags, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Uid, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *Container) AppendObservables(path string, observables []Observable) []Observable {
+	if path != "" {
+		observables = append(observables, Observable{Name: ocsf.Ptr(path), TypeId: 27})
+	}
+	if v.Hash != nil {
+		observables = v.Hash.AppendObservables(ocsf.JoinPath(path, "hash"), observables)
+	}
+	if v.Image != nil {
+		observables = v.Image.AppendObservables(ocsf.JoinPath(path, "image"), observables)
+	}
+	for i := range v.Tags {
+		observables = v.Tags[i].AppendObservables(ocsf.JoinPath(path, "tags"), observables)
+	}
+
+	return observables
+}
+
 var ContainerFields = []arrow.Field{
-	{Name: "hash", Type: FingerprintStruct, Nullable: true},
-	{Name: "image", Type: ImageStruct, Nullable: true},
-	{Name: "labels", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true},
-	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "network_driver", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "orchestrator", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "pod_uuid", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "runtime", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "size", Type: arrow.PrimitiveTypes.Int64, Nullable: true},
-	{Name: "tags", Type: arrow.ListOf(KeyValueobjectStruct), Nullable: true},
-	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "hash", Type: FingerprintStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Hash", "Commit hash of image created for docker or the SHA256 hash of the container. For example: <code>13550340a8681c84c861aac2e5b440161c2b33a3e4f302ac680ca5b686de48de</code>.", "optional", "")},
+	{Name: "image", Type: ImageStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Image", "The container image used as a template to run the container.", "optional", "")},
+	{Name: "labels", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true, Metadata: ocsf.AttributeMetadata("Labels", "The list of labels associated to the container.", "optional", "")},
+	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Name", "The container name.", "optional", "")},
+	{Name: "network_driver", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Network Driver", "The network driver used by the container. For example, bridge, overlay, host, none, etc.", "optional", "")},
+	{Name: "orchestrator", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Orchestrator", "The orchestrator managing the container, such as ECS, EKS, K8s, or OpenShift.", "optional", "")},
+	{Name: "pod_uuid", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Pod UUID", "The unique identifier of the pod (or equivalent) that the container is executing on.", "optional", "")},
+	{Name: "runtime", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Runtime", "The backend running the container, such as containerd or cri-o.", "optional", "")},
+	{Name: "size", Type: arrow.PrimitiveTypes.Int64, Nullable: true, Metadata: ocsf.AttributeMetadata("Size", "The size of the container image.", "optional", "")},
+	{Name: "tags", Type: arrow.ListOf(KeyValueobjectStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("Tags", "The list of tags; <code>{key:value}</code> pairs associated to the container.", "optional", "")},
+	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Unique ID", "The full container unique identifier for this instantiation of the container. For example: <code>ac2ea168264a08f9aaca0dfc82ff3551418dfd22d02b713142a6843caa2f61bf</code>.", "optional", "")},
 }
 
 var ContainerStruct = arrow.StructOf(ContainerFields...)
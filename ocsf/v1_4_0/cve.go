@@ -2,6 +2,7 @@
 package v1_4_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -45,18 +46,264 @@ func (v *CVE) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *CVE) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *CVE) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	for i := range v.Cvss {
+		errs = append(errs, v.Cvss[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "cvss"), i))...)
+	}
+	if v.Epss != nil {
+		errs = append(errs, v.Epss.ValidateAt(ocsf.JoinPath(path, "epss"))...)
+	}
+	if v.Product != nil {
+		errs = append(errs, v.Product.ValidateAt(ocsf.JoinPath(path, "product"))...)
+	}
+	for i := range v.RelatedCwes {
+		errs = append(errs, v.RelatedCwes[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "related_cwes"), i))...)
+	}
+	if v.Uid == "" {
+		errs.Add(ocsf.JoinPath(path, "uid"), "required attribute is missing")
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *CVE) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "created_time":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.CreatedTime, true
+	case "cvss":
+		if rest == "" {
+			if index < 0 {
+				return v.Cvss, v.Cvss != nil
+			}
+			return ocsf.Index(v.Cvss, index)
+		}
+		if index < 0 || index >= len(v.Cvss) {
+			return nil, false
+		}
+		return v.Cvss[index].Get(rest)
+	case "desc":
+		if index >= 0 || rest != "" || v.Desc == nil {
+			return nil, false
+		}
+		return *v.Desc, true
+	case "epss":
+		if index >= 0 || v.Epss == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Epss, true
+		}
+		return v.Epss.Get(rest)
+	case "modified_time":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.ModifiedTime, true
+	case "product":
+		if index >= 0 || v.Product == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Product, true
+		}
+		return v.Product.Get(rest)
+	case "references":
+		if rest == "" {
+			if index < 0 {
+				return v.References, v.References != nil
+			}
+			return ocsf.Index(v.References, index)
+		}
+		return nil, false
+	case "related_cwes":
+		if rest == "" {
+			if index < 0 {
+				return v.RelatedCwes, v.RelatedCwes != nil
+			}
+			return ocsf.Index(v.RelatedCwes, index)
+		}
+		if index < 0 || index >= len(v.RelatedCwes) {
+			return nil, false
+		}
+		return v.RelatedCwes[index].Get(rest)
+	case "title":
+		if index >= 0 || rest != "" || v.Title == nil {
+			return nil, false
+		}
+		return *v.Title, true
+	case "type":
+		if index >= 0 || rest != "" || v.Type == nil {
+			return nil, false
+		}
+		return *v.Type, true
+	case "uid":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.Uid, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *CVE) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "created_time":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.CreatedTime, value)
+	case "cvss":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Cvss, value)
+		}
+		elem, err := ocsf.Element(&v.Cvss, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "desc":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Desc, value)
+	case "epss":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Epss, value)
+		}
+		if v.Epss == nil {
+			v.Epss = &EPSS{}
+		}
+		return v.Epss.Set(rest, value)
+	case "modified_time":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.ModifiedTime, value)
+	case "product":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Product, value)
+		}
+		if v.Product == nil {
+			v.Product = &Product{}
+		}
+		return v.Product.Set(rest, value)
+	case "references":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.References, value)
+		}
+		elem, err := ocsf.Element(&v.References, index)
+		if err != nil {
+			return err
+		}
+		if rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(elem, value)
+	case "related_cwes":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.RelatedCwes, value)
+		}
+		elem, err := ocsf.Element(&v.RelatedCwes, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "title":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Title, value)
+	case "type":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Type, value)
+	case "uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.Uid, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *CVE) AppendObservables(path string, observables []Observable) []Observable {
+	for i := range v.Cvss {
+		observables = v.Cvss[i].AppendObservables(ocsf.JoinPath(path, "cvss"), observables)
+	}
+	if v.Epss != nil {
+		observables = v.Epss.AppendObservables(ocsf.JoinPath(path, "epss"), observables)
+	}
+	if v.Product != nil {
+		observables = v.Product.AppendObservables(ocsf.JoinPath(path, "product"), observables)
+	}
+	for i := range v.RelatedCwes {
+		observables = v.RelatedCwes[i].AppendObservables(ocsf.JoinPath(path, "related_cwes"), observables)
+	}
+
+	return observables
+}
+
 var CVEFields = []arrow.Field{
-	{Name: "created_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
-	{Name: "cvss", Type: arrow.ListOf(CVSSScoreStruct), Nullable: true},
-	{Name: "desc", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "epss", Type: EPSSStruct, Nullable: true},
-	{Name: "modified_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
-	{Name: "product", Type: ProductStruct, Nullable: true},
-	{Name: "references", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true},
-	{Name: "related_cwes", Type: arrow.ListOf(CWEStruct), Nullable: true},
-	{Name: "title", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "type", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: false},
+	{Name: "created_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true, Metadata: ocsf.AttributeMetadata("Created Time", "The Record Creation Date identifies when the CVE ID was issued to a CVE Numbering Authority (CNA) or the CVE Record was published on the CVE List. Note that the Record Creation Date does not necessarily indicate when this vulnerability was discovered, shared with the affected vendor, publicly disclosed, or updated in CVE.", "optional", "")},
+	{Name: "cvss", Type: arrow.ListOf(CVSSScoreStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("CVSS Score", "The CVSS object details Common Vulnerability Scoring System (<a target='_blank' href='https://www.first.org/cvss/'>CVSS</a>) scores from the advisory that are related to the vulnerability.", "optional", "")},
+	{Name: "desc", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Description", "A brief description of the CVE Record.", "optional", "")},
+	{Name: "epss", Type: EPSSStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("EPSS", "The Exploit Prediction Scoring System (EPSS) object describes the estimated probability a vulnerability will be exploited. EPSS is a community-driven effort to combine descriptive information about vulnerabilities (CVEs) with evidence of actual exploitation in-the-wild. (<a target='_blank' href='https://www.first.org/epss/'>EPSS</a>).", "optional", "")},
+	{Name: "modified_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true, Metadata: ocsf.AttributeMetadata("Modified Time", "The Record Modified Date identifies when the CVE record was last updated.", "optional", "")},
+	{Name: "product", Type: ProductStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Product", "The product where the vulnerability was discovered.", "optional", "")},
+	{Name: "references", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true, Metadata: ocsf.AttributeMetadata("References", "A list of reference URLs with additional information about the CVE Record.", "optional", "")},
+	{Name: "related_cwes", Type: arrow.ListOf(CWEStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("Related CWEs", "Describes the Common Weakness Enumeration <a target='_blank' href='https://cwe.mitre.org/'>(CWE)</a> details related to the CVE Record.", "optional", "")},
+	{Name: "title", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Title", "A title or a brief phrase summarizing the CVE record.", "optional", "")},
+	{Name: "type", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Vulnerability Type", "<p>The vulnerability type as selected from a large dropdown menu during CVE refinement.</p>Most frequently used vulnerability types are: <code>DoS</code>, <code>Code Execution</code>, <code>Overflow</code>, <code>Memory Corruption</code>, <code>Sql Injection</code>, <code>XSS</code>, <code>Directory Traversal</code>, <code>Http Response Splitting</code>, <code>Bypass something</code>, <code>Gain Information</code>, <code>Gain Privileges</code>, <code>CSRF</code>, <code>File Inclusion</code>. For more information see <a target='_blank' href='https://www.cvedetails.com/vulnerabilities-by-types.php'>Vulnerabilities By Type</a> distributions.", "optional", "")},
+	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: false, Metadata: ocsf.AttributeMetadata("CVE ID", "The Common Vulnerabilities and Exposures unique number assigned to a specific computer vulnerability. A CVE Identifier begins with 4 digits representing the year followed by a sequence of digits that acts as a unique identifier. For example: <code>CVE-2021-12345</code>.", "required", "")},
 }
 
 var CVEStruct = arrow.StructOf(CVEFields...)
@@ -2,6 +2,7 @@
 package v1_4_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -39,16 +40,177 @@ func (v *CVSSScore) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *CVSSScore) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *CVSSScore) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	for i := range v.Metrics {
+		errs = append(errs, v.Metrics[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "metrics"), i))...)
+	}
+	if v.Version == "" {
+		errs.Add(ocsf.JoinPath(path, "version"), "required attribute is missing")
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *CVSSScore) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "base_score":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.BaseScore, true
+	case "depth":
+		if index >= 0 || rest != "" || v.Depth == nil {
+			return nil, false
+		}
+		return *v.Depth, true
+	case "metrics":
+		if rest == "" {
+			if index < 0 {
+				return v.Metrics, v.Metrics != nil
+			}
+			return ocsf.Index(v.Metrics, index)
+		}
+		if index < 0 || index >= len(v.Metrics) {
+			return nil, false
+		}
+		return v.Metrics[index].Get(rest)
+	case "overall_score":
+		if index >= 0 || rest != "" || v.OverallScore == nil {
+			return nil, false
+		}
+		return *v.OverallScore, true
+	case "severity":
+		if index >= 0 || rest != "" || v.Severity == nil {
+			return nil, false
+		}
+		return *v.Severity, true
+	case "src_url":
+		if index >= 0 || rest != "" || v.SrcUrl == nil {
+			return nil, false
+		}
+		return *v.SrcUrl, true
+	case "vector_string":
+		if index >= 0 || rest != "" || v.VectorString == nil {
+			return nil, false
+		}
+		return *v.VectorString, true
+	case "vendor_name":
+		if index >= 0 || rest != "" || v.VendorName == nil {
+			return nil, false
+		}
+		return *v.VendorName, true
+	case "version":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.Version, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *CVSSScore) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "base_score":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.BaseScore, value)
+	case "depth":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Depth, value)
+	case "metrics":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Metrics, value)
+		}
+		elem, err := ocsf.Element(&v.Metrics, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "overall_score":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.OverallScore, value)
+	case "severity":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Severity, value)
+	case "src_url":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.SrcUrl, value)
+	case "vector_string":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.VectorString, value)
+	case "vendor_name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.VendorName, value)
+	case "version":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.Version, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *CVSSScore) AppendObservables(path string, observables []Observable) []Observable {
+	for i := range v.Metrics {
+		observables = v.Metrics[i].AppendObservables(ocsf.JoinPath(path, "metrics"), observables)
+	}
+
+	return observables
+}
+
 var CVSSScoreFields = []arrow.Field{
-	{Name: "base_score", Type: arrow.PrimitiveTypes.Float64, Nullable: false},
-	{Name: "depth", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "metrics", Type: arrow.ListOf(MetricStruct), Nullable: true},
-	{Name: "overall_score", Type: arrow.PrimitiveTypes.Float64, Nullable: true},
-	{Name: "severity", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "src_url", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "vector_string", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "vendor_name", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "version", Type: arrow.BinaryTypes.String, Nullable: false},
+	{Name: "base_score", Type: arrow.PrimitiveTypes.Float64, Nullable: false, Metadata: ocsf.AttributeMetadata("Base Score", "The CVSS base score. For example: <code>9.1</code>.", "required", "")},
+	{Name: "depth", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("CVSS Depth", "The CVSS depth represents a depth of the equation used to calculate CVSS score.", "optional", "")},
+	{Name: "metrics", Type: arrow.ListOf(MetricStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("Metrics", "The Common Vulnerability Scoring System metrics. This attribute contains information on the CVE's impact. If the CVE has been analyzed, this attribute will contain any CVSSv2 or CVSSv3 information associated with the vulnerability. For example: <code>{ {\"Access Vector\", \"Network\"}, {\"Access Complexity\", \"Low\"}, ...}</code>.", "optional", "")},
+	{Name: "overall_score", Type: arrow.PrimitiveTypes.Float64, Nullable: true, Metadata: ocsf.AttributeMetadata("Overall Score", "The CVSS overall score, impacted by base, temporal, and environmental metrics. For example: <code>9.1</code>.", "optional", "")},
+	{Name: "severity", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Severity", "<p>The Common Vulnerability Scoring System (CVSS) Qualitative Severity Rating. A textual representation of the numeric score.</p><strong>CVSS v2.0</strong><ul><li>Low (0.0 – 3.9)</li><li>Medium (4.0 – 6.9)</li><li>High (7.0 – 10.0)</li></ul></p><strong>CVSS v3.0</strong><ul><li>None (0.0)</li><li>Low (0.1 - 3.9)</li><li>Medium (4.0 - 6.9)</li><li>High (7.0 - 8.9)</li><li>Critical (9.0 - 10.0)</li></ul>", "optional", "")},
+	{Name: "src_url", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Source URL", "The source URL for the CVSS score. For example: <code>https://nvd.nist.gov/vuln/detail/CVE-2021-44228</code>", "optional", "")},
+	{Name: "vector_string", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Vector String", "The CVSS vector string is a text representation of a set of CVSS metrics. It is commonly used to record or transfer CVSS metric information in a concise form. For example: <code>3.1/AV:L/AC:L/PR:L/UI:N/S:U/C:H/I:N/A:H</code>.", "optional", "")},
+	{Name: "vendor_name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Vendor Name", "The vendor that provided the CVSS score. For example: <code>NVD, REDHAT</code> etc.", "optional", "")},
+	{Name: "version", Type: arrow.BinaryTypes.String, Nullable: false, Metadata: ocsf.AttributeMetadata("Version", "The CVSS version. For example: <code>3.1</code>.", "required", "")},
 }
 
 var CVSSScoreStruct = arrow.StructOf(CVSSScoreFields...)
@@ -2,6 +2,7 @@
 package v1_4_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -21,10 +22,89 @@ func (v *CWE) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *CWE) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *CWE) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	if v.Uid == "" {
+		errs.Add(ocsf.JoinPath(path, "uid"), "required attribute is missing")
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *CWE) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "caption":
+		if index >= 0 || rest != "" || v.Caption == nil {
+			return nil, false
+		}
+		return *v.Caption, true
+	case "src_url":
+		if index >= 0 || rest != "" || v.SrcUrl == nil {
+			return nil, false
+		}
+		return *v.SrcUrl, true
+	case "uid":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.Uid, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *CWE) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "caption":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Caption, value)
+	case "src_url":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.SrcUrl, value)
+	case "uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.Uid, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *CWE) AppendObservables(path string, observables []Observable) []Observable {
+
+	return observables
+}
+
 var CWEFields = []arrow.Field{
-	{Name: "caption", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "src_url", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: false},
+	{Name: "caption", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Caption", "The caption assigned to the Common Weakness Enumeration unique identifier.", "optional", "")},
+	{Name: "src_url", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Source URL", "URL pointing to the CWE Specification. For more information see <a target='_blank' href='https://cwe.mitre.org/'>CWE.</a>", "optional", "")},
+	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: false, Metadata: ocsf.AttributeMetadata("CWE ID", "The Common Weakness Enumeration unique number assigned to a specific weakness. A CWE Identifier begins \"CWE\" followed by a sequence of digits that acts as a unique identifier. For example: <code>CWE-123</code>.", "required", "")},
 }
 
 var CWEStruct = arrow.StructOf(CWEFields...)
@@ -2,6 +2,7 @@
 package v1_4_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -21,10 +22,86 @@ func (v *MITREDEFENDTechnique) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *MITREDEFENDTechnique) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *MITREDEFENDTechnique) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *MITREDEFENDTechnique) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "name":
+		if index >= 0 || rest != "" || v.Name == nil {
+			return nil, false
+		}
+		return *v.Name, true
+	case "src_url":
+		if index >= 0 || rest != "" || v.SrcUrl == nil {
+			return nil, false
+		}
+		return *v.SrcUrl, true
+	case "uid":
+		if index >= 0 || rest != "" || v.Uid == nil {
+			return nil, false
+		}
+		return *v.Uid, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *MITREDEFENDTechnique) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Name, value)
+	case "src_url":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.SrcUrl, value)
+	case "uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Uid, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *MITREDEFENDTechnique) AppendObservables(path string, observables []Observable) []Observable {
+
+	return observables
+}
+
 var MITREDEFENDTechniqueFields = []arrow.Field{
-	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "src_url", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Name", "The name of the defensive technique. For example: <code>IO Port Restriction</code>.", "optional", "")},
+	{Name: "src_url", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Source URL", "The versioned permalink of the defensive technique. For example: <code>https://d3fend.mitre.org/technique/d3f:IOPortRestriction/</code>.", "optional", "")},
+	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Unique ID", "The unique identifier of the defensive technique. For example: <code>D3-IOPR</code>.", "optional", "")},
 }
 
 var MITREDEFENDTechniqueStruct = arrow.StructOf(MITREDEFENDTechniqueFields...)
@@ -2,6 +2,7 @@
 package v1_4_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -21,10 +22,116 @@ func (v *MITRED3FEND) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *MITRED3FEND) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *MITRED3FEND) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	if v.D3fTactic != nil {
+		errs = append(errs, v.D3fTactic.ValidateAt(ocsf.JoinPath(path, "d3f_tactic"))...)
+	}
+	if v.D3fTechnique != nil {
+		errs = append(errs, v.D3fTechnique.ValidateAt(ocsf.JoinPath(path, "d3f_technique"))...)
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *MITRED3FEND) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "d3f_tactic":
+		if index >= 0 || v.D3fTactic == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.D3fTactic, true
+		}
+		return v.D3fTactic.Get(rest)
+	case "d3f_technique":
+		if index >= 0 || v.D3fTechnique == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.D3fTechnique, true
+		}
+		return v.D3fTechnique.Get(rest)
+	case "version":
+		if index >= 0 || rest != "" || v.Version == nil {
+			return nil, false
+		}
+		return *v.Version, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *MITRED3FEND) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "d3f_tactic":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.D3fTactic, value)
+		}
+		if v.D3fTactic == nil {
+			v.D3fTactic = &MITRED3FENDTactic{}
+		}
+		return v.D3fTactic.Set(rest, value)
+	case "d3f_technique":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.D3fTechnique, value)
+		}
+		if v.D3fTechnique == nil {
+			v.D3fTechnique = &MITREDEFENDTechnique{}
+		}
+		return v.D3fTechnique.Set(rest, value)
+	case "version":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Version, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *MITRED3FEND) AppendObservables(path string, observables []Observable) []Observable {
+	if v.D3fTactic != nil {
+		observables = v.D3fTactic.AppendObservables(ocsf.JoinPath(path, "d3f_tactic"), observables)
+	}
+	if v.D3fTechnique != nil {
+		observables = v.D3fTechnique.AppendObservables(ocsf.JoinPath(path, "d3f_technique"), observables)
+	}
+
+	return observables
+}
+
 var MITRED3FENDFields = []arrow.Field{
-	{Name: "d3f_tactic", Type: MITRED3FENDTacticStruct, Nullable: true},
-	{Name: "d3f_technique", Type: MITREDEFENDTechniqueStruct, Nullable: true},
-	{Name: "version", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "d3f_tactic", Type: MITRED3FENDTacticStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("MITRE DEFEND™ Tactic", "The Tactic object describes the tactic ID and/or name that is associated with a countermeasure.", "optional", "")},
+	{Name: "d3f_technique", Type: MITREDEFENDTechniqueStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("MITRE DEFEND™ Technique", "The Technique object describes the technique ID and/or name associated with a countermeasure.", "optional", "")},
+	{Name: "version", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Version", "The D3FEND™ Matrix version.", "optional", "")},
 }
 
 var MITRED3FENDStruct = arrow.StructOf(MITRED3FENDFields...)
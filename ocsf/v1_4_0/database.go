@@ -2,6 +2,7 @@
 package v1_4_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -42,17 +43,207 @@ func (v *Database) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *Database) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *Database) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	for i := range v.DataClassifications {
+		errs = append(errs, v.DataClassifications[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "data_classifications"), i))...)
+	}
+	for i := range v.Groups {
+		errs = append(errs, v.Groups[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "groups"), i))...)
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *Database) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "created_time":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.CreatedTime, true
+	case "data_classifications":
+		if rest == "" {
+			if index < 0 {
+				return v.DataClassifications, v.DataClassifications != nil
+			}
+			return ocsf.Index(v.DataClassifications, index)
+		}
+		if index < 0 || index >= len(v.DataClassifications) {
+			return nil, false
+		}
+		return v.DataClassifications[index].Get(rest)
+	case "desc":
+		if index >= 0 || rest != "" || v.Desc == nil {
+			return nil, false
+		}
+		return *v.Desc, true
+	case "groups":
+		if rest == "" {
+			if index < 0 {
+				return v.Groups, v.Groups != nil
+			}
+			return ocsf.Index(v.Groups, index)
+		}
+		if index < 0 || index >= len(v.Groups) {
+			return nil, false
+		}
+		return v.Groups[index].Get(rest)
+	case "modified_time":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.ModifiedTime, true
+	case "name":
+		if index >= 0 || rest != "" || v.Name == nil {
+			return nil, false
+		}
+		return *v.Name, true
+	case "size":
+		if index >= 0 || rest != "" || v.Size == nil {
+			return nil, false
+		}
+		return *v.Size, true
+	case "type":
+		if index >= 0 || rest != "" || v.Type == nil {
+			return nil, false
+		}
+		return *v.Type, true
+	case "type_id":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.TypeId, true
+	case "uid":
+		if index >= 0 || rest != "" || v.Uid == nil {
+			return nil, false
+		}
+		return *v.Uid, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *Database) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "created_time":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.CreatedTime, value)
+	case "data_classifications":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.DataClassifications, value)
+		}
+		elem, err := ocsf.Element(&v.DataClassifications, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "desc":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Desc, value)
+	case "groups":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Groups, value)
+		}
+		elem, err := ocsf.Element(&v.Groups, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "modified_time":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.ModifiedTime, value)
+	case "name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Name, value)
+	case "size":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Size, value)
+	case "type":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Type, value)
+	case "type_id":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.TypeId, value)
+	case "uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Uid, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *Database) AppendObservables(path string, observables []Observable) []Observable {
+	for i := range v.DataClassifications {
+		observables = v.DataClassifications[i].AppendObservables(ocsf.JoinPath(path, "data_classifications"), observables)
+	}
+	for i := range v.Groups {
+		observables = v.Groups[i].AppendObservables(ocsf.JoinPath(path, "groups"), observables)
+	}
+
+	return observables
+}
+
 var DatabaseFields = []arrow.Field{
-	{Name: "created_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
-	{Name: "data_classifications", Type: arrow.ListOf(DataClassificationStruct), Nullable: true},
-	{Name: "desc", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "groups", Type: arrow.ListOf(GroupStruct), Nullable: true},
-	{Name: "modified_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
-	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "size", Type: arrow.PrimitiveTypes.Int64, Nullable: true},
-	{Name: "type", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "type_id", Type: arrow.PrimitiveTypes.Int32, Nullable: false},
-	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "created_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true, Metadata: ocsf.AttributeMetadata("Created Time", "The time when the database was known to have been created.", "optional", "")},
+	{Name: "data_classifications", Type: arrow.ListOf(DataClassificationStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("Data Classification", "A list of Data Classification objects, that include information about data classification levels and data category types, indentified by a classifier.", "optional", "")},
+	{Name: "desc", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Description", "The description of the database.", "optional", "")},
+	{Name: "groups", Type: arrow.ListOf(GroupStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("Groups", "The group names to which the database belongs.", "optional", "")},
+	{Name: "modified_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true, Metadata: ocsf.AttributeMetadata("Modified Time", "The most recent time when any changes, updates, or modifications were made within the database.", "optional", "")},
+	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Name", "The database name, ordinarily as assigned by a database administrator.", "optional", "")},
+	{Name: "size", Type: arrow.PrimitiveTypes.Int64, Nullable: true, Metadata: ocsf.AttributeMetadata("Size", "The size of the database in bytes.", "optional", "")},
+	{Name: "type", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Type", "The database type.", "optional", "")},
+	{Name: "type_id", Type: arrow.PrimitiveTypes.Int32, Nullable: false, Metadata: ocsf.AttributeMetadata("Type ID", "The normalized identifier of the database type.", "required", "")},
+	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Unique ID", "The unique identifier of the database.", "optional", "")},
 }
 
 var DatabaseStruct = arrow.StructOf(DatabaseFields...)
@@ -2,6 +2,7 @@
 package v1_4_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -20,7 +21,7 @@ type Databucket struct {
 	Criticality *string `json:"criticality,omitempty" parquet:"criticality,optional"`
 
 	// Data: Additional data describing the resource.
-	Data *string `json:"data,omitempty" parquet:"data,optional"`
+	Data *ocsf.RawJSON `json:"data,omitempty" parquet:"data,optional"`
 
 	// Data Classification: A list of Data Classification objects, that include information about data classification levels and data category types, indentified by a classifier.
 	DataClassifications []DataClassification `json:"data_classifications,omitempty" parquet:"data_classifications,list,optional"`
@@ -93,34 +94,517 @@ func (v *Databucket) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *Databucket) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *Databucket) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	for i := range v.AgentList {
+		errs = append(errs, v.AgentList[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "agent_list"), i))...)
+	}
+	for i := range v.DataClassifications {
+		errs = append(errs, v.DataClassifications[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "data_classifications"), i))...)
+	}
+	if v.EncryptionDetails != nil {
+		errs = append(errs, v.EncryptionDetails.ValidateAt(ocsf.JoinPath(path, "encryption_details"))...)
+	}
+	if v.File != nil {
+		errs = append(errs, v.File.ValidateAt(ocsf.JoinPath(path, "file"))...)
+	}
+	if v.Group != nil {
+		errs = append(errs, v.Group.ValidateAt(ocsf.JoinPath(path, "group"))...)
+	}
+	for i := range v.Groups {
+		errs = append(errs, v.Groups[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "groups"), i))...)
+	}
+	if v.Owner != nil {
+		errs = append(errs, v.Owner.ValidateAt(ocsf.JoinPath(path, "owner"))...)
+	}
+	for i := range v.Tags {
+		errs = append(errs, v.Tags[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "tags"), i))...)
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *Databucket) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "agent_list":
+		if rest == "" {
+			if index < 0 {
+				return v.AgentList, v.AgentList != nil
+			}
+			return ocsf.Index(v.AgentList, index)
+		}
+		if index < 0 || index >= len(v.AgentList) {
+			return nil, false
+		}
+		return v.AgentList[index].Get(rest)
+	case "cloud_partition":
+		if index >= 0 || rest != "" || v.CloudPartition == nil {
+			return nil, false
+		}
+		return *v.CloudPartition, true
+	case "created_time":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.CreatedTime, true
+	case "criticality":
+		if index >= 0 || rest != "" || v.Criticality == nil {
+			return nil, false
+		}
+		return *v.Criticality, true
+	case "data":
+		if index >= 0 || rest != "" || v.Data == nil {
+			return nil, false
+		}
+		return *v.Data, true
+	case "data_classifications":
+		if rest == "" {
+			if index < 0 {
+				return v.DataClassifications, v.DataClassifications != nil
+			}
+			return ocsf.Index(v.DataClassifications, index)
+		}
+		if index < 0 || index >= len(v.DataClassifications) {
+			return nil, false
+		}
+		return v.DataClassifications[index].Get(rest)
+	case "desc":
+		if index >= 0 || rest != "" || v.Desc == nil {
+			return nil, false
+		}
+		return *v.Desc, true
+	case "encryption_details":
+		if index >= 0 || v.EncryptionDetails == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.EncryptionDetails, true
+		}
+		return v.EncryptionDetails.Get(rest)
+	case "file":
+		if index >= 0 || v.File == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.File, true
+		}
+		return v.File.Get(rest)
+	case "group":
+		if index >= 0 || v.Group == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Group, true
+		}
+		return v.Group.Get(rest)
+	case "groups":
+		if rest == "" {
+			if index < 0 {
+				return v.Groups, v.Groups != nil
+			}
+			return ocsf.Index(v.Groups, index)
+		}
+		if index < 0 || index >= len(v.Groups) {
+			return nil, false
+		}
+		return v.Groups[index].Get(rest)
+	case "hostname":
+		if index >= 0 || rest != "" || v.Hostname == nil {
+			return nil, false
+		}
+		return *v.Hostname, true
+	case "ip":
+		if index >= 0 || rest != "" || v.Ip == nil {
+			return nil, false
+		}
+		return *v.Ip, true
+	case "is_encrypted":
+		if index >= 0 || rest != "" || v.IsEncrypted == nil {
+			return nil, false
+		}
+		return *v.IsEncrypted, true
+	case "is_public":
+		if index >= 0 || rest != "" || v.IsPublic == nil {
+			return nil, false
+		}
+		return *v.IsPublic, true
+	case "labels":
+		if rest == "" {
+			if index < 0 {
+				return v.Labels, v.Labels != nil
+			}
+			return ocsf.Index(v.Labels, index)
+		}
+		return nil, false
+	case "modified_time":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.ModifiedTime, true
+	case "name":
+		if index >= 0 || rest != "" || v.Name == nil {
+			return nil, false
+		}
+		return *v.Name, true
+	case "namespace":
+		if index >= 0 || rest != "" || v.Namespace == nil {
+			return nil, false
+		}
+		return *v.Namespace, true
+	case "owner":
+		if index >= 0 || v.Owner == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Owner, true
+		}
+		return v.Owner.Get(rest)
+	case "region":
+		if index >= 0 || rest != "" || v.Region == nil {
+			return nil, false
+		}
+		return *v.Region, true
+	case "size":
+		if index >= 0 || rest != "" || v.Size == nil {
+			return nil, false
+		}
+		return *v.Size, true
+	case "tags":
+		if rest == "" {
+			if index < 0 {
+				return v.Tags, v.Tags != nil
+			}
+			return ocsf.Index(v.Tags, index)
+		}
+		if index < 0 || index >= len(v.Tags) {
+			return nil, false
+		}
+		return v.Tags[index].Get(rest)
+	case "type":
+		if index >= 0 || rest != "" || v.Type == nil {
+			return nil, false
+		}
+		return *v.Type, true
+	case "type_id":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.TypeId, true
+	case "uid":
+		if index >= 0 || rest != "" || v.Uid == nil {
+			return nil, false
+		}
+		return *v.Uid, true
+	case "version":
+		if index >= 0 || rest != "" || v.Version == nil {
+			return nil, false
+		}
+		return *v.Version, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *Databucket) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "agent_list":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.AgentList, value)
+		}
+		elem, err := ocsf.Element(&v.AgentList, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "cloud_partition":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.CloudPartition, value)
+	case "created_time":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.CreatedTime, value)
+	case "criticality":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Criticality, value)
+	case "data":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Data, value)
+	case "data_classifications":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.DataClassifications, value)
+		}
+		elem, err := ocsf.Element(&v.DataClassifications, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "desc":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Desc, value)
+	case "encryption_details":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.EncryptionDetails, value)
+		}
+		if v.EncryptionDetails == nil {
+			v.EncryptionDetails = &EncryptionDetails{}
+		}
+		return v.EncryptionDetails.Set(rest, value)
+	case "file":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.File, value)
+		}
+		if v.File == nil {
+			v.File = &File{}
+		}
+		return v.File.Set(rest, value)
+	case "group":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Group, value)
+		}
+		if v.Group == nil {
+			v.Group = &Group{}
+		}
+		return v.Group.Set(rest, value)
+	case "groups":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Groups, value)
+		}
+		elem, err := ocsf.Element(&v.Groups, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "hostname":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Hostname, value)
+	case "ip":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Ip, value)
+	case "is_encrypted":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.IsEncrypted, value)
+	case "is_public":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.IsPublic, value)
+	case "labels":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Labels, value)
+		}
+		elem, err := ocsf.Element(&v.Labels, index)
+		if err != nil {
+			return err
+		}
+		if rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(elem, value)
+	case "modified_time":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.ModifiedTime, value)
+	case "name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Name, value)
+	case "namespace":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Namespace, value)
+	case "owner":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Owner, value)
+		}
+		if v.Owner == nil {
+			v.Owner = &User{}
+		}
+		return v.Owner.Set(rest, value)
+	case "region":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Region, value)
+	case "size":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Size, value)
+	case "tags":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Tags, value)
+		}
+		elem, err := ocsf.Element(&v.Tags, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "type":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Type, value)
+	case "type_id":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.TypeId, value)
+	case "uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Uid, value)
+	case "version":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Version, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *Databucket) AppendObservables(path string, observables []Observable) []Observable {
+	for i := range v.AgentList {
+		observables = v.AgentList[i].AppendObservables(ocsf.JoinPath(path, "agent_list"), observables)
+	}
+	for i := range v.DataClassifications {
+		observables = v.DataClassifications[i].AppendObservables(ocsf.JoinPath(path, "data_classifications"), observables)
+	}
+	if v.EncryptionDetails != nil {
+		observables = v.EncryptionDetails.AppendObservables(ocsf.JoinPath(path, "encryption_details"), observables)
+	}
+	if v.File != nil {
+		observables = v.File.AppendObservables(ocsf.JoinPath(path, "file"), observables)
+	}
+	if v.Group != nil {
+		observables = v.Group.AppendObservables(ocsf.JoinPath(path, "group"), observables)
+	}
+	for i := range v.Groups {
+		observables = v.Groups[i].AppendObservables(ocsf.JoinPath(path, "groups"), observables)
+	}
+	if v.Hostname != nil {
+		observables = append(observables, Observable{Name: ocsf.Ptr(ocsf.JoinPath(path, "hostname")), TypeId: 1, Value: ocsf.Ptr(*v.Hostname)})
+	}
+	if v.Ip != nil {
+		observables = append(observables, Observable{Name: ocsf.Ptr(ocsf.JoinPath(path, "ip")), TypeId: 2, Value: ocsf.Ptr(*v.Ip)})
+	}
+	if v.Owner != nil {
+		observables = v.Owner.AppendObservables(ocsf.JoinPath(path, "owner"), observables)
+	}
+	for i := range v.Tags {
+		observables = v.Tags[i].AppendObservables(ocsf.JoinPath(path, "tags"), observables)
+	}
+
+	return observables
+}
+
 var DatabucketFields = []arrow.Field{
-	{Name: "agent_list", Type: arrow.ListOf(AgentStruct), Nullable: true},
-	{Name: "cloud_partition", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "created_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
-	{Name: "criticality", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "data", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "data_classifications", Type: arrow.ListOf(DataClassificationStruct), Nullable: true},
-	{Name: "desc", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "encryption_details", Type: EncryptionDetailsStruct, Nullable: true},
-	{Name: "file", Type: FileStruct, Nullable: true},
-	{Name: "group", Type: GroupStruct, Nullable: true},
-	{Name: "groups", Type: arrow.ListOf(GroupStruct), Nullable: true},
-	{Name: "hostname", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "ip", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "is_encrypted", Type: arrow.FixedWidthTypes.Boolean, Nullable: true},
-	{Name: "is_public", Type: arrow.FixedWidthTypes.Boolean, Nullable: true},
-	{Name: "labels", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true},
-	{Name: "modified_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
-	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "namespace", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "owner", Type: UserStruct, Nullable: true},
-	{Name: "region", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "size", Type: arrow.PrimitiveTypes.Int64, Nullable: true},
-	{Name: "tags", Type: arrow.ListOf(KeyValueobjectStruct), Nullable: true},
-	{Name: "type", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "type_id", Type: arrow.PrimitiveTypes.Int32, Nullable: false},
-	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "version", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "agent_list", Type: arrow.ListOf(AgentStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("Agent List", "A list of <code>agent</code> objects associated with a device, endpoint, or resource.", "optional", "")},
+	{Name: "cloud_partition", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Cloud Partition", "The canonical cloud partition name to which the region is assigned (e.g. AWS Partitions: aws, aws-cn, aws-us-gov).", "optional", "")},
+	{Name: "created_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true, Metadata: ocsf.AttributeMetadata("Created Time", "The time when the databucket was known to have been created.", "optional", "")},
+	{Name: "criticality", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Criticality", "The criticality of the resource as defined by the event source.", "optional", "")},
+	{Name: "data", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Data", "Additional data describing the resource.", "optional", "")},
+	{Name: "data_classifications", Type: arrow.ListOf(DataClassificationStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("Data Classification", "A list of Data Classification objects, that include information about data classification levels and data category types, indentified by a classifier.", "optional", "")},
+	{Name: "desc", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Description", "The description of the databucket.", "optional", "")},
+	{Name: "encryption_details", Type: EncryptionDetailsStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Encryption Details", "The encryption details of the databucket. Should be populated if the databucket is encrypted.", "optional", "")},
+	{Name: "file", Type: FileStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("File", "Details about the file/object within a databucket.", "optional", "")},
+	{Name: "group", Type: GroupStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Group", "The name of the related resource group.", "optional", "")},
+	{Name: "groups", Type: arrow.ListOf(GroupStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("Groups", "The group names to which the databucket belongs.", "optional", "")},
+	{Name: "hostname", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Hostname", "The fully qualified name of the resource.", "optional", "")},
+	{Name: "ip", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("IP Address", "The IP address of the resource, in either IPv4 or IPv6 format.", "optional", "")},
+	{Name: "is_encrypted", Type: arrow.FixedWidthTypes.Boolean, Nullable: true, Metadata: ocsf.AttributeMetadata("Encrypted", "Indicates if the databucket is encrypted.", "optional", "")},
+	{Name: "is_public", Type: arrow.FixedWidthTypes.Boolean, Nullable: true, Metadata: ocsf.AttributeMetadata("Public", "Indicates if the databucket is publicly accessible.", "optional", "")},
+	{Name: "labels", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true, Metadata: ocsf.AttributeMetadata("Labels", "The list of labels associated to the resource.", "optional", "")},
+	{Name: "modified_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true, Metadata: ocsf.AttributeMetadata("Modified Time", "The most recent time when any changes, updates, or modifications were made within the databucket.", "optional", "")},
+	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Name", "The databucket name.", "optional", "")},
+	{Name: "namespace", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Namespace", "The namespace is useful when similar entities exist that you need to keep separate.", "optional", "")},
+	{Name: "owner", Type: UserStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Owner", "The identity of the service or user account that owns the resource.", "optional", "")},
+	{Name: "region", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Region", "The cloud region of the resource.", "optional", "")},
+	{Name: "size", Type: arrow.PrimitiveTypes.Int64, Nullable: true, Metadata: ocsf.AttributeMetadata("Size", "The size of the databucket in bytes.", "optional", "")},
+	{Name: "tags", Type: arrow.ListOf(KeyValueobjectStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("Tags", "The list of tags; <code>{key:value}</code> pairs associated to the resource.", "optional", "")},
+	{Name: "type", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Type", "The databucket type.", "optional", "")},
+	{Name: "type_id", Type: arrow.PrimitiveTypes.Int32, Nullable: false, Metadata: ocsf.AttributeMetadata("Type ID", "The normalized identifier of the databucket type.", "required", "")},
+	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Unique ID", "The unique identifier of the databucket.", "optional", "")},
+	{Name: "version", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Version", "The version of the resource. For example <code>1.2.3</code>.", "optional", "")},
 }
 
 var DatabucketStruct = arrow.StructOf(DatabucketFields...)
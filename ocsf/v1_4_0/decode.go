@@ -0,0 +1,112 @@
+// autogenerated by scripts/model_gen.go. DO NOT EDIT
+package v1_4_0
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
+)
+
+// eventClasses creates an empty event of each class by class_uid.
+var eventClasses = map[int32]func() Event{
+	3001: func() Event { return &AccountChange{} },
+	5009: func() Event { return &AdminGroupQuery{} },
+	8002: func() Event { return &AirborneBroadcastActivity{} },
+	6003: func() Event { return &APIActivity{} },
+	6008: func() Event { return &ApplicationError{} },
+	6002: func() Event { return &ApplicationLifecycle{} },
+	3002: func() Event { return &Authentication{} },
+	3003: func() Event { return &AuthorizeSession{} },
+	0:    func() Event { return &BaseEvent{} },
+	5023: func() Event { return &CloudResourcesInventoryInfo{} },
+	2003: func() Event { return &ComplianceFinding{} },
+	5002: func() Event { return &DeviceConfigState{} },
+	2006: func() Event { return &DataSecurityFinding{} },
+	6005: func() Event { return &DatastoreActivity{} },
+	2004: func() Event { return &DetectionFinding{} },
+	5019: func() Event { return &DeviceConfigStateChange{} },
+	4004: func() Event { return &DHCPActivity{} },
+	4003: func() Event { return &DNSActivity{} },
+	8001: func() Event { return &DroneFlightsActivity{} },
+	4009: func() Event { return &EmailActivity{} },
+	4011: func() Event { return &EmailFileActivity{} },
+	4012: func() Event { return &EmailURLActivity{} },
+	3004: func() Event { return &EntityManagement{} },
+	1008: func() Event { return &EventLogActivity{} },
+	1001: func() Event { return &FileSystemActivity{} },
+	6006: func() Event { return &FileHostingActivity{} },
+	5007: func() Event { return &FileQuery{} },
+	7002: func() Event { return &FileRemediationActivity{} },
+	5008: func() Event { return &FolderQuery{} },
+	4008: func() Event { return &FTPActivity{} },
+	3006: func() Event { return &GroupManagement{} },
+	4002: func() Event { return &HTTPActivity{} },
+	2005: func() Event { return &IncidentFinding{} },
+	5001: func() Event { return &DeviceInventoryInfo{} },
+	5010: func() Event { return &JobQuery{} },
+	1003: func() Event { return &KernelActivity{} },
+	1002: func() Event { return &KernelExtensionActivity{} },
+	5006: func() Event { return &KernelObjectQuery{} },
+	1004: func() Event { return &MemoryActivity{} },
+	1005: func() Event { return &ModuleActivity{} },
+	5011: func() Event { return &ModuleQuery{} },
+	4001: func() Event { return &NetworkActivity{} },
+	5012: func() Event { return &NetworkConnectionQuery{} },
+	4010: func() Event { return &NetworkFileActivity{} },
+	7004: func() Event { return &NetworkRemediationActivity{} },
+	5013: func() Event { return &NetworksQuery{} },
+	4013: func() Event { return &NTPActivity{} },
+	5021: func() Event { return &OSINTInventoryInfo{} },
+	5004: func() Event { return &OperatingSystemPatchState{} },
+	5014: func() Event { return &PeripheralDeviceQuery{} },
+	1007: func() Event { return &ProcessActivity{} },
+	5015: func() Event { return &ProcessQuery{} },
+	7003: func() Event { return &ProcessRemediationActivity{} },
+	4005: func() Event { return &RDPActivity{} },
+	7001: func() Event { return &RemediationActivity{} },
+	6007: func() Event { return &ScanActivity{} },
+	1006: func() Event { return &ScheduledJobActivity{} },
+	1009: func() Event { return &ScriptActivity{} },
+	2001: func() Event { return &SecurityFinding{} },
+	5016: func() Event { return &ServiceQuery{} },
+	5017: func() Event { return &UserSessionQuery{} },
+	4006: func() Event { return &SMBActivity{} },
+	5020: func() Event { return &SoftwareInventoryInfo{} },
+	4007: func() Event { return &SSHActivity{} },
+	5022: func() Event { return &StartupItemQuery{} },
+	4014: func() Event { return &TunnelActivity{} },
+	3005: func() Event { return &UserAccessManagement{} },
+	5003: func() Event { return &UserInventoryInfo{} },
+	5018: func() Event { return &UserQuery{} },
+	2002: func() Event { return &VulnerabilityFinding{} },
+	6004: func() Event { return &WebResourceAccessActivity{} },
+	6001: func() Event { return &WebResourcesActivity{} },
+}
+
+// Decode decodes a raw OCSF event into the class named by its class_uid, e.g. an *APIActivity for
+// class_uid 6003.
+func Decode(data []byte) (Event, error) {
+	classUID, err := ocsf.ClassUID(data)
+	if err != nil {
+		return nil, err
+	}
+
+	newEvent, ok := eventClasses[classUID]
+	if !ok {
+		return nil, fmt.Errorf("unknown class_uid %d", classUID)
+	}
+
+	event := newEvent()
+	if err := json.Unmarshal(data, event); err != nil {
+		return nil, fmt.Errorf("failed to decode class_uid %d: %w", classUID, err)
+	}
+	return event, nil
+}
+
+// NewDecoder returns a decoder reading a stream of OCSF events, such as newline-delimited JSON,
+// from r. Its Decode method returns io.EOF once the stream is exhausted.
+func NewDecoder(r io.Reader) *ocsf.Decoder[Event] {
+	return ocsf.NewDecoder(r, Decode)
+}
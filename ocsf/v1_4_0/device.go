@@ -2,6 +2,7 @@
 package v1_4_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -157,55 +158,777 @@ func (v *Device) Observable() (*int, string) {
 	return &typeId, "device"
 }
 
+func (v *Device) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *Device) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	for i := range v.AgentList {
+		errs = append(errs, v.AgentList[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "agent_list"), i))...)
+	}
+	if v.Container != nil {
+		errs = append(errs, v.Container.ValidateAt(ocsf.JoinPath(path, "container"))...)
+	}
+	for i := range v.Groups {
+		errs = append(errs, v.Groups[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "groups"), i))...)
+	}
+	if v.HwInfo != nil {
+		errs = append(errs, v.HwInfo.ValidateAt(ocsf.JoinPath(path, "hw_info"))...)
+	}
+	if v.Image != nil {
+		errs = append(errs, v.Image.ValidateAt(ocsf.JoinPath(path, "image"))...)
+	}
+	if v.Location != nil {
+		errs = append(errs, v.Location.ValidateAt(ocsf.JoinPath(path, "location"))...)
+	}
+	for i := range v.NetworkInterfaces {
+		errs = append(errs, v.NetworkInterfaces[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "network_interfaces"), i))...)
+	}
+	if v.Org != nil {
+		errs = append(errs, v.Org.ValidateAt(ocsf.JoinPath(path, "org"))...)
+	}
+	if v.Os != nil {
+		errs = append(errs, v.Os.ValidateAt(ocsf.JoinPath(path, "os"))...)
+	}
+	if v.Owner != nil {
+		errs = append(errs, v.Owner.ValidateAt(ocsf.JoinPath(path, "owner"))...)
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *Device) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "agent_list":
+		if rest == "" {
+			if index < 0 {
+				return v.AgentList, v.AgentList != nil
+			}
+			return ocsf.Index(v.AgentList, index)
+		}
+		if index < 0 || index >= len(v.AgentList) {
+			return nil, false
+		}
+		return v.AgentList[index].Get(rest)
+	case "autoscale_uid":
+		if index >= 0 || rest != "" || v.AutoscaleUid == nil {
+			return nil, false
+		}
+		return *v.AutoscaleUid, true
+	case "boot_time":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.BootTime, true
+	case "container":
+		if index >= 0 || v.Container == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Container, true
+		}
+		return v.Container.Get(rest)
+	case "created_time":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.CreatedTime, true
+	case "desc":
+		if index >= 0 || rest != "" || v.Desc == nil {
+			return nil, false
+		}
+		return *v.Desc, true
+	case "domain":
+		if index >= 0 || rest != "" || v.Domain == nil {
+			return nil, false
+		}
+		return *v.Domain, true
+	case "first_seen_time":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.FirstSeenTime, true
+	case "groups":
+		if rest == "" {
+			if index < 0 {
+				return v.Groups, v.Groups != nil
+			}
+			return ocsf.Index(v.Groups, index)
+		}
+		if index < 0 || index >= len(v.Groups) {
+			return nil, false
+		}
+		return v.Groups[index].Get(rest)
+	case "hostname":
+		if index >= 0 || rest != "" || v.Hostname == nil {
+			return nil, false
+		}
+		return *v.Hostname, true
+	case "hw_info":
+		if index >= 0 || v.HwInfo == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.HwInfo, true
+		}
+		return v.HwInfo.Get(rest)
+	case "hypervisor":
+		if index >= 0 || rest != "" || v.Hypervisor == nil {
+			return nil, false
+		}
+		return *v.Hypervisor, true
+	case "image":
+		if index >= 0 || v.Image == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Image, true
+		}
+		return v.Image.Get(rest)
+	case "imei_list":
+		if rest == "" {
+			if index < 0 {
+				return v.ImeiList, v.ImeiList != nil
+			}
+			return ocsf.Index(v.ImeiList, index)
+		}
+		return nil, false
+	case "instance_uid":
+		if index >= 0 || rest != "" || v.InstanceUid == nil {
+			return nil, false
+		}
+		return *v.InstanceUid, true
+	case "interface_name":
+		if index >= 0 || rest != "" || v.InterfaceName == nil {
+			return nil, false
+		}
+		return *v.InterfaceName, true
+	case "interface_uid":
+		if index >= 0 || rest != "" || v.InterfaceUid == nil {
+			return nil, false
+		}
+		return *v.InterfaceUid, true
+	case "ip":
+		if index >= 0 || rest != "" || v.Ip == nil {
+			return nil, false
+		}
+		return *v.Ip, true
+	case "is_compliant":
+		if index >= 0 || rest != "" || v.IsCompliant == nil {
+			return nil, false
+		}
+		return *v.IsCompliant, true
+	case "is_managed":
+		if index >= 0 || rest != "" || v.IsManaged == nil {
+			return nil, false
+		}
+		return *v.IsManaged, true
+	case "is_personal":
+		if index >= 0 || rest != "" || v.IsPersonal == nil {
+			return nil, false
+		}
+		return *v.IsPersonal, true
+	case "is_trusted":
+		if index >= 0 || rest != "" || v.IsTrusted == nil {
+			return nil, false
+		}
+		return *v.IsTrusted, true
+	case "last_seen_time":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.LastSeenTime, true
+	case "location":
+		if index >= 0 || v.Location == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Location, true
+		}
+		return v.Location.Get(rest)
+	case "mac":
+		if index >= 0 || rest != "" || v.Mac == nil {
+			return nil, false
+		}
+		return *v.Mac, true
+	case "model":
+		if index >= 0 || rest != "" || v.Model == nil {
+			return nil, false
+		}
+		return *v.Model, true
+	case "modified_time":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.ModifiedTime, true
+	case "name":
+		if index >= 0 || rest != "" || v.Name == nil {
+			return nil, false
+		}
+		return *v.Name, true
+	case "namespace_pid":
+		if index >= 0 || rest != "" || v.NamespacePid == nil {
+			return nil, false
+		}
+		return *v.NamespacePid, true
+	case "network_interfaces":
+		if rest == "" {
+			if index < 0 {
+				return v.NetworkInterfaces, v.NetworkInterfaces != nil
+			}
+			return ocsf.Index(v.NetworkInterfaces, index)
+		}
+		if index < 0 || index >= len(v.NetworkInterfaces) {
+			return nil, false
+		}
+		return v.NetworkInterfaces[index].Get(rest)
+	case "org":
+		if index >= 0 || v.Org == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Org, true
+		}
+		return v.Org.Get(rest)
+	case "os":
+		if index >= 0 || v.Os == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Os, true
+		}
+		return v.Os.Get(rest)
+	case "os_machine_uuid":
+		if index >= 0 || rest != "" || v.OsMachineUuid == nil {
+			return nil, false
+		}
+		return *v.OsMachineUuid, true
+	case "owner":
+		if index >= 0 || v.Owner == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Owner, true
+		}
+		return v.Owner.Get(rest)
+	case "region":
+		if index >= 0 || rest != "" || v.Region == nil {
+			return nil, false
+		}
+		return *v.Region, true
+	case "risk_level":
+		if index >= 0 || rest != "" || v.RiskLevel == nil {
+			return nil, false
+		}
+		return *v.RiskLevel, true
+	case "risk_level_id":
+		if index >= 0 || rest != "" || v.RiskLevelId == nil {
+			return nil, false
+		}
+		return *v.RiskLevelId, true
+	case "risk_score":
+		if index >= 0 || rest != "" || v.RiskScore == nil {
+			return nil, false
+		}
+		return *v.RiskScore, true
+	case "subnet":
+		if index >= 0 || rest != "" || v.Subnet == nil {
+			return nil, false
+		}
+		return *v.Subnet, true
+	case "subnet_uid":
+		if index >= 0 || rest != "" || v.SubnetUid == nil {
+			return nil, false
+		}
+		return *v.SubnetUid, true
+	case "type":
+		if index >= 0 || rest != "" || v.Type == nil {
+			return nil, false
+		}
+		return *v.Type, true
+	case "type_id":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.TypeId, true
+	case "uid":
+		if index >= 0 || rest != "" || v.Uid == nil {
+			return nil, false
+		}
+		return *v.Uid, true
+	case "uid_alt":
+		if index >= 0 || rest != "" || v.UidAlt == nil {
+			return nil, false
+		}
+		return *v.UidAlt, true
+	case "vendor_name":
+		if index >= 0 || rest != "" || v.VendorName == nil {
+			return nil, false
+		}
+		return *v.VendorName, true
+	case "vlan_uid":
+		if index >= 0 || rest != "" || v.VlanUid == nil {
+			return nil, false
+		}
+		return *v.VlanUid, true
+	case "vpc_uid":
+		if index >= 0 || rest != "" || v.VpcUid == nil {
+			return nil, false
+		}
+		return *v.VpcUid, true
+	case "zone":
+		if index >= 0 || rest != "" || v.Zone == nil {
+			return nil, false
+		}
+		return *v.Zone, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *Device) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "agent_list":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.AgentList, value)
+		}
+		elem, err := ocsf.Element(&v.AgentList, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "autoscale_uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.AutoscaleUid, value)
+	case "boot_time":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.BootTime, value)
+	case "container":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Container, value)
+		}
+		if v.Container == nil {
+			v.Container = &Container{}
+		}
+		return v.Container.Set(rest, value)
+	case "created_time":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.CreatedTime, value)
+	case "desc":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Desc, value)
+	case "domain":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Domain, value)
+	case "first_seen_time":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.FirstSeenTime, value)
+	case "groups":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Groups, value)
+		}
+		elem, err := ocsf.Element(&v.Groups, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "hostname":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Hostname, value)
+	case "hw_info":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.HwInfo, value)
+		}
+		if v.HwInfo == nil {
+			v.HwInfo = &DeviceHardwareInfo{}
+		}
+		return v.HwInfo.Set(rest, value)
+	case "hypervisor":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Hypervisor, value)
+	case "image":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Image, value)
+		}
+		if v.Image == nil {
+			v.Image = &Image{}
+		}
+		return v.Image.Set(rest, value)
+	case "imei_list":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.ImeiList, value)
+		}
+		elem, err := ocsf.Element(&v.ImeiList, index)
+		if err != nil {
+			return err
+		}
+		if rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(elem, value)
+	case "instance_uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.InstanceUid, value)
+	case "interface_name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.InterfaceName, value)
+	case "interface_uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.InterfaceUid, value)
+	case "ip":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Ip, value)
+	case "is_compliant":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.IsCompliant, value)
+	case "is_managed":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.IsManaged, value)
+	case "is_personal":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.IsPersonal, value)
+	case "is_trusted":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.IsTrusted, value)
+	case "last_seen_time":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.LastSeenTime, value)
+	case "location":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Location, value)
+		}
+		if v.Location == nil {
+			v.Location = &GeoLocation{}
+		}
+		return v.Location.Set(rest, value)
+	case "mac":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Mac, value)
+	case "model":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Model, value)
+	case "modified_time":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.ModifiedTime, value)
+	case "name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Name, value)
+	case "namespace_pid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.NamespacePid, value)
+	case "network_interfaces":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.NetworkInterfaces, value)
+		}
+		elem, err := ocsf.Element(&v.NetworkInterfaces, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "org":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Org, value)
+		}
+		if v.Org == nil {
+			v.Org = &Organization{}
+		}
+		return v.Org.Set(rest, value)
+	case "os":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Os, value)
+		}
+		if v.Os == nil {
+			v.Os = &OperatingSystemOS{}
+		}
+		return v.Os.Set(rest, value)
+	case "os_machine_uuid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.OsMachineUuid, value)
+	case "owner":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Owner, value)
+		}
+		if v.Owner == nil {
+			v.Owner = &User{}
+		}
+		return v.Owner.Set(rest, value)
+	case "region":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Region, value)
+	case "risk_level":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.RiskLevel, value)
+	case "risk_level_id":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.RiskLevelId, value)
+	case "risk_score":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.RiskScore, value)
+	case "subnet":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Subnet, value)
+	case "subnet_uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.SubnetUid, value)
+	case "type":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Type, value)
+	case "type_id":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.TypeId, value)
+	case "uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Uid, value)
+	case "uid_alt":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.UidAlt, value)
+	case "vendor_name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.VendorName, value)
+	case "vlan_uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.VlanUid, value)
+	case "vpc_uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.VpcUid, value)
+	case "zone":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Zone, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *Device) AppendObservables(path string, observables []Observable) []Observable {
+	if path != "" {
+		observables = append(observables, Observable{Name: ocsf.Ptr(path), TypeId: 20})
+	}
+	for i := range v.AgentList {
+		observables = v.AgentList[i].AppendObservables(ocsf.JoinPath(path, "agent_list"), observables)
+	}
+	if v.Container != nil {
+		observables = v.Container.AppendObservables(ocsf.JoinPath(path, "container"), observables)
+	}
+	for i := range v.Groups {
+		observables = v.Groups[i].AppendObservables(ocsf.JoinPath(path, "groups"), observables)
+	}
+	if v.Hostname != nil {
+		observables = append(observables, Observable{Name: ocsf.Ptr(ocsf.JoinPath(path, "hostname")), TypeId: 1, Value: ocsf.Ptr(*v.Hostname)})
+	}
+	if v.HwInfo != nil {
+		observables = v.HwInfo.AppendObservables(ocsf.JoinPath(path, "hw_info"), observables)
+	}
+	if v.Image != nil {
+		observables = v.Image.AppendObservables(ocsf.JoinPath(path, "image"), observables)
+	}
+	if v.Ip != nil {
+		observables = append(observables, Observable{Name: ocsf.Ptr(ocsf.JoinPath(path, "ip")), TypeId: 2, Value: ocsf.Ptr(*v.Ip)})
+	}
+	if v.Location != nil {
+		observables = v.Location.AppendObservables(ocsf.JoinPath(path, "location"), observables)
+	}
+	if v.Mac != nil {
+		observables = append(observables, Observable{Name: ocsf.Ptr(ocsf.JoinPath(path, "mac")), TypeId: 3, Value: ocsf.Ptr(*v.Mac)})
+	}
+	for i := range v.NetworkInterfaces {
+		observables = v.NetworkInterfaces[i].AppendObservables(ocsf.JoinPath(path, "network_interfaces"), observables)
+	}
+	if v.Org != nil {
+		observables = v.Org.AppendObservables(ocsf.JoinPath(path, "org"), observables)
+	}
+	if v.Os != nil {
+		observables = v.Os.AppendObservables(ocsf.JoinPath(path, "os"), observables)
+	}
+	if v.Owner != nil {
+		observables = v.Owner.AppendObservables(ocsf.JoinPath(path, "owner"), observables)
+	}
+
+	return observables
+}
+
 var DeviceFields = []arrow.Field{
-	{Name: "agent_list", Type: arrow.ListOf(AgentStruct), Nullable: true},
-	{Name: "autoscale_uid", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "boot_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
-	{Name: "container", Type: ContainerStruct, Nullable: true},
-	{Name: "created_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
-	{Name: "desc", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "domain", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "first_seen_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
-	{Name: "groups", Type: arrow.ListOf(GroupStruct), Nullable: true},
-	{Name: "hostname", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "hw_info", Type: DeviceHardwareInfoStruct, Nullable: true},
-	{Name: "hypervisor", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "image", Type: ImageStruct, Nullable: true},
-	{Name: "imei_list", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true},
-	{Name: "instance_uid", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "interface_name", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "interface_uid", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "ip", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "is_compliant", Type: arrow.FixedWidthTypes.Boolean, Nullable: true},
-	{Name: "is_managed", Type: arrow.FixedWidthTypes.Boolean, Nullable: true},
-	{Name: "is_personal", Type: arrow.FixedWidthTypes.Boolean, Nullable: true},
-	{Name: "is_trusted", Type: arrow.FixedWidthTypes.Boolean, Nullable: true},
-	{Name: "last_seen_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
-	{Name: "location", Type: GeoLocationStruct, Nullable: true},
-	{Name: "mac", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "model", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "modified_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
-	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "namespace_pid", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "network_interfaces", Type: arrow.ListOf(NetworkInterfaceStruct), Nullable: true},
-	{Name: "org", Type: OrganizationStruct, Nullable: true},
-	{Name: "os", Type: OperatingSystemOSStruct, Nullable: true},
-	{Name: "os_machine_uuid", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "owner", Type: UserStruct, Nullable: true},
-	{Name: "region", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "risk_level", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "risk_level_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "risk_score", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "subnet", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "subnet_uid", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "type", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "type_id", Type: arrow.PrimitiveTypes.Int32, Nullable: false},
-	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "uid_alt", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "vendor_name", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "vlan_uid", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "vpc_uid", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "zone", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "agent_list", Type: arrow.ListOf(AgentStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("Agent List", "A list of <code>agent</code> objects associated with a device, endpoint, or resource.", "optional", "")},
+	{Name: "autoscale_uid", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Autoscale UID", "The unique identifier of the cloud autoscale configuration.", "optional", "")},
+	{Name: "boot_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true, Metadata: ocsf.AttributeMetadata("Boot Time", "The time the system was booted.", "optional", "")},
+	{Name: "container", Type: ContainerStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Container", "The information describing an instance of a container. A container is a prepackaged, portable system image that runs isolated on an existing system using a container runtime like containerd.", "optional", "")},
+	{Name: "created_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true, Metadata: ocsf.AttributeMetadata("Created Time", "The time when the device was known to have been created.", "optional", "")},
+	{Name: "desc", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Description", "The description of the device, ordinarily as reported by the operating system.", "optional", "")},
+	{Name: "domain", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Domain", "The network domain where the device resides. For example: <code>work.example.com</code>.", "optional", "")},
+	{Name: "first_seen_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true, Metadata: ocsf.AttributeMetadata("First Seen", "The initial discovery time of the device.", "optional", "")},
+	{Name: "groups", Type: arrow.ListOf(GroupStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("Groups", "The group names to which the device belongs. For example: <code>[\"Windows Laptops\", \"Engineering\"]</code>.", "optional", "")},
+	{Name: "hostname", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Hostname", "The device hostname.", "optional", "")},
+	{Name: "hw_info", Type: DeviceHardwareInfoStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Hardware Info", "The endpoint hardware information.", "optional", "")},
+	{Name: "hypervisor", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Hypervisor", "The name of the hypervisor running on the device. For example, <code>Xen</code>, <code>VMware</code>, <code>Hyper-V</code>, <code>VirtualBox</code>, etc.", "optional", "")},
+	{Name: "image", Type: ImageStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Image", "The image used as a template to run the virtual machine.", "optional", "")},
+	{Name: "imei_list", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true, Metadata: ocsf.AttributeMetadata("IMEI List", "The International Mobile Equipment Identity values that are associated with the device.", "optional", "")},
+	{Name: "instance_uid", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Instance ID", "The unique identifier of a VM instance.", "optional", "")},
+	{Name: "interface_name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Network Interface Name", "The name of the network interface (e.g. eth2).", "optional", "")},
+	{Name: "interface_uid", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Network Interface ID", "The unique identifier of the network interface.", "optional", "")},
+	{Name: "ip", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("IP Address", "The device IP address, in either IPv4 or IPv6 format.", "optional", "")},
+	{Name: "is_compliant", Type: arrow.FixedWidthTypes.Boolean, Nullable: true, Metadata: ocsf.AttributeMetadata("Compliant Device", "The event occurred on a compliant device.", "optional", "")},
+	{Name: "is_managed", Type: arrow.FixedWidthTypes.Boolean, Nullable: true, Metadata: ocsf.AttributeMetadata("Managed Device", "The event occurred on a managed device.", "optional", "")},
+	{Name: "is_personal", Type: arrow.FixedWidthTypes.Boolean, Nullable: true, Metadata: ocsf.AttributeMetadata("Personal Device", "The event occurred on a personal device.", "optional", "")},
+	{Name: "is_trusted", Type: arrow.FixedWidthTypes.Boolean, Nullable: true, Metadata: ocsf.AttributeMetadata("Trusted Device", "The event occurred on a trusted device.", "optional", "")},
+	{Name: "last_seen_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true, Metadata: ocsf.AttributeMetadata("Last Seen", "The most recent discovery time of the device.", "optional", "")},
+	{Name: "location", Type: GeoLocationStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Geo Location", "The geographical location of the device.", "optional", "")},
+	{Name: "mac", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("MAC Address", "The Media Access Control (MAC) address of the endpoint.", "optional", "")},
+	{Name: "model", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Model", "The model of the device. For example <code>ThinkPad X1 Carbon</code>.", "optional", "")},
+	{Name: "modified_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true, Metadata: ocsf.AttributeMetadata("Modified Time", "The time when the device was last known to have been modified.", "optional", "")},
+	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Name", "The alternate device name, ordinarily as assigned by an administrator. <p><b>Note:</b> The <b>Name</b> could be any other string that helps to identify the device, such as a phone number; for example <code>310-555-1234</code>.</p>", "optional", "")},
+	{Name: "namespace_pid", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Namespace PID", "If running under a process namespace (such as in a container), the process identifier within that process namespace.", "optional", "")},
+	{Name: "network_interfaces", Type: arrow.ListOf(NetworkInterfaceStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("Network Interfaces", "The network interfaces that are associated with the device, one for each unique MAC address/IP address/hostname/name combination.<p><b>Note:</b> The first element of the array is the network information that pertains to the event.</p>", "optional", "")},
+	{Name: "org", Type: OrganizationStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Organization", "Organization and org unit related to the device.", "optional", "")},
+	{Name: "os", Type: OperatingSystemOSStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("OS", "The endpoint operating system.", "optional", "")},
+	{Name: "os_machine_uuid", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("OS Machine UUID", "The operating system assigned Machine ID. In Windows, this is the value stored at the registry path: <code>HKEY_LOCAL_MACHINE\\SOFTWARE\\Microsoft\\Cryptography\\MachineGuid</code>. In Linux, this is stored in the file: <code>/etc/machine-id</code>.", "optional", "")},
+	{Name: "owner", Type: UserStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Owner", "The identity of the service or user account that owns the endpoint or was last logged into it.", "optional", "")},
+	{Name: "region", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Region", "The region where the virtual machine is located. For example, an AWS Region.", "optional", "")},
+	{Name: "risk_level", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Risk Level", "The risk level, normalized to the caption of the risk_level_id value.", "optional", "")},
+	{Name: "risk_level_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Risk Level ID", "The normalized risk level id.", "optional", "")},
+	{Name: "risk_score", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Risk Score", "The risk score as reported by the event source.", "optional", "")},
+	{Name: "subnet", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Subnet", "The subnet mask.", "optional", "")},
+	{Name: "subnet_uid", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Subnet UID", "The unique identifier of a virtual subnet.", "optional", "")},
+	{Name: "type", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Type", "The device type. For example: <code>unknown</code>, <code>server</code>, <code>desktop</code>, <code>laptop</code>, <code>tablet</code>, <code>mobile</code>, <code>virtual</code>, <code>browser</code>, or <code>other</code>.", "optional", "")},
+	{Name: "type_id", Type: arrow.PrimitiveTypes.Int32, Nullable: false, Metadata: ocsf.AttributeMetadata("Type ID", "The device type ID.", "required", "")},
+	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Unique ID", "The unique identifier of the device. For example the Windows TargetSID or AWS EC2 ARN.", "optional", "")},
+	{Name: "uid_alt", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Alternate ID", "An alternate unique identifier of the device if any. For example the ActiveDirectory DN.", "optional", "")},
+	{Name: "vendor_name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Vendor Name", "The vendor for the device. For example <code>Dell</code> or <code>Lenovo</code>.", "optional", "")},
+	{Name: "vlan_uid", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("VLAN", "The Virtual LAN identifier.", "optional", "")},
+	{Name: "vpc_uid", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("VPC UID", "The unique identifier of the Virtual Private Cloud (VPC).", "optional", "")},
+	{Name: "zone", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Network Zone", "The network zone or LAN segment.", "optional", "")},
 }
 
 var DeviceStruct = arrow.StructOf(DeviceFields...)
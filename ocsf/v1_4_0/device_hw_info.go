@@ -2,6 +2,7 @@
 package v1_4_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -63,24 +64,270 @@ func (v *DeviceHardwareInfo) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *DeviceHardwareInfo) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *DeviceHardwareInfo) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	if v.DesktopDisplay != nil {
+		errs = append(errs, v.DesktopDisplay.ValidateAt(ocsf.JoinPath(path, "desktop_display"))...)
+	}
+	if v.KeyboardInfo != nil {
+		errs = append(errs, v.KeyboardInfo.ValidateAt(ocsf.JoinPath(path, "keyboard_info"))...)
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *DeviceHardwareInfo) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "bios_date":
+		if index >= 0 || rest != "" || v.BiosDate == nil {
+			return nil, false
+		}
+		return *v.BiosDate, true
+	case "bios_manufacturer":
+		if index >= 0 || rest != "" || v.BiosManufacturer == nil {
+			return nil, false
+		}
+		return *v.BiosManufacturer, true
+	case "bios_ver":
+		if index >= 0 || rest != "" || v.BiosVer == nil {
+			return nil, false
+		}
+		return *v.BiosVer, true
+	case "chassis":
+		if index >= 0 || rest != "" || v.Chassis == nil {
+			return nil, false
+		}
+		return *v.Chassis, true
+	case "cpu_architecture":
+		if index >= 0 || rest != "" || v.CpuArchitecture == nil {
+			return nil, false
+		}
+		return *v.CpuArchitecture, true
+	case "cpu_architecture_id":
+		if index >= 0 || rest != "" || v.CpuArchitectureId == nil {
+			return nil, false
+		}
+		return *v.CpuArchitectureId, true
+	case "cpu_bits":
+		if index >= 0 || rest != "" || v.CpuBits == nil {
+			return nil, false
+		}
+		return *v.CpuBits, true
+	case "cpu_cores":
+		if index >= 0 || rest != "" || v.CpuCores == nil {
+			return nil, false
+		}
+		return *v.CpuCores, true
+	case "cpu_count":
+		if index >= 0 || rest != "" || v.CpuCount == nil {
+			return nil, false
+		}
+		return *v.CpuCount, true
+	case "cpu_speed":
+		if index >= 0 || rest != "" || v.CpuSpeed == nil {
+			return nil, false
+		}
+		return *v.CpuSpeed, true
+	case "cpu_type":
+		if index >= 0 || rest != "" || v.CpuType == nil {
+			return nil, false
+		}
+		return *v.CpuType, true
+	case "desktop_display":
+		if index >= 0 || v.DesktopDisplay == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.DesktopDisplay, true
+		}
+		return v.DesktopDisplay.Get(rest)
+	case "keyboard_info":
+		if index >= 0 || v.KeyboardInfo == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.KeyboardInfo, true
+		}
+		return v.KeyboardInfo.Get(rest)
+	case "ram_size":
+		if index >= 0 || rest != "" || v.RamSize == nil {
+			return nil, false
+		}
+		return *v.RamSize, true
+	case "serial_number":
+		if index >= 0 || rest != "" || v.SerialNumber == nil {
+			return nil, false
+		}
+		return *v.SerialNumber, true
+	case "uuid":
+		if index >= 0 || rest != "" || v.Uuid == nil {
+			return nil, false
+		}
+		return *v.Uuid, true
+	case "vendor_name":
+		if index >= 0 || rest != "" || v.VendorName == nil {
+			return nil, false
+		}
+		return *v.VendorName, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *DeviceHardwareInfo) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "bios_date":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.BiosDate, value)
+	case "bios_manufacturer":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.BiosManufacturer, value)
+	case "bios_ver":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.BiosVer, value)
+	case "chassis":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Chassis, value)
+	case "cpu_architecture":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.CpuArchitecture, value)
+	case "cpu_architecture_id":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.CpuArchitectureId, value)
+	case "cpu_bits":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.CpuBits, value)
+	case "cpu_cores":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.CpuCores, value)
+	case "cpu_count":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.CpuCount, value)
+	case "cpu_speed":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.CpuSpeed, value)
+	case "cpu_type":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.CpuType, value)
+	case "desktop_display":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.DesktopDisplay, value)
+		}
+		if v.DesktopDisplay == nil {
+			v.DesktopDisplay = &Display{}
+		}
+		return v.DesktopDisplay.Set(rest, value)
+	case "keyboard_info":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.KeyboardInfo, value)
+		}
+		if v.KeyboardInfo == nil {
+			v.KeyboardInfo = &KeyboardInformation{}
+		}
+		return v.KeyboardInfo.Set(rest, value)
+	case "ram_size":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.RamSize, value)
+	case "serial_number":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.SerialNumber, value)
+	case "uuid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Uuid, value)
+	case "vendor_name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.VendorName, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *DeviceHardwareInfo) AppendObservables(path string, observables []Observable) []Observable {
+	if v.DesktopDisplay != nil {
+		observables = v.DesktopDisplay.AppendObservables(ocsf.JoinPath(path, "desktop_display"), observables)
+	}
+	if v.KeyboardInfo != nil {
+		observables = v.KeyboardInfo.AppendObservables(ocsf.JoinPath(path, "keyboard_info"), observables)
+	}
+
+	return observables
+}
+
 var DeviceHardwareInfoFields = []arrow.Field{
-	{Name: "bios_date", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "bios_manufacturer", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "bios_ver", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "chassis", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "cpu_architecture", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "cpu_architecture_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "cpu_bits", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "cpu_cores", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "cpu_count", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "cpu_speed", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "cpu_type", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "desktop_display", Type: DisplayStruct, Nullable: true},
-	{Name: "keyboard_info", Type: KeyboardInformationStruct, Nullable: true},
-	{Name: "ram_size", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "serial_number", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "uuid", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "vendor_name", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "bios_date", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("BIOS Date", "The BIOS date. For example: <code>03/31/16</code>.", "optional", "")},
+	{Name: "bios_manufacturer", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("BIOS Manufacturer", "The BIOS manufacturer. For example: <code>LENOVO</code>.", "optional", "")},
+	{Name: "bios_ver", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("BIOS Version", "The BIOS version. For example: <code>LENOVO G5ETA2WW (2.62)</code>.", "optional", "")},
+	{Name: "chassis", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Chassis", "The chassis type describes the system enclosure or physical form factor. Such as the following examples for Windows <a target='_blank' href='https://docs.microsoft.com/en-us/windows/win32/cimwin32prov/win32-systemenclosure'>Windows Chassis Types</a>", "optional", "")},
+	{Name: "cpu_architecture", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("CPU Architecture", "The CPU architecture, normalized to the caption of the <code>cpu_architecture_id</code> value. In the case of <code>Other</code>, it is defined by the source.", "optional", "")},
+	{Name: "cpu_architecture_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("CPU Architecture ID", "The normalized identifier of the CPU architecture.", "optional", "")},
+	{Name: "cpu_bits", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("CPU Bits", "The cpu architecture, the number of bits used for addressing in memory. For example: <code>32</code> or <code>64</code>.", "optional", "")},
+	{Name: "cpu_cores", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("CPU Cores", "The number of processor cores in all installed processors. For Example: <code>42</code>.", "optional", "")},
+	{Name: "cpu_count", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("CPU Count", "The number of physical processors on a system. For example: <code>1</code>.", "optional", "")},
+	{Name: "cpu_speed", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Processor Speed", "The speed of the processor in Mhz. For Example: <code>4200</code>.", "optional", "")},
+	{Name: "cpu_type", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Processor Type", "The processor type. For example: <code>x86 Family 6 Model 37 Stepping 5</code>.", "optional", "")},
+	{Name: "desktop_display", Type: DisplayStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Desktop Display", "The desktop display affiliated with the event", "optional", "")},
+	{Name: "keyboard_info", Type: KeyboardInformationStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Keyboard Information", "The keyboard detailed information.", "optional", "")},
+	{Name: "ram_size", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("RAM Size", "The total amount of installed RAM, in Megabytes. For example: <code>2048</code>.", "optional", "")},
+	{Name: "serial_number", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Serial Number", "The device manufacturer serial number.", "optional", "")},
+	{Name: "uuid", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("UUID", "The device manufacturer assigned universally unique hardware identifier. For example: The BIOS System UUID or the Apple IOPlatformUUID.", "optional", "")},
+	{Name: "vendor_name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Vendor Name", "The device manufacturer.", "optional", "")},
 }
 
 var DeviceHardwareInfoStruct = arrow.StructOf(DeviceHardwareInfoFields...)
@@ -2,6 +2,7 @@
 package v1_4_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -36,15 +37,171 @@ func (v *DigitalSignature) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *DigitalSignature) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *DigitalSignature) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	if v.Certificate != nil {
+		errs = append(errs, v.Certificate.ValidateAt(ocsf.JoinPath(path, "certificate"))...)
+	}
+	if v.Digest != nil {
+		errs = append(errs, v.Digest.ValidateAt(ocsf.JoinPath(path, "digest"))...)
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *DigitalSignature) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "algorithm":
+		if index >= 0 || rest != "" || v.Algorithm == nil {
+			return nil, false
+		}
+		return *v.Algorithm, true
+	case "algorithm_id":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.AlgorithmId, true
+	case "certificate":
+		if index >= 0 || v.Certificate == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Certificate, true
+		}
+		return v.Certificate.Get(rest)
+	case "created_time":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.CreatedTime, true
+	case "developer_uid":
+		if index >= 0 || rest != "" || v.DeveloperUid == nil {
+			return nil, false
+		}
+		return *v.DeveloperUid, true
+	case "digest":
+		if index >= 0 || v.Digest == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Digest, true
+		}
+		return v.Digest.Get(rest)
+	case "state":
+		if index >= 0 || rest != "" || v.State == nil {
+			return nil, false
+		}
+		return *v.State, true
+	case "state_id":
+		if index >= 0 || rest != "" || v.StateId == nil {
+			return nil, false
+		}
+		return *v.StateId, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *DigitalSignature) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "algorithm":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Algorithm, value)
+	case "algorithm_id":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.AlgorithmId, value)
+	case "certificate":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Certificate, value)
+		}
+		if v.Certificate == nil {
+			v.Certificate = &DigitalCertificate{}
+		}
+		return v.Certificate.Set(rest, value)
+	case "created_time":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.CreatedTime, value)
+	case "developer_uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.DeveloperUid, value)
+	case "digest":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Digest, value)
+		}
+		if v.Digest == nil {
+			v.Digest = &Fingerprint{}
+		}
+		return v.Digest.Set(rest, value)
+	case "state":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.State, value)
+	case "state_id":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.StateId, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *DigitalSignature) AppendObservables(path string, observables []Observable) []Observable {
+	if v.Certificate != nil {
+		observables = v.Certificate.AppendObservables(ocsf.JoinPath(path, "certificate"), observables)
+	}
+	if v.Digest != nil {
+		observables = v.Digest.AppendObservables(ocsf.JoinPath(path, "digest"), observables)
+	}
+
+	return observables
+}
+
 var DigitalSignatureFields = []arrow.Field{
-	{Name: "algorithm", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "algorithm_id", Type: arrow.PrimitiveTypes.Int32, Nullable: false},
-	{Name: "certificate", Type: DigitalCertificateStruct, Nullable: true},
-	{Name: "created_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
-	{Name: "developer_uid", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "digest", Type: FingerprintStruct, Nullable: true},
-	{Name: "state", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "state_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
+	{Name: "algorithm", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Algorithm", "The digital signature algorithm used to create the signature, normalized to the caption of 'algorithm_id'. In the case of 'Other', it is defined by the event source.", "optional", "")},
+	{Name: "algorithm_id", Type: arrow.PrimitiveTypes.Int32, Nullable: false, Metadata: ocsf.AttributeMetadata("Algorithm ID", "The identifier of the normalized digital signature algorithm.", "required", "")},
+	{Name: "certificate", Type: DigitalCertificateStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Certificate", "The certificate object containing information about the digital certificate.", "optional", "")},
+	{Name: "created_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true, Metadata: ocsf.AttributeMetadata("Created Time", "The time when the digital signature was created.", "optional", "")},
+	{Name: "developer_uid", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Developer UID", "The developer ID on the certificate that signed the file.", "optional", "")},
+	{Name: "digest", Type: FingerprintStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Message Digest", "The message digest attribute contains the fixed length message hash representation and the corresponding hashing algorithm information.", "optional", "")},
+	{Name: "state", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("State", "The digital signature state defines the signature state, normalized to the caption of 'state_id'. In the case of 'Other', it is defined by the event source.", "optional", "")},
+	{Name: "state_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("State ID", "The normalized identifier of the signature state.", "optional", "")},
 }
 
 var DigitalSignatureStruct = arrow.StructOf(DigitalSignatureFields...)
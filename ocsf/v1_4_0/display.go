@@ -2,6 +2,7 @@
 package v1_4_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -27,12 +28,108 @@ func (v *Display) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *Display) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *Display) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *Display) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "color_depth":
+		if index >= 0 || rest != "" || v.ColorDepth == nil {
+			return nil, false
+		}
+		return *v.ColorDepth, true
+	case "physical_height":
+		if index >= 0 || rest != "" || v.PhysicalHeight == nil {
+			return nil, false
+		}
+		return *v.PhysicalHeight, true
+	case "physical_orientation":
+		if index >= 0 || rest != "" || v.PhysicalOrientation == nil {
+			return nil, false
+		}
+		return *v.PhysicalOrientation, true
+	case "physical_width":
+		if index >= 0 || rest != "" || v.PhysicalWidth == nil {
+			return nil, false
+		}
+		return *v.PhysicalWidth, true
+	case "scale_factor":
+		if index >= 0 || rest != "" || v.ScaleFactor == nil {
+			return nil, false
+		}
+		return *v.ScaleFactor, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *Display) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "color_depth":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.ColorDepth, value)
+	case "physical_height":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.PhysicalHeight, value)
+	case "physical_orientation":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.PhysicalOrientation, value)
+	case "physical_width":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.PhysicalWidth, value)
+	case "scale_factor":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.ScaleFactor, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *Display) AppendObservables(path string, observables []Observable) []Observable {
+
+	return observables
+}
+
 var DisplayFields = []arrow.Field{
-	{Name: "color_depth", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "physical_height", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "physical_orientation", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "physical_width", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "scale_factor", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
+	{Name: "color_depth", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Color Depth", "The numeric color depth.", "optional", "")},
+	{Name: "physical_height", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Physical Height", "The numeric physical height of display.", "optional", "")},
+	{Name: "physical_orientation", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Physical Orientation", "The numeric physical orientation of display.", "optional", "")},
+	{Name: "physical_width", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Physical Width", "The numeric physical width of display.", "optional", "")},
+	{Name: "scale_factor", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Scale Factor", "The numeric scale factor of display.", "optional", "")},
 }
 
 var DisplayStruct = arrow.StructOf(DisplayFields...)
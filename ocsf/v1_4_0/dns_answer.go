@@ -2,6 +2,7 @@
 package v1_4_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -33,14 +34,159 @@ func (v *DNSAnswer) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *DNSAnswer) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *DNSAnswer) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	if v.Rdata == "" {
+		errs.Add(ocsf.JoinPath(path, "rdata"), "required attribute is missing")
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *DNSAnswer) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "class":
+		if index >= 0 || rest != "" || v.Class == nil {
+			return nil, false
+		}
+		return *v.Class, true
+	case "flag_ids":
+		if rest == "" {
+			if index < 0 {
+				return v.FlagIds, v.FlagIds != nil
+			}
+			return ocsf.Index(v.FlagIds, index)
+		}
+		return nil, false
+	case "flags":
+		if rest == "" {
+			if index < 0 {
+				return v.Flags, v.Flags != nil
+			}
+			return ocsf.Index(v.Flags, index)
+		}
+		return nil, false
+	case "packet_uid":
+		if index >= 0 || rest != "" || v.PacketUid == nil {
+			return nil, false
+		}
+		return *v.PacketUid, true
+	case "rdata":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.Rdata, true
+	case "ttl":
+		if index >= 0 || rest != "" || v.Ttl == nil {
+			return nil, false
+		}
+		return *v.Ttl, true
+	case "type":
+		if index >= 0 || rest != "" || v.Type == nil {
+			return nil, false
+		}
+		return *v.Type, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *DNSAnswer) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "class":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Class, value)
+	case "flag_ids":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.FlagIds, value)
+		}
+		elem, err := ocsf.Element(&v.FlagIds, index)
+		if err != nil {
+			return err
+		}
+		if rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(elem, value)
+	case "flags":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Flags, value)
+		}
+		elem, err := ocsf.Element(&v.Flags, index)
+		if err != nil {
+			return err
+		}
+		if rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(elem, value)
+	case "packet_uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.PacketUid, value)
+	case "rdata":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.Rdata, value)
+	case "ttl":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Ttl, value)
+	case "type":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Type, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *DNSAnswer) AppendObservables(path string, observables []Observable) []Observable {
+
+	return observables
+}
+
 var DNSAnswerFields = []arrow.Field{
-	{Name: "class", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "flag_ids", Type: arrow.ListOf(arrow.PrimitiveTypes.Int32), Nullable: true},
-	{Name: "flags", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true},
-	{Name: "packet_uid", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "rdata", Type: arrow.BinaryTypes.String, Nullable: false},
-	{Name: "ttl", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "type", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "class", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Resource Record Class", "The class of DNS data contained in this resource record. See <a target='_blank' href='https://www.rfc-editor.org/rfc/rfc1035.txt'>RFC1035</a>. For example: <code>IN</code>.", "optional", "")},
+	{Name: "flag_ids", Type: arrow.ListOf(arrow.PrimitiveTypes.Int32), Nullable: true, Metadata: ocsf.AttributeMetadata("DNS Header Flags", "The list of DNS answer header flag IDs.", "optional", "")},
+	{Name: "flags", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true, Metadata: ocsf.AttributeMetadata("DNS Header Flags", "The list of DNS answer header flags.", "optional", "")},
+	{Name: "packet_uid", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Packet UID", "The DNS packet identifier assigned by the program that generated the query. The identifier is copied to the response.", "optional", "")},
+	{Name: "rdata", Type: arrow.BinaryTypes.String, Nullable: false, Metadata: ocsf.AttributeMetadata("DNS RData", "The data describing the DNS resource. The meaning of this data depends on the type and class of the resource record.", "required", "")},
+	{Name: "ttl", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("TTL", "The time interval that the resource record may be cached. Zero value means that the resource record can only be used for the transaction in progress, and should not be cached.", "optional", "")},
+	{Name: "type", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Resource Record Type", "The type of data contained in this resource record. See <a target='_blank' href='https://www.rfc-editor.org/rfc/rfc1035.txt'>RFC1035</a>. For example: <code>CNAME</code>.", "optional", "")},
 }
 
 var DNSAnswerStruct = arrow.StructOf(DNSAnswerFields...)
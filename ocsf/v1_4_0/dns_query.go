@@ -2,6 +2,7 @@
 package v1_4_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -30,13 +31,123 @@ func (v *DNSQuery) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *DNSQuery) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *DNSQuery) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	if v.Hostname == "" {
+		errs.Add(ocsf.JoinPath(path, "hostname"), "required attribute is missing")
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *DNSQuery) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "class":
+		if index >= 0 || rest != "" || v.Class == nil {
+			return nil, false
+		}
+		return *v.Class, true
+	case "hostname":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.Hostname, true
+	case "opcode":
+		if index >= 0 || rest != "" || v.Opcode == nil {
+			return nil, false
+		}
+		return *v.Opcode, true
+	case "opcode_id":
+		if index >= 0 || rest != "" || v.OpcodeId == nil {
+			return nil, false
+		}
+		return *v.OpcodeId, true
+	case "packet_uid":
+		if index >= 0 || rest != "" || v.PacketUid == nil {
+			return nil, false
+		}
+		return *v.PacketUid, true
+	case "type":
+		if index >= 0 || rest != "" || v.Type == nil {
+			return nil, false
+		}
+		return *v.Type, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *DNSQuery) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "class":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Class, value)
+	case "hostname":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.Hostname, value)
+	case "opcode":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Opcode, value)
+	case "opcode_id":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.OpcodeId, value)
+	case "packet_uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.PacketUid, value)
+	case "type":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Type, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *DNSQuery) AppendObservables(path string, observables []Observable) []Observable {
+	observables = append(observables, Observable{Name: ocsf.Ptr(ocsf.JoinPath(path, "hostname")), TypeId: 1, Value: ocsf.Ptr(v.Hostname)})
+
+	return observables
+}
+
 var DNSQueryFields = []arrow.Field{
-	{Name: "class", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "hostname", Type: arrow.BinaryTypes.String, Nullable: false},
-	{Name: "opcode", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "opcode_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "packet_uid", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "type", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "class", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Resource Record Class", "The class of resource records being queried. See <a target='_blank' href='https://www.rfc-editor.org/rfc/rfc1035.txt'>RFC1035</a>. For example: <code>IN</code>.", "optional", "")},
+	{Name: "hostname", Type: arrow.BinaryTypes.String, Nullable: false, Metadata: ocsf.AttributeMetadata("Hostname", "The hostname or domain being queried. For example: <code>www.example.com</code>", "required", "")},
+	{Name: "opcode", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("DNS Opcode", "The DNS opcode specifies the type of the query message.", "optional", "")},
+	{Name: "opcode_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("DNS Opcode ID", "The DNS opcode ID specifies the normalized query message type as defined in <a target='_blank' href='https://www.rfc-editor.org/rfc/rfc5395.html'>RFC-5395</a>.", "optional", "")},
+	{Name: "packet_uid", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Packet UID", "The DNS packet identifier assigned by the program that generated the query. The identifier is copied to the response.", "optional", "")},
+	{Name: "type", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Resource Record Type", "The type of resource records being queried. See <a target='_blank' href='https://www.rfc-editor.org/rfc/rfc1035.txt'>RFC1035</a>. For example: A, AAAA, CNAME, MX, and NS.", "optional", "")},
 }
 
 var DNSQueryStruct = arrow.StructOf(DNSQueryFields...)
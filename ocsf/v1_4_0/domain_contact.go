@@ -2,6 +2,7 @@
 package v1_4_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -33,14 +34,148 @@ func (v *DomainContact) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *DomainContact) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *DomainContact) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	if v.Location != nil {
+		errs = append(errs, v.Location.ValidateAt(ocsf.JoinPath(path, "location"))...)
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *DomainContact) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "email_addr":
+		if index >= 0 || rest != "" || v.EmailAddr == nil {
+			return nil, false
+		}
+		return *v.EmailAddr, true
+	case "location":
+		if index >= 0 || v.Location == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Location, true
+		}
+		return v.Location.Get(rest)
+	case "name":
+		if index >= 0 || rest != "" || v.Name == nil {
+			return nil, false
+		}
+		return *v.Name, true
+	case "phone_number":
+		if index >= 0 || rest != "" || v.PhoneNumber == nil {
+			return nil, false
+		}
+		return *v.PhoneNumber, true
+	case "type":
+		if index >= 0 || rest != "" || v.Type == nil {
+			return nil, false
+		}
+		return *v.Type, true
+	case "type_id":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.TypeId, true
+	case "uid":
+		if index >= 0 || rest != "" || v.Uid == nil {
+			return nil, false
+		}
+		return *v.Uid, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *DomainContact) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "email_addr":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.EmailAddr, value)
+	case "location":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Location, value)
+		}
+		if v.Location == nil {
+			v.Location = &GeoLocation{}
+		}
+		return v.Location.Set(rest, value)
+	case "name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Name, value)
+	case "phone_number":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.PhoneNumber, value)
+	case "type":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Type, value)
+	case "type_id":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.TypeId, value)
+	case "uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Uid, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *DomainContact) AppendObservables(path string, observables []Observable) []Observable {
+	if v.EmailAddr != nil {
+		observables = append(observables, Observable{Name: ocsf.Ptr(ocsf.JoinPath(path, "email_addr")), TypeId: 5, Value: ocsf.Ptr(*v.EmailAddr)})
+	}
+	if v.Location != nil {
+		observables = v.Location.AppendObservables(ocsf.JoinPath(path, "location"), observables)
+	}
+
+	return observables
+}
+
 var DomainContactFields = []arrow.Field{
-	{Name: "email_addr", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "location", Type: GeoLocationStruct, Nullable: true},
-	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "phone_number", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "type", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "type_id", Type: arrow.PrimitiveTypes.Int32, Nullable: false},
-	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "email_addr", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Contact Email", "The user's primary email address.", "optional", "")},
+	{Name: "location", Type: GeoLocationStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Contact Location Information", "Location details for the contract such as the city, state/province, country, etc.", "optional", "")},
+	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Name", "The individual or organization name for the contact.", "optional", "")},
+	{Name: "phone_number", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Phone Number", "The number associated with the phone.", "optional", "")},
+	{Name: "type", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Domain Contact Type", "The Domain Contact type, normalized to the caption of the <code>type_id</code> value. In the case of 'Other', it is defined by the source", "optional", "")},
+	{Name: "type_id", Type: arrow.PrimitiveTypes.Int32, Nullable: false, Metadata: ocsf.AttributeMetadata("Domain Contact Type ID", "The normalized domain contact type ID.", "required", "")},
+	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Unique ID", "The unique identifier of the contact information, typically provided in WHOIS information.", "optional", "")},
 }
 
 var DomainContactStruct = arrow.StructOf(DomainContactFields...)
@@ -2,6 +2,7 @@
 package v1_4_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -27,12 +28,108 @@ func (v *EncryptionDetails) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *EncryptionDetails) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *EncryptionDetails) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *EncryptionDetails) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "algorithm":
+		if index >= 0 || rest != "" || v.Algorithm == nil {
+			return nil, false
+		}
+		return *v.Algorithm, true
+	case "algorithm_id":
+		if index >= 0 || rest != "" || v.AlgorithmId == nil {
+			return nil, false
+		}
+		return *v.AlgorithmId, true
+	case "key_length":
+		if index >= 0 || rest != "" || v.KeyLength == nil {
+			return nil, false
+		}
+		return *v.KeyLength, true
+	case "key_uid":
+		if index >= 0 || rest != "" || v.KeyUid == nil {
+			return nil, false
+		}
+		return *v.KeyUid, true
+	case "type":
+		if index >= 0 || rest != "" || v.Type == nil {
+			return nil, false
+		}
+		return *v.Type, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *EncryptionDetails) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "algorithm":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Algorithm, value)
+	case "algorithm_id":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.AlgorithmId, value)
+	case "key_length":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.KeyLength, value)
+	case "key_uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.KeyUid, value)
+	case "type":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Type, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *EncryptionDetails) AppendObservables(path string, observables []Observable) []Observable {
+
+	return observables
+}
+
 var EncryptionDetailsFields = []arrow.Field{
-	{Name: "algorithm", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "algorithm_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "key_length", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "key_uid", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "type", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "algorithm", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Encryption Algorithm", "The encryption algorithm used, normalized to the caption of 'algorithm_id", "optional", "")},
+	{Name: "algorithm_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Encryption Algorithm ID", "The encryption algorithm used.", "optional", "")},
+	{Name: "key_length", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Encryption Key Length", "The length of the encryption key used.", "optional", "")},
+	{Name: "key_uid", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Key UID", "The unique identifier of the key used for encrpytion. For example, AWS KMS Key ARN.", "optional", "")},
+	{Name: "type", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Encryption Type", "The type of the encryption used.", "optional", "")},
 }
 
 var EncryptionDetailsStruct = arrow.StructOf(EncryptionDetailsFields...)
@@ -2,6 +2,7 @@
 package v1_4_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -79,29 +80,404 @@ func (v *Endpoint) Observable() (*int, string) {
 	return &typeId, "endpoint"
 }
 
+func (v *Endpoint) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *Endpoint) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	for i := range v.AgentList {
+		errs = append(errs, v.AgentList[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "agent_list"), i))...)
+	}
+	if v.Container != nil {
+		errs = append(errs, v.Container.ValidateAt(ocsf.JoinPath(path, "container"))...)
+	}
+	if v.HwInfo != nil {
+		errs = append(errs, v.HwInfo.ValidateAt(ocsf.JoinPath(path, "hw_info"))...)
+	}
+	if v.Location != nil {
+		errs = append(errs, v.Location.ValidateAt(ocsf.JoinPath(path, "location"))...)
+	}
+	if v.Os != nil {
+		errs = append(errs, v.Os.ValidateAt(ocsf.JoinPath(path, "os"))...)
+	}
+	if v.Owner != nil {
+		errs = append(errs, v.Owner.ValidateAt(ocsf.JoinPath(path, "owner"))...)
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *Endpoint) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "agent_list":
+		if rest == "" {
+			if index < 0 {
+				return v.AgentList, v.AgentList != nil
+			}
+			return ocsf.Index(v.AgentList, index)
+		}
+		if index < 0 || index >= len(v.AgentList) {
+			return nil, false
+		}
+		return v.AgentList[index].Get(rest)
+	case "container":
+		if index >= 0 || v.Container == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Container, true
+		}
+		return v.Container.Get(rest)
+	case "domain":
+		if index >= 0 || rest != "" || v.Domain == nil {
+			return nil, false
+		}
+		return *v.Domain, true
+	case "hostname":
+		if index >= 0 || rest != "" || v.Hostname == nil {
+			return nil, false
+		}
+		return *v.Hostname, true
+	case "hw_info":
+		if index >= 0 || v.HwInfo == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.HwInfo, true
+		}
+		return v.HwInfo.Get(rest)
+	case "instance_uid":
+		if index >= 0 || rest != "" || v.InstanceUid == nil {
+			return nil, false
+		}
+		return *v.InstanceUid, true
+	case "interface_name":
+		if index >= 0 || rest != "" || v.InterfaceName == nil {
+			return nil, false
+		}
+		return *v.InterfaceName, true
+	case "interface_uid":
+		if index >= 0 || rest != "" || v.InterfaceUid == nil {
+			return nil, false
+		}
+		return *v.InterfaceUid, true
+	case "ip":
+		if index >= 0 || rest != "" || v.Ip == nil {
+			return nil, false
+		}
+		return *v.Ip, true
+	case "location":
+		if index >= 0 || v.Location == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Location, true
+		}
+		return v.Location.Get(rest)
+	case "mac":
+		if index >= 0 || rest != "" || v.Mac == nil {
+			return nil, false
+		}
+		return *v.Mac, true
+	case "name":
+		if index >= 0 || rest != "" || v.Name == nil {
+			return nil, false
+		}
+		return *v.Name, true
+	case "namespace_pid":
+		if index >= 0 || rest != "" || v.NamespacePid == nil {
+			return nil, false
+		}
+		return *v.NamespacePid, true
+	case "os":
+		if index >= 0 || v.Os == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Os, true
+		}
+		return v.Os.Get(rest)
+	case "owner":
+		if index >= 0 || v.Owner == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Owner, true
+		}
+		return v.Owner.Get(rest)
+	case "subnet_uid":
+		if index >= 0 || rest != "" || v.SubnetUid == nil {
+			return nil, false
+		}
+		return *v.SubnetUid, true
+	case "type":
+		if index >= 0 || rest != "" || v.Type == nil {
+			return nil, false
+		}
+		return *v.Type, true
+	case "type_id":
+		if index >= 0 || rest != "" || v.TypeId == nil {
+			return nil, false
+		}
+		return *v.TypeId, true
+	case "uid":
+		if index >= 0 || rest != "" || v.Uid == nil {
+			return nil, false
+		}
+		return *v.Uid, true
+	case "vlan_uid":
+		if index >= 0 || rest != "" || v.VlanUid == nil {
+			return nil, false
+		}
+		return *v.VlanUid, true
+	case "vpc_uid":
+		if index >= 0 || rest != "" || v.VpcUid == nil {
+			return nil, false
+		}
+		return *v.VpcUid, true
+	case "zone":
+		if index >= 0 || rest != "" || v.Zone == nil {
+			return nil, false
+		}
+		return *v.Zone, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *Endpoint) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "agent_list":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.AgentList, value)
+		}
+		elem, err := ocsf.Element(&v.AgentList, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "container":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Container, value)
+		}
+		if v.Container == nil {
+			v.Container = &Container{}
+		}
+		return v.Container.Set(rest, value)
+	case "domain":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Domain, value)
+	case "hostname":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Hostname, value)
+	case "hw_info":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.HwInfo, value)
+		}
+		if v.HwInfo == nil {
+			v.HwInfo = &DeviceHardwareInfo{}
+		}
+		return v.HwInfo.Set(rest, value)
+	case "instance_uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.InstanceUid, value)
+	case "interface_name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.InterfaceName, value)
+	case "interface_uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.InterfaceUid, value)
+	case "ip":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Ip, value)
+	case "location":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Location, value)
+		}
+		if v.Location == nil {
+			v.Location = &GeoLocation{}
+		}
+		return v.Location.Set(rest, value)
+	case "mac":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Mac, value)
+	case "name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Name, value)
+	case "namespace_pid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.NamespacePid, value)
+	case "os":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Os, value)
+		}
+		if v.Os == nil {
+			v.Os = &OperatingSystemOS{}
+		}
+		return v.Os.Set(rest, value)
+	case "owner":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Owner, value)
+		}
+		if v.Owner == nil {
+			v.Owner = &User{}
+		}
+		return v.Owner.Set(rest, value)
+	case "subnet_uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.SubnetUid, value)
+	case "type":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Type, value)
+	case "type_id":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.TypeId, value)
+	case "uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Uid, value)
+	case "vlan_uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.VlanUid, value)
+	case "vpc_uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.VpcUid, value)
+	case "zone":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Zone, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *Endpoint) AppendObservables(path string, observables []Observable) []Observable {
+	if path != "" {
+		observables = append(observables, Observable{Name: ocsf.Ptr(path), TypeId: 20})
+	}
+	for i := range v.AgentList {
+		observables = v.AgentList[i].AppendObservables(ocsf.JoinPath(path, "agent_list"), observables)
+	}
+	if v.Container != nil {
+		observables = v.Container.AppendObservables(ocsf.JoinPath(path, "container"), observables)
+	}
+	if v.Hostname != nil {
+		observables = append(observables, Observable{Name: ocsf.Ptr(ocsf.JoinPath(path, "hostname")), TypeId: 1, Value: ocsf.Ptr(*v.Hostname)})
+	}
+	if v.HwInfo != nil {
+		observables = v.HwInfo.AppendObservables(ocsf.JoinPath(path, "hw_info"), observables)
+	}
+	if v.Ip != nil {
+		observables = append(observables, Observable{Name: ocsf.Ptr(ocsf.JoinPath(path, "ip")), TypeId: 2, Value: ocsf.Ptr(*v.Ip)})
+	}
+	if v.Location != nil {
+		observables = v.Location.AppendObservables(ocsf.JoinPath(path, "location"), observables)
+	}
+	if v.Mac != nil {
+		observables = append(observables, Observable{Name: ocsf.Ptr(ocsf.JoinPath(path, "mac")), TypeId: 3, Value: ocsf.Ptr(*v.Mac)})
+	}
+	if v.Os != nil {
+		observables = v.Os.AppendObservables(ocsf.JoinPath(path, "os"), observables)
+	}
+	if v.Owner != nil {
+		observables = v.Owner.AppendObservables(ocsf.JoinPath(path, "owner"), observables)
+	}
+
+	return observables
+}
+
 var EndpointFields = []arrow.Field{
-	{Name: "agent_list", Type: arrow.ListOf(AgentStruct), Nullable: true},
-	{Name: "container", Type: ContainerStruct, Nullable: true},
-	{Name: "domain", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "hostname", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "hw_info", Type: DeviceHardwareInfoStruct, Nullable: true},
-	{Name: "instance_uid", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "interface_name", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "interface_uid", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "ip", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "location", Type: GeoLocationStruct, Nullable: true},
-	{Name: "mac", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "namespace_pid", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "os", Type: OperatingSystemOSStruct, Nullable: true},
-	{Name: "owner", Type: UserStruct, Nullable: true},
-	{Name: "subnet_uid", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "type", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "type_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "vlan_uid", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "vpc_uid", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "zone", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "agent_list", Type: arrow.ListOf(AgentStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("Agent List", "A list of <code>agent</code> objects associated with a device, endpoint, or resource.", "optional", "")},
+	{Name: "container", Type: ContainerStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Container", "The information describing an instance of a container. A container is a prepackaged, portable system image that runs isolated on an existing system using a container runtime like containerd.", "optional", "")},
+	{Name: "domain", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Domain", "The name of the domain that the endpoint belongs to or that corresponds to the endpoint.", "optional", "")},
+	{Name: "hostname", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Hostname", "The fully qualified name of the endpoint.", "optional", "")},
+	{Name: "hw_info", Type: DeviceHardwareInfoStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Hardware Info", "The endpoint hardware information.", "optional", "")},
+	{Name: "instance_uid", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Instance ID", "The unique identifier of a VM instance.", "optional", "")},
+	{Name: "interface_name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Network Interface Name", "The name of the network interface (e.g. eth2).", "optional", "")},
+	{Name: "interface_uid", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Network Interface ID", "The unique identifier of the network interface.", "optional", "")},
+	{Name: "ip", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("IP Address", "The IP address of the endpoint, in either IPv4 or IPv6 format.", "optional", "")},
+	{Name: "location", Type: GeoLocationStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Geo Location", "The geographical location of the endpoint.", "optional", "")},
+	{Name: "mac", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("MAC Address", "The Media Access Control (MAC) address of the endpoint.", "optional", "")},
+	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Name", "The short name of the endpoint.", "optional", "")},
+	{Name: "namespace_pid", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Namespace PID", "If running under a process namespace (such as in a container), the process identifier within that process namespace.", "optional", "")},
+	{Name: "os", Type: OperatingSystemOSStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("OS", "The endpoint operating system.", "optional", "")},
+	{Name: "owner", Type: UserStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Owner", "The identity of the service or user account that owns the endpoint or was last logged into it.", "optional", "")},
+	{Name: "subnet_uid", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Subnet UID", "The unique identifier of a virtual subnet.", "optional", "")},
+	{Name: "type", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Type", "The endpoint type. For example: <code>unknown</code>, <code>server</code>, <code>desktop</code>, <code>laptop</code>, <code>tablet</code>, <code>mobile</code>, <code>virtual</code>, <code>browser</code>, or <code>other</code>.", "optional", "")},
+	{Name: "type_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Type ID", "The endpoint type ID.", "optional", "")},
+	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Unique ID", "The unique identifier of the endpoint.", "optional", "")},
+	{Name: "vlan_uid", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("VLAN", "The Virtual LAN identifier.", "optional", "")},
+	{Name: "vpc_uid", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("VPC UID", "The unique identifier of the Virtual Private Cloud (VPC).", "optional", "")},
+	{Name: "zone", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Network Zone", "The network zone or LAN segment.", "optional", "")},
 }
 
 var EndpointStruct = arrow.StructOf(EndpointFields...)
@@ -2,6 +2,7 @@
 package v1_4_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -11,7 +12,7 @@ type Enrichment struct {
 	CreatedTime int64 `json:"created_time,omitempty" parquet:"created_time,timestamp_millis,timestamp(millisecond),optional"`
 
 	// Data: The enrichment data associated with the attribute and value. The meaning of this data depends on the type the enrichment record.
-	Data string `json:"data" parquet:"data"`
+	Data ocsf.RawJSON `json:"data" parquet:"data"`
 
 	// Description: A long description of the enrichment data.
 	Desc *string `json:"desc,omitempty" parquet:"desc,optional"`
@@ -42,17 +43,187 @@ func (v *Enrichment) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *Enrichment) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *Enrichment) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	if v.Data == "" {
+		errs.Add(ocsf.JoinPath(path, "data"), "required attribute is missing")
+	}
+	if v.Name == "" {
+		errs.Add(ocsf.JoinPath(path, "name"), "required attribute is missing")
+	}
+	if v.Reputation != nil {
+		errs = append(errs, v.Reputation.ValidateAt(ocsf.JoinPath(path, "reputation"))...)
+	}
+	if v.Value == "" {
+		errs.Add(ocsf.JoinPath(path, "value"), "required attribute is missing")
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *Enrichment) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "created_time":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.CreatedTime, true
+	case "data":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.Data, true
+	case "desc":
+		if index >= 0 || rest != "" || v.Desc == nil {
+			return nil, false
+		}
+		return *v.Desc, true
+	case "name":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.Name, true
+	case "provider":
+		if index >= 0 || rest != "" || v.Provider == nil {
+			return nil, false
+		}
+		return *v.Provider, true
+	case "reputation":
+		if index >= 0 || v.Reputation == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Reputation, true
+		}
+		return v.Reputation.Get(rest)
+	case "short_desc":
+		if index >= 0 || rest != "" || v.ShortDesc == nil {
+			return nil, false
+		}
+		return *v.ShortDesc, true
+	case "src_url":
+		if index >= 0 || rest != "" || v.SrcUrl == nil {
+			return nil, false
+		}
+		return *v.SrcUrl, true
+	case "type":
+		if index >= 0 || rest != "" || v.Type == nil {
+			return nil, false
+		}
+		return *v.Type, true
+	case "value":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.Value, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *Enrichment) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "created_time":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.CreatedTime, value)
+	case "data":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.Data, value)
+	case "desc":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Desc, value)
+	case "name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.Name, value)
+	case "provider":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Provider, value)
+	case "reputation":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Reputation, value)
+		}
+		if v.Reputation == nil {
+			v.Reputation = &Reputation{}
+		}
+		return v.Reputation.Set(rest, value)
+	case "short_desc":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.ShortDesc, value)
+	case "src_url":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.SrcUrl, value)
+	case "type":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Type, value)
+	case "value":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.Value, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *Enrichment) AppendObservables(path string, observables []Observable) []Observable {
+	if v.Reputation != nil {
+		observables = v.Reputation.AppendObservables(ocsf.JoinPath(path, "reputation"), observables)
+	}
+
+	return observables
+}
+
 var EnrichmentFields = []arrow.Field{
-	{Name: "created_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
-	{Name: "data", Type: arrow.BinaryTypes.String, Nullable: false},
-	{Name: "desc", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: false},
-	{Name: "provider", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "reputation", Type: ReputationStruct, Nullable: true},
-	{Name: "short_desc", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "src_url", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "type", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "value", Type: arrow.BinaryTypes.String, Nullable: false},
+	{Name: "created_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true, Metadata: ocsf.AttributeMetadata("Created Time", "The time when the enrichment data was generated.", "optional", "")},
+	{Name: "data", Type: arrow.BinaryTypes.String, Nullable: false, Metadata: ocsf.AttributeMetadata("Data", "The enrichment data associated with the attribute and value. The meaning of this data depends on the type the enrichment record.", "required", "")},
+	{Name: "desc", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Description", "A long description of the enrichment data.", "optional", "")},
+	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: false, Metadata: ocsf.AttributeMetadata("Name", "The name of the attribute to which the enriched data pertains.", "required", "")},
+	{Name: "provider", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Provider", "The enrichment data provider name.", "optional", "")},
+	{Name: "reputation", Type: ReputationStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Reputation Scores", "The reputation of the enrichment data.", "optional", "")},
+	{Name: "short_desc", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Short Description", "A short description of the enrichment data.", "optional", "")},
+	{Name: "src_url", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Source URL", "The URL of the source of the enrichment data.", "optional", "")},
+	{Name: "type", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Type", "The enrichment type. For example: <code>location</code>.", "optional", "")},
+	{Name: "value", Type: arrow.BinaryTypes.String, Nullable: false, Metadata: ocsf.AttributeMetadata("Value", "The value of the attribute to which the enriched data pertains.", "required", "")},
 }
 
 var EnrichmentStruct = arrow.StructOf(EnrichmentFields...)
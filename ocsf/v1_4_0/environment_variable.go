@@ -2,6 +2,7 @@
 package v1_4_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -18,9 +19,81 @@ func (v *EnvironmentVariable) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *EnvironmentVariable) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *EnvironmentVariable) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	if v.Name == "" {
+		errs.Add(ocsf.JoinPath(path, "name"), "required attribute is missing")
+	}
+	if v.Value == "" {
+		errs.Add(ocsf.JoinPath(path, "value"), "required attribute is missing")
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *EnvironmentVariable) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "name":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.Name, true
+	case "value":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.Value, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *EnvironmentVariable) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.Name, value)
+	case "value":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.Value, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *EnvironmentVariable) AppendObservables(path string, observables []Observable) []Observable {
+
+	return observables
+}
+
 var EnvironmentVariableFields = []arrow.Field{
-	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: false},
-	{Name: "value", Type: arrow.BinaryTypes.String, Nullable: false},
+	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: false, Metadata: ocsf.AttributeMetadata("Name", "The name of the environment variable.", "required", "")},
+	{Name: "value", Type: arrow.BinaryTypes.String, Nullable: false, Metadata: ocsf.AttributeMetadata("Value", "The value of the environment variable.", "required", "")},
 }
 
 var EnvironmentVariableStruct = arrow.StructOf(EnvironmentVariableFields...)
@@ -0,0 +1,48 @@
+package v1_4_0
+
+import "testing"
+
+func TestEvent(t *testing.T) {
+	finding := NewVulnerabilityFinding(VulnerabilityFindingActivityIdUpdate)
+	finding.SeverityId = 4
+	finding.Time = 1700000000000
+	finding.FindingInfo.Uid = "finding-1"
+
+	var event Event = &finding
+	if event.GetClassUid() != 2002 || event.GetCategoryUid() != 2 || event.GetActivityId() != 2 || event.GetTypeUid() != 200202 {
+		t.Errorf("got class %d, category %d, activity %d and type %d", event.GetClassUid(), event.GetCategoryUid(), event.GetActivityId(), event.GetTypeUid())
+	}
+	if event.GetSeverityId() != 4 || event.GetTime() != 1700000000000 {
+		t.Errorf("got severity %d and time %d", event.GetSeverityId(), event.GetTime())
+	}
+	if event.GetMetadata() != &finding.Metadata || event.GetMetadata().Version != "1.4.0" {
+		t.Errorf("GetMetadata does not return the event's metadata")
+	}
+
+	findingEvent, ok := event.(FindingEvent)
+	if !ok {
+		t.Fatalf("%T does not implement FindingEvent", event)
+	}
+	if findingEvent.GetFindingUid() != "finding-1" || findingEvent.GetFindingInfo().Uid != "finding-1" {
+		t.Errorf("got finding uid %q", findingEvent.GetFindingUid())
+	}
+
+	if _, ok := any(&APIActivity{}).(FindingEvent); ok {
+		t.Errorf("APIActivity implements FindingEvent, want only the Findings category")
+	}
+}
+
+func TestConstructorWithoutActivities(t *testing.T) {
+	authentication := NewAuthentication(1)
+	if authentication.ClassUid != 3002 || authentication.CategoryUid != 3 || authentication.TypeUid != 300201 {
+		t.Errorf("got class %d, category %d and type %d", authentication.ClassUid, authentication.CategoryUid, authentication.TypeUid)
+	}
+	if authentication.ActivityName != nil || authentication.TypeName != nil {
+		t.Errorf("got activity_name %v and type_name %v for an activity without a caption", authentication.ActivityName, authentication.TypeName)
+	}
+
+	other := NewAuthentication(AuthenticationActivityIdOther)
+	if other.ActivityName == nil || *other.ActivityName != "Other" || *other.TypeName != "Authentication: Other" {
+		t.Errorf("got activity_name %v and type_name %v", other.ActivityName, other.TypeName)
+	}
+}
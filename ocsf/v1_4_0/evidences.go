@@ -2,6 +2,7 @@
 package v1_4_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -20,7 +21,7 @@ type EvidenceArtifacts struct {
 	Container *Container `json:"container,omitempty" parquet:"container,optional"`
 
 	// Data: Additional evidence data that is not accounted for in the specific evidence attributes.<code> Use only when absolutely necessary.</code>
-	Data *string `json:"data,omitempty" parquet:"data,optional"`
+	Data *ocsf.RawJSON `json:"data,omitempty" parquet:"data,optional"`
 
 	// Database: Describes details about the database associated to the activity that triggered the detection.
 	Database *Database `json:"database,omitempty" parquet:"database,optional"`
@@ -87,32 +88,695 @@ func (v *EvidenceArtifacts) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *EvidenceArtifacts) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *EvidenceArtifacts) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	if v.Actor != nil {
+		errs = append(errs, v.Actor.ValidateAt(ocsf.JoinPath(path, "actor"))...)
+	}
+	if v.Api != nil {
+		errs = append(errs, v.Api.ValidateAt(ocsf.JoinPath(path, "api"))...)
+	}
+	if v.ConnectionInfo != nil {
+		errs = append(errs, v.ConnectionInfo.ValidateAt(ocsf.JoinPath(path, "connection_info"))...)
+	}
+	if v.Container != nil {
+		errs = append(errs, v.Container.ValidateAt(ocsf.JoinPath(path, "container"))...)
+	}
+	if v.Database != nil {
+		errs = append(errs, v.Database.ValidateAt(ocsf.JoinPath(path, "database"))...)
+	}
+	if v.Databucket != nil {
+		errs = append(errs, v.Databucket.ValidateAt(ocsf.JoinPath(path, "databucket"))...)
+	}
+	if v.Device != nil {
+		errs = append(errs, v.Device.ValidateAt(ocsf.JoinPath(path, "device"))...)
+	}
+	if v.DstEndpoint != nil {
+		errs = append(errs, v.DstEndpoint.ValidateAt(ocsf.JoinPath(path, "dst_endpoint"))...)
+	}
+	if v.Email != nil {
+		errs = append(errs, v.Email.ValidateAt(ocsf.JoinPath(path, "email"))...)
+	}
+	if v.File != nil {
+		errs = append(errs, v.File.ValidateAt(ocsf.JoinPath(path, "file"))...)
+	}
+	if v.HttpRequest != nil {
+		errs = append(errs, v.HttpRequest.ValidateAt(ocsf.JoinPath(path, "http_request"))...)
+	}
+	if v.HttpResponse != nil {
+		errs = append(errs, v.HttpResponse.ValidateAt(ocsf.JoinPath(path, "http_response"))...)
+	}
+	for i := range v.Ja4FingerprintList {
+		errs = append(errs, v.Ja4FingerprintList[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "ja4_fingerprint_list"), i))...)
+	}
+	if v.Job != nil {
+		errs = append(errs, v.Job.ValidateAt(ocsf.JoinPath(path, "job"))...)
+	}
+	if v.Process != nil {
+		errs = append(errs, v.Process.ValidateAt(ocsf.JoinPath(path, "process"))...)
+	}
+	if v.Query != nil {
+		errs = append(errs, v.Query.ValidateAt(ocsf.JoinPath(path, "query"))...)
+	}
+	if v.RegKey != nil {
+		errs = append(errs, v.RegKey.ValidateAt(ocsf.JoinPath(path, "reg_key"))...)
+	}
+	if v.RegValue != nil {
+		errs = append(errs, v.RegValue.ValidateAt(ocsf.JoinPath(path, "reg_value"))...)
+	}
+	if v.Script != nil {
+		errs = append(errs, v.Script.ValidateAt(ocsf.JoinPath(path, "script"))...)
+	}
+	if v.SrcEndpoint != nil {
+		errs = append(errs, v.SrcEndpoint.ValidateAt(ocsf.JoinPath(path, "src_endpoint"))...)
+	}
+	if v.Tls != nil {
+		errs = append(errs, v.Tls.ValidateAt(ocsf.JoinPath(path, "tls"))...)
+	}
+	if v.Url != nil {
+		errs = append(errs, v.Url.ValidateAt(ocsf.JoinPath(path, "url"))...)
+	}
+	if v.User != nil {
+		errs = append(errs, v.User.ValidateAt(ocsf.JoinPath(path, "user"))...)
+	}
+	if v.WinService != nil {
+		errs = append(errs, v.WinService.ValidateAt(ocsf.JoinPath(path, "win_service"))...)
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *EvidenceArtifacts) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "actor":
+		if index >= 0 || v.Actor == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Actor, true
+		}
+		return v.Actor.Get(rest)
+	case "api":
+		if index >= 0 || v.Api == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Api, true
+		}
+		return v.Api.Get(rest)
+	case "connection_info":
+		if index >= 0 || v.ConnectionInfo == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.ConnectionInfo, true
+		}
+		return v.ConnectionInfo.Get(rest)
+	case "container":
+		if index >= 0 || v.Container == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Container, true
+		}
+		return v.Container.Get(rest)
+	case "data":
+		if index >= 0 || rest != "" || v.Data == nil {
+			return nil, false
+		}
+		return *v.Data, true
+	case "database":
+		if index >= 0 || v.Database == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Database, true
+		}
+		return v.Database.Get(rest)
+	case "databucket":
+		if index >= 0 || v.Databucket == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Databucket, true
+		}
+		return v.Databucket.Get(rest)
+	case "device":
+		if index >= 0 || v.Device == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Device, true
+		}
+		return v.Device.Get(rest)
+	case "dst_endpoint":
+		if index >= 0 || v.DstEndpoint == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.DstEndpoint, true
+		}
+		return v.DstEndpoint.Get(rest)
+	case "email":
+		if index >= 0 || v.Email == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Email, true
+		}
+		return v.Email.Get(rest)
+	case "file":
+		if index >= 0 || v.File == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.File, true
+		}
+		return v.File.Get(rest)
+	case "http_request":
+		if index >= 0 || v.HttpRequest == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.HttpRequest, true
+		}
+		return v.HttpRequest.Get(rest)
+	case "http_response":
+		if index >= 0 || v.HttpResponse == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.HttpResponse, true
+		}
+		return v.HttpResponse.Get(rest)
+	case "ja4_fingerprint_list":
+		if rest == "" {
+			if index < 0 {
+				return v.Ja4FingerprintList, v.Ja4FingerprintList != nil
+			}
+			return ocsf.Index(v.Ja4FingerprintList, index)
+		}
+		if index < 0 || index >= len(v.Ja4FingerprintList) {
+			return nil, false
+		}
+		return v.Ja4FingerprintList[index].Get(rest)
+	case "job":
+		if index >= 0 || v.Job == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Job, true
+		}
+		return v.Job.Get(rest)
+	case "process":
+		if index >= 0 || v.Process == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Process, true
+		}
+		return v.Process.Get(rest)
+	case "query":
+		if index >= 0 || v.Query == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Query, true
+		}
+		return v.Query.Get(rest)
+	case "reg_key":
+		if index >= 0 || v.RegKey == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.RegKey, true
+		}
+		return v.RegKey.Get(rest)
+	case "reg_value":
+		if index >= 0 || v.RegValue == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.RegValue, true
+		}
+		return v.RegValue.Get(rest)
+	case "script":
+		if index >= 0 || v.Script == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Script, true
+		}
+		return v.Script.Get(rest)
+	case "src_endpoint":
+		if index >= 0 || v.SrcEndpoint == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.SrcEndpoint, true
+		}
+		return v.SrcEndpoint.Get(rest)
+	case "tls":
+		if index >= 0 || v.Tls == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Tls, true
+		}
+		return v.Tls.Get(rest)
+	case "url":
+		if index >= 0 || v.Url == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Url, true
+		}
+		return v.Url.Get(rest)
+	case "user":
+		if index >= 0 || v.User == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.User, true
+		}
+		return v.User.Get(rest)
+	case "win_service":
+		if index >= 0 || v.WinService == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.WinService, true
+		}
+		return v.WinService.Get(rest)
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *EvidenceArtifacts) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "actor":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Actor, value)
+		}
+		if v.Actor == nil {
+			v.Actor = &Actor{}
+		}
+		return v.Actor.Set(rest, value)
+	case "api":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Api, value)
+		}
+		if v.Api == nil {
+			v.Api = &API{}
+		}
+		return v.Api.Set(rest, value)
+	case "connection_info":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.ConnectionInfo, value)
+		}
+		if v.ConnectionInfo == nil {
+			v.ConnectionInfo = &NetworkConnectionInformation{}
+		}
+		return v.ConnectionInfo.Set(rest, value)
+	case "container":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Container, value)
+		}
+		if v.Container == nil {
+			v.Container = &Container{}
+		}
+		return v.Container.Set(rest, value)
+	case "data":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Data, value)
+	case "database":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Database, value)
+		}
+		if v.Database == nil {
+			v.Database = &Database{}
+		}
+		return v.Database.Set(rest, value)
+	case "databucket":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Databucket, value)
+		}
+		if v.Databucket == nil {
+			v.Databucket = &Databucket{}
+		}
+		return v.Databucket.Set(rest, value)
+	case "device":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Device, value)
+		}
+		if v.Device == nil {
+			v.Device = &Device{}
+		}
+		return v.Device.Set(rest, value)
+	case "dst_endpoint":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.DstEndpoint, value)
+		}
+		if v.DstEndpoint == nil {
+			v.DstEndpoint = &NetworkEndpoint{}
+		}
+		return v.DstEndpoint.Set(rest, value)
+	case "email":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Email, value)
+		}
+		if v.Email == nil {
+			v.Email = &Email{}
+		}
+		return v.Email.Set(rest, value)
+	case "file":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.File, value)
+		}
+		if v.File == nil {
+			v.File = &File{}
+		}
+		return v.File.Set(rest, value)
+	case "http_request":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.HttpRequest, value)
+		}
+		if v.HttpRequest == nil {
+			v.HttpRequest = &HTTPRequest{}
+		}
+		return v.HttpRequest.Set(rest, value)
+	case "http_response":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.HttpResponse, value)
+		}
+		if v.HttpResponse == nil {
+			v.HttpResponse = &HTTPResponse{}
+		}
+		return v.HttpResponse.Set(rest, value)
+	case "ja4_fingerprint_list":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Ja4FingerprintList, value)
+		}
+		elem, err := ocsf.Element(&v.Ja4FingerprintList, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "job":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Job, value)
+		}
+		if v.Job == nil {
+			v.Job = &Job{}
+		}
+		return v.Job.Set(rest, value)
+	case "process":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Process, value)
+		}
+		if v.Process == nil {
+			v.Process = &Process{}
+		}
+		return v.Process.Set(rest, value)
+	case "query":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Query, value)
+		}
+		if v.Query == nil {
+			v.Query = &DNSQuery{}
+		}
+		return v.Query.Set(rest, value)
+	case "reg_key":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.RegKey, value)
+		}
+		if v.RegKey == nil {
+			v.RegKey = &RegistryKey{}
+		}
+		return v.RegKey.Set(rest, value)
+	case "reg_value":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.RegValue, value)
+		}
+		if v.RegValue == nil {
+			v.RegValue = &RegistryValue{}
+		}
+		return v.RegValue.Set(rest, value)
+	case "script":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Script, value)
+		}
+		if v.Script == nil {
+			v.Script = &Script{}
+		}
+		return v.Script.Set(rest, value)
+	case "src_endpoint":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.SrcEndpoint, value)
+		}
+		if v.SrcEndpoint == nil {
+			v.SrcEndpoint = &NetworkEndpoint{}
+		}
+		return v.SrcEndpoint.Set(rest, value)
+	case "tls":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Tls, value)
+		}
+		if v.Tls == nil {
+			v.Tls = &TransportLayerSecurityTLS{}
+		}
+		return v.Tls.Set(rest, value)
+	case "url":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Url, value)
+		}
+		if v.Url == nil {
+			v.Url = &UniformResourceLocator{}
+		}
+		return v.Url.Set(rest, value)
+	case "user":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.User, value)
+		}
+		if v.User == nil {
+			v.User = &User{}
+		}
+		return v.User.Set(rest, value)
+	case "win_service":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.WinService, value)
+		}
+		if v.WinService == nil {
+			v.WinService = &WindowsService{}
+		}
+		return v.WinService.Set(rest, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *EvidenceArtifacts) AppendObservables(path string, observables []Observable) []Observable {
+	if v.Actor != nil {
+		observables = v.Actor.AppendObservables(ocsf.JoinPath(path, "actor"), observables)
+	}
+	if v.Api != nil {
+		observables = v.Api.AppendObservables(ocsf.JoinPath(path, "api"), observables)
+	}
+	if v.ConnectionInfo != nil {
+		observables = v.ConnectionInfo.AppendObservables(ocsf.JoinPath(path, "connection_info"), observables)
+	}
+	if v.Container != nil {
+		observables = v.Container.AppendObservables(ocsf.JoinPath(path, "container"), observables)
+	}
+	if v.Database != nil {
+		observables = v.Database.AppendObservables(ocsf.JoinPath(path, "database"), observables)
+	}
+	if v.Databucket != nil {
+		observables = v.Databucket.AppendObservables(ocsf.JoinPath(path, "databucket"), observables)
+	}
+	if v.Device != nil {
+		observables = v.Device.AppendObservables(ocsf.JoinPath(path, "device"), observables)
+	}
+	if v.DstEndpoint != nil {
+		observables = v.DstEndpoint.AppendObservables(ocsf.JoinPath(path, "dst_endpoint"), observables)
+	}
+	if v.Email != nil {
+		observables = v.Email.AppendObservables(ocsf.JoinPath(path, "email"), observables)
+	}
+	if v.File != nil {
+		observables = v.File.AppendObservables(ocsf.JoinPath(path, "file"), observables)
+	}
+	if v.HttpRequest != nil {
+		observables = v.HttpRequest.AppendObservables(ocsf.JoinPath(path, "http_request"), observables)
+	}
+	if v.HttpResponse != nil {
+		observables = v.HttpResponse.AppendObservables(ocsf.JoinPath(path, "http_response"), observables)
+	}
+	for i := range v.Ja4FingerprintList {
+		observables = v.Ja4FingerprintList[i].AppendObservables(ocsf.JoinPath(path, "ja4_fingerprint_list"), observables)
+	}
+	if v.Job != nil {
+		observables = v.Job.AppendObservables(ocsf.JoinPath(path, "job"), observables)
+	}
+	if v.Process != nil {
+		observables = v.Process.AppendObservables(ocsf.JoinPath(path, "process"), observables)
+	}
+	if v.Query != nil {
+		observables = v.Query.AppendObservables(ocsf.JoinPath(path, "query"), observables)
+	}
+	if v.RegKey != nil {
+		observables = v.RegKey.AppendObservables(ocsf.JoinPath(path, "reg_key"), observables)
+	}
+	if v.RegValue != nil {
+		observables = v.RegValue.AppendObservables(ocsf.JoinPath(path, "reg_value"), observables)
+	}
+	if v.Script != nil {
+		observables = v.Script.AppendObservables(ocsf.JoinPath(path, "script"), observables)
+	}
+	if v.SrcEndpoint != nil {
+		observables = v.SrcEndpoint.AppendObservables(ocsf.JoinPath(path, "src_endpoint"), observables)
+	}
+	if v.Tls != nil {
+		observables = v.Tls.AppendObservables(ocsf.JoinPath(path, "tls"), observables)
+	}
+	if v.Url != nil {
+		observables = v.Url.AppendObservables(ocsf.JoinPath(path, "url"), observables)
+	}
+	if v.User != nil {
+		observables = v.User.AppendObservables(ocsf.JoinPath(path, "user"), observables)
+	}
+	if v.WinService != nil {
+		observables = v.WinService.AppendObservables(ocsf.JoinPath(path, "win_service"), observables)
+	}
+
+	return observables
+}
+
 var EvidenceArtifactsFields = []arrow.Field{
-	{Name: "actor", Type: ActorStruct, Nullable: true},
-	{Name: "api", Type: APIStruct, Nullable: true},
-	{Name: "connection_info", Type: NetworkConnectionInformationStruct, Nullable: true},
-	{Name: "container", Type: ContainerStruct, Nullable: true},
-	{Name: "data", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "database", Type: DatabaseStruct, Nullable: true},
-	{Name: "databucket", Type: DatabucketStruct, Nullable: true},
-	{Name: "device", Type: DeviceStruct, Nullable: true},
-	{Name: "dst_endpoint", Type: NetworkEndpointStruct, Nullable: true},
-	{Name: "email", Type: EmailStruct, Nullable: true},
-	{Name: "file", Type: FileStruct, Nullable: true},
-	{Name: "http_request", Type: HTTPRequestStruct, Nullable: true},
-	{Name: "http_response", Type: HTTPResponseStruct, Nullable: true},
-	{Name: "ja4_fingerprint_list", Type: arrow.ListOf(JA4FingerprintStruct), Nullable: true},
-	{Name: "job", Type: JobStruct, Nullable: true},
-	{Name: "process", Type: ProcessStruct, Nullable: true},
-	{Name: "query", Type: DNSQueryStruct, Nullable: true},
-	{Name: "reg_key", Type: RegistryKeyStruct, Nullable: true},
-	{Name: "reg_value", Type: RegistryValueStruct, Nullable: true},
-	{Name: "script", Type: ScriptStruct, Nullable: true},
-	{Name: "src_endpoint", Type: NetworkEndpointStruct, Nullable: true},
-	{Name: "tls", Type: TransportLayerSecurityTLSStruct, Nullable: true},
-	{Name: "url", Type: UniformResourceLocatorStruct, Nullable: true},
-	{Name: "user", Type: UserStruct, Nullable: true},
-	{Name: "win_service", Type: WindowsServiceStruct, Nullable: true},
+	{Name: "actor", Type: ActorStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Actor", "Describes details about the user/role/process that was the source of the activity that triggered the detection.", "optional", "")},
+	{Name: "api", Type: APIStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("API Details", "Describes details about the API call associated to the activity that triggered the detection.", "optional", "")},
+	{Name: "connection_info", Type: NetworkConnectionInformationStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Connection Info", "Describes details about the network connection associated to the activity that triggered the detection.", "optional", "")},
+	{Name: "container", Type: ContainerStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Container", "Describes details about the container associated to the activity that triggered the detection.", "optional", "")},
+	{Name: "data", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Data", "Additional evidence data that is not accounted for in the specific evidence attributes.<code> Use only when absolutely necessary.</code>", "optional", "")},
+	{Name: "database", Type: DatabaseStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Database", "Describes details about the database associated to the activity that triggered the detection.", "optional", "")},
+	{Name: "databucket", Type: DatabucketStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Databucket", "Describes details about the databucket associated to the activity that triggered the detection.", "optional", "")},
+	{Name: "device", Type: DeviceStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Device", "An addressable device, computer system or host associated to the activity that triggered the detection.", "optional", "")},
+	{Name: "dst_endpoint", Type: NetworkEndpointStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Destination Endpoint", "Describes details about the destination of the network activity that triggered the detection.", "optional", "")},
+	{Name: "email", Type: EmailStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Email", "The email object associated to the activity that triggered the detection.", "optional", "")},
+	{Name: "file", Type: FileStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("File", "Describes details about the file associated to the activity that triggered the detection.", "optional", "")},
+	{Name: "http_request", Type: HTTPRequestStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("HTTP Request", "Describes details about the http request associated to the activity that triggered the detection.", "optional", "")},
+	{Name: "http_response", Type: HTTPResponseStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("HTTP Response", "Describes details about the http response associated to the activity that triggered the detection.", "optional", "")},
+	{Name: "ja4_fingerprint_list", Type: arrow.ListOf(JA4FingerprintStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("JA4+ Fingerprints", "Describes details about the JA4+ fingerprints that triggered the detection.", "optional", "")},
+	{Name: "job", Type: JobStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Job", "Describes details about the scheduled job that was associated with the activity that triggered the detection.", "optional", "")},
+	{Name: "process", Type: ProcessStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Process", "Describes details about the process associated to the activity that triggered the detection.", "optional", "")},
+	{Name: "query", Type: DNSQueryStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("DNS Query", "Describes details about the DNS query associated to the activity that triggered the detection.", "optional", "")},
+	{Name: "reg_key", Type: RegistryKeyStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Registry Key", "Describes details about the registry key that triggered the detection.", "optional", "")},
+	{Name: "reg_value", Type: RegistryValueStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Registry Value", "Describes details about the registry value that triggered the detection.", "optional", "")},
+	{Name: "script", Type: ScriptStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Script", "Describes details about the script that was associated with the activity that triggered the detection.", "optional", "")},
+	{Name: "src_endpoint", Type: NetworkEndpointStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Source Endpoint", "Describes details about the source of the network activity that triggered the detection.", "optional", "")},
+	{Name: "tls", Type: TransportLayerSecurityTLSStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("TLS", "Describes details about the Transport Layer Security (TLS) activity that triggered the detection.", "optional", "")},
+	{Name: "url", Type: UniformResourceLocatorStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("URL", "The URL object that pertains to the event or object associated to the activity that triggered the detection.", "optional", "")},
+	{Name: "user", Type: UserStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("User", "Describes details about the user that was the target or somehow else associated with the activity that triggered the detection.", "optional", "")},
+	{Name: "win_service", Type: WindowsServiceStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Windows Service", "Describes details about the Windows service that triggered the detection.", "optional", "")},
 }
 
 var EvidenceArtifactsStruct = arrow.StructOf(EvidenceArtifactsFields...)
@@ -2,6 +2,7 @@
 package v1_4_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -21,10 +22,95 @@ func (v *SchemaExtension) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *SchemaExtension) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *SchemaExtension) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	if v.Name == "" {
+		errs.Add(ocsf.JoinPath(path, "name"), "required attribute is missing")
+	}
+	if v.Uid == "" {
+		errs.Add(ocsf.JoinPath(path, "uid"), "required attribute is missing")
+	}
+	if v.Version == "" {
+		errs.Add(ocsf.JoinPath(path, "version"), "required attribute is missing")
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *SchemaExtension) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "name":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.Name, true
+	case "uid":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.Uid, true
+	case "version":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.Version, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *SchemaExtension) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.Name, value)
+	case "uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.Uid, value)
+	case "version":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.Version, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *SchemaExtension) AppendObservables(path string, observables []Observable) []Observable {
+
+	return observables
+}
+
 var SchemaExtensionFields = []arrow.Field{
-	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: false},
-	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: false},
-	{Name: "version", Type: arrow.BinaryTypes.String, Nullable: false},
+	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: false, Metadata: ocsf.AttributeMetadata("Name", "The schema extension name. For example: <code>dev</code>.", "required", "")},
+	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: false, Metadata: ocsf.AttributeMetadata("Unique ID", "The schema extension unique identifier. For example: <code>999</code>.", "required", "")},
+	{Name: "version", Type: arrow.BinaryTypes.String, Nullable: false, Metadata: ocsf.AttributeMetadata("Version", "The schema extension version. For example: <code>1.0.0-alpha.2</code>.", "required", "")},
 }
 
 var SchemaExtensionStruct = arrow.StructOf(SchemaExtensionFields...)
@@ -2,6 +2,7 @@
 package v1_4_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -122,7 +123,7 @@ type File struct {
 	Version *string `json:"version,omitempty" parquet:"version,optional"`
 
 	// Extended Attributes: An unordered collection of zero or more name/value pairs where each pair represents a file or folder extended attribute.</p>For example: Windows alternate data stream attributes (ADS stream name, ADS size, etc.), user-defined or application-defined attributes, ACL, owner, primary group, etc. Examples from DCS: </p><ul><li><strong>ads_name</strong></li><li><strong>ads_size</strong></li><li><strong>dacl</strong></li><li><strong>owner</strong></li><li><strong>primary_group</strong></li><li><strong>link_name</strong> - name of the link associated to the file.</li><li><strong>hard_link_count</strong> - the number of links that are associated to the file.</li></ul>
-	Xattributes *string `json:"xattributes,omitempty" parquet:"xattributes,optional"`
+	Xattributes *ocsf.RawJSON `json:"xattributes,omitempty" parquet:"xattributes,optional"`
 }
 
 func (v *File) Observable() (*int, string) {
@@ -130,46 +131,675 @@ func (v *File) Observable() (*int, string) {
 	return &typeId, "file"
 }
 
+func (v *File) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *File) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	if v.Accessor != nil {
+		errs = append(errs, v.Accessor.ValidateAt(ocsf.JoinPath(path, "accessor"))...)
+	}
+	if v.Creator != nil {
+		errs = append(errs, v.Creator.ValidateAt(ocsf.JoinPath(path, "creator"))...)
+	}
+	for i := range v.DataClassifications {
+		errs = append(errs, v.DataClassifications[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "data_classifications"), i))...)
+	}
+	if v.EncryptionDetails != nil {
+		errs = append(errs, v.EncryptionDetails.ValidateAt(ocsf.JoinPath(path, "encryption_details"))...)
+	}
+	for i := range v.Hashes {
+		errs = append(errs, v.Hashes[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "hashes"), i))...)
+	}
+	if v.Modifier != nil {
+		errs = append(errs, v.Modifier.ValidateAt(ocsf.JoinPath(path, "modifier"))...)
+	}
+	if v.Name == "" {
+		errs.Add(ocsf.JoinPath(path, "name"), "required attribute is missing")
+	}
+	if v.Owner != nil {
+		errs = append(errs, v.Owner.ValidateAt(ocsf.JoinPath(path, "owner"))...)
+	}
+	if v.Product != nil {
+		errs = append(errs, v.Product.ValidateAt(ocsf.JoinPath(path, "product"))...)
+	}
+	if v.Signature != nil {
+		errs = append(errs, v.Signature.ValidateAt(ocsf.JoinPath(path, "signature"))...)
+	}
+	for i := range v.Tags {
+		errs = append(errs, v.Tags[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "tags"), i))...)
+	}
+	if v.Url != nil {
+		errs = append(errs, v.Url.ValidateAt(ocsf.JoinPath(path, "url"))...)
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *File) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "accessed_time":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.AccessedTime, true
+	case "accessor":
+		if index >= 0 || v.Accessor == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Accessor, true
+		}
+		return v.Accessor.Get(rest)
+	case "attributes":
+		if index >= 0 || rest != "" || v.Attributes == nil {
+			return nil, false
+		}
+		return *v.Attributes, true
+	case "company_name":
+		if index >= 0 || rest != "" || v.CompanyName == nil {
+			return nil, false
+		}
+		return *v.CompanyName, true
+	case "confidentiality":
+		if index >= 0 || rest != "" || v.Confidentiality == nil {
+			return nil, false
+		}
+		return *v.Confidentiality, true
+	case "confidentiality_id":
+		if index >= 0 || rest != "" || v.ConfidentialityId == nil {
+			return nil, false
+		}
+		return *v.ConfidentialityId, true
+	case "created_time":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.CreatedTime, true
+	case "creator":
+		if index >= 0 || v.Creator == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Creator, true
+		}
+		return v.Creator.Get(rest)
+	case "data_classifications":
+		if rest == "" {
+			if index < 0 {
+				return v.DataClassifications, v.DataClassifications != nil
+			}
+			return ocsf.Index(v.DataClassifications, index)
+		}
+		if index < 0 || index >= len(v.DataClassifications) {
+			return nil, false
+		}
+		return v.DataClassifications[index].Get(rest)
+	case "desc":
+		if index >= 0 || rest != "" || v.Desc == nil {
+			return nil, false
+		}
+		return *v.Desc, true
+	case "drive_type":
+		if index >= 0 || rest != "" || v.DriveType == nil {
+			return nil, false
+		}
+		return *v.DriveType, true
+	case "drive_type_id":
+		if index >= 0 || rest != "" || v.DriveTypeId == nil {
+			return nil, false
+		}
+		return *v.DriveTypeId, true
+	case "encryption_details":
+		if index >= 0 || v.EncryptionDetails == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.EncryptionDetails, true
+		}
+		return v.EncryptionDetails.Get(rest)
+	case "ext":
+		if index >= 0 || rest != "" || v.Ext == nil {
+			return nil, false
+		}
+		return *v.Ext, true
+	case "hashes":
+		if rest == "" {
+			if index < 0 {
+				return v.Hashes, v.Hashes != nil
+			}
+			return ocsf.Index(v.Hashes, index)
+		}
+		if index < 0 || index >= len(v.Hashes) {
+			return nil, false
+		}
+		return v.Hashes[index].Get(rest)
+	case "internal_name":
+		if index >= 0 || rest != "" || v.InternalName == nil {
+			return nil, false
+		}
+		return *v.InternalName, true
+	case "is_deleted":
+		if index >= 0 || rest != "" || v.IsDeleted == nil {
+			return nil, false
+		}
+		return *v.IsDeleted, true
+	case "is_encrypted":
+		if index >= 0 || rest != "" || v.IsEncrypted == nil {
+			return nil, false
+		}
+		return *v.IsEncrypted, true
+	case "is_public":
+		if index >= 0 || rest != "" || v.IsPublic == nil {
+			return nil, false
+		}
+		return *v.IsPublic, true
+	case "is_system":
+		if index >= 0 || rest != "" || v.IsSystem == nil {
+			return nil, false
+		}
+		return *v.IsSystem, true
+	case "mime_type":
+		if index >= 0 || rest != "" || v.MimeType == nil {
+			return nil, false
+		}
+		return *v.MimeType, true
+	case "modified_time":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.ModifiedTime, true
+	case "modifier":
+		if index >= 0 || v.Modifier == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Modifier, true
+		}
+		return v.Modifier.Get(rest)
+	case "name":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.Name, true
+	case "owner":
+		if index >= 0 || v.Owner == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Owner, true
+		}
+		return v.Owner.Get(rest)
+	case "parent_folder":
+		if index >= 0 || rest != "" || v.ParentFolder == nil {
+			return nil, false
+		}
+		return *v.ParentFolder, true
+	case "path":
+		if index >= 0 || rest != "" || v.Path == nil {
+			return nil, false
+		}
+		return *v.Path, true
+	case "product":
+		if index >= 0 || v.Product == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Product, true
+		}
+		return v.Product.Get(rest)
+	case "security_descriptor":
+		if index >= 0 || rest != "" || v.SecurityDescriptor == nil {
+			return nil, false
+		}
+		return *v.SecurityDescriptor, true
+	case "signature":
+		if index >= 0 || v.Signature == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Signature, true
+		}
+		return v.Signature.Get(rest)
+	case "size":
+		if index >= 0 || rest != "" || v.Size == nil {
+			return nil, false
+		}
+		return *v.Size, true
+	case "storage_class":
+		if index >= 0 || rest != "" || v.StorageClass == nil {
+			return nil, false
+		}
+		return *v.StorageClass, true
+	case "tags":
+		if rest == "" {
+			if index < 0 {
+				return v.Tags, v.Tags != nil
+			}
+			return ocsf.Index(v.Tags, index)
+		}
+		if index < 0 || index >= len(v.Tags) {
+			return nil, false
+		}
+		return v.Tags[index].Get(rest)
+	case "type":
+		if index >= 0 || rest != "" || v.Type == nil {
+			return nil, false
+		}
+		return *v.Type, true
+	case "type_id":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.TypeId, true
+	case "uid":
+		if index >= 0 || rest != "" || v.Uid == nil {
+			return nil, false
+		}
+		return *v.Uid, true
+	case "url":
+		if index >= 0 || v.Url == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Url, true
+		}
+		return v.Url.Get(rest)
+	case "version":
+		if index >= 0 || rest != "" || v.Version == nil {
+			return nil, false
+		}
+		return *v.Version, true
+	case "xattributes":
+		if index >= 0 || rest != "" || v.Xattributes == nil {
+			return nil, false
+		}
+		return *v.Xattributes, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *File) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "accessed_time":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.AccessedTime, value)
+	case "accessor":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Accessor, value)
+		}
+		if v.Accessor == nil {
+			v.Accessor = &User{}
+		}
+		return v.Accessor.Set(rest, value)
+	case "attributes":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Attributes, value)
+	case "company_name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.CompanyName, value)
+	case "confidentiality":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Confidentiality, value)
+	case "confidentiality_id":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.ConfidentialityId, value)
+	case "created_time":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.CreatedTime, value)
+	case "creator":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Creator, value)
+		}
+		if v.Creator == nil {
+			v.Creator = &User{}
+		}
+		return v.Creator.Set(rest, value)
+	case "data_classifications":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.DataClassifications, value)
+		}
+		elem, err := ocsf.Element(&v.DataClassifications, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "desc":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Desc, value)
+	case "drive_type":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.DriveType, value)
+	case "drive_type_id":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.DriveTypeId, value)
+	case "encryption_details":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.EncryptionDetails, value)
+		}
+		if v.EncryptionDetails == nil {
+			v.EncryptionDetails = &EncryptionDetails{}
+		}
+		return v.EncryptionDetails.Set(rest, value)
+	case "ext":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Ext, value)
+	case "hashes":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Hashes, value)
+		}
+		elem, err := ocsf.Element(&v.Hashes, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "internal_name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.InternalName, value)
+	case "is_deleted":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.IsDeleted, value)
+	case "is_encrypted":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.IsEncrypted, value)
+	case "is_public":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.IsPublic, value)
+	case "is_system":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.IsSystem, value)
+	case "mime_type":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.MimeType, value)
+	case "modified_time":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.ModifiedTime, value)
+	case "modifier":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Modifier, value)
+		}
+		if v.Modifier == nil {
+			v.Modifier = &User{}
+		}
+		return v.Modifier.Set(rest, value)
+	case "name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.Name, value)
+	case "owner":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Owner, value)
+		}
+		if v.Owner == nil {
+			v.Owner = &User{}
+		}
+		return v.Owner.Set(rest, value)
+	case "parent_folder":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.ParentFolder, value)
+	case "path":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Path, value)
+	case "product":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Product, value)
+		}
+		if v.Product == nil {
+			v.Product = &Product{}
+		}
+		return v.Product.Set(rest, value)
+	case "security_descriptor":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.SecurityDescriptor, value)
+	case "signature":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Signature, value)
+		}
+		if v.Signature == nil {
+			v.Signature = &DigitalSignature{}
+		}
+		return v.Signature.Set(rest, value)
+	case "size":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Size, value)
+	case "storage_class":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.StorageClass, value)
+	case "tags":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Tags, value)
+		}
+		elem, err := ocsf.Element(&v.Tags, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "type":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Type, value)
+	case "type_id":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.TypeId, value)
+	case "uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Uid, value)
+	case "url":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Url, value)
+		}
+		if v.Url == nil {
+			v.Url = &UniformResourceLocator{}
+		}
+		return v.Url.Set(rest, value)
+	case "version":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Version, value)
+	case "xattributes":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Xattributes, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *File) AppendObservables(path string, observables []Observable) []Observable {
+	if path != "" {
+		observables = append(observables, Observable{Name: ocsf.Ptr(path), TypeId: 24})
+	}
+	if v.Accessor != nil {
+		observables = v.Accessor.AppendObservables(ocsf.JoinPath(path, "accessor"), observables)
+	}
+	if v.Creator != nil {
+		observables = v.Creator.AppendObservables(ocsf.JoinPath(path, "creator"), observables)
+	}
+	for i := range v.DataClassifications {
+		observables = v.DataClassifications[i].AppendObservables(ocsf.JoinPath(path, "data_classifications"), observables)
+	}
+	if v.EncryptionDetails != nil {
+		observables = v.EncryptionDetails.AppendObservables(ocsf.JoinPath(path, "encryption_details"), observables)
+	}
+	for i := range v.Hashes {
+		observables = v.Hashes[i].AppendObservables(ocsf.JoinPath(path, "hashes"), observables)
+	}
+	if v.Modifier != nil {
+		observables = v.Modifier.AppendObservables(ocsf.JoinPath(path, "modifier"), observables)
+	}
+	observables = append(observables, Observable{Name: ocsf.Ptr(ocsf.JoinPath(path, "name")), TypeId: 7, Value: ocsf.Ptr(v.Name)})
+	if v.Owner != nil {
+		observables = v.Owner.AppendObservables(ocsf.JoinPath(path, "owner"), observables)
+	}
+	if v.Product != nil {
+		observables = v.Product.AppendObservables(ocsf.JoinPath(path, "product"), observables)
+	}
+	if v.Signature != nil {
+		observables = v.Signature.AppendObservables(ocsf.JoinPath(path, "signature"), observables)
+	}
+	for i := range v.Tags {
+		observables = v.Tags[i].AppendObservables(ocsf.JoinPath(path, "tags"), observables)
+	}
+	if v.Url != nil {
+		observables = v.Url.AppendObservables(ocsf.JoinPath(path, "url"), observables)
+	}
+
+	return observables
+}
+
 var FileFields = []arrow.Field{
-	{Name: "accessed_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
-	{Name: "accessor", Type: UserStruct, Nullable: true},
-	{Name: "attributes", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "company_name", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "confidentiality", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "confidentiality_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "created_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
-	{Name: "creator", Type: UserStruct, Nullable: true},
-	{Name: "data_classifications", Type: arrow.ListOf(DataClassificationStruct), Nullable: true},
-	{Name: "desc", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "drive_type", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "drive_type_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "encryption_details", Type: EncryptionDetailsStruct, Nullable: true},
-	{Name: "ext", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "hashes", Type: arrow.ListOf(FingerprintStruct), Nullable: true},
-	{Name: "internal_name", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "is_deleted", Type: arrow.FixedWidthTypes.Boolean, Nullable: true},
-	{Name: "is_encrypted", Type: arrow.FixedWidthTypes.Boolean, Nullable: true},
-	{Name: "is_public", Type: arrow.FixedWidthTypes.Boolean, Nullable: true},
-	{Name: "is_system", Type: arrow.FixedWidthTypes.Boolean, Nullable: true},
-	{Name: "mime_type", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "modified_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
-	{Name: "modifier", Type: UserStruct, Nullable: true},
-	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: false},
-	{Name: "owner", Type: UserStruct, Nullable: true},
-	{Name: "parent_folder", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "path", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "product", Type: ProductStruct, Nullable: true},
-	{Name: "security_descriptor", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "signature", Type: DigitalSignatureStruct, Nullable: true},
-	{Name: "size", Type: arrow.PrimitiveTypes.Int64, Nullable: true},
-	{Name: "storage_class", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "tags", Type: arrow.ListOf(KeyValueobjectStruct), Nullable: true},
-	{Name: "type", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "type_id", Type: arrow.PrimitiveTypes.Int32, Nullable: false},
-	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "url", Type: UniformResourceLocatorStruct, Nullable: true},
-	{Name: "version", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "xattributes", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "accessed_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true, Metadata: ocsf.AttributeMetadata("Accessed Time", "The time when the file was last accessed.", "optional", "")},
+	{Name: "accessor", Type: UserStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Accessor", "The name of the user who last accessed the object.", "optional", "")},
+	{Name: "attributes", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Attributes", "The bitmask value that represents the file attributes.", "optional", "")},
+	{Name: "company_name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Company Name", "The name of the company that published the file. For example: <code>Microsoft Corporation</code>.", "optional", "")},
+	{Name: "confidentiality", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Confidentiality", "The file content confidentiality, normalized to the confidentiality_id value. In the case of 'Other', it is defined by the event source.", "optional", "")},
+	{Name: "confidentiality_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Confidentiality ID", "The normalized identifier of the file content confidentiality indicator.", "optional", "")},
+	{Name: "created_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true, Metadata: ocsf.AttributeMetadata("Created Time", "The time when the file was created.", "optional", "")},
+	{Name: "creator", Type: UserStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Creator", "The user that created the file.", "optional", "")},
+	{Name: "data_classifications", Type: arrow.ListOf(DataClassificationStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("Data Classification", "A list of Data Classification objects, that include information about data classification levels and data category types, indentified by a classifier.", "optional", "")},
+	{Name: "desc", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Description", "The description of the file, as returned by file system. For example: the description as returned by the Unix file command or the Windows file type.", "optional", "")},
+	{Name: "drive_type", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Drive Type", "The drive type, normalized to the caption of the <code>drive_type_id</code> value. In the case of <code>Other</code>, it is defined by the source.", "optional", "")},
+	{Name: "drive_type_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Drive Type ID", "Identifies the type of a disk drive, i.e. fixed, removable, etc.", "optional", "")},
+	{Name: "encryption_details", Type: EncryptionDetailsStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Encryption Details", "The encryption details of the file. Should be populated if the file is encrypted.", "optional", "")},
+	{Name: "ext", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("File Extension", "The extension of the file, excluding the leading dot. For example: <code>exe</code> from <code>svchost.exe</code>, or <code>gz</code> from <code>export.tar.gz</code>.", "optional", "")},
+	{Name: "hashes", Type: arrow.ListOf(FingerprintStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("Hashes", "An array of hash attributes.", "optional", "")},
+	{Name: "internal_name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Internal Name", "The name of the file as identified within the file itself. This contrasts with the name by which the file is known on disk. Where available, the internal name is widely used by security practitioners and detection content because the on-disk file name is not reliable. On the Windows OS, most PE files contain a <a href=\"https://learn.microsoft.com/en-us/windows/win32/menurc/versioninfo-resource\">VERSIONINFO</a> resource from which the internal name can be obtained. On macOS, binaries can optionally embed a copy of the application's Info.plist file which in turn contains the name of the executable.", "optional", "")},
+	{Name: "is_deleted", Type: arrow.FixedWidthTypes.Boolean, Nullable: true, Metadata: ocsf.AttributeMetadata("Deleted", "Indicates if the file was deleted from the filesystem.", "optional", "")},
+	{Name: "is_encrypted", Type: arrow.FixedWidthTypes.Boolean, Nullable: true, Metadata: ocsf.AttributeMetadata("Encrypted", "Indicates if the file is encrypted.", "optional", "")},
+	{Name: "is_public", Type: arrow.FixedWidthTypes.Boolean, Nullable: true, Metadata: ocsf.AttributeMetadata("Public", "Indicates if the file is publicly accessible. For example in an object's public access in AWS S3", "optional", "")},
+	{Name: "is_system", Type: arrow.FixedWidthTypes.Boolean, Nullable: true, Metadata: ocsf.AttributeMetadata("System", "The indication of whether the object is part of the operating system.", "optional", "")},
+	{Name: "mime_type", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("MIME type", "The Multipurpose Internet Mail Extensions (MIME) type of the file, if applicable.", "optional", "")},
+	{Name: "modified_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true, Metadata: ocsf.AttributeMetadata("Modified Time", "The time when the file was last modified.", "optional", "")},
+	{Name: "modifier", Type: UserStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Modifier", "The user that last modified the file.", "optional", "")},
+	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: false, Metadata: ocsf.AttributeMetadata("Name", "The name of the file. For example: <code>svchost.exe</code>", "required", "")},
+	{Name: "owner", Type: UserStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Owner", "The user that owns the file/object.", "optional", "")},
+	{Name: "parent_folder", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Parent Folder", "The parent folder in which the file resides. For example: <code>c:\\windows\\system32</code>", "optional", "")},
+	{Name: "path", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Path", "The full path to the file. For example: <code>c:\\windows\\system32\\svchost.exe</code>.", "optional", "")},
+	{Name: "product", Type: ProductStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Product", "The product that created or installed the file.", "optional", "")},
+	{Name: "security_descriptor", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Security Descriptor", "The object security descriptor.", "optional", "")},
+	{Name: "signature", Type: DigitalSignatureStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Digital Signature", "The digital signature of the file.", "optional", "")},
+	{Name: "size", Type: arrow.PrimitiveTypes.Int64, Nullable: true, Metadata: ocsf.AttributeMetadata("Size", "The size of data, in bytes.", "optional", "")},
+	{Name: "storage_class", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Storage Class", "The storage class of the file. For example in AWS S3: <code>STANDARD, STANDARD_IA, GLACIER</code>.", "optional", "")},
+	{Name: "tags", Type: arrow.ListOf(KeyValueobjectStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("Tags", "The list of tags; <code>{key:value}</code> pairs associated to the file.", "optional", "")},
+	{Name: "type", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Type", "The file type.", "optional", "")},
+	{Name: "type_id", Type: arrow.PrimitiveTypes.Int32, Nullable: false, Metadata: ocsf.AttributeMetadata("Type ID", "The file type ID.", "required", "")},
+	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Unique ID", "The unique identifier of the file as defined by the storage system, such the file system file ID.", "optional", "")},
+	{Name: "url", Type: UniformResourceLocatorStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("URL", "The URL of the file, when applicable.", "optional", "")},
+	{Name: "version", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Version", "The file version. For example: <code>8.0.7601.17514</code>.", "optional", "")},
+	{Name: "xattributes", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Extended Attributes", "An unordered collection of zero or more name/value pairs where each pair represents a file or folder extended attribute.</p>For example: Windows alternate data stream attributes (ADS stream name, ADS size, etc.), user-defined or application-defined attributes, ACL, owner, primary group, etc. Examples from DCS: </p><ul><li><strong>ads_name</strong></li><li><strong>ads_size</strong></li><li><strong>dacl</strong></li><li><strong>owner</strong></li><li><strong>primary_group</strong></li><li><strong>link_name</strong> - name of the link associated to the file.</li><li><strong>hard_link_count</strong> - the number of links that are associated to the file.</li></ul>", "optional", "")},
 }
 
 var FileStruct = arrow.StructOf(FileFields...)
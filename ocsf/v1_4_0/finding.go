@@ -2,6 +2,7 @@
 package v1_4_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -51,20 +52,267 @@ func (v *Finding) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *Finding) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *Finding) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	if v.Product != nil {
+		errs = append(errs, v.Product.ValidateAt(ocsf.JoinPath(path, "product"))...)
+	}
+	for i := range v.RelatedEvents {
+		errs = append(errs, v.RelatedEvents[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "related_events"), i))...)
+	}
+	if v.Remediation != nil {
+		errs = append(errs, v.Remediation.ValidateAt(ocsf.JoinPath(path, "remediation"))...)
+	}
+	if v.Title == "" {
+		errs.Add(ocsf.JoinPath(path, "title"), "required attribute is missing")
+	}
+	if v.Uid == "" {
+		errs.Add(ocsf.JoinPath(path, "uid"), "required attribute is missing")
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *Finding) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "created_time":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.CreatedTime, true
+	case "desc":
+		if index >= 0 || rest != "" || v.Desc == nil {
+			return nil, false
+		}
+		return *v.Desc, true
+	case "first_seen_time":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.FirstSeenTime, true
+	case "last_seen_time":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.LastSeenTime, true
+	case "modified_time":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.ModifiedTime, true
+	case "product":
+		if index >= 0 || v.Product == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Product, true
+		}
+		return v.Product.Get(rest)
+	case "related_events":
+		if rest == "" {
+			if index < 0 {
+				return v.RelatedEvents, v.RelatedEvents != nil
+			}
+			return ocsf.Index(v.RelatedEvents, index)
+		}
+		if index < 0 || index >= len(v.RelatedEvents) {
+			return nil, false
+		}
+		return v.RelatedEvents[index].Get(rest)
+	case "remediation":
+		if index >= 0 || v.Remediation == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Remediation, true
+		}
+		return v.Remediation.Get(rest)
+	case "src_url":
+		if index >= 0 || rest != "" || v.SrcUrl == nil {
+			return nil, false
+		}
+		return *v.SrcUrl, true
+	case "supporting_data":
+		if index >= 0 || rest != "" || v.SupportingData == nil {
+			return nil, false
+		}
+		return *v.SupportingData, true
+	case "title":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.Title, true
+	case "types":
+		if rest == "" {
+			if index < 0 {
+				return v.Types, v.Types != nil
+			}
+			return ocsf.Index(v.Types, index)
+		}
+		return nil, false
+	case "uid":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.Uid, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *Finding) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "created_time":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.CreatedTime, value)
+	case "desc":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Desc, value)
+	case "first_seen_time":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.FirstSeenTime, value)
+	case "last_seen_time":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.LastSeenTime, value)
+	case "modified_time":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.ModifiedTime, value)
+	case "product":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Product, value)
+		}
+		if v.Product == nil {
+			v.Product = &Product{}
+		}
+		return v.Product.Set(rest, value)
+	case "related_events":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.RelatedEvents, value)
+		}
+		elem, err := ocsf.Element(&v.RelatedEvents, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "remediation":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Remediation, value)
+		}
+		if v.Remediation == nil {
+			v.Remediation = &Remediation{}
+		}
+		return v.Remediation.Set(rest, value)
+	case "src_url":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.SrcUrl, value)
+	case "supporting_data":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.SupportingData, value)
+	case "title":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.Title, value)
+	case "types":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Types, value)
+		}
+		elem, err := ocsf.Element(&v.Types, index)
+		if err != nil {
+			return err
+		}
+		if rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(elem, value)
+	case "uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.Uid, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *Finding) AppendObservables(path string, observables []Observable) []Observable {
+	if v.Product != nil {
+		observables = v.Product.AppendObservables(ocsf.JoinPath(path, "product"), observables)
+	}
+	for i := range v.RelatedEvents {
+		observables = v.RelatedEvents[i].AppendObservables(ocsf.JoinPath(path, "related_events"), observables)
+	}
+	if v.Remediation != nil {
+		observables = v.Remediation.AppendObservables(ocsf.JoinPath(path, "remediation"), observables)
+	}
+
+	return observables
+}
+
 var FindingFields = []arrow.Field{
-	{Name: "created_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
-	{Name: "desc", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "first_seen_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
-	{Name: "last_seen_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
-	{Name: "modified_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
-	{Name: "product", Type: ProductStruct, Nullable: true},
-	{Name: "related_events", Type: arrow.ListOf(RelatedEventFindingStruct), Nullable: true},
-	{Name: "remediation", Type: RemediationStruct, Nullable: true},
-	{Name: "src_url", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "supporting_data", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "title", Type: arrow.BinaryTypes.String, Nullable: false},
-	{Name: "types", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true},
-	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: false},
+	{Name: "created_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true, Metadata: ocsf.AttributeMetadata("Created Time", "The time when the finding was created.", "optional", "")},
+	{Name: "desc", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Description", "The description of the reported finding.", "optional", "")},
+	{Name: "first_seen_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true, Metadata: ocsf.AttributeMetadata("First Seen", "The time when the finding was first observed.", "optional", "")},
+	{Name: "last_seen_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true, Metadata: ocsf.AttributeMetadata("Last Seen", "The time when the finding was most recently observed.", "optional", "")},
+	{Name: "modified_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true, Metadata: ocsf.AttributeMetadata("Modified Time", "The time when the finding was last modified.", "optional", "")},
+	{Name: "product", Type: ProductStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Product", "Details about the product that reported the finding.", "optional", "")},
+	{Name: "related_events", Type: arrow.ListOf(RelatedEventFindingStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("Related Events/Findings", "Describes events and/or other findings related to the finding as identified by the security product. Note that these events may or may not be in OCSF.", "optional", "")},
+	{Name: "remediation", Type: RemediationStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Remediation Guidance", "Describes the recommended remediation steps to address identified issue(s).", "optional", "")},
+	{Name: "src_url", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Source URL", "The URL pointing to the source of the finding.", "optional", "")},
+	{Name: "supporting_data", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Supporting Data", "Additional data supporting a finding as provided by security tool", "optional", "")},
+	{Name: "title", Type: arrow.BinaryTypes.String, Nullable: false, Metadata: ocsf.AttributeMetadata("Title", "A title or a brief phrase summarizing the reported finding.", "required", "")},
+	{Name: "types", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true, Metadata: ocsf.AttributeMetadata("Types", "One or more types of the reported finding.", "optional", "")},
+	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: false, Metadata: ocsf.AttributeMetadata("Unique ID", "The unique identifier of the reported finding.", "required", "")},
 }
 
 var FindingStruct = arrow.StructOf(FindingFields...)
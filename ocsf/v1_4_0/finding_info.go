@@ -2,6 +2,7 @@
 package v1_4_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -69,26 +70,431 @@ func (v *FindingInformation) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *FindingInformation) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *FindingInformation) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	if v.Analytic != nil {
+		errs = append(errs, v.Analytic.ValidateAt(ocsf.JoinPath(path, "analytic"))...)
+	}
+	for i := range v.Attacks {
+		errs = append(errs, v.Attacks[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "attacks"), i))...)
+	}
+	for i := range v.KillChain {
+		errs = append(errs, v.KillChain[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "kill_chain"), i))...)
+	}
+	if v.Product != nil {
+		errs = append(errs, v.Product.ValidateAt(ocsf.JoinPath(path, "product"))...)
+	}
+	for i := range v.RelatedAnalytics {
+		errs = append(errs, v.RelatedAnalytics[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "related_analytics"), i))...)
+	}
+	for i := range v.RelatedEvents {
+		errs = append(errs, v.RelatedEvents[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "related_events"), i))...)
+	}
+	for i := range v.Tags {
+		errs = append(errs, v.Tags[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "tags"), i))...)
+	}
+	if v.Uid == "" {
+		errs.Add(ocsf.JoinPath(path, "uid"), "required attribute is missing")
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *FindingInformation) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "analytic":
+		if index >= 0 || v.Analytic == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Analytic, true
+		}
+		return v.Analytic.Get(rest)
+	case "attacks":
+		if rest == "" {
+			if index < 0 {
+				return v.Attacks, v.Attacks != nil
+			}
+			return ocsf.Index(v.Attacks, index)
+		}
+		if index < 0 || index >= len(v.Attacks) {
+			return nil, false
+		}
+		return v.Attacks[index].Get(rest)
+	case "created_time":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.CreatedTime, true
+	case "data_sources":
+		if rest == "" {
+			if index < 0 {
+				return v.DataSources, v.DataSources != nil
+			}
+			return ocsf.Index(v.DataSources, index)
+		}
+		return nil, false
+	case "desc":
+		if index >= 0 || rest != "" || v.Desc == nil {
+			return nil, false
+		}
+		return *v.Desc, true
+	case "first_seen_time":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.FirstSeenTime, true
+	case "kill_chain":
+		if rest == "" {
+			if index < 0 {
+				return v.KillChain, v.KillChain != nil
+			}
+			return ocsf.Index(v.KillChain, index)
+		}
+		if index < 0 || index >= len(v.KillChain) {
+			return nil, false
+		}
+		return v.KillChain[index].Get(rest)
+	case "last_seen_time":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.LastSeenTime, true
+	case "modified_time":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.ModifiedTime, true
+	case "product":
+		if index >= 0 || v.Product == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Product, true
+		}
+		return v.Product.Get(rest)
+	case "related_analytics":
+		if rest == "" {
+			if index < 0 {
+				return v.RelatedAnalytics, v.RelatedAnalytics != nil
+			}
+			return ocsf.Index(v.RelatedAnalytics, index)
+		}
+		if index < 0 || index >= len(v.RelatedAnalytics) {
+			return nil, false
+		}
+		return v.RelatedAnalytics[index].Get(rest)
+	case "related_events":
+		if rest == "" {
+			if index < 0 {
+				return v.RelatedEvents, v.RelatedEvents != nil
+			}
+			return ocsf.Index(v.RelatedEvents, index)
+		}
+		if index < 0 || index >= len(v.RelatedEvents) {
+			return nil, false
+		}
+		return v.RelatedEvents[index].Get(rest)
+	case "related_events_count":
+		if index >= 0 || rest != "" || v.RelatedEventsCount == nil {
+			return nil, false
+		}
+		return *v.RelatedEventsCount, true
+	case "src_url":
+		if index >= 0 || rest != "" || v.SrcUrl == nil {
+			return nil, false
+		}
+		return *v.SrcUrl, true
+	case "tags":
+		if rest == "" {
+			if index < 0 {
+				return v.Tags, v.Tags != nil
+			}
+			return ocsf.Index(v.Tags, index)
+		}
+		if index < 0 || index >= len(v.Tags) {
+			return nil, false
+		}
+		return v.Tags[index].Get(rest)
+	case "title":
+		if index >= 0 || rest != "" || v.Title == nil {
+			return nil, false
+		}
+		return *v.Title, true
+	case "types":
+		if rest == "" {
+			if index < 0 {
+				return v.Types, v.Types != nil
+			}
+			return ocsf.Index(v.Types, index)
+		}
+		return nil, false
+	case "uid":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.Uid, true
+	case "uid_alt":
+		if index >= 0 || rest != "" || v.UidAlt == nil {
+			return nil, false
+		}
+		return *v.UidAlt, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *FindingInformation) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "analytic":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Analytic, value)
+		}
+		if v.Analytic == nil {
+			v.Analytic = &Analytic{}
+		}
+		return v.Analytic.Set(rest, value)
+	case "attacks":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Attacks, value)
+		}
+		elem, err := ocsf.Element(&v.Attacks, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "created_time":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.CreatedTime, value)
+	case "data_sources":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.DataSources, value)
+		}
+		elem, err := ocsf.Element(&v.DataSources, index)
+		if err != nil {
+			return err
+		}
+		if rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(elem, value)
+	case "desc":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Desc, value)
+	case "first_seen_time":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.FirstSeenTime, value)
+	case "kill_chain":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.KillChain, value)
+		}
+		elem, err := ocsf.Element(&v.KillChain, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "last_seen_time":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.LastSeenTime, value)
+	case "modified_time":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.ModifiedTime, value)
+	case "product":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Product, value)
+		}
+		if v.Product == nil {
+			v.Product = &Product{}
+		}
+		return v.Product.Set(rest, value)
+	case "related_analytics":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.RelatedAnalytics, value)
+		}
+		elem, err := ocsf.Element(&v.RelatedAnalytics, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "related_events":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.RelatedEvents, value)
+		}
+		elem, err := ocsf.Element(&v.RelatedEvents, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "related_events_count":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.RelatedEventsCount, value)
+	case "src_url":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.SrcUrl, value)
+	case "tags":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Tags, value)
+		}
+		elem, err := ocsf.Element(&v.Tags, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "title":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Title, value)
+	case "types":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Types, value)
+		}
+		elem, err := ocsf.Element(&v.Types, index)
+		if err != nil {
+			return err
+		}
+		if rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(elem, value)
+	case "uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.Uid, value)
+	case "uid_alt":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.UidAlt, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *FindingInformation) AppendObservables(path string, observables []Observable) []Observable {
+	if v.Analytic != nil {
+		observables = v.Analytic.AppendObservables(ocsf.JoinPath(path, "analytic"), observables)
+	}
+	for i := range v.Attacks {
+		observables = v.Attacks[i].AppendObservables(ocsf.JoinPath(path, "attacks"), observables)
+	}
+	for i := range v.KillChain {
+		observables = v.KillChain[i].AppendObservables(ocsf.JoinPath(path, "kill_chain"), observables)
+	}
+	if v.Product != nil {
+		observables = v.Product.AppendObservables(ocsf.JoinPath(path, "product"), observables)
+	}
+	for i := range v.RelatedAnalytics {
+		observables = v.RelatedAnalytics[i].AppendObservables(ocsf.JoinPath(path, "related_analytics"), observables)
+	}
+	for i := range v.RelatedEvents {
+		observables = v.RelatedEvents[i].AppendObservables(ocsf.JoinPath(path, "related_events"), observables)
+	}
+	for i := range v.Tags {
+		observables = v.Tags[i].AppendObservables(ocsf.JoinPath(path, "tags"), observables)
+	}
+
+	return observables
+}
+
 var FindingInformationFields = []arrow.Field{
-	{Name: "analytic", Type: AnalyticStruct, Nullable: true},
-	{Name: "attacks", Type: arrow.ListOf(MITREATTCKStruct), Nullable: true},
-	{Name: "created_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
-	{Name: "data_sources", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true},
-	{Name: "desc", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "first_seen_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
-	{Name: "kill_chain", Type: arrow.ListOf(KillChainPhaseStruct), Nullable: true},
-	{Name: "last_seen_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
-	{Name: "modified_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
-	{Name: "product", Type: ProductStruct, Nullable: true},
-	{Name: "related_analytics", Type: arrow.ListOf(AnalyticStruct), Nullable: true},
-	{Name: "related_events", Type: arrow.ListOf(RelatedEventFindingStruct), Nullable: true},
-	{Name: "related_events_count", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "src_url", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "tags", Type: arrow.ListOf(KeyValueobjectStruct), Nullable: true},
-	{Name: "title", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "types", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true},
-	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: false},
-	{Name: "uid_alt", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "analytic", Type: AnalyticStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Analytic", "The analytic technique used to analyze and derive insights from the data or information that led to the finding or conclusion.", "optional", "")},
+	{Name: "attacks", Type: arrow.ListOf(MITREATTCKStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("MITRE ATT&CK® Details", "The <a target='_blank' href='https://attack.mitre.org'>MITRE ATT&CK®</a> technique and associated tactics related to the finding.", "optional", "")},
+	{Name: "created_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true, Metadata: ocsf.AttributeMetadata("Created Time", "The time when the finding was created.", "optional", "")},
+	{Name: "data_sources", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true, Metadata: ocsf.AttributeMetadata("Data Sources", "A list of data sources utilized in generation of the finding.", "optional", "")},
+	{Name: "desc", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Description", "The description of the reported finding.", "optional", "")},
+	{Name: "first_seen_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true, Metadata: ocsf.AttributeMetadata("First Seen", "The time when the finding was first observed. e.g. The time when a vulnerability was first observed. <p>It can differ from the <code>created_time</code> timestamp, which reflects the time this finding was created.</p>", "optional", "")},
+	{Name: "kill_chain", Type: arrow.ListOf(KillChainPhaseStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("Kill Chain", "The <a target='_blank' href='https://www.lockheedmartin.com/en-us/capabilities/cyber/cyber-kill-chain.html'>Cyber Kill Chain®</a> provides a detailed description of each phase and its associated activities within the broader context of a cyber attack.", "optional", "")},
+	{Name: "last_seen_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true, Metadata: ocsf.AttributeMetadata("Last Seen", "The time when the finding was most recently observed. e.g. The time when a vulnerability was most recently observed. <p>It can differ from the <code>modified_time</code> timestamp, which reflects the time this finding was last modified.</p>", "optional", "")},
+	{Name: "modified_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true, Metadata: ocsf.AttributeMetadata("Modified Time", "The time when the finding was last modified.", "optional", "")},
+	{Name: "product", Type: ProductStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Product", "Details about the product that reported the finding.", "optional", "")},
+	{Name: "related_analytics", Type: arrow.ListOf(AnalyticStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("Related Analytics", "Other analytics related to this finding.", "optional", "")},
+	{Name: "related_events", Type: arrow.ListOf(RelatedEventFindingStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("Related Events/Findings", "Describes events and/or other findings related to the finding as identified by the security product. Note that these events may or may not be in OCSF.", "optional", "")},
+	{Name: "related_events_count", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Related Events/Findings Count", "Number of related events or findings.", "optional", "")},
+	{Name: "src_url", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Source URL", "The URL pointing to the source of the finding.", "optional", "")},
+	{Name: "tags", Type: arrow.ListOf(KeyValueobjectStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("Tags", "The list of tags; <code>{key:value}</code> pairs associated with the finding.", "optional", "")},
+	{Name: "title", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Title", "A title or a brief phrase summarizing the reported finding.", "optional", "")},
+	{Name: "types", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true, Metadata: ocsf.AttributeMetadata("Types", "One or more types of the reported finding.", "optional", "")},
+	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: false, Metadata: ocsf.AttributeMetadata("Unique ID", "The unique identifier of the reported finding.", "required", "")},
+	{Name: "uid_alt", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Alternate ID", "The alternative unique identifier of the reported finding.", "optional", "")},
 }
 
 var FindingInformationStruct = arrow.StructOf(FindingInformationFields...)
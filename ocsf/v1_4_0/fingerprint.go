@@ -2,6 +2,7 @@
 package v1_4_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -22,10 +23,93 @@ func (v *Fingerprint) Observable() (*int, string) {
 	return &typeId, "fingerprint"
 }
 
+func (v *Fingerprint) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *Fingerprint) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	if v.Value == "" {
+		errs.Add(ocsf.JoinPath(path, "value"), "required attribute is missing")
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *Fingerprint) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "algorithm":
+		if index >= 0 || rest != "" || v.Algorithm == nil {
+			return nil, false
+		}
+		return *v.Algorithm, true
+	case "algorithm_id":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.AlgorithmId, true
+	case "value":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.Value, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *Fingerprint) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "algorithm":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Algorithm, value)
+	case "algorithm_id":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.AlgorithmId, value)
+	case "value":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.Value, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *Fingerprint) AppendObservables(path string, observables []Observable) []Observable {
+	if path != "" {
+		observables = append(observables, Observable{Name: ocsf.Ptr(path), TypeId: 30})
+	}
+	observables = append(observables, Observable{Name: ocsf.Ptr(ocsf.JoinPath(path, "value")), TypeId: 8, Value: ocsf.Ptr(v.Value)})
+
+	return observables
+}
+
 var FingerprintFields = []arrow.Field{
-	{Name: "algorithm", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "algorithm_id", Type: arrow.PrimitiveTypes.Int32, Nullable: false},
-	{Name: "value", Type: arrow.BinaryTypes.String, Nullable: false},
+	{Name: "algorithm", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Algorithm", "The hash algorithm used to create the digital fingerprint, normalized to the caption of <code>algorithm_id</code>. In the case of <code>Other</code>, it is defined by the event source.", "optional", "")},
+	{Name: "algorithm_id", Type: arrow.PrimitiveTypes.Int32, Nullable: false, Metadata: ocsf.AttributeMetadata("Algorithm ID", "The identifier of the normalized hash algorithm, which was used to create the digital fingerprint.", "required", "")},
+	{Name: "value", Type: arrow.BinaryTypes.String, Nullable: false, Metadata: ocsf.AttributeMetadata("Value", "The digital fingerprint value.", "required", "")},
 }
 
 var FingerprintStruct = arrow.StructOf(FingerprintFields...)
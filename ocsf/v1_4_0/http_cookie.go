@@ -2,6 +2,7 @@
 package v1_4_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -36,15 +37,147 @@ func (v *HTTPCookie) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *HTTPCookie) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *HTTPCookie) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	if v.Name == "" {
+		errs.Add(ocsf.JoinPath(path, "name"), "required attribute is missing")
+	}
+	if v.Value == "" {
+		errs.Add(ocsf.JoinPath(path, "value"), "required attribute is missing")
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *HTTPCookie) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "domain":
+		if index >= 0 || rest != "" || v.Domain == nil {
+			return nil, false
+		}
+		return *v.Domain, true
+	case "expiration_time":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.ExpirationTime, true
+	case "is_http_only":
+		if index >= 0 || rest != "" || v.IsHttpOnly == nil {
+			return nil, false
+		}
+		return *v.IsHttpOnly, true
+	case "is_secure":
+		if index >= 0 || rest != "" || v.IsSecure == nil {
+			return nil, false
+		}
+		return *v.IsSecure, true
+	case "name":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.Name, true
+	case "path":
+		if index >= 0 || rest != "" || v.Path == nil {
+			return nil, false
+		}
+		return *v.Path, true
+	case "samesite":
+		if index >= 0 || rest != "" || v.Samesite == nil {
+			return nil, false
+		}
+		return *v.Samesite, true
+	case "value":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.Value, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *HTTPCookie) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "domain":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Domain, value)
+	case "expiration_time":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.ExpirationTime, value)
+	case "is_http_only":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.IsHttpOnly, value)
+	case "is_secure":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.IsSecure, value)
+	case "name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.Name, value)
+	case "path":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Path, value)
+	case "samesite":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Samesite, value)
+	case "value":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.Value, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *HTTPCookie) AppendObservables(path string, observables []Observable) []Observable {
+
+	return observables
+}
+
 var HTTPCookieFields = []arrow.Field{
-	{Name: "domain", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "expiration_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
-	{Name: "is_http_only", Type: arrow.FixedWidthTypes.Boolean, Nullable: true},
-	{Name: "is_secure", Type: arrow.FixedWidthTypes.Boolean, Nullable: true},
-	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: false},
-	{Name: "path", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "samesite", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "value", Type: arrow.BinaryTypes.String, Nullable: false},
+	{Name: "domain", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Domain", "The domain name for the server from which the http_cookie is served.", "optional", "")},
+	{Name: "expiration_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true, Metadata: ocsf.AttributeMetadata("Expiration Time", "The expiration time of the HTTP cookie.", "optional", "")},
+	{Name: "is_http_only", Type: arrow.FixedWidthTypes.Boolean, Nullable: true, Metadata: ocsf.AttributeMetadata("HTTP Only", "This attribute prevents the cookie from being accessed via JavaScript.", "optional", "")},
+	{Name: "is_secure", Type: arrow.FixedWidthTypes.Boolean, Nullable: true, Metadata: ocsf.AttributeMetadata("Secure", "The cookie attribute indicates that cookies are sent to the server only when the request is encrypted using the HTTPS protocol.", "optional", "")},
+	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: false, Metadata: ocsf.AttributeMetadata("Name", "The HTTP cookie name.", "required", "")},
+	{Name: "path", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Path", "The path of the HTTP cookie.", "optional", "")},
+	{Name: "samesite", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("SameSite", "The cookie attribute that lets servers specify whether/when cookies are sent with cross-site requests. Values are: Strict, Lax or None", "optional", "")},
+	{Name: "value", Type: arrow.BinaryTypes.String, Nullable: false, Metadata: ocsf.AttributeMetadata("Value", "The HTTP cookie value.", "required", "")},
 }
 
 var HTTPCookieStruct = arrow.StructOf(HTTPCookieFields...)
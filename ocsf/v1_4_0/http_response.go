@@ -2,6 +2,7 @@
 package v1_4_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -36,15 +37,163 @@ func (v *HTTPResponse) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *HTTPResponse) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *HTTPResponse) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	for i := range v.HttpHeaders {
+		errs = append(errs, v.HttpHeaders[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "http_headers"), i))...)
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *HTTPResponse) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "body_length":
+		if index >= 0 || rest != "" || v.BodyLength == nil {
+			return nil, false
+		}
+		return *v.BodyLength, true
+	case "code":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.Code, true
+	case "content_type":
+		if index >= 0 || rest != "" || v.ContentType == nil {
+			return nil, false
+		}
+		return *v.ContentType, true
+	case "http_headers":
+		if rest == "" {
+			if index < 0 {
+				return v.HttpHeaders, v.HttpHeaders != nil
+			}
+			return ocsf.Index(v.HttpHeaders, index)
+		}
+		if index < 0 || index >= len(v.HttpHeaders) {
+			return nil, false
+		}
+		return v.HttpHeaders[index].Get(rest)
+	case "latency":
+		if index >= 0 || rest != "" || v.Latency == nil {
+			return nil, false
+		}
+		return *v.Latency, true
+	case "length":
+		if index >= 0 || rest != "" || v.Length == nil {
+			return nil, false
+		}
+		return *v.Length, true
+	case "message":
+		if index >= 0 || rest != "" || v.Message == nil {
+			return nil, false
+		}
+		return *v.Message, true
+	case "status":
+		if index >= 0 || rest != "" || v.Status == nil {
+			return nil, false
+		}
+		return *v.Status, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *HTTPResponse) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "body_length":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.BodyLength, value)
+	case "code":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.Code, value)
+	case "content_type":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.ContentType, value)
+	case "http_headers":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.HttpHeaders, value)
+		}
+		elem, err := ocsf.Element(&v.HttpHeaders, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "latency":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Latency, value)
+	case "length":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Length, value)
+	case "message":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Message, value)
+	case "status":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Status, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *HTTPResponse) AppendObservables(path string, observables []Observable) []Observable {
+	for i := range v.HttpHeaders {
+		observables = v.HttpHeaders[i].AppendObservables(ocsf.JoinPath(path, "http_headers"), observables)
+	}
+
+	return observables
+}
+
 var HTTPResponseFields = []arrow.Field{
-	{Name: "body_length", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "code", Type: arrow.PrimitiveTypes.Int32, Nullable: false},
-	{Name: "content_type", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "http_headers", Type: arrow.ListOf(HTTPHeaderStruct), Nullable: true},
-	{Name: "latency", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "length", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "message", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "status", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "body_length", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Response Body Length", "The actual length of the HTTP response body, in number of bytes, independent of a potentially existing Content-Length header.", "optional", "")},
+	{Name: "code", Type: arrow.PrimitiveTypes.Int32, Nullable: false, Metadata: ocsf.AttributeMetadata("Response Code", "The Hypertext Transfer Protocol (HTTP) status code returned from the web server to the client. For example, 200.", "required", "")},
+	{Name: "content_type", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("HTTP Content Type", "The request header that identifies the original <a target='_blank' href='https://www.iana.org/assignments/media-types/media-types.xhtml'>media type </a> of the resource (prior to any content encoding applied for sending).", "optional", "")},
+	{Name: "http_headers", Type: arrow.ListOf(HTTPHeaderStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("HTTP Headers", "Additional HTTP headers of an HTTP request or response.", "optional", "")},
+	{Name: "latency", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Latency", "The HTTP response latency measured in milliseconds.", "optional", "")},
+	{Name: "length", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Response Length", "The length of the entire HTTP response, in number of bytes.", "optional", "")},
+	{Name: "message", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Message", "The description of the event/finding, as defined by the source.", "optional", "")},
+	{Name: "status", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Status", "The response status. For example: A successful HTTP status of 'OK' which corresponds to a code of 200.", "optional", "")},
 }
 
 var HTTPResponseStruct = arrow.StructOf(HTTPResponseFields...)
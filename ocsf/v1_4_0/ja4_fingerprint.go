@@ -2,6 +2,7 @@
 package v1_4_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -33,14 +34,133 @@ func (v *JA4Fingerprint) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *JA4Fingerprint) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *JA4Fingerprint) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	if v.Value == "" {
+		errs.Add(ocsf.JoinPath(path, "value"), "required attribute is missing")
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *JA4Fingerprint) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "section_a":
+		if index >= 0 || rest != "" || v.SectionA == nil {
+			return nil, false
+		}
+		return *v.SectionA, true
+	case "section_b":
+		if index >= 0 || rest != "" || v.SectionB == nil {
+			return nil, false
+		}
+		return *v.SectionB, true
+	case "section_c":
+		if index >= 0 || rest != "" || v.SectionC == nil {
+			return nil, false
+		}
+		return *v.SectionC, true
+	case "section_d":
+		if index >= 0 || rest != "" || v.SectionD == nil {
+			return nil, false
+		}
+		return *v.SectionD, true
+	case "type":
+		if index >= 0 || rest != "" || v.Type == nil {
+			return nil, false
+		}
+		return *v.Type, true
+	case "type_id":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.TypeId, true
+	case "value":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.Value, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *JA4Fingerprint) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "section_a":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.SectionA, value)
+	case "section_b":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.SectionB, value)
+	case "section_c":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.SectionC, value)
+	case "section_d":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.SectionD, value)
+	case "type":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Type, value)
+	case "type_id":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.TypeId, value)
+	case "value":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.Value, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *JA4Fingerprint) AppendObservables(path string, observables []Observable) []Observable {
+
+	return observables
+}
+
 var JA4FingerprintFields = []arrow.Field{
-	{Name: "section_a", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "section_b", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "section_c", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "section_d", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "type", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "type_id", Type: arrow.PrimitiveTypes.Int32, Nullable: false},
-	{Name: "value", Type: arrow.BinaryTypes.String, Nullable: false},
+	{Name: "section_a", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("JA4 Section A", "The 'a' section of the JA4 fingerprint.", "optional", "")},
+	{Name: "section_b", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("JA4 Section B", "The 'b' section of the JA4 fingerprint.", "optional", "")},
+	{Name: "section_c", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("JA4 Section C", "The 'c' section of the JA4 fingerprint.", "optional", "")},
+	{Name: "section_d", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("JA4 Section D", "The 'd' section of the JA4 fingerprint.", "optional", "")},
+	{Name: "type", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Type", "The JA4+ fingerprint type as defined by <a href='https://blog.foxio.io/ja4+-network-fingerprinting target='_blank'>FoxIO</a>, normalized to the caption of 'type_id'. In the case of 'Other', it is defined by the event source.", "optional", "")},
+	{Name: "type_id", Type: arrow.PrimitiveTypes.Int32, Nullable: false, Metadata: ocsf.AttributeMetadata("Type ID", "The identifier of the JA4+ fingerprint type.", "required", "")},
+	{Name: "value", Type: arrow.BinaryTypes.String, Nullable: false, Metadata: ocsf.AttributeMetadata("Value", "The JA4+ fingerprint value.", "required", "")},
 }
 
 var JA4FingerprintStruct = arrow.StructOf(JA4FingerprintFields...)
@@ -2,6 +2,7 @@
 package v1_4_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -42,17 +43,189 @@ func (v *Job) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *Job) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *Job) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	errs = append(errs, v.File.ValidateAt(ocsf.JoinPath(path, "file"))...)
+	if v.Name == "" {
+		errs.Add(ocsf.JoinPath(path, "name"), "required attribute is missing")
+	}
+	if v.User != nil {
+		errs = append(errs, v.User.ValidateAt(ocsf.JoinPath(path, "user"))...)
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *Job) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "cmd_line":
+		if index >= 0 || rest != "" || v.CmdLine == nil {
+			return nil, false
+		}
+		return *v.CmdLine, true
+	case "created_time":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.CreatedTime, true
+	case "desc":
+		if index >= 0 || rest != "" || v.Desc == nil {
+			return nil, false
+		}
+		return *v.Desc, true
+	case "file":
+		if index >= 0 {
+			return nil, false
+		}
+		if rest == "" {
+			return &v.File, true
+		}
+		return v.File.Get(rest)
+	case "last_run_time":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.LastRunTime, true
+	case "name":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.Name, true
+	case "next_run_time":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.NextRunTime, true
+	case "run_state":
+		if index >= 0 || rest != "" || v.RunState == nil {
+			return nil, false
+		}
+		return *v.RunState, true
+	case "run_state_id":
+		if index >= 0 || rest != "" || v.RunStateId == nil {
+			return nil, false
+		}
+		return *v.RunStateId, true
+	case "user":
+		if index >= 0 || v.User == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.User, true
+		}
+		return v.User.Get(rest)
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *Job) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "cmd_line":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.CmdLine, value)
+	case "created_time":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.CreatedTime, value)
+	case "desc":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Desc, value)
+	case "file":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetRequired(&v.File, value)
+		}
+		return v.File.Set(rest, value)
+	case "last_run_time":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.LastRunTime, value)
+	case "name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.Name, value)
+	case "next_run_time":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.NextRunTime, value)
+	case "run_state":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.RunState, value)
+	case "run_state_id":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.RunStateId, value)
+	case "user":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.User, value)
+		}
+		if v.User == nil {
+			v.User = &User{}
+		}
+		return v.User.Set(rest, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *Job) AppendObservables(path string, observables []Observable) []Observable {
+	observables = v.File.AppendObservables(ocsf.JoinPath(path, "file"), observables)
+	if v.User != nil {
+		observables = v.User.AppendObservables(ocsf.JoinPath(path, "user"), observables)
+	}
+
+	return observables
+}
+
 var JobFields = []arrow.Field{
-	{Name: "cmd_line", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "created_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
-	{Name: "desc", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "file", Type: FileStruct, Nullable: false},
-	{Name: "last_run_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
-	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: false},
-	{Name: "next_run_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
-	{Name: "run_state", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "run_state_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "user", Type: UserStruct, Nullable: true},
+	{Name: "cmd_line", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Command Line", "The job command line.", "optional", "")},
+	{Name: "created_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true, Metadata: ocsf.AttributeMetadata("Created Time", "The time when the job was created.", "optional", "")},
+	{Name: "desc", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Description", "The description of the job.", "optional", "")},
+	{Name: "file", Type: FileStruct, Nullable: false, Metadata: ocsf.AttributeMetadata("File", "The file that pertains to the job.", "required", "")},
+	{Name: "last_run_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true, Metadata: ocsf.AttributeMetadata("Last Run", "The time when the job was last run.", "optional", "")},
+	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: false, Metadata: ocsf.AttributeMetadata("Name", "The name of the job.", "required", "")},
+	{Name: "next_run_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true, Metadata: ocsf.AttributeMetadata("Next Run", "The time when the job will next be run.", "optional", "")},
+	{Name: "run_state", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Run State", "The run state of the job.", "optional", "")},
+	{Name: "run_state_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Run State ID", "The run state ID of the job.", "optional", "")},
+	{Name: "user", Type: UserStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("User", "The user that created the job.", "optional", "")},
 }
 
 var JobStruct = arrow.StructOf(JobFields...)
@@ -2,6 +2,7 @@
 package v1_4_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -54,21 +55,255 @@ func (v *KBArticle) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *KBArticle) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *KBArticle) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	if v.AvgTimespan != nil {
+		errs = append(errs, v.AvgTimespan.ValidateAt(ocsf.JoinPath(path, "avg_timespan"))...)
+	}
+	if v.Os != nil {
+		errs = append(errs, v.Os.ValidateAt(ocsf.JoinPath(path, "os"))...)
+	}
+	if v.Product != nil {
+		errs = append(errs, v.Product.ValidateAt(ocsf.JoinPath(path, "product"))...)
+	}
+	if v.Uid == "" {
+		errs.Add(ocsf.JoinPath(path, "uid"), "required attribute is missing")
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *KBArticle) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "avg_timespan":
+		if index >= 0 || v.AvgTimespan == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.AvgTimespan, true
+		}
+		return v.AvgTimespan.Get(rest)
+	case "bulletin":
+		if index >= 0 || rest != "" || v.Bulletin == nil {
+			return nil, false
+		}
+		return *v.Bulletin, true
+	case "classification":
+		if index >= 0 || rest != "" || v.Classification == nil {
+			return nil, false
+		}
+		return *v.Classification, true
+	case "created_time":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.CreatedTime, true
+	case "install_state":
+		if index >= 0 || rest != "" || v.InstallState == nil {
+			return nil, false
+		}
+		return *v.InstallState, true
+	case "install_state_id":
+		if index >= 0 || rest != "" || v.InstallStateId == nil {
+			return nil, false
+		}
+		return *v.InstallStateId, true
+	case "is_superseded":
+		if index >= 0 || rest != "" || v.IsSuperseded == nil {
+			return nil, false
+		}
+		return *v.IsSuperseded, true
+	case "os":
+		if index >= 0 || v.Os == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Os, true
+		}
+		return v.Os.Get(rest)
+	case "product":
+		if index >= 0 || v.Product == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Product, true
+		}
+		return v.Product.Get(rest)
+	case "severity":
+		if index >= 0 || rest != "" || v.Severity == nil {
+			return nil, false
+		}
+		return *v.Severity, true
+	case "size":
+		if index >= 0 || rest != "" || v.Size == nil {
+			return nil, false
+		}
+		return *v.Size, true
+	case "src_url":
+		if index >= 0 || rest != "" || v.SrcUrl == nil {
+			return nil, false
+		}
+		return *v.SrcUrl, true
+	case "title":
+		if index >= 0 || rest != "" || v.Title == nil {
+			return nil, false
+		}
+		return *v.Title, true
+	case "uid":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.Uid, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *KBArticle) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "avg_timespan":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.AvgTimespan, value)
+		}
+		if v.AvgTimespan == nil {
+			v.AvgTimespan = &TimeSpan{}
+		}
+		return v.AvgTimespan.Set(rest, value)
+	case "bulletin":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Bulletin, value)
+	case "classification":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Classification, value)
+	case "created_time":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.CreatedTime, value)
+	case "install_state":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.InstallState, value)
+	case "install_state_id":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.InstallStateId, value)
+	case "is_superseded":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.IsSuperseded, value)
+	case "os":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Os, value)
+		}
+		if v.Os == nil {
+			v.Os = &OperatingSystemOS{}
+		}
+		return v.Os.Set(rest, value)
+	case "product":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Product, value)
+		}
+		if v.Product == nil {
+			v.Product = &Product{}
+		}
+		return v.Product.Set(rest, value)
+	case "severity":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Severity, value)
+	case "size":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Size, value)
+	case "src_url":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.SrcUrl, value)
+	case "title":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Title, value)
+	case "uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.Uid, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *KBArticle) AppendObservables(path string, observables []Observable) []Observable {
+	if v.AvgTimespan != nil {
+		observables = v.AvgTimespan.AppendObservables(ocsf.JoinPath(path, "avg_timespan"), observables)
+	}
+	if v.Os != nil {
+		observables = v.Os.AppendObservables(ocsf.JoinPath(path, "os"), observables)
+	}
+	if v.Product != nil {
+		observables = v.Product.AppendObservables(ocsf.JoinPath(path, "product"), observables)
+	}
+
+	return observables
+}
+
 var KBArticleFields = []arrow.Field{
-	{Name: "avg_timespan", Type: TimeSpanStruct, Nullable: true},
-	{Name: "bulletin", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "classification", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "created_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
-	{Name: "install_state", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "install_state_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "is_superseded", Type: arrow.FixedWidthTypes.Boolean, Nullable: true},
-	{Name: "os", Type: OperatingSystemOSStruct, Nullable: true},
-	{Name: "product", Type: ProductStruct, Nullable: true},
-	{Name: "severity", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "size", Type: arrow.PrimitiveTypes.Int64, Nullable: true},
-	{Name: "src_url", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "title", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: false},
+	{Name: "avg_timespan", Type: TimeSpanStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Average Timespan", "The average time to patch.", "optional", "")},
+	{Name: "bulletin", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Patch Bulletin", "The kb article bulletin identifier.", "optional", "")},
+	{Name: "classification", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Classification", "The vendors classification of the kb article.", "optional", "")},
+	{Name: "created_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true, Metadata: ocsf.AttributeMetadata("Created Time", "The date the kb article was released by the vendor.", "optional", "")},
+	{Name: "install_state", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Install State", "The install state of the kb article.", "optional", "")},
+	{Name: "install_state_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Install State ID", "The normalized install state ID of the kb article.", "optional", "")},
+	{Name: "is_superseded", Type: arrow.FixedWidthTypes.Boolean, Nullable: true, Metadata: ocsf.AttributeMetadata("The patch is superseded.", "The kb article has been replaced by another.", "optional", "")},
+	{Name: "os", Type: OperatingSystemOSStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("OS", "The operating system the kb article applies.", "optional", "")},
+	{Name: "product", Type: ProductStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Product", "The product details the kb article applies.", "optional", "")},
+	{Name: "severity", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Severity", "The severity of the kb article.", "optional", "")},
+	{Name: "size", Type: arrow.PrimitiveTypes.Int64, Nullable: true, Metadata: ocsf.AttributeMetadata("Size", "The size in bytes for the kb article.", "optional", "")},
+	{Name: "src_url", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Source URL", "The kb article link from the source vendor.", "optional", "")},
+	{Name: "title", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Title", "The title of the kb article.", "optional", "")},
+	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: false, Metadata: ocsf.AttributeMetadata("Unique ID", "The unique identifier for the kb article.", "required", "")},
 }
 
 var KBArticleStruct = arrow.StructOf(KBArticleFields...)
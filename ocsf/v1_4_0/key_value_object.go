@@ -2,6 +2,7 @@
 package v1_4_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -21,10 +22,102 @@ func (v *KeyValueobject) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *KeyValueobject) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *KeyValueobject) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	if v.Name == "" {
+		errs.Add(ocsf.JoinPath(path, "name"), "required attribute is missing")
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *KeyValueobject) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "name":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.Name, true
+	case "value":
+		if index >= 0 || rest != "" || v.Value == nil {
+			return nil, false
+		}
+		return *v.Value, true
+	case "values":
+		if rest == "" {
+			if index < 0 {
+				return v.Values, v.Values != nil
+			}
+			return ocsf.Index(v.Values, index)
+		}
+		return nil, false
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *KeyValueobject) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.Name, value)
+	case "value":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Value, value)
+	case "values":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Values, value)
+		}
+		elem, err := ocsf.Element(&v.Values, index)
+		if err != nil {
+			return err
+		}
+		if rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(elem, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *KeyValueobject) AppendObservables(path string, observables []Observable) []Observable {
+
+	return observables
+}
+
 var KeyValueobjectFields = []arrow.Field{
-	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: false},
-	{Name: "value", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "values", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true},
+	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: false, Metadata: ocsf.AttributeMetadata("Name", "The name of the key.", "required", "")},
+	{Name: "value", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Value", "The value associated to the key.", "optional", "")},
+	{Name: "values", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true, Metadata: ocsf.AttributeMetadata("Values", "Optional, the values associated to the key. You can populate this attribute, when you have multiple values for the same key.", "optional", "")},
 }
 
 var KeyValueobjectStruct = arrow.StructOf(KeyValueobjectFields...)
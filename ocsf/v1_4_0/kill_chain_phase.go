@@ -2,6 +2,7 @@
 package v1_4_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -18,9 +19,75 @@ func (v *KillChainPhase) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *KillChainPhase) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *KillChainPhase) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *KillChainPhase) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "phase":
+		if index >= 0 || rest != "" || v.Phase == nil {
+			return nil, false
+		}
+		return *v.Phase, true
+	case "phase_id":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.PhaseId, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *KillChainPhase) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "phase":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Phase, value)
+	case "phase_id":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.PhaseId, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *KillChainPhase) AppendObservables(path string, observables []Observable) []Observable {
+
+	return observables
+}
+
 var KillChainPhaseFields = []arrow.Field{
-	{Name: "phase", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "phase_id", Type: arrow.PrimitiveTypes.Int32, Nullable: false},
+	{Name: "phase", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Kill Chain Phase", "The cyber kill chain phase.", "optional", "")},
+	{Name: "phase_id", Type: arrow.PrimitiveTypes.Int32, Nullable: false, Metadata: ocsf.AttributeMetadata("Kill Chain Phase ID", "The cyber kill chain phase identifier.", "required", "")},
 }
 
 var KillChainPhaseStruct = arrow.StructOf(KillChainPhaseFields...)
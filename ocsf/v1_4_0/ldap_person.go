@@ -2,6 +2,7 @@
 package v1_4_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -72,53 +73,378 @@ func (v *LDAPPerson) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *LDAPPerson) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *LDAPPerson) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	if v.Location != nil {
+		errs = append(errs, v.Location.ValidateAt(ocsf.JoinPath(path, "location"))...)
+	}
+	if v.Manager != nil {
+		errs = append(errs, v.Manager.ValidateAt(ocsf.JoinPath(path, "manager"))...)
+	}
+	for i := range v.Tags {
+		errs = append(errs, v.Tags[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "tags"), i))...)
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *LDAPPerson) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "cost_center":
+		if index >= 0 || rest != "" || v.CostCenter == nil {
+			return nil, false
+		}
+		return *v.CostCenter, true
+	case "created_time":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.CreatedTime, true
+	case "deleted_time":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.DeletedTime, true
+	case "email_addrs":
+		if rest == "" {
+			if index < 0 {
+				return v.EmailAddrs, v.EmailAddrs != nil
+			}
+			return ocsf.Index(v.EmailAddrs, index)
+		}
+		return nil, false
+	case "employee_uid":
+		if index >= 0 || rest != "" || v.EmployeeUid == nil {
+			return nil, false
+		}
+		return *v.EmployeeUid, true
+	case "given_name":
+		if index >= 0 || rest != "" || v.GivenName == nil {
+			return nil, false
+		}
+		return *v.GivenName, true
+	case "hire_time":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.HireTime, true
+	case "job_title":
+		if index >= 0 || rest != "" || v.JobTitle == nil {
+			return nil, false
+		}
+		return *v.JobTitle, true
+	case "labels":
+		if rest == "" {
+			if index < 0 {
+				return v.Labels, v.Labels != nil
+			}
+			return ocsf.Index(v.Labels, index)
+		}
+		return nil, false
+	case "last_login_time":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.LastLoginTime, true
+	case "ldap_cn":
+		if index >= 0 || rest != "" || v.LdapCn == nil {
+			return nil, false
+		}
+		return *v.LdapCn, true
+	case "ldap_dn":
+		if index >= 0 || rest != "" || v.LdapDn == nil {
+			return nil, false
+		}
+		return *v.LdapDn, true
+	case "leave_time":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.LeaveTime, true
+	case "location":
+		if index >= 0 || v.Location == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Location, true
+		}
+		return v.Location.Get(rest)
+	case "manager":
+		if index >= 0 || v.Manager == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Manager, true
+		}
+		return v.Manager.Get(rest)
+	case "modified_time":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.ModifiedTime, true
+	case "office_location":
+		if index >= 0 || rest != "" || v.OfficeLocation == nil {
+			return nil, false
+		}
+		return *v.OfficeLocation, true
+	case "phone_number":
+		if index >= 0 || rest != "" || v.PhoneNumber == nil {
+			return nil, false
+		}
+		return *v.PhoneNumber, true
+	case "surname":
+		if index >= 0 || rest != "" || v.Surname == nil {
+			return nil, false
+		}
+		return *v.Surname, true
+	case "tags":
+		if rest == "" {
+			if index < 0 {
+				return v.Tags, v.Tags != nil
+			}
+			return ocsf.Index(v.Tags, index)
+		}
+		if index < 0 || index >= len(v.Tags) {
+			return nil, false
+		}
+		return v.Tags[index].Get(rest)
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *LDAPPerson) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "cost_center":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.CostCenter, value)
+	case "created_time":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.CreatedTime, value)
+	case "deleted_time":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.DeletedTime, value)
+	case "email_addrs":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.EmailAddrs, value)
+		}
+		elem, err := ocsf.Element(&v.EmailAddrs, index)
+		if err != nil {
+			return err
+		}
+		if rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(elem, value)
+	case "employee_uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.EmployeeUid, value)
+	case "given_name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.GivenName, value)
+	case "hire_time":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.HireTime, value)
+	case "job_title":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.JobTitle, value)
+	case "labels":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Labels, value)
+		}
+		elem, err := ocsf.Element(&v.Labels, index)
+		if err != nil {
+			return err
+		}
+		if rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(elem, value)
+	case "last_login_time":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.LastLoginTime, value)
+	case "ldap_cn":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.LdapCn, value)
+	case "ldap_dn":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.LdapDn, value)
+	case "leave_time":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.LeaveTime, value)
+	case "location":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Location, value)
+		}
+		if v.Location == nil {
+			v.Location = &GeoLocation{}
+		}
+		return v.Location.Set(rest, value)
+	case "manager":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Manager, value)
+		}
+		if v.Manager == nil {
+			v.Manager = &User{}
+		}
+		return v.Manager.Set(rest, value)
+	case "modified_time":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.ModifiedTime, value)
+	case "office_location":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.OfficeLocation, value)
+	case "phone_number":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.PhoneNumber, value)
+	case "surname":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Surname, value)
+	case "tags":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Tags, value)
+		}
+		elem, err := ocsf.Element(&v.Tags, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *LDAPPerson) AppendObservables(path string, observables []Observable) []Observable {
+	if v.Location != nil {
+		observables = v.Location.AppendObservables(ocsf.JoinPath(path, "location"), observables)
+	}
+	if v.Manager != nil {
+		observables = v.Manager.AppendObservables(ocsf.JoinPath(path, "manager"), observables)
+	}
+	for i := range v.Tags {
+		observables = v.Tags[i].AppendObservables(ocsf.JoinPath(path, "tags"), observables)
+	}
+
+	return observables
+}
+
 var LDAPPersonFields = []arrow.Field{
-	{Name: "cost_center", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "created_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
-	{Name: "deleted_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
-	{Name: "email_addrs", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true},
-	{Name: "employee_uid", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "given_name", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "hire_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
-	{Name: "job_title", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "labels", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true},
-	{Name: "last_login_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
-	{Name: "ldap_cn", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "ldap_dn", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "leave_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
-	{Name: "location", Type: GeoLocationStruct, Nullable: true},
-	{Name: "manager", Type: UserStruct, Nullable: true},
-	{Name: "modified_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
-	{Name: "office_location", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "phone_number", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "surname", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "tags", Type: arrow.ListOf(KeyValueobjectStruct), Nullable: true},
+	{Name: "cost_center", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Cost Center", "The cost center associated with the user.", "optional", "")},
+	{Name: "created_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true, Metadata: ocsf.AttributeMetadata("Created Time", "The timestamp when the user was created.", "optional", "")},
+	{Name: "deleted_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true, Metadata: ocsf.AttributeMetadata("Deleted Time", "The timestamp when the user was deleted. In Active Directory (AD), when a user is deleted they are moved to a temporary container and then removed after 30 days. So, this field can be populated even after a user is deleted for the next 30 days.", "optional", "")},
+	{Name: "email_addrs", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true, Metadata: ocsf.AttributeMetadata("Email Addresses", "A list of additional email addresses for the user.", "optional", "")},
+	{Name: "employee_uid", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Employee ID", "The employee identifier assigned to the user by the organization.", "optional", "")},
+	{Name: "given_name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Given Name", "The given or first name of the user.", "optional", "")},
+	{Name: "hire_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true, Metadata: ocsf.AttributeMetadata("Hire Time", "The timestamp when the user was or will be hired by the organization.", "optional", "")},
+	{Name: "job_title", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Job Title", "The user's job title.", "optional", "")},
+	{Name: "labels", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true, Metadata: ocsf.AttributeMetadata("Labels", "The labels associated with the user. For example in AD this could be the <code>userType</code>, <code>employeeType</code>. For example: <code>Member, Employee</code>.", "optional", "")},
+	{Name: "last_login_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true, Metadata: ocsf.AttributeMetadata("Last Login", "The last time when the user logged in.", "optional", "")},
+	{Name: "ldap_cn", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("LDAP Common Name", "The LDAP and X.500 <code>commonName</code> attribute, typically the full name of the person. For example, <code>John Doe</code>.", "optional", "")},
+	{Name: "ldap_dn", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("LDAP Distinguished Name", "The X.500 Distinguished Name (DN) is a structured string that uniquely identifies an entry, such as a user, in an X.500 directory service For example, <code>cn=John Doe,ou=People,dc=example,dc=com</code>.", "optional", "")},
+	{Name: "leave_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true, Metadata: ocsf.AttributeMetadata("Leave Time", "The timestamp when the user left or will be leaving the organization.", "optional", "")},
+	{Name: "location", Type: GeoLocationStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Geo Location", "The geographical location associated with a user. This is typically the user's usual work location.", "optional", "")},
+	{Name: "manager", Type: UserStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Manager", "The user's manager. This helps in understanding an org hierarchy. This should only ever be populated once in an event. I.e. there should not be a manager's manager in an event.", "optional", "")},
+	{Name: "modified_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true, Metadata: ocsf.AttributeMetadata("Modified Time", "The timestamp when the user entry was last modified.", "optional", "")},
+	{Name: "office_location", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Office Location", "The primary office location associated with the user. This could be any string and isn't a specific address. For example, <code>South East Virtual</code>.", "optional", "")},
+	{Name: "phone_number", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Telephone Number", "The telephone number of the user. Corresponds to the LDAP <code>Telephone-Number</code> CN.", "optional", "")},
+	{Name: "surname", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Surname", "The last or family name for the user.", "optional", "")},
+	{Name: "tags", Type: arrow.ListOf(KeyValueobjectStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("Tags", "The list of tags; <code>{key:value}</code> pairs associated to the user.", "optional", "")},
 }
 
 var LDAPPersonStruct = arrow.StructOf(LDAPPersonFields...)
 
 var LDAPPersonSchema = arrow.NewSchema(LDAPPersonFields, nil)
 var LDAPPersonClassname = "ldap_person"
+
 var LDAPPersonRefFields = []arrow.Field{
-	{Name: "cost_center", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "created_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
-	{Name: "deleted_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
-	{Name: "email_addrs", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true},
-	{Name: "employee_uid", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "given_name", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "hire_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
-	{Name: "job_title", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "labels", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true},
-	{Name: "last_login_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
-	{Name: "ldap_cn", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "ldap_dn", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "leave_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
-
-	{Name: "modified_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
-	{Name: "office_location", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "phone_number", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "surname", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "cost_center", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Cost Center", "The cost center associated with the user.", "optional", "")},
+	{Name: "created_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true, Metadata: ocsf.AttributeMetadata("Created Time", "The timestamp when the user was created.", "optional", "")},
+	{Name: "deleted_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true, Metadata: ocsf.AttributeMetadata("Deleted Time", "The timestamp when the user was deleted. In Active Directory (AD), when a user is deleted they are moved to a temporary container and then removed after 30 days. So, this field can be populated even after a user is deleted for the next 30 days.", "optional", "")},
+	{Name: "email_addrs", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true, Metadata: ocsf.AttributeMetadata("Email Addresses", "A list of additional email addresses for the user.", "optional", "")},
+	{Name: "employee_uid", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Employee ID", "The employee identifier assigned to the user by the organization.", "optional", "")},
+	{Name: "given_name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Given Name", "The given or first name of the user.", "optional", "")},
+	{Name: "hire_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true, Metadata: ocsf.AttributeMetadata("Hire Time", "The timestamp when the user was or will be hired by the organization.", "optional", "")},
+	{Name: "job_title", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Job Title", "The user's job title.", "optional", "")},
+	{Name: "labels", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true, Metadata: ocsf.AttributeMetadata("Labels", "The labels associated with the user. For example in AD this could be the <code>userType</code>, <code>employeeType</code>. For example: <code>Member, Employee</code>.", "optional", "")},
+	{Name: "last_login_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true, Metadata: ocsf.AttributeMetadata("Last Login", "The last time when the user logged in.", "optional", "")},
+	{Name: "ldap_cn", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("LDAP Common Name", "The LDAP and X.500 <code>commonName</code> attribute, typically the full name of the person. For example, <code>John Doe</code>.", "optional", "")},
+	{Name: "ldap_dn", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("LDAP Distinguished Name", "The X.500 Distinguished Name (DN) is a structured string that uniquely identifies an entry, such as a user, in an X.500 directory service For example, <code>cn=John Doe,ou=People,dc=example,dc=com</code>.", "optional", "")},
+	{Name: "leave_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true, Metadata: ocsf.AttributeMetadata("Leave Time", "The timestamp when the user left or will be leaving the organization.", "optional", "")},
+	{Name: "modified_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true, Metadata: ocsf.AttributeMetadata("Modified Time", "The timestamp when the user entry was last modified.", "optional", "")},
+	{Name: "office_location", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Office Location", "The primary office location associated with the user. This could be any string and isn't a specific address. For example, <code>South East Virtual</code>.", "optional", "")},
+	{Name: "phone_number", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Telephone Number", "The telephone number of the user. Corresponds to the LDAP <code>Telephone-Number</code> CN.", "optional", "")},
+	{Name: "surname", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Surname", "The last or family name for the user.", "optional", "")},
 }
+
 var LDAPPersonRefStruct = arrow.StructOf(LDAPPersonRefFields...)
 
 type LDAPPersonRef struct {
@@ -162,10 +488,6 @@ type LDAPPersonRef struct {
 	// Leave Time: The timestamp when the user left or will be leaving the organization.
 	LeaveTime int64 `json:"leave_time,omitempty" parquet:"leave_time,timestamp_millis,timestamp(millisecond),optional"`
 
-	// Geo Location: The geographical location associated with a user. This is typically the user's usual work location.
-
-	// Manager: The user's manager. This helps in understanding an org hierarchy. This should only ever be populated once in an event. I.e. there should not be a manager's manager in an event.
-
 	// Modified Time: The timestamp when the user entry was last modified.
 	ModifiedTime int64 `json:"modified_time,omitempty" parquet:"modified_time,timestamp_millis,timestamp(millisecond),optional"`
 
@@ -177,7 +499,4 @@ type LDAPPersonRef struct {
 
 	// Surname: The last or family name for the user.
 	Surname *string `json:"surname,omitempty" parquet:"surname,optional"`
-
-	// Tags: The list of tags; <code>{key:value}</code> pairs associated to the user.
-
 }
@@ -2,6 +2,7 @@
 package v1_4_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -45,18 +46,236 @@ func (v *LoadBalancer) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *LoadBalancer) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *LoadBalancer) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	if v.DstEndpoint != nil {
+		errs = append(errs, v.DstEndpoint.ValidateAt(ocsf.JoinPath(path, "dst_endpoint"))...)
+	}
+	for i := range v.EndpointConnections {
+		errs = append(errs, v.EndpointConnections[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "endpoint_connections"), i))...)
+	}
+	for i := range v.Metrics {
+		errs = append(errs, v.Metrics[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "metrics"), i))...)
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *LoadBalancer) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "classification":
+		if index >= 0 || rest != "" || v.Classification == nil {
+			return nil, false
+		}
+		return *v.Classification, true
+	case "code":
+		if index >= 0 || rest != "" || v.Code == nil {
+			return nil, false
+		}
+		return *v.Code, true
+	case "dst_endpoint":
+		if index >= 0 || v.DstEndpoint == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.DstEndpoint, true
+		}
+		return v.DstEndpoint.Get(rest)
+	case "endpoint_connections":
+		if rest == "" {
+			if index < 0 {
+				return v.EndpointConnections, v.EndpointConnections != nil
+			}
+			return ocsf.Index(v.EndpointConnections, index)
+		}
+		if index < 0 || index >= len(v.EndpointConnections) {
+			return nil, false
+		}
+		return v.EndpointConnections[index].Get(rest)
+	case "error_message":
+		if index >= 0 || rest != "" || v.ErrorMessage == nil {
+			return nil, false
+		}
+		return *v.ErrorMessage, true
+	case "ip":
+		if index >= 0 || rest != "" || v.Ip == nil {
+			return nil, false
+		}
+		return *v.Ip, true
+	case "message":
+		if index >= 0 || rest != "" || v.Message == nil {
+			return nil, false
+		}
+		return *v.Message, true
+	case "metrics":
+		if rest == "" {
+			if index < 0 {
+				return v.Metrics, v.Metrics != nil
+			}
+			return ocsf.Index(v.Metrics, index)
+		}
+		if index < 0 || index >= len(v.Metrics) {
+			return nil, false
+		}
+		return v.Metrics[index].Get(rest)
+	case "name":
+		if index >= 0 || rest != "" || v.Name == nil {
+			return nil, false
+		}
+		return *v.Name, true
+	case "status_detail":
+		if index >= 0 || rest != "" || v.StatusDetail == nil {
+			return nil, false
+		}
+		return *v.StatusDetail, true
+	case "uid":
+		if index >= 0 || rest != "" || v.Uid == nil {
+			return nil, false
+		}
+		return *v.Uid, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *LoadBalancer) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "classification":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Classification, value)
+	case "code":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Code, value)
+	case "dst_endpoint":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.DstEndpoint, value)
+		}
+		if v.DstEndpoint == nil {
+			v.DstEndpoint = &NetworkEndpoint{}
+		}
+		return v.DstEndpoint.Set(rest, value)
+	case "endpoint_connections":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.EndpointConnections, value)
+		}
+		elem, err := ocsf.Element(&v.EndpointConnections, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "error_message":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.ErrorMessage, value)
+	case "ip":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Ip, value)
+	case "message":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Message, value)
+	case "metrics":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Metrics, value)
+		}
+		elem, err := ocsf.Element(&v.Metrics, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Name, value)
+	case "status_detail":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.StatusDetail, value)
+	case "uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Uid, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *LoadBalancer) AppendObservables(path string, observables []Observable) []Observable {
+	if v.DstEndpoint != nil {
+		observables = v.DstEndpoint.AppendObservables(ocsf.JoinPath(path, "dst_endpoint"), observables)
+	}
+	for i := range v.EndpointConnections {
+		observables = v.EndpointConnections[i].AppendObservables(ocsf.JoinPath(path, "endpoint_connections"), observables)
+	}
+	if v.Ip != nil {
+		observables = append(observables, Observable{Name: ocsf.Ptr(ocsf.JoinPath(path, "ip")), TypeId: 2, Value: ocsf.Ptr(*v.Ip)})
+	}
+	for i := range v.Metrics {
+		observables = v.Metrics[i].AppendObservables(ocsf.JoinPath(path, "metrics"), observables)
+	}
+
+	return observables
+}
+
 var LoadBalancerFields = []arrow.Field{
-	{Name: "classification", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "code", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "dst_endpoint", Type: NetworkEndpointStruct, Nullable: true},
-	{Name: "endpoint_connections", Type: arrow.ListOf(EndpointConnectionStruct), Nullable: true},
-	{Name: "error_message", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "ip", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "message", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "metrics", Type: arrow.ListOf(MetricStruct), Nullable: true},
-	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "status_detail", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "classification", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Classification", "The request classification as defined by the load balancer.", "optional", "")},
+	{Name: "code", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Response Code", "The numeric response status code detailing the connection from the load balancer to the destination target.", "optional", "")},
+	{Name: "dst_endpoint", Type: NetworkEndpointStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Destination Endpoint", "The destination to which the load balancer is distributing traffic.", "optional", "")},
+	{Name: "endpoint_connections", Type: arrow.ListOf(EndpointConnectionStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("Endpoint Connections", "An object detailing the load balancer connection attempts and responses.", "optional", "")},
+	{Name: "error_message", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Error Message", "The load balancer error message.", "optional", "")},
+	{Name: "ip", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("IP Address", "The IP address of the load balancer node that handled the client request. Note: the load balancer may have other IP addresses, and this is not an IP address of the target/distribution endpoint - see <code>dst_endpoint</code>.", "optional", "")},
+	{Name: "message", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Message", "The load balancer message.", "optional", "")},
+	{Name: "metrics", Type: arrow.ListOf(MetricStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("Metrics", "General purpose metrics associated with the load balancer.", "optional", "")},
+	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Name", "The name of the load balancer.", "optional", "")},
+	{Name: "status_detail", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Status Detail", "The status detail contains additional status information about the load balancer distribution event.", "optional", "")},
+	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Unique ID", "The unique identifier for the load balancer.", "optional", "")},
 }
 
 var LoadBalancerStruct = arrow.StructOf(LoadBalancerFields...)
@@ -2,6 +2,7 @@
 package v1_4_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -64,24 +65,243 @@ func (v *GeoLocation) Observable() (*int, string) {
 	return &typeId, "location"
 }
 
+func (v *GeoLocation) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *GeoLocation) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *GeoLocation) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "aerial_height":
+		if index >= 0 || rest != "" || v.AerialHeight == nil {
+			return nil, false
+		}
+		return *v.AerialHeight, true
+	case "city":
+		if index >= 0 || rest != "" || v.City == nil {
+			return nil, false
+		}
+		return *v.City, true
+	case "continent":
+		if index >= 0 || rest != "" || v.Continent == nil {
+			return nil, false
+		}
+		return *v.Continent, true
+	case "country":
+		if index >= 0 || rest != "" || v.Country == nil {
+			return nil, false
+		}
+		return *v.Country, true
+	case "desc":
+		if index >= 0 || rest != "" || v.Desc == nil {
+			return nil, false
+		}
+		return *v.Desc, true
+	case "geodetic_altitude":
+		if index >= 0 || rest != "" || v.GeodeticAltitude == nil {
+			return nil, false
+		}
+		return *v.GeodeticAltitude, true
+	case "geodetic_vertical_accuracy":
+		if index >= 0 || rest != "" || v.GeodeticVerticalAccuracy == nil {
+			return nil, false
+		}
+		return *v.GeodeticVerticalAccuracy, true
+	case "geohash":
+		if index >= 0 || rest != "" || v.Geohash == nil {
+			return nil, false
+		}
+		return *v.Geohash, true
+	case "horizontal_accuracy":
+		if index >= 0 || rest != "" || v.HorizontalAccuracy == nil {
+			return nil, false
+		}
+		return *v.HorizontalAccuracy, true
+	case "is_on_premises":
+		if index >= 0 || rest != "" || v.IsOnPremises == nil {
+			return nil, false
+		}
+		return *v.IsOnPremises, true
+	case "isp":
+		if index >= 0 || rest != "" || v.Isp == nil {
+			return nil, false
+		}
+		return *v.Isp, true
+	case "lat":
+		if index >= 0 || rest != "" || v.Lat == nil {
+			return nil, false
+		}
+		return *v.Lat, true
+	case "long":
+		if index >= 0 || rest != "" || v.Long == nil {
+			return nil, false
+		}
+		return *v.Long, true
+	case "postal_code":
+		if index >= 0 || rest != "" || v.PostalCode == nil {
+			return nil, false
+		}
+		return *v.PostalCode, true
+	case "pressure_altitude":
+		if index >= 0 || rest != "" || v.PressureAltitude == nil {
+			return nil, false
+		}
+		return *v.PressureAltitude, true
+	case "provider":
+		if index >= 0 || rest != "" || v.Provider == nil {
+			return nil, false
+		}
+		return *v.Provider, true
+	case "region":
+		if index >= 0 || rest != "" || v.Region == nil {
+			return nil, false
+		}
+		return *v.Region, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *GeoLocation) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "aerial_height":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.AerialHeight, value)
+	case "city":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.City, value)
+	case "continent":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Continent, value)
+	case "country":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Country, value)
+	case "desc":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Desc, value)
+	case "geodetic_altitude":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.GeodeticAltitude, value)
+	case "geodetic_vertical_accuracy":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.GeodeticVerticalAccuracy, value)
+	case "geohash":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Geohash, value)
+	case "horizontal_accuracy":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.HorizontalAccuracy, value)
+	case "is_on_premises":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.IsOnPremises, value)
+	case "isp":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Isp, value)
+	case "lat":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Lat, value)
+	case "long":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Long, value)
+	case "postal_code":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.PostalCode, value)
+	case "pressure_altitude":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.PressureAltitude, value)
+	case "provider":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Provider, value)
+	case "region":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Region, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *GeoLocation) AppendObservables(path string, observables []Observable) []Observable {
+	if path != "" {
+		observables = append(observables, Observable{Name: ocsf.Ptr(path), TypeId: 26})
+	}
+
+	return observables
+}
+
 var GeoLocationFields = []arrow.Field{
-	{Name: "aerial_height", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "city", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "continent", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "country", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "desc", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "geodetic_altitude", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "geodetic_vertical_accuracy", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "geohash", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "horizontal_accuracy", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "is_on_premises", Type: arrow.FixedWidthTypes.Boolean, Nullable: true},
-	{Name: "isp", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "lat", Type: arrow.PrimitiveTypes.Float64, Nullable: true},
-	{Name: "long", Type: arrow.PrimitiveTypes.Float64, Nullable: true},
-	{Name: "postal_code", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "pressure_altitude", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "provider", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "region", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "aerial_height", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Aerial Height", "Expressed as either height above takeoff location or height above ground level (AGL) for a UAS current location. This value is provided in meters and must have a minimum resolution of 1 m. Special Values: <code>Invalid</code>, <code>No Value</code>, or <code>Unknown: -1000 m</code>.", "optional", "")},
+	{Name: "city", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("City", "The name of the city.", "optional", "")},
+	{Name: "continent", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Continent", "The name of the continent.", "optional", "")},
+	{Name: "country", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Country", "The ISO 3166-1 Alpha-2 country code.<p><b>Note:</b> The two letter country code should be capitalized. For example: <code>US</code> or <code>CA</code>.</p>", "optional", "")},
+	{Name: "desc", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Description", "The description of the geographical location.", "optional", "")},
+	{Name: "geodetic_altitude", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Geodetic Altitude", "The aircraft distance above or below the ellipsoid as measured along a line that passes through the aircraft and is normal to the surface of the WGS-84 ellipsoid. This value is provided in meters and must have a minimum resolution of 1 m. Special Values: <code>Invalid</code>, <code>No Value</code>, or <code>Unknown: -1000 m</code>.", "optional", "")},
+	{Name: "geodetic_vertical_accuracy", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Geodetic Vertical Accuracy", "Provides quality/containment on geodetic altitude. This is based on ADS-B Geodetic Vertical Accuracy (GVA). Measured in meters.", "optional", "")},
+	{Name: "geohash", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Geohash", "<p>Geohash of the geo-coordinates (latitude and longitude).</p><a target='_blank' href='https://en.wikipedia.org/wiki/Geohash'>Geohashing</a> is a geocoding system used to encode geographic coordinates in decimal degrees, to a single string.", "optional", "")},
+	{Name: "horizontal_accuracy", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Horizontal Accuracy", "Provides quality/containment on horizontal position. This is based on ADS-B NACp. Measured in meters.", "optional", "")},
+	{Name: "is_on_premises", Type: arrow.FixedWidthTypes.Boolean, Nullable: true, Metadata: ocsf.AttributeMetadata("On Premises", "The indication of whether the location is on premises.", "optional", "")},
+	{Name: "isp", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("ISP", "The name of the Internet Service Provider (ISP).", "optional", "")},
+	{Name: "lat", Type: arrow.PrimitiveTypes.Float64, Nullable: true, Metadata: ocsf.AttributeMetadata("Latitude", "The geographical Latitude coordinate represented in Decimal Degrees (DD). For example: <code>42.361145</code>.", "optional", "")},
+	{Name: "long", Type: arrow.PrimitiveTypes.Float64, Nullable: true, Metadata: ocsf.AttributeMetadata("Longitude", "The geographical Longitude coordinate represented in Decimal Degrees (DD). For example: <code>-71.057083</code>.", "optional", "")},
+	{Name: "postal_code", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Postal Code", "The postal code of the location.", "optional", "")},
+	{Name: "pressure_altitude", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Pressure Altitude", "The uncorrected barometric pressure altitude (based on reference standard 29.92 inHg, 1013.25 mb) provides a reference for algorithms that utilize 'altitude deltas' between aircraft. This value is provided in meters and must have a minimum resolution of 1 m.. Special Values: <code>Invalid</code>, <code>No Value</code>, or <code>Unknown: -1000 m</code>.", "optional", "")},
+	{Name: "provider", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Provider", "The provider of the geographical location data.", "optional", "")},
+	{Name: "region", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Region", "The alphanumeric code that identifies the principal subdivision (e.g. province or state) of the country. For example, 'CH-VD' for the Canton of Vaud, Switzerland", "optional", "")},
 }
 
 var GeoLocationStruct = arrow.StructOf(GeoLocationFields...)
@@ -2,6 +2,7 @@
 package v1_4_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -48,19 +49,215 @@ func (v *Logger) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *Logger) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *Logger) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	if v.Device != nil {
+		errs = append(errs, v.Device.ValidateAt(ocsf.JoinPath(path, "device"))...)
+	}
+	if v.Product != nil {
+		errs = append(errs, v.Product.ValidateAt(ocsf.JoinPath(path, "product"))...)
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *Logger) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "device":
+		if index >= 0 || v.Device == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Device, true
+		}
+		return v.Device.Get(rest)
+	case "event_uid":
+		if index >= 0 || rest != "" || v.EventUid == nil {
+			return nil, false
+		}
+		return *v.EventUid, true
+	case "log_level":
+		if index >= 0 || rest != "" || v.LogLevel == nil {
+			return nil, false
+		}
+		return *v.LogLevel, true
+	case "log_name":
+		if index >= 0 || rest != "" || v.LogName == nil {
+			return nil, false
+		}
+		return *v.LogName, true
+	case "log_provider":
+		if index >= 0 || rest != "" || v.LogProvider == nil {
+			return nil, false
+		}
+		return *v.LogProvider, true
+	case "log_version":
+		if index >= 0 || rest != "" || v.LogVersion == nil {
+			return nil, false
+		}
+		return *v.LogVersion, true
+	case "logged_time":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.LoggedTime, true
+	case "name":
+		if index >= 0 || rest != "" || v.Name == nil {
+			return nil, false
+		}
+		return *v.Name, true
+	case "product":
+		if index >= 0 || v.Product == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Product, true
+		}
+		return v.Product.Get(rest)
+	case "transmit_time":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.TransmitTime, true
+	case "uid":
+		if index >= 0 || rest != "" || v.Uid == nil {
+			return nil, false
+		}
+		return *v.Uid, true
+	case "version":
+		if index >= 0 || rest != "" || v.Version == nil {
+			return nil, false
+		}
+		return *v.Version, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *Logger) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "device":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Device, value)
+		}
+		if v.Device == nil {
+			v.Device = &Device{}
+		}
+		return v.Device.Set(rest, value)
+	case "event_uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.EventUid, value)
+	case "log_level":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.LogLevel, value)
+	case "log_name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.LogName, value)
+	case "log_provider":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.LogProvider, value)
+	case "log_version":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.LogVersion, value)
+	case "logged_time":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.LoggedTime, value)
+	case "name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Name, value)
+	case "product":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Product, value)
+		}
+		if v.Product == nil {
+			v.Product = &Product{}
+		}
+		return v.Product.Set(rest, value)
+	case "transmit_time":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.TransmitTime, value)
+	case "uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Uid, value)
+	case "version":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Version, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *Logger) AppendObservables(path string, observables []Observable) []Observable {
+	if v.Device != nil {
+		observables = v.Device.AppendObservables(ocsf.JoinPath(path, "device"), observables)
+	}
+	if v.Product != nil {
+		observables = v.Product.AppendObservables(ocsf.JoinPath(path, "product"), observables)
+	}
+
+	return observables
+}
+
 var LoggerFields = []arrow.Field{
-	{Name: "device", Type: DeviceStruct, Nullable: true},
-	{Name: "event_uid", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "log_level", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "log_name", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "log_provider", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "log_version", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "logged_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
-	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "product", Type: ProductStruct, Nullable: true},
-	{Name: "transmit_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
-	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "version", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "device", Type: DeviceStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Device", "The device where the events are logged.", "optional", "")},
+	{Name: "event_uid", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Event UID", "The unique identifier of the event assigned by the logger.", "optional", "")},
+	{Name: "log_level", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Log Level", "The audit level at which an event was generated.", "optional", "")},
+	{Name: "log_name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Log Name", "The event log name. For example, syslog file name or Windows logging subsystem: Security.", "optional", "")},
+	{Name: "log_provider", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Log Provider", "The logging provider or logging service that logged the event. For example, Microsoft-Windows-Security-Auditing.", "optional", "")},
+	{Name: "log_version", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Log Version", "The event log schema version that specifies the format of the original event. For example syslog version or Cisco Log Schema Version.", "optional", "")},
+	{Name: "logged_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true, Metadata: ocsf.AttributeMetadata("Logged Time", "<p>The time when the logging system collected and logged the event.</p>This attribute is distinct from the event time in that event time typically contain the time extracted from the original event. Most of the time, these two times will be different.", "optional", "")},
+	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Name", "The name of the logging product instance.", "optional", "")},
+	{Name: "product", Type: ProductStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Product", "The product logging the event.  This may be the event source product, a management server product, a scanning product, a SIEM, etc.", "optional", "")},
+	{Name: "transmit_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true, Metadata: ocsf.AttributeMetadata("Transmission Time", "The time when the event was transmitted from the logging device to it's next destination.", "optional", "")},
+	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Unique ID", "The unique identifier of the logging product instance.", "optional", "")},
+	{Name: "version", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Version", "The version of the logging product.", "optional", "")},
 }
 
 var LoggerStruct = arrow.StructOf(LoggerFields...)
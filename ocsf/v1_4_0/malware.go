@@ -2,6 +2,7 @@
 package v1_4_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -33,14 +34,181 @@ func (v *Malware) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *Malware) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *Malware) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	if len(v.ClassificationIds) == 0 {
+		errs.Add(ocsf.JoinPath(path, "classification_ids"), "required attribute is missing")
+	}
+	for i := range v.Cves {
+		errs = append(errs, v.Cves[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "cves"), i))...)
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *Malware) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "classification_ids":
+		if rest == "" {
+			if index < 0 {
+				return v.ClassificationIds, v.ClassificationIds != nil
+			}
+			return ocsf.Index(v.ClassificationIds, index)
+		}
+		return nil, false
+	case "classifications":
+		if rest == "" {
+			if index < 0 {
+				return v.Classifications, v.Classifications != nil
+			}
+			return ocsf.Index(v.Classifications, index)
+		}
+		return nil, false
+	case "cves":
+		if rest == "" {
+			if index < 0 {
+				return v.Cves, v.Cves != nil
+			}
+			return ocsf.Index(v.Cves, index)
+		}
+		if index < 0 || index >= len(v.Cves) {
+			return nil, false
+		}
+		return v.Cves[index].Get(rest)
+	case "name":
+		if index >= 0 || rest != "" || v.Name == nil {
+			return nil, false
+		}
+		return *v.Name, true
+	case "path":
+		if index >= 0 || rest != "" || v.Path == nil {
+			return nil, false
+		}
+		return *v.Path, true
+	case "provider":
+		if index >= 0 || rest != "" || v.Provider == nil {
+			return nil, false
+		}
+		return *v.Provider, true
+	case "uid":
+		if index >= 0 || rest != "" || v.Uid == nil {
+			return nil, false
+		}
+		return *v.Uid, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *Malware) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "classification_ids":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.ClassificationIds, value)
+		}
+		elem, err := ocsf.Element(&v.ClassificationIds, index)
+		if err != nil {
+			return err
+		}
+		if rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(elem, value)
+	case "classifications":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Classifications, value)
+		}
+		elem, err := ocsf.Element(&v.Classifications, index)
+		if err != nil {
+			return err
+		}
+		if rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(elem, value)
+	case "cves":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Cves, value)
+		}
+		elem, err := ocsf.Element(&v.Cves, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Name, value)
+	case "path":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Path, value)
+	case "provider":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Provider, value)
+	case "uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Uid, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *Malware) AppendObservables(path string, observables []Observable) []Observable {
+	for i := range v.Cves {
+		observables = v.Cves[i].AppendObservables(ocsf.JoinPath(path, "cves"), observables)
+	}
+
+	return observables
+}
+
 var MalwareFields = []arrow.Field{
-	{Name: "classification_ids", Type: arrow.ListOf(arrow.PrimitiveTypes.Int32), Nullable: false},
-	{Name: "classifications", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true},
-	{Name: "cves", Type: arrow.ListOf(CVEStruct), Nullable: true},
-	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "path", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "provider", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "classification_ids", Type: arrow.ListOf(arrow.PrimitiveTypes.Int32), Nullable: false, Metadata: ocsf.AttributeMetadata("Classification IDs", "The list of normalized identifiers of the malware classifications. Reference: <a target='_blank' href='https://docs.oasis-open.org/cti/stix/v2.1/os/stix-v2.1-os.html#_oxlc4df65spl'>STIX Malware Types</a>", "required", "")},
+	{Name: "classifications", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true, Metadata: ocsf.AttributeMetadata("Classifications", "The list of malware classifications, normalized to the captions of the <code>classification_ids</code> values. In the case of 'Other', they are defined by the event source.", "optional", "")},
+	{Name: "cves", Type: arrow.ListOf(CVEStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("CVE List", "List of Common Vulnerabilities and Exposures (<a target='_blank' href='https://cve.mitre.org/'>CVE</a>).", "optional", "")},
+	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Name", "The malware name, as reported by the detection engine.", "optional", "")},
+	{Name: "path", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Path", "The filesystem path of the malware that was observed.", "optional", "")},
+	{Name: "provider", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Provider", "The provider of the malware information.", "optional", "")},
+	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Unique ID", "The malware unique identifier, as reported by the detection engine. For example a virus id or an IPS signature id.", "optional", "")},
 }
 
 var MalwareStruct = arrow.StructOf(MalwareFields...)
@@ -2,13 +2,14 @@
 package v1_4_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
 type ManagedEntity struct {
 
 	// Data: The managed entity content as a JSON object.
-	Data *string `json:"data,omitempty" parquet:"data,optional"`
+	Data *ocsf.RawJSON `json:"data,omitempty" parquet:"data,optional"`
 
 	// Device: An addressable device, computer system or host.
 	Device *Device `json:"device,omitempty" parquet:"device,optional"`
@@ -51,20 +52,301 @@ func (v *ManagedEntity) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *ManagedEntity) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *ManagedEntity) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	if v.Device != nil {
+		errs = append(errs, v.Device.ValidateAt(ocsf.JoinPath(path, "device"))...)
+	}
+	if v.Email != nil {
+		errs = append(errs, v.Email.ValidateAt(ocsf.JoinPath(path, "email"))...)
+	}
+	if v.Group != nil {
+		errs = append(errs, v.Group.ValidateAt(ocsf.JoinPath(path, "group"))...)
+	}
+	if v.Location != nil {
+		errs = append(errs, v.Location.ValidateAt(ocsf.JoinPath(path, "location"))...)
+	}
+	if v.Org != nil {
+		errs = append(errs, v.Org.ValidateAt(ocsf.JoinPath(path, "org"))...)
+	}
+	if v.Policy != nil {
+		errs = append(errs, v.Policy.ValidateAt(ocsf.JoinPath(path, "policy"))...)
+	}
+	if v.User != nil {
+		errs = append(errs, v.User.ValidateAt(ocsf.JoinPath(path, "user"))...)
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *ManagedEntity) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "data":
+		if index >= 0 || rest != "" || v.Data == nil {
+			return nil, false
+		}
+		return *v.Data, true
+	case "device":
+		if index >= 0 || v.Device == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Device, true
+		}
+		return v.Device.Get(rest)
+	case "email":
+		if index >= 0 || v.Email == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Email, true
+		}
+		return v.Email.Get(rest)
+	case "group":
+		if index >= 0 || v.Group == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Group, true
+		}
+		return v.Group.Get(rest)
+	case "location":
+		if index >= 0 || v.Location == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Location, true
+		}
+		return v.Location.Get(rest)
+	case "name":
+		if index >= 0 || rest != "" || v.Name == nil {
+			return nil, false
+		}
+		return *v.Name, true
+	case "org":
+		if index >= 0 || v.Org == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Org, true
+		}
+		return v.Org.Get(rest)
+	case "policy":
+		if index >= 0 || v.Policy == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Policy, true
+		}
+		return v.Policy.Get(rest)
+	case "type":
+		if index >= 0 || rest != "" || v.Type == nil {
+			return nil, false
+		}
+		return *v.Type, true
+	case "type_id":
+		if index >= 0 || rest != "" || v.TypeId == nil {
+			return nil, false
+		}
+		return *v.TypeId, true
+	case "uid":
+		if index >= 0 || rest != "" || v.Uid == nil {
+			return nil, false
+		}
+		return *v.Uid, true
+	case "user":
+		if index >= 0 || v.User == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.User, true
+		}
+		return v.User.Get(rest)
+	case "version":
+		if index >= 0 || rest != "" || v.Version == nil {
+			return nil, false
+		}
+		return *v.Version, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *ManagedEntity) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "data":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Data, value)
+	case "device":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Device, value)
+		}
+		if v.Device == nil {
+			v.Device = &Device{}
+		}
+		return v.Device.Set(rest, value)
+	case "email":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Email, value)
+		}
+		if v.Email == nil {
+			v.Email = &Email{}
+		}
+		return v.Email.Set(rest, value)
+	case "group":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Group, value)
+		}
+		if v.Group == nil {
+			v.Group = &Group{}
+		}
+		return v.Group.Set(rest, value)
+	case "location":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Location, value)
+		}
+		if v.Location == nil {
+			v.Location = &GeoLocation{}
+		}
+		return v.Location.Set(rest, value)
+	case "name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Name, value)
+	case "org":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Org, value)
+		}
+		if v.Org == nil {
+			v.Org = &Organization{}
+		}
+		return v.Org.Set(rest, value)
+	case "policy":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Policy, value)
+		}
+		if v.Policy == nil {
+			v.Policy = &Policy{}
+		}
+		return v.Policy.Set(rest, value)
+	case "type":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Type, value)
+	case "type_id":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.TypeId, value)
+	case "uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Uid, value)
+	case "user":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.User, value)
+		}
+		if v.User == nil {
+			v.User = &User{}
+		}
+		return v.User.Set(rest, value)
+	case "version":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Version, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *ManagedEntity) AppendObservables(path string, observables []Observable) []Observable {
+	if v.Device != nil {
+		observables = v.Device.AppendObservables(ocsf.JoinPath(path, "device"), observables)
+	}
+	if v.Email != nil {
+		observables = v.Email.AppendObservables(ocsf.JoinPath(path, "email"), observables)
+	}
+	if v.Group != nil {
+		observables = v.Group.AppendObservables(ocsf.JoinPath(path, "group"), observables)
+	}
+	if v.Location != nil {
+		observables = v.Location.AppendObservables(ocsf.JoinPath(path, "location"), observables)
+	}
+	if v.Org != nil {
+		observables = v.Org.AppendObservables(ocsf.JoinPath(path, "org"), observables)
+	}
+	if v.Policy != nil {
+		observables = v.Policy.AppendObservables(ocsf.JoinPath(path, "policy"), observables)
+	}
+	if v.User != nil {
+		observables = v.User.AppendObservables(ocsf.JoinPath(path, "user"), observables)
+	}
+
+	return observables
+}
+
 var ManagedEntityFields = []arrow.Field{
-	{Name: "data", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "device", Type: DeviceStruct, Nullable: true},
-	{Name: "email", Type: EmailStruct, Nullable: true},
-	{Name: "group", Type: GroupStruct, Nullable: true},
-	{Name: "location", Type: GeoLocationStruct, Nullable: true},
-	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "org", Type: OrganizationStruct, Nullable: true},
-	{Name: "policy", Type: PolicyStruct, Nullable: true},
-	{Name: "type", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "type_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "user", Type: UserStruct, Nullable: true},
-	{Name: "version", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "data", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Data", "The managed entity content as a JSON object.", "optional", "")},
+	{Name: "device", Type: DeviceStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Device", "An addressable device, computer system or host.", "optional", "")},
+	{Name: "email", Type: EmailStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Email", "The email object.", "optional", "")},
+	{Name: "group", Type: GroupStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Group", "The group object associated with an entity such as user, policy, or rule.", "optional", "")},
+	{Name: "location", Type: GeoLocationStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Geo Location", "The detailed geographical location usually associated with an IP address.", "optional", "")},
+	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Name", "The name of the managed entity.", "optional", "")},
+	{Name: "org", Type: OrganizationStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Organization", "Organization and org unit relevant to the event or object.", "optional", "")},
+	{Name: "policy", Type: PolicyStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Policy", "Describes details of a managed policy.", "optional", "")},
+	{Name: "type", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Type", "The managed entity type. For example: <code>policy</code>, <code>user</code>, <code>organizational unit</code>, <code>device</code>.", "optional", "")},
+	{Name: "type_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Type ID", "The type of the Managed Entity. It is recommended to also populate the <code>type</code> attribute with the associated label, or the source specific name if <code>Other</code>.", "optional", "")},
+	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Unique ID", "The identifier of the managed entity.", "optional", "")},
+	{Name: "user", Type: UserStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("User", "The user that pertains to the event or object.", "optional", "")},
+	{Name: "version", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Version", "The version of the managed entity. For example: <code>1.2.3</code>.", "optional", "")},
 }
 
 var ManagedEntityStruct = arrow.StructOf(ManagedEntityFields...)
@@ -2,6 +2,7 @@
 package v1_4_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -78,29 +79,433 @@ func (v *Metadata) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *Metadata) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *Metadata) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	for i := range v.DataClassifications {
+		errs = append(errs, v.DataClassifications[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "data_classifications"), i))...)
+	}
+	for i := range v.Extensions {
+		errs = append(errs, v.Extensions[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "extensions"), i))...)
+	}
+	for i := range v.Loggers {
+		errs = append(errs, v.Loggers[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "loggers"), i))...)
+	}
+	errs = append(errs, v.Product.ValidateAt(ocsf.JoinPath(path, "product"))...)
+	for i := range v.Tags {
+		errs = append(errs, v.Tags[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "tags"), i))...)
+	}
+	if v.Version == "" {
+		errs.Add(ocsf.JoinPath(path, "version"), "required attribute is missing")
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *Metadata) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "correlation_uid":
+		if index >= 0 || rest != "" || v.CorrelationUid == nil {
+			return nil, false
+		}
+		return *v.CorrelationUid, true
+	case "data_classifications":
+		if rest == "" {
+			if index < 0 {
+				return v.DataClassifications, v.DataClassifications != nil
+			}
+			return ocsf.Index(v.DataClassifications, index)
+		}
+		if index < 0 || index >= len(v.DataClassifications) {
+			return nil, false
+		}
+		return v.DataClassifications[index].Get(rest)
+	case "debug":
+		if rest == "" {
+			if index < 0 {
+				return v.Debug, v.Debug != nil
+			}
+			return ocsf.Index(v.Debug, index)
+		}
+		return nil, false
+	case "event_code":
+		if index >= 0 || rest != "" || v.EventCode == nil {
+			return nil, false
+		}
+		return *v.EventCode, true
+	case "extensions":
+		if rest == "" {
+			if index < 0 {
+				return v.Extensions, v.Extensions != nil
+			}
+			return ocsf.Index(v.Extensions, index)
+		}
+		if index < 0 || index >= len(v.Extensions) {
+			return nil, false
+		}
+		return v.Extensions[index].Get(rest)
+	case "labels":
+		if rest == "" {
+			if index < 0 {
+				return v.Labels, v.Labels != nil
+			}
+			return ocsf.Index(v.Labels, index)
+		}
+		return nil, false
+	case "log_level":
+		if index >= 0 || rest != "" || v.LogLevel == nil {
+			return nil, false
+		}
+		return *v.LogLevel, true
+	case "log_name":
+		if index >= 0 || rest != "" || v.LogName == nil {
+			return nil, false
+		}
+		return *v.LogName, true
+	case "log_provider":
+		if index >= 0 || rest != "" || v.LogProvider == nil {
+			return nil, false
+		}
+		return *v.LogProvider, true
+	case "log_version":
+		if index >= 0 || rest != "" || v.LogVersion == nil {
+			return nil, false
+		}
+		return *v.LogVersion, true
+	case "logged_time":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.LoggedTime, true
+	case "loggers":
+		if rest == "" {
+			if index < 0 {
+				return v.Loggers, v.Loggers != nil
+			}
+			return ocsf.Index(v.Loggers, index)
+		}
+		if index < 0 || index >= len(v.Loggers) {
+			return nil, false
+		}
+		return v.Loggers[index].Get(rest)
+	case "modified_time":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.ModifiedTime, true
+	case "original_time":
+		if index >= 0 || rest != "" || v.OriginalTime == nil {
+			return nil, false
+		}
+		return *v.OriginalTime, true
+	case "processed_time":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.ProcessedTime, true
+	case "product":
+		if index >= 0 {
+			return nil, false
+		}
+		if rest == "" {
+			return &v.Product, true
+		}
+		return v.Product.Get(rest)
+	case "profiles":
+		if rest == "" {
+			if index < 0 {
+				return v.Profiles, v.Profiles != nil
+			}
+			return ocsf.Index(v.Profiles, index)
+		}
+		return nil, false
+	case "sequence":
+		if index >= 0 || rest != "" || v.Sequence == nil {
+			return nil, false
+		}
+		return *v.Sequence, true
+	case "tags":
+		if rest == "" {
+			if index < 0 {
+				return v.Tags, v.Tags != nil
+			}
+			return ocsf.Index(v.Tags, index)
+		}
+		if index < 0 || index >= len(v.Tags) {
+			return nil, false
+		}
+		return v.Tags[index].Get(rest)
+	case "tenant_uid":
+		if index >= 0 || rest != "" || v.TenantUid == nil {
+			return nil, false
+		}
+		return *v.TenantUid, true
+	case "uid":
+		if index >= 0 || rest != "" || v.Uid == nil {
+			return nil, false
+		}
+		return *v.Uid, true
+	case "version":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.Version, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *Metadata) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "correlation_uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.CorrelationUid, value)
+	case "data_classifications":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.DataClassifications, value)
+		}
+		elem, err := ocsf.Element(&v.DataClassifications, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "debug":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Debug, value)
+		}
+		elem, err := ocsf.Element(&v.Debug, index)
+		if err != nil {
+			return err
+		}
+		if rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(elem, value)
+	case "event_code":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.EventCode, value)
+	case "extensions":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Extensions, value)
+		}
+		elem, err := ocsf.Element(&v.Extensions, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "labels":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Labels, value)
+		}
+		elem, err := ocsf.Element(&v.Labels, index)
+		if err != nil {
+			return err
+		}
+		if rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(elem, value)
+	case "log_level":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.LogLevel, value)
+	case "log_name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.LogName, value)
+	case "log_provider":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.LogProvider, value)
+	case "log_version":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.LogVersion, value)
+	case "logged_time":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.LoggedTime, value)
+	case "loggers":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Loggers, value)
+		}
+		elem, err := ocsf.Element(&v.Loggers, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "modified_time":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.ModifiedTime, value)
+	case "original_time":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.OriginalTime, value)
+	case "processed_time":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.ProcessedTime, value)
+	case "product":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetRequired(&v.Product, value)
+		}
+		return v.Product.Set(rest, value)
+	case "profiles":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Profiles, value)
+		}
+		elem, err := ocsf.Element(&v.Profiles, index)
+		if err != nil {
+			return err
+		}
+		if rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(elem, value)
+	case "sequence":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Sequence, value)
+	case "tags":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Tags, value)
+		}
+		elem, err := ocsf.Element(&v.Tags, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "tenant_uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.TenantUid, value)
+	case "uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Uid, value)
+	case "version":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.Version, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *Metadata) AppendObservables(path string, observables []Observable) []Observable {
+	for i := range v.DataClassifications {
+		observables = v.DataClassifications[i].AppendObservables(ocsf.JoinPath(path, "data_classifications"), observables)
+	}
+	for i := range v.Extensions {
+		observables = v.Extensions[i].AppendObservables(ocsf.JoinPath(path, "extensions"), observables)
+	}
+	for i := range v.Loggers {
+		observables = v.Loggers[i].AppendObservables(ocsf.JoinPath(path, "loggers"), observables)
+	}
+	observables = v.Product.AppendObservables(ocsf.JoinPath(path, "product"), observables)
+	for i := range v.Tags {
+		observables = v.Tags[i].AppendObservables(ocsf.JoinPath(path, "tags"), observables)
+	}
+
+	return observables
+}
+
 var MetadataFields = []arrow.Field{
-	{Name: "correlation_uid", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "data_classifications", Type: arrow.ListOf(DataClassificationStruct), Nullable: true},
-	{Name: "debug", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true},
-	{Name: "event_code", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "extensions", Type: arrow.ListOf(SchemaExtensionStruct), Nullable: true},
-	{Name: "labels", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true},
-	{Name: "log_level", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "log_name", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "log_provider", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "log_version", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "logged_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
-	{Name: "loggers", Type: arrow.ListOf(LoggerStruct), Nullable: true},
-	{Name: "modified_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
-	{Name: "original_time", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "processed_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
-	{Name: "product", Type: ProductStruct, Nullable: false},
-	{Name: "profiles", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true},
-	{Name: "sequence", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "tags", Type: arrow.ListOf(KeyValueobjectStruct), Nullable: true},
-	{Name: "tenant_uid", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "version", Type: arrow.BinaryTypes.String, Nullable: false},
+	{Name: "correlation_uid", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Correlation UID", "The unique identifier used to correlate events.", "optional", "")},
+	{Name: "data_classifications", Type: arrow.ListOf(DataClassificationStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("Data Classification", "A list of Data Classification objects, that include information about data classification levels and data category types, indentified by a classifier.", "optional", "")},
+	{Name: "debug", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true, Metadata: ocsf.AttributeMetadata("Debug Information", "Debug information about non-fatal issues with this OCSF event. Each issue is a line in this string array.", "optional", "")},
+	{Name: "event_code", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Event Code", "The <code>Event ID, Code, or Name</code> that the product uses to primarily identify the event.", "optional", "")},
+	{Name: "extensions", Type: arrow.ListOf(SchemaExtensionStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("Schema Extensions", "The schema extensions used to create the event.", "optional", "")},
+	{Name: "labels", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true, Metadata: ocsf.AttributeMetadata("Labels", "The list of labels attached to the event. For example: <code>[\"sample\", \"dev\"]</code>", "optional", "")},
+	{Name: "log_level", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Log Level", "The audit level at which an event was generated.", "optional", "")},
+	{Name: "log_name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Log Name", "The event log name. For example, syslog file name or Windows logging subsystem: Security.", "optional", "")},
+	{Name: "log_provider", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Log Provider", "The logging provider or logging service that logged the event. For example, Microsoft-Windows-Security-Auditing.", "optional", "")},
+	{Name: "log_version", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Log Version", "The event log schema version that specifies the format of the original event. For example syslog version or Cisco Log Schema Version.", "optional", "")},
+	{Name: "logged_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true, Metadata: ocsf.AttributeMetadata("Logged Time", "<p>The time when the logging system collected and logged the event.</p>This attribute is distinct from the event time in that event time typically contain the time extracted from the original event. Most of the time, these two times will be different.", "optional", "")},
+	{Name: "loggers", Type: arrow.ListOf(LoggerStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("Loggers", "An array of Logger objects that describe the devices and logging products between the event source and its eventual destination. Note, this attribute can be used when there is a complex end-to-end path of event flow.", "optional", "")},
+	{Name: "modified_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true, Metadata: ocsf.AttributeMetadata("Modified Time", "The time when the event was last modified or enriched.", "optional", "")},
+	{Name: "original_time", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Original Time", "The original event time as reported by the event source. For example, the time in the original format from system event log such as Syslog on Unix/Linux and the System event file on Windows. Omit if event is generated instead of collected via logs.", "optional", "")},
+	{Name: "processed_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true, Metadata: ocsf.AttributeMetadata("Processed Time", "The event processed time, such as an ETL operation.", "optional", "")},
+	{Name: "product", Type: ProductStruct, Nullable: false, Metadata: ocsf.AttributeMetadata("Product", "The product that reported the event.", "required", "")},
+	{Name: "profiles", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true, Metadata: ocsf.AttributeMetadata("Profiles", "The list of profiles used to create the event.  Profiles should be referenced by their <code>name</code> attribute for core profiles, or <code>extension/name</code> for profiles from extensions.", "optional", "")},
+	{Name: "sequence", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Sequence Number", "Sequence number of the event. The sequence number is a value available in some events, to make the exact ordering of events unambiguous, regardless of the event time precision.", "optional", "")},
+	{Name: "tags", Type: arrow.ListOf(KeyValueobjectStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("Tags", "The list of tags; <code>{key:value}</code> pairs associated to the event.", "optional", "")},
+	{Name: "tenant_uid", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Tenant UID", "The unique tenant identifier.", "optional", "")},
+	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Event UID", "The logging system-assigned unique identifier of an event instance.", "optional", "")},
+	{Name: "version", Type: arrow.BinaryTypes.String, Nullable: false, Metadata: ocsf.AttributeMetadata("Version", "The version of the OCSF schema, using Semantic Versioning Specification (<a target='_blank' href='https://semver.org'>SemVer</a>). For example: 1.0.0. Event consumers use the version to determine the available event attributes.", "required", "")},
 }
 
 var MetadataStruct = arrow.StructOf(MetadataFields...)
@@ -2,6 +2,7 @@
 package v1_4_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -33,14 +34,145 @@ func (v *Module) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *Module) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *Module) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	if v.File != nil {
+		errs = append(errs, v.File.ValidateAt(ocsf.JoinPath(path, "file"))...)
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *Module) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "base_address":
+		if index >= 0 || rest != "" || v.BaseAddress == nil {
+			return nil, false
+		}
+		return *v.BaseAddress, true
+	case "file":
+		if index >= 0 || v.File == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.File, true
+		}
+		return v.File.Get(rest)
+	case "function_name":
+		if index >= 0 || rest != "" || v.FunctionName == nil {
+			return nil, false
+		}
+		return *v.FunctionName, true
+	case "load_type":
+		if index >= 0 || rest != "" || v.LoadType == nil {
+			return nil, false
+		}
+		return *v.LoadType, true
+	case "load_type_id":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.LoadTypeId, true
+	case "start_address":
+		if index >= 0 || rest != "" || v.StartAddress == nil {
+			return nil, false
+		}
+		return *v.StartAddress, true
+	case "type":
+		if index >= 0 || rest != "" || v.Type == nil {
+			return nil, false
+		}
+		return *v.Type, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *Module) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "base_address":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.BaseAddress, value)
+	case "file":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.File, value)
+		}
+		if v.File == nil {
+			v.File = &File{}
+		}
+		return v.File.Set(rest, value)
+	case "function_name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.FunctionName, value)
+	case "load_type":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.LoadType, value)
+	case "load_type_id":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.LoadTypeId, value)
+	case "start_address":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.StartAddress, value)
+	case "type":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Type, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *Module) AppendObservables(path string, observables []Observable) []Observable {
+	if v.File != nil {
+		observables = v.File.AppendObservables(ocsf.JoinPath(path, "file"), observables)
+	}
+
+	return observables
+}
+
 var ModuleFields = []arrow.Field{
-	{Name: "base_address", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "file", Type: FileStruct, Nullable: true},
-	{Name: "function_name", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "load_type", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "load_type_id", Type: arrow.PrimitiveTypes.Int32, Nullable: false},
-	{Name: "start_address", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "type", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "base_address", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Base Address", "The memory address where the module was loaded.", "optional", "")},
+	{Name: "file", Type: FileStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("File", "The module file object.", "optional", "")},
+	{Name: "function_name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Function Name", "The entry-point function of the module. The system calls the entry-point function whenever a process or thread loads or unloads the module.", "optional", "")},
+	{Name: "load_type", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Load Type", "The load type, normalized to the caption of the load_type_id value. In the case of 'Other', it is defined by the event source.", "optional", "")},
+	{Name: "load_type_id", Type: arrow.PrimitiveTypes.Int32, Nullable: false, Metadata: ocsf.AttributeMetadata("Load Type ID", "The normalized identifier for how the module was loaded in memory.", "required", "")},
+	{Name: "start_address", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Start Address", "The start address of the execution.", "optional", "")},
+	{Name: "type", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Type", "The module type.", "optional", "")},
 }
 
 var ModuleStruct = arrow.StructOf(ModuleFields...)
@@ -2,6 +2,7 @@
 package v1_4_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -39,16 +40,152 @@ func (v *OccurrenceDetails) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *OccurrenceDetails) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *OccurrenceDetails) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *OccurrenceDetails) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "cell_name":
+		if index >= 0 || rest != "" || v.CellName == nil {
+			return nil, false
+		}
+		return *v.CellName, true
+	case "column_name":
+		if index >= 0 || rest != "" || v.ColumnName == nil {
+			return nil, false
+		}
+		return *v.ColumnName, true
+	case "column_number":
+		if index >= 0 || rest != "" || v.ColumnNumber == nil {
+			return nil, false
+		}
+		return *v.ColumnNumber, true
+	case "end_line":
+		if index >= 0 || rest != "" || v.EndLine == nil {
+			return nil, false
+		}
+		return *v.EndLine, true
+	case "json_path":
+		if index >= 0 || rest != "" || v.JsonPath == nil {
+			return nil, false
+		}
+		return *v.JsonPath, true
+	case "page_number":
+		if index >= 0 || rest != "" || v.PageNumber == nil {
+			return nil, false
+		}
+		return *v.PageNumber, true
+	case "record_index_in_array":
+		if index >= 0 || rest != "" || v.RecordIndexInArray == nil {
+			return nil, false
+		}
+		return *v.RecordIndexInArray, true
+	case "row_number":
+		if index >= 0 || rest != "" || v.RowNumber == nil {
+			return nil, false
+		}
+		return *v.RowNumber, true
+	case "start_line":
+		if index >= 0 || rest != "" || v.StartLine == nil {
+			return nil, false
+		}
+		return *v.StartLine, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *OccurrenceDetails) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "cell_name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.CellName, value)
+	case "column_name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.ColumnName, value)
+	case "column_number":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.ColumnNumber, value)
+	case "end_line":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.EndLine, value)
+	case "json_path":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.JsonPath, value)
+	case "page_number":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.PageNumber, value)
+	case "record_index_in_array":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.RecordIndexInArray, value)
+	case "row_number":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.RowNumber, value)
+	case "start_line":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.StartLine, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *OccurrenceDetails) AppendObservables(path string, observables []Observable) []Observable {
+
+	return observables
+}
+
 var OccurrenceDetailsFields = []arrow.Field{
-	{Name: "cell_name", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "column_name", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "column_number", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "end_line", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "json_path", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "page_number", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "record_index_in_array", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "row_number", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "start_line", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
+	{Name: "cell_name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Cell Name", "The cell name/reference in a spreadsheet. e.g <code>A2</code>", "optional", "")},
+	{Name: "column_name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Column Name", "The column name in a spreadsheet, where the information was discovered.", "optional", "")},
+	{Name: "column_number", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Column Number", "The column number in a spreadsheet or a plain text document, where the information was discovered.", "optional", "")},
+	{Name: "end_line", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("End Line", "The line number of the last line of the file, where the information was discovered.", "optional", "")},
+	{Name: "json_path", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("JSON Path", "The JSON path of the attribute in a json record, where the information was discovered", "optional", "")},
+	{Name: "page_number", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Page Number", "The page number in a document, where the information was discovered.", "optional", "")},
+	{Name: "record_index_in_array", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Record Index in Array", "The index of the record in the array of records, where the information was discovered. e.g. the index of a record in an array of JSON records in a file.", "optional", "")},
+	{Name: "row_number", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Row Number", "The row number in a spreadsheet, where the information was discovered.", "optional", "")},
+	{Name: "start_line", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Start Line", "The line number of the first line of the file, where the information was discovered.", "optional", "")},
 }
 
 var OccurrenceDetailsStruct = arrow.StructOf(OccurrenceDetailsFields...)
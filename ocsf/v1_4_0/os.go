@@ -2,6 +2,7 @@
 package v1_4_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -51,20 +52,199 @@ func (v *OperatingSystemOS) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *OperatingSystemOS) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *OperatingSystemOS) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	if v.Name == "" {
+		errs.Add(ocsf.JoinPath(path, "name"), "required attribute is missing")
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *OperatingSystemOS) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "build":
+		if index >= 0 || rest != "" || v.Build == nil {
+			return nil, false
+		}
+		return *v.Build, true
+	case "country":
+		if index >= 0 || rest != "" || v.Country == nil {
+			return nil, false
+		}
+		return *v.Country, true
+	case "cpe_name":
+		if index >= 0 || rest != "" || v.CpeName == nil {
+			return nil, false
+		}
+		return *v.CpeName, true
+	case "cpu_bits":
+		if index >= 0 || rest != "" || v.CpuBits == nil {
+			return nil, false
+		}
+		return *v.CpuBits, true
+	case "edition":
+		if index >= 0 || rest != "" || v.Edition == nil {
+			return nil, false
+		}
+		return *v.Edition, true
+	case "kernel_release":
+		if index >= 0 || rest != "" || v.KernelRelease == nil {
+			return nil, false
+		}
+		return *v.KernelRelease, true
+	case "lang":
+		if index >= 0 || rest != "" || v.Lang == nil {
+			return nil, false
+		}
+		return *v.Lang, true
+	case "name":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.Name, true
+	case "sp_name":
+		if index >= 0 || rest != "" || v.SpName == nil {
+			return nil, false
+		}
+		return *v.SpName, true
+	case "sp_ver":
+		if index >= 0 || rest != "" || v.SpVer == nil {
+			return nil, false
+		}
+		return *v.SpVer, true
+	case "type":
+		if index >= 0 || rest != "" || v.Type == nil {
+			return nil, false
+		}
+		return *v.Type, true
+	case "type_id":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.TypeId, true
+	case "version":
+		if index >= 0 || rest != "" || v.Version == nil {
+			return nil, false
+		}
+		return *v.Version, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *OperatingSystemOS) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "build":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Build, value)
+	case "country":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Country, value)
+	case "cpe_name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.CpeName, value)
+	case "cpu_bits":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.CpuBits, value)
+	case "edition":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Edition, value)
+	case "kernel_release":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.KernelRelease, value)
+	case "lang":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Lang, value)
+	case "name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.Name, value)
+	case "sp_name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.SpName, value)
+	case "sp_ver":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.SpVer, value)
+	case "type":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Type, value)
+	case "type_id":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.TypeId, value)
+	case "version":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Version, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *OperatingSystemOS) AppendObservables(path string, observables []Observable) []Observable {
+
+	return observables
+}
+
 var OperatingSystemOSFields = []arrow.Field{
-	{Name: "build", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "country", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "cpe_name", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "cpu_bits", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "edition", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "kernel_release", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "lang", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: false},
-	{Name: "sp_name", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "sp_ver", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "type", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "type_id", Type: arrow.PrimitiveTypes.Int32, Nullable: false},
-	{Name: "version", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "build", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("OS Build", "The operating system build number.", "optional", "")},
+	{Name: "country", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Country", "The operating system country code, as defined by the ISO 3166-1 standard (Alpha-2 code).<p><b>Note:</b> The two letter country code should be capitalized. For example: <code>US</code> or <code>CA</code>.</p>", "optional", "")},
+	{Name: "cpe_name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("The product CPE identifier", "The Common Platform Enumeration (CPE) name as described by (<a target='_blank' href='https://nvd.nist.gov/products/cpe'>NIST</a>) For example: <code>cpe:/a:apple:safari:16.2</code>.", "optional", "")},
+	{Name: "cpu_bits", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("CPU Bits", "The cpu architecture, the number of bits used for addressing in memory. For example: <code>32</code> or <code>64</code>.", "optional", "")},
+	{Name: "edition", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("OS Edition", "The operating system edition. For example: <code>Professional</code>.", "optional", "")},
+	{Name: "kernel_release", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Kernel Release", "The kernel release of the operating system. On Unix-based systems, this is determined from the <code>uname -r</code> command output, for example \"5.15.0-122-generic\".", "optional", "")},
+	{Name: "lang", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Language", "The two letter lower case language codes, as defined by <a target='_blank' href='https://en.wikipedia.org/wiki/ISO_639-1'>ISO 639-1</a>. For example: <code>en</code> (English), <code>de</code> (German), or <code>fr</code> (French).", "optional", "")},
+	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: false, Metadata: ocsf.AttributeMetadata("Name", "The operating system name.", "required", "")},
+	{Name: "sp_name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("OS Service Pack", "The name of the latest Service Pack.", "optional", "")},
+	{Name: "sp_ver", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("OS Service Pack Version", "The version number of the latest Service Pack.", "optional", "")},
+	{Name: "type", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Type", "The type of the operating system.", "optional", "")},
+	{Name: "type_id", Type: arrow.PrimitiveTypes.Int32, Nullable: false, Metadata: ocsf.AttributeMetadata("Type ID", "The type identifier of the operating system.", "required", "")},
+	{Name: "version", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Version", "The version of the OS running on the device that originated the event. For example: \"Windows 10\", \"OS X 10.7\", or \"iOS 9\".", "optional", "")},
 }
 
 var OperatingSystemOSStruct = arrow.StructOf(OperatingSystemOSFields...)
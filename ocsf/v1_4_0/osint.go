@@ -2,6 +2,7 @@
 package v1_4_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -93,34 +94,618 @@ func (v *OSINT) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *OSINT) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *OSINT) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	for i := range v.Answers {
+		errs = append(errs, v.Answers[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "answers"), i))...)
+	}
+	for i := range v.Attacks {
+		errs = append(errs, v.Attacks[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "attacks"), i))...)
+	}
+	if v.AutonomousSystem != nil {
+		errs = append(errs, v.AutonomousSystem.ValidateAt(ocsf.JoinPath(path, "autonomous_system"))...)
+	}
+	if v.Email != nil {
+		errs = append(errs, v.Email.ValidateAt(ocsf.JoinPath(path, "email"))...)
+	}
+	if v.EmailAuth != nil {
+		errs = append(errs, v.EmailAuth.ValidateAt(ocsf.JoinPath(path, "email_auth"))...)
+	}
+	if v.File != nil {
+		errs = append(errs, v.File.ValidateAt(ocsf.JoinPath(path, "file"))...)
+	}
+	for i := range v.KillChain {
+		errs = append(errs, v.KillChain[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "kill_chain"), i))...)
+	}
+	if v.Location != nil {
+		errs = append(errs, v.Location.ValidateAt(ocsf.JoinPath(path, "location"))...)
+	}
+	for i := range v.RelatedAnalytics {
+		errs = append(errs, v.RelatedAnalytics[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "related_analytics"), i))...)
+	}
+	if v.Reputation != nil {
+		errs = append(errs, v.Reputation.ValidateAt(ocsf.JoinPath(path, "reputation"))...)
+	}
+	if v.Script != nil {
+		errs = append(errs, v.Script.ValidateAt(ocsf.JoinPath(path, "script"))...)
+	}
+	for i := range v.Signatures {
+		errs = append(errs, v.Signatures[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "signatures"), i))...)
+	}
+	if v.Value == "" {
+		errs.Add(ocsf.JoinPath(path, "value"), "required attribute is missing")
+	}
+	for i := range v.Vulnerabilities {
+		errs = append(errs, v.Vulnerabilities[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "vulnerabilities"), i))...)
+	}
+	if v.Whois != nil {
+		errs = append(errs, v.Whois.ValidateAt(ocsf.JoinPath(path, "whois"))...)
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *OSINT) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "answers":
+		if rest == "" {
+			if index < 0 {
+				return v.Answers, v.Answers != nil
+			}
+			return ocsf.Index(v.Answers, index)
+		}
+		if index < 0 || index >= len(v.Answers) {
+			return nil, false
+		}
+		return v.Answers[index].Get(rest)
+	case "attacks":
+		if rest == "" {
+			if index < 0 {
+				return v.Attacks, v.Attacks != nil
+			}
+			return ocsf.Index(v.Attacks, index)
+		}
+		if index < 0 || index >= len(v.Attacks) {
+			return nil, false
+		}
+		return v.Attacks[index].Get(rest)
+	case "autonomous_system":
+		if index >= 0 || v.AutonomousSystem == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.AutonomousSystem, true
+		}
+		return v.AutonomousSystem.Get(rest)
+	case "comment":
+		if index >= 0 || rest != "" || v.Comment == nil {
+			return nil, false
+		}
+		return *v.Comment, true
+	case "confidence":
+		if index >= 0 || rest != "" || v.Confidence == nil {
+			return nil, false
+		}
+		return *v.Confidence, true
+	case "confidence_id":
+		if index >= 0 || rest != "" || v.ConfidenceId == nil {
+			return nil, false
+		}
+		return *v.ConfidenceId, true
+	case "email":
+		if index >= 0 || v.Email == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Email, true
+		}
+		return v.Email.Get(rest)
+	case "email_auth":
+		if index >= 0 || v.EmailAuth == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.EmailAuth, true
+		}
+		return v.EmailAuth.Get(rest)
+	case "file":
+		if index >= 0 || v.File == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.File, true
+		}
+		return v.File.Get(rest)
+	case "kill_chain":
+		if rest == "" {
+			if index < 0 {
+				return v.KillChain, v.KillChain != nil
+			}
+			return ocsf.Index(v.KillChain, index)
+		}
+		if index < 0 || index >= len(v.KillChain) {
+			return nil, false
+		}
+		return v.KillChain[index].Get(rest)
+	case "location":
+		if index >= 0 || v.Location == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Location, true
+		}
+		return v.Location.Get(rest)
+	case "name":
+		if index >= 0 || rest != "" || v.Name == nil {
+			return nil, false
+		}
+		return *v.Name, true
+	case "related_analytics":
+		if rest == "" {
+			if index < 0 {
+				return v.RelatedAnalytics, v.RelatedAnalytics != nil
+			}
+			return ocsf.Index(v.RelatedAnalytics, index)
+		}
+		if index < 0 || index >= len(v.RelatedAnalytics) {
+			return nil, false
+		}
+		return v.RelatedAnalytics[index].Get(rest)
+	case "reputation":
+		if index >= 0 || v.Reputation == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Reputation, true
+		}
+		return v.Reputation.Get(rest)
+	case "script":
+		if index >= 0 || v.Script == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Script, true
+		}
+		return v.Script.Get(rest)
+	case "signatures":
+		if rest == "" {
+			if index < 0 {
+				return v.Signatures, v.Signatures != nil
+			}
+			return ocsf.Index(v.Signatures, index)
+		}
+		if index < 0 || index >= len(v.Signatures) {
+			return nil, false
+		}
+		return v.Signatures[index].Get(rest)
+	case "src_url":
+		if index >= 0 || rest != "" || v.SrcUrl == nil {
+			return nil, false
+		}
+		return *v.SrcUrl, true
+	case "subdomains":
+		if rest == "" {
+			if index < 0 {
+				return v.Subdomains, v.Subdomains != nil
+			}
+			return ocsf.Index(v.Subdomains, index)
+		}
+		return nil, false
+	case "subnet":
+		if index >= 0 || rest != "" || v.Subnet == nil {
+			return nil, false
+		}
+		return *v.Subnet, true
+	case "tlp":
+		if index >= 0 || rest != "" || v.Tlp == nil {
+			return nil, false
+		}
+		return *v.Tlp, true
+	case "type":
+		if index >= 0 || rest != "" || v.Type == nil {
+			return nil, false
+		}
+		return *v.Type, true
+	case "type_id":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.TypeId, true
+	case "uid":
+		if index >= 0 || rest != "" || v.Uid == nil {
+			return nil, false
+		}
+		return *v.Uid, true
+	case "value":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.Value, true
+	case "vendor_name":
+		if index >= 0 || rest != "" || v.VendorName == nil {
+			return nil, false
+		}
+		return *v.VendorName, true
+	case "vulnerabilities":
+		if rest == "" {
+			if index < 0 {
+				return v.Vulnerabilities, v.Vulnerabilities != nil
+			}
+			return ocsf.Index(v.Vulnerabilities, index)
+		}
+		if index < 0 || index >= len(v.Vulnerabilities) {
+			return nil, false
+		}
+		return v.Vulnerabilities[index].Get(rest)
+	case "whois":
+		if index >= 0 || v.Whois == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Whois, true
+		}
+		return v.Whois.Get(rest)
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *OSINT) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "answers":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Answers, value)
+		}
+		elem, err := ocsf.Element(&v.Answers, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "attacks":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Attacks, value)
+		}
+		elem, err := ocsf.Element(&v.Attacks, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "autonomous_system":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.AutonomousSystem, value)
+		}
+		if v.AutonomousSystem == nil {
+			v.AutonomousSystem = &AutonomousSystem{}
+		}
+		return v.AutonomousSystem.Set(rest, value)
+	case "comment":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Comment, value)
+	case "confidence":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Confidence, value)
+	case "confidence_id":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.ConfidenceId, value)
+	case "email":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Email, value)
+		}
+		if v.Email == nil {
+			v.Email = &Email{}
+		}
+		return v.Email.Set(rest, value)
+	case "email_auth":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.EmailAuth, value)
+		}
+		if v.EmailAuth == nil {
+			v.EmailAuth = &EmailAuthentication{}
+		}
+		return v.EmailAuth.Set(rest, value)
+	case "file":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.File, value)
+		}
+		if v.File == nil {
+			v.File = &File{}
+		}
+		return v.File.Set(rest, value)
+	case "kill_chain":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.KillChain, value)
+		}
+		elem, err := ocsf.Element(&v.KillChain, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "location":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Location, value)
+		}
+		if v.Location == nil {
+			v.Location = &GeoLocation{}
+		}
+		return v.Location.Set(rest, value)
+	case "name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Name, value)
+	case "related_analytics":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.RelatedAnalytics, value)
+		}
+		elem, err := ocsf.Element(&v.RelatedAnalytics, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "reputation":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Reputation, value)
+		}
+		if v.Reputation == nil {
+			v.Reputation = &Reputation{}
+		}
+		return v.Reputation.Set(rest, value)
+	case "script":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Script, value)
+		}
+		if v.Script == nil {
+			v.Script = &Script{}
+		}
+		return v.Script.Set(rest, value)
+	case "signatures":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Signatures, value)
+		}
+		elem, err := ocsf.Element(&v.Signatures, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "src_url":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.SrcUrl, value)
+	case "subdomains":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Subdomains, value)
+		}
+		elem, err := ocsf.Element(&v.Subdomains, index)
+		if err != nil {
+			return err
+		}
+		if rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(elem, value)
+	case "subnet":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Subnet, value)
+	case "tlp":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Tlp, value)
+	case "type":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Type, value)
+	case "type_id":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.TypeId, value)
+	case "uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Uid, value)
+	case "value":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.Value, value)
+	case "vendor_name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.VendorName, value)
+	case "vulnerabilities":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Vulnerabilities, value)
+		}
+		elem, err := ocsf.Element(&v.Vulnerabilities, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "whois":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Whois, value)
+		}
+		if v.Whois == nil {
+			v.Whois = &WHOIS{}
+		}
+		return v.Whois.Set(rest, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *OSINT) AppendObservables(path string, observables []Observable) []Observable {
+	for i := range v.Answers {
+		observables = v.Answers[i].AppendObservables(ocsf.JoinPath(path, "answers"), observables)
+	}
+	for i := range v.Attacks {
+		observables = v.Attacks[i].AppendObservables(ocsf.JoinPath(path, "attacks"), observables)
+	}
+	if v.AutonomousSystem != nil {
+		observables = v.AutonomousSystem.AppendObservables(ocsf.JoinPath(path, "autonomous_system"), observables)
+	}
+	if v.Email != nil {
+		observables = v.Email.AppendObservables(ocsf.JoinPath(path, "email"), observables)
+	}
+	if v.EmailAuth != nil {
+		observables = v.EmailAuth.AppendObservables(ocsf.JoinPath(path, "email_auth"), observables)
+	}
+	if v.File != nil {
+		observables = v.File.AppendObservables(ocsf.JoinPath(path, "file"), observables)
+	}
+	for i := range v.KillChain {
+		observables = v.KillChain[i].AppendObservables(ocsf.JoinPath(path, "kill_chain"), observables)
+	}
+	if v.Location != nil {
+		observables = v.Location.AppendObservables(ocsf.JoinPath(path, "location"), observables)
+	}
+	for i := range v.RelatedAnalytics {
+		observables = v.RelatedAnalytics[i].AppendObservables(ocsf.JoinPath(path, "related_analytics"), observables)
+	}
+	if v.Reputation != nil {
+		observables = v.Reputation.AppendObservables(ocsf.JoinPath(path, "reputation"), observables)
+	}
+	if v.Script != nil {
+		observables = v.Script.AppendObservables(ocsf.JoinPath(path, "script"), observables)
+	}
+	for i := range v.Signatures {
+		observables = v.Signatures[i].AppendObservables(ocsf.JoinPath(path, "signatures"), observables)
+	}
+	for i := range v.Vulnerabilities {
+		observables = v.Vulnerabilities[i].AppendObservables(ocsf.JoinPath(path, "vulnerabilities"), observables)
+	}
+	if v.Whois != nil {
+		observables = v.Whois.AppendObservables(ocsf.JoinPath(path, "whois"), observables)
+	}
+
+	return observables
+}
+
 var OSINTFields = []arrow.Field{
-	{Name: "answers", Type: arrow.ListOf(DNSAnswerStruct), Nullable: true},
-	{Name: "attacks", Type: arrow.ListOf(MITREATTCKStruct), Nullable: true},
-	{Name: "autonomous_system", Type: AutonomousSystemStruct, Nullable: true},
-	{Name: "comment", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "confidence", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "confidence_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "email", Type: EmailStruct, Nullable: true},
-	{Name: "email_auth", Type: EmailAuthenticationStruct, Nullable: true},
-	{Name: "file", Type: FileStruct, Nullable: true},
-	{Name: "kill_chain", Type: arrow.ListOf(KillChainPhaseStruct), Nullable: true},
-	{Name: "location", Type: GeoLocationStruct, Nullable: true},
-	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "related_analytics", Type: arrow.ListOf(AnalyticStruct), Nullable: true},
-	{Name: "reputation", Type: ReputationStruct, Nullable: true},
-	{Name: "script", Type: ScriptStruct, Nullable: true},
-	{Name: "signatures", Type: arrow.ListOf(DigitalSignatureStruct), Nullable: true},
-	{Name: "src_url", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "subdomains", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true},
-	{Name: "subnet", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "tlp", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "type", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "type_id", Type: arrow.PrimitiveTypes.Int32, Nullable: false},
-	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "value", Type: arrow.BinaryTypes.String, Nullable: false},
-	{Name: "vendor_name", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "vulnerabilities", Type: arrow.ListOf(VulnerabilityDetailsStruct), Nullable: true},
-	{Name: "whois", Type: WHOISStruct, Nullable: true},
+	{Name: "answers", Type: arrow.ListOf(DNSAnswerStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("Related DNS Answers", "Any pertinent DNS answers information related to an indicator or OSINT analysis.", "optional", "")},
+	{Name: "attacks", Type: arrow.ListOf(MITREATTCKStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("MITRE ATT&CK® Details", "MITRE ATT&CK Tactics, Techniques, and/or Procedures (TTPs) pertinent to an indicator or OSINT analysis.", "optional", "")},
+	{Name: "autonomous_system", Type: AutonomousSystemStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Autonomous System", "Any pertinent autonomous system information related to an indicator or OSINT analysis.", "optional", "")},
+	{Name: "comment", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Analyst Comments", "Analyst commentary or source commentary about an indicator or OSINT analysis.", "optional", "")},
+	{Name: "confidence", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Confidence", "The confidence of an indicator being malicious and/or pertinent, normalized to the caption of the confidence_id value. In the case of 'Other', it is defined by the event source or analyst.", "optional", "")},
+	{Name: "confidence_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Confidence ID", "The normalized confidence refers to the accuracy of collected information related to the OSINT or how pertinent an indicator or analysis is to a specific event or finding. A low confidence means that the information collected or analysis conducted lacked detail or is not accurate enough to qualify an indicator as fully malicious.", "optional", "")},
+	{Name: "email", Type: EmailStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Related Email", "Any email information pertinent to an indicator or OSINT analysis.", "optional", "")},
+	{Name: "email_auth", Type: EmailAuthenticationStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Related Email Authentication", "Any email authentication information pertinent to an indicator or OSINT analysis.", "optional", "")},
+	{Name: "file", Type: FileStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Related File", "Any pertinent file information related to an indicator or OSINT analysis.", "optional", "")},
+	{Name: "kill_chain", Type: arrow.ListOf(KillChainPhaseStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("Kill Chain", "Lockheed Martin Kill Chain Phases pertinent to an indicator or OSINT analysis.", "optional", "")},
+	{Name: "location", Type: GeoLocationStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Geo Location", "Any pertinent geolocation information related to an indicator or OSINT analysis.", "optional", "")},
+	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Name", "The name of the entity.", "optional", "")},
+	{Name: "related_analytics", Type: arrow.ListOf(AnalyticStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("Related Analytics", "Any analytics related to an indicator or OSINT analysis.", "optional", "")},
+	{Name: "reputation", Type: ReputationStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Reputation Scores", "Related reputational analysis from third-party engines and analysts for a given indicator or OSINT analysis.", "optional", "")},
+	{Name: "script", Type: ScriptStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Related Script Data", "Any pertinent script information related to an indicator or OSINT analysis.", "optional", "")},
+	{Name: "signatures", Type: arrow.ListOf(DigitalSignatureStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("Related Digital Signatures", "Any digital signatures or hashes related to an indicator or OSINT analysis.", "optional", "")},
+	{Name: "src_url", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Source URL", "The source URL of an indicator or OSINT analysis, e.g., a URL back to a TIP, report, or otherwise.", "optional", "")},
+	{Name: "subdomains", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true, Metadata: ocsf.AttributeMetadata("Related Subdomains", "Any pertinent subdomain information - such as those generated by a Domain Generation Algorithm - related to an indicator or OSINT analysis.", "optional", "")},
+	{Name: "subnet", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Related Subnet", "A CIDR or network block related to an indicator or OSINT analysis.", "optional", "")},
+	{Name: "tlp", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Traffic Light Protocol", "The <a target='_blank' href='https://www.first.org/tlp/'>Traffic Light Protocol</a> was created to facilitate greater sharing of potentially sensitive information and more effective collaboration. TLP provides a simple and intuitive schema for indicating with whom potentially sensitive information can be shared.", "optional", "")},
+	{Name: "type", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Type", "The OSINT indicator type.", "optional", "")},
+	{Name: "type_id", Type: arrow.PrimitiveTypes.Int32, Nullable: false, Metadata: ocsf.AttributeMetadata("Indicator Type ID", "The OSINT indicator type ID.", "required", "")},
+	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Unique ID", "The unique identifier of the entity.", "optional", "")},
+	{Name: "value", Type: arrow.BinaryTypes.String, Nullable: false, Metadata: ocsf.AttributeMetadata("Indicator", "The actual indicator value in scope, e.g., a SHA-256 hash hexdigest or a domain name.", "required", "")},
+	{Name: "vendor_name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Vendor Name", "The vendor name of a tool which generates intelligence or provides indicators.", "optional", "")},
+	{Name: "vulnerabilities", Type: arrow.ListOf(VulnerabilityDetailsStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("Related Vulnerabilities", "Any vulnerabilities related to an indicator or OSINT analysis.", "optional", "")},
+	{Name: "whois", Type: WHOISStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("WHOIS", "Any pertinent WHOIS information related to an indicator or OSINT analysis.", "optional", "")},
 }
 
 var OSINTStruct = arrow.StructOf(OSINTFields...)
@@ -2,6 +2,7 @@
 package v1_4_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -48,19 +49,206 @@ func (v *SoftwarePackage) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *SoftwarePackage) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *SoftwarePackage) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	if v.Hash != nil {
+		errs = append(errs, v.Hash.ValidateAt(ocsf.JoinPath(path, "hash"))...)
+	}
+	if v.Name == "" {
+		errs.Add(ocsf.JoinPath(path, "name"), "required attribute is missing")
+	}
+	if v.Version == "" {
+		errs.Add(ocsf.JoinPath(path, "version"), "required attribute is missing")
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *SoftwarePackage) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "architecture":
+		if index >= 0 || rest != "" || v.Architecture == nil {
+			return nil, false
+		}
+		return *v.Architecture, true
+	case "cpe_name":
+		if index >= 0 || rest != "" || v.CpeName == nil {
+			return nil, false
+		}
+		return *v.CpeName, true
+	case "epoch":
+		if index >= 0 || rest != "" || v.Epoch == nil {
+			return nil, false
+		}
+		return *v.Epoch, true
+	case "hash":
+		if index >= 0 || v.Hash == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Hash, true
+		}
+		return v.Hash.Get(rest)
+	case "license":
+		if index >= 0 || rest != "" || v.License == nil {
+			return nil, false
+		}
+		return *v.License, true
+	case "name":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.Name, true
+	case "purl":
+		if index >= 0 || rest != "" || v.Purl == nil {
+			return nil, false
+		}
+		return *v.Purl, true
+	case "release":
+		if index >= 0 || rest != "" || v.Release == nil {
+			return nil, false
+		}
+		return *v.Release, true
+	case "type":
+		if index >= 0 || rest != "" || v.Type == nil {
+			return nil, false
+		}
+		return *v.Type, true
+	case "type_id":
+		if index >= 0 || rest != "" || v.TypeId == nil {
+			return nil, false
+		}
+		return *v.TypeId, true
+	case "vendor_name":
+		if index >= 0 || rest != "" || v.VendorName == nil {
+			return nil, false
+		}
+		return *v.VendorName, true
+	case "version":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.Version, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *SoftwarePackage) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "architecture":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Architecture, value)
+	case "cpe_name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.CpeName, value)
+	case "epoch":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Epoch, value)
+	case "hash":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Hash, value)
+		}
+		if v.Hash == nil {
+			v.Hash = &Fingerprint{}
+		}
+		return v.Hash.Set(rest, value)
+	case "license":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.License, value)
+	case "name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.Name, value)
+	case "purl":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Purl, value)
+	case "release":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Release, value)
+	case "type":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Type, value)
+	case "type_id":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.TypeId, value)
+	case "vendor_name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.VendorName, value)
+	case "version":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.Version, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *SoftwarePackage) AppendObservables(path string, observables []Observable) []Observable {
+	if v.Hash != nil {
+		observables = v.Hash.AppendObservables(ocsf.JoinPath(path, "hash"), observables)
+	}
+
+	return observables
+}
+
 var SoftwarePackageFields = []arrow.Field{
-	{Name: "architecture", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "cpe_name", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "epoch", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "hash", Type: FingerprintStruct, Nullable: true},
-	{Name: "license", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: false},
-	{Name: "purl", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "release", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "type", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "type_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "vendor_name", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "version", Type: arrow.BinaryTypes.String, Nullable: false},
+	{Name: "architecture", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Architecture", "Architecture is a shorthand name describing the type of computer hardware the packaged software is meant to run on.", "optional", "")},
+	{Name: "cpe_name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("The product CPE identifier", "The Common Platform Enumeration (CPE) name as described by (<a target='_blank' href='https://nvd.nist.gov/products/cpe'>NIST</a>) For example: <code>cpe:/a:apple:safari:16.2</code>.", "optional", "")},
+	{Name: "epoch", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Epoch", "The software package epoch. Epoch is a way to define weighted dependencies based on version numbers.", "optional", "")},
+	{Name: "hash", Type: FingerprintStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Hash", "Cryptographic hash to identify the binary instance of a software component. This can include any component such file, package, or library.", "optional", "")},
+	{Name: "license", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Software License", "The software license applied to this package.", "optional", "")},
+	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: false, Metadata: ocsf.AttributeMetadata("Name", "The software package name.", "required", "")},
+	{Name: "purl", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Package URL", "A purl is a URL string used to identify and locate a software package in a mostly universal and uniform way across programming languages, package managers, packaging conventions, tools, APIs and databases.", "optional", "")},
+	{Name: "release", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Software Release Details", "Release is the number of times a version of the software has been packaged.", "optional", "")},
+	{Name: "type", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Type", "The type of software package, normalized to the caption of the type_id value. In the case of 'Other', it is defined by the source.", "optional", "")},
+	{Name: "type_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Type ID", "The type of software package.", "optional", "")},
+	{Name: "vendor_name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Vendor Name", "The name of the vendor who published the software package.", "optional", "")},
+	{Name: "version", Type: arrow.BinaryTypes.String, Nullable: false, Metadata: ocsf.AttributeMetadata("Version", "The software package version.", "required", "")},
 }
 
 var SoftwarePackageStruct = arrow.StructOf(SoftwarePackageFields...)
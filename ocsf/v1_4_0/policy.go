@@ -2,6 +2,7 @@
 package v1_4_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -30,13 +31,134 @@ func (v *Policy) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *Policy) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *Policy) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	if v.Group != nil {
+		errs = append(errs, v.Group.ValidateAt(ocsf.JoinPath(path, "group"))...)
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *Policy) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "desc":
+		if index >= 0 || rest != "" || v.Desc == nil {
+			return nil, false
+		}
+		return *v.Desc, true
+	case "group":
+		if index >= 0 || v.Group == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Group, true
+		}
+		return v.Group.Get(rest)
+	case "is_applied":
+		if index >= 0 || rest != "" || v.IsApplied == nil {
+			return nil, false
+		}
+		return *v.IsApplied, true
+	case "name":
+		if index >= 0 || rest != "" || v.Name == nil {
+			return nil, false
+		}
+		return *v.Name, true
+	case "uid":
+		if index >= 0 || rest != "" || v.Uid == nil {
+			return nil, false
+		}
+		return *v.Uid, true
+	case "version":
+		if index >= 0 || rest != "" || v.Version == nil {
+			return nil, false
+		}
+		return *v.Version, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *Policy) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "desc":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Desc, value)
+	case "group":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Group, value)
+		}
+		if v.Group == nil {
+			v.Group = &Group{}
+		}
+		return v.Group.Set(rest, value)
+	case "is_applied":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.IsApplied, value)
+	case "name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Name, value)
+	case "uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Uid, value)
+	case "version":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Version, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *Policy) AppendObservables(path string, observables []Observable) []Observable {
+	if v.Group != nil {
+		observables = v.Group.AppendObservables(ocsf.JoinPath(path, "group"), observables)
+	}
+
+	return observables
+}
+
 var PolicyFields = []arrow.Field{
-	{Name: "desc", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "group", Type: GroupStruct, Nullable: true},
-	{Name: "is_applied", Type: arrow.FixedWidthTypes.Boolean, Nullable: true},
-	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "version", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "desc", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Description", "The description of the policy.", "optional", "")},
+	{Name: "group", Type: GroupStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Group", "The policy group.", "optional", "")},
+	{Name: "is_applied", Type: arrow.FixedWidthTypes.Boolean, Nullable: true, Metadata: ocsf.AttributeMetadata("Applied", "A determination if the content of a policy was applied to a target or request, or not.", "optional", "")},
+	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Name", "The policy name. For example: <code>IAM Policy</code>.", "optional", "")},
+	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Unique ID", "A unique identifier of the policy instance.", "optional", "")},
+	{Name: "version", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Version", "The policy version number.", "optional", "")},
 }
 
 var PolicyStruct = arrow.StructOf(PolicyFields...)
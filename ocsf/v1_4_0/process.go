@@ -2,6 +2,7 @@
 package v1_4_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -83,7 +84,7 @@ type Process struct {
 	WorkingDirectory *string `json:"working_directory,omitempty" parquet:"working_directory,optional"`
 
 	// Extended Attributes: An unordered collection of zero or more name/value pairs that represent a process extended attribute.
-	Xattributes *string `json:"xattributes,omitempty" parquet:"xattributes,optional"`
+	Xattributes *ocsf.RawJSON `json:"xattributes,omitempty" parquet:"xattributes,optional"`
 }
 
 func (v *Process) Observable() (*int, string) {
@@ -91,69 +92,510 @@ func (v *Process) Observable() (*int, string) {
 	return &typeId, "process"
 }
 
-var ProcessFields = []arrow.Field{
-	{Name: "ancestry", Type: arrow.ListOf(ProcessEntityStruct), Nullable: true},
-	{Name: "auid", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "cmd_line", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "container", Type: ContainerStruct, Nullable: true},
-	{Name: "created_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
-	{Name: "egid", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "environment_variables", Type: arrow.ListOf(EnvironmentVariableStruct), Nullable: true},
-	{Name: "euid", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "file", Type: FileStruct, Nullable: true},
-	{Name: "group", Type: GroupStruct, Nullable: true},
-	{Name: "integrity", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "integrity_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "loaded_modules", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true},
-	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "namespace_pid", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "parent_process", Type: ProcessRefStruct, Nullable: true},
-	{Name: "path", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "pid", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "sandbox", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "session", Type: SessionStruct, Nullable: true},
-	{Name: "terminated_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
-	{Name: "tid", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "user", Type: UserStruct, Nullable: true},
-	{Name: "working_directory", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "xattributes", Type: arrow.BinaryTypes.String, Nullable: true},
+func (v *Process) Validate() error {
+	return v.ValidateAt("").Err()
 }
 
-var ProcessStruct = arrow.StructOf(ProcessFields...)
-
-var ProcessSchema = arrow.NewSchema(ProcessFields, nil)
-var ProcessClassname = "process"
-var ProcessRefFields = []arrow.Field{
+func (v *Process) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	for i := range v.Ancestry {
+		errs = append(errs, v.Ancestry[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "ancestry"), i))...)
+	}
+	if v.Container != nil {
+		errs = append(errs, v.Container.ValidateAt(ocsf.JoinPath(path, "container"))...)
+	}
+	for i := range v.EnvironmentVariables {
+		errs = append(errs, v.EnvironmentVariables[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "environment_variables"), i))...)
+	}
+	if v.File != nil {
+		errs = append(errs, v.File.ValidateAt(ocsf.JoinPath(path, "file"))...)
+	}
+	if v.Group != nil {
+		errs = append(errs, v.Group.ValidateAt(ocsf.JoinPath(path, "group"))...)
+	}
+	if v.Session != nil {
+		errs = append(errs, v.Session.ValidateAt(ocsf.JoinPath(path, "session"))...)
+	}
+	if v.User != nil {
+		errs = append(errs, v.User.ValidateAt(ocsf.JoinPath(path, "user"))...)
+	}
+
+	return errs
+}
 
-	{Name: "auid", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "cmd_line", Type: arrow.BinaryTypes.String, Nullable: true},
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *Process) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "ancestry":
+		if rest == "" {
+			if index < 0 {
+				return v.Ancestry, v.Ancestry != nil
+			}
+			return ocsf.Index(v.Ancestry, index)
+		}
+		if index < 0 || index >= len(v.Ancestry) {
+			return nil, false
+		}
+		return v.Ancestry[index].Get(rest)
+	case "auid":
+		if index >= 0 || rest != "" || v.Auid == nil {
+			return nil, false
+		}
+		return *v.Auid, true
+	case "cmd_line":
+		if index >= 0 || rest != "" || v.CmdLine == nil {
+			return nil, false
+		}
+		return *v.CmdLine, true
+	case "container":
+		if index >= 0 || v.Container == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Container, true
+		}
+		return v.Container.Get(rest)
+	case "created_time":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.CreatedTime, true
+	case "egid":
+		if index >= 0 || rest != "" || v.Egid == nil {
+			return nil, false
+		}
+		return *v.Egid, true
+	case "environment_variables":
+		if rest == "" {
+			if index < 0 {
+				return v.EnvironmentVariables, v.EnvironmentVariables != nil
+			}
+			return ocsf.Index(v.EnvironmentVariables, index)
+		}
+		if index < 0 || index >= len(v.EnvironmentVariables) {
+			return nil, false
+		}
+		return v.EnvironmentVariables[index].Get(rest)
+	case "euid":
+		if index >= 0 || rest != "" || v.Euid == nil {
+			return nil, false
+		}
+		return *v.Euid, true
+	case "file":
+		if index >= 0 || v.File == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.File, true
+		}
+		return v.File.Get(rest)
+	case "group":
+		if index >= 0 || v.Group == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Group, true
+		}
+		return v.Group.Get(rest)
+	case "integrity":
+		if index >= 0 || rest != "" || v.Integrity == nil {
+			return nil, false
+		}
+		return *v.Integrity, true
+	case "integrity_id":
+		if index >= 0 || rest != "" || v.IntegrityId == nil {
+			return nil, false
+		}
+		return *v.IntegrityId, true
+	case "loaded_modules":
+		if rest == "" {
+			if index < 0 {
+				return v.LoadedModules, v.LoadedModules != nil
+			}
+			return ocsf.Index(v.LoadedModules, index)
+		}
+		return nil, false
+	case "name":
+		if index >= 0 || rest != "" || v.Name == nil {
+			return nil, false
+		}
+		return *v.Name, true
+	case "namespace_pid":
+		if index >= 0 || rest != "" || v.NamespacePid == nil {
+			return nil, false
+		}
+		return *v.NamespacePid, true
+	case "parent_process":
+		if index >= 0 || rest != "" || v.ParentProcess == nil {
+			return nil, false
+		}
+		return v.ParentProcess, true
+	case "path":
+		if index >= 0 || rest != "" || v.Path == nil {
+			return nil, false
+		}
+		return *v.Path, true
+	case "pid":
+		if index >= 0 || rest != "" || v.Pid == nil {
+			return nil, false
+		}
+		return *v.Pid, true
+	case "sandbox":
+		if index >= 0 || rest != "" || v.Sandbox == nil {
+			return nil, false
+		}
+		return *v.Sandbox, true
+	case "session":
+		if index >= 0 || v.Session == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Session, true
+		}
+		return v.Session.Get(rest)
+	case "terminated_time":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.TerminatedTime, true
+	case "tid":
+		if index >= 0 || rest != "" || v.Tid == nil {
+			return nil, false
+		}
+		return *v.Tid, true
+	case "uid":
+		if index >= 0 || rest != "" || v.Uid == nil {
+			return nil, false
+		}
+		return *v.Uid, true
+	case "user":
+		if index >= 0 || v.User == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.User, true
+		}
+		return v.User.Get(rest)
+	case "working_directory":
+		if index >= 0 || rest != "" || v.WorkingDirectory == nil {
+			return nil, false
+		}
+		return *v.WorkingDirectory, true
+	case "xattributes":
+		if index >= 0 || rest != "" || v.Xattributes == nil {
+			return nil, false
+		}
+		return *v.Xattributes, true
+	}
+
+	return nil, false
+}
 
-	{Name: "created_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
-	{Name: "egid", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *Process) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "ancestry":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Ancestry, value)
+		}
+		elem, err := ocsf.Element(&v.Ancestry, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "auid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Auid, value)
+	case "cmd_line":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.CmdLine, value)
+	case "container":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Container, value)
+		}
+		if v.Container == nil {
+			v.Container = &Container{}
+		}
+		return v.Container.Set(rest, value)
+	case "created_time":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.CreatedTime, value)
+	case "egid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Egid, value)
+	case "environment_variables":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.EnvironmentVariables, value)
+		}
+		elem, err := ocsf.Element(&v.EnvironmentVariables, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "euid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Euid, value)
+	case "file":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.File, value)
+		}
+		if v.File == nil {
+			v.File = &File{}
+		}
+		return v.File.Set(rest, value)
+	case "group":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Group, value)
+		}
+		if v.Group == nil {
+			v.Group = &Group{}
+		}
+		return v.Group.Set(rest, value)
+	case "integrity":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Integrity, value)
+	case "integrity_id":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.IntegrityId, value)
+	case "loaded_modules":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.LoadedModules, value)
+		}
+		elem, err := ocsf.Element(&v.LoadedModules, index)
+		if err != nil {
+			return err
+		}
+		if rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(elem, value)
+	case "name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Name, value)
+	case "namespace_pid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.NamespacePid, value)
+	case "parent_process":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.ParentProcess, value)
+	case "path":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Path, value)
+	case "pid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Pid, value)
+	case "sandbox":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Sandbox, value)
+	case "session":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Session, value)
+		}
+		if v.Session == nil {
+			v.Session = &Session{}
+		}
+		return v.Session.Set(rest, value)
+	case "terminated_time":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.TerminatedTime, value)
+	case "tid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Tid, value)
+	case "uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Uid, value)
+	case "user":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.User, value)
+		}
+		if v.User == nil {
+			v.User = &User{}
+		}
+		return v.User.Set(rest, value)
+	case "working_directory":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.WorkingDirectory, value)
+	case "xattributes":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Xattributes, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
 
-	{Name: "euid", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *Process) AppendObservables(path string, observables []Observable) []Observable {
+	if path != "" {
+		observables = append(observables, Observable{Name: ocsf.Ptr(path), TypeId: 25})
+	}
+	for i := range v.Ancestry {
+		observables = v.Ancestry[i].AppendObservables(ocsf.JoinPath(path, "ancestry"), observables)
+	}
+	if v.Container != nil {
+		observables = v.Container.AppendObservables(ocsf.JoinPath(path, "container"), observables)
+	}
+	for i := range v.EnvironmentVariables {
+		observables = v.EnvironmentVariables[i].AppendObservables(ocsf.JoinPath(path, "environment_variables"), observables)
+	}
+	if v.File != nil {
+		observables = v.File.AppendObservables(ocsf.JoinPath(path, "file"), observables)
+	}
+	if v.Group != nil {
+		observables = v.Group.AppendObservables(ocsf.JoinPath(path, "group"), observables)
+	}
+	if v.Name != nil {
+		observables = append(observables, Observable{Name: ocsf.Ptr(ocsf.JoinPath(path, "name")), TypeId: 9, Value: ocsf.Ptr(*v.Name)})
+	}
+	if v.Session != nil {
+		observables = v.Session.AppendObservables(ocsf.JoinPath(path, "session"), observables)
+	}
+	if v.User != nil {
+		observables = v.User.AppendObservables(ocsf.JoinPath(path, "user"), observables)
+	}
+
+	return observables
+}
 
-	{Name: "integrity", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "integrity_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "loaded_modules", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true},
-	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "namespace_pid", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
+var ProcessFields = []arrow.Field{
+	{Name: "ancestry", Type: arrow.ListOf(ProcessEntityStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("Ancestry", "An array of Process Entities describing the extended parentage of this process object. Direct parent information sould be expressed through the <code>parent_process</code> attribute. The first array element is the direct parent of this process object. Subsequent list elements go up the process parentage hierarchy. That is, the array is sorted from newest to oldest process. It is recommended to only populate this field for the top-level process object.", "optional", "")},
+	{Name: "auid", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Audit User ID", "The audit user assigned at login by the audit subsystem.", "optional", "")},
+	{Name: "cmd_line", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Command Line", "The full command line used to launch an application, service, process, or job. For example: <code>ssh user@10.0.0.10</code>. If the command line is unavailable or missing, the empty string <code>”</code> is to be used.", "optional", "")},
+	{Name: "container", Type: ContainerStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Container", "The information describing an instance of a container. A container is a prepackaged, portable system image that runs isolated on an existing system using a container runtime like containerd.", "optional", "")},
+	{Name: "created_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true, Metadata: ocsf.AttributeMetadata("Created Time", "The time when the process was created/started.", "optional", "")},
+	{Name: "egid", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Effective Group ID", "The effective group under which this process is running.", "optional", "")},
+	{Name: "environment_variables", Type: arrow.ListOf(EnvironmentVariableStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("Environment Variables", "Environment variables associated with the process.", "optional", "")},
+	{Name: "euid", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Effective User ID", "The effective user under which this process is running.", "optional", "")},
+	{Name: "file", Type: FileStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("File", "The process file object.", "optional", "")},
+	{Name: "group", Type: GroupStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Group", "The group under which this process is running.", "optional", "")},
+	{Name: "integrity", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Integrity", "The process integrity level, normalized to the caption of the integrity_id value. In the case of 'Other', it is defined by the event source (Windows only).", "optional", "")},
+	{Name: "integrity_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Integrity Level", "The normalized identifier of the process integrity level (Windows only).", "optional", "")},
+	{Name: "loaded_modules", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true, Metadata: ocsf.AttributeMetadata("Loaded Modules", "The list of loaded module names.", "optional", "")},
+	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Name", "The friendly name of the process, for example: <code>Notepad++</code>.", "optional", "")},
+	{Name: "namespace_pid", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Namespace PID", "If running under a process namespace (such as in a container), the process identifier within that process namespace.", "optional", "")},
+	{Name: "parent_process", Type: ProcessRefStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Parent Process", "The parent process of this process object. It is recommended to only populate this field for the top-level process object, to prevent deep nesting. Additional ancestry information can be supplied in the <code>ancestry</code> attribute.", "optional", "")},
+	{Name: "path", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Path", "The process file path.", "optional", "")},
+	{Name: "pid", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Process ID", "The process identifier, as reported by the operating system. Process ID (PID) is a number used by the operating system to uniquely identify an active process.", "optional", "")},
+	{Name: "sandbox", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Sandbox", "The name of the containment jail (i.e., sandbox). For example, hardened_ps, high_security_ps, oracle_ps, netsvcs_ps, or default_ps.", "optional", "")},
+	{Name: "session", Type: SessionStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Session", "The user session under which this process is running.", "optional", "")},
+	{Name: "terminated_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true, Metadata: ocsf.AttributeMetadata("Terminated Time", "The time when the process was terminated.", "optional", "")},
+	{Name: "tid", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Thread ID", "The Identifier of the thread associated with the event, as returned by the operating system.", "optional", "")},
+	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Unique ID", "A unique identifier for this process assigned by the producer (tool).  Facilitates correlation of a process event with other events for that process.", "optional", "")},
+	{Name: "user", Type: UserStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("User", "The user under which this process is running.", "optional", "")},
+	{Name: "working_directory", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Working Directory", "The working directory of a process.", "optional", "")},
+	{Name: "xattributes", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Extended Attributes", "An unordered collection of zero or more name/value pairs that represent a process extended attribute.", "optional", "")},
+}
 
-	{Name: "path", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "pid", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "sandbox", Type: arrow.BinaryTypes.String, Nullable: true},
+var ProcessStruct = arrow.StructOf(ProcessFields...)
 
-	{Name: "terminated_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
-	{Name: "tid", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true},
+var ProcessSchema = arrow.NewSchema(ProcessFields, nil)
+var ProcessClassname = "process"
 
-	{Name: "working_directory", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "xattributes", Type: arrow.BinaryTypes.String, Nullable: true},
+var ProcessRefFields = []arrow.Field{
+	{Name: "auid", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Audit User ID", "The audit user assigned at login by the audit subsystem.", "optional", "")},
+	{Name: "cmd_line", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Command Line", "The full command line used to launch an application, service, process, or job. For example: <code>ssh user@10.0.0.10</code>. If the command line is unavailable or missing, the empty string <code>”</code> is to be used.", "optional", "")},
+	{Name: "created_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true, Metadata: ocsf.AttributeMetadata("Created Time", "The time when the process was created/started.", "optional", "")},
+	{Name: "egid", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Effective Group ID", "The effective group under which this process is running.", "optional", "")},
+	{Name: "euid", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Effective User ID", "The effective user under which this process is running.", "optional", "")},
+	{Name: "integrity", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Integrity", "The process integrity level, normalized to the caption of the integrity_id value. In the case of 'Other', it is defined by the event source (Windows only).", "optional", "")},
+	{Name: "integrity_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Integrity Level", "The normalized identifier of the process integrity level (Windows only).", "optional", "")},
+	{Name: "loaded_modules", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true, Metadata: ocsf.AttributeMetadata("Loaded Modules", "The list of loaded module names.", "optional", "")},
+	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Name", "The friendly name of the process, for example: <code>Notepad++</code>.", "optional", "")},
+	{Name: "namespace_pid", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Namespace PID", "If running under a process namespace (such as in a container), the process identifier within that process namespace.", "optional", "")},
+	{Name: "path", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Path", "The process file path.", "optional", "")},
+	{Name: "pid", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Process ID", "The process identifier, as reported by the operating system. Process ID (PID) is a number used by the operating system to uniquely identify an active process.", "optional", "")},
+	{Name: "sandbox", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Sandbox", "The name of the containment jail (i.e., sandbox). For example, hardened_ps, high_security_ps, oracle_ps, netsvcs_ps, or default_ps.", "optional", "")},
+	{Name: "terminated_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true, Metadata: ocsf.AttributeMetadata("Terminated Time", "The time when the process was terminated.", "optional", "")},
+	{Name: "tid", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Thread ID", "The Identifier of the thread associated with the event, as returned by the operating system.", "optional", "")},
+	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Unique ID", "A unique identifier for this process assigned by the producer (tool).  Facilitates correlation of a process event with other events for that process.", "optional", "")},
+	{Name: "working_directory", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Working Directory", "The working directory of a process.", "optional", "")},
+	{Name: "xattributes", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Extended Attributes", "An unordered collection of zero or more name/value pairs that represent a process extended attribute.", "optional", "")},
 }
+
 var ProcessRefStruct = arrow.StructOf(ProcessRefFields...)
 
 type ProcessRef struct {
+
+	// Audit User ID: The audit user assigned at login by the audit subsystem.
 	Auid *int32 `json:"auid,omitempty" parquet:"auid,optional"`
 
 	// Command Line: The full command line used to launch an application, service, process, or job. For example: <code>ssh user@10.0.0.10</code>. If the command line is unavailable or missing, the empty string <code>”</code> is to be used.
@@ -162,10 +604,10 @@ type ProcessRef struct {
 	// Created Time: The time when the process was created/started.
 	CreatedTime int64 `json:"created_time,omitempty" parquet:"created_time,timestamp_millis,timestamp(millisecond),optional"`
 
+	// Effective Group ID: The effective group under which this process is running.
 	Egid *int32 `json:"egid,omitempty" parquet:"egid,optional"`
 
-	// Environment Variables: Environment variables associated with the process.
-
+	// Effective User ID: The effective user under which this process is running.
 	Euid *int32 `json:"euid,omitempty" parquet:"euid,optional"`
 
 	// Integrity: The process integrity level, normalized to the caption of the integrity_id value. In the case of 'Other', it is defined by the event source (Windows only).
@@ -186,6 +628,7 @@ type ProcessRef struct {
 	// Path: The process file path.
 	Path *string `json:"path,omitempty" parquet:"path,optional"`
 
+	// Process ID: The process identifier, as reported by the operating system. Process ID (PID) is a number used by the operating system to uniquely identify an active process.
 	Pid *int32 `json:"pid,omitempty" parquet:"pid,optional"`
 
 	// Sandbox: The name of the containment jail (i.e., sandbox). For example, hardened_ps, high_security_ps, oracle_ps, netsvcs_ps, or default_ps.
@@ -204,5 +647,5 @@ type ProcessRef struct {
 	WorkingDirectory *string `json:"working_directory,omitempty" parquet:"working_directory,optional"`
 
 	// Extended Attributes: An unordered collection of zero or more name/value pairs that represent a process extended attribute.
-	Xattributes *string `json:"xattributes,omitempty" parquet:"xattributes,optional"`
+	Xattributes *ocsf.RawJSON `json:"xattributes,omitempty" parquet:"xattributes,optional"`
 }
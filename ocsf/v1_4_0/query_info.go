@@ -2,6 +2,7 @@
 package v1_4_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -11,7 +12,7 @@ type QueryInformation struct {
 	Bytes *int64 `json:"bytes,omitempty" parquet:"bytes,optional"`
 
 	// Data: The data returned from the query execution.
-	Data *string `json:"data,omitempty" parquet:"data,optional"`
+	Data *ocsf.RawJSON `json:"data,omitempty" parquet:"data,optional"`
 
 	// Name: The query name for a saved or scheduled query.
 	Name *string `json:"name,omitempty" parquet:"name,optional"`
@@ -30,13 +31,122 @@ func (v *QueryInformation) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *QueryInformation) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *QueryInformation) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	if v.QueryString == "" {
+		errs.Add(ocsf.JoinPath(path, "query_string"), "required attribute is missing")
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *QueryInformation) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "bytes":
+		if index >= 0 || rest != "" || v.Bytes == nil {
+			return nil, false
+		}
+		return *v.Bytes, true
+	case "data":
+		if index >= 0 || rest != "" || v.Data == nil {
+			return nil, false
+		}
+		return *v.Data, true
+	case "name":
+		if index >= 0 || rest != "" || v.Name == nil {
+			return nil, false
+		}
+		return *v.Name, true
+	case "query_string":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.QueryString, true
+	case "query_time":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.QueryTime, true
+	case "uid":
+		if index >= 0 || rest != "" || v.Uid == nil {
+			return nil, false
+		}
+		return *v.Uid, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *QueryInformation) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "bytes":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Bytes, value)
+	case "data":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Data, value)
+	case "name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Name, value)
+	case "query_string":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.QueryString, value)
+	case "query_time":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.QueryTime, value)
+	case "uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Uid, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *QueryInformation) AppendObservables(path string, observables []Observable) []Observable {
+
+	return observables
+}
+
 var QueryInformationFields = []arrow.Field{
-	{Name: "bytes", Type: arrow.PrimitiveTypes.Int64, Nullable: true},
-	{Name: "data", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "query_string", Type: arrow.BinaryTypes.String, Nullable: false},
-	{Name: "query_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
-	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "bytes", Type: arrow.PrimitiveTypes.Int64, Nullable: true, Metadata: ocsf.AttributeMetadata("Total Bytes", "The size of the data returned from the query.", "optional", "")},
+	{Name: "data", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Data", "The data returned from the query execution.", "optional", "")},
+	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Name", "The query name for a saved or scheduled query.", "optional", "")},
+	{Name: "query_string", Type: arrow.BinaryTypes.String, Nullable: false, Metadata: ocsf.AttributeMetadata("Query String", "A string representing the query code being run. For example: <code>SELECT * FROM my_table</code>", "required", "")},
+	{Name: "query_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true, Metadata: ocsf.AttributeMetadata("Query Time", "The time when the query was run.", "optional", "")},
+	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Unique ID", "The unique identifier of the query.", "optional", "")},
 }
 
 var QueryInformationStruct = arrow.StructOf(QueryInformationFields...)
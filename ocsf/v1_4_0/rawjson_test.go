@@ -0,0 +1,56 @@
+package v1_4_0
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/parquet-go/parquet-go"
+)
+
+func TestUnmapped(t *testing.T) {
+	unmapped, err := ocsf.NewRawJSON(map[string]any{"eventID": "abc", "score": 7})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	activity := NewAPIActivity(APIActivityActivityIdRead)
+	activity.Unmapped = &unmapped
+
+	data, err := json.Marshal(activity)
+	if err != nil {
+		t.Fatalf("failed to encode activity: %v", err)
+	}
+	if !strings.Contains(string(data), `"unmapped":{"eventID":"abc","score":7}`) {
+		t.Errorf("got %s, want unmapped as a nested object", data)
+	}
+
+	var decoded APIActivity
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("failed to decode activity: %v", err)
+	}
+	object, err := decoded.Unmapped.Object()
+	if err != nil || object["eventID"] != "abc" {
+		t.Errorf("got unmapped %v, %v", object, err)
+	}
+
+	// Arrow and Parquet store the JSON encoding as a string column.
+	fields, _ := APIActivitySchema.FieldsByName("unmapped")
+	if len(fields) != 1 || fields[0].Type != arrow.BinaryTypes.String {
+		t.Errorf("got unmapped fields %v, want a string column", fields)
+	}
+
+	var buf bytes.Buffer
+	if err := parquet.Write(&buf, []APIActivity{activity}); err != nil {
+		t.Fatalf("failed to write parquet: %v", err)
+	}
+	rows, err := parquet.Read[APIActivity](bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("failed to read parquet: %v", err)
+	}
+	if *rows[0].Unmapped != unmapped {
+		t.Errorf("got parquet unmapped %s, want %s", *rows[0].Unmapped, unmapped)
+	}
+}
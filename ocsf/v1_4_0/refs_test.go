@@ -0,0 +1,51 @@
+package v1_4_0
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestRefs(t *testing.T) {
+	// The package is generated with reference structs one level deep, which drop their object
+	// attributes to cut the cycles of self-referencing objects.
+	var tests = []struct {
+		ref     reflect.Type
+		kept    string
+		dropped []string
+	}{
+		{ref: reflect.TypeFor[ProcessRef](), kept: "Name", dropped: []string{"ParentProcess", "File", "User"}},
+		{ref: reflect.TypeFor[LDAPPersonRef](), kept: "CostCenter", dropped: []string{"Manager", "Location"}},
+	}
+	for _, tt := range tests {
+		if _, ok := tt.ref.FieldByName(tt.kept); !ok {
+			t.Errorf("%s is missing %s", tt.ref.Name(), tt.kept)
+		}
+		for _, name := range tt.dropped {
+			if _, ok := tt.ref.FieldByName(name); ok {
+				t.Errorf("%s has %s, want the object attributes dropped", tt.ref.Name(), name)
+			}
+		}
+	}
+
+	// Decoding keeps the attributes of the parent process and drops its own parent.
+	var process Process
+	data := `{"name":"bash","parent_process":{"name":"sshd","pid":42,"parent_process":{"name":"systemd"}}}`
+	if err := json.Unmarshal([]byte(data), &process); err != nil {
+		t.Fatalf("failed to decode process: %v", err)
+	}
+	if process.ParentProcess == nil || *process.ParentProcess.Name != "sshd" || *process.ParentProcess.Pid != 42 {
+		t.Errorf("got parent process %+v, want sshd", process.ParentProcess)
+	}
+	encoded, err := json.Marshal(process.ParentProcess)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(encoded) != `{"name":"sshd","pid":42}` {
+		t.Errorf("got parent process %s, want its own parent dropped", encoded)
+	}
+	// Reference structs are read and set as a whole.
+	if got, ok := process.Get("parent_process"); !ok || got != process.ParentProcess {
+		t.Errorf("got parent_process %v, %v", got, ok)
+	}
+}
@@ -2,6 +2,7 @@
 package v1_4_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -25,11 +26,103 @@ func (v *RegistryKey) Observable() (*int, string) {
 	return &typeId, "reg_key"
 }
 
+func (v *RegistryKey) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *RegistryKey) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	if v.Path == "" {
+		errs.Add(ocsf.JoinPath(path, "path"), "required attribute is missing")
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *RegistryKey) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "is_system":
+		if index >= 0 || rest != "" || v.IsSystem == nil {
+			return nil, false
+		}
+		return *v.IsSystem, true
+	case "modified_time":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.ModifiedTime, true
+	case "path":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.Path, true
+	case "security_descriptor":
+		if index >= 0 || rest != "" || v.SecurityDescriptor == nil {
+			return nil, false
+		}
+		return *v.SecurityDescriptor, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *RegistryKey) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "is_system":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.IsSystem, value)
+	case "modified_time":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.ModifiedTime, value)
+	case "path":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.Path, value)
+	case "security_descriptor":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.SecurityDescriptor, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *RegistryKey) AppendObservables(path string, observables []Observable) []Observable {
+	if path != "" {
+		observables = append(observables, Observable{Name: ocsf.Ptr(path), TypeId: 28})
+	}
+
+	return observables
+}
+
 var RegistryKeyFields = []arrow.Field{
-	{Name: "is_system", Type: arrow.FixedWidthTypes.Boolean, Nullable: true},
-	{Name: "modified_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
-	{Name: "path", Type: arrow.BinaryTypes.String, Nullable: false},
-	{Name: "security_descriptor", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "is_system", Type: arrow.FixedWidthTypes.Boolean, Nullable: true, Metadata: ocsf.AttributeMetadata("System", "The indication of whether the object is part of the operating system.", "optional", "")},
+	{Name: "modified_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true, Metadata: ocsf.AttributeMetadata("Modified Time", "The time when the registry key was last modified.", "optional", "")},
+	{Name: "path", Type: arrow.BinaryTypes.String, Nullable: false, Metadata: ocsf.AttributeMetadata("Path", "The full path to the registry key.", "required", "")},
+	{Name: "security_descriptor", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Security Descriptor", "The security descriptor of the registry key.", "optional", "")},
 }
 
 var RegistryKeyStruct = arrow.StructOf(RegistryKeyFields...)
@@ -2,6 +2,7 @@
 package v1_4_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -11,7 +12,7 @@ type RequestElements struct {
 	Containers []Container `json:"containers,omitempty" parquet:"containers,list,optional"`
 
 	// Data: The additional data that is associated with the api request.
-	Data *string `json:"data,omitempty" parquet:"data,optional"`
+	Data *ocsf.RawJSON `json:"data,omitempty" parquet:"data,optional"`
 
 	// Flags: The communication flags that are associated with the api request.
 	Flags []string `json:"flags,omitempty" parquet:"flags,list,optional"`
@@ -24,11 +25,135 @@ func (v *RequestElements) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *RequestElements) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *RequestElements) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	for i := range v.Containers {
+		errs = append(errs, v.Containers[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "containers"), i))...)
+	}
+	if v.Uid == "" {
+		errs.Add(ocsf.JoinPath(path, "uid"), "required attribute is missing")
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *RequestElements) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "containers":
+		if rest == "" {
+			if index < 0 {
+				return v.Containers, v.Containers != nil
+			}
+			return ocsf.Index(v.Containers, index)
+		}
+		if index < 0 || index >= len(v.Containers) {
+			return nil, false
+		}
+		return v.Containers[index].Get(rest)
+	case "data":
+		if index >= 0 || rest != "" || v.Data == nil {
+			return nil, false
+		}
+		return *v.Data, true
+	case "flags":
+		if rest == "" {
+			if index < 0 {
+				return v.Flags, v.Flags != nil
+			}
+			return ocsf.Index(v.Flags, index)
+		}
+		return nil, false
+	case "uid":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.Uid, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *RequestElements) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "containers":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Containers, value)
+		}
+		elem, err := ocsf.Element(&v.Containers, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "data":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Data, value)
+	case "flags":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Flags, value)
+		}
+		elem, err := ocsf.Element(&v.Flags, index)
+		if err != nil {
+			return err
+		}
+		if rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(elem, value)
+	case "uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.Uid, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *RequestElements) AppendObservables(path string, observables []Observable) []Observable {
+	for i := range v.Containers {
+		observables = v.Containers[i].AppendObservables(ocsf.JoinPath(path, "containers"), observables)
+	}
+
+	return observables
+}
+
 var RequestElementsFields = []arrow.Field{
-	{Name: "containers", Type: arrow.ListOf(ContainerStruct), Nullable: true},
-	{Name: "data", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "flags", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true},
-	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: false},
+	{Name: "containers", Type: arrow.ListOf(ContainerStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("Containers", "When working with containerized applications, the set of containers which write to the standard the output of a particular logging driver. For example, this may be the set of containers involved in handling api requests and responses for a containerized application.", "optional", "")},
+	{Name: "data", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Data", "The additional data that is associated with the api request.", "optional", "")},
+	{Name: "flags", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true, Metadata: ocsf.AttributeMetadata("Flags", "The communication flags that are associated with the api request.", "optional", "")},
+	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: false, Metadata: ocsf.AttributeMetadata("Unique ID", "The unique request identifier.", "required", "")},
 }
 
 var RequestElementsStruct = arrow.StructOf(RequestElementsFields...)
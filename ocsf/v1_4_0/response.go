@@ -2,6 +2,7 @@
 package v1_4_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -14,7 +15,7 @@ type ResponseElements struct {
 	Containers []Container `json:"containers,omitempty" parquet:"containers,list,optional"`
 
 	// Data: The additional data that is associated with the api response.
-	Data *string `json:"data,omitempty" parquet:"data,optional"`
+	Data *ocsf.RawJSON `json:"data,omitempty" parquet:"data,optional"`
 
 	// Error Code: Error Code
 	Error *string `json:"error,omitempty" parquet:"error,optional"`
@@ -33,14 +34,165 @@ func (v *ResponseElements) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *ResponseElements) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *ResponseElements) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	for i := range v.Containers {
+		errs = append(errs, v.Containers[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "containers"), i))...)
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *ResponseElements) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "code":
+		if index >= 0 || rest != "" || v.Code == nil {
+			return nil, false
+		}
+		return *v.Code, true
+	case "containers":
+		if rest == "" {
+			if index < 0 {
+				return v.Containers, v.Containers != nil
+			}
+			return ocsf.Index(v.Containers, index)
+		}
+		if index < 0 || index >= len(v.Containers) {
+			return nil, false
+		}
+		return v.Containers[index].Get(rest)
+	case "data":
+		if index >= 0 || rest != "" || v.Data == nil {
+			return nil, false
+		}
+		return *v.Data, true
+	case "error":
+		if index >= 0 || rest != "" || v.Error == nil {
+			return nil, false
+		}
+		return *v.Error, true
+	case "error_message":
+		if index >= 0 || rest != "" || v.ErrorMessage == nil {
+			return nil, false
+		}
+		return *v.ErrorMessage, true
+	case "flags":
+		if rest == "" {
+			if index < 0 {
+				return v.Flags, v.Flags != nil
+			}
+			return ocsf.Index(v.Flags, index)
+		}
+		return nil, false
+	case "message":
+		if index >= 0 || rest != "" || v.Message == nil {
+			return nil, false
+		}
+		return *v.Message, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *ResponseElements) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "code":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Code, value)
+	case "containers":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Containers, value)
+		}
+		elem, err := ocsf.Element(&v.Containers, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "data":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Data, value)
+	case "error":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Error, value)
+	case "error_message":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.ErrorMessage, value)
+	case "flags":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Flags, value)
+		}
+		elem, err := ocsf.Element(&v.Flags, index)
+		if err != nil {
+			return err
+		}
+		if rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(elem, value)
+	case "message":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Message, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *ResponseElements) AppendObservables(path string, observables []Observable) []Observable {
+	for i := range v.Containers {
+		observables = v.Containers[i].AppendObservables(ocsf.JoinPath(path, "containers"), observables)
+	}
+
+	return observables
+}
+
 var ResponseElementsFields = []arrow.Field{
-	{Name: "code", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "containers", Type: arrow.ListOf(ContainerStruct), Nullable: true},
-	{Name: "data", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "error", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "error_message", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "flags", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true},
-	{Name: "message", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "code", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Response Code", "The numeric response sent to a request.", "optional", "")},
+	{Name: "containers", Type: arrow.ListOf(ContainerStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("Containers", "When working with containerized applications, the set of containers which write to the standard the output of a particular logging driver. For example, this may be the set of containers involved in handling api requests and responses for a containerized application.", "optional", "")},
+	{Name: "data", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Data", "The additional data that is associated with the api response.", "optional", "")},
+	{Name: "error", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Error Code", "Error Code", "optional", "")},
+	{Name: "error_message", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Error Message", "Error Message", "optional", "")},
+	{Name: "flags", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true, Metadata: ocsf.AttributeMetadata("Flags", "The communication flags that are associated with the api response.", "optional", "")},
+	{Name: "message", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Message", "The description of the event/finding, as defined by the source.", "optional", "")},
 }
 
 var ResponseElementsStruct = arrow.StructOf(ResponseElementsFields...)
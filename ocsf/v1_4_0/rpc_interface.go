@@ -2,6 +2,7 @@
 package v1_4_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -24,11 +25,103 @@ func (v *RPCInterface) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *RPCInterface) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *RPCInterface) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	if v.Uuid == "" {
+		errs.Add(ocsf.JoinPath(path, "uuid"), "required attribute is missing")
+	}
+	if v.Version == "" {
+		errs.Add(ocsf.JoinPath(path, "version"), "required attribute is missing")
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *RPCInterface) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "ack_reason":
+		if index >= 0 || rest != "" || v.AckReason == nil {
+			return nil, false
+		}
+		return *v.AckReason, true
+	case "ack_result":
+		if index >= 0 || rest != "" || v.AckResult == nil {
+			return nil, false
+		}
+		return *v.AckResult, true
+	case "uuid":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.Uuid, true
+	case "version":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.Version, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *RPCInterface) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "ack_reason":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.AckReason, value)
+	case "ack_result":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.AckResult, value)
+	case "uuid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.Uuid, value)
+	case "version":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.Version, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *RPCInterface) AppendObservables(path string, observables []Observable) []Observable {
+
+	return observables
+}
+
 var RPCInterfaceFields = []arrow.Field{
-	{Name: "ack_reason", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "ack_result", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "uuid", Type: arrow.BinaryTypes.String, Nullable: false},
-	{Name: "version", Type: arrow.BinaryTypes.String, Nullable: false},
+	{Name: "ack_reason", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Acknowledgement Reason", "An integer that provides a reason code or additional information about the acknowledgment result.", "optional", "")},
+	{Name: "ack_result", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Acknowledgement Result", "An integer that denotes the acknowledgment result of the DCE/RPC call.", "optional", "")},
+	{Name: "uuid", Type: arrow.BinaryTypes.String, Nullable: false, Metadata: ocsf.AttributeMetadata("UUID", "The unique identifier of the particular remote procedure or service.", "required", "")},
+	{Name: "version", Type: arrow.BinaryTypes.String, Nullable: false, Metadata: ocsf.AttributeMetadata("Version", "The version of the DCE/RPC protocol being used in the session.", "required", "")},
 }
 
 var RPCInterfaceStruct = arrow.StructOf(RPCInterfaceFields...)
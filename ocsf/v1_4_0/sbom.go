@@ -2,6 +2,7 @@
 package v1_4_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -24,11 +25,145 @@ func (v *SoftwareBillofMaterials) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *SoftwareBillofMaterials) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *SoftwareBillofMaterials) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	errs = append(errs, v.Package.ValidateAt(ocsf.JoinPath(path, "package"))...)
+	if v.Product != nil {
+		errs = append(errs, v.Product.ValidateAt(ocsf.JoinPath(path, "product"))...)
+	}
+	if len(v.SoftwareComponents) == 0 {
+		errs.Add(ocsf.JoinPath(path, "software_components"), "required attribute is missing")
+	}
+	for i := range v.SoftwareComponents {
+		errs = append(errs, v.SoftwareComponents[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "software_components"), i))...)
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *SoftwareBillofMaterials) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "created_time":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.CreatedTime, true
+	case "package":
+		if index >= 0 {
+			return nil, false
+		}
+		if rest == "" {
+			return &v.Package, true
+		}
+		return v.Package.Get(rest)
+	case "product":
+		if index >= 0 || v.Product == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Product, true
+		}
+		return v.Product.Get(rest)
+	case "software_components":
+		if rest == "" {
+			if index < 0 {
+				return v.SoftwareComponents, v.SoftwareComponents != nil
+			}
+			return ocsf.Index(v.SoftwareComponents, index)
+		}
+		if index < 0 || index >= len(v.SoftwareComponents) {
+			return nil, false
+		}
+		return v.SoftwareComponents[index].Get(rest)
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *SoftwareBillofMaterials) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "created_time":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.CreatedTime, value)
+	case "package":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetRequired(&v.Package, value)
+		}
+		return v.Package.Set(rest, value)
+	case "product":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Product, value)
+		}
+		if v.Product == nil {
+			v.Product = &Product{}
+		}
+		return v.Product.Set(rest, value)
+	case "software_components":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.SoftwareComponents, value)
+		}
+		elem, err := ocsf.Element(&v.SoftwareComponents, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *SoftwareBillofMaterials) AppendObservables(path string, observables []Observable) []Observable {
+	observables = v.Package.AppendObservables(ocsf.JoinPath(path, "package"), observables)
+	if v.Product != nil {
+		observables = v.Product.AppendObservables(ocsf.JoinPath(path, "product"), observables)
+	}
+	for i := range v.SoftwareComponents {
+		observables = v.SoftwareComponents[i].AppendObservables(ocsf.JoinPath(path, "software_components"), observables)
+	}
+
+	return observables
+}
+
 var SoftwareBillofMaterialsFields = []arrow.Field{
-	{Name: "created_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
-	{Name: "package", Type: SoftwarePackageStruct, Nullable: false},
-	{Name: "product", Type: ProductStruct, Nullable: true},
-	{Name: "software_components", Type: arrow.ListOf(SoftwareComponentStruct), Nullable: false},
+	{Name: "created_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true, Metadata: ocsf.AttributeMetadata("Created Time", "The time when the SBOM was created.", "optional", "")},
+	{Name: "package", Type: SoftwarePackageStruct, Nullable: false, Metadata: ocsf.AttributeMetadata("Software Package", "The device software that is being discovered by an inventory process.", "required", "")},
+	{Name: "product", Type: ProductStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Product", "The product that generated the SBOM e.g. cdxgen or Syft.", "optional", "")},
+	{Name: "software_components", Type: arrow.ListOf(SoftwareComponentStruct), Nullable: false, Metadata: ocsf.AttributeMetadata("Software Components", "The list of software components used in the software package.", "required", "")},
 }
 
 var SoftwareBillofMaterialsStruct = arrow.StructOf(SoftwareBillofMaterialsFields...)
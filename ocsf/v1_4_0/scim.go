@@ -2,6 +2,7 @@
 package v1_4_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -72,27 +73,276 @@ func (v *SCIM) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *SCIM) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *SCIM) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *SCIM) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "auth_protocol":
+		if index >= 0 || rest != "" || v.AuthProtocol == nil {
+			return nil, false
+		}
+		return *v.AuthProtocol, true
+	case "auth_protocol_id":
+		if index >= 0 || rest != "" || v.AuthProtocolId == nil {
+			return nil, false
+		}
+		return *v.AuthProtocolId, true
+	case "created_time":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.CreatedTime, true
+	case "error_message":
+		if index >= 0 || rest != "" || v.ErrorMessage == nil {
+			return nil, false
+		}
+		return *v.ErrorMessage, true
+	case "is_group_provisioning_enabled":
+		if index >= 0 || rest != "" || v.IsGroupProvisioningEnabled == nil {
+			return nil, false
+		}
+		return *v.IsGroupProvisioningEnabled, true
+	case "is_user_provisioning_enabled":
+		if index >= 0 || rest != "" || v.IsUserProvisioningEnabled == nil {
+			return nil, false
+		}
+		return *v.IsUserProvisioningEnabled, true
+	case "last_run_time":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.LastRunTime, true
+	case "modified_time":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.ModifiedTime, true
+	case "name":
+		if index >= 0 || rest != "" || v.Name == nil {
+			return nil, false
+		}
+		return *v.Name, true
+	case "protocol_name":
+		if index >= 0 || rest != "" || v.ProtocolName == nil {
+			return nil, false
+		}
+		return *v.ProtocolName, true
+	case "rate_limit":
+		if index >= 0 || rest != "" || v.RateLimit == nil {
+			return nil, false
+		}
+		return *v.RateLimit, true
+	case "scim_group_schema":
+		if index >= 0 || rest != "" || v.ScimGroupSchema == nil {
+			return nil, false
+		}
+		return *v.ScimGroupSchema, true
+	case "scim_user_schema":
+		if index >= 0 || rest != "" || v.ScimUserSchema == nil {
+			return nil, false
+		}
+		return *v.ScimUserSchema, true
+	case "state":
+		if index >= 0 || rest != "" || v.State == nil {
+			return nil, false
+		}
+		return *v.State, true
+	case "state_id":
+		if index >= 0 || rest != "" || v.StateId == nil {
+			return nil, false
+		}
+		return *v.StateId, true
+	case "uid":
+		if index >= 0 || rest != "" || v.Uid == nil {
+			return nil, false
+		}
+		return *v.Uid, true
+	case "uid_alt":
+		if index >= 0 || rest != "" || v.UidAlt == nil {
+			return nil, false
+		}
+		return *v.UidAlt, true
+	case "url_string":
+		if index >= 0 || rest != "" || v.UrlString == nil {
+			return nil, false
+		}
+		return *v.UrlString, true
+	case "vendor_name":
+		if index >= 0 || rest != "" || v.VendorName == nil {
+			return nil, false
+		}
+		return *v.VendorName, true
+	case "version":
+		if index >= 0 || rest != "" || v.Version == nil {
+			return nil, false
+		}
+		return *v.Version, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *SCIM) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "auth_protocol":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.AuthProtocol, value)
+	case "auth_protocol_id":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.AuthProtocolId, value)
+	case "created_time":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.CreatedTime, value)
+	case "error_message":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.ErrorMessage, value)
+	case "is_group_provisioning_enabled":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.IsGroupProvisioningEnabled, value)
+	case "is_user_provisioning_enabled":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.IsUserProvisioningEnabled, value)
+	case "last_run_time":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.LastRunTime, value)
+	case "modified_time":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.ModifiedTime, value)
+	case "name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Name, value)
+	case "protocol_name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.ProtocolName, value)
+	case "rate_limit":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.RateLimit, value)
+	case "scim_group_schema":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.ScimGroupSchema, value)
+	case "scim_user_schema":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.ScimUserSchema, value)
+	case "state":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.State, value)
+	case "state_id":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.StateId, value)
+	case "uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Uid, value)
+	case "uid_alt":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.UidAlt, value)
+	case "url_string":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.UrlString, value)
+	case "vendor_name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.VendorName, value)
+	case "version":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Version, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *SCIM) AppendObservables(path string, observables []Observable) []Observable {
+	if v.UrlString != nil {
+		observables = append(observables, Observable{Name: ocsf.Ptr(ocsf.JoinPath(path, "url_string")), TypeId: 6, Value: ocsf.Ptr(*v.UrlString)})
+	}
+
+	return observables
+}
+
 var SCIMFields = []arrow.Field{
-	{Name: "auth_protocol", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "auth_protocol_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "created_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
-	{Name: "error_message", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "is_group_provisioning_enabled", Type: arrow.FixedWidthTypes.Boolean, Nullable: true},
-	{Name: "is_user_provisioning_enabled", Type: arrow.FixedWidthTypes.Boolean, Nullable: true},
-	{Name: "last_run_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
-	{Name: "modified_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
-	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "protocol_name", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "rate_limit", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "scim_group_schema", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "scim_user_schema", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "state", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "state_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "uid_alt", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "url_string", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "vendor_name", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "version", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "auth_protocol", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Auth Protocol", "The authorization protocol as defined by the caption of <code>auth_protocol_id</code>. In the case of <code>Other</code>, it is defined by the event source.", "optional", "")},
+	{Name: "auth_protocol_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Auth Protocol ID", "The normalized identifier of the authorization protocol used by the SCIM resource.", "optional", "")},
+	{Name: "created_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true, Metadata: ocsf.AttributeMetadata("Created Time", "When the SCIM resource was added to the service provider.", "optional", "")},
+	{Name: "error_message", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Last Error Message", "Message or code associated with the last encountered error.", "optional", "")},
+	{Name: "is_group_provisioning_enabled", Type: arrow.FixedWidthTypes.Boolean, Nullable: true, Metadata: ocsf.AttributeMetadata("SCIM Group Provisioning Enabled", "Indicates whether the SCIM resource is configured to provision groups, automatically or otherwise.", "optional", "")},
+	{Name: "is_user_provisioning_enabled", Type: arrow.FixedWidthTypes.Boolean, Nullable: true, Metadata: ocsf.AttributeMetadata("SCIM User Provisioning Enabled", "Indicates whether the SCIM resource is configured to provision users, automatically or otherwise.", "optional", "")},
+	{Name: "last_run_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true, Metadata: ocsf.AttributeMetadata("Last Sync Time", "Timestamp of the most recent successful synchronization.", "optional", "")},
+	{Name: "modified_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true, Metadata: ocsf.AttributeMetadata("Modified Time", "The most recent time when the SCIM resource was updated at the service provider.", "optional", "")},
+	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Name", "The name of the SCIM resource.", "optional", "")},
+	{Name: "protocol_name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Supported Protocol", "The supported protocol for the SCIM resource. E.g., <code>SAML</code>, <code>OIDC</code>, or <code>OAuth2</code>.", "optional", "")},
+	{Name: "rate_limit", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Rate Limit", "Maximum number of requests allowed by the SCIM resource within a specified time frame to avoid throttling.", "optional", "")},
+	{Name: "scim_group_schema", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("SCIM Group Schema", "SCIM provides a schema for representing groups, identified using the following schema URI: <code>urn:ietf:params:scim:schemas:core:2.0:Group</code> as defined in <a target='_blank' href='https://datatracker.ietf.org/doc/html/rfc7643'>RFC-7634</a>. This attribute will capture key-value pairs for the scheme implemented in a SCIM resource.", "optional", "")},
+	{Name: "scim_user_schema", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("SCIM User Schema", "SCIM provides a resource type for user resources. The core schema for user is identified using the following schema URI: <code>urn:ietf:params:scim:schemas:core:2.0:User</code> as defined in <a target='_blank' href='https://datatracker.ietf.org/doc/html/rfc7643'>RFC-7634</a>. his attribute will capture key-value pairs for the scheme implemented in a SCIM resource. This object is inclusive of both the basic and Enterprise User Schema Extension.", "optional", "")},
+	{Name: "state", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("State", "The provisioning state of the SCIM resource, normalized to the caption of the <code>state_id</code> value. In the case of <code>Other</code>, it is defined by the event source.", "optional", "")},
+	{Name: "state_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("State ID", "The normalized state ID of the SCIM resource to reflect its activation status.", "optional", "")},
+	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Unique ID", "A unique identifier for a SCIM resource as defined by the service provider.", "optional", "")},
+	{Name: "uid_alt", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("External ID", "A String that is an identifier for the resource as defined by the provisioning client. The <code>externalId</code> may simplify identification of a resource between the provisioning client and the service provider by allowing the client to use a filter to locate the resource with an identifier from the provisioning domain, obviating the need to store a local mapping between the provisioning domain's identifier of the resource and the identifier used by the service provider.", "optional", "")},
+	{Name: "url_string", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("SCIM Endpoint URL", "The primary URL for SCIM API requests.", "optional", "")},
+	{Name: "vendor_name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Service Provider", "Name of the vendor or service provider implementing SCIM. E.g., <code>Okta</code>, <code>Auth0</code>, <code>Microsoft</code>.", "optional", "")},
+	{Name: "version", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("SCIM Version", "SCIM protocol version supported e.g., <code>SCIM 2.0</code>.", "optional", "")},
 }
 
 var SCIMStruct = arrow.StructOf(SCIMFields...)
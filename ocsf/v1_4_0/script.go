@@ -2,6 +2,7 @@
 package v1_4_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -36,15 +37,186 @@ func (v *Script) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *Script) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *Script) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	if v.File != nil {
+		errs = append(errs, v.File.ValidateAt(ocsf.JoinPath(path, "file"))...)
+	}
+	for i := range v.Hashes {
+		errs = append(errs, v.Hashes[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "hashes"), i))...)
+	}
+	errs = append(errs, v.ScriptContent.ValidateAt(ocsf.JoinPath(path, "script_content"))...)
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *Script) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "file":
+		if index >= 0 || v.File == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.File, true
+		}
+		return v.File.Get(rest)
+	case "hashes":
+		if rest == "" {
+			if index < 0 {
+				return v.Hashes, v.Hashes != nil
+			}
+			return ocsf.Index(v.Hashes, index)
+		}
+		if index < 0 || index >= len(v.Hashes) {
+			return nil, false
+		}
+		return v.Hashes[index].Get(rest)
+	case "name":
+		if index >= 0 || rest != "" || v.Name == nil {
+			return nil, false
+		}
+		return *v.Name, true
+	case "parent_uid":
+		if index >= 0 || rest != "" || v.ParentUid == nil {
+			return nil, false
+		}
+		return *v.ParentUid, true
+	case "script_content":
+		if index >= 0 {
+			return nil, false
+		}
+		if rest == "" {
+			return &v.ScriptContent, true
+		}
+		return v.ScriptContent.Get(rest)
+	case "type":
+		if index >= 0 || rest != "" || v.Type == nil {
+			return nil, false
+		}
+		return *v.Type, true
+	case "type_id":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.TypeId, true
+	case "uid":
+		if index >= 0 || rest != "" || v.Uid == nil {
+			return nil, false
+		}
+		return *v.Uid, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *Script) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "file":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.File, value)
+		}
+		if v.File == nil {
+			v.File = &File{}
+		}
+		return v.File.Set(rest, value)
+	case "hashes":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Hashes, value)
+		}
+		elem, err := ocsf.Element(&v.Hashes, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Name, value)
+	case "parent_uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.ParentUid, value)
+	case "script_content":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetRequired(&v.ScriptContent, value)
+		}
+		return v.ScriptContent.Set(rest, value)
+	case "type":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Type, value)
+	case "type_id":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.TypeId, value)
+	case "uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Uid, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *Script) AppendObservables(path string, observables []Observable) []Observable {
+	if v.File != nil {
+		observables = v.File.AppendObservables(ocsf.JoinPath(path, "file"), observables)
+	}
+	for i := range v.Hashes {
+		observables = v.Hashes[i].AppendObservables(ocsf.JoinPath(path, "hashes"), observables)
+	}
+	observables = v.ScriptContent.AppendObservables(ocsf.JoinPath(path, "script_content"), observables)
+
+	return observables
+}
+
 var ScriptFields = []arrow.Field{
-	{Name: "file", Type: FileStruct, Nullable: true},
-	{Name: "hashes", Type: arrow.ListOf(FingerprintStruct), Nullable: true},
-	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "parent_uid", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "script_content", Type: LongStringStruct, Nullable: false},
-	{Name: "type", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "type_id", Type: arrow.PrimitiveTypes.Int32, Nullable: false},
-	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "file", Type: FileStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("File", "Present if this script is associated with a file. Not present in the case of a file-less script.", "optional", "")},
+	{Name: "hashes", Type: arrow.ListOf(FingerprintStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("Hashes", "An array of the script's cryptographic hashes. Note that these hashes are calculated on the script in its original encoding, and not on the normalized UTF-8 encoding found in the <code>script_content</code> attribute.", "optional", "")},
+	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Name", "Unique identifier for the script or macro, independent of the containing file, used for tracking, auditing, and security analysis.", "optional", "")},
+	{Name: "parent_uid", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Parent Unique ID", "This attribute relates a sub-script to a parent script having the matching <code>uid</code> attribute. In the case of PowerShell, sub-script execution can be identified by matching the activity correlation ID of the raw ETW events provided by the OS.", "optional", "")},
+	{Name: "script_content", Type: LongStringStruct, Nullable: false, Metadata: ocsf.AttributeMetadata("Script Content", "The script content, normalized to UTF-8 encoding irrespective of its original encoding. When emitting this attribute, it may be appropriate to truncate large scripts. When consuming this attribute, large scripts should be anticipated.", "required", "")},
+	{Name: "type", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Type", "The script type, normalized to the caption of the <code>type_id</code> value. In the case of 'Other', it is defined by the event source.", "optional", "")},
+	{Name: "type_id", Type: arrow.PrimitiveTypes.Int32, Nullable: false, Metadata: ocsf.AttributeMetadata("Type ID", "The normalized script type ID.", "required", "")},
+	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Unique ID", "Some script engines assign a unique ID to each individual execution of a given script. This attribute captures that unique ID. In the case of PowerShell, the unique ID corresponds to the <code>ScriptBlockId</code> in the raw ETW events provided by the OS.", "optional", "")},
 }
 
 var ScriptStruct = arrow.StructOf(ScriptFields...)
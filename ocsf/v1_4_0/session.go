@@ -2,6 +2,7 @@
 package v1_4_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -51,20 +52,196 @@ func (v *Session) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *Session) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *Session) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *Session) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "count":
+		if index >= 0 || rest != "" || v.Count == nil {
+			return nil, false
+		}
+		return *v.Count, true
+	case "created_time":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.CreatedTime, true
+	case "credential_uid":
+		if index >= 0 || rest != "" || v.CredentialUid == nil {
+			return nil, false
+		}
+		return *v.CredentialUid, true
+	case "expiration_reason":
+		if index >= 0 || rest != "" || v.ExpirationReason == nil {
+			return nil, false
+		}
+		return *v.ExpirationReason, true
+	case "expiration_time":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.ExpirationTime, true
+	case "is_mfa":
+		if index >= 0 || rest != "" || v.IsMfa == nil {
+			return nil, false
+		}
+		return *v.IsMfa, true
+	case "is_remote":
+		if index >= 0 || rest != "" || v.IsRemote == nil {
+			return nil, false
+		}
+		return *v.IsRemote, true
+	case "is_vpn":
+		if index >= 0 || rest != "" || v.IsVpn == nil {
+			return nil, false
+		}
+		return *v.IsVpn, true
+	case "issuer":
+		if index >= 0 || rest != "" || v.Issuer == nil {
+			return nil, false
+		}
+		return *v.Issuer, true
+	case "terminal":
+		if index >= 0 || rest != "" || v.Terminal == nil {
+			return nil, false
+		}
+		return *v.Terminal, true
+	case "uid":
+		if index >= 0 || rest != "" || v.Uid == nil {
+			return nil, false
+		}
+		return *v.Uid, true
+	case "uid_alt":
+		if index >= 0 || rest != "" || v.UidAlt == nil {
+			return nil, false
+		}
+		return *v.UidAlt, true
+	case "uuid":
+		if index >= 0 || rest != "" || v.Uuid == nil {
+			return nil, false
+		}
+		return *v.Uuid, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *Session) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "count":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Count, value)
+	case "created_time":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.CreatedTime, value)
+	case "credential_uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.CredentialUid, value)
+	case "expiration_reason":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.ExpirationReason, value)
+	case "expiration_time":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.ExpirationTime, value)
+	case "is_mfa":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.IsMfa, value)
+	case "is_remote":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.IsRemote, value)
+	case "is_vpn":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.IsVpn, value)
+	case "issuer":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Issuer, value)
+	case "terminal":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Terminal, value)
+	case "uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Uid, value)
+	case "uid_alt":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.UidAlt, value)
+	case "uuid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Uuid, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *Session) AppendObservables(path string, observables []Observable) []Observable {
+
+	return observables
+}
+
 var SessionFields = []arrow.Field{
-	{Name: "count", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "created_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
-	{Name: "credential_uid", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "expiration_reason", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "expiration_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
-	{Name: "is_mfa", Type: arrow.FixedWidthTypes.Boolean, Nullable: true},
-	{Name: "is_remote", Type: arrow.FixedWidthTypes.Boolean, Nullable: true},
-	{Name: "is_vpn", Type: arrow.FixedWidthTypes.Boolean, Nullable: true},
-	{Name: "issuer", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "terminal", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "uid_alt", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "uuid", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "count", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Count", "The number of identical sessions spawned from the same source IP, destination IP, application, and content/threat type seen over a period of time.", "optional", "")},
+	{Name: "created_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true, Metadata: ocsf.AttributeMetadata("Created Time", "The time when the session was created.", "optional", "")},
+	{Name: "credential_uid", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("User Credential ID", "The unique identifier of the user's credential. For example, AWS Access Key ID.", "optional", "")},
+	{Name: "expiration_reason", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Expiration Reason", "The reason which triggered the session expiration.", "optional", "")},
+	{Name: "expiration_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true, Metadata: ocsf.AttributeMetadata("Expiration Time", "The session expiration time.", "optional", "")},
+	{Name: "is_mfa", Type: arrow.FixedWidthTypes.Boolean, Nullable: true, Metadata: ocsf.AttributeMetadata("Multi Factor Authentication", "Indicates whether Multi Factor Authentication was used during authentication.", "optional", "")},
+	{Name: "is_remote", Type: arrow.FixedWidthTypes.Boolean, Nullable: true, Metadata: ocsf.AttributeMetadata("Remote", "The indication of whether the session is remote.", "optional", "")},
+	{Name: "is_vpn", Type: arrow.FixedWidthTypes.Boolean, Nullable: true, Metadata: ocsf.AttributeMetadata("VPN Session", "The indication of whether the session is a VPN session.", "optional", "")},
+	{Name: "issuer", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Issuer Details", "The identifier of the session issuer.", "optional", "")},
+	{Name: "terminal", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Terminal", "The Pseudo Terminal associated with the session. Ex: the tty or pts value.", "optional", "")},
+	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Unique ID", "The unique identifier of the session.", "optional", "")},
+	{Name: "uid_alt", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Alternate ID", "The alternate unique identifier of the session. e.g. AWS ARN - <code>arn:aws:sts::123344444444:assumed-role/Admin/example-session</code>.", "optional", "")},
+	{Name: "uuid", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("UUID", "The universally unique identifier of the session.", "optional", "")},
 }
 
 var SessionStruct = arrow.StructOf(SessionFields...)
@@ -2,6 +2,7 @@
 package v1_4_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -45,18 +46,195 @@ func (v *SoftwareComponent) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *SoftwareComponent) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *SoftwareComponent) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	if v.Hash != nil {
+		errs = append(errs, v.Hash.ValidateAt(ocsf.JoinPath(path, "hash"))...)
+	}
+	if v.Name == "" {
+		errs.Add(ocsf.JoinPath(path, "name"), "required attribute is missing")
+	}
+	if v.Version == "" {
+		errs.Add(ocsf.JoinPath(path, "version"), "required attribute is missing")
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *SoftwareComponent) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "author":
+		if index >= 0 || rest != "" || v.Author == nil {
+			return nil, false
+		}
+		return *v.Author, true
+	case "hash":
+		if index >= 0 || v.Hash == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Hash, true
+		}
+		return v.Hash.Get(rest)
+	case "license":
+		if index >= 0 || rest != "" || v.License == nil {
+			return nil, false
+		}
+		return *v.License, true
+	case "name":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.Name, true
+	case "purl":
+		if index >= 0 || rest != "" || v.Purl == nil {
+			return nil, false
+		}
+		return *v.Purl, true
+	case "related_component":
+		if index >= 0 || rest != "" || v.RelatedComponent == nil {
+			return nil, false
+		}
+		return *v.RelatedComponent, true
+	case "relationship":
+		if index >= 0 || rest != "" || v.Relationship == nil {
+			return nil, false
+		}
+		return *v.Relationship, true
+	case "relationship_id":
+		if index >= 0 || rest != "" || v.RelationshipId == nil {
+			return nil, false
+		}
+		return *v.RelationshipId, true
+	case "type":
+		if index >= 0 || rest != "" || v.Type == nil {
+			return nil, false
+		}
+		return *v.Type, true
+	case "type_id":
+		if index >= 0 || rest != "" || v.TypeId == nil {
+			return nil, false
+		}
+		return *v.TypeId, true
+	case "version":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.Version, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *SoftwareComponent) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "author":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Author, value)
+	case "hash":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Hash, value)
+		}
+		if v.Hash == nil {
+			v.Hash = &Fingerprint{}
+		}
+		return v.Hash.Set(rest, value)
+	case "license":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.License, value)
+	case "name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.Name, value)
+	case "purl":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Purl, value)
+	case "related_component":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.RelatedComponent, value)
+	case "relationship":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Relationship, value)
+	case "relationship_id":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.RelationshipId, value)
+	case "type":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Type, value)
+	case "type_id":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.TypeId, value)
+	case "version":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.Version, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *SoftwareComponent) AppendObservables(path string, observables []Observable) []Observable {
+	if v.Hash != nil {
+		observables = v.Hash.AppendObservables(ocsf.JoinPath(path, "hash"), observables)
+	}
+
+	return observables
+}
+
 var SoftwareComponentFields = []arrow.Field{
-	{Name: "author", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "hash", Type: FingerprintStruct, Nullable: true},
-	{Name: "license", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: false},
-	{Name: "purl", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "related_component", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "relationship", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "relationship_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "type", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "type_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "version", Type: arrow.BinaryTypes.String, Nullable: false},
+	{Name: "author", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Author", "The author(s) who published the software component.", "optional", "")},
+	{Name: "hash", Type: FingerprintStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Hash", "Cryptographic hash to identify the binary instance of a software component.", "optional", "")},
+	{Name: "license", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Software License", "The software license applied to this component.", "optional", "")},
+	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: false, Metadata: ocsf.AttributeMetadata("Name", "The software component name.", "required", "")},
+	{Name: "purl", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Package URL", "The Package URL (PURL) to identify the software component. This is a URL that uniquely identifies the component, including the component's name, version, and type. The URL is used to locate and retrieve the component's metadata and content.", "optional", "")},
+	{Name: "related_component", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Related Component", "The package URL (PURL) of the component that this software component has a relationship with.", "optional", "")},
+	{Name: "relationship", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Relationship", "The relationship between two software components, normalized to the caption of the <code>relationship_id</code> value. In the case of 'Other', it is defined by the source.", "optional", "")},
+	{Name: "relationship_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Relationship ID", "The normalized identifier of the relationship between two software components.", "optional", "")},
+	{Name: "type", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Type", "The type of software component, normalized to the caption of the <code>type_id</code> value. In the case of 'Other', it is defined by the source.", "optional", "")},
+	{Name: "type_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Type ID", "The type of software component.", "optional", "")},
+	{Name: "version", Type: arrow.BinaryTypes.String, Nullable: false, Metadata: ocsf.AttributeMetadata("Version", "The software component version.", "required", "")},
 }
 
 var SoftwareComponentStruct = arrow.StructOf(SoftwareComponentFields...)
@@ -2,6 +2,7 @@
 package v1_4_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -57,22 +58,246 @@ func (v *SSO) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *SSO) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *SSO) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	if v.Certificate != nil {
+		errs = append(errs, v.Certificate.ValidateAt(ocsf.JoinPath(path, "certificate"))...)
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *SSO) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "auth_protocol":
+		if index >= 0 || rest != "" || v.AuthProtocol == nil {
+			return nil, false
+		}
+		return *v.AuthProtocol, true
+	case "auth_protocol_id":
+		if index >= 0 || rest != "" || v.AuthProtocolId == nil {
+			return nil, false
+		}
+		return *v.AuthProtocolId, true
+	case "certificate":
+		if index >= 0 || v.Certificate == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Certificate, true
+		}
+		return v.Certificate.Get(rest)
+	case "created_time":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.CreatedTime, true
+	case "duration_mins":
+		if index >= 0 || rest != "" || v.DurationMins == nil {
+			return nil, false
+		}
+		return *v.DurationMins, true
+	case "idle_timeout":
+		if index >= 0 || rest != "" || v.IdleTimeout == nil {
+			return nil, false
+		}
+		return *v.IdleTimeout, true
+	case "login_endpoint":
+		if index >= 0 || rest != "" || v.LoginEndpoint == nil {
+			return nil, false
+		}
+		return *v.LoginEndpoint, true
+	case "logout_endpoint":
+		if index >= 0 || rest != "" || v.LogoutEndpoint == nil {
+			return nil, false
+		}
+		return *v.LogoutEndpoint, true
+	case "metadata_endpoint":
+		if index >= 0 || rest != "" || v.MetadataEndpoint == nil {
+			return nil, false
+		}
+		return *v.MetadataEndpoint, true
+	case "modified_time":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.ModifiedTime, true
+	case "name":
+		if index >= 0 || rest != "" || v.Name == nil {
+			return nil, false
+		}
+		return *v.Name, true
+	case "protocol_name":
+		if index >= 0 || rest != "" || v.ProtocolName == nil {
+			return nil, false
+		}
+		return *v.ProtocolName, true
+	case "scopes":
+		if rest == "" {
+			if index < 0 {
+				return v.Scopes, v.Scopes != nil
+			}
+			return ocsf.Index(v.Scopes, index)
+		}
+		return nil, false
+	case "uid":
+		if index >= 0 || rest != "" || v.Uid == nil {
+			return nil, false
+		}
+		return *v.Uid, true
+	case "vendor_name":
+		if index >= 0 || rest != "" || v.VendorName == nil {
+			return nil, false
+		}
+		return *v.VendorName, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *SSO) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "auth_protocol":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.AuthProtocol, value)
+	case "auth_protocol_id":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.AuthProtocolId, value)
+	case "certificate":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Certificate, value)
+		}
+		if v.Certificate == nil {
+			v.Certificate = &DigitalCertificate{}
+		}
+		return v.Certificate.Set(rest, value)
+	case "created_time":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.CreatedTime, value)
+	case "duration_mins":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.DurationMins, value)
+	case "idle_timeout":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.IdleTimeout, value)
+	case "login_endpoint":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.LoginEndpoint, value)
+	case "logout_endpoint":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.LogoutEndpoint, value)
+	case "metadata_endpoint":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.MetadataEndpoint, value)
+	case "modified_time":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.ModifiedTime, value)
+	case "name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Name, value)
+	case "protocol_name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.ProtocolName, value)
+	case "scopes":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Scopes, value)
+		}
+		elem, err := ocsf.Element(&v.Scopes, index)
+		if err != nil {
+			return err
+		}
+		if rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(elem, value)
+	case "uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Uid, value)
+	case "vendor_name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.VendorName, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *SSO) AppendObservables(path string, observables []Observable) []Observable {
+	if v.Certificate != nil {
+		observables = v.Certificate.AppendObservables(ocsf.JoinPath(path, "certificate"), observables)
+	}
+
+	return observables
+}
+
 var SSOFields = []arrow.Field{
-	{Name: "auth_protocol", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "auth_protocol_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "certificate", Type: DigitalCertificateStruct, Nullable: true},
-	{Name: "created_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
-	{Name: "duration_mins", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "idle_timeout", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "login_endpoint", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "logout_endpoint", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "metadata_endpoint", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "modified_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
-	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "protocol_name", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "scopes", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true},
-	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "vendor_name", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "auth_protocol", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Auth Protocol", "The authorization protocol as defined by the caption of <code>auth_protocol_id</code>. In the case of <code>Other</code>, it is defined by the event source.", "optional", "")},
+	{Name: "auth_protocol_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Auth Protocol ID", "The normalized identifier of the authentication protocol used by the SSO resource.", "optional", "")},
+	{Name: "certificate", Type: DigitalCertificateStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("SAML Certificate", "Digital Signature associated with the SSO resource, e.g., SAML X.509 certificate details.", "optional", "")},
+	{Name: "created_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true, Metadata: ocsf.AttributeMetadata("Created Time", "When the SSO resource was created.", "optional", "")},
+	{Name: "duration_mins", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("SSO Session Duration", "The duration (in minutes) for an SSO session, after which re-authentication is required.", "optional", "")},
+	{Name: "idle_timeout", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("SSO Idle Timeout", "Duration (in minutes) of allowed inactivity before Single Sign-On (SSO) session expiration.", "optional", "")},
+	{Name: "login_endpoint", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("SSO Login Endpoint", "URL for initiating an SSO login request.", "optional", "")},
+	{Name: "logout_endpoint", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("SSO Logout Endpoint", "URL for initiating an SSO logout request, allowing sessions to be terminated across applications.", "optional", "")},
+	{Name: "metadata_endpoint", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("SSO Metadata Endpoint", "URL where metadata about the SSO configuration is available (e.g., for SAML configurations).", "optional", "")},
+	{Name: "modified_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true, Metadata: ocsf.AttributeMetadata("Modified Time", "The most recent time when the SSO resource was updated.", "optional", "")},
+	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Name", "The name of the SSO resource.", "optional", "")},
+	{Name: "protocol_name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Supported Protocol", "The supported protocol for the SSO resource. E.g., <code>SAML</code> or <code>OIDC</code>.", "optional", "")},
+	{Name: "scopes", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true, Metadata: ocsf.AttributeMetadata("Scopes", "Scopes define the specific permissions or actions that the client is allowed to perform on behalf of the user. Each scope represents a different set of permissions, and the user can selectively grant or deny access to specific scopes during the authorization process.", "optional", "")},
+	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Unique ID", "A unique identifier for a SSO resource.", "optional", "")},
+	{Name: "vendor_name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Service Provider", "Name of the vendor or service provider implementing SSO. E.g., <code>Okta</code>, <code>Auth0</code>, <code>Microsoft</code>.", "optional", "")},
 }
 
 var SSOStruct = arrow.StructOf(SSOFields...)
@@ -2,6 +2,7 @@
 package v1_4_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -51,20 +52,285 @@ func (v *StartupItem) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *StartupItem) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *StartupItem) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	if v.Driver != nil {
+		errs = append(errs, v.Driver.ValidateAt(ocsf.JoinPath(path, "driver"))...)
+	}
+	if v.Job != nil {
+		errs = append(errs, v.Job.ValidateAt(ocsf.JoinPath(path, "job"))...)
+	}
+	if v.Name == "" {
+		errs.Add(ocsf.JoinPath(path, "name"), "required attribute is missing")
+	}
+	if v.Process != nil {
+		errs = append(errs, v.Process.ValidateAt(ocsf.JoinPath(path, "process"))...)
+	}
+	if v.WinService != nil {
+		errs = append(errs, v.WinService.ValidateAt(ocsf.JoinPath(path, "win_service"))...)
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *StartupItem) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "driver":
+		if index >= 0 || v.Driver == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Driver, true
+		}
+		return v.Driver.Get(rest)
+	case "job":
+		if index >= 0 || v.Job == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Job, true
+		}
+		return v.Job.Get(rest)
+	case "name":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.Name, true
+	case "process":
+		if index >= 0 || v.Process == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Process, true
+		}
+		return v.Process.Get(rest)
+	case "run_mode_ids":
+		if rest == "" {
+			if index < 0 {
+				return v.RunModeIds, v.RunModeIds != nil
+			}
+			return ocsf.Index(v.RunModeIds, index)
+		}
+		return nil, false
+	case "run_modes":
+		if rest == "" {
+			if index < 0 {
+				return v.RunModes, v.RunModes != nil
+			}
+			return ocsf.Index(v.RunModes, index)
+		}
+		return nil, false
+	case "run_state":
+		if index >= 0 || rest != "" || v.RunState == nil {
+			return nil, false
+		}
+		return *v.RunState, true
+	case "run_state_id":
+		if index >= 0 || rest != "" || v.RunStateId == nil {
+			return nil, false
+		}
+		return *v.RunStateId, true
+	case "start_type":
+		if index >= 0 || rest != "" || v.StartType == nil {
+			return nil, false
+		}
+		return *v.StartType, true
+	case "start_type_id":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.StartTypeId, true
+	case "type":
+		if index >= 0 || rest != "" || v.Type == nil {
+			return nil, false
+		}
+		return *v.Type, true
+	case "type_id":
+		if index >= 0 || rest != "" || v.TypeId == nil {
+			return nil, false
+		}
+		return *v.TypeId, true
+	case "win_service":
+		if index >= 0 || v.WinService == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.WinService, true
+		}
+		return v.WinService.Get(rest)
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *StartupItem) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "driver":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Driver, value)
+		}
+		if v.Driver == nil {
+			v.Driver = &KernelExtension{}
+		}
+		return v.Driver.Set(rest, value)
+	case "job":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Job, value)
+		}
+		if v.Job == nil {
+			v.Job = &Job{}
+		}
+		return v.Job.Set(rest, value)
+	case "name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.Name, value)
+	case "process":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Process, value)
+		}
+		if v.Process == nil {
+			v.Process = &Process{}
+		}
+		return v.Process.Set(rest, value)
+	case "run_mode_ids":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.RunModeIds, value)
+		}
+		elem, err := ocsf.Element(&v.RunModeIds, index)
+		if err != nil {
+			return err
+		}
+		if rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(elem, value)
+	case "run_modes":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.RunModes, value)
+		}
+		elem, err := ocsf.Element(&v.RunModes, index)
+		if err != nil {
+			return err
+		}
+		if rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(elem, value)
+	case "run_state":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.RunState, value)
+	case "run_state_id":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.RunStateId, value)
+	case "start_type":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.StartType, value)
+	case "start_type_id":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.StartTypeId, value)
+	case "type":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Type, value)
+	case "type_id":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.TypeId, value)
+	case "win_service":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.WinService, value)
+		}
+		if v.WinService == nil {
+			v.WinService = &WindowsService{}
+		}
+		return v.WinService.Set(rest, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *StartupItem) AppendObservables(path string, observables []Observable) []Observable {
+	if v.Driver != nil {
+		observables = v.Driver.AppendObservables(ocsf.JoinPath(path, "driver"), observables)
+	}
+	if v.Job != nil {
+		observables = v.Job.AppendObservables(ocsf.JoinPath(path, "job"), observables)
+	}
+	if v.Process != nil {
+		observables = v.Process.AppendObservables(ocsf.JoinPath(path, "process"), observables)
+	}
+	if v.WinService != nil {
+		observables = v.WinService.AppendObservables(ocsf.JoinPath(path, "win_service"), observables)
+	}
+
+	return observables
+}
+
 var StartupItemFields = []arrow.Field{
-	{Name: "driver", Type: KernelExtensionStruct, Nullable: true},
-	{Name: "job", Type: JobStruct, Nullable: true},
-	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: false},
-	{Name: "process", Type: ProcessStruct, Nullable: true},
-	{Name: "run_mode_ids", Type: arrow.ListOf(arrow.PrimitiveTypes.Int32), Nullable: true},
-	{Name: "run_modes", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true},
-	{Name: "run_state", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "run_state_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "start_type", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "start_type_id", Type: arrow.PrimitiveTypes.Int32, Nullable: false},
-	{Name: "type", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "type_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "win_service", Type: WindowsServiceStruct, Nullable: true},
+	{Name: "driver", Type: KernelExtensionStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Kernel Driver", "The startup item kernel driver resource.", "optional", "")},
+	{Name: "job", Type: JobStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Job", "The startup item job resource.", "optional", "")},
+	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: false, Metadata: ocsf.AttributeMetadata("Name", "The unique name of the startup item.", "required", "")},
+	{Name: "process", Type: ProcessStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Process", "The startup item process resource.", "optional", "")},
+	{Name: "run_mode_ids", Type: arrow.ListOf(arrow.PrimitiveTypes.Int32), Nullable: true, Metadata: ocsf.AttributeMetadata("Run Mode IDs", "The list of normalized identifiers that describe the startup items' properties when it is running.  Use this field to capture extended information about the process, which may depend on the type of startup item.  E.g., A Windows service that interacts with the desktop.", "optional", "")},
+	{Name: "run_modes", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true, Metadata: ocsf.AttributeMetadata("Run Modes", "The list of run_modes, normalized to the captions of the run_mode_id values.  In the case of 'Other', they are defined by the event source.", "optional", "")},
+	{Name: "run_state", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Run State", "The run state of the startup item.", "optional", "")},
+	{Name: "run_state_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Run State ID", "The run state ID of the startup item.", "optional", "")},
+	{Name: "start_type", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Start Type", "The start type of the startup item.", "optional", "")},
+	{Name: "start_type_id", Type: arrow.PrimitiveTypes.Int32, Nullable: false, Metadata: ocsf.AttributeMetadata("Start Type ID", "The start type ID of the startup item.", "required", "")},
+	{Name: "type", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Type", "The startup item type.", "optional", "")},
+	{Name: "type_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Type ID", "The startup item type identifier.", "optional", "")},
+	{Name: "win_service", Type: WindowsServiceStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Windows Service", "The startup item Windows service resource.", "optional", "")},
 }
 
 var StartupItemStruct = arrow.StructOf(StartupItemFields...)
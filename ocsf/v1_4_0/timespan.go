@@ -2,6 +2,7 @@
 package v1_4_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -42,17 +43,163 @@ func (v *TimeSpan) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *TimeSpan) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *TimeSpan) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *TimeSpan) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "duration":
+		if index >= 0 || rest != "" || v.Duration == nil {
+			return nil, false
+		}
+		return *v.Duration, true
+	case "duration_days":
+		if index >= 0 || rest != "" || v.DurationDays == nil {
+			return nil, false
+		}
+		return *v.DurationDays, true
+	case "duration_hours":
+		if index >= 0 || rest != "" || v.DurationHours == nil {
+			return nil, false
+		}
+		return *v.DurationHours, true
+	case "duration_mins":
+		if index >= 0 || rest != "" || v.DurationMins == nil {
+			return nil, false
+		}
+		return *v.DurationMins, true
+	case "duration_months":
+		if index >= 0 || rest != "" || v.DurationMonths == nil {
+			return nil, false
+		}
+		return *v.DurationMonths, true
+	case "duration_secs":
+		if index >= 0 || rest != "" || v.DurationSecs == nil {
+			return nil, false
+		}
+		return *v.DurationSecs, true
+	case "duration_weeks":
+		if index >= 0 || rest != "" || v.DurationWeeks == nil {
+			return nil, false
+		}
+		return *v.DurationWeeks, true
+	case "duration_years":
+		if index >= 0 || rest != "" || v.DurationYears == nil {
+			return nil, false
+		}
+		return *v.DurationYears, true
+	case "type":
+		if index >= 0 || rest != "" || v.Type == nil {
+			return nil, false
+		}
+		return *v.Type, true
+	case "type_id":
+		if index >= 0 || rest != "" || v.TypeId == nil {
+			return nil, false
+		}
+		return *v.TypeId, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *TimeSpan) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "duration":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Duration, value)
+	case "duration_days":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.DurationDays, value)
+	case "duration_hours":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.DurationHours, value)
+	case "duration_mins":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.DurationMins, value)
+	case "duration_months":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.DurationMonths, value)
+	case "duration_secs":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.DurationSecs, value)
+	case "duration_weeks":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.DurationWeeks, value)
+	case "duration_years":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.DurationYears, value)
+	case "type":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Type, value)
+	case "type_id":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.TypeId, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *TimeSpan) AppendObservables(path string, observables []Observable) []Observable {
+
+	return observables
+}
+
 var TimeSpanFields = []arrow.Field{
-	{Name: "duration", Type: arrow.PrimitiveTypes.Int64, Nullable: true},
-	{Name: "duration_days", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "duration_hours", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "duration_mins", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "duration_months", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "duration_secs", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "duration_weeks", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "duration_years", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "type", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "type_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
+	{Name: "duration", Type: arrow.PrimitiveTypes.Int64, Nullable: true, Metadata: ocsf.AttributeMetadata("Duration Milliseconds", "The duration of the time span in milliseconds.", "optional", "")},
+	{Name: "duration_days", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Duration Days", "The duration of the time span in days.", "optional", "")},
+	{Name: "duration_hours", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Duration Hours", "The duration of the time span in hours.", "optional", "")},
+	{Name: "duration_mins", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Duration Minutes", "The duration of the time span in minutes.", "optional", "")},
+	{Name: "duration_months", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Duration Months", "The duration of the time span in months.", "optional", "")},
+	{Name: "duration_secs", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Duration Seconds", "The duration of the time span in seconds.", "optional", "")},
+	{Name: "duration_weeks", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Duration Weeks", "The duration of the time span in weeks.", "optional", "")},
+	{Name: "duration_years", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Duration Years", "The duration of the time span in years.", "optional", "")},
+	{Name: "type", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Time Span Type", "The type of time span duration the object represents.", "optional", "")},
+	{Name: "type_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Time Span Type ID", "The normalized identifier for the time span duration type.", "optional", "")},
 }
 
 var TimeSpanStruct = arrow.StructOf(TimeSpanFields...)
@@ -2,6 +2,7 @@
 package v1_4_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -51,20 +52,305 @@ func (v *TransportLayerSecurityTLS) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *TransportLayerSecurityTLS) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *TransportLayerSecurityTLS) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	if v.Certificate != nil {
+		errs = append(errs, v.Certificate.ValidateAt(ocsf.JoinPath(path, "certificate"))...)
+	}
+	if v.Ja3Hash != nil {
+		errs = append(errs, v.Ja3Hash.ValidateAt(ocsf.JoinPath(path, "ja3_hash"))...)
+	}
+	if v.Ja3sHash != nil {
+		errs = append(errs, v.Ja3sHash.ValidateAt(ocsf.JoinPath(path, "ja3s_hash"))...)
+	}
+	for i := range v.TlsExtensionList {
+		errs = append(errs, v.TlsExtensionList[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "tls_extension_list"), i))...)
+	}
+	if v.Version == "" {
+		errs.Add(ocsf.JoinPath(path, "version"), "required attribute is missing")
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *TransportLayerSecurityTLS) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "alert":
+		if index >= 0 || rest != "" || v.Alert == nil {
+			return nil, false
+		}
+		return *v.Alert, true
+	case "certificate":
+		if index >= 0 || v.Certificate == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Certificate, true
+		}
+		return v.Certificate.Get(rest)
+	case "certificate_chain":
+		if rest == "" {
+			if index < 0 {
+				return v.CertificateChain, v.CertificateChain != nil
+			}
+			return ocsf.Index(v.CertificateChain, index)
+		}
+		return nil, false
+	case "cipher":
+		if index >= 0 || rest != "" || v.Cipher == nil {
+			return nil, false
+		}
+		return *v.Cipher, true
+	case "client_ciphers":
+		if rest == "" {
+			if index < 0 {
+				return v.ClientCiphers, v.ClientCiphers != nil
+			}
+			return ocsf.Index(v.ClientCiphers, index)
+		}
+		return nil, false
+	case "handshake_dur":
+		if index >= 0 || rest != "" || v.HandshakeDur == nil {
+			return nil, false
+		}
+		return *v.HandshakeDur, true
+	case "ja3_hash":
+		if index >= 0 || v.Ja3Hash == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Ja3Hash, true
+		}
+		return v.Ja3Hash.Get(rest)
+	case "ja3s_hash":
+		if index >= 0 || v.Ja3sHash == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Ja3sHash, true
+		}
+		return v.Ja3sHash.Get(rest)
+	case "key_length":
+		if index >= 0 || rest != "" || v.KeyLength == nil {
+			return nil, false
+		}
+		return *v.KeyLength, true
+	case "server_ciphers":
+		if rest == "" {
+			if index < 0 {
+				return v.ServerCiphers, v.ServerCiphers != nil
+			}
+			return ocsf.Index(v.ServerCiphers, index)
+		}
+		return nil, false
+	case "sni":
+		if index >= 0 || rest != "" || v.Sni == nil {
+			return nil, false
+		}
+		return *v.Sni, true
+	case "tls_extension_list":
+		if rest == "" {
+			if index < 0 {
+				return v.TlsExtensionList, v.TlsExtensionList != nil
+			}
+			return ocsf.Index(v.TlsExtensionList, index)
+		}
+		if index < 0 || index >= len(v.TlsExtensionList) {
+			return nil, false
+		}
+		return v.TlsExtensionList[index].Get(rest)
+	case "version":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.Version, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *TransportLayerSecurityTLS) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "alert":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Alert, value)
+	case "certificate":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Certificate, value)
+		}
+		if v.Certificate == nil {
+			v.Certificate = &DigitalCertificate{}
+		}
+		return v.Certificate.Set(rest, value)
+	case "certificate_chain":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.CertificateChain, value)
+		}
+		elem, err := ocsf.Element(&v.CertificateChain, index)
+		if err != nil {
+			return err
+		}
+		if rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(elem, value)
+	case "cipher":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Cipher, value)
+	case "client_ciphers":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.ClientCiphers, value)
+		}
+		elem, err := ocsf.Element(&v.ClientCiphers, index)
+		if err != nil {
+			return err
+		}
+		if rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(elem, value)
+	case "handshake_dur":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.HandshakeDur, value)
+	case "ja3_hash":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Ja3Hash, value)
+		}
+		if v.Ja3Hash == nil {
+			v.Ja3Hash = &Fingerprint{}
+		}
+		return v.Ja3Hash.Set(rest, value)
+	case "ja3s_hash":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Ja3sHash, value)
+		}
+		if v.Ja3sHash == nil {
+			v.Ja3sHash = &Fingerprint{}
+		}
+		return v.Ja3sHash.Set(rest, value)
+	case "key_length":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.KeyLength, value)
+	case "server_ciphers":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.ServerCiphers, value)
+		}
+		elem, err := ocsf.Element(&v.ServerCiphers, index)
+		if err != nil {
+			return err
+		}
+		if rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(elem, value)
+	case "sni":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Sni, value)
+	case "tls_extension_list":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.TlsExtensionList, value)
+		}
+		elem, err := ocsf.Element(&v.TlsExtensionList, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "version":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.Version, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *TransportLayerSecurityTLS) AppendObservables(path string, observables []Observable) []Observable {
+	if v.Certificate != nil {
+		observables = v.Certificate.AppendObservables(ocsf.JoinPath(path, "certificate"), observables)
+	}
+	if v.Ja3Hash != nil {
+		observables = v.Ja3Hash.AppendObservables(ocsf.JoinPath(path, "ja3_hash"), observables)
+	}
+	if v.Ja3sHash != nil {
+		observables = v.Ja3sHash.AppendObservables(ocsf.JoinPath(path, "ja3s_hash"), observables)
+	}
+	for i := range v.TlsExtensionList {
+		observables = v.TlsExtensionList[i].AppendObservables(ocsf.JoinPath(path, "tls_extension_list"), observables)
+	}
+
+	return observables
+}
+
 var TransportLayerSecurityTLSFields = []arrow.Field{
-	{Name: "alert", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "certificate", Type: DigitalCertificateStruct, Nullable: true},
-	{Name: "certificate_chain", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true},
-	{Name: "cipher", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "client_ciphers", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true},
-	{Name: "handshake_dur", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "ja3_hash", Type: FingerprintStruct, Nullable: true},
-	{Name: "ja3s_hash", Type: FingerprintStruct, Nullable: true},
-	{Name: "key_length", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "server_ciphers", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true},
-	{Name: "sni", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "tls_extension_list", Type: arrow.ListOf(TLSExtensionStruct), Nullable: true},
-	{Name: "version", Type: arrow.BinaryTypes.String, Nullable: false},
+	{Name: "alert", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Client TLS Alert", "The integer value of TLS alert if present. The alerts are defined in the TLS specification in <a target='_blank' href='https://datatracker.ietf.org/doc/html/rfc2246'>RFC-2246</a>.", "optional", "")},
+	{Name: "certificate", Type: DigitalCertificateStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Certificate", "The certificate object containing information about the digital certificate.", "optional", "")},
+	{Name: "certificate_chain", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true, Metadata: ocsf.AttributeMetadata("Certificate Chain", "The Chain of Certificate Serial Numbers field provides a chain of Certificate Issuer Serial Numbers leading to the Root Certificate Issuer.", "optional", "")},
+	{Name: "cipher", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Cipher Suite", "The negotiated cipher suite.", "optional", "")},
+	{Name: "client_ciphers", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true, Metadata: ocsf.AttributeMetadata("Client Cipher Suites", "The client cipher suites that were exchanged during the TLS handshake negotiation.", "optional", "")},
+	{Name: "handshake_dur", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Handshake Duration", "The amount of total time for the TLS handshake to complete after the TCP connection is established, including client-side delays, in milliseconds.", "optional", "")},
+	{Name: "ja3_hash", Type: FingerprintStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("JA3 Hash", "The MD5 hash of a JA3 string.", "optional", "")},
+	{Name: "ja3s_hash", Type: FingerprintStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("JA3S Hash", "The MD5 hash of a JA3S string.", "optional", "")},
+	{Name: "key_length", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Key Length", "The length of the encryption key.", "optional", "")},
+	{Name: "server_ciphers", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true, Metadata: ocsf.AttributeMetadata("Server Cipher Suites", "The server cipher suites that were exchanged during the TLS handshake negotiation.", "optional", "")},
+	{Name: "sni", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Server Name Indication", " The Server Name Indication (SNI) extension sent by the client.", "optional", "")},
+	{Name: "tls_extension_list", Type: arrow.ListOf(TLSExtensionStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("TLS Extension List", "The list of TLS extensions.", "optional", "")},
+	{Name: "version", Type: arrow.BinaryTypes.String, Nullable: false, Metadata: ocsf.AttributeMetadata("Version", "The TLS protocol version.", "required", "")},
 }
 
 var TransportLayerSecurityTLSStruct = arrow.StructOf(TransportLayerSecurityTLSFields...)
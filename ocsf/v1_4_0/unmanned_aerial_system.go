@@ -2,6 +2,7 @@
 package v1_4_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -54,21 +55,237 @@ func (v *UnmannedAerialSystem) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *UnmannedAerialSystem) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *UnmannedAerialSystem) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	if v.HwInfo != nil {
+		errs = append(errs, v.HwInfo.ValidateAt(ocsf.JoinPath(path, "hw_info"))...)
+	}
+	if v.Location != nil {
+		errs = append(errs, v.Location.ValidateAt(ocsf.JoinPath(path, "location"))...)
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *UnmannedAerialSystem) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "hw_info":
+		if index >= 0 || v.HwInfo == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.HwInfo, true
+		}
+		return v.HwInfo.Get(rest)
+	case "location":
+		if index >= 0 || v.Location == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Location, true
+		}
+		return v.Location.Get(rest)
+	case "model":
+		if index >= 0 || rest != "" || v.Model == nil {
+			return nil, false
+		}
+		return *v.Model, true
+	case "name":
+		if index >= 0 || rest != "" || v.Name == nil {
+			return nil, false
+		}
+		return *v.Name, true
+	case "serial_number":
+		if index >= 0 || rest != "" || v.SerialNumber == nil {
+			return nil, false
+		}
+		return *v.SerialNumber, true
+	case "speed":
+		if index >= 0 || rest != "" || v.Speed == nil {
+			return nil, false
+		}
+		return *v.Speed, true
+	case "speed_accuracy":
+		if index >= 0 || rest != "" || v.SpeedAccuracy == nil {
+			return nil, false
+		}
+		return *v.SpeedAccuracy, true
+	case "track_direction":
+		if index >= 0 || rest != "" || v.TrackDirection == nil {
+			return nil, false
+		}
+		return *v.TrackDirection, true
+	case "type":
+		if index >= 0 || rest != "" || v.Type == nil {
+			return nil, false
+		}
+		return *v.Type, true
+	case "type_id":
+		if index >= 0 || rest != "" || v.TypeId == nil {
+			return nil, false
+		}
+		return *v.TypeId, true
+	case "uid":
+		if index >= 0 || rest != "" || v.Uid == nil {
+			return nil, false
+		}
+		return *v.Uid, true
+	case "uid_alt":
+		if index >= 0 || rest != "" || v.UidAlt == nil {
+			return nil, false
+		}
+		return *v.UidAlt, true
+	case "uuid":
+		if index >= 0 || rest != "" || v.Uuid == nil {
+			return nil, false
+		}
+		return *v.Uuid, true
+	case "vertical_speed":
+		if index >= 0 || rest != "" || v.VerticalSpeed == nil {
+			return nil, false
+		}
+		return *v.VerticalSpeed, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *UnmannedAerialSystem) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "hw_info":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.HwInfo, value)
+		}
+		if v.HwInfo == nil {
+			v.HwInfo = &DeviceHardwareInfo{}
+		}
+		return v.HwInfo.Set(rest, value)
+	case "location":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Location, value)
+		}
+		if v.Location == nil {
+			v.Location = &GeoLocation{}
+		}
+		return v.Location.Set(rest, value)
+	case "model":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Model, value)
+	case "name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Name, value)
+	case "serial_number":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.SerialNumber, value)
+	case "speed":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Speed, value)
+	case "speed_accuracy":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.SpeedAccuracy, value)
+	case "track_direction":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.TrackDirection, value)
+	case "type":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Type, value)
+	case "type_id":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.TypeId, value)
+	case "uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Uid, value)
+	case "uid_alt":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.UidAlt, value)
+	case "uuid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Uuid, value)
+	case "vertical_speed":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.VerticalSpeed, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *UnmannedAerialSystem) AppendObservables(path string, observables []Observable) []Observable {
+	if v.HwInfo != nil {
+		observables = v.HwInfo.AppendObservables(ocsf.JoinPath(path, "hw_info"), observables)
+	}
+	if v.Location != nil {
+		observables = v.Location.AppendObservables(ocsf.JoinPath(path, "location"), observables)
+	}
+
+	return observables
+}
+
 var UnmannedAerialSystemFields = []arrow.Field{
-	{Name: "hw_info", Type: DeviceHardwareInfoStruct, Nullable: true},
-	{Name: "location", Type: GeoLocationStruct, Nullable: true},
-	{Name: "model", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "serial_number", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "speed", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "speed_accuracy", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "track_direction", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "type", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "type_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "uid_alt", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "uuid", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "vertical_speed", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "hw_info", Type: DeviceHardwareInfoStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("UAS Hardware Information", "The endpoint hardware information.", "optional", "")},
+	{Name: "location", Type: GeoLocationStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("UAS Position Location Information", "The detailed geographical location usually associated with an IP address.", "optional", "")},
+	{Name: "model", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Model", "The model name of the aircraft or unmanned system.", "optional", "")},
+	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Name", "The name of the unmanned system as reported by tracking or sensing hardware.", "optional", "")},
+	{Name: "serial_number", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Serial Number", "The serial number of the unmanned system. This is expressed in <code>CTA-2063-A</code> format.", "optional", "")},
+	{Name: "speed", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Speed", "Ground speed of flight. This value is provided in meters per second with a minimum resolution of 0.25 m/s. Special Values: <code>Invalid</code>, <code>No Value</code>, or <code>Unknown: 255 m/s</code>.", "optional", "")},
+	{Name: "speed_accuracy", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Speed Accuracy", "Provides quality/containment on horizontal ground speed. Measured in meters/second.", "optional", "")},
+	{Name: "track_direction", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Track Direction", "Direction of flight expressed as a “True North-based” ground track angle. This value is provided in clockwise degrees with a minimum resolution of 1 degree. If aircraft is not moving horizontally, use the “Unknown” value", "optional", "")},
+	{Name: "type", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Type", "The type of the UAS. For example, Helicopter, Gyroplane, Rocket, etc.", "optional", "")},
+	{Name: "type_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Type ID", "The UAS type identifier.", "optional", "")},
+	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("UAS ID", "The primary identification identifier for an unmanned system. This can be a Serial Number (in <code>CTA-2063-A</code> format, the Registration ID (provided by the <code>CAA</code>, a UTM, or a unique Session ID.", "optional", "")},
+	{Name: "uid_alt", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("UAS Alternate ID", "A secondary identification identifier for an unmanned system. This can be a Serial Number (in <code>CTA-2063-A</code> format, the Registration ID (provided by the <code>CAA</code>, a UTM, or a unique Session ID.", "optional", "")},
+	{Name: "uuid", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("UTM UUID", "The Unmanned Aircraft System Traffic Management (UTM) provided universal unique ID (UUID) traceable to a non-obfuscated ID where this UTM UUID acts as a 'session id' to protect exposure of operationally sensitive information.", "optional", "")},
+	{Name: "vertical_speed", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Vertical Speed", "Vertical speed upward relative to the WGS-84 datum, measured in meters per second. Special Values: <code>Invalid</code>, <code>No Value</code>, or <code>Unknown: 63 m/s</code>.", "optional", "")},
 }
 
 var UnmannedAerialSystemStruct = arrow.StructOf(UnmannedAerialSystemFields...)
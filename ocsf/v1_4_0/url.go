@@ -2,6 +2,7 @@
 package v1_4_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -46,18 +47,212 @@ func (v *UniformResourceLocator) Observable() (*int, string) {
 	return &typeId, "url"
 }
 
+func (v *UniformResourceLocator) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *UniformResourceLocator) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *UniformResourceLocator) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "categories":
+		if rest == "" {
+			if index < 0 {
+				return v.Categories, v.Categories != nil
+			}
+			return ocsf.Index(v.Categories, index)
+		}
+		return nil, false
+	case "category_ids":
+		if rest == "" {
+			if index < 0 {
+				return v.CategoryIds, v.CategoryIds != nil
+			}
+			return ocsf.Index(v.CategoryIds, index)
+		}
+		return nil, false
+	case "domain":
+		if index >= 0 || rest != "" || v.Domain == nil {
+			return nil, false
+		}
+		return *v.Domain, true
+	case "hostname":
+		if index >= 0 || rest != "" || v.Hostname == nil {
+			return nil, false
+		}
+		return *v.Hostname, true
+	case "path":
+		if index >= 0 || rest != "" || v.Path == nil {
+			return nil, false
+		}
+		return *v.Path, true
+	case "port":
+		if index >= 0 || rest != "" || v.Port == nil {
+			return nil, false
+		}
+		return *v.Port, true
+	case "query_string":
+		if index >= 0 || rest != "" || v.QueryString == nil {
+			return nil, false
+		}
+		return *v.QueryString, true
+	case "resource_type":
+		if index >= 0 || rest != "" || v.ResourceType == nil {
+			return nil, false
+		}
+		return *v.ResourceType, true
+	case "scheme":
+		if index >= 0 || rest != "" || v.Scheme == nil {
+			return nil, false
+		}
+		return *v.Scheme, true
+	case "subdomain":
+		if index >= 0 || rest != "" || v.Subdomain == nil {
+			return nil, false
+		}
+		return *v.Subdomain, true
+	case "url_string":
+		if index >= 0 || rest != "" || v.UrlString == nil {
+			return nil, false
+		}
+		return *v.UrlString, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *UniformResourceLocator) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "categories":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Categories, value)
+		}
+		elem, err := ocsf.Element(&v.Categories, index)
+		if err != nil {
+			return err
+		}
+		if rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(elem, value)
+	case "category_ids":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.CategoryIds, value)
+		}
+		elem, err := ocsf.Element(&v.CategoryIds, index)
+		if err != nil {
+			return err
+		}
+		if rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(elem, value)
+	case "domain":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Domain, value)
+	case "hostname":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Hostname, value)
+	case "path":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Path, value)
+	case "port":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Port, value)
+	case "query_string":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.QueryString, value)
+	case "resource_type":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.ResourceType, value)
+	case "scheme":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Scheme, value)
+	case "subdomain":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Subdomain, value)
+	case "url_string":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.UrlString, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *UniformResourceLocator) AppendObservables(path string, observables []Observable) []Observable {
+	if path != "" {
+		observables = append(observables, Observable{Name: ocsf.Ptr(path), TypeId: 23})
+	}
+	if v.Hostname != nil {
+		observables = append(observables, Observable{Name: ocsf.Ptr(ocsf.JoinPath(path, "hostname")), TypeId: 1, Value: ocsf.Ptr(*v.Hostname)})
+	}
+	if v.Port != nil {
+		observables = append(observables, Observable{Name: ocsf.Ptr(ocsf.JoinPath(path, "port")), TypeId: 11, Value: ocsf.Ptr(ocsf.ObservableValue(*v.Port))})
+	}
+	if v.UrlString != nil {
+		observables = append(observables, Observable{Name: ocsf.Ptr(ocsf.JoinPath(path, "url_string")), TypeId: 6, Value: ocsf.Ptr(*v.UrlString)})
+	}
+
+	return observables
+}
+
 var UniformResourceLocatorFields = []arrow.Field{
-	{Name: "categories", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true},
-	{Name: "category_ids", Type: arrow.ListOf(arrow.PrimitiveTypes.Int32), Nullable: true},
-	{Name: "domain", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "hostname", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "path", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "port", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "query_string", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "resource_type", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "scheme", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "subdomain", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "url_string", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "categories", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true, Metadata: ocsf.AttributeMetadata("Website Categorization", "The Website categorization names, as defined by <code>category_ids</code> enum values.", "optional", "")},
+	{Name: "category_ids", Type: arrow.ListOf(arrow.PrimitiveTypes.Int32), Nullable: true, Metadata: ocsf.AttributeMetadata("Website Categorization IDs", "The Website categorization identifiers.", "optional", "")},
+	{Name: "domain", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Domain", "The domain portion of the URL. For example: <code>example.com</code> in <code>https://sub.example.com</code>.", "optional", "")},
+	{Name: "hostname", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Hostname", "The URL host as extracted from the URL. For example: <code>www.example.com</code> from <code>www.example.com/download/trouble</code>.", "optional", "")},
+	{Name: "path", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Path", "The URL path as extracted from the URL. For example: <code>/download/trouble</code> from <code>www.example.com/download/trouble</code>.", "optional", "")},
+	{Name: "port", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Port", "The URL port. For example: <code>80</code>.", "optional", "")},
+	{Name: "query_string", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("HTTP Query String", "The query portion of the URL. For example: the query portion of the URL <code>http://www.example.com/search?q=bad&sort=date</code> is <code>q=bad&sort=date</code>.", "optional", "")},
+	{Name: "resource_type", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Resource Type", "The context in which a resource was retrieved in a web request.", "optional", "")},
+	{Name: "scheme", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Scheme", "The scheme portion of the URL. For example: <code>http</code>, <code>https</code>, <code>ftp</code>, or <code>sftp</code>.", "optional", "")},
+	{Name: "subdomain", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Subdomain", "The subdomain portion of the URL. For example: <code>sub</code> in <code>https://sub.example.com</code> or <code>sub2.sub1</code> in <code>https://sub2.sub1.example.com</code>.", "optional", "")},
+	{Name: "url_string", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("URL String", "The URL string. See RFC 1738. For example: <code>http://www.example.com/download/trouble.exe</code>. Note: The URL path should not populate the URL string.", "optional", "")},
 }
 
 var UniformResourceLocatorStruct = arrow.StructOf(UniformResourceLocatorFields...)
@@ -2,6 +2,7 @@
 package v1_5_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -36,15 +37,194 @@ func (v *AffectedCode) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *AffectedCode) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *AffectedCode) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	errs = append(errs, v.File.ValidateAt(ocsf.JoinPath(path, "file"))...)
+	if v.Owner != nil {
+		errs = append(errs, v.Owner.ValidateAt(ocsf.JoinPath(path, "owner"))...)
+	}
+	if v.Remediation != nil {
+		errs = append(errs, v.Remediation.ValidateAt(ocsf.JoinPath(path, "remediation"))...)
+	}
+	if v.Rule != nil {
+		errs = append(errs, v.Rule.ValidateAt(ocsf.JoinPath(path, "rule"))...)
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *AffectedCode) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "end_column":
+		if index >= 0 || rest != "" || v.EndColumn == nil {
+			return nil, false
+		}
+		return *v.EndColumn, true
+	case "end_line":
+		if index >= 0 || rest != "" || v.EndLine == nil {
+			return nil, false
+		}
+		return *v.EndLine, true
+	case "file":
+		if index >= 0 {
+			return nil, false
+		}
+		if rest == "" {
+			return &v.File, true
+		}
+		return v.File.Get(rest)
+	case "owner":
+		if index >= 0 || v.Owner == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Owner, true
+		}
+		return v.Owner.Get(rest)
+	case "remediation":
+		if index >= 0 || v.Remediation == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Remediation, true
+		}
+		return v.Remediation.Get(rest)
+	case "rule":
+		if index >= 0 || v.Rule == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Rule, true
+		}
+		return v.Rule.Get(rest)
+	case "start_column":
+		if index >= 0 || rest != "" || v.StartColumn == nil {
+			return nil, false
+		}
+		return *v.StartColumn, true
+	case "start_line":
+		if index >= 0 || rest != "" || v.StartLine == nil {
+			return nil, false
+		}
+		return *v.StartLine, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *AffectedCode) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "end_column":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.EndColumn, value)
+	case "end_line":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.EndLine, value)
+	case "file":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetRequired(&v.File, value)
+		}
+		return v.File.Set(rest, value)
+	case "owner":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Owner, value)
+		}
+		if v.Owner == nil {
+			v.Owner = &User{}
+		}
+		return v.Owner.Set(rest, value)
+	case "remediation":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Remediation, value)
+		}
+		if v.Remediation == nil {
+			v.Remediation = &Remediation{}
+		}
+		return v.Remediation.Set(rest, value)
+	case "rule":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Rule, value)
+		}
+		if v.Rule == nil {
+			v.Rule = &Rule{}
+		}
+		return v.Rule.Set(rest, value)
+	case "start_column":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.StartColumn, value)
+	case "start_line":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.StartLine, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *AffectedCode) AppendObservables(path string, observables []Observable) []Observable {
+	observables = v.File.AppendObservables(ocsf.JoinPath(path, "file"), observables)
+	if v.Owner != nil {
+		observables = v.Owner.AppendObservables(ocsf.JoinPath(path, "owner"), observables)
+	}
+	if v.Remediation != nil {
+		observables = v.Remediation.AppendObservables(ocsf.JoinPath(path, "remediation"), observables)
+	}
+	if v.Rule != nil {
+		observables = v.Rule.AppendObservables(ocsf.JoinPath(path, "rule"), observables)
+	}
+
+	return observables
+}
+
 var AffectedCodeFields = []arrow.Field{
-	{Name: "end_column", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "end_line", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "file", Type: FileStruct, Nullable: false},
-	{Name: "owner", Type: UserStruct, Nullable: true},
-	{Name: "remediation", Type: RemediationStruct, Nullable: true},
-	{Name: "rule", Type: RuleStruct, Nullable: true},
-	{Name: "start_column", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "start_line", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
+	{Name: "end_column", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("End Column", "The column number of the last part of the assessed code identified as vulnerable.", "optional", "")},
+	{Name: "end_line", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("End Line", "The line number of the last line of code block identified as vulnerable.", "optional", "")},
+	{Name: "file", Type: FileStruct, Nullable: false, Metadata: ocsf.AttributeMetadata("File", "Details about the file that contains the affected code block.", "required", "")},
+	{Name: "owner", Type: UserStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Owner", "Details about the user that owns the affected file.", "optional", "")},
+	{Name: "remediation", Type: RemediationStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Remediation Guidance", "Describes the recommended remediation steps to address identified issue(s).", "optional", "")},
+	{Name: "rule", Type: RuleStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Related Rule", "Details about the specific rule, e.g., those defined as part of a larger <code>policy</code>, that triggered the finding.", "optional", "")},
+	{Name: "start_column", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Start Column", "The column number of the first part of the assessed code identified as vulnerable.", "optional", "")},
+	{Name: "start_line", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Start Line", "The line number of the first line of code block identified as vulnerable.", "optional", "")},
 }
 
 var AffectedCodeStruct = arrow.StructOf(AffectedCodeFields...)
@@ -2,6 +2,7 @@
 package v1_5_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -72,27 +73,309 @@ func (v *AffectedSoftwarePackage) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *AffectedSoftwarePackage) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *AffectedSoftwarePackage) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	if v.Hash != nil {
+		errs = append(errs, v.Hash.ValidateAt(ocsf.JoinPath(path, "hash"))...)
+	}
+	if v.Name == "" {
+		errs.Add(ocsf.JoinPath(path, "name"), "required attribute is missing")
+	}
+	if v.Remediation != nil {
+		errs = append(errs, v.Remediation.ValidateAt(ocsf.JoinPath(path, "remediation"))...)
+	}
+	if v.Version == "" {
+		errs.Add(ocsf.JoinPath(path, "version"), "required attribute is missing")
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *AffectedSoftwarePackage) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "architecture":
+		if index >= 0 || rest != "" || v.Architecture == nil {
+			return nil, false
+		}
+		return *v.Architecture, true
+	case "cpe_name":
+		if index >= 0 || rest != "" || v.CpeName == nil {
+			return nil, false
+		}
+		return *v.CpeName, true
+	case "epoch":
+		if index >= 0 || rest != "" || v.Epoch == nil {
+			return nil, false
+		}
+		return *v.Epoch, true
+	case "fixed_in_version":
+		if index >= 0 || rest != "" || v.FixedInVersion == nil {
+			return nil, false
+		}
+		return *v.FixedInVersion, true
+	case "hash":
+		if index >= 0 || v.Hash == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Hash, true
+		}
+		return v.Hash.Get(rest)
+	case "license":
+		if index >= 0 || rest != "" || v.License == nil {
+			return nil, false
+		}
+		return *v.License, true
+	case "license_url":
+		if index >= 0 || rest != "" || v.LicenseUrl == nil {
+			return nil, false
+		}
+		return *v.LicenseUrl, true
+	case "name":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.Name, true
+	case "package_manager":
+		if index >= 0 || rest != "" || v.PackageManager == nil {
+			return nil, false
+		}
+		return *v.PackageManager, true
+	case "package_manager_url":
+		if index >= 0 || rest != "" || v.PackageManagerUrl == nil {
+			return nil, false
+		}
+		return *v.PackageManagerUrl, true
+	case "path":
+		if index >= 0 || rest != "" || v.Path == nil {
+			return nil, false
+		}
+		return *v.Path, true
+	case "purl":
+		if index >= 0 || rest != "" || v.Purl == nil {
+			return nil, false
+		}
+		return *v.Purl, true
+	case "release":
+		if index >= 0 || rest != "" || v.Release == nil {
+			return nil, false
+		}
+		return *v.Release, true
+	case "remediation":
+		if index >= 0 || v.Remediation == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Remediation, true
+		}
+		return v.Remediation.Get(rest)
+	case "src_url":
+		if index >= 0 || rest != "" || v.SrcUrl == nil {
+			return nil, false
+		}
+		return *v.SrcUrl, true
+	case "type":
+		if index >= 0 || rest != "" || v.Type == nil {
+			return nil, false
+		}
+		return *v.Type, true
+	case "type_id":
+		if index >= 0 || rest != "" || v.TypeId == nil {
+			return nil, false
+		}
+		return *v.TypeId, true
+	case "uid":
+		if index >= 0 || rest != "" || v.Uid == nil {
+			return nil, false
+		}
+		return *v.Uid, true
+	case "vendor_name":
+		if index >= 0 || rest != "" || v.VendorName == nil {
+			return nil, false
+		}
+		return *v.VendorName, true
+	case "version":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.Version, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *AffectedSoftwarePackage) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "architecture":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Architecture, value)
+	case "cpe_name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.CpeName, value)
+	case "epoch":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Epoch, value)
+	case "fixed_in_version":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.FixedInVersion, value)
+	case "hash":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Hash, value)
+		}
+		if v.Hash == nil {
+			v.Hash = &Fingerprint{}
+		}
+		return v.Hash.Set(rest, value)
+	case "license":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.License, value)
+	case "license_url":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.LicenseUrl, value)
+	case "name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.Name, value)
+	case "package_manager":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.PackageManager, value)
+	case "package_manager_url":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.PackageManagerUrl, value)
+	case "path":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Path, value)
+	case "purl":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Purl, value)
+	case "release":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Release, value)
+	case "remediation":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Remediation, value)
+		}
+		if v.Remediation == nil {
+			v.Remediation = &Remediation{}
+		}
+		return v.Remediation.Set(rest, value)
+	case "src_url":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.SrcUrl, value)
+	case "type":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Type, value)
+	case "type_id":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.TypeId, value)
+	case "uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Uid, value)
+	case "vendor_name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.VendorName, value)
+	case "version":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.Version, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *AffectedSoftwarePackage) AppendObservables(path string, observables []Observable) []Observable {
+	if v.Hash != nil {
+		observables = v.Hash.AppendObservables(ocsf.JoinPath(path, "hash"), observables)
+	}
+	if v.Remediation != nil {
+		observables = v.Remediation.AppendObservables(ocsf.JoinPath(path, "remediation"), observables)
+	}
+
+	return observables
+}
+
 var AffectedSoftwarePackageFields = []arrow.Field{
-	{Name: "architecture", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "cpe_name", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "epoch", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "fixed_in_version", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "hash", Type: FingerprintStruct, Nullable: true},
-	{Name: "license", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "license_url", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: false},
-	{Name: "package_manager", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "package_manager_url", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "path", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "purl", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "release", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "remediation", Type: RemediationStruct, Nullable: true},
-	{Name: "src_url", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "type", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "type_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "vendor_name", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "version", Type: arrow.BinaryTypes.String, Nullable: false},
+	{Name: "architecture", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Architecture", "Architecture is a shorthand name describing the type of computer hardware the packaged software is meant to run on.", "optional", "")},
+	{Name: "cpe_name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("The product CPE identifier", "The Common Platform Enumeration (CPE) name as described by (<a target='_blank' href='https://nvd.nist.gov/products/cpe'>NIST</a>) For example: <code>cpe:/a:apple:safari:16.2</code>.", "optional", "")},
+	{Name: "epoch", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Epoch", "The software package epoch. Epoch is a way to define weighted dependencies based on version numbers.", "optional", "")},
+	{Name: "fixed_in_version", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Fixed In Version", "The software package version in which a reported vulnerability was patched/fixed.", "optional", "")},
+	{Name: "hash", Type: FingerprintStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Hash", "Cryptographic hash to identify the binary instance of a software component. This can include any component such file, package, or library.", "optional", "")},
+	{Name: "license", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Software License", "The software license applied to this package.", "optional", "")},
+	{Name: "license_url", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Software License URL", "The URL pointing to the license applied on package or software. This is typically a <code>LICENSE.md</code> file within a repository.", "optional", "")},
+	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: false, Metadata: ocsf.AttributeMetadata("Name", "The software package name.", "required", "")},
+	{Name: "package_manager", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Package Manager", "The software packager manager utilized to manage a package on a system, e.g. npm, yum, dpkg etc.", "optional", "")},
+	{Name: "package_manager_url", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Package Manager URL", "The URL of the package or library at the package manager, or the specific URL or URI of an internal package manager link such as <code>AWS CodeArtifact</code> or <code>Artifactory</code>.", "optional", "")},
+	{Name: "path", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Path", "The installation path of the affected package.", "optional", "")},
+	{Name: "purl", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Package URL", "A purl is a URL string used to identify and locate a software package in a mostly universal and uniform way across programming languages, package managers, packaging conventions, tools, APIs and databases.", "optional", "")},
+	{Name: "release", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Software Release Details", "Release is the number of times a version of the software has been packaged.", "optional", "")},
+	{Name: "remediation", Type: RemediationStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Remediation Guidance", "Describes the recommended remediation steps to address identified issue(s).", "optional", "")},
+	{Name: "src_url", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Source URL", "The link to the specific library or package such as within <code>GitHub</code>, this is different from the link to the package manager where the library or package is hosted.", "optional", "")},
+	{Name: "type", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Type", "The type of software package, normalized to the caption of the <code>type_id</code> value. In the case of 'Other', it is defined by the source.", "optional", "")},
+	{Name: "type_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Type ID", "The type of software package.", "optional", "")},
+	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Package UID", "A unique identifier for the package or library reported by the source tool. E.g., the <code>libId</code> within the <code>sbom</code> field of an OX Security Issue or the SPDX <code>components.*.bom-ref</code>.", "optional", "")},
+	{Name: "vendor_name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Vendor Name", "The name of the vendor who published the software package.", "optional", "")},
+	{Name: "version", Type: arrow.BinaryTypes.String, Nullable: false, Metadata: ocsf.AttributeMetadata("Version", "The software package version.", "required", "")},
 }
 
 var AffectedSoftwarePackageStruct = arrow.StructOf(AffectedSoftwarePackageFields...)
@@ -2,6 +2,7 @@
 package v1_5_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -24,11 +25,125 @@ func (v *Anomaly) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *Anomaly) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *Anomaly) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	if v.ObservationParameter == "" {
+		errs.Add(ocsf.JoinPath(path, "observation_parameter"), "required attribute is missing")
+	}
+	if len(v.Observations) == 0 {
+		errs.Add(ocsf.JoinPath(path, "observations"), "required attribute is missing")
+	}
+	for i := range v.Observations {
+		errs = append(errs, v.Observations[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "observations"), i))...)
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *Anomaly) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "observation_parameter":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.ObservationParameter, true
+	case "observation_type":
+		if index >= 0 || rest != "" || v.ObservationType == nil {
+			return nil, false
+		}
+		return *v.ObservationType, true
+	case "observations":
+		if rest == "" {
+			if index < 0 {
+				return v.Observations, v.Observations != nil
+			}
+			return ocsf.Index(v.Observations, index)
+		}
+		if index < 0 || index >= len(v.Observations) {
+			return nil, false
+		}
+		return v.Observations[index].Get(rest)
+	case "observed_pattern":
+		if index >= 0 || rest != "" || v.ObservedPattern == nil {
+			return nil, false
+		}
+		return *v.ObservedPattern, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *Anomaly) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "observation_parameter":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.ObservationParameter, value)
+	case "observation_type":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.ObservationType, value)
+	case "observations":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Observations, value)
+		}
+		elem, err := ocsf.Element(&v.Observations, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "observed_pattern":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.ObservedPattern, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *Anomaly) AppendObservables(path string, observables []Observable) []Observable {
+	for i := range v.Observations {
+		observables = v.Observations[i].AppendObservables(ocsf.JoinPath(path, "observations"), observables)
+	}
+
+	return observables
+}
+
 var AnomalyFields = []arrow.Field{
-	{Name: "observation_parameter", Type: arrow.BinaryTypes.String, Nullable: false},
-	{Name: "observation_type", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "observations", Type: arrow.ListOf(ObservationStruct), Nullable: false},
-	{Name: "observed_pattern", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "observation_parameter", Type: arrow.BinaryTypes.String, Nullable: false, Metadata: ocsf.AttributeMetadata("Observation Parameter", "The specific parameter, metric or property where the anomaly was observed. Examples include: CPU usage percentage, API response time in milliseconds, HTTP error rate, memory utilization, network latency, transaction volume, etc. This helps identify the exact aspect of the system exhibiting anomalous behavior.", "required", "")},
+	{Name: "observation_type", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Observation Type", "The type of analysis methodology used to detect the anomaly. This indicates how the anomaly was identified through different analytical approaches. Common types include: Frequency Analysis, Time Pattern Analysis, Volume Analysis, Sequence Analysis, Distribution Analysis, etc.", "optional", "")},
+	{Name: "observations", Type: arrow.ListOf(ObservationStruct), Nullable: false, Metadata: ocsf.AttributeMetadata("Observations", "Details about the observed anomaly or observations that were flagged as anomalous compared to expected baseline behavior.", "required", "")},
+	{Name: "observed_pattern", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Observed Pattern", "The specific pattern identified within the observation type. For Frequency Analysis, this could be 'FREQUENT', 'INFREQUENT', 'RARE', or 'UNSEEN'. For Time Pattern Analysis, this could be 'BUSINESS_HOURS', 'OFF_HOURS', or 'UNUSUAL_TIME'. For Volume Analysis, this could be 'NORMAL_VOLUME', 'HIGH_VOLUME', or 'SURGE'. The pattern values are specific to each observation type and indicate how the observed behavior relates to the baseline.", "optional", "")},
 }
 
 var AnomalyStruct = arrow.StructOf(AnomalyFields...)
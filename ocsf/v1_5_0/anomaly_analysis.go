@@ -2,6 +2,7 @@
 package v1_5_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -21,10 +22,158 @@ func (v *AnomalyAnalysis) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *AnomalyAnalysis) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *AnomalyAnalysis) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	if len(v.AnalysisTargets) == 0 {
+		errs.Add(ocsf.JoinPath(path, "analysis_targets"), "required attribute is missing")
+	}
+	for i := range v.AnalysisTargets {
+		errs = append(errs, v.AnalysisTargets[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "analysis_targets"), i))...)
+	}
+	if len(v.Anomalies) == 0 {
+		errs.Add(ocsf.JoinPath(path, "anomalies"), "required attribute is missing")
+	}
+	for i := range v.Anomalies {
+		errs = append(errs, v.Anomalies[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "anomalies"), i))...)
+	}
+	for i := range v.Baselines {
+		errs = append(errs, v.Baselines[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "baselines"), i))...)
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *AnomalyAnalysis) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "analysis_targets":
+		if rest == "" {
+			if index < 0 {
+				return v.AnalysisTargets, v.AnalysisTargets != nil
+			}
+			return ocsf.Index(v.AnalysisTargets, index)
+		}
+		if index < 0 || index >= len(v.AnalysisTargets) {
+			return nil, false
+		}
+		return v.AnalysisTargets[index].Get(rest)
+	case "anomalies":
+		if rest == "" {
+			if index < 0 {
+				return v.Anomalies, v.Anomalies != nil
+			}
+			return ocsf.Index(v.Anomalies, index)
+		}
+		if index < 0 || index >= len(v.Anomalies) {
+			return nil, false
+		}
+		return v.Anomalies[index].Get(rest)
+	case "baselines":
+		if rest == "" {
+			if index < 0 {
+				return v.Baselines, v.Baselines != nil
+			}
+			return ocsf.Index(v.Baselines, index)
+		}
+		if index < 0 || index >= len(v.Baselines) {
+			return nil, false
+		}
+		return v.Baselines[index].Get(rest)
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *AnomalyAnalysis) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "analysis_targets":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.AnalysisTargets, value)
+		}
+		elem, err := ocsf.Element(&v.AnalysisTargets, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "anomalies":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Anomalies, value)
+		}
+		elem, err := ocsf.Element(&v.Anomalies, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "baselines":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Baselines, value)
+		}
+		elem, err := ocsf.Element(&v.Baselines, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *AnomalyAnalysis) AppendObservables(path string, observables []Observable) []Observable {
+	for i := range v.AnalysisTargets {
+		observables = v.AnalysisTargets[i].AppendObservables(ocsf.JoinPath(path, "analysis_targets"), observables)
+	}
+	for i := range v.Anomalies {
+		observables = v.Anomalies[i].AppendObservables(ocsf.JoinPath(path, "anomalies"), observables)
+	}
+	for i := range v.Baselines {
+		observables = v.Baselines[i].AppendObservables(ocsf.JoinPath(path, "baselines"), observables)
+	}
+
+	return observables
+}
+
 var AnomalyAnalysisFields = []arrow.Field{
-	{Name: "analysis_targets", Type: arrow.ListOf(AnalysisTargetStruct), Nullable: false},
-	{Name: "anomalies", Type: arrow.ListOf(AnomalyStruct), Nullable: false},
-	{Name: "baselines", Type: arrow.ListOf(BaselineStruct), Nullable: true},
+	{Name: "analysis_targets", Type: arrow.ListOf(AnalysisTargetStruct), Nullable: false, Metadata: ocsf.AttributeMetadata("Analysis Targets", "The analysis targets define the scope of monitored activities, specifying what entities, systems or processes are analyzed for activity patterns.", "required", "")},
+	{Name: "anomalies", Type: arrow.ListOf(AnomalyStruct), Nullable: false, Metadata: ocsf.AttributeMetadata("Anomalies", "List of detected activities that significantly deviate from the established baselines. This can include unusual access patterns, unexpected user-agents, abnormal API usage, suspicious traffic spikes, unauthorized access attempts, and other activities that may indicate potential security threats or system issues.", "required", "")},
+	{Name: "baselines", Type: arrow.ListOf(BaselineStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("Baselines", "List of established patterns representing normal activity that serve as reference points for anomaly detection. This includes typical user interaction patterns like common user-agents, expected API access frequencies and patterns, standard resource utilization levels, and regular traffic flows. These baselines help establish what constitutes 'normal' activity in the system.", "optional", "")},
 }
 
 var AnomalyAnalysisStruct = arrow.StructOf(AnomalyAnalysisFields...)
@@ -2,6 +2,7 @@
 package v1_5_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -30,13 +31,182 @@ func (v *API) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *API) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *API) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	if v.Group != nil {
+		errs = append(errs, v.Group.ValidateAt(ocsf.JoinPath(path, "group"))...)
+	}
+	if v.Operation == "" {
+		errs.Add(ocsf.JoinPath(path, "operation"), "required attribute is missing")
+	}
+	if v.Request != nil {
+		errs = append(errs, v.Request.ValidateAt(ocsf.JoinPath(path, "request"))...)
+	}
+	if v.Response != nil {
+		errs = append(errs, v.Response.ValidateAt(ocsf.JoinPath(path, "response"))...)
+	}
+	if v.Service != nil {
+		errs = append(errs, v.Service.ValidateAt(ocsf.JoinPath(path, "service"))...)
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *API) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "group":
+		if index >= 0 || v.Group == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Group, true
+		}
+		return v.Group.Get(rest)
+	case "operation":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.Operation, true
+	case "request":
+		if index >= 0 || v.Request == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Request, true
+		}
+		return v.Request.Get(rest)
+	case "response":
+		if index >= 0 || v.Response == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Response, true
+		}
+		return v.Response.Get(rest)
+	case "service":
+		if index >= 0 || v.Service == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Service, true
+		}
+		return v.Service.Get(rest)
+	case "version":
+		if index >= 0 || rest != "" || v.Version == nil {
+			return nil, false
+		}
+		return *v.Version, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *API) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "group":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Group, value)
+		}
+		if v.Group == nil {
+			v.Group = &Group{}
+		}
+		return v.Group.Set(rest, value)
+	case "operation":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.Operation, value)
+	case "request":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Request, value)
+		}
+		if v.Request == nil {
+			v.Request = &RequestElements{}
+		}
+		return v.Request.Set(rest, value)
+	case "response":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Response, value)
+		}
+		if v.Response == nil {
+			v.Response = &ResponseElements{}
+		}
+		return v.Response.Set(rest, value)
+	case "service":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Service, value)
+		}
+		if v.Service == nil {
+			v.Service = &Service{}
+		}
+		return v.Service.Set(rest, value)
+	case "version":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Version, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *API) AppendObservables(path string, observables []Observable) []Observable {
+	if v.Group != nil {
+		observables = v.Group.AppendObservables(ocsf.JoinPath(path, "group"), observables)
+	}
+	if v.Request != nil {
+		observables = v.Request.AppendObservables(ocsf.JoinPath(path, "request"), observables)
+	}
+	if v.Response != nil {
+		observables = v.Response.AppendObservables(ocsf.JoinPath(path, "response"), observables)
+	}
+	if v.Service != nil {
+		observables = v.Service.AppendObservables(ocsf.JoinPath(path, "service"), observables)
+	}
+
+	return observables
+}
+
 var APIFields = []arrow.Field{
-	{Name: "group", Type: GroupStruct, Nullable: true},
-	{Name: "operation", Type: arrow.BinaryTypes.String, Nullable: false},
-	{Name: "request", Type: RequestElementsStruct, Nullable: true},
-	{Name: "response", Type: ResponseElementsStruct, Nullable: true},
-	{Name: "service", Type: ServiceStruct, Nullable: true},
-	{Name: "version", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "group", Type: GroupStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Group", "The information pertaining to the API group.", "optional", "")},
+	{Name: "operation", Type: arrow.BinaryTypes.String, Nullable: false, Metadata: ocsf.AttributeMetadata("Operation", "Verb/Operation associated with the request", "required", "")},
+	{Name: "request", Type: RequestElementsStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("API Request Details", "Details pertaining to the API request.", "optional", "")},
+	{Name: "response", Type: ResponseElementsStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("API Response Details", "Details pertaining to the API response.", "optional", "")},
+	{Name: "service", Type: ServiceStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Service", "The information pertaining to the API service.", "optional", "")},
+	{Name: "version", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Version", "The version of the API service.", "optional", "")},
 }
 
 var APIStruct = arrow.StructOf(APIFields...)
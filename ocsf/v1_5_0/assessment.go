@@ -2,6 +2,7 @@
 package v1_5_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -30,13 +31,134 @@ func (v *Assessment) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *Assessment) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *Assessment) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	if v.Policy != nil {
+		errs = append(errs, v.Policy.ValidateAt(ocsf.JoinPath(path, "policy"))...)
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *Assessment) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "category":
+		if index >= 0 || rest != "" || v.Category == nil {
+			return nil, false
+		}
+		return *v.Category, true
+	case "desc":
+		if index >= 0 || rest != "" || v.Desc == nil {
+			return nil, false
+		}
+		return *v.Desc, true
+	case "meets_criteria":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.MeetsCriteria, true
+	case "name":
+		if index >= 0 || rest != "" || v.Name == nil {
+			return nil, false
+		}
+		return *v.Name, true
+	case "policy":
+		if index >= 0 || v.Policy == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Policy, true
+		}
+		return v.Policy.Get(rest)
+	case "uid":
+		if index >= 0 || rest != "" || v.Uid == nil {
+			return nil, false
+		}
+		return *v.Uid, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *Assessment) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "category":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Category, value)
+	case "desc":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Desc, value)
+	case "meets_criteria":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.MeetsCriteria, value)
+	case "name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Name, value)
+	case "policy":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Policy, value)
+		}
+		if v.Policy == nil {
+			v.Policy = &Policy{}
+		}
+		return v.Policy.Set(rest, value)
+	case "uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Uid, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *Assessment) AppendObservables(path string, observables []Observable) []Observable {
+	if v.Policy != nil {
+		observables = v.Policy.AppendObservables(ocsf.JoinPath(path, "policy"), observables)
+	}
+
+	return observables
+}
+
 var AssessmentFields = []arrow.Field{
-	{Name: "category", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "desc", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "meets_criteria", Type: arrow.FixedWidthTypes.Boolean, Nullable: false},
-	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "policy", Type: PolicyStruct, Nullable: true},
-	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "category", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Category", "The category that the assessment is part of. For example: <code>Prevention</code> or <code>Windows 10</code>.", "optional", "")},
+	{Name: "desc", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Description", "The description of the assessment criteria, or a description of the specific configuration or signal the assessment is targeting.", "optional", "")},
+	{Name: "meets_criteria", Type: arrow.FixedWidthTypes.Boolean, Nullable: false, Metadata: ocsf.AttributeMetadata("Meets Criteria", "Determines whether the assessment against the specific configuration or signal meets the assessments criteria. For example, if the assessment checks if a <code>Datastore</code> is encrypted or not, having encryption would be evaluated as <code>true</code>.", "required", "")},
+	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Name", "The name of the configuration or signal being assessed. For example: <code>Kernel Mode Code Integrity (KMCI)</code> or <code>publicAccessibilityState</code>.", "optional", "")},
+	{Name: "policy", Type: PolicyStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Assessment Policy", "The details of any policy associated with an assessment.", "optional", "")},
+	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Unique ID", "The unique identifier of the configuration or signal being assessed. For example: the <code>signal_id</code>.", "optional", "")},
 }
 
 var AssessmentStruct = arrow.StructOf(AssessmentFields...)
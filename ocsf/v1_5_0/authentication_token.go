@@ -2,6 +2,7 @@
 package v1_5_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -33,14 +34,145 @@ func (v *AuthenticationToken) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *AuthenticationToken) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *AuthenticationToken) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	if v.EncryptionDetails != nil {
+		errs = append(errs, v.EncryptionDetails.ValidateAt(ocsf.JoinPath(path, "encryption_details"))...)
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *AuthenticationToken) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "created_time":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.CreatedTime, true
+	case "encryption_details":
+		if index >= 0 || v.EncryptionDetails == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.EncryptionDetails, true
+		}
+		return v.EncryptionDetails.Get(rest)
+	case "expiration_time":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.ExpirationTime, true
+	case "is_renewable":
+		if index >= 0 || rest != "" || v.IsRenewable == nil {
+			return nil, false
+		}
+		return *v.IsRenewable, true
+	case "kerberos_flags":
+		if index >= 0 || rest != "" || v.KerberosFlags == nil {
+			return nil, false
+		}
+		return *v.KerberosFlags, true
+	case "type":
+		if index >= 0 || rest != "" || v.Type == nil {
+			return nil, false
+		}
+		return *v.Type, true
+	case "type_id":
+		if index >= 0 || rest != "" || v.TypeId == nil {
+			return nil, false
+		}
+		return *v.TypeId, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *AuthenticationToken) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "created_time":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.CreatedTime, value)
+	case "encryption_details":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.EncryptionDetails, value)
+		}
+		if v.EncryptionDetails == nil {
+			v.EncryptionDetails = &EncryptionDetails{}
+		}
+		return v.EncryptionDetails.Set(rest, value)
+	case "expiration_time":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.ExpirationTime, value)
+	case "is_renewable":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.IsRenewable, value)
+	case "kerberos_flags":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.KerberosFlags, value)
+	case "type":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Type, value)
+	case "type_id":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.TypeId, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *AuthenticationToken) AppendObservables(path string, observables []Observable) []Observable {
+	if v.EncryptionDetails != nil {
+		observables = v.EncryptionDetails.AppendObservables(ocsf.JoinPath(path, "encryption_details"), observables)
+	}
+
+	return observables
+}
+
 var AuthenticationTokenFields = []arrow.Field{
-	{Name: "created_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
-	{Name: "encryption_details", Type: EncryptionDetailsStruct, Nullable: true},
-	{Name: "expiration_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
-	{Name: "is_renewable", Type: arrow.FixedWidthTypes.Boolean, Nullable: true},
-	{Name: "kerberos_flags", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "type", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "type_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
+	{Name: "created_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true, Metadata: ocsf.AttributeMetadata("Created Time", "The time that the authentication token was created.", "optional", "")},
+	{Name: "encryption_details", Type: EncryptionDetailsStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Encryption Details", "The encryption details of the authentication token.", "optional", "")},
+	{Name: "expiration_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true, Metadata: ocsf.AttributeMetadata("Expiration Time", "The expiration time of the authentication token.", "optional", "")},
+	{Name: "is_renewable", Type: arrow.FixedWidthTypes.Boolean, Nullable: true, Metadata: ocsf.AttributeMetadata("Renewable", "Indicates whether the authentication token is renewable.", "optional", "")},
+	{Name: "kerberos_flags", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Kerberos Flags", "A bitmask, either in hexadecimal or decimal form, which encodes various attributes or permissions associated with a Kerberos ticket. These flags delineate specific characteristics of the ticket, such as its renewability or forwardability.", "optional", "")},
+	{Name: "type", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Type", "The type of the authentication token.", "optional", "")},
+	{Name: "type_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Type ID", "The normalized authentication token type identifier.", "optional", "")},
 }
 
 var AuthenticationTokenStruct = arrow.StructOf(AuthenticationTokenFields...)
@@ -2,6 +2,7 @@
 package v1_5_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -21,10 +22,111 @@ func (v *CISBenchmark) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *CISBenchmark) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *CISBenchmark) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	for i := range v.CisControls {
+		errs = append(errs, v.CisControls[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "cis_controls"), i))...)
+	}
+	if v.Name == "" {
+		errs.Add(ocsf.JoinPath(path, "name"), "required attribute is missing")
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *CISBenchmark) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "cis_controls":
+		if rest == "" {
+			if index < 0 {
+				return v.CisControls, v.CisControls != nil
+			}
+			return ocsf.Index(v.CisControls, index)
+		}
+		if index < 0 || index >= len(v.CisControls) {
+			return nil, false
+		}
+		return v.CisControls[index].Get(rest)
+	case "desc":
+		if index >= 0 || rest != "" || v.Desc == nil {
+			return nil, false
+		}
+		return *v.Desc, true
+	case "name":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.Name, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *CISBenchmark) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "cis_controls":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.CisControls, value)
+		}
+		elem, err := ocsf.Element(&v.CisControls, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "desc":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Desc, value)
+	case "name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.Name, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *CISBenchmark) AppendObservables(path string, observables []Observable) []Observable {
+	for i := range v.CisControls {
+		observables = v.CisControls[i].AppendObservables(ocsf.JoinPath(path, "cis_controls"), observables)
+	}
+
+	return observables
+}
+
 var CISBenchmarkFields = []arrow.Field{
-	{Name: "cis_controls", Type: arrow.ListOf(CISControlStruct), Nullable: true},
-	{Name: "desc", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: false},
+	{Name: "cis_controls", Type: arrow.ListOf(CISControlStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("CIS Controls", "The CIS Critical Security Controls is a prioritized set of actions to protect your organization and data from cyber-attack vectors.", "optional", "")},
+	{Name: "desc", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Description", "The CIS Benchmark description. For example: <i>The cramfs filesystem type is a compressed read-only Linux filesystem embedded in small footprint systems. A cramfs image can be used without having to first decompress the image.</i>", "optional", "")},
+	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: false, Metadata: ocsf.AttributeMetadata("Name", "The CIS Benchmark name. For example: <i>Ensure mounting of cramfs filesystems is disabled.</i>", "required", "")},
 }
 
 var CISBenchmarkStruct = arrow.StructOf(CISBenchmarkFields...)
@@ -2,6 +2,7 @@
 package v1_5_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -48,19 +49,290 @@ func (v *Compliance) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *Compliance) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *Compliance) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	for i := range v.Assessments {
+		errs = append(errs, v.Assessments[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "assessments"), i))...)
+	}
+	for i := range v.Checks {
+		errs = append(errs, v.Checks[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "checks"), i))...)
+	}
+	for i := range v.ControlParameters {
+		errs = append(errs, v.ControlParameters[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "control_parameters"), i))...)
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *Compliance) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "assessments":
+		if rest == "" {
+			if index < 0 {
+				return v.Assessments, v.Assessments != nil
+			}
+			return ocsf.Index(v.Assessments, index)
+		}
+		if index < 0 || index >= len(v.Assessments) {
+			return nil, false
+		}
+		return v.Assessments[index].Get(rest)
+	case "category":
+		if index >= 0 || rest != "" || v.Category == nil {
+			return nil, false
+		}
+		return *v.Category, true
+	case "checks":
+		if rest == "" {
+			if index < 0 {
+				return v.Checks, v.Checks != nil
+			}
+			return ocsf.Index(v.Checks, index)
+		}
+		if index < 0 || index >= len(v.Checks) {
+			return nil, false
+		}
+		return v.Checks[index].Get(rest)
+	case "control":
+		if index >= 0 || rest != "" || v.Control == nil {
+			return nil, false
+		}
+		return *v.Control, true
+	case "control_parameters":
+		if rest == "" {
+			if index < 0 {
+				return v.ControlParameters, v.ControlParameters != nil
+			}
+			return ocsf.Index(v.ControlParameters, index)
+		}
+		if index < 0 || index >= len(v.ControlParameters) {
+			return nil, false
+		}
+		return v.ControlParameters[index].Get(rest)
+	case "desc":
+		if index >= 0 || rest != "" || v.Desc == nil {
+			return nil, false
+		}
+		return *v.Desc, true
+	case "requirements":
+		if rest == "" {
+			if index < 0 {
+				return v.Requirements, v.Requirements != nil
+			}
+			return ocsf.Index(v.Requirements, index)
+		}
+		return nil, false
+	case "standards":
+		if rest == "" {
+			if index < 0 {
+				return v.Standards, v.Standards != nil
+			}
+			return ocsf.Index(v.Standards, index)
+		}
+		return nil, false
+	case "status":
+		if index >= 0 || rest != "" || v.Status == nil {
+			return nil, false
+		}
+		return *v.Status, true
+	case "status_code":
+		if index >= 0 || rest != "" || v.StatusCode == nil {
+			return nil, false
+		}
+		return *v.StatusCode, true
+	case "status_details":
+		if rest == "" {
+			if index < 0 {
+				return v.StatusDetails, v.StatusDetails != nil
+			}
+			return ocsf.Index(v.StatusDetails, index)
+		}
+		return nil, false
+	case "status_id":
+		if index >= 0 || rest != "" || v.StatusId == nil {
+			return nil, false
+		}
+		return *v.StatusId, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *Compliance) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "assessments":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Assessments, value)
+		}
+		elem, err := ocsf.Element(&v.Assessments, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "category":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Category, value)
+	case "checks":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Checks, value)
+		}
+		elem, err := ocsf.Element(&v.Checks, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "control":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Control, value)
+	case "control_parameters":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.ControlParameters, value)
+		}
+		elem, err := ocsf.Element(&v.ControlParameters, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "desc":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Desc, value)
+	case "requirements":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Requirements, value)
+		}
+		elem, err := ocsf.Element(&v.Requirements, index)
+		if err != nil {
+			return err
+		}
+		if rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(elem, value)
+	case "standards":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Standards, value)
+		}
+		elem, err := ocsf.Element(&v.Standards, index)
+		if err != nil {
+			return err
+		}
+		if rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(elem, value)
+	case "status":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Status, value)
+	case "status_code":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.StatusCode, value)
+	case "status_details":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.StatusDetails, value)
+		}
+		elem, err := ocsf.Element(&v.StatusDetails, index)
+		if err != nil {
+			return err
+		}
+		if rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(elem, value)
+	case "status_id":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.StatusId, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *Compliance) AppendObservables(path string, observables []Observable) []Observable {
+	for i := range v.Assessments {
+		observables = v.Assessments[i].AppendObservables(ocsf.JoinPath(path, "assessments"), observables)
+	}
+	for i := range v.Checks {
+		observables = v.Checks[i].AppendObservables(ocsf.JoinPath(path, "checks"), observables)
+	}
+	for i := range v.ControlParameters {
+		observables = v.ControlParameters[i].AppendObservables(ocsf.JoinPath(path, "control_parameters"), observables)
+	}
+
+	return observables
+}
+
 var ComplianceFields = []arrow.Field{
-	{Name: "assessments", Type: arrow.ListOf(AssessmentStruct), Nullable: true},
-	{Name: "category", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "checks", Type: arrow.ListOf(CheckStruct), Nullable: true},
-	{Name: "control", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "control_parameters", Type: arrow.ListOf(KeyValueobjectStruct), Nullable: true},
-	{Name: "desc", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "requirements", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true},
-	{Name: "standards", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true},
-	{Name: "status", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "status_code", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "status_details", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true},
-	{Name: "status_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
+	{Name: "assessments", Type: arrow.ListOf(AssessmentStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("Assessments", "A list of assessments associated with the compliance requirements evaluation.", "optional", "")},
+	{Name: "category", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Category", "The category a control framework pertains to, as reported by the source tool, such as <code>Asset Management</code> or <code>Risk Assessment</code>.", "optional", "")},
+	{Name: "checks", Type: arrow.ListOf(CheckStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("Compliance Checks", "A list of compliance checks associated with specific industry standards or frameworks. Each check represents an individual rule or requirement that has been evaluated against a target device. Checks typically include details such as the check name (e.g., CIS: 'Ensure mounting of cramfs filesystems is disabled' or DISA STIG descriptive titles), unique identifiers (such as CIS identifier '1.1.1.1' or DISA STIG identifier 'V-230234'), descriptions (detailed explanations of security requirements or vulnerability discussions), and version information.", "optional", "")},
+	{Name: "control", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Security Control", "A Control is a prescriptive, actionable set of specifications that strengthens device posture. The control specifies required security measures, while the specific implementation values are defined in control_parameters. E.g., CIS AWS Foundations Benchmark 1.2.0 - Control 2.1 - Ensure CloudTrail is enabled in all regions", "optional", "")},
+	{Name: "control_parameters", Type: arrow.ListOf(KeyValueobjectStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("Control Parameters", "The list of control parameters evaluated in a Compliance check. E.g., parameters for CloudTrail configuration might include <code>multiRegionTrailEnabled: true</code>, <code>logFileValidationEnabled: true</code>, and <code>requiredRegions: [us-east-1, us-west-2]</code>", "optional", "")},
+	{Name: "desc", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Description", "The description or criteria of a control.", "optional", "")},
+	{Name: "requirements", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true, Metadata: ocsf.AttributeMetadata("Compliance Requirements", "The specific compliance requirements being evaluated. E.g., <code>PCI DSS Requirement 8.2.3 - Passwords must meet minimum complexity requirements</code> or <code>HIPAA Security Rule 164.312(a)(2)(iv) - Implement encryption and decryption mechanisms</code>", "optional", "")},
+	{Name: "standards", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true, Metadata: ocsf.AttributeMetadata("Compliance Standards", "List: The regulatory or industry standards being evaluated for compliance.", "optional", "")},
+	{Name: "status", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Status", "The resultant status of the compliance check normalized to the caption of the <code>status_id</code> value. In the case of 'Other', it is defined by the event source.", "optional", "")},
+	{Name: "status_code", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Status Code", "The resultant status code of the compliance check.", "optional", "")},
+	{Name: "status_details", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true, Metadata: ocsf.AttributeMetadata("Status Details", "A list of contextual descriptions of the <code>status, status_code</code> values.", "optional", "")},
+	{Name: "status_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Status ID", "The normalized status identifier of the compliance check.", "optional", "")},
 }
 
 var ComplianceStruct = arrow.StructOf(ComplianceFields...)
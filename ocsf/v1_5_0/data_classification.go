@@ -2,6 +2,7 @@
 package v1_5_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -54,21 +55,272 @@ func (v *DataClassification) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *DataClassification) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *DataClassification) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	if v.ClassifierDetails != nil {
+		errs = append(errs, v.ClassifierDetails.ValidateAt(ocsf.JoinPath(path, "classifier_details"))...)
+	}
+	for i := range v.DiscoveryDetails {
+		errs = append(errs, v.DiscoveryDetails[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "discovery_details"), i))...)
+	}
+	if v.Policy != nil {
+		errs = append(errs, v.Policy.ValidateAt(ocsf.JoinPath(path, "policy"))...)
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *DataClassification) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "category":
+		if index >= 0 || rest != "" || v.Category == nil {
+			return nil, false
+		}
+		return *v.Category, true
+	case "category_id":
+		if index >= 0 || rest != "" || v.CategoryId == nil {
+			return nil, false
+		}
+		return *v.CategoryId, true
+	case "classifier_details":
+		if index >= 0 || v.ClassifierDetails == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.ClassifierDetails, true
+		}
+		return v.ClassifierDetails.Get(rest)
+	case "confidentiality":
+		if index >= 0 || rest != "" || v.Confidentiality == nil {
+			return nil, false
+		}
+		return *v.Confidentiality, true
+	case "confidentiality_id":
+		if index >= 0 || rest != "" || v.ConfidentialityId == nil {
+			return nil, false
+		}
+		return *v.ConfidentialityId, true
+	case "discovery_details":
+		if rest == "" {
+			if index < 0 {
+				return v.DiscoveryDetails, v.DiscoveryDetails != nil
+			}
+			return ocsf.Index(v.DiscoveryDetails, index)
+		}
+		if index < 0 || index >= len(v.DiscoveryDetails) {
+			return nil, false
+		}
+		return v.DiscoveryDetails[index].Get(rest)
+	case "policy":
+		if index >= 0 || v.Policy == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Policy, true
+		}
+		return v.Policy.Get(rest)
+	case "size":
+		if index >= 0 || rest != "" || v.Size == nil {
+			return nil, false
+		}
+		return *v.Size, true
+	case "src_url":
+		if index >= 0 || rest != "" || v.SrcUrl == nil {
+			return nil, false
+		}
+		return *v.SrcUrl, true
+	case "status":
+		if index >= 0 || rest != "" || v.Status == nil {
+			return nil, false
+		}
+		return *v.Status, true
+	case "status_details":
+		if rest == "" {
+			if index < 0 {
+				return v.StatusDetails, v.StatusDetails != nil
+			}
+			return ocsf.Index(v.StatusDetails, index)
+		}
+		return nil, false
+	case "status_id":
+		if index >= 0 || rest != "" || v.StatusId == nil {
+			return nil, false
+		}
+		return *v.StatusId, true
+	case "total":
+		if index >= 0 || rest != "" || v.Total == nil {
+			return nil, false
+		}
+		return *v.Total, true
+	case "uid":
+		if index >= 0 || rest != "" || v.Uid == nil {
+			return nil, false
+		}
+		return *v.Uid, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *DataClassification) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "category":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Category, value)
+	case "category_id":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.CategoryId, value)
+	case "classifier_details":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.ClassifierDetails, value)
+		}
+		if v.ClassifierDetails == nil {
+			v.ClassifierDetails = &ClassifierDetails{}
+		}
+		return v.ClassifierDetails.Set(rest, value)
+	case "confidentiality":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Confidentiality, value)
+	case "confidentiality_id":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.ConfidentialityId, value)
+	case "discovery_details":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.DiscoveryDetails, value)
+		}
+		elem, err := ocsf.Element(&v.DiscoveryDetails, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "policy":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Policy, value)
+		}
+		if v.Policy == nil {
+			v.Policy = &Policy{}
+		}
+		return v.Policy.Set(rest, value)
+	case "size":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Size, value)
+	case "src_url":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.SrcUrl, value)
+	case "status":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Status, value)
+	case "status_details":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.StatusDetails, value)
+		}
+		elem, err := ocsf.Element(&v.StatusDetails, index)
+		if err != nil {
+			return err
+		}
+		if rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(elem, value)
+	case "status_id":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.StatusId, value)
+	case "total":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Total, value)
+	case "uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Uid, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *DataClassification) AppendObservables(path string, observables []Observable) []Observable {
+	if v.ClassifierDetails != nil {
+		observables = v.ClassifierDetails.AppendObservables(ocsf.JoinPath(path, "classifier_details"), observables)
+	}
+	for i := range v.DiscoveryDetails {
+		observables = v.DiscoveryDetails[i].AppendObservables(ocsf.JoinPath(path, "discovery_details"), observables)
+	}
+	if v.Policy != nil {
+		observables = v.Policy.AppendObservables(ocsf.JoinPath(path, "policy"), observables)
+	}
+
+	return observables
+}
+
 var DataClassificationFields = []arrow.Field{
-	{Name: "category", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "category_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "classifier_details", Type: ClassifierDetailsStruct, Nullable: true},
-	{Name: "confidentiality", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "confidentiality_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "discovery_details", Type: arrow.ListOf(DiscoveryDetailsStruct), Nullable: true},
-	{Name: "policy", Type: PolicyStruct, Nullable: true},
-	{Name: "size", Type: arrow.PrimitiveTypes.Int64, Nullable: true},
-	{Name: "src_url", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "status", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "status_details", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true},
-	{Name: "status_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "total", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "category", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Category", "The name of the data classification category that data matched into, e.g. Financial, Personal, Governmental, etc.", "optional", "")},
+	{Name: "category_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Category ID", "The normalized identifier of the data classification category.", "optional", "")},
+	{Name: "classifier_details", Type: ClassifierDetailsStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Classifier Details", "Describes details about the classifier used for data classification.", "optional", "")},
+	{Name: "confidentiality", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Confidentiality", "The file content confidentiality, normalized to the confidentiality_id value. In the case of 'Other', it is defined by the event source.", "optional", "")},
+	{Name: "confidentiality_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Confidentiality ID", "The normalized identifier of the file content confidentiality indicator.", "optional", "")},
+	{Name: "discovery_details", Type: arrow.ListOf(DiscoveryDetailsStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("Discovery Details", "Details about the data discovered by classification job.", "optional", "")},
+	{Name: "policy", Type: PolicyStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Policy", "Details about the data policy that governs data handling and security measures related to classification.", "optional", "")},
+	{Name: "size", Type: arrow.PrimitiveTypes.Int64, Nullable: true, Metadata: ocsf.AttributeMetadata("Size", "Size of the data classified.", "optional", "")},
+	{Name: "src_url", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Source URL", "The source URL pointing towards the full classifcation job details.", "optional", "")},
+	{Name: "status", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Status", "The resultant status of the classification job normalized to the caption of the <code>status_id</code> value. In the case of 'Other', it is defined by the event source.", "optional", "")},
+	{Name: "status_details", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true, Metadata: ocsf.AttributeMetadata("Status Details", "The contextual description of the <code>status, status_id</code> value.", "optional", "")},
+	{Name: "status_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Status ID", "The normalized status identifier of the classification job.", "optional", "")},
+	{Name: "total", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Total", "The total count of discovered entities, by the classification job.", "optional", "")},
+	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Unique ID", "The unique identifier of the classification job.", "optional", "")},
 }
 
 var DataClassificationStruct = arrow.StructOf(DataClassificationFields...)
@@ -2,6 +2,7 @@
 package v1_5_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -27,12 +28,132 @@ func (v *DCERPC) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *DCERPC) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *DCERPC) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	if len(v.Flags) == 0 {
+		errs.Add(ocsf.JoinPath(path, "flags"), "required attribute is missing")
+	}
+	errs = append(errs, v.RpcInterface.ValidateAt(ocsf.JoinPath(path, "rpc_interface"))...)
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *DCERPC) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "command":
+		if index >= 0 || rest != "" || v.Command == nil {
+			return nil, false
+		}
+		return *v.Command, true
+	case "command_response":
+		if index >= 0 || rest != "" || v.CommandResponse == nil {
+			return nil, false
+		}
+		return *v.CommandResponse, true
+	case "flags":
+		if rest == "" {
+			if index < 0 {
+				return v.Flags, v.Flags != nil
+			}
+			return ocsf.Index(v.Flags, index)
+		}
+		return nil, false
+	case "opnum":
+		if index >= 0 || rest != "" || v.Opnum == nil {
+			return nil, false
+		}
+		return *v.Opnum, true
+	case "rpc_interface":
+		if index >= 0 {
+			return nil, false
+		}
+		if rest == "" {
+			return &v.RpcInterface, true
+		}
+		return v.RpcInterface.Get(rest)
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *DCERPC) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "command":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Command, value)
+	case "command_response":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.CommandResponse, value)
+	case "flags":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Flags, value)
+		}
+		elem, err := ocsf.Element(&v.Flags, index)
+		if err != nil {
+			return err
+		}
+		if rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(elem, value)
+	case "opnum":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Opnum, value)
+	case "rpc_interface":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetRequired(&v.RpcInterface, value)
+		}
+		return v.RpcInterface.Set(rest, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *DCERPC) AppendObservables(path string, observables []Observable) []Observable {
+	observables = v.RpcInterface.AppendObservables(ocsf.JoinPath(path, "rpc_interface"), observables)
+
+	return observables
+}
+
 var DCERPCFields = []arrow.Field{
-	{Name: "command", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "command_response", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "flags", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: false},
-	{Name: "opnum", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "rpc_interface", Type: RPCInterfaceStruct, Nullable: false},
+	{Name: "command", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Command", "The request command (e.g. REQUEST, BIND).", "optional", "")},
+	{Name: "command_response", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Command Response", "The reply to the request command (e.g. RESPONSE, BINDACK or FAULT).", "optional", "")},
+	{Name: "flags", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: false, Metadata: ocsf.AttributeMetadata("Flags", "The list of interface flags.", "required", "")},
+	{Name: "opnum", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Opnum", "An operation number used to identify a specific remote procedure call (RPC) method or a method in an interface.", "optional", "")},
+	{Name: "rpc_interface", Type: RPCInterfaceStruct, Nullable: false, Metadata: ocsf.AttributeMetadata("Remote Procedure Call Interface", "The RPC Interface object describes the details pertaining to the remote procedure call interface.", "required", "")},
 }
 
 var DCERPCStruct = arrow.StructOf(DCERPCFields...)
@@ -0,0 +1,64 @@
+package v1_5_0
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestDecode(t *testing.T) {
+	finding := NewVulnerabilityFinding(VulnerabilityFindingActivityIdCreate)
+	finding.FindingInfo.Uid = "finding-1"
+	data, err := json.Marshal(finding)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	event, err := Decode(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	decoded, ok := event.(*VulnerabilityFinding)
+	if !ok {
+		t.Fatalf("got %T, want *VulnerabilityFinding", event)
+	}
+	if decoded.FindingInfo.Uid != "finding-1" || decoded.TypeUid != 200201 {
+		t.Errorf("got finding_info.uid %q and type_uid %d, want finding-1 and 200201", decoded.FindingInfo.Uid, decoded.TypeUid)
+	}
+
+	for _, data := range []string{`{"class_uid": 9999}`, `{"activity_id": 1}`, `[`} {
+		if _, err := Decode([]byte(data)); err == nil {
+			t.Errorf("expected an error decoding %s", data)
+		}
+	}
+}
+
+func TestNewDecoder(t *testing.T) {
+	stream := `{"class_uid": 6003, "api": {"operation": "GetObject"}}
+{"class_uid": 4001}
+{"class_uid": 9999}
+{"class_uid": 2002, "finding_info": {"uid": "finding-1"}}
+`
+	decoder := NewDecoder(strings.NewReader(stream))
+
+	var classes []int32
+	for {
+		event, err := decoder.Decode()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			if !strings.Contains(err.Error(), "event 3") {
+				t.Errorf("got %v, want an error for the third event", err)
+			}
+			continue
+		}
+		classes = append(classes, event.GetClassUid())
+	}
+
+	want := []int32{APIActivityClassUid, 4001, VulnerabilityFindingClassUid}
+	if len(classes) != len(want) || classes[0] != want[0] || classes[1] != want[1] || classes[2] != want[2] {
+		t.Errorf("got classes %v, want %v", classes, want)
+	}
+}
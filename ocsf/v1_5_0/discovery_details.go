@@ -2,6 +2,7 @@
 package v1_5_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -24,11 +25,119 @@ func (v *DiscoveryDetails) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *DiscoveryDetails) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *DiscoveryDetails) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	for i := range v.Occurrences {
+		errs = append(errs, v.Occurrences[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "occurrences"), i))...)
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *DiscoveryDetails) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "count":
+		if index >= 0 || rest != "" || v.Count == nil {
+			return nil, false
+		}
+		return *v.Count, true
+	case "occurrences":
+		if rest == "" {
+			if index < 0 {
+				return v.Occurrences, v.Occurrences != nil
+			}
+			return ocsf.Index(v.Occurrences, index)
+		}
+		if index < 0 || index >= len(v.Occurrences) {
+			return nil, false
+		}
+		return v.Occurrences[index].Get(rest)
+	case "type":
+		if index >= 0 || rest != "" || v.Type == nil {
+			return nil, false
+		}
+		return *v.Type, true
+	case "value":
+		if index >= 0 || rest != "" || v.Value == nil {
+			return nil, false
+		}
+		return *v.Value, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *DiscoveryDetails) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "count":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Count, value)
+	case "occurrences":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Occurrences, value)
+		}
+		elem, err := ocsf.Element(&v.Occurrences, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "type":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Type, value)
+	case "value":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Value, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *DiscoveryDetails) AppendObservables(path string, observables []Observable) []Observable {
+	for i := range v.Occurrences {
+		observables = v.Occurrences[i].AppendObservables(ocsf.JoinPath(path, "occurrences"), observables)
+	}
+
+	return observables
+}
+
 var DiscoveryDetailsFields = []arrow.Field{
-	{Name: "count", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "occurrences", Type: arrow.ListOf(OccurrenceDetailsStruct), Nullable: true},
-	{Name: "type", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "value", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "count", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Count", "The number of discovered entities of the specified type.", "optional", "")},
+	{Name: "occurrences", Type: arrow.ListOf(OccurrenceDetailsStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("Occurrences", "Details about where in the target entity, specified information was discovered. Only the attributes, relevant to the target entity type should be populuated.", "optional", "")},
+	{Name: "type", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Type", "The specific type of information that was discovered. e.g.<code> name, phone_number, etc.</code>", "optional", "")},
+	{Name: "value", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Value", "Optionally, the specific value of discovered information.", "optional", "")},
 }
 
 var DiscoveryDetailsStruct = arrow.StructOf(DiscoveryDetailsFields...)
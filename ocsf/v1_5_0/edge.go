@@ -2,13 +2,14 @@
 package v1_5_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
 type Edge struct {
 
 	// Data: Additional data about the edge such as weight, distance, or custom properties.
-	Data *string `json:"data,omitempty" parquet:"data,optional"`
+	Data *ocsf.RawJSON `json:"data,omitempty" parquet:"data,optional"`
 
 	// Directed: Indicates whether the edge is (<code>true</code>) or undirected (<code>false</code>).
 	IsDirected *bool `json:"is_directed,omitempty" parquet:"is_directed,optional"`
@@ -33,14 +34,136 @@ func (v *Edge) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *Edge) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *Edge) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	if v.Source == "" {
+		errs.Add(ocsf.JoinPath(path, "source"), "required attribute is missing")
+	}
+	if v.Target == "" {
+		errs.Add(ocsf.JoinPath(path, "target"), "required attribute is missing")
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *Edge) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "data":
+		if index >= 0 || rest != "" || v.Data == nil {
+			return nil, false
+		}
+		return *v.Data, true
+	case "is_directed":
+		if index >= 0 || rest != "" || v.IsDirected == nil {
+			return nil, false
+		}
+		return *v.IsDirected, true
+	case "name":
+		if index >= 0 || rest != "" || v.Name == nil {
+			return nil, false
+		}
+		return *v.Name, true
+	case "relation":
+		if index >= 0 || rest != "" || v.Relation == nil {
+			return nil, false
+		}
+		return *v.Relation, true
+	case "source":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.Source, true
+	case "target":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.Target, true
+	case "uid":
+		if index >= 0 || rest != "" || v.Uid == nil {
+			return nil, false
+		}
+		return *v.Uid, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *Edge) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "data":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Data, value)
+	case "is_directed":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.IsDirected, value)
+	case "name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Name, value)
+	case "relation":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Relation, value)
+	case "source":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.Source, value)
+	case "target":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.Target, value)
+	case "uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Uid, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *Edge) AppendObservables(path string, observables []Observable) []Observable {
+
+	return observables
+}
+
 var EdgeFields = []arrow.Field{
-	{Name: "data", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "is_directed", Type: arrow.FixedWidthTypes.Boolean, Nullable: true},
-	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "relation", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "source", Type: arrow.BinaryTypes.String, Nullable: false},
-	{Name: "target", Type: arrow.BinaryTypes.String, Nullable: false},
-	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "data", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Data", "Additional data about the edge such as weight, distance, or custom properties.", "optional", "")},
+	{Name: "is_directed", Type: arrow.FixedWidthTypes.Boolean, Nullable: true, Metadata: ocsf.AttributeMetadata("Directed", "Indicates whether the edge is (<code>true</code>) or undirected (<code>false</code>).", "optional", "")},
+	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Name", "The human-readable name or label for the edge.", "optional", "")},
+	{Name: "relation", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Relation", "The type of relationship between nodes (e.g. is-attached-to , depends-on, etc).", "optional", "")},
+	{Name: "source", Type: arrow.BinaryTypes.String, Nullable: false, Metadata: ocsf.AttributeMetadata("Source", "The unique identifier of the node where the edge originates.", "required", "")},
+	{Name: "target", Type: arrow.BinaryTypes.String, Nullable: false, Metadata: ocsf.AttributeMetadata("Target", "The unique identifier of the node where the edge terminates.", "required", "")},
+	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Unique ID", "Unique identifier of the edge.", "optional", "")},
 }
 
 var EdgeStruct = arrow.StructOf(EdgeFields...)
@@ -2,6 +2,7 @@
 package v1_5_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -70,26 +71,444 @@ func (v *Email) Observable() (*int, string) {
 	return &typeId, "email"
 }
 
+func (v *Email) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *Email) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	for i := range v.DataClassifications {
+		errs = append(errs, v.DataClassifications[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "data_classifications"), i))...)
+	}
+	for i := range v.Files {
+		errs = append(errs, v.Files[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "files"), i))...)
+	}
+	for i := range v.HttpHeaders {
+		errs = append(errs, v.HttpHeaders[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "http_headers"), i))...)
+	}
+	for i := range v.Urls {
+		errs = append(errs, v.Urls[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "urls"), i))...)
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *Email) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "cc":
+		if rest == "" {
+			if index < 0 {
+				return v.Cc, v.Cc != nil
+			}
+			return ocsf.Index(v.Cc, index)
+		}
+		return nil, false
+	case "cc_mailboxes":
+		if rest == "" {
+			if index < 0 {
+				return v.CcMailboxes, v.CcMailboxes != nil
+			}
+			return ocsf.Index(v.CcMailboxes, index)
+		}
+		return nil, false
+	case "data_classifications":
+		if rest == "" {
+			if index < 0 {
+				return v.DataClassifications, v.DataClassifications != nil
+			}
+			return ocsf.Index(v.DataClassifications, index)
+		}
+		if index < 0 || index >= len(v.DataClassifications) {
+			return nil, false
+		}
+		return v.DataClassifications[index].Get(rest)
+	case "delivered_to_list":
+		if rest == "" {
+			if index < 0 {
+				return v.DeliveredToList, v.DeliveredToList != nil
+			}
+			return ocsf.Index(v.DeliveredToList, index)
+		}
+		return nil, false
+	case "files":
+		if rest == "" {
+			if index < 0 {
+				return v.Files, v.Files != nil
+			}
+			return ocsf.Index(v.Files, index)
+		}
+		if index < 0 || index >= len(v.Files) {
+			return nil, false
+		}
+		return v.Files[index].Get(rest)
+	case "from":
+		if index >= 0 || rest != "" || v.From == nil {
+			return nil, false
+		}
+		return *v.From, true
+	case "from_mailbox":
+		if index >= 0 || rest != "" || v.FromMailbox == nil {
+			return nil, false
+		}
+		return *v.FromMailbox, true
+	case "http_headers":
+		if rest == "" {
+			if index < 0 {
+				return v.HttpHeaders, v.HttpHeaders != nil
+			}
+			return ocsf.Index(v.HttpHeaders, index)
+		}
+		if index < 0 || index >= len(v.HttpHeaders) {
+			return nil, false
+		}
+		return v.HttpHeaders[index].Get(rest)
+	case "is_read":
+		if index >= 0 || rest != "" || v.IsRead == nil {
+			return nil, false
+		}
+		return *v.IsRead, true
+	case "message_uid":
+		if index >= 0 || rest != "" || v.MessageUid == nil {
+			return nil, false
+		}
+		return *v.MessageUid, true
+	case "raw_header":
+		if index >= 0 || rest != "" || v.RawHeader == nil {
+			return nil, false
+		}
+		return *v.RawHeader, true
+	case "reply_to_mailboxes":
+		if rest == "" {
+			if index < 0 {
+				return v.ReplyToMailboxes, v.ReplyToMailboxes != nil
+			}
+			return ocsf.Index(v.ReplyToMailboxes, index)
+		}
+		return nil, false
+	case "size":
+		if index >= 0 || rest != "" || v.Size == nil {
+			return nil, false
+		}
+		return *v.Size, true
+	case "subject":
+		if index >= 0 || rest != "" || v.Subject == nil {
+			return nil, false
+		}
+		return *v.Subject, true
+	case "to":
+		if rest == "" {
+			if index < 0 {
+				return v.To, v.To != nil
+			}
+			return ocsf.Index(v.To, index)
+		}
+		return nil, false
+	case "to_mailboxes":
+		if rest == "" {
+			if index < 0 {
+				return v.ToMailboxes, v.ToMailboxes != nil
+			}
+			return ocsf.Index(v.ToMailboxes, index)
+		}
+		return nil, false
+	case "uid":
+		if index >= 0 || rest != "" || v.Uid == nil {
+			return nil, false
+		}
+		return *v.Uid, true
+	case "urls":
+		if rest == "" {
+			if index < 0 {
+				return v.Urls, v.Urls != nil
+			}
+			return ocsf.Index(v.Urls, index)
+		}
+		if index < 0 || index >= len(v.Urls) {
+			return nil, false
+		}
+		return v.Urls[index].Get(rest)
+	case "x_originating_ip":
+		if rest == "" {
+			if index < 0 {
+				return v.XOriginatingIp, v.XOriginatingIp != nil
+			}
+			return ocsf.Index(v.XOriginatingIp, index)
+		}
+		return nil, false
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *Email) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "cc":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Cc, value)
+		}
+		elem, err := ocsf.Element(&v.Cc, index)
+		if err != nil {
+			return err
+		}
+		if rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(elem, value)
+	case "cc_mailboxes":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.CcMailboxes, value)
+		}
+		elem, err := ocsf.Element(&v.CcMailboxes, index)
+		if err != nil {
+			return err
+		}
+		if rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(elem, value)
+	case "data_classifications":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.DataClassifications, value)
+		}
+		elem, err := ocsf.Element(&v.DataClassifications, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "delivered_to_list":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.DeliveredToList, value)
+		}
+		elem, err := ocsf.Element(&v.DeliveredToList, index)
+		if err != nil {
+			return err
+		}
+		if rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(elem, value)
+	case "files":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Files, value)
+		}
+		elem, err := ocsf.Element(&v.Files, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "from":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.From, value)
+	case "from_mailbox":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.FromMailbox, value)
+	case "http_headers":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.HttpHeaders, value)
+		}
+		elem, err := ocsf.Element(&v.HttpHeaders, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "is_read":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.IsRead, value)
+	case "message_uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.MessageUid, value)
+	case "raw_header":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.RawHeader, value)
+	case "reply_to_mailboxes":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.ReplyToMailboxes, value)
+		}
+		elem, err := ocsf.Element(&v.ReplyToMailboxes, index)
+		if err != nil {
+			return err
+		}
+		if rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(elem, value)
+	case "size":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Size, value)
+	case "subject":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Subject, value)
+	case "to":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.To, value)
+		}
+		elem, err := ocsf.Element(&v.To, index)
+		if err != nil {
+			return err
+		}
+		if rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(elem, value)
+	case "to_mailboxes":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.ToMailboxes, value)
+		}
+		elem, err := ocsf.Element(&v.ToMailboxes, index)
+		if err != nil {
+			return err
+		}
+		if rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(elem, value)
+	case "uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Uid, value)
+	case "urls":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Urls, value)
+		}
+		elem, err := ocsf.Element(&v.Urls, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "x_originating_ip":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.XOriginatingIp, value)
+		}
+		elem, err := ocsf.Element(&v.XOriginatingIp, index)
+		if err != nil {
+			return err
+		}
+		if rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(elem, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *Email) AppendObservables(path string, observables []Observable) []Observable {
+	if path != "" {
+		observables = append(observables, Observable{Name: ocsf.Ptr(path), TypeId: 22})
+	}
+	for i := range v.DataClassifications {
+		observables = v.DataClassifications[i].AppendObservables(ocsf.JoinPath(path, "data_classifications"), observables)
+	}
+	for i := range v.Files {
+		observables = v.Files[i].AppendObservables(ocsf.JoinPath(path, "files"), observables)
+	}
+	for i := range v.HttpHeaders {
+		observables = v.HttpHeaders[i].AppendObservables(ocsf.JoinPath(path, "http_headers"), observables)
+	}
+	for i := range v.Urls {
+		observables = v.Urls[i].AppendObservables(ocsf.JoinPath(path, "urls"), observables)
+	}
+
+	return observables
+}
+
 var EmailFields = []arrow.Field{
-	{Name: "cc", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true},
-	{Name: "cc_mailboxes", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true},
-	{Name: "data_classifications", Type: arrow.ListOf(DataClassificationStruct), Nullable: true},
-	{Name: "delivered_to_list", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true},
-	{Name: "files", Type: arrow.ListOf(FileStruct), Nullable: true},
-	{Name: "from", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "from_mailbox", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "http_headers", Type: arrow.ListOf(HTTPHeaderStruct), Nullable: true},
-	{Name: "is_read", Type: arrow.FixedWidthTypes.Boolean, Nullable: true},
-	{Name: "message_uid", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "raw_header", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "reply_to_mailboxes", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true},
-	{Name: "size", Type: arrow.PrimitiveTypes.Int64, Nullable: true},
-	{Name: "subject", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "to", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true},
-	{Name: "to_mailboxes", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true},
-	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "urls", Type: arrow.ListOf(UniformResourceLocatorStruct), Nullable: true},
-	{Name: "x_originating_ip", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true},
+	{Name: "cc", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true, Metadata: ocsf.AttributeMetadata("Cc", "The machine-readable email header Cc values, as defined by RFC 5322. For example <code>example.user@usersdomain.com</code>.", "optional", "")},
+	{Name: "cc_mailboxes", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true, Metadata: ocsf.AttributeMetadata("Cc Mailboxes", "The human-readable email header Cc Mailbox values. For example <code>'Example User &lt;example.user@usersdomain.com&gt;'</code>.", "optional", "")},
+	{Name: "data_classifications", Type: arrow.ListOf(DataClassificationStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("Data Classification", "A list of Data Classification objects, that include information about data classification levels and data category types, indentified by a classifier.", "optional", "")},
+	{Name: "delivered_to_list", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true, Metadata: ocsf.AttributeMetadata("Delivered To", "The machine-readable <strong>Delivered-To</strong> email header values. For example <code>example.user@usersdomain.com</code>", "optional", "")},
+	{Name: "files", Type: arrow.ListOf(FileStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("Files", "The files embedded or attached to the email.", "optional", "")},
+	{Name: "from", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("From", "The machine-readable email header From values, as defined by RFC 5322. For example <code>example.user@usersdomain.com</code>", "optional", "")},
+	{Name: "from_mailbox", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("From Mailbox", "The human-readable email header From Mailbox value. For example <code>'Example User &lt;example.user@usersdomain.com&gt;'</code>.", "optional", "")},
+	{Name: "http_headers", Type: arrow.ListOf(HTTPHeaderStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("HTTP Headers", "Additional HTTP headers of an HTTP request or response.", "optional", "")},
+	{Name: "is_read", Type: arrow.FixedWidthTypes.Boolean, Nullable: true, Metadata: ocsf.AttributeMetadata("Read", "The indication of whether the email has been read.", "optional", "")},
+	{Name: "message_uid", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Message UID", "The email header Message-ID value, as defined by RFC 5322.", "optional", "")},
+	{Name: "raw_header", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Raw Header", "The email authentication header.", "optional", "")},
+	{Name: "reply_to_mailboxes", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true, Metadata: ocsf.AttributeMetadata("Reply To Mailboxes", "The human-readable email header Reply To Mailbox values. For example <code>'Example User &lt;example.user@usersdomain.com&gt;'</code>.", "optional", "")},
+	{Name: "size", Type: arrow.PrimitiveTypes.Int64, Nullable: true, Metadata: ocsf.AttributeMetadata("Size", "The size in bytes of the email, including attachments.", "optional", "")},
+	{Name: "subject", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Subject", "The email header Subject value, as defined by RFC 5322.", "optional", "")},
+	{Name: "to", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true, Metadata: ocsf.AttributeMetadata("To", "The machine-readable email header To values, as defined by RFC 5322. For example <code>example.user@usersdomain.com</code>", "optional", "")},
+	{Name: "to_mailboxes", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true, Metadata: ocsf.AttributeMetadata("To Mailboxes", "The human-readable email header To Mailbox values. For example <code>'Example User &lt;example.user@usersdomain.com&gt;'</code>.", "optional", "")},
+	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Email Thread UID", "The unique identifier of the email thread.", "optional", "")},
+	{Name: "urls", Type: arrow.ListOf(UniformResourceLocatorStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("URLs", "The URLs embedded in the email.", "optional", "")},
+	{Name: "x_originating_ip", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true, Metadata: ocsf.AttributeMetadata("X-Originating-IP", "The X-Originating-IP header identifying the emails originating IP address(es).", "optional", "")},
 }
 
 var EmailStruct = arrow.StructOf(EmailFields...)
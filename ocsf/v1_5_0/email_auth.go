@@ -2,6 +2,7 @@
 package v1_5_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -33,14 +34,130 @@ func (v *EmailAuthentication) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *EmailAuthentication) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *EmailAuthentication) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *EmailAuthentication) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "dkim":
+		if index >= 0 || rest != "" || v.Dkim == nil {
+			return nil, false
+		}
+		return *v.Dkim, true
+	case "dkim_domain":
+		if index >= 0 || rest != "" || v.DkimDomain == nil {
+			return nil, false
+		}
+		return *v.DkimDomain, true
+	case "dkim_signature":
+		if index >= 0 || rest != "" || v.DkimSignature == nil {
+			return nil, false
+		}
+		return *v.DkimSignature, true
+	case "dmarc":
+		if index >= 0 || rest != "" || v.Dmarc == nil {
+			return nil, false
+		}
+		return *v.Dmarc, true
+	case "dmarc_override":
+		if index >= 0 || rest != "" || v.DmarcOverride == nil {
+			return nil, false
+		}
+		return *v.DmarcOverride, true
+	case "dmarc_policy":
+		if index >= 0 || rest != "" || v.DmarcPolicy == nil {
+			return nil, false
+		}
+		return *v.DmarcPolicy, true
+	case "spf":
+		if index >= 0 || rest != "" || v.Spf == nil {
+			return nil, false
+		}
+		return *v.Spf, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *EmailAuthentication) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "dkim":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Dkim, value)
+	case "dkim_domain":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.DkimDomain, value)
+	case "dkim_signature":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.DkimSignature, value)
+	case "dmarc":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Dmarc, value)
+	case "dmarc_override":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.DmarcOverride, value)
+	case "dmarc_policy":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.DmarcPolicy, value)
+	case "spf":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Spf, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *EmailAuthentication) AppendObservables(path string, observables []Observable) []Observable {
+
+	return observables
+}
+
 var EmailAuthenticationFields = []arrow.Field{
-	{Name: "dkim", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "dkim_domain", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "dkim_signature", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "dmarc", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "dmarc_override", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "dmarc_policy", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "spf", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "dkim", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("DKIM Status", "The DomainKeys Identified Mail (DKIM) status of the email.", "optional", "")},
+	{Name: "dkim_domain", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("DKIM Domain", "The DomainKeys Identified Mail (DKIM) signing domain of the email.", "optional", "")},
+	{Name: "dkim_signature", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("DKIM Signature", "The DomainKeys Identified Mail (DKIM) signature used by the sending/receiving system.", "optional", "")},
+	{Name: "dmarc", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("DMARC Status", "The Domain-based Message Authentication, Reporting and Conformance (DMARC) status of the email.", "optional", "")},
+	{Name: "dmarc_override", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("DMARC Override", "The Domain-based Message Authentication, Reporting and Conformance (DMARC) override action.", "optional", "")},
+	{Name: "dmarc_policy", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("DMARC Policy", "The Domain-based Message Authentication, Reporting and Conformance (DMARC) policy status.", "optional", "")},
+	{Name: "spf", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("SPF Status", "The Sender Policy Framework (SPF) status of the email.", "optional", "")},
 }
 
 var EmailAuthenticationStruct = arrow.StructOf(EmailAuthenticationFields...)
@@ -2,6 +2,7 @@
 package v1_5_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -18,9 +19,90 @@ func (v *EndpointConnection) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *EndpointConnection) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *EndpointConnection) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	if v.NetworkEndpoint != nil {
+		errs = append(errs, v.NetworkEndpoint.ValidateAt(ocsf.JoinPath(path, "network_endpoint"))...)
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *EndpointConnection) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "code":
+		if index >= 0 || rest != "" || v.Code == nil {
+			return nil, false
+		}
+		return *v.Code, true
+	case "network_endpoint":
+		if index >= 0 || v.NetworkEndpoint == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.NetworkEndpoint, true
+		}
+		return v.NetworkEndpoint.Get(rest)
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *EndpointConnection) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "code":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Code, value)
+	case "network_endpoint":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.NetworkEndpoint, value)
+		}
+		if v.NetworkEndpoint == nil {
+			v.NetworkEndpoint = &NetworkEndpoint{}
+		}
+		return v.NetworkEndpoint.Set(rest, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *EndpointConnection) AppendObservables(path string, observables []Observable) []Observable {
+	if v.NetworkEndpoint != nil {
+		observables = v.NetworkEndpoint.AppendObservables(ocsf.JoinPath(path, "network_endpoint"), observables)
+	}
+
+	return observables
+}
+
 var EndpointConnectionFields = []arrow.Field{
-	{Name: "code", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "network_endpoint", Type: NetworkEndpointStruct, Nullable: true},
+	{Name: "code", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Response Code", "A numerical response status code providing details about the connection.", "optional", "")},
+	{Name: "network_endpoint", Type: NetworkEndpointStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Network Endpoint", "Provides characteristics of the network endpoint.", "optional", "")},
 }
 
 var EndpointConnectionStruct = arrow.StructOf(EndpointConnectionFields...)
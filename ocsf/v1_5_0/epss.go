@@ -2,6 +2,7 @@
 package v1_5_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -24,11 +25,100 @@ func (v *EPSS) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *EPSS) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *EPSS) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	if v.Score == "" {
+		errs.Add(ocsf.JoinPath(path, "score"), "required attribute is missing")
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *EPSS) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "created_time":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.CreatedTime, true
+	case "percentile":
+		if index >= 0 || rest != "" || v.Percentile == nil {
+			return nil, false
+		}
+		return *v.Percentile, true
+	case "score":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.Score, true
+	case "version":
+		if index >= 0 || rest != "" || v.Version == nil {
+			return nil, false
+		}
+		return *v.Version, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *EPSS) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "created_time":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.CreatedTime, value)
+	case "percentile":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Percentile, value)
+	case "score":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.Score, value)
+	case "version":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Version, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *EPSS) AppendObservables(path string, observables []Observable) []Observable {
+
+	return observables
+}
+
 var EPSSFields = []arrow.Field{
-	{Name: "created_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
-	{Name: "percentile", Type: arrow.PrimitiveTypes.Float64, Nullable: true},
-	{Name: "score", Type: arrow.BinaryTypes.String, Nullable: false},
-	{Name: "version", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "created_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true, Metadata: ocsf.AttributeMetadata("Created Time", "The timestamp indicating when the EPSS score was calculated.", "optional", "")},
+	{Name: "percentile", Type: arrow.PrimitiveTypes.Float64, Nullable: true, Metadata: ocsf.AttributeMetadata("EPSS Percentile", "The EPSS score's percentile representing relative importance and ranking of the score in the larger EPSS dataset.", "optional", "")},
+	{Name: "score", Type: arrow.BinaryTypes.String, Nullable: false, Metadata: ocsf.AttributeMetadata("EPPS Score", "The EPSS score representing the probability [0-1] of exploitation in the wild in the next 30 days (following score publication).", "required", "")},
+	{Name: "version", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Version", "The version of the EPSS model used to calculate the score.", "optional", "")},
 }
 
 var EPSSStruct = arrow.StructOf(EPSSFields...)
@@ -0,0 +1,2841 @@
+// autogenerated by scripts/model_gen.go. DO NOT EDIT
+package v1_5_0
+
+import "github.com/Santiago-Labs/go-ocsf/ocsf"
+
+// Event is implemented by every class.
+type Event interface {
+	ocsf.Event
+	GetMetadata() *Metadata
+}
+
+// FindingEvent is implemented by every class of the Findings category. It is not named Finding,
+// which is the generated finding object.
+type FindingEvent interface {
+	Event
+	ocsf.Finding
+	GetFindingInfo() *FindingInformation
+}
+
+var (
+	_ Event        = (*AccountChange)(nil)
+	_ Event        = (*AdminGroupQuery)(nil)
+	_ Event        = (*AirborneBroadcastActivity)(nil)
+	_ Event        = (*APIActivity)(nil)
+	_ Event        = (*ApplicationError)(nil)
+	_ Event        = (*ApplicationLifecycle)(nil)
+	_ Event        = (*ApplicationSecurityPostureFinding)(nil)
+	_ FindingEvent = (*ApplicationSecurityPostureFinding)(nil)
+	_ Event        = (*Authentication)(nil)
+	_ Event        = (*AuthorizeSession)(nil)
+	_ Event        = (*BaseEvent)(nil)
+	_ Event        = (*CloudResourcesInventoryInfo)(nil)
+	_ Event        = (*ComplianceFinding)(nil)
+	_ FindingEvent = (*ComplianceFinding)(nil)
+	_ Event        = (*DeviceConfigState)(nil)
+	_ Event        = (*DataSecurityFinding)(nil)
+	_ FindingEvent = (*DataSecurityFinding)(nil)
+	_ Event        = (*DatastoreActivity)(nil)
+	_ Event        = (*DetectionFinding)(nil)
+	_ FindingEvent = (*DetectionFinding)(nil)
+	_ Event        = (*DeviceConfigStateChange)(nil)
+	_ Event        = (*DHCPActivity)(nil)
+	_ Event        = (*DNSActivity)(nil)
+	_ Event        = (*DroneFlightsActivity)(nil)
+	_ Event        = (*EmailActivity)(nil)
+	_ Event        = (*EmailFileActivity)(nil)
+	_ Event        = (*EmailURLActivity)(nil)
+	_ Event        = (*EntityManagement)(nil)
+	_ Event        = (*EventLogActivity)(nil)
+	_ Event        = (*LiveEvidenceInfo)(nil)
+	_ Event        = (*FileSystemActivity)(nil)
+	_ Event        = (*FileHostingActivity)(nil)
+	_ Event        = (*FileQuery)(nil)
+	_ Event        = (*FileRemediationActivity)(nil)
+	_ Event        = (*FolderQuery)(nil)
+	_ Event        = (*FTPActivity)(nil)
+	_ Event        = (*GroupManagement)(nil)
+	_ Event        = (*HTTPActivity)(nil)
+	_ Event        = (*IncidentFinding)(nil)
+	_ Event        = (*DeviceInventoryInfo)(nil)
+	_ Event        = (*JobQuery)(nil)
+	_ Event        = (*KernelActivity)(nil)
+	_ Event        = (*KernelExtensionActivity)(nil)
+	_ Event        = (*KernelObjectQuery)(nil)
+	_ Event        = (*MemoryActivity)(nil)
+	_ Event        = (*ModuleActivity)(nil)
+	_ Event        = (*ModuleQuery)(nil)
+	_ Event        = (*NetworkActivity)(nil)
+	_ Event        = (*NetworkConnectionQuery)(nil)
+	_ Event        = (*NetworkFileActivity)(nil)
+	_ Event        = (*NetworkRemediationActivity)(nil)
+	_ Event        = (*NetworksQuery)(nil)
+	_ Event        = (*NTPActivity)(nil)
+	_ Event        = (*OSINTInventoryInfo)(nil)
+	_ Event        = (*OperatingSystemPatchState)(nil)
+	_ Event        = (*PeripheralDeviceQuery)(nil)
+	_ Event        = (*PrefetchQuery)(nil)
+	_ Event        = (*ProcessActivity)(nil)
+	_ Event        = (*ProcessQuery)(nil)
+	_ Event        = (*ProcessRemediationActivity)(nil)
+	_ Event        = (*RDPActivity)(nil)
+	_ Event        = (*RegistryKeyActivity)(nil)
+	_ Event        = (*RegistryKeyQuery)(nil)
+	_ Event        = (*RegistryValueActivity)(nil)
+	_ Event        = (*RegistryValueQuery)(nil)
+	_ Event        = (*RemediationActivity)(nil)
+	_ Event        = (*ScanActivity)(nil)
+	_ Event        = (*ScheduledJobActivity)(nil)
+	_ Event        = (*ScriptActivity)(nil)
+	_ Event        = (*SecurityFinding)(nil)
+	_ Event        = (*ServiceQuery)(nil)
+	_ Event        = (*UserSessionQuery)(nil)
+	_ Event        = (*SMBActivity)(nil)
+	_ Event        = (*SoftwareInventoryInfo)(nil)
+	_ Event        = (*SSHActivity)(nil)
+	_ Event        = (*StartupItemQuery)(nil)
+	_ Event        = (*TunnelActivity)(nil)
+	_ Event        = (*UserAccessManagement)(nil)
+	_ Event        = (*UserInventoryInfo)(nil)
+	_ Event        = (*UserQuery)(nil)
+	_ Event        = (*VulnerabilityFinding)(nil)
+	_ FindingEvent = (*VulnerabilityFinding)(nil)
+	_ Event        = (*WebResourceAccessActivity)(nil)
+	_ Event        = (*WebResourcesActivity)(nil)
+	_ Event        = (*WindowsResourceActivity)(nil)
+	_ Event        = (*WindowsServiceActivity)(nil)
+)
+
+// Classes describes every class of the package.
+func Classes() []ocsf.Class {
+	return []ocsf.Class{
+		new(AccountChange).OCSFClass(),
+		new(AdminGroupQuery).OCSFClass(),
+		new(AirborneBroadcastActivity).OCSFClass(),
+		new(APIActivity).OCSFClass(),
+		new(ApplicationError).OCSFClass(),
+		new(ApplicationLifecycle).OCSFClass(),
+		new(ApplicationSecurityPostureFinding).OCSFClass(),
+		new(Authentication).OCSFClass(),
+		new(AuthorizeSession).OCSFClass(),
+		new(BaseEvent).OCSFClass(),
+		new(CloudResourcesInventoryInfo).OCSFClass(),
+		new(ComplianceFinding).OCSFClass(),
+		new(DeviceConfigState).OCSFClass(),
+		new(DataSecurityFinding).OCSFClass(),
+		new(DatastoreActivity).OCSFClass(),
+		new(DetectionFinding).OCSFClass(),
+		new(DeviceConfigStateChange).OCSFClass(),
+		new(DHCPActivity).OCSFClass(),
+		new(DNSActivity).OCSFClass(),
+		new(DroneFlightsActivity).OCSFClass(),
+		new(EmailActivity).OCSFClass(),
+		new(EmailFileActivity).OCSFClass(),
+		new(EmailURLActivity).OCSFClass(),
+		new(EntityManagement).OCSFClass(),
+		new(EventLogActivity).OCSFClass(),
+		new(LiveEvidenceInfo).OCSFClass(),
+		new(FileSystemActivity).OCSFClass(),
+		new(FileHostingActivity).OCSFClass(),
+		new(FileQuery).OCSFClass(),
+		new(FileRemediationActivity).OCSFClass(),
+		new(FolderQuery).OCSFClass(),
+		new(FTPActivity).OCSFClass(),
+		new(GroupManagement).OCSFClass(),
+		new(HTTPActivity).OCSFClass(),
+		new(IncidentFinding).OCSFClass(),
+		new(DeviceInventoryInfo).OCSFClass(),
+		new(JobQuery).OCSFClass(),
+		new(KernelActivity).OCSFClass(),
+		new(KernelExtensionActivity).OCSFClass(),
+		new(KernelObjectQuery).OCSFClass(),
+		new(MemoryActivity).OCSFClass(),
+		new(ModuleActivity).OCSFClass(),
+		new(ModuleQuery).OCSFClass(),
+		new(NetworkActivity).OCSFClass(),
+		new(NetworkConnectionQuery).OCSFClass(),
+		new(NetworkFileActivity).OCSFClass(),
+		new(NetworkRemediationActivity).OCSFClass(),
+		new(NetworksQuery).OCSFClass(),
+		new(NTPActivity).OCSFClass(),
+		new(OSINTInventoryInfo).OCSFClass(),
+		new(OperatingSystemPatchState).OCSFClass(),
+		new(PeripheralDeviceQuery).OCSFClass(),
+		new(PrefetchQuery).OCSFClass(),
+		new(ProcessActivity).OCSFClass(),
+		new(ProcessQuery).OCSFClass(),
+		new(ProcessRemediationActivity).OCSFClass(),
+		new(RDPActivity).OCSFClass(),
+		new(RegistryKeyActivity).OCSFClass(),
+		new(RegistryKeyQuery).OCSFClass(),
+		new(RegistryValueActivity).OCSFClass(),
+		new(RegistryValueQuery).OCSFClass(),
+		new(RemediationActivity).OCSFClass(),
+		new(ScanActivity).OCSFClass(),
+		new(ScheduledJobActivity).OCSFClass(),
+		new(ScriptActivity).OCSFClass(),
+		new(SecurityFinding).OCSFClass(),
+		new(ServiceQuery).OCSFClass(),
+		new(UserSessionQuery).OCSFClass(),
+		new(SMBActivity).OCSFClass(),
+		new(SoftwareInventoryInfo).OCSFClass(),
+		new(SSHActivity).OCSFClass(),
+		new(StartupItemQuery).OCSFClass(),
+		new(TunnelActivity).OCSFClass(),
+		new(UserAccessManagement).OCSFClass(),
+		new(UserInventoryInfo).OCSFClass(),
+		new(UserQuery).OCSFClass(),
+		new(VulnerabilityFinding).OCSFClass(),
+		new(WebResourceAccessActivity).OCSFClass(),
+		new(WebResourcesActivity).OCSFClass(),
+		new(WindowsResourceActivity).OCSFClass(),
+		new(WindowsServiceActivity).OCSFClass(),
+	}
+}
+
+func (v *AccountChange) OCSFClass() ocsf.Class {
+	return ocsf.Class{Name: AccountChangeClassname, Package: "v1_5_0", Version: "1.5.0", Schema: AccountChangeSchema}
+}
+
+func (v *AccountChange) GetActivityId() int32 {
+	return v.ActivityId
+}
+
+func (v *AccountChange) GetCategoryUid() int32 {
+	return v.CategoryUid
+}
+
+func (v *AccountChange) GetClassUid() int32 {
+	return v.ClassUid
+}
+
+func (v *AccountChange) GetMetadata() *Metadata {
+	return &v.Metadata
+}
+
+func (v *AccountChange) GetSeverityId() int32 {
+	return v.SeverityId
+}
+
+func (v *AccountChange) GetTime() int64 {
+	return v.Time
+}
+
+func (v *AccountChange) GetTypeUid() int64 {
+	return v.TypeUid
+}
+
+func (v *AdminGroupQuery) OCSFClass() ocsf.Class {
+	return ocsf.Class{Name: AdminGroupQueryClassname, Package: "v1_5_0", Version: "1.5.0", Schema: AdminGroupQuerySchema}
+}
+
+func (v *AdminGroupQuery) GetActivityId() int32 {
+	return v.ActivityId
+}
+
+func (v *AdminGroupQuery) GetCategoryUid() int32 {
+	return v.CategoryUid
+}
+
+func (v *AdminGroupQuery) GetClassUid() int32 {
+	return v.ClassUid
+}
+
+func (v *AdminGroupQuery) GetMetadata() *Metadata {
+	return &v.Metadata
+}
+
+func (v *AdminGroupQuery) GetSeverityId() int32 {
+	return v.SeverityId
+}
+
+func (v *AdminGroupQuery) GetTime() int64 {
+	return v.Time
+}
+
+func (v *AdminGroupQuery) GetTypeUid() int64 {
+	return v.TypeUid
+}
+
+func (v *AirborneBroadcastActivity) OCSFClass() ocsf.Class {
+	return ocsf.Class{Name: AirborneBroadcastActivityClassname, Package: "v1_5_0", Version: "1.5.0", Schema: AirborneBroadcastActivitySchema}
+}
+
+func (v *AirborneBroadcastActivity) GetActivityId() int32 {
+	return v.ActivityId
+}
+
+func (v *AirborneBroadcastActivity) GetCategoryUid() int32 {
+	return v.CategoryUid
+}
+
+func (v *AirborneBroadcastActivity) GetClassUid() int32 {
+	return v.ClassUid
+}
+
+func (v *AirborneBroadcastActivity) GetMetadata() *Metadata {
+	return &v.Metadata
+}
+
+func (v *AirborneBroadcastActivity) GetSeverityId() int32 {
+	return v.SeverityId
+}
+
+func (v *AirborneBroadcastActivity) GetTime() int64 {
+	return v.Time
+}
+
+func (v *AirborneBroadcastActivity) GetTypeUid() int64 {
+	return v.TypeUid
+}
+
+func (v *APIActivity) OCSFClass() ocsf.Class {
+	return ocsf.Class{Name: APIActivityClassname, Package: "v1_5_0", Version: "1.5.0", Schema: APIActivitySchema}
+}
+
+func (v *APIActivity) GetActivityId() int32 {
+	return v.ActivityId
+}
+
+func (v *APIActivity) GetCategoryUid() int32 {
+	return v.CategoryUid
+}
+
+func (v *APIActivity) GetClassUid() int32 {
+	return v.ClassUid
+}
+
+func (v *APIActivity) GetMetadata() *Metadata {
+	return &v.Metadata
+}
+
+func (v *APIActivity) GetSeverityId() int32 {
+	return v.SeverityId
+}
+
+func (v *APIActivity) GetTime() int64 {
+	return v.Time
+}
+
+func (v *APIActivity) GetTypeUid() int64 {
+	return v.TypeUid
+}
+
+func (v *ApplicationError) OCSFClass() ocsf.Class {
+	return ocsf.Class{Name: ApplicationErrorClassname, Package: "v1_5_0", Version: "1.5.0", Schema: ApplicationErrorSchema}
+}
+
+func (v *ApplicationError) GetActivityId() int32 {
+	return v.ActivityId
+}
+
+func (v *ApplicationError) GetCategoryUid() int32 {
+	return v.CategoryUid
+}
+
+func (v *ApplicationError) GetClassUid() int32 {
+	return v.ClassUid
+}
+
+func (v *ApplicationError) GetMetadata() *Metadata {
+	return &v.Metadata
+}
+
+func (v *ApplicationError) GetSeverityId() int32 {
+	return v.SeverityId
+}
+
+func (v *ApplicationError) GetTime() int64 {
+	return v.Time
+}
+
+func (v *ApplicationError) GetTypeUid() int64 {
+	return v.TypeUid
+}
+
+func (v *ApplicationLifecycle) OCSFClass() ocsf.Class {
+	return ocsf.Class{Name: ApplicationLifecycleClassname, Package: "v1_5_0", Version: "1.5.0", Schema: ApplicationLifecycleSchema}
+}
+
+func (v *ApplicationLifecycle) GetActivityId() int32 {
+	return v.ActivityId
+}
+
+func (v *ApplicationLifecycle) GetCategoryUid() int32 {
+	return v.CategoryUid
+}
+
+func (v *ApplicationLifecycle) GetClassUid() int32 {
+	return v.ClassUid
+}
+
+func (v *ApplicationLifecycle) GetMetadata() *Metadata {
+	return &v.Metadata
+}
+
+func (v *ApplicationLifecycle) GetSeverityId() int32 {
+	return v.SeverityId
+}
+
+func (v *ApplicationLifecycle) GetTime() int64 {
+	return v.Time
+}
+
+func (v *ApplicationLifecycle) GetTypeUid() int64 {
+	return v.TypeUid
+}
+
+func (v *ApplicationSecurityPostureFinding) OCSFClass() ocsf.Class {
+	return ocsf.Class{Name: ApplicationSecurityPostureFindingClassname, Package: "v1_5_0", Version: "1.5.0", Schema: ApplicationSecurityPostureFindingSchema}
+}
+
+func (v *ApplicationSecurityPostureFinding) GetActivityId() int32 {
+	return v.ActivityId
+}
+
+func (v *ApplicationSecurityPostureFinding) GetCategoryUid() int32 {
+	return v.CategoryUid
+}
+
+func (v *ApplicationSecurityPostureFinding) GetClassUid() int32 {
+	return v.ClassUid
+}
+
+func (v *ApplicationSecurityPostureFinding) GetMetadata() *Metadata {
+	return &v.Metadata
+}
+
+func (v *ApplicationSecurityPostureFinding) GetSeverityId() int32 {
+	return v.SeverityId
+}
+
+func (v *ApplicationSecurityPostureFinding) GetTime() int64 {
+	return v.Time
+}
+
+func (v *ApplicationSecurityPostureFinding) GetTypeUid() int64 {
+	return v.TypeUid
+}
+
+func (v *ApplicationSecurityPostureFinding) GetFindingInfo() *FindingInformation {
+	return &v.FindingInfo
+}
+
+func (v *ApplicationSecurityPostureFinding) GetFindingUid() string {
+	if v.GetFindingInfo() == nil || v.GetFindingInfo().Uid == "" {
+		return ""
+	}
+	return v.GetFindingInfo().Uid
+}
+
+func (v *Authentication) OCSFClass() ocsf.Class {
+	return ocsf.Class{Name: AuthenticationClassname, Package: "v1_5_0", Version: "1.5.0", Schema: AuthenticationSchema}
+}
+
+func (v *Authentication) GetActivityId() int32 {
+	return v.ActivityId
+}
+
+func (v *Authentication) GetCategoryUid() int32 {
+	return v.CategoryUid
+}
+
+func (v *Authentication) GetClassUid() int32 {
+	return v.ClassUid
+}
+
+func (v *Authentication) GetMetadata() *Metadata {
+	return &v.Metadata
+}
+
+func (v *Authentication) GetSeverityId() int32 {
+	return v.SeverityId
+}
+
+func (v *Authentication) GetTime() int64 {
+	return v.Time
+}
+
+func (v *Authentication) GetTypeUid() int64 {
+	return v.TypeUid
+}
+
+func (v *AuthorizeSession) OCSFClass() ocsf.Class {
+	return ocsf.Class{Name: AuthorizeSessionClassname, Package: "v1_5_0", Version: "1.5.0", Schema: AuthorizeSessionSchema}
+}
+
+func (v *AuthorizeSession) GetActivityId() int32 {
+	return v.ActivityId
+}
+
+func (v *AuthorizeSession) GetCategoryUid() int32 {
+	return v.CategoryUid
+}
+
+func (v *AuthorizeSession) GetClassUid() int32 {
+	return v.ClassUid
+}
+
+func (v *AuthorizeSession) GetMetadata() *Metadata {
+	return &v.Metadata
+}
+
+func (v *AuthorizeSession) GetSeverityId() int32 {
+	return v.SeverityId
+}
+
+func (v *AuthorizeSession) GetTime() int64 {
+	return v.Time
+}
+
+func (v *AuthorizeSession) GetTypeUid() int64 {
+	return v.TypeUid
+}
+
+func (v *BaseEvent) OCSFClass() ocsf.Class {
+	return ocsf.Class{Name: BaseEventClassname, Package: "v1_5_0", Version: "1.5.0", Schema: BaseEventSchema}
+}
+
+func (v *BaseEvent) GetActivityId() int32 {
+	return v.ActivityId
+}
+
+func (v *BaseEvent) GetCategoryUid() int32 {
+	return v.CategoryUid
+}
+
+func (v *BaseEvent) GetClassUid() int32 {
+	return v.ClassUid
+}
+
+func (v *BaseEvent) GetMetadata() *Metadata {
+	return &v.Metadata
+}
+
+func (v *BaseEvent) GetSeverityId() int32 {
+	return v.SeverityId
+}
+
+func (v *BaseEvent) GetTime() int64 {
+	return v.Time
+}
+
+func (v *BaseEvent) GetTypeUid() int64 {
+	return v.TypeUid
+}
+
+func (v *CloudResourcesInventoryInfo) OCSFClass() ocsf.Class {
+	return ocsf.Class{Name: CloudResourcesInventoryInfoClassname, Package: "v1_5_0", Version: "1.5.0", Schema: CloudResourcesInventoryInfoSchema}
+}
+
+func (v *CloudResourcesInventoryInfo) GetActivityId() int32 {
+	return v.ActivityId
+}
+
+func (v *CloudResourcesInventoryInfo) GetCategoryUid() int32 {
+	return v.CategoryUid
+}
+
+func (v *CloudResourcesInventoryInfo) GetClassUid() int32 {
+	return v.ClassUid
+}
+
+func (v *CloudResourcesInventoryInfo) GetMetadata() *Metadata {
+	return &v.Metadata
+}
+
+func (v *CloudResourcesInventoryInfo) GetSeverityId() int32 {
+	return v.SeverityId
+}
+
+func (v *CloudResourcesInventoryInfo) GetTime() int64 {
+	return v.Time
+}
+
+func (v *CloudResourcesInventoryInfo) GetTypeUid() int64 {
+	return v.TypeUid
+}
+
+func (v *ComplianceFinding) OCSFClass() ocsf.Class {
+	return ocsf.Class{Name: ComplianceFindingClassname, Package: "v1_5_0", Version: "1.5.0", Schema: ComplianceFindingSchema}
+}
+
+func (v *ComplianceFinding) GetActivityId() int32 {
+	return v.ActivityId
+}
+
+func (v *ComplianceFinding) GetCategoryUid() int32 {
+	return v.CategoryUid
+}
+
+func (v *ComplianceFinding) GetClassUid() int32 {
+	return v.ClassUid
+}
+
+func (v *ComplianceFinding) GetMetadata() *Metadata {
+	return &v.Metadata
+}
+
+func (v *ComplianceFinding) GetSeverityId() int32 {
+	return v.SeverityId
+}
+
+func (v *ComplianceFinding) GetTime() int64 {
+	return v.Time
+}
+
+func (v *ComplianceFinding) GetTypeUid() int64 {
+	return v.TypeUid
+}
+
+func (v *ComplianceFinding) GetFindingInfo() *FindingInformation {
+	return &v.FindingInfo
+}
+
+func (v *ComplianceFinding) GetFindingUid() string {
+	if v.GetFindingInfo() == nil || v.GetFindingInfo().Uid == "" {
+		return ""
+	}
+	return v.GetFindingInfo().Uid
+}
+
+func (v *DeviceConfigState) OCSFClass() ocsf.Class {
+	return ocsf.Class{Name: DeviceConfigStateClassname, Package: "v1_5_0", Version: "1.5.0", Schema: DeviceConfigStateSchema}
+}
+
+func (v *DeviceConfigState) GetActivityId() int32 {
+	return v.ActivityId
+}
+
+func (v *DeviceConfigState) GetCategoryUid() int32 {
+	return v.CategoryUid
+}
+
+func (v *DeviceConfigState) GetClassUid() int32 {
+	return v.ClassUid
+}
+
+func (v *DeviceConfigState) GetMetadata() *Metadata {
+	return &v.Metadata
+}
+
+func (v *DeviceConfigState) GetSeverityId() int32 {
+	return v.SeverityId
+}
+
+func (v *DeviceConfigState) GetTime() int64 {
+	return v.Time
+}
+
+func (v *DeviceConfigState) GetTypeUid() int64 {
+	return v.TypeUid
+}
+
+func (v *DataSecurityFinding) OCSFClass() ocsf.Class {
+	return ocsf.Class{Name: DataSecurityFindingClassname, Package: "v1_5_0", Version: "1.5.0", Schema: DataSecurityFindingSchema}
+}
+
+func (v *DataSecurityFinding) GetActivityId() int32 {
+	return v.ActivityId
+}
+
+func (v *DataSecurityFinding) GetCategoryUid() int32 {
+	return v.CategoryUid
+}
+
+func (v *DataSecurityFinding) GetClassUid() int32 {
+	return v.ClassUid
+}
+
+func (v *DataSecurityFinding) GetMetadata() *Metadata {
+	return &v.Metadata
+}
+
+func (v *DataSecurityFinding) GetSeverityId() int32 {
+	return v.SeverityId
+}
+
+func (v *DataSecurityFinding) GetTime() int64 {
+	return v.Time
+}
+
+func (v *DataSecurityFinding) GetTypeUid() int64 {
+	return v.TypeUid
+}
+
+func (v *DataSecurityFinding) GetFindingInfo() *FindingInformation {
+	return &v.FindingInfo
+}
+
+func (v *DataSecurityFinding) GetFindingUid() string {
+	if v.GetFindingInfo() == nil || v.GetFindingInfo().Uid == "" {
+		return ""
+	}
+	return v.GetFindingInfo().Uid
+}
+
+func (v *DatastoreActivity) OCSFClass() ocsf.Class {
+	return ocsf.Class{Name: DatastoreActivityClassname, Package: "v1_5_0", Version: "1.5.0", Schema: DatastoreActivitySchema}
+}
+
+func (v *DatastoreActivity) GetActivityId() int32 {
+	return v.ActivityId
+}
+
+func (v *DatastoreActivity) GetCategoryUid() int32 {
+	return v.CategoryUid
+}
+
+func (v *DatastoreActivity) GetClassUid() int32 {
+	return v.ClassUid
+}
+
+func (v *DatastoreActivity) GetMetadata() *Metadata {
+	return &v.Metadata
+}
+
+func (v *DatastoreActivity) GetSeverityId() int32 {
+	return v.SeverityId
+}
+
+func (v *DatastoreActivity) GetTime() int64 {
+	return v.Time
+}
+
+func (v *DatastoreActivity) GetTypeUid() int64 {
+	return v.TypeUid
+}
+
+func (v *DetectionFinding) OCSFClass() ocsf.Class {
+	return ocsf.Class{Name: DetectionFindingClassname, Package: "v1_5_0", Version: "1.5.0", Schema: DetectionFindingSchema}
+}
+
+func (v *DetectionFinding) GetActivityId() int32 {
+	return v.ActivityId
+}
+
+func (v *DetectionFinding) GetCategoryUid() int32 {
+	return v.CategoryUid
+}
+
+func (v *DetectionFinding) GetClassUid() int32 {
+	return v.ClassUid
+}
+
+func (v *DetectionFinding) GetMetadata() *Metadata {
+	return &v.Metadata
+}
+
+func (v *DetectionFinding) GetSeverityId() int32 {
+	return v.SeverityId
+}
+
+func (v *DetectionFinding) GetTime() int64 {
+	return v.Time
+}
+
+func (v *DetectionFinding) GetTypeUid() int64 {
+	return v.TypeUid
+}
+
+func (v *DetectionFinding) GetFindingInfo() *FindingInformation {
+	return &v.FindingInfo
+}
+
+func (v *DetectionFinding) GetFindingUid() string {
+	if v.GetFindingInfo() == nil || v.GetFindingInfo().Uid == "" {
+		return ""
+	}
+	return v.GetFindingInfo().Uid
+}
+
+func (v *DeviceConfigStateChange) OCSFClass() ocsf.Class {
+	return ocsf.Class{Name: DeviceConfigStateChangeClassname, Package: "v1_5_0", Version: "1.5.0", Schema: DeviceConfigStateChangeSchema}
+}
+
+func (v *DeviceConfigStateChange) GetActivityId() int32 {
+	return v.ActivityId
+}
+
+func (v *DeviceConfigStateChange) GetCategoryUid() int32 {
+	return v.CategoryUid
+}
+
+func (v *DeviceConfigStateChange) GetClassUid() int32 {
+	return v.ClassUid
+}
+
+func (v *DeviceConfigStateChange) GetMetadata() *Metadata {
+	return &v.Metadata
+}
+
+func (v *DeviceConfigStateChange) GetSeverityId() int32 {
+	return v.SeverityId
+}
+
+func (v *DeviceConfigStateChange) GetTime() int64 {
+	return v.Time
+}
+
+func (v *DeviceConfigStateChange) GetTypeUid() int64 {
+	return v.TypeUid
+}
+
+func (v *DHCPActivity) OCSFClass() ocsf.Class {
+	return ocsf.Class{Name: DHCPActivityClassname, Package: "v1_5_0", Version: "1.5.0", Schema: DHCPActivitySchema}
+}
+
+func (v *DHCPActivity) GetActivityId() int32 {
+	return v.ActivityId
+}
+
+func (v *DHCPActivity) GetCategoryUid() int32 {
+	return v.CategoryUid
+}
+
+func (v *DHCPActivity) GetClassUid() int32 {
+	return v.ClassUid
+}
+
+func (v *DHCPActivity) GetMetadata() *Metadata {
+	return &v.Metadata
+}
+
+func (v *DHCPActivity) GetSeverityId() int32 {
+	return v.SeverityId
+}
+
+func (v *DHCPActivity) GetTime() int64 {
+	return v.Time
+}
+
+func (v *DHCPActivity) GetTypeUid() int64 {
+	return v.TypeUid
+}
+
+func (v *DNSActivity) OCSFClass() ocsf.Class {
+	return ocsf.Class{Name: DNSActivityClassname, Package: "v1_5_0", Version: "1.5.0", Schema: DNSActivitySchema}
+}
+
+func (v *DNSActivity) GetActivityId() int32 {
+	return v.ActivityId
+}
+
+func (v *DNSActivity) GetCategoryUid() int32 {
+	return v.CategoryUid
+}
+
+func (v *DNSActivity) GetClassUid() int32 {
+	return v.ClassUid
+}
+
+func (v *DNSActivity) GetMetadata() *Metadata {
+	return &v.Metadata
+}
+
+func (v *DNSActivity) GetSeverityId() int32 {
+	return v.SeverityId
+}
+
+func (v *DNSActivity) GetTime() int64 {
+	return v.Time
+}
+
+func (v *DNSActivity) GetTypeUid() int64 {
+	return v.TypeUid
+}
+
+func (v *DroneFlightsActivity) OCSFClass() ocsf.Class {
+	return ocsf.Class{Name: DroneFlightsActivityClassname, Package: "v1_5_0", Version: "1.5.0", Schema: DroneFlightsActivitySchema}
+}
+
+func (v *DroneFlightsActivity) GetActivityId() int32 {
+	return v.ActivityId
+}
+
+func (v *DroneFlightsActivity) GetCategoryUid() int32 {
+	return v.CategoryUid
+}
+
+func (v *DroneFlightsActivity) GetClassUid() int32 {
+	return v.ClassUid
+}
+
+func (v *DroneFlightsActivity) GetMetadata() *Metadata {
+	return &v.Metadata
+}
+
+func (v *DroneFlightsActivity) GetSeverityId() int32 {
+	return v.SeverityId
+}
+
+func (v *DroneFlightsActivity) GetTime() int64 {
+	return v.Time
+}
+
+func (v *DroneFlightsActivity) GetTypeUid() int64 {
+	return v.TypeUid
+}
+
+func (v *EmailActivity) OCSFClass() ocsf.Class {
+	return ocsf.Class{Name: EmailActivityClassname, Package: "v1_5_0", Version: "1.5.0", Schema: EmailActivitySchema}
+}
+
+func (v *EmailActivity) GetActivityId() int32 {
+	return v.ActivityId
+}
+
+func (v *EmailActivity) GetCategoryUid() int32 {
+	return v.CategoryUid
+}
+
+func (v *EmailActivity) GetClassUid() int32 {
+	return v.ClassUid
+}
+
+func (v *EmailActivity) GetMetadata() *Metadata {
+	return &v.Metadata
+}
+
+func (v *EmailActivity) GetSeverityId() int32 {
+	return v.SeverityId
+}
+
+func (v *EmailActivity) GetTime() int64 {
+	return v.Time
+}
+
+func (v *EmailActivity) GetTypeUid() int64 {
+	return v.TypeUid
+}
+
+func (v *EmailFileActivity) OCSFClass() ocsf.Class {
+	return ocsf.Class{Name: EmailFileActivityClassname, Package: "v1_5_0", Version: "1.5.0", Schema: EmailFileActivitySchema}
+}
+
+func (v *EmailFileActivity) GetActivityId() int32 {
+	return v.ActivityId
+}
+
+func (v *EmailFileActivity) GetCategoryUid() int32 {
+	return v.CategoryUid
+}
+
+func (v *EmailFileActivity) GetClassUid() int32 {
+	return v.ClassUid
+}
+
+func (v *EmailFileActivity) GetMetadata() *Metadata {
+	return &v.Metadata
+}
+
+func (v *EmailFileActivity) GetSeverityId() int32 {
+	return v.SeverityId
+}
+
+func (v *EmailFileActivity) GetTime() int64 {
+	return v.Time
+}
+
+func (v *EmailFileActivity) GetTypeUid() int64 {
+	return v.TypeUid
+}
+
+func (v *EmailURLActivity) OCSFClass() ocsf.Class {
+	return ocsf.Class{Name: EmailURLActivityClassname, Package: "v1_5_0", Version: "1.5.0", Schema: EmailURLActivitySchema}
+}
+
+func (v *EmailURLActivity) GetActivityId() int32 {
+	return v.ActivityId
+}
+
+func (v *EmailURLActivity) GetCategoryUid() int32 {
+	return v.CategoryUid
+}
+
+func (v *EmailURLActivity) GetClassUid() int32 {
+	return v.ClassUid
+}
+
+func (v *EmailURLActivity) GetMetadata() *Metadata {
+	return &v.Metadata
+}
+
+func (v *EmailURLActivity) GetSeverityId() int32 {
+	return v.SeverityId
+}
+
+func (v *EmailURLActivity) GetTime() int64 {
+	return v.Time
+}
+
+func (v *EmailURLActivity) GetTypeUid() int64 {
+	return v.TypeUid
+}
+
+func (v *EntityManagement) OCSFClass() ocsf.Class {
+	return ocsf.Class{Name: EntityManagementClassname, Package: "v1_5_0", Version: "1.5.0", Schema: EntityManagementSchema}
+}
+
+func (v *EntityManagement) GetActivityId() int32 {
+	return v.ActivityId
+}
+
+func (v *EntityManagement) GetCategoryUid() int32 {
+	return v.CategoryUid
+}
+
+func (v *EntityManagement) GetClassUid() int32 {
+	return v.ClassUid
+}
+
+func (v *EntityManagement) GetMetadata() *Metadata {
+	return &v.Metadata
+}
+
+func (v *EntityManagement) GetSeverityId() int32 {
+	return v.SeverityId
+}
+
+func (v *EntityManagement) GetTime() int64 {
+	return v.Time
+}
+
+func (v *EntityManagement) GetTypeUid() int64 {
+	return v.TypeUid
+}
+
+func (v *EventLogActivity) OCSFClass() ocsf.Class {
+	return ocsf.Class{Name: EventLogActivityClassname, Package: "v1_5_0", Version: "1.5.0", Schema: EventLogActivitySchema}
+}
+
+func (v *EventLogActivity) GetActivityId() int32 {
+	return v.ActivityId
+}
+
+func (v *EventLogActivity) GetCategoryUid() int32 {
+	return v.CategoryUid
+}
+
+func (v *EventLogActivity) GetClassUid() int32 {
+	return v.ClassUid
+}
+
+func (v *EventLogActivity) GetMetadata() *Metadata {
+	return &v.Metadata
+}
+
+func (v *EventLogActivity) GetSeverityId() int32 {
+	return v.SeverityId
+}
+
+func (v *EventLogActivity) GetTime() int64 {
+	return v.Time
+}
+
+func (v *EventLogActivity) GetTypeUid() int64 {
+	return v.TypeUid
+}
+
+func (v *LiveEvidenceInfo) OCSFClass() ocsf.Class {
+	return ocsf.Class{Name: LiveEvidenceInfoClassname, Package: "v1_5_0", Version: "1.5.0", Schema: LiveEvidenceInfoSchema}
+}
+
+func (v *LiveEvidenceInfo) GetActivityId() int32 {
+	return v.ActivityId
+}
+
+func (v *LiveEvidenceInfo) GetCategoryUid() int32 {
+	return v.CategoryUid
+}
+
+func (v *LiveEvidenceInfo) GetClassUid() int32 {
+	return v.ClassUid
+}
+
+func (v *LiveEvidenceInfo) GetMetadata() *Metadata {
+	return &v.Metadata
+}
+
+func (v *LiveEvidenceInfo) GetSeverityId() int32 {
+	return v.SeverityId
+}
+
+func (v *LiveEvidenceInfo) GetTime() int64 {
+	return v.Time
+}
+
+func (v *LiveEvidenceInfo) GetTypeUid() int64 {
+	return v.TypeUid
+}
+
+func (v *FileSystemActivity) OCSFClass() ocsf.Class {
+	return ocsf.Class{Name: FileSystemActivityClassname, Package: "v1_5_0", Version: "1.5.0", Schema: FileSystemActivitySchema}
+}
+
+func (v *FileSystemActivity) GetActivityId() int32 {
+	return v.ActivityId
+}
+
+func (v *FileSystemActivity) GetCategoryUid() int32 {
+	return v.CategoryUid
+}
+
+func (v *FileSystemActivity) GetClassUid() int32 {
+	return v.ClassUid
+}
+
+func (v *FileSystemActivity) GetMetadata() *Metadata {
+	return &v.Metadata
+}
+
+func (v *FileSystemActivity) GetSeverityId() int32 {
+	return v.SeverityId
+}
+
+func (v *FileSystemActivity) GetTime() int64 {
+	return v.Time
+}
+
+func (v *FileSystemActivity) GetTypeUid() int64 {
+	return v.TypeUid
+}
+
+func (v *FileHostingActivity) OCSFClass() ocsf.Class {
+	return ocsf.Class{Name: FileHostingActivityClassname, Package: "v1_5_0", Version: "1.5.0", Schema: FileHostingActivitySchema}
+}
+
+func (v *FileHostingActivity) GetActivityId() int32 {
+	return v.ActivityId
+}
+
+func (v *FileHostingActivity) GetCategoryUid() int32 {
+	return v.CategoryUid
+}
+
+func (v *FileHostingActivity) GetClassUid() int32 {
+	return v.ClassUid
+}
+
+func (v *FileHostingActivity) GetMetadata() *Metadata {
+	return &v.Metadata
+}
+
+func (v *FileHostingActivity) GetSeverityId() int32 {
+	return v.SeverityId
+}
+
+func (v *FileHostingActivity) GetTime() int64 {
+	return v.Time
+}
+
+func (v *FileHostingActivity) GetTypeUid() int64 {
+	return v.TypeUid
+}
+
+func (v *FileQuery) OCSFClass() ocsf.Class {
+	return ocsf.Class{Name: FileQueryClassname, Package: "v1_5_0", Version: "1.5.0", Schema: FileQuerySchema}
+}
+
+func (v *FileQuery) GetActivityId() int32 {
+	return v.ActivityId
+}
+
+func (v *FileQuery) GetCategoryUid() int32 {
+	return v.CategoryUid
+}
+
+func (v *FileQuery) GetClassUid() int32 {
+	return v.ClassUid
+}
+
+func (v *FileQuery) GetMetadata() *Metadata {
+	return &v.Metadata
+}
+
+func (v *FileQuery) GetSeverityId() int32 {
+	return v.SeverityId
+}
+
+func (v *FileQuery) GetTime() int64 {
+	return v.Time
+}
+
+func (v *FileQuery) GetTypeUid() int64 {
+	return v.TypeUid
+}
+
+func (v *FileRemediationActivity) OCSFClass() ocsf.Class {
+	return ocsf.Class{Name: FileRemediationActivityClassname, Package: "v1_5_0", Version: "1.5.0", Schema: FileRemediationActivitySchema}
+}
+
+func (v *FileRemediationActivity) GetActivityId() int32 {
+	return v.ActivityId
+}
+
+func (v *FileRemediationActivity) GetCategoryUid() int32 {
+	return v.CategoryUid
+}
+
+func (v *FileRemediationActivity) GetClassUid() int32 {
+	return v.ClassUid
+}
+
+func (v *FileRemediationActivity) GetMetadata() *Metadata {
+	return &v.Metadata
+}
+
+func (v *FileRemediationActivity) GetSeverityId() int32 {
+	return v.SeverityId
+}
+
+func (v *FileRemediationActivity) GetTime() int64 {
+	return v.Time
+}
+
+func (v *FileRemediationActivity) GetTypeUid() int64 {
+	return v.TypeUid
+}
+
+func (v *FolderQuery) OCSFClass() ocsf.Class {
+	return ocsf.Class{Name: FolderQueryClassname, Package: "v1_5_0", Version: "1.5.0", Schema: FolderQuerySchema}
+}
+
+func (v *FolderQuery) GetActivityId() int32 {
+	return v.ActivityId
+}
+
+func (v *FolderQuery) GetCategoryUid() int32 {
+	return v.CategoryUid
+}
+
+func (v *FolderQuery) GetClassUid() int32 {
+	return v.ClassUid
+}
+
+func (v *FolderQuery) GetMetadata() *Metadata {
+	return &v.Metadata
+}
+
+func (v *FolderQuery) GetSeverityId() int32 {
+	return v.SeverityId
+}
+
+func (v *FolderQuery) GetTime() int64 {
+	return v.Time
+}
+
+func (v *FolderQuery) GetTypeUid() int64 {
+	return v.TypeUid
+}
+
+func (v *FTPActivity) OCSFClass() ocsf.Class {
+	return ocsf.Class{Name: FTPActivityClassname, Package: "v1_5_0", Version: "1.5.0", Schema: FTPActivitySchema}
+}
+
+func (v *FTPActivity) GetActivityId() int32 {
+	return v.ActivityId
+}
+
+func (v *FTPActivity) GetCategoryUid() int32 {
+	return v.CategoryUid
+}
+
+func (v *FTPActivity) GetClassUid() int32 {
+	return v.ClassUid
+}
+
+func (v *FTPActivity) GetMetadata() *Metadata {
+	return &v.Metadata
+}
+
+func (v *FTPActivity) GetSeverityId() int32 {
+	return v.SeverityId
+}
+
+func (v *FTPActivity) GetTime() int64 {
+	return v.Time
+}
+
+func (v *FTPActivity) GetTypeUid() int64 {
+	return v.TypeUid
+}
+
+func (v *GroupManagement) OCSFClass() ocsf.Class {
+	return ocsf.Class{Name: GroupManagementClassname, Package: "v1_5_0", Version: "1.5.0", Schema: GroupManagementSchema}
+}
+
+func (v *GroupManagement) GetActivityId() int32 {
+	return v.ActivityId
+}
+
+func (v *GroupManagement) GetCategoryUid() int32 {
+	return v.CategoryUid
+}
+
+func (v *GroupManagement) GetClassUid() int32 {
+	return v.ClassUid
+}
+
+func (v *GroupManagement) GetMetadata() *Metadata {
+	return &v.Metadata
+}
+
+func (v *GroupManagement) GetSeverityId() int32 {
+	return v.SeverityId
+}
+
+func (v *GroupManagement) GetTime() int64 {
+	return v.Time
+}
+
+func (v *GroupManagement) GetTypeUid() int64 {
+	return v.TypeUid
+}
+
+func (v *HTTPActivity) OCSFClass() ocsf.Class {
+	return ocsf.Class{Name: HTTPActivityClassname, Package: "v1_5_0", Version: "1.5.0", Schema: HTTPActivitySchema}
+}
+
+func (v *HTTPActivity) GetActivityId() int32 {
+	return v.ActivityId
+}
+
+func (v *HTTPActivity) GetCategoryUid() int32 {
+	return v.CategoryUid
+}
+
+func (v *HTTPActivity) GetClassUid() int32 {
+	return v.ClassUid
+}
+
+func (v *HTTPActivity) GetMetadata() *Metadata {
+	return &v.Metadata
+}
+
+func (v *HTTPActivity) GetSeverityId() int32 {
+	return v.SeverityId
+}
+
+func (v *HTTPActivity) GetTime() int64 {
+	return v.Time
+}
+
+func (v *HTTPActivity) GetTypeUid() int64 {
+	return v.TypeUid
+}
+
+func (v *IncidentFinding) OCSFClass() ocsf.Class {
+	return ocsf.Class{Name: IncidentFindingClassname, Package: "v1_5_0", Version: "1.5.0", Schema: IncidentFindingSchema}
+}
+
+func (v *IncidentFinding) GetActivityId() int32 {
+	return v.ActivityId
+}
+
+func (v *IncidentFinding) GetCategoryUid() int32 {
+	return v.CategoryUid
+}
+
+func (v *IncidentFinding) GetClassUid() int32 {
+	return v.ClassUid
+}
+
+func (v *IncidentFinding) GetMetadata() *Metadata {
+	return &v.Metadata
+}
+
+func (v *IncidentFinding) GetSeverityId() int32 {
+	return v.SeverityId
+}
+
+func (v *IncidentFinding) GetTime() int64 {
+	return v.Time
+}
+
+func (v *IncidentFinding) GetTypeUid() int64 {
+	return v.TypeUid
+}
+
+func (v *DeviceInventoryInfo) OCSFClass() ocsf.Class {
+	return ocsf.Class{Name: DeviceInventoryInfoClassname, Package: "v1_5_0", Version: "1.5.0", Schema: DeviceInventoryInfoSchema}
+}
+
+func (v *DeviceInventoryInfo) GetActivityId() int32 {
+	return v.ActivityId
+}
+
+func (v *DeviceInventoryInfo) GetCategoryUid() int32 {
+	return v.CategoryUid
+}
+
+func (v *DeviceInventoryInfo) GetClassUid() int32 {
+	return v.ClassUid
+}
+
+func (v *DeviceInventoryInfo) GetMetadata() *Metadata {
+	return &v.Metadata
+}
+
+func (v *DeviceInventoryInfo) GetSeverityId() int32 {
+	return v.SeverityId
+}
+
+func (v *DeviceInventoryInfo) GetTime() int64 {
+	return v.Time
+}
+
+func (v *DeviceInventoryInfo) GetTypeUid() int64 {
+	return v.TypeUid
+}
+
+func (v *JobQuery) OCSFClass() ocsf.Class {
+	return ocsf.Class{Name: JobQueryClassname, Package: "v1_5_0", Version: "1.5.0", Schema: JobQuerySchema}
+}
+
+func (v *JobQuery) GetActivityId() int32 {
+	return v.ActivityId
+}
+
+func (v *JobQuery) GetCategoryUid() int32 {
+	return v.CategoryUid
+}
+
+func (v *JobQuery) GetClassUid() int32 {
+	return v.ClassUid
+}
+
+func (v *JobQuery) GetMetadata() *Metadata {
+	return &v.Metadata
+}
+
+func (v *JobQuery) GetSeverityId() int32 {
+	return v.SeverityId
+}
+
+func (v *JobQuery) GetTime() int64 {
+	return v.Time
+}
+
+func (v *JobQuery) GetTypeUid() int64 {
+	return v.TypeUid
+}
+
+func (v *KernelActivity) OCSFClass() ocsf.Class {
+	return ocsf.Class{Name: KernelActivityClassname, Package: "v1_5_0", Version: "1.5.0", Schema: KernelActivitySchema}
+}
+
+func (v *KernelActivity) GetActivityId() int32 {
+	return v.ActivityId
+}
+
+func (v *KernelActivity) GetCategoryUid() int32 {
+	return v.CategoryUid
+}
+
+func (v *KernelActivity) GetClassUid() int32 {
+	return v.ClassUid
+}
+
+func (v *KernelActivity) GetMetadata() *Metadata {
+	return &v.Metadata
+}
+
+func (v *KernelActivity) GetSeverityId() int32 {
+	return v.SeverityId
+}
+
+func (v *KernelActivity) GetTime() int64 {
+	return v.Time
+}
+
+func (v *KernelActivity) GetTypeUid() int64 {
+	return v.TypeUid
+}
+
+func (v *KernelExtensionActivity) OCSFClass() ocsf.Class {
+	return ocsf.Class{Name: KernelExtensionActivityClassname, Package: "v1_5_0", Version: "1.5.0", Schema: KernelExtensionActivitySchema}
+}
+
+func (v *KernelExtensionActivity) GetActivityId() int32 {
+	return v.ActivityId
+}
+
+func (v *KernelExtensionActivity) GetCategoryUid() int32 {
+	return v.CategoryUid
+}
+
+func (v *KernelExtensionActivity) GetClassUid() int32 {
+	return v.ClassUid
+}
+
+func (v *KernelExtensionActivity) GetMetadata() *Metadata {
+	return &v.Metadata
+}
+
+func (v *KernelExtensionActivity) GetSeverityId() int32 {
+	return v.SeverityId
+}
+
+func (v *KernelExtensionActivity) GetTime() int64 {
+	return v.Time
+}
+
+func (v *KernelExtensionActivity) GetTypeUid() int64 {
+	return v.TypeUid
+}
+
+func (v *KernelObjectQuery) OCSFClass() ocsf.Class {
+	return ocsf.Class{Name: KernelObjectQueryClassname, Package: "v1_5_0", Version: "1.5.0", Schema: KernelObjectQuerySchema}
+}
+
+func (v *KernelObjectQuery) GetActivityId() int32 {
+	return v.ActivityId
+}
+
+func (v *KernelObjectQuery) GetCategoryUid() int32 {
+	return v.CategoryUid
+}
+
+func (v *KernelObjectQuery) GetClassUid() int32 {
+	return v.ClassUid
+}
+
+func (v *KernelObjectQuery) GetMetadata() *Metadata {
+	return &v.Metadata
+}
+
+func (v *KernelObjectQuery) GetSeverityId() int32 {
+	return v.SeverityId
+}
+
+func (v *KernelObjectQuery) GetTime() int64 {
+	return v.Time
+}
+
+func (v *KernelObjectQuery) GetTypeUid() int64 {
+	return v.TypeUid
+}
+
+func (v *MemoryActivity) OCSFClass() ocsf.Class {
+	return ocsf.Class{Name: MemoryActivityClassname, Package: "v1_5_0", Version: "1.5.0", Schema: MemoryActivitySchema}
+}
+
+func (v *MemoryActivity) GetActivityId() int32 {
+	return v.ActivityId
+}
+
+func (v *MemoryActivity) GetCategoryUid() int32 {
+	return v.CategoryUid
+}
+
+func (v *MemoryActivity) GetClassUid() int32 {
+	return v.ClassUid
+}
+
+func (v *MemoryActivity) GetMetadata() *Metadata {
+	return &v.Metadata
+}
+
+func (v *MemoryActivity) GetSeverityId() int32 {
+	return v.SeverityId
+}
+
+func (v *MemoryActivity) GetTime() int64 {
+	return v.Time
+}
+
+func (v *MemoryActivity) GetTypeUid() int64 {
+	return v.TypeUid
+}
+
+func (v *ModuleActivity) OCSFClass() ocsf.Class {
+	return ocsf.Class{Name: ModuleActivityClassname, Package: "v1_5_0", Version: "1.5.0", Schema: ModuleActivitySchema}
+}
+
+func (v *ModuleActivity) GetActivityId() int32 {
+	return v.ActivityId
+}
+
+func (v *ModuleActivity) GetCategoryUid() int32 {
+	return v.CategoryUid
+}
+
+func (v *ModuleActivity) GetClassUid() int32 {
+	return v.ClassUid
+}
+
+func (v *ModuleActivity) GetMetadata() *Metadata {
+	return &v.Metadata
+}
+
+func (v *ModuleActivity) GetSeverityId() int32 {
+	return v.SeverityId
+}
+
+func (v *ModuleActivity) GetTime() int64 {
+	return v.Time
+}
+
+func (v *ModuleActivity) GetTypeUid() int64 {
+	return v.TypeUid
+}
+
+func (v *ModuleQuery) OCSFClass() ocsf.Class {
+	return ocsf.Class{Name: ModuleQueryClassname, Package: "v1_5_0", Version: "1.5.0", Schema: ModuleQuerySchema}
+}
+
+func (v *ModuleQuery) GetActivityId() int32 {
+	return v.ActivityId
+}
+
+func (v *ModuleQuery) GetCategoryUid() int32 {
+	return v.CategoryUid
+}
+
+func (v *ModuleQuery) GetClassUid() int32 {
+	return v.ClassUid
+}
+
+func (v *ModuleQuery) GetMetadata() *Metadata {
+	return &v.Metadata
+}
+
+func (v *ModuleQuery) GetSeverityId() int32 {
+	return v.SeverityId
+}
+
+func (v *ModuleQuery) GetTime() int64 {
+	return v.Time
+}
+
+func (v *ModuleQuery) GetTypeUid() int64 {
+	return v.TypeUid
+}
+
+func (v *NetworkActivity) OCSFClass() ocsf.Class {
+	return ocsf.Class{Name: NetworkActivityClassname, Package: "v1_5_0", Version: "1.5.0", Schema: NetworkActivitySchema}
+}
+
+func (v *NetworkActivity) GetActivityId() int32 {
+	return v.ActivityId
+}
+
+func (v *NetworkActivity) GetCategoryUid() int32 {
+	return v.CategoryUid
+}
+
+func (v *NetworkActivity) GetClassUid() int32 {
+	return v.ClassUid
+}
+
+func (v *NetworkActivity) GetMetadata() *Metadata {
+	return &v.Metadata
+}
+
+func (v *NetworkActivity) GetSeverityId() int32 {
+	return v.SeverityId
+}
+
+func (v *NetworkActivity) GetTime() int64 {
+	return v.Time
+}
+
+func (v *NetworkActivity) GetTypeUid() int64 {
+	return v.TypeUid
+}
+
+func (v *NetworkConnectionQuery) OCSFClass() ocsf.Class {
+	return ocsf.Class{Name: NetworkConnectionQueryClassname, Package: "v1_5_0", Version: "1.5.0", Schema: NetworkConnectionQuerySchema}
+}
+
+func (v *NetworkConnectionQuery) GetActivityId() int32 {
+	return v.ActivityId
+}
+
+func (v *NetworkConnectionQuery) GetCategoryUid() int32 {
+	return v.CategoryUid
+}
+
+func (v *NetworkConnectionQuery) GetClassUid() int32 {
+	return v.ClassUid
+}
+
+func (v *NetworkConnectionQuery) GetMetadata() *Metadata {
+	return &v.Metadata
+}
+
+func (v *NetworkConnectionQuery) GetSeverityId() int32 {
+	return v.SeverityId
+}
+
+func (v *NetworkConnectionQuery) GetTime() int64 {
+	return v.Time
+}
+
+func (v *NetworkConnectionQuery) GetTypeUid() int64 {
+	return v.TypeUid
+}
+
+func (v *NetworkFileActivity) OCSFClass() ocsf.Class {
+	return ocsf.Class{Name: NetworkFileActivityClassname, Package: "v1_5_0", Version: "1.5.0", Schema: NetworkFileActivitySchema}
+}
+
+func (v *NetworkFileActivity) GetActivityId() int32 {
+	return v.ActivityId
+}
+
+func (v *NetworkFileActivity) GetCategoryUid() int32 {
+	return v.CategoryUid
+}
+
+func (v *NetworkFileActivity) GetClassUid() int32 {
+	return v.ClassUid
+}
+
+func (v *NetworkFileActivity) GetMetadata() *Metadata {
+	return &v.Metadata
+}
+
+func (v *NetworkFileActivity) GetSeverityId() int32 {
+	return v.SeverityId
+}
+
+func (v *NetworkFileActivity) GetTime() int64 {
+	return v.Time
+}
+
+func (v *NetworkFileActivity) GetTypeUid() int64 {
+	return v.TypeUid
+}
+
+func (v *NetworkRemediationActivity) OCSFClass() ocsf.Class {
+	return ocsf.Class{Name: NetworkRemediationActivityClassname, Package: "v1_5_0", Version: "1.5.0", Schema: NetworkRemediationActivitySchema}
+}
+
+func (v *NetworkRemediationActivity) GetActivityId() int32 {
+	return v.ActivityId
+}
+
+func (v *NetworkRemediationActivity) GetCategoryUid() int32 {
+	return v.CategoryUid
+}
+
+func (v *NetworkRemediationActivity) GetClassUid() int32 {
+	return v.ClassUid
+}
+
+func (v *NetworkRemediationActivity) GetMetadata() *Metadata {
+	return &v.Metadata
+}
+
+func (v *NetworkRemediationActivity) GetSeverityId() int32 {
+	return v.SeverityId
+}
+
+func (v *NetworkRemediationActivity) GetTime() int64 {
+	return v.Time
+}
+
+func (v *NetworkRemediationActivity) GetTypeUid() int64 {
+	return v.TypeUid
+}
+
+func (v *NetworksQuery) OCSFClass() ocsf.Class {
+	return ocsf.Class{Name: NetworksQueryClassname, Package: "v1_5_0", Version: "1.5.0", Schema: NetworksQuerySchema}
+}
+
+func (v *NetworksQuery) GetActivityId() int32 {
+	return v.ActivityId
+}
+
+func (v *NetworksQuery) GetCategoryUid() int32 {
+	return v.CategoryUid
+}
+
+func (v *NetworksQuery) GetClassUid() int32 {
+	return v.ClassUid
+}
+
+func (v *NetworksQuery) GetMetadata() *Metadata {
+	return &v.Metadata
+}
+
+func (v *NetworksQuery) GetSeverityId() int32 {
+	return v.SeverityId
+}
+
+func (v *NetworksQuery) GetTime() int64 {
+	return v.Time
+}
+
+func (v *NetworksQuery) GetTypeUid() int64 {
+	return v.TypeUid
+}
+
+func (v *NTPActivity) OCSFClass() ocsf.Class {
+	return ocsf.Class{Name: NTPActivityClassname, Package: "v1_5_0", Version: "1.5.0", Schema: NTPActivitySchema}
+}
+
+func (v *NTPActivity) GetActivityId() int32 {
+	return v.ActivityId
+}
+
+func (v *NTPActivity) GetCategoryUid() int32 {
+	return v.CategoryUid
+}
+
+func (v *NTPActivity) GetClassUid() int32 {
+	return v.ClassUid
+}
+
+func (v *NTPActivity) GetMetadata() *Metadata {
+	return &v.Metadata
+}
+
+func (v *NTPActivity) GetSeverityId() int32 {
+	return v.SeverityId
+}
+
+func (v *NTPActivity) GetTime() int64 {
+	return v.Time
+}
+
+func (v *NTPActivity) GetTypeUid() int64 {
+	return v.TypeUid
+}
+
+func (v *OSINTInventoryInfo) OCSFClass() ocsf.Class {
+	return ocsf.Class{Name: OSINTInventoryInfoClassname, Package: "v1_5_0", Version: "1.5.0", Schema: OSINTInventoryInfoSchema}
+}
+
+func (v *OSINTInventoryInfo) GetActivityId() int32 {
+	return v.ActivityId
+}
+
+func (v *OSINTInventoryInfo) GetCategoryUid() int32 {
+	return v.CategoryUid
+}
+
+func (v *OSINTInventoryInfo) GetClassUid() int32 {
+	return v.ClassUid
+}
+
+func (v *OSINTInventoryInfo) GetMetadata() *Metadata {
+	return &v.Metadata
+}
+
+func (v *OSINTInventoryInfo) GetSeverityId() int32 {
+	return v.SeverityId
+}
+
+func (v *OSINTInventoryInfo) GetTime() int64 {
+	return v.Time
+}
+
+func (v *OSINTInventoryInfo) GetTypeUid() int64 {
+	return v.TypeUid
+}
+
+func (v *OperatingSystemPatchState) OCSFClass() ocsf.Class {
+	return ocsf.Class{Name: OperatingSystemPatchStateClassname, Package: "v1_5_0", Version: "1.5.0", Schema: OperatingSystemPatchStateSchema}
+}
+
+func (v *OperatingSystemPatchState) GetActivityId() int32 {
+	return v.ActivityId
+}
+
+func (v *OperatingSystemPatchState) GetCategoryUid() int32 {
+	return v.CategoryUid
+}
+
+func (v *OperatingSystemPatchState) GetClassUid() int32 {
+	return v.ClassUid
+}
+
+func (v *OperatingSystemPatchState) GetMetadata() *Metadata {
+	return &v.Metadata
+}
+
+func (v *OperatingSystemPatchState) GetSeverityId() int32 {
+	return v.SeverityId
+}
+
+func (v *OperatingSystemPatchState) GetTime() int64 {
+	return v.Time
+}
+
+func (v *OperatingSystemPatchState) GetTypeUid() int64 {
+	return v.TypeUid
+}
+
+func (v *PeripheralDeviceQuery) OCSFClass() ocsf.Class {
+	return ocsf.Class{Name: PeripheralDeviceQueryClassname, Package: "v1_5_0", Version: "1.5.0", Schema: PeripheralDeviceQuerySchema}
+}
+
+func (v *PeripheralDeviceQuery) GetActivityId() int32 {
+	return v.ActivityId
+}
+
+func (v *PeripheralDeviceQuery) GetCategoryUid() int32 {
+	return v.CategoryUid
+}
+
+func (v *PeripheralDeviceQuery) GetClassUid() int32 {
+	return v.ClassUid
+}
+
+func (v *PeripheralDeviceQuery) GetMetadata() *Metadata {
+	return &v.Metadata
+}
+
+func (v *PeripheralDeviceQuery) GetSeverityId() int32 {
+	return v.SeverityId
+}
+
+func (v *PeripheralDeviceQuery) GetTime() int64 {
+	return v.Time
+}
+
+func (v *PeripheralDeviceQuery) GetTypeUid() int64 {
+	return v.TypeUid
+}
+
+func (v *PrefetchQuery) OCSFClass() ocsf.Class {
+	return ocsf.Class{Name: PrefetchQueryClassname, Package: "v1_5_0", Version: "1.5.0", Schema: PrefetchQuerySchema}
+}
+
+func (v *PrefetchQuery) GetActivityId() int32 {
+	return v.ActivityId
+}
+
+func (v *PrefetchQuery) GetCategoryUid() int32 {
+	return v.CategoryUid
+}
+
+func (v *PrefetchQuery) GetClassUid() int32 {
+	return v.ClassUid
+}
+
+func (v *PrefetchQuery) GetMetadata() *Metadata {
+	return &v.Metadata
+}
+
+func (v *PrefetchQuery) GetSeverityId() int32 {
+	return v.SeverityId
+}
+
+func (v *PrefetchQuery) GetTime() int64 {
+	return v.Time
+}
+
+func (v *PrefetchQuery) GetTypeUid() int64 {
+	return v.TypeUid
+}
+
+func (v *ProcessActivity) OCSFClass() ocsf.Class {
+	return ocsf.Class{Name: ProcessActivityClassname, Package: "v1_5_0", Version: "1.5.0", Schema: ProcessActivitySchema}
+}
+
+func (v *ProcessActivity) GetActivityId() int32 {
+	return v.ActivityId
+}
+
+func (v *ProcessActivity) GetCategoryUid() int32 {
+	return v.CategoryUid
+}
+
+func (v *ProcessActivity) GetClassUid() int32 {
+	return v.ClassUid
+}
+
+func (v *ProcessActivity) GetMetadata() *Metadata {
+	return &v.Metadata
+}
+
+func (v *ProcessActivity) GetSeverityId() int32 {
+	return v.SeverityId
+}
+
+func (v *ProcessActivity) GetTime() int64 {
+	return v.Time
+}
+
+func (v *ProcessActivity) GetTypeUid() int64 {
+	return v.TypeUid
+}
+
+func (v *ProcessQuery) OCSFClass() ocsf.Class {
+	return ocsf.Class{Name: ProcessQueryClassname, Package: "v1_5_0", Version: "1.5.0", Schema: ProcessQuerySchema}
+}
+
+func (v *ProcessQuery) GetActivityId() int32 {
+	return v.ActivityId
+}
+
+func (v *ProcessQuery) GetCategoryUid() int32 {
+	return v.CategoryUid
+}
+
+func (v *ProcessQuery) GetClassUid() int32 {
+	return v.ClassUid
+}
+
+func (v *ProcessQuery) GetMetadata() *Metadata {
+	return &v.Metadata
+}
+
+func (v *ProcessQuery) GetSeverityId() int32 {
+	return v.SeverityId
+}
+
+func (v *ProcessQuery) GetTime() int64 {
+	return v.Time
+}
+
+func (v *ProcessQuery) GetTypeUid() int64 {
+	return v.TypeUid
+}
+
+func (v *ProcessRemediationActivity) OCSFClass() ocsf.Class {
+	return ocsf.Class{Name: ProcessRemediationActivityClassname, Package: "v1_5_0", Version: "1.5.0", Schema: ProcessRemediationActivitySchema}
+}
+
+func (v *ProcessRemediationActivity) GetActivityId() int32 {
+	return v.ActivityId
+}
+
+func (v *ProcessRemediationActivity) GetCategoryUid() int32 {
+	return v.CategoryUid
+}
+
+func (v *ProcessRemediationActivity) GetClassUid() int32 {
+	return v.ClassUid
+}
+
+func (v *ProcessRemediationActivity) GetMetadata() *Metadata {
+	return &v.Metadata
+}
+
+func (v *ProcessRemediationActivity) GetSeverityId() int32 {
+	return v.SeverityId
+}
+
+func (v *ProcessRemediationActivity) GetTime() int64 {
+	return v.Time
+}
+
+func (v *ProcessRemediationActivity) GetTypeUid() int64 {
+	return v.TypeUid
+}
+
+func (v *RDPActivity) OCSFClass() ocsf.Class {
+	return ocsf.Class{Name: RDPActivityClassname, Package: "v1_5_0", Version: "1.5.0", Schema: RDPActivitySchema}
+}
+
+func (v *RDPActivity) GetActivityId() int32 {
+	return v.ActivityId
+}
+
+func (v *RDPActivity) GetCategoryUid() int32 {
+	return v.CategoryUid
+}
+
+func (v *RDPActivity) GetClassUid() int32 {
+	return v.ClassUid
+}
+
+func (v *RDPActivity) GetMetadata() *Metadata {
+	return &v.Metadata
+}
+
+func (v *RDPActivity) GetSeverityId() int32 {
+	return v.SeverityId
+}
+
+func (v *RDPActivity) GetTime() int64 {
+	return v.Time
+}
+
+func (v *RDPActivity) GetTypeUid() int64 {
+	return v.TypeUid
+}
+
+func (v *RegistryKeyActivity) OCSFClass() ocsf.Class {
+	return ocsf.Class{Name: RegistryKeyActivityClassname, Package: "v1_5_0", Version: "1.5.0", Schema: RegistryKeyActivitySchema}
+}
+
+func (v *RegistryKeyActivity) GetActivityId() int32 {
+	return v.ActivityId
+}
+
+func (v *RegistryKeyActivity) GetCategoryUid() int32 {
+	return v.CategoryUid
+}
+
+func (v *RegistryKeyActivity) GetClassUid() int32 {
+	return v.ClassUid
+}
+
+func (v *RegistryKeyActivity) GetMetadata() *Metadata {
+	return &v.Metadata
+}
+
+func (v *RegistryKeyActivity) GetSeverityId() int32 {
+	return v.SeverityId
+}
+
+func (v *RegistryKeyActivity) GetTime() int64 {
+	return v.Time
+}
+
+func (v *RegistryKeyActivity) GetTypeUid() int64 {
+	return v.TypeUid
+}
+
+func (v *RegistryKeyQuery) OCSFClass() ocsf.Class {
+	return ocsf.Class{Name: RegistryKeyQueryClassname, Package: "v1_5_0", Version: "1.5.0", Schema: RegistryKeyQuerySchema}
+}
+
+func (v *RegistryKeyQuery) GetActivityId() int32 {
+	return v.ActivityId
+}
+
+func (v *RegistryKeyQuery) GetCategoryUid() int32 {
+	return v.CategoryUid
+}
+
+func (v *RegistryKeyQuery) GetClassUid() int32 {
+	return v.ClassUid
+}
+
+func (v *RegistryKeyQuery) GetMetadata() *Metadata {
+	return &v.Metadata
+}
+
+func (v *RegistryKeyQuery) GetSeverityId() int32 {
+	return v.SeverityId
+}
+
+func (v *RegistryKeyQuery) GetTime() int64 {
+	return v.Time
+}
+
+func (v *RegistryKeyQuery) GetTypeUid() int64 {
+	return v.TypeUid
+}
+
+func (v *RegistryValueActivity) OCSFClass() ocsf.Class {
+	return ocsf.Class{Name: RegistryValueActivityClassname, Package: "v1_5_0", Version: "1.5.0", Schema: RegistryValueActivitySchema}
+}
+
+func (v *RegistryValueActivity) GetActivityId() int32 {
+	return v.ActivityId
+}
+
+func (v *RegistryValueActivity) GetCategoryUid() int32 {
+	return v.CategoryUid
+}
+
+func (v *RegistryValueActivity) GetClassUid() int32 {
+	return v.ClassUid
+}
+
+func (v *RegistryValueActivity) GetMetadata() *Metadata {
+	return &v.Metadata
+}
+
+func (v *RegistryValueActivity) GetSeverityId() int32 {
+	return v.SeverityId
+}
+
+func (v *RegistryValueActivity) GetTime() int64 {
+	return v.Time
+}
+
+func (v *RegistryValueActivity) GetTypeUid() int64 {
+	return v.TypeUid
+}
+
+func (v *RegistryValueQuery) OCSFClass() ocsf.Class {
+	return ocsf.Class{Name: RegistryValueQueryClassname, Package: "v1_5_0", Version: "1.5.0", Schema: RegistryValueQuerySchema}
+}
+
+func (v *RegistryValueQuery) GetActivityId() int32 {
+	return v.ActivityId
+}
+
+func (v *RegistryValueQuery) GetCategoryUid() int32 {
+	return v.CategoryUid
+}
+
+func (v *RegistryValueQuery) GetClassUid() int32 {
+	return v.ClassUid
+}
+
+func (v *RegistryValueQuery) GetMetadata() *Metadata {
+	return &v.Metadata
+}
+
+func (v *RegistryValueQuery) GetSeverityId() int32 {
+	return v.SeverityId
+}
+
+func (v *RegistryValueQuery) GetTime() int64 {
+	return v.Time
+}
+
+func (v *RegistryValueQuery) GetTypeUid() int64 {
+	return v.TypeUid
+}
+
+func (v *RemediationActivity) OCSFClass() ocsf.Class {
+	return ocsf.Class{Name: RemediationActivityClassname, Package: "v1_5_0", Version: "1.5.0", Schema: RemediationActivitySchema}
+}
+
+func (v *RemediationActivity) GetActivityId() int32 {
+	return v.ActivityId
+}
+
+func (v *RemediationActivity) GetCategoryUid() int32 {
+	return v.CategoryUid
+}
+
+func (v *RemediationActivity) GetClassUid() int32 {
+	return v.ClassUid
+}
+
+func (v *RemediationActivity) GetMetadata() *Metadata {
+	return &v.Metadata
+}
+
+func (v *RemediationActivity) GetSeverityId() int32 {
+	return v.SeverityId
+}
+
+func (v *RemediationActivity) GetTime() int64 {
+	return v.Time
+}
+
+func (v *RemediationActivity) GetTypeUid() int64 {
+	return v.TypeUid
+}
+
+func (v *ScanActivity) OCSFClass() ocsf.Class {
+	return ocsf.Class{Name: ScanActivityClassname, Package: "v1_5_0", Version: "1.5.0", Schema: ScanActivitySchema}
+}
+
+func (v *ScanActivity) GetActivityId() int32 {
+	return v.ActivityId
+}
+
+func (v *ScanActivity) GetCategoryUid() int32 {
+	return v.CategoryUid
+}
+
+func (v *ScanActivity) GetClassUid() int32 {
+	return v.ClassUid
+}
+
+func (v *ScanActivity) GetMetadata() *Metadata {
+	return &v.Metadata
+}
+
+func (v *ScanActivity) GetSeverityId() int32 {
+	return v.SeverityId
+}
+
+func (v *ScanActivity) GetTime() int64 {
+	return v.Time
+}
+
+func (v *ScanActivity) GetTypeUid() int64 {
+	return v.TypeUid
+}
+
+func (v *ScheduledJobActivity) OCSFClass() ocsf.Class {
+	return ocsf.Class{Name: ScheduledJobActivityClassname, Package: "v1_5_0", Version: "1.5.0", Schema: ScheduledJobActivitySchema}
+}
+
+func (v *ScheduledJobActivity) GetActivityId() int32 {
+	return v.ActivityId
+}
+
+func (v *ScheduledJobActivity) GetCategoryUid() int32 {
+	return v.CategoryUid
+}
+
+func (v *ScheduledJobActivity) GetClassUid() int32 {
+	return v.ClassUid
+}
+
+func (v *ScheduledJobActivity) GetMetadata() *Metadata {
+	return &v.Metadata
+}
+
+func (v *ScheduledJobActivity) GetSeverityId() int32 {
+	return v.SeverityId
+}
+
+func (v *ScheduledJobActivity) GetTime() int64 {
+	return v.Time
+}
+
+func (v *ScheduledJobActivity) GetTypeUid() int64 {
+	return v.TypeUid
+}
+
+func (v *ScriptActivity) OCSFClass() ocsf.Class {
+	return ocsf.Class{Name: ScriptActivityClassname, Package: "v1_5_0", Version: "1.5.0", Schema: ScriptActivitySchema}
+}
+
+func (v *ScriptActivity) GetActivityId() int32 {
+	return v.ActivityId
+}
+
+func (v *ScriptActivity) GetCategoryUid() int32 {
+	return v.CategoryUid
+}
+
+func (v *ScriptActivity) GetClassUid() int32 {
+	return v.ClassUid
+}
+
+func (v *ScriptActivity) GetMetadata() *Metadata {
+	return &v.Metadata
+}
+
+func (v *ScriptActivity) GetSeverityId() int32 {
+	return v.SeverityId
+}
+
+func (v *ScriptActivity) GetTime() int64 {
+	return v.Time
+}
+
+func (v *ScriptActivity) GetTypeUid() int64 {
+	return v.TypeUid
+}
+
+func (v *SecurityFinding) OCSFClass() ocsf.Class {
+	return ocsf.Class{Name: SecurityFindingClassname, Package: "v1_5_0", Version: "1.5.0", Schema: SecurityFindingSchema}
+}
+
+func (v *SecurityFinding) GetActivityId() int32 {
+	return v.ActivityId
+}
+
+func (v *SecurityFinding) GetCategoryUid() int32 {
+	return v.CategoryUid
+}
+
+func (v *SecurityFinding) GetClassUid() int32 {
+	return v.ClassUid
+}
+
+func (v *SecurityFinding) GetMetadata() *Metadata {
+	return &v.Metadata
+}
+
+func (v *SecurityFinding) GetSeverityId() int32 {
+	return v.SeverityId
+}
+
+func (v *SecurityFinding) GetTime() int64 {
+	return v.Time
+}
+
+func (v *SecurityFinding) GetTypeUid() int64 {
+	return v.TypeUid
+}
+
+func (v *ServiceQuery) OCSFClass() ocsf.Class {
+	return ocsf.Class{Name: ServiceQueryClassname, Package: "v1_5_0", Version: "1.5.0", Schema: ServiceQuerySchema}
+}
+
+func (v *ServiceQuery) GetActivityId() int32 {
+	return v.ActivityId
+}
+
+func (v *ServiceQuery) GetCategoryUid() int32 {
+	return v.CategoryUid
+}
+
+func (v *ServiceQuery) GetClassUid() int32 {
+	return v.ClassUid
+}
+
+func (v *ServiceQuery) GetMetadata() *Metadata {
+	return &v.Metadata
+}
+
+func (v *ServiceQuery) GetSeverityId() int32 {
+	return v.SeverityId
+}
+
+func (v *ServiceQuery) GetTime() int64 {
+	return v.Time
+}
+
+func (v *ServiceQuery) GetTypeUid() int64 {
+	return v.TypeUid
+}
+
+func (v *UserSessionQuery) OCSFClass() ocsf.Class {
+	return ocsf.Class{Name: UserSessionQueryClassname, Package: "v1_5_0", Version: "1.5.0", Schema: UserSessionQuerySchema}
+}
+
+func (v *UserSessionQuery) GetActivityId() int32 {
+	return v.ActivityId
+}
+
+func (v *UserSessionQuery) GetCategoryUid() int32 {
+	return v.CategoryUid
+}
+
+func (v *UserSessionQuery) GetClassUid() int32 {
+	return v.ClassUid
+}
+
+func (v *UserSessionQuery) GetMetadata() *Metadata {
+	return &v.Metadata
+}
+
+func (v *UserSessionQuery) GetSeverityId() int32 {
+	return v.SeverityId
+}
+
+func (v *UserSessionQuery) GetTime() int64 {
+	return v.Time
+}
+
+func (v *UserSessionQuery) GetTypeUid() int64 {
+	return v.TypeUid
+}
+
+func (v *SMBActivity) OCSFClass() ocsf.Class {
+	return ocsf.Class{Name: SMBActivityClassname, Package: "v1_5_0", Version: "1.5.0", Schema: SMBActivitySchema}
+}
+
+func (v *SMBActivity) GetActivityId() int32 {
+	return v.ActivityId
+}
+
+func (v *SMBActivity) GetCategoryUid() int32 {
+	return v.CategoryUid
+}
+
+func (v *SMBActivity) GetClassUid() int32 {
+	return v.ClassUid
+}
+
+func (v *SMBActivity) GetMetadata() *Metadata {
+	return &v.Metadata
+}
+
+func (v *SMBActivity) GetSeverityId() int32 {
+	return v.SeverityId
+}
+
+func (v *SMBActivity) GetTime() int64 {
+	return v.Time
+}
+
+func (v *SMBActivity) GetTypeUid() int64 {
+	return v.TypeUid
+}
+
+func (v *SoftwareInventoryInfo) OCSFClass() ocsf.Class {
+	return ocsf.Class{Name: SoftwareInventoryInfoClassname, Package: "v1_5_0", Version: "1.5.0", Schema: SoftwareInventoryInfoSchema}
+}
+
+func (v *SoftwareInventoryInfo) GetActivityId() int32 {
+	return v.ActivityId
+}
+
+func (v *SoftwareInventoryInfo) GetCategoryUid() int32 {
+	return v.CategoryUid
+}
+
+func (v *SoftwareInventoryInfo) GetClassUid() int32 {
+	return v.ClassUid
+}
+
+func (v *SoftwareInventoryInfo) GetMetadata() *Metadata {
+	return &v.Metadata
+}
+
+func (v *SoftwareInventoryInfo) GetSeverityId() int32 {
+	return v.SeverityId
+}
+
+func (v *SoftwareInventoryInfo) GetTime() int64 {
+	return v.Time
+}
+
+func (v *SoftwareInventoryInfo) GetTypeUid() int64 {
+	return v.TypeUid
+}
+
+func (v *SSHActivity) OCSFClass() ocsf.Class {
+	return ocsf.Class{Name: SSHActivityClassname, Package: "v1_5_0", Version: "1.5.0", Schema: SSHActivitySchema}
+}
+
+func (v *SSHActivity) GetActivityId() int32 {
+	return v.ActivityId
+}
+
+func (v *SSHActivity) GetCategoryUid() int32 {
+	return v.CategoryUid
+}
+
+func (v *SSHActivity) GetClassUid() int32 {
+	return v.ClassUid
+}
+
+func (v *SSHActivity) GetMetadata() *Metadata {
+	return &v.Metadata
+}
+
+func (v *SSHActivity) GetSeverityId() int32 {
+	return v.SeverityId
+}
+
+func (v *SSHActivity) GetTime() int64 {
+	return v.Time
+}
+
+func (v *SSHActivity) GetTypeUid() int64 {
+	return v.TypeUid
+}
+
+func (v *StartupItemQuery) OCSFClass() ocsf.Class {
+	return ocsf.Class{Name: StartupItemQueryClassname, Package: "v1_5_0", Version: "1.5.0", Schema: StartupItemQuerySchema}
+}
+
+func (v *StartupItemQuery) GetActivityId() int32 {
+	return v.ActivityId
+}
+
+func (v *StartupItemQuery) GetCategoryUid() int32 {
+	return v.CategoryUid
+}
+
+func (v *StartupItemQuery) GetClassUid() int32 {
+	return v.ClassUid
+}
+
+func (v *StartupItemQuery) GetMetadata() *Metadata {
+	return &v.Metadata
+}
+
+func (v *StartupItemQuery) GetSeverityId() int32 {
+	return v.SeverityId
+}
+
+func (v *StartupItemQuery) GetTime() int64 {
+	return v.Time
+}
+
+func (v *StartupItemQuery) GetTypeUid() int64 {
+	return v.TypeUid
+}
+
+func (v *TunnelActivity) OCSFClass() ocsf.Class {
+	return ocsf.Class{Name: TunnelActivityClassname, Package: "v1_5_0", Version: "1.5.0", Schema: TunnelActivitySchema}
+}
+
+func (v *TunnelActivity) GetActivityId() int32 {
+	return v.ActivityId
+}
+
+func (v *TunnelActivity) GetCategoryUid() int32 {
+	return v.CategoryUid
+}
+
+func (v *TunnelActivity) GetClassUid() int32 {
+	return v.ClassUid
+}
+
+func (v *TunnelActivity) GetMetadata() *Metadata {
+	return &v.Metadata
+}
+
+func (v *TunnelActivity) GetSeverityId() int32 {
+	return v.SeverityId
+}
+
+func (v *TunnelActivity) GetTime() int64 {
+	return v.Time
+}
+
+func (v *TunnelActivity) GetTypeUid() int64 {
+	return v.TypeUid
+}
+
+func (v *UserAccessManagement) OCSFClass() ocsf.Class {
+	return ocsf.Class{Name: UserAccessManagementClassname, Package: "v1_5_0", Version: "1.5.0", Schema: UserAccessManagementSchema}
+}
+
+func (v *UserAccessManagement) GetActivityId() int32 {
+	return v.ActivityId
+}
+
+func (v *UserAccessManagement) GetCategoryUid() int32 {
+	return v.CategoryUid
+}
+
+func (v *UserAccessManagement) GetClassUid() int32 {
+	return v.ClassUid
+}
+
+func (v *UserAccessManagement) GetMetadata() *Metadata {
+	return &v.Metadata
+}
+
+func (v *UserAccessManagement) GetSeverityId() int32 {
+	return v.SeverityId
+}
+
+func (v *UserAccessManagement) GetTime() int64 {
+	return v.Time
+}
+
+func (v *UserAccessManagement) GetTypeUid() int64 {
+	return v.TypeUid
+}
+
+func (v *UserInventoryInfo) OCSFClass() ocsf.Class {
+	return ocsf.Class{Name: UserInventoryInfoClassname, Package: "v1_5_0", Version: "1.5.0", Schema: UserInventoryInfoSchema}
+}
+
+func (v *UserInventoryInfo) GetActivityId() int32 {
+	return v.ActivityId
+}
+
+func (v *UserInventoryInfo) GetCategoryUid() int32 {
+	return v.CategoryUid
+}
+
+func (v *UserInventoryInfo) GetClassUid() int32 {
+	return v.ClassUid
+}
+
+func (v *UserInventoryInfo) GetMetadata() *Metadata {
+	return &v.Metadata
+}
+
+func (v *UserInventoryInfo) GetSeverityId() int32 {
+	return v.SeverityId
+}
+
+func (v *UserInventoryInfo) GetTime() int64 {
+	return v.Time
+}
+
+func (v *UserInventoryInfo) GetTypeUid() int64 {
+	return v.TypeUid
+}
+
+func (v *UserQuery) OCSFClass() ocsf.Class {
+	return ocsf.Class{Name: UserQueryClassname, Package: "v1_5_0", Version: "1.5.0", Schema: UserQuerySchema}
+}
+
+func (v *UserQuery) GetActivityId() int32 {
+	return v.ActivityId
+}
+
+func (v *UserQuery) GetCategoryUid() int32 {
+	return v.CategoryUid
+}
+
+func (v *UserQuery) GetClassUid() int32 {
+	return v.ClassUid
+}
+
+func (v *UserQuery) GetMetadata() *Metadata {
+	return &v.Metadata
+}
+
+func (v *UserQuery) GetSeverityId() int32 {
+	return v.SeverityId
+}
+
+func (v *UserQuery) GetTime() int64 {
+	return v.Time
+}
+
+func (v *UserQuery) GetTypeUid() int64 {
+	return v.TypeUid
+}
+
+func (v *VulnerabilityFinding) OCSFClass() ocsf.Class {
+	return ocsf.Class{Name: VulnerabilityFindingClassname, Package: "v1_5_0", Version: "1.5.0", Schema: VulnerabilityFindingSchema}
+}
+
+func (v *VulnerabilityFinding) GetActivityId() int32 {
+	return v.ActivityId
+}
+
+func (v *VulnerabilityFinding) GetCategoryUid() int32 {
+	return v.CategoryUid
+}
+
+func (v *VulnerabilityFinding) GetClassUid() int32 {
+	return v.ClassUid
+}
+
+func (v *VulnerabilityFinding) GetMetadata() *Metadata {
+	return &v.Metadata
+}
+
+func (v *VulnerabilityFinding) GetSeverityId() int32 {
+	return v.SeverityId
+}
+
+func (v *VulnerabilityFinding) GetTime() int64 {
+	return v.Time
+}
+
+func (v *VulnerabilityFinding) GetTypeUid() int64 {
+	return v.TypeUid
+}
+
+func (v *VulnerabilityFinding) GetFindingInfo() *FindingInformation {
+	return &v.FindingInfo
+}
+
+func (v *VulnerabilityFinding) GetFindingUid() string {
+	if v.GetFindingInfo() == nil || v.GetFindingInfo().Uid == "" {
+		return ""
+	}
+	return v.GetFindingInfo().Uid
+}
+
+func (v *WebResourceAccessActivity) OCSFClass() ocsf.Class {
+	return ocsf.Class{Name: WebResourceAccessActivityClassname, Package: "v1_5_0", Version: "1.5.0", Schema: WebResourceAccessActivitySchema}
+}
+
+func (v *WebResourceAccessActivity) GetActivityId() int32 {
+	return v.ActivityId
+}
+
+func (v *WebResourceAccessActivity) GetCategoryUid() int32 {
+	return v.CategoryUid
+}
+
+func (v *WebResourceAccessActivity) GetClassUid() int32 {
+	return v.ClassUid
+}
+
+func (v *WebResourceAccessActivity) GetMetadata() *Metadata {
+	return &v.Metadata
+}
+
+func (v *WebResourceAccessActivity) GetSeverityId() int32 {
+	return v.SeverityId
+}
+
+func (v *WebResourceAccessActivity) GetTime() int64 {
+	return v.Time
+}
+
+func (v *WebResourceAccessActivity) GetTypeUid() int64 {
+	return v.TypeUid
+}
+
+func (v *WebResourcesActivity) OCSFClass() ocsf.Class {
+	return ocsf.Class{Name: WebResourcesActivityClassname, Package: "v1_5_0", Version: "1.5.0", Schema: WebResourcesActivitySchema}
+}
+
+func (v *WebResourcesActivity) GetActivityId() int32 {
+	return v.ActivityId
+}
+
+func (v *WebResourcesActivity) GetCategoryUid() int32 {
+	return v.CategoryUid
+}
+
+func (v *WebResourcesActivity) GetClassUid() int32 {
+	return v.ClassUid
+}
+
+func (v *WebResourcesActivity) GetMetadata() *Metadata {
+	return &v.Metadata
+}
+
+func (v *WebResourcesActivity) GetSeverityId() int32 {
+	return v.SeverityId
+}
+
+func (v *WebResourcesActivity) GetTime() int64 {
+	return v.Time
+}
+
+func (v *WebResourcesActivity) GetTypeUid() int64 {
+	return v.TypeUid
+}
+
+func (v *WindowsResourceActivity) OCSFClass() ocsf.Class {
+	return ocsf.Class{Name: WindowsResourceActivityClassname, Package: "v1_5_0", Version: "1.5.0", Schema: WindowsResourceActivitySchema}
+}
+
+func (v *WindowsResourceActivity) GetActivityId() int32 {
+	return v.ActivityId
+}
+
+func (v *WindowsResourceActivity) GetCategoryUid() int32 {
+	return v.CategoryUid
+}
+
+func (v *WindowsResourceActivity) GetClassUid() int32 {
+	return v.ClassUid
+}
+
+func (v *WindowsResourceActivity) GetMetadata() *Metadata {
+	return &v.Metadata
+}
+
+func (v *WindowsResourceActivity) GetSeverityId() int32 {
+	return v.SeverityId
+}
+
+func (v *WindowsResourceActivity) GetTime() int64 {
+	return v.Time
+}
+
+func (v *WindowsResourceActivity) GetTypeUid() int64 {
+	return v.TypeUid
+}
+
+func (v *WindowsServiceActivity) OCSFClass() ocsf.Class {
+	return ocsf.Class{Name: WindowsServiceActivityClassname, Package: "v1_5_0", Version: "1.5.0", Schema: WindowsServiceActivitySchema}
+}
+
+func (v *WindowsServiceActivity) GetActivityId() int32 {
+	return v.ActivityId
+}
+
+func (v *WindowsServiceActivity) GetCategoryUid() int32 {
+	return v.CategoryUid
+}
+
+func (v *WindowsServiceActivity) GetClassUid() int32 {
+	return v.ClassUid
+}
+
+func (v *WindowsServiceActivity) GetMetadata() *Metadata {
+	return &v.Metadata
+}
+
+func (v *WindowsServiceActivity) GetSeverityId() int32 {
+	return v.SeverityId
+}
+
+func (v *WindowsServiceActivity) GetTime() int64 {
+	return v.Time
+}
+
+func (v *WindowsServiceActivity) GetTypeUid() int64 {
+	return v.TypeUid
+}
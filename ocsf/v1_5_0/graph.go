@@ -2,6 +2,7 @@
 package v1_5_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -39,16 +40,199 @@ func (v *Graph) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *Graph) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *Graph) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	for i := range v.Edges {
+		errs = append(errs, v.Edges[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "edges"), i))...)
+	}
+	if len(v.Nodes) == 0 {
+		errs.Add(ocsf.JoinPath(path, "nodes"), "required attribute is missing")
+	}
+	for i := range v.Nodes {
+		errs = append(errs, v.Nodes[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "nodes"), i))...)
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *Graph) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "desc":
+		if index >= 0 || rest != "" || v.Desc == nil {
+			return nil, false
+		}
+		return *v.Desc, true
+	case "edges":
+		if rest == "" {
+			if index < 0 {
+				return v.Edges, v.Edges != nil
+			}
+			return ocsf.Index(v.Edges, index)
+		}
+		if index < 0 || index >= len(v.Edges) {
+			return nil, false
+		}
+		return v.Edges[index].Get(rest)
+	case "is_directed":
+		if index >= 0 || rest != "" || v.IsDirected == nil {
+			return nil, false
+		}
+		return *v.IsDirected, true
+	case "name":
+		if index >= 0 || rest != "" || v.Name == nil {
+			return nil, false
+		}
+		return *v.Name, true
+	case "nodes":
+		if rest == "" {
+			if index < 0 {
+				return v.Nodes, v.Nodes != nil
+			}
+			return ocsf.Index(v.Nodes, index)
+		}
+		if index < 0 || index >= len(v.Nodes) {
+			return nil, false
+		}
+		return v.Nodes[index].Get(rest)
+	case "query_language":
+		if index >= 0 || rest != "" || v.QueryLanguage == nil {
+			return nil, false
+		}
+		return *v.QueryLanguage, true
+	case "query_language_id":
+		if index >= 0 || rest != "" || v.QueryLanguageId == nil {
+			return nil, false
+		}
+		return *v.QueryLanguageId, true
+	case "type":
+		if index >= 0 || rest != "" || v.Type == nil {
+			return nil, false
+		}
+		return *v.Type, true
+	case "uid":
+		if index >= 0 || rest != "" || v.Uid == nil {
+			return nil, false
+		}
+		return *v.Uid, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *Graph) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "desc":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Desc, value)
+	case "edges":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Edges, value)
+		}
+		elem, err := ocsf.Element(&v.Edges, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "is_directed":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.IsDirected, value)
+	case "name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Name, value)
+	case "nodes":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Nodes, value)
+		}
+		elem, err := ocsf.Element(&v.Nodes, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "query_language":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.QueryLanguage, value)
+	case "query_language_id":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.QueryLanguageId, value)
+	case "type":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Type, value)
+	case "uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Uid, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *Graph) AppendObservables(path string, observables []Observable) []Observable {
+	for i := range v.Edges {
+		observables = v.Edges[i].AppendObservables(ocsf.JoinPath(path, "edges"), observables)
+	}
+	for i := range v.Nodes {
+		observables = v.Nodes[i].AppendObservables(ocsf.JoinPath(path, "nodes"), observables)
+	}
+
+	return observables
+}
+
 var GraphFields = []arrow.Field{
-	{Name: "desc", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "edges", Type: arrow.ListOf(EdgeStruct), Nullable: true},
-	{Name: "is_directed", Type: arrow.FixedWidthTypes.Boolean, Nullable: true},
-	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "nodes", Type: arrow.ListOf(NodeStruct), Nullable: false},
-	{Name: "query_language", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "query_language_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "type", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "desc", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Description", "The graph description - provides additional details about the graph's purpose and contents.", "optional", "")},
+	{Name: "edges", Type: arrow.ListOf(EdgeStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("Edges", "The edges/connections between nodes in the graph - contains the collection of <code>edge</code> objects defining relationships between nodes.", "optional", "")},
+	{Name: "is_directed", Type: arrow.FixedWidthTypes.Boolean, Nullable: true, Metadata: ocsf.AttributeMetadata("Directed", "Indicates if the graph is directed (<code>true</code>) or undirected (<code>false</code>).", "optional", "")},
+	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Name", "The graph name - a human readable identifier for the graph.", "optional", "")},
+	{Name: "nodes", Type: arrow.ListOf(NodeStruct), Nullable: false, Metadata: ocsf.AttributeMetadata("Nodes", "The nodes/vertices of the graph - contains the collection of <code>node</code> objects that make up the graph.", "required", "")},
+	{Name: "query_language", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Query Language", "The graph query language, normalized to the caption of the <code>query_language_id</code> value.", "optional", "")},
+	{Name: "query_language_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Query Language ID", "The normalized identifier of a graph query language that can be used to interact with the graph.", "optional", "")},
+	{Name: "type", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Type", "The graph type. Typically useful to represent the specifc type of graph that is used.", "optional", "")},
+	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Unique ID", "Unique identifier of the graph - a unique ID to reference this specific graph.", "optional", "")},
 }
 
 var GraphStruct = arrow.StructOf(GraphFields...)
@@ -2,6 +2,7 @@
 package v1_5_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -18,9 +19,83 @@ func (v *HASSH) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *HASSH) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *HASSH) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	errs = append(errs, v.Fingerprint.ValidateAt(ocsf.JoinPath(path, "fingerprint"))...)
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *HASSH) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "algorithm":
+		if index >= 0 || rest != "" || v.Algorithm == nil {
+			return nil, false
+		}
+		return *v.Algorithm, true
+	case "fingerprint":
+		if index >= 0 {
+			return nil, false
+		}
+		if rest == "" {
+			return &v.Fingerprint, true
+		}
+		return v.Fingerprint.Get(rest)
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *HASSH) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "algorithm":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Algorithm, value)
+	case "fingerprint":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetRequired(&v.Fingerprint, value)
+		}
+		return v.Fingerprint.Set(rest, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *HASSH) AppendObservables(path string, observables []Observable) []Observable {
+	observables = v.Fingerprint.AppendObservables(ocsf.JoinPath(path, "fingerprint"), observables)
+
+	return observables
+}
+
 var HASSHFields = []arrow.Field{
-	{Name: "algorithm", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "fingerprint", Type: FingerprintStruct, Nullable: false},
+	{Name: "algorithm", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Algorithm", "The concatenation of key exchange, encryption, authentication and compression algorithms (separated by ';'). NOTE: This is not the underlying algorithm for the hash implementation.", "optional", "")},
+	{Name: "fingerprint", Type: FingerprintStruct, Nullable: false, Metadata: ocsf.AttributeMetadata("Fingerprint", "The hash of the key exchange, encryption, authentication and compression algorithms.", "required", "")},
 }
 
 var HASSHStruct = arrow.StructOf(HASSHFields...)
@@ -2,6 +2,7 @@
 package v1_5_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -45,18 +46,224 @@ func (v *HTTPRequest) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *HTTPRequest) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *HTTPRequest) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	for i := range v.HttpHeaders {
+		errs = append(errs, v.HttpHeaders[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "http_headers"), i))...)
+	}
+	if v.Url != nil {
+		errs = append(errs, v.Url.ValidateAt(ocsf.JoinPath(path, "url"))...)
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *HTTPRequest) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "args":
+		if index >= 0 || rest != "" || v.Args == nil {
+			return nil, false
+		}
+		return *v.Args, true
+	case "body_length":
+		if index >= 0 || rest != "" || v.BodyLength == nil {
+			return nil, false
+		}
+		return *v.BodyLength, true
+	case "http_headers":
+		if rest == "" {
+			if index < 0 {
+				return v.HttpHeaders, v.HttpHeaders != nil
+			}
+			return ocsf.Index(v.HttpHeaders, index)
+		}
+		if index < 0 || index >= len(v.HttpHeaders) {
+			return nil, false
+		}
+		return v.HttpHeaders[index].Get(rest)
+	case "http_method":
+		if index >= 0 || rest != "" || v.HttpMethod == nil {
+			return nil, false
+		}
+		return *v.HttpMethod, true
+	case "length":
+		if index >= 0 || rest != "" || v.Length == nil {
+			return nil, false
+		}
+		return *v.Length, true
+	case "referrer":
+		if index >= 0 || rest != "" || v.Referrer == nil {
+			return nil, false
+		}
+		return *v.Referrer, true
+	case "uid":
+		if index >= 0 || rest != "" || v.Uid == nil {
+			return nil, false
+		}
+		return *v.Uid, true
+	case "url":
+		if index >= 0 || v.Url == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Url, true
+		}
+		return v.Url.Get(rest)
+	case "user_agent":
+		if index >= 0 || rest != "" || v.UserAgent == nil {
+			return nil, false
+		}
+		return *v.UserAgent, true
+	case "version":
+		if index >= 0 || rest != "" || v.Version == nil {
+			return nil, false
+		}
+		return *v.Version, true
+	case "x_forwarded_for":
+		if rest == "" {
+			if index < 0 {
+				return v.XForwardedFor, v.XForwardedFor != nil
+			}
+			return ocsf.Index(v.XForwardedFor, index)
+		}
+		return nil, false
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *HTTPRequest) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "args":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Args, value)
+	case "body_length":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.BodyLength, value)
+	case "http_headers":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.HttpHeaders, value)
+		}
+		elem, err := ocsf.Element(&v.HttpHeaders, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "http_method":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.HttpMethod, value)
+	case "length":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Length, value)
+	case "referrer":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Referrer, value)
+	case "uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Uid, value)
+	case "url":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Url, value)
+		}
+		if v.Url == nil {
+			v.Url = &UniformResourceLocator{}
+		}
+		return v.Url.Set(rest, value)
+	case "user_agent":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.UserAgent, value)
+	case "version":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Version, value)
+	case "x_forwarded_for":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.XForwardedFor, value)
+		}
+		elem, err := ocsf.Element(&v.XForwardedFor, index)
+		if err != nil {
+			return err
+		}
+		if rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(elem, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *HTTPRequest) AppendObservables(path string, observables []Observable) []Observable {
+	for i := range v.HttpHeaders {
+		observables = v.HttpHeaders[i].AppendObservables(ocsf.JoinPath(path, "http_headers"), observables)
+	}
+	if v.Url != nil {
+		observables = v.Url.AppendObservables(ocsf.JoinPath(path, "url"), observables)
+	}
+
+	return observables
+}
+
 var HTTPRequestFields = []arrow.Field{
-	{Name: "args", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "body_length", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "http_headers", Type: arrow.ListOf(HTTPHeaderStruct), Nullable: true},
-	{Name: "http_method", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "length", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "referrer", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "url", Type: UniformResourceLocatorStruct, Nullable: true},
-	{Name: "user_agent", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "version", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "x_forwarded_for", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true},
+	{Name: "args", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("HTTP Arguments", "The arguments sent along with the HTTP request.", "optional", "")},
+	{Name: "body_length", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Request Body Length", "The actual length of the HTTP request body, in number of bytes, independent of a potentially existing Content-Length header.", "optional", "")},
+	{Name: "http_headers", Type: arrow.ListOf(HTTPHeaderStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("HTTP Headers", "Additional HTTP headers of an HTTP request or response.", "optional", "")},
+	{Name: "http_method", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("HTTP Method", "The <a target='_blank' href='https://developer.mozilla.org/en-US/docs/Web/HTTP/Methods'>HTTP request method</a> indicates the desired action to be performed for a given resource.", "optional", "")},
+	{Name: "length", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Request Length", "The length of the entire HTTP request, in number of bytes.", "optional", "")},
+	{Name: "referrer", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("HTTP Referrer", "The request header that identifies the address of the previous web page, which is linked to the current web page or resource being requested.", "optional", "")},
+	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Unique ID", "The unique identifier of the http request.", "optional", "")},
+	{Name: "url", Type: UniformResourceLocatorStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("URL", "The URL object that pertains to the request.", "optional", "")},
+	{Name: "user_agent", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("HTTP User-Agent", "The request header that identifies the operating system and web browser.", "optional", "")},
+	{Name: "version", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("HTTP Version", "The Hypertext Transfer Protocol (HTTP) version.", "optional", "")},
+	{Name: "x_forwarded_for", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true, Metadata: ocsf.AttributeMetadata("X-Forwarded-For", "The X-Forwarded-For header identifying the originating IP address(es) of a client connecting to a web server through an HTTP proxy or a load balancer.", "optional", "")},
 }
 
 var HTTPRequestStruct = arrow.StructOf(HTTPRequestFields...)
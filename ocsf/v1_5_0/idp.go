@@ -2,6 +2,7 @@
 package v1_5_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -54,21 +55,277 @@ func (v *IdentityProvider) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *IdentityProvider) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *IdentityProvider) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	for i := range v.AuthFactors {
+		errs = append(errs, v.AuthFactors[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "auth_factors"), i))...)
+	}
+	if v.Fingerprint != nil {
+		errs = append(errs, v.Fingerprint.ValidateAt(ocsf.JoinPath(path, "fingerprint"))...)
+	}
+	if v.Scim != nil {
+		errs = append(errs, v.Scim.ValidateAt(ocsf.JoinPath(path, "scim"))...)
+	}
+	if v.Sso != nil {
+		errs = append(errs, v.Sso.ValidateAt(ocsf.JoinPath(path, "sso"))...)
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *IdentityProvider) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "auth_factors":
+		if rest == "" {
+			if index < 0 {
+				return v.AuthFactors, v.AuthFactors != nil
+			}
+			return ocsf.Index(v.AuthFactors, index)
+		}
+		if index < 0 || index >= len(v.AuthFactors) {
+			return nil, false
+		}
+		return v.AuthFactors[index].Get(rest)
+	case "domain":
+		if index >= 0 || rest != "" || v.Domain == nil {
+			return nil, false
+		}
+		return *v.Domain, true
+	case "fingerprint":
+		if index >= 0 || v.Fingerprint == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Fingerprint, true
+		}
+		return v.Fingerprint.Get(rest)
+	case "has_mfa":
+		if index >= 0 || rest != "" || v.HasMfa == nil {
+			return nil, false
+		}
+		return *v.HasMfa, true
+	case "issuer":
+		if index >= 0 || rest != "" || v.Issuer == nil {
+			return nil, false
+		}
+		return *v.Issuer, true
+	case "name":
+		if index >= 0 || rest != "" || v.Name == nil {
+			return nil, false
+		}
+		return *v.Name, true
+	case "protocol_name":
+		if index >= 0 || rest != "" || v.ProtocolName == nil {
+			return nil, false
+		}
+		return *v.ProtocolName, true
+	case "scim":
+		if index >= 0 || v.Scim == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Scim, true
+		}
+		return v.Scim.Get(rest)
+	case "sso":
+		if index >= 0 || v.Sso == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Sso, true
+		}
+		return v.Sso.Get(rest)
+	case "state":
+		if index >= 0 || rest != "" || v.State == nil {
+			return nil, false
+		}
+		return *v.State, true
+	case "state_id":
+		if index >= 0 || rest != "" || v.StateId == nil {
+			return nil, false
+		}
+		return *v.StateId, true
+	case "tenant_uid":
+		if index >= 0 || rest != "" || v.TenantUid == nil {
+			return nil, false
+		}
+		return *v.TenantUid, true
+	case "uid":
+		if index >= 0 || rest != "" || v.Uid == nil {
+			return nil, false
+		}
+		return *v.Uid, true
+	case "url_string":
+		if index >= 0 || rest != "" || v.UrlString == nil {
+			return nil, false
+		}
+		return *v.UrlString, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *IdentityProvider) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "auth_factors":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.AuthFactors, value)
+		}
+		elem, err := ocsf.Element(&v.AuthFactors, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "domain":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Domain, value)
+	case "fingerprint":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Fingerprint, value)
+		}
+		if v.Fingerprint == nil {
+			v.Fingerprint = &Fingerprint{}
+		}
+		return v.Fingerprint.Set(rest, value)
+	case "has_mfa":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.HasMfa, value)
+	case "issuer":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Issuer, value)
+	case "name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Name, value)
+	case "protocol_name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.ProtocolName, value)
+	case "scim":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Scim, value)
+		}
+		if v.Scim == nil {
+			v.Scim = &SCIM{}
+		}
+		return v.Scim.Set(rest, value)
+	case "sso":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Sso, value)
+		}
+		if v.Sso == nil {
+			v.Sso = &SSO{}
+		}
+		return v.Sso.Set(rest, value)
+	case "state":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.State, value)
+	case "state_id":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.StateId, value)
+	case "tenant_uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.TenantUid, value)
+	case "uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Uid, value)
+	case "url_string":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.UrlString, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *IdentityProvider) AppendObservables(path string, observables []Observable) []Observable {
+	for i := range v.AuthFactors {
+		observables = v.AuthFactors[i].AppendObservables(ocsf.JoinPath(path, "auth_factors"), observables)
+	}
+	if v.Fingerprint != nil {
+		observables = v.Fingerprint.AppendObservables(ocsf.JoinPath(path, "fingerprint"), observables)
+	}
+	if v.Scim != nil {
+		observables = v.Scim.AppendObservables(ocsf.JoinPath(path, "scim"), observables)
+	}
+	if v.Sso != nil {
+		observables = v.Sso.AppendObservables(ocsf.JoinPath(path, "sso"), observables)
+	}
+	if v.UrlString != nil {
+		observables = append(observables, Observable{Name: ocsf.Ptr(ocsf.JoinPath(path, "url_string")), TypeId: 6, Value: ocsf.Ptr(*v.UrlString)})
+	}
+
+	return observables
+}
+
 var IdentityProviderFields = []arrow.Field{
-	{Name: "auth_factors", Type: arrow.ListOf(AuthenticationFactorStruct), Nullable: true},
-	{Name: "domain", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "fingerprint", Type: FingerprintStruct, Nullable: true},
-	{Name: "has_mfa", Type: arrow.FixedWidthTypes.Boolean, Nullable: true},
-	{Name: "issuer", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "protocol_name", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "scim", Type: SCIMStruct, Nullable: true},
-	{Name: "sso", Type: SSOStruct, Nullable: true},
-	{Name: "state", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "state_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "tenant_uid", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "url_string", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "auth_factors", Type: arrow.ListOf(AuthenticationFactorStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("Authentication Factors", "The Authentication Factors object describes the different types of Multi-Factor Authentication (MFA) methods and/or devices supported by the Identity Provider.", "optional", "")},
+	{Name: "domain", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Domain", "The primary domain associated with the Identity Provider.", "optional", "")},
+	{Name: "fingerprint", Type: FingerprintStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Certificate Fingerprint", "The fingerprint of the X.509 certificate used by the Identity Provider.", "optional", "")},
+	{Name: "has_mfa", Type: arrow.FixedWidthTypes.Boolean, Nullable: true, Metadata: ocsf.AttributeMetadata("MFA Enforced", "The Identity Provider enforces Multi Factor Authentication (MFA).", "optional", "")},
+	{Name: "issuer", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Issuer Details", "The unique identifier (often a URL) used by the Identity Provider as its issuer.", "optional", "")},
+	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Name", "The name of the Identity Provider.", "optional", "")},
+	{Name: "protocol_name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Supported Protocol", "The supported protocol of the Identity Provider. E.g., <code>SAML</code>, <code>OIDC</code>, or <code>OAuth2</code>.", "optional", "")},
+	{Name: "scim", Type: SCIMStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("SCIM", "The System for Cross-domain Identity Management (SCIM) resource object provides a structured set of attributes related to SCIM protocols used for identity provisioning and management across cloud-based platforms. It standardizes user and group provisioning details, enabling identity synchronization and lifecycle management with compatible Identity Providers (IdPs) and applications. SCIM is defined in <a target='_blank' href='https://datatracker.ietf.org/doc/html/rfc7643'>RFC-7634</a>", "optional", "")},
+	{Name: "sso", Type: SSOStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("SSO", "The Single Sign-On (SSO) object provides a structure for normalizing SSO attributes, configuration, and/or settings from Identity Providers.", "optional", "")},
+	{Name: "state", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("State", "The configuration state of the Identity Provider, normalized to the caption of the <code>state_id</code> value. In the case of <code>Other</code>, it is defined by the event source.", "optional", "")},
+	{Name: "state_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("State ID", "The normalized state ID of the Identity Provider to reflect its configuration or activation status.", "optional", "")},
+	{Name: "tenant_uid", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Tenant UID", "The tenant ID associated with the Identity Provider.", "optional", "")},
+	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Unique ID", "The unique identifier of the Identity Provider.", "optional", "")},
+	{Name: "url_string", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Configuration URL", "The URL for accessing the configuration or metadata of the Identity Provider.", "optional", "")},
 }
 
 var IdentityProviderStruct = arrow.StructOf(IdentityProviderFields...)
@@ -2,6 +2,7 @@
 package v1_5_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -27,12 +28,108 @@ func (v *KeyboardInformation) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *KeyboardInformation) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *KeyboardInformation) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *KeyboardInformation) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "function_keys":
+		if index >= 0 || rest != "" || v.FunctionKeys == nil {
+			return nil, false
+		}
+		return *v.FunctionKeys, true
+	case "ime":
+		if index >= 0 || rest != "" || v.Ime == nil {
+			return nil, false
+		}
+		return *v.Ime, true
+	case "keyboard_layout":
+		if index >= 0 || rest != "" || v.KeyboardLayout == nil {
+			return nil, false
+		}
+		return *v.KeyboardLayout, true
+	case "keyboard_subtype":
+		if index >= 0 || rest != "" || v.KeyboardSubtype == nil {
+			return nil, false
+		}
+		return *v.KeyboardSubtype, true
+	case "keyboard_type":
+		if index >= 0 || rest != "" || v.KeyboardType == nil {
+			return nil, false
+		}
+		return *v.KeyboardType, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *KeyboardInformation) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "function_keys":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.FunctionKeys, value)
+	case "ime":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Ime, value)
+	case "keyboard_layout":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.KeyboardLayout, value)
+	case "keyboard_subtype":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.KeyboardSubtype, value)
+	case "keyboard_type":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.KeyboardType, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *KeyboardInformation) AppendObservables(path string, observables []Observable) []Observable {
+
+	return observables
+}
+
 var KeyboardInformationFields = []arrow.Field{
-	{Name: "function_keys", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "ime", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "keyboard_layout", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "keyboard_subtype", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "keyboard_type", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "function_keys", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Function Keys", "The number of function keys on client keyboard.", "optional", "")},
+	{Name: "ime", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("IME", "The Input Method Editor (IME) file name.", "optional", "")},
+	{Name: "keyboard_layout", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Keyboard Layout", "The keyboard locale identifier name (e.g., en-US).", "optional", "")},
+	{Name: "keyboard_subtype", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Keyboard Subtype", "The keyboard numeric code.", "optional", "")},
+	{Name: "keyboard_type", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Keyboard Type", "The keyboard type (e.g., xt, ico).", "optional", "")},
 }
 
 var KeyboardInformationStruct = arrow.StructOf(KeyboardInformationFields...)
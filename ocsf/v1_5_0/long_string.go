@@ -2,6 +2,7 @@
 package v1_5_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -21,10 +22,89 @@ func (v *LongString) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *LongString) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *LongString) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	if v.Value == "" {
+		errs.Add(ocsf.JoinPath(path, "value"), "required attribute is missing")
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *LongString) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "is_truncated":
+		if index >= 0 || rest != "" || v.IsTruncated == nil {
+			return nil, false
+		}
+		return *v.IsTruncated, true
+	case "untruncated_size":
+		if index >= 0 || rest != "" || v.UntruncatedSize == nil {
+			return nil, false
+		}
+		return *v.UntruncatedSize, true
+	case "value":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.Value, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *LongString) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "is_truncated":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.IsTruncated, value)
+	case "untruncated_size":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.UntruncatedSize, value)
+	case "value":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.Value, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *LongString) AppendObservables(path string, observables []Observable) []Observable {
+
+	return observables
+}
+
 var LongStringFields = []arrow.Field{
-	{Name: "is_truncated", Type: arrow.FixedWidthTypes.Boolean, Nullable: true},
-	{Name: "untruncated_size", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "value", Type: arrow.BinaryTypes.String, Nullable: false},
+	{Name: "is_truncated", Type: arrow.FixedWidthTypes.Boolean, Nullable: true, Metadata: ocsf.AttributeMetadata("Is Truncated", "Indicates that <code>value</code> has been truncated. May be omitted if truncation has not occurred.", "optional", "")},
+	{Name: "untruncated_size", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Untruncated Size", "The size in bytes of the string represented by <code>value</code> before truncation. Should be omitted if truncation has not occurred.", "optional", "")},
+	{Name: "value", Type: arrow.BinaryTypes.String, Nullable: false, Metadata: ocsf.AttributeMetadata("Value", "The string value, truncated if <code>is_truncated</code> is <code>true</code>.", "required", "")},
 }
 
 var LongStringStruct = arrow.StructOf(LongStringFields...)
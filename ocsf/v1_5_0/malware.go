@@ -2,6 +2,7 @@
 package v1_5_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -42,17 +43,236 @@ func (v *Malware) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *Malware) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *Malware) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	if len(v.ClassificationIds) == 0 {
+		errs.Add(ocsf.JoinPath(path, "classification_ids"), "required attribute is missing")
+	}
+	for i := range v.Cves {
+		errs = append(errs, v.Cves[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "cves"), i))...)
+	}
+	for i := range v.Files {
+		errs = append(errs, v.Files[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "files"), i))...)
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *Malware) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "classification_ids":
+		if rest == "" {
+			if index < 0 {
+				return v.ClassificationIds, v.ClassificationIds != nil
+			}
+			return ocsf.Index(v.ClassificationIds, index)
+		}
+		return nil, false
+	case "classifications":
+		if rest == "" {
+			if index < 0 {
+				return v.Classifications, v.Classifications != nil
+			}
+			return ocsf.Index(v.Classifications, index)
+		}
+		return nil, false
+	case "cves":
+		if rest == "" {
+			if index < 0 {
+				return v.Cves, v.Cves != nil
+			}
+			return ocsf.Index(v.Cves, index)
+		}
+		if index < 0 || index >= len(v.Cves) {
+			return nil, false
+		}
+		return v.Cves[index].Get(rest)
+	case "files":
+		if rest == "" {
+			if index < 0 {
+				return v.Files, v.Files != nil
+			}
+			return ocsf.Index(v.Files, index)
+		}
+		if index < 0 || index >= len(v.Files) {
+			return nil, false
+		}
+		return v.Files[index].Get(rest)
+	case "name":
+		if index >= 0 || rest != "" || v.Name == nil {
+			return nil, false
+		}
+		return *v.Name, true
+	case "num_infected":
+		if index >= 0 || rest != "" || v.NumInfected == nil {
+			return nil, false
+		}
+		return *v.NumInfected, true
+	case "provider":
+		if index >= 0 || rest != "" || v.Provider == nil {
+			return nil, false
+		}
+		return *v.Provider, true
+	case "severity":
+		if index >= 0 || rest != "" || v.Severity == nil {
+			return nil, false
+		}
+		return *v.Severity, true
+	case "severity_id":
+		if index >= 0 || rest != "" || v.SeverityId == nil {
+			return nil, false
+		}
+		return *v.SeverityId, true
+	case "uid":
+		if index >= 0 || rest != "" || v.Uid == nil {
+			return nil, false
+		}
+		return *v.Uid, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *Malware) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "classification_ids":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.ClassificationIds, value)
+		}
+		elem, err := ocsf.Element(&v.ClassificationIds, index)
+		if err != nil {
+			return err
+		}
+		if rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(elem, value)
+	case "classifications":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Classifications, value)
+		}
+		elem, err := ocsf.Element(&v.Classifications, index)
+		if err != nil {
+			return err
+		}
+		if rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(elem, value)
+	case "cves":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Cves, value)
+		}
+		elem, err := ocsf.Element(&v.Cves, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "files":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Files, value)
+		}
+		elem, err := ocsf.Element(&v.Files, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Name, value)
+	case "num_infected":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.NumInfected, value)
+	case "provider":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Provider, value)
+	case "severity":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Severity, value)
+	case "severity_id":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.SeverityId, value)
+	case "uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Uid, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *Malware) AppendObservables(path string, observables []Observable) []Observable {
+	for i := range v.Cves {
+		observables = v.Cves[i].AppendObservables(ocsf.JoinPath(path, "cves"), observables)
+	}
+	for i := range v.Files {
+		observables = v.Files[i].AppendObservables(ocsf.JoinPath(path, "files"), observables)
+	}
+
+	return observables
+}
+
 var MalwareFields = []arrow.Field{
-	{Name: "classification_ids", Type: arrow.ListOf(arrow.PrimitiveTypes.Int32), Nullable: false},
-	{Name: "classifications", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true},
-	{Name: "cves", Type: arrow.ListOf(CVEStruct), Nullable: true},
-	{Name: "files", Type: arrow.ListOf(FileStruct), Nullable: true},
-	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "num_infected", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "provider", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "severity", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "severity_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "classification_ids", Type: arrow.ListOf(arrow.PrimitiveTypes.Int32), Nullable: false, Metadata: ocsf.AttributeMetadata("Classification IDs", "The list of normalized identifiers of the malware classifications.", "required", "")},
+	{Name: "classifications", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true, Metadata: ocsf.AttributeMetadata("Classifications", "The list of malware classifications, normalized to the captions of the <code>classification_ids</code> values. In the case of 'Other', they are defined by the event source.", "optional", "")},
+	{Name: "cves", Type: arrow.ListOf(CVEStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("CVE List", "The list of Common Vulnerabilities and Exposures (CVE) identifiers associated with the malware. Reference: <a target='_blank' href='https://cve.mitre.org/'>CVE</a>", "optional", "")},
+	{Name: "files", Type: arrow.ListOf(FileStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("Files", "The list of file objects representing files that were identified as infected by the malware.", "optional", "")},
+	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Name", "The malware name, as reported by the detection engine.", "optional", "")},
+	{Name: "num_infected", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Number of Infected Entities", "The number of files that were identified to be infected by the malware.", "optional", "")},
+	{Name: "provider", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Provider", "The name or identifier of the security solution or service that provided the malware detection information.", "optional", "")},
+	{Name: "severity", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Severity", "The severity of the malware, normalized to the captions of the <code>severity_id</code> values. In the case of 'Other', they are defined by the event source.", "optional", "")},
+	{Name: "severity_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Severity ID", "The normalized identifier of the malware severity.", "optional", "")},
+	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Unique ID", "A unique identifier for the specific malware instance, as assigned by the detection engine (e.g., virus signature ID or IPS rule ID).", "optional", "")},
 }
 
 var MalwareStruct = arrow.StructOf(MalwareFields...)
@@ -0,0 +1,57 @@
+package v1_5_0
+
+import (
+	"testing"
+
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
+	"github.com/apache/arrow-go/v18/arrow"
+)
+
+func TestFieldMetadata(t *testing.T) {
+	var tests = []struct {
+		name   string
+		fields []arrow.Field
+		field  string
+		want   map[string]string
+	}{
+		{name: "Test an enum attribute", fields: VulnerabilityFindingFields, field: "activity_id", want: map[string]string{
+			ocsf.MetadataCaption:     "Activity ID",
+			ocsf.MetadataDescription: "The normalized identifier of the finding activity.",
+			ocsf.MetadataRequirement: "required",
+			ocsf.MetadataEnum:        "0: Unknown; 1: Create; 2: Update; 3: Close; 99: Other",
+		}},
+		{name: "Test an attribute without enum", fields: VulnerabilityFindingFields, field: "unmapped", want: map[string]string{
+			ocsf.MetadataCaption:     "Unmapped Data",
+			ocsf.MetadataDescription: "The attributes that are not mapped to the event schema. The names and values of those attributes are specific to the event source.",
+			ocsf.MetadataRequirement: "optional",
+		}},
+		{name: "Test an attribute of a nested object", fields: FindingInformationFields, field: "uid", want: map[string]string{
+			ocsf.MetadataCaption:     "Unique ID",
+			ocsf.MetadataDescription: "The unique identifier of the reported finding.",
+			ocsf.MetadataRequirement: "required",
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var field *arrow.Field
+			for i := range tt.fields {
+				if tt.fields[i].Name == tt.field {
+					field = &tt.fields[i]
+				}
+			}
+			if field == nil {
+				t.Fatalf("%s is missing", tt.field)
+			}
+			got := field.Metadata.ToMap()
+			if len(got) != len(tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			for key, value := range tt.want {
+				if got[key] != value {
+					t.Errorf("got %s %q, want %q", key, got[key], value)
+				}
+			}
+		})
+	}
+}
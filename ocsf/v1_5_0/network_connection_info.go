@@ -2,6 +2,7 @@
 package v1_5_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -51,20 +52,211 @@ func (v *NetworkConnectionInformation) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *NetworkConnectionInformation) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *NetworkConnectionInformation) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	if v.Session != nil {
+		errs = append(errs, v.Session.ValidateAt(ocsf.JoinPath(path, "session"))...)
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *NetworkConnectionInformation) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "boundary":
+		if index >= 0 || rest != "" || v.Boundary == nil {
+			return nil, false
+		}
+		return *v.Boundary, true
+	case "boundary_id":
+		if index >= 0 || rest != "" || v.BoundaryId == nil {
+			return nil, false
+		}
+		return *v.BoundaryId, true
+	case "community_uid":
+		if index >= 0 || rest != "" || v.CommunityUid == nil {
+			return nil, false
+		}
+		return *v.CommunityUid, true
+	case "direction":
+		if index >= 0 || rest != "" || v.Direction == nil {
+			return nil, false
+		}
+		return *v.Direction, true
+	case "direction_id":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.DirectionId, true
+	case "flag_history":
+		if index >= 0 || rest != "" || v.FlagHistory == nil {
+			return nil, false
+		}
+		return *v.FlagHistory, true
+	case "protocol_name":
+		if index >= 0 || rest != "" || v.ProtocolName == nil {
+			return nil, false
+		}
+		return *v.ProtocolName, true
+	case "protocol_num":
+		if index >= 0 || rest != "" || v.ProtocolNum == nil {
+			return nil, false
+		}
+		return *v.ProtocolNum, true
+	case "protocol_ver":
+		if index >= 0 || rest != "" || v.ProtocolVer == nil {
+			return nil, false
+		}
+		return *v.ProtocolVer, true
+	case "protocol_ver_id":
+		if index >= 0 || rest != "" || v.ProtocolVerId == nil {
+			return nil, false
+		}
+		return *v.ProtocolVerId, true
+	case "session":
+		if index >= 0 || v.Session == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Session, true
+		}
+		return v.Session.Get(rest)
+	case "tcp_flags":
+		if index >= 0 || rest != "" || v.TcpFlags == nil {
+			return nil, false
+		}
+		return *v.TcpFlags, true
+	case "uid":
+		if index >= 0 || rest != "" || v.Uid == nil {
+			return nil, false
+		}
+		return *v.Uid, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *NetworkConnectionInformation) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "boundary":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Boundary, value)
+	case "boundary_id":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.BoundaryId, value)
+	case "community_uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.CommunityUid, value)
+	case "direction":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Direction, value)
+	case "direction_id":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.DirectionId, value)
+	case "flag_history":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.FlagHistory, value)
+	case "protocol_name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.ProtocolName, value)
+	case "protocol_num":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.ProtocolNum, value)
+	case "protocol_ver":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.ProtocolVer, value)
+	case "protocol_ver_id":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.ProtocolVerId, value)
+	case "session":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Session, value)
+		}
+		if v.Session == nil {
+			v.Session = &Session{}
+		}
+		return v.Session.Set(rest, value)
+	case "tcp_flags":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.TcpFlags, value)
+	case "uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Uid, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *NetworkConnectionInformation) AppendObservables(path string, observables []Observable) []Observable {
+	if v.Session != nil {
+		observables = v.Session.AppendObservables(ocsf.JoinPath(path, "session"), observables)
+	}
+
+	return observables
+}
+
 var NetworkConnectionInformationFields = []arrow.Field{
-	{Name: "boundary", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "boundary_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "community_uid", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "direction", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "direction_id", Type: arrow.PrimitiveTypes.Int32, Nullable: false},
-	{Name: "flag_history", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "protocol_name", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "protocol_num", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "protocol_ver", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "protocol_ver_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "session", Type: SessionStruct, Nullable: true},
-	{Name: "tcp_flags", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "boundary", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Boundary", "The boundary of the connection, normalized to the caption of 'boundary_id'. In the case of 'Other', it is defined by the event source. <p> For cloud connections, this translates to the traffic-boundary(same VPC, through IGW, etc.). For traditional networks, this is described as Local, Internal, or External.</p>", "optional", "")},
+	{Name: "boundary_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Boundary ID", "<p>The normalized identifier of the boundary of the connection. </p><p> For cloud connections, this translates to the traffic-boundary (same VPC, through IGW, etc.). For traditional networks, this is described as Local, Internal, or External.</p>", "optional", "")},
+	{Name: "community_uid", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Community ID", "The Community ID of the network connection.", "optional", "")},
+	{Name: "direction", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Direction", "The direction of the initiated connection, traffic, or email, normalized to the caption of the direction_id value. In the case of 'Other', it is defined by the event source.", "optional", "")},
+	{Name: "direction_id", Type: arrow.PrimitiveTypes.Int32, Nullable: false, Metadata: ocsf.AttributeMetadata("Direction ID", "The normalized identifier of the direction of the initiated connection, traffic, or email.", "required", "")},
+	{Name: "flag_history", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Connection Flag History", "The Connection Flag History summarizes events in a network connection. For example flags <code> ShAD </code> representing SYN, SYN/ACK, ACK and Data exchange.", "optional", "")},
+	{Name: "protocol_name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Protocol Name", "The IP protocol name in lowercase, as defined by the Internet Assigned Numbers Authority (IANA). For example: <code>tcp</code> or <code>udp</code>.", "optional", "")},
+	{Name: "protocol_num", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Protocol Number", "The IP protocol number, as defined by the Internet Assigned Numbers Authority (IANA). For example: <code>6</code> for TCP and <code>17</code> for UDP.", "optional", "")},
+	{Name: "protocol_ver", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("IP Version", "The Internet Protocol version.", "optional", "")},
+	{Name: "protocol_ver_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("IP Version ID", "The Internet Protocol version identifier.", "optional", "")},
+	{Name: "session", Type: SessionStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Session", "The authenticated user or service session.", "optional", "")},
+	{Name: "tcp_flags", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("TCP Flags", "The network connection TCP header flags (i.e., control bits).", "optional", "")},
+	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Connection UID", "The unique identifier of the connection.", "optional", "")},
 }
 
 var NetworkConnectionInformationStruct = arrow.StructOf(NetworkConnectionInformationFields...)
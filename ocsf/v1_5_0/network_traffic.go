@@ -2,6 +2,7 @@
 package v1_5_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -42,17 +43,163 @@ func (v *NetworkTraffic) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *NetworkTraffic) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *NetworkTraffic) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *NetworkTraffic) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "bytes":
+		if index >= 0 || rest != "" || v.Bytes == nil {
+			return nil, false
+		}
+		return *v.Bytes, true
+	case "bytes_in":
+		if index >= 0 || rest != "" || v.BytesIn == nil {
+			return nil, false
+		}
+		return *v.BytesIn, true
+	case "bytes_missed":
+		if index >= 0 || rest != "" || v.BytesMissed == nil {
+			return nil, false
+		}
+		return *v.BytesMissed, true
+	case "bytes_out":
+		if index >= 0 || rest != "" || v.BytesOut == nil {
+			return nil, false
+		}
+		return *v.BytesOut, true
+	case "chunks":
+		if index >= 0 || rest != "" || v.Chunks == nil {
+			return nil, false
+		}
+		return *v.Chunks, true
+	case "chunks_in":
+		if index >= 0 || rest != "" || v.ChunksIn == nil {
+			return nil, false
+		}
+		return *v.ChunksIn, true
+	case "chunks_out":
+		if index >= 0 || rest != "" || v.ChunksOut == nil {
+			return nil, false
+		}
+		return *v.ChunksOut, true
+	case "packets":
+		if index >= 0 || rest != "" || v.Packets == nil {
+			return nil, false
+		}
+		return *v.Packets, true
+	case "packets_in":
+		if index >= 0 || rest != "" || v.PacketsIn == nil {
+			return nil, false
+		}
+		return *v.PacketsIn, true
+	case "packets_out":
+		if index >= 0 || rest != "" || v.PacketsOut == nil {
+			return nil, false
+		}
+		return *v.PacketsOut, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *NetworkTraffic) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "bytes":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Bytes, value)
+	case "bytes_in":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.BytesIn, value)
+	case "bytes_missed":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.BytesMissed, value)
+	case "bytes_out":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.BytesOut, value)
+	case "chunks":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Chunks, value)
+	case "chunks_in":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.ChunksIn, value)
+	case "chunks_out":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.ChunksOut, value)
+	case "packets":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Packets, value)
+	case "packets_in":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.PacketsIn, value)
+	case "packets_out":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.PacketsOut, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *NetworkTraffic) AppendObservables(path string, observables []Observable) []Observable {
+
+	return observables
+}
+
 var NetworkTrafficFields = []arrow.Field{
-	{Name: "bytes", Type: arrow.PrimitiveTypes.Int64, Nullable: true},
-	{Name: "bytes_in", Type: arrow.PrimitiveTypes.Int64, Nullable: true},
-	{Name: "bytes_missed", Type: arrow.PrimitiveTypes.Int64, Nullable: true},
-	{Name: "bytes_out", Type: arrow.PrimitiveTypes.Int64, Nullable: true},
-	{Name: "chunks", Type: arrow.PrimitiveTypes.Int64, Nullable: true},
-	{Name: "chunks_in", Type: arrow.PrimitiveTypes.Int64, Nullable: true},
-	{Name: "chunks_out", Type: arrow.PrimitiveTypes.Int64, Nullable: true},
-	{Name: "packets", Type: arrow.PrimitiveTypes.Int64, Nullable: true},
-	{Name: "packets_in", Type: arrow.PrimitiveTypes.Int64, Nullable: true},
-	{Name: "packets_out", Type: arrow.PrimitiveTypes.Int64, Nullable: true},
+	{Name: "bytes", Type: arrow.PrimitiveTypes.Int64, Nullable: true, Metadata: ocsf.AttributeMetadata("Total Bytes", "The total number of bytes (in and out).", "optional", "")},
+	{Name: "bytes_in", Type: arrow.PrimitiveTypes.Int64, Nullable: true, Metadata: ocsf.AttributeMetadata("Bytes In", "The number of bytes sent from the destination to the source.", "optional", "")},
+	{Name: "bytes_missed", Type: arrow.PrimitiveTypes.Int64, Nullable: true, Metadata: ocsf.AttributeMetadata("Bytes Missed", "Indicates the number of bytes missed, which is representative of packet loss.", "optional", "")},
+	{Name: "bytes_out", Type: arrow.PrimitiveTypes.Int64, Nullable: true, Metadata: ocsf.AttributeMetadata("Bytes Out", "The number of bytes sent from the source to the destination.", "optional", "")},
+	{Name: "chunks", Type: arrow.PrimitiveTypes.Int64, Nullable: true, Metadata: ocsf.AttributeMetadata("Chunks", "The total number of chunks (in and out).", "optional", "")},
+	{Name: "chunks_in", Type: arrow.PrimitiveTypes.Int64, Nullable: true, Metadata: ocsf.AttributeMetadata("Chunks In", "The number of chunks sent from the destination to the source.", "optional", "")},
+	{Name: "chunks_out", Type: arrow.PrimitiveTypes.Int64, Nullable: true, Metadata: ocsf.AttributeMetadata("Chunks Out", "The number of chunks sent from the source to the destination.", "optional", "")},
+	{Name: "packets", Type: arrow.PrimitiveTypes.Int64, Nullable: true, Metadata: ocsf.AttributeMetadata("Total Packets", "The total number of packets (in and out).", "optional", "")},
+	{Name: "packets_in", Type: arrow.PrimitiveTypes.Int64, Nullable: true, Metadata: ocsf.AttributeMetadata("Packets In", "The number of packets sent from the destination to the source.", "optional", "")},
+	{Name: "packets_out", Type: arrow.PrimitiveTypes.Int64, Nullable: true, Metadata: ocsf.AttributeMetadata("Packets Out", "The number of packets sent from the source to the destination.", "optional", "")},
 }
 
 var NetworkTrafficStruct = arrow.StructOf(NetworkTrafficFields...)
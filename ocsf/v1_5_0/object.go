@@ -2,6 +2,7 @@
 package v1_5_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -12,6 +13,52 @@ func (v *Object) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *Object) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *Object) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *Object) Get(path string) (any, bool) {
+	name, _, _, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *Object) Set(path string, value any) error {
+	name, _, _, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *Object) AppendObservables(path string, observables []Observable) []Observable {
+
+	return observables
+}
+
 var ObjectFields = []arrow.Field{}
 
 var ObjectStruct = arrow.StructOf(ObjectFields...)
@@ -2,6 +2,7 @@
 package v1_5_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -21,10 +22,104 @@ func (v *Observation) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *Observation) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *Observation) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	if v.Timespan != nil {
+		errs = append(errs, v.Timespan.ValidateAt(ocsf.JoinPath(path, "timespan"))...)
+	}
+	if v.Value == "" {
+		errs.Add(ocsf.JoinPath(path, "value"), "required attribute is missing")
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *Observation) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "count":
+		if index >= 0 || rest != "" || v.Count == nil {
+			return nil, false
+		}
+		return *v.Count, true
+	case "timespan":
+		if index >= 0 || v.Timespan == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Timespan, true
+		}
+		return v.Timespan.Get(rest)
+	case "value":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.Value, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *Observation) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "count":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Count, value)
+	case "timespan":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Timespan, value)
+		}
+		if v.Timespan == nil {
+			v.Timespan = &TimeSpan{}
+		}
+		return v.Timespan.Set(rest, value)
+	case "value":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.Value, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *Observation) AppendObservables(path string, observables []Observable) []Observable {
+	if v.Timespan != nil {
+		observables = v.Timespan.AppendObservables(ocsf.JoinPath(path, "timespan"), observables)
+	}
+
+	return observables
+}
+
 var ObservationFields = []arrow.Field{
-	{Name: "count", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "timespan", Type: TimeSpanStruct, Nullable: true},
-	{Name: "value", Type: arrow.BinaryTypes.String, Nullable: false},
+	{Name: "count", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Count", "Integer representing the total number of times this specific value/event was observed across all occurrences. Helps establish prevalence and patterns.", "optional", "")},
+	{Name: "timespan", Type: TimeSpanStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Time Span", "The time window when the value or event was first observed. It is used to analyze activity patterns, detect trends, or correlate events within a specific timeframe.", "optional", "")},
+	{Name: "value", Type: arrow.BinaryTypes.String, Nullable: false, Metadata: ocsf.AttributeMetadata("Value", "The specific value, event, indicator or data point that was observed and recorded. This is the core piece of information being tracked.", "required", "")},
 }
 
 var ObservationStruct = arrow.StructOf(ObservationFields...)
@@ -2,6 +2,7 @@
 package v1_5_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -153,54 +154,944 @@ func (v *OSINT) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *OSINT) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *OSINT) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	for i := range v.Answers {
+		errs = append(errs, v.Answers[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "answers"), i))...)
+	}
+	for i := range v.Attacks {
+		errs = append(errs, v.Attacks[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "attacks"), i))...)
+	}
+	if v.AutonomousSystem != nil {
+		errs = append(errs, v.AutonomousSystem.ValidateAt(ocsf.JoinPath(path, "autonomous_system"))...)
+	}
+	if v.Campaign != nil {
+		errs = append(errs, v.Campaign.ValidateAt(ocsf.JoinPath(path, "campaign"))...)
+	}
+	if v.Creator != nil {
+		errs = append(errs, v.Creator.ValidateAt(ocsf.JoinPath(path, "creator"))...)
+	}
+	if v.Email != nil {
+		errs = append(errs, v.Email.ValidateAt(ocsf.JoinPath(path, "email"))...)
+	}
+	if v.EmailAuth != nil {
+		errs = append(errs, v.EmailAuth.ValidateAt(ocsf.JoinPath(path, "email_auth"))...)
+	}
+	if v.File != nil {
+		errs = append(errs, v.File.ValidateAt(ocsf.JoinPath(path, "file"))...)
+	}
+	for i := range v.KillChain {
+		errs = append(errs, v.KillChain[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "kill_chain"), i))...)
+	}
+	if v.Location != nil {
+		errs = append(errs, v.Location.ValidateAt(ocsf.JoinPath(path, "location"))...)
+	}
+	for i := range v.Malware {
+		errs = append(errs, v.Malware[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "malware"), i))...)
+	}
+	for i := range v.RelatedAnalytics {
+		errs = append(errs, v.RelatedAnalytics[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "related_analytics"), i))...)
+	}
+	if v.Reputation != nil {
+		errs = append(errs, v.Reputation.ValidateAt(ocsf.JoinPath(path, "reputation"))...)
+	}
+	if v.Script != nil {
+		errs = append(errs, v.Script.ValidateAt(ocsf.JoinPath(path, "script"))...)
+	}
+	for i := range v.Signatures {
+		errs = append(errs, v.Signatures[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "signatures"), i))...)
+	}
+	if v.ThreatActor != nil {
+		errs = append(errs, v.ThreatActor.ValidateAt(ocsf.JoinPath(path, "threat_actor"))...)
+	}
+	if v.Value == "" {
+		errs.Add(ocsf.JoinPath(path, "value"), "required attribute is missing")
+	}
+	for i := range v.Vulnerabilities {
+		errs = append(errs, v.Vulnerabilities[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "vulnerabilities"), i))...)
+	}
+	if v.Whois != nil {
+		errs = append(errs, v.Whois.ValidateAt(ocsf.JoinPath(path, "whois"))...)
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *OSINT) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "answers":
+		if rest == "" {
+			if index < 0 {
+				return v.Answers, v.Answers != nil
+			}
+			return ocsf.Index(v.Answers, index)
+		}
+		if index < 0 || index >= len(v.Answers) {
+			return nil, false
+		}
+		return v.Answers[index].Get(rest)
+	case "attacks":
+		if rest == "" {
+			if index < 0 {
+				return v.Attacks, v.Attacks != nil
+			}
+			return ocsf.Index(v.Attacks, index)
+		}
+		if index < 0 || index >= len(v.Attacks) {
+			return nil, false
+		}
+		return v.Attacks[index].Get(rest)
+	case "autonomous_system":
+		if index >= 0 || v.AutonomousSystem == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.AutonomousSystem, true
+		}
+		return v.AutonomousSystem.Get(rest)
+	case "campaign":
+		if index >= 0 || v.Campaign == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Campaign, true
+		}
+		return v.Campaign.Get(rest)
+	case "category":
+		if index >= 0 || rest != "" || v.Category == nil {
+			return nil, false
+		}
+		return *v.Category, true
+	case "comment":
+		if index >= 0 || rest != "" || v.Comment == nil {
+			return nil, false
+		}
+		return *v.Comment, true
+	case "confidence":
+		if index >= 0 || rest != "" || v.Confidence == nil {
+			return nil, false
+		}
+		return *v.Confidence, true
+	case "confidence_id":
+		if index >= 0 || rest != "" || v.ConfidenceId == nil {
+			return nil, false
+		}
+		return *v.ConfidenceId, true
+	case "created_time":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.CreatedTime, true
+	case "creator":
+		if index >= 0 || v.Creator == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Creator, true
+		}
+		return v.Creator.Get(rest)
+	case "desc":
+		if index >= 0 || rest != "" || v.Desc == nil {
+			return nil, false
+		}
+		return *v.Desc, true
+	case "detection_pattern":
+		if index >= 0 || rest != "" || v.DetectionPattern == nil {
+			return nil, false
+		}
+		return *v.DetectionPattern, true
+	case "detection_pattern_type":
+		if index >= 0 || rest != "" || v.DetectionPatternType == nil {
+			return nil, false
+		}
+		return *v.DetectionPatternType, true
+	case "detection_pattern_type_id":
+		if index >= 0 || rest != "" || v.DetectionPatternTypeId == nil {
+			return nil, false
+		}
+		return *v.DetectionPatternTypeId, true
+	case "email":
+		if index >= 0 || v.Email == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Email, true
+		}
+		return v.Email.Get(rest)
+	case "email_auth":
+		if index >= 0 || v.EmailAuth == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.EmailAuth, true
+		}
+		return v.EmailAuth.Get(rest)
+	case "expiration_time":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.ExpirationTime, true
+	case "external_uid":
+		if index >= 0 || rest != "" || v.ExternalUid == nil {
+			return nil, false
+		}
+		return *v.ExternalUid, true
+	case "file":
+		if index >= 0 || v.File == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.File, true
+		}
+		return v.File.Get(rest)
+	case "intrusion_sets":
+		if rest == "" {
+			if index < 0 {
+				return v.IntrusionSets, v.IntrusionSets != nil
+			}
+			return ocsf.Index(v.IntrusionSets, index)
+		}
+		return nil, false
+	case "kill_chain":
+		if rest == "" {
+			if index < 0 {
+				return v.KillChain, v.KillChain != nil
+			}
+			return ocsf.Index(v.KillChain, index)
+		}
+		if index < 0 || index >= len(v.KillChain) {
+			return nil, false
+		}
+		return v.KillChain[index].Get(rest)
+	case "labels":
+		if rest == "" {
+			if index < 0 {
+				return v.Labels, v.Labels != nil
+			}
+			return ocsf.Index(v.Labels, index)
+		}
+		return nil, false
+	case "location":
+		if index >= 0 || v.Location == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Location, true
+		}
+		return v.Location.Get(rest)
+	case "malware":
+		if rest == "" {
+			if index < 0 {
+				return v.Malware, v.Malware != nil
+			}
+			return ocsf.Index(v.Malware, index)
+		}
+		if index < 0 || index >= len(v.Malware) {
+			return nil, false
+		}
+		return v.Malware[index].Get(rest)
+	case "modified_time":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.ModifiedTime, true
+	case "name":
+		if index >= 0 || rest != "" || v.Name == nil {
+			return nil, false
+		}
+		return *v.Name, true
+	case "references":
+		if rest == "" {
+			if index < 0 {
+				return v.References, v.References != nil
+			}
+			return ocsf.Index(v.References, index)
+		}
+		return nil, false
+	case "related_analytics":
+		if rest == "" {
+			if index < 0 {
+				return v.RelatedAnalytics, v.RelatedAnalytics != nil
+			}
+			return ocsf.Index(v.RelatedAnalytics, index)
+		}
+		if index < 0 || index >= len(v.RelatedAnalytics) {
+			return nil, false
+		}
+		return v.RelatedAnalytics[index].Get(rest)
+	case "reputation":
+		if index >= 0 || v.Reputation == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Reputation, true
+		}
+		return v.Reputation.Get(rest)
+	case "risk_score":
+		if index >= 0 || rest != "" || v.RiskScore == nil {
+			return nil, false
+		}
+		return *v.RiskScore, true
+	case "script":
+		if index >= 0 || v.Script == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Script, true
+		}
+		return v.Script.Get(rest)
+	case "severity":
+		if index >= 0 || rest != "" || v.Severity == nil {
+			return nil, false
+		}
+		return *v.Severity, true
+	case "severity_id":
+		if index >= 0 || rest != "" || v.SeverityId == nil {
+			return nil, false
+		}
+		return *v.SeverityId, true
+	case "signatures":
+		if rest == "" {
+			if index < 0 {
+				return v.Signatures, v.Signatures != nil
+			}
+			return ocsf.Index(v.Signatures, index)
+		}
+		if index < 0 || index >= len(v.Signatures) {
+			return nil, false
+		}
+		return v.Signatures[index].Get(rest)
+	case "src_url":
+		if index >= 0 || rest != "" || v.SrcUrl == nil {
+			return nil, false
+		}
+		return *v.SrcUrl, true
+	case "subdomains":
+		if rest == "" {
+			if index < 0 {
+				return v.Subdomains, v.Subdomains != nil
+			}
+			return ocsf.Index(v.Subdomains, index)
+		}
+		return nil, false
+	case "subnet":
+		if index >= 0 || rest != "" || v.Subnet == nil {
+			return nil, false
+		}
+		return *v.Subnet, true
+	case "threat_actor":
+		if index >= 0 || v.ThreatActor == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.ThreatActor, true
+		}
+		return v.ThreatActor.Get(rest)
+	case "tlp":
+		if index >= 0 || rest != "" || v.Tlp == nil {
+			return nil, false
+		}
+		return *v.Tlp, true
+	case "type":
+		if index >= 0 || rest != "" || v.Type == nil {
+			return nil, false
+		}
+		return *v.Type, true
+	case "type_id":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.TypeId, true
+	case "uid":
+		if index >= 0 || rest != "" || v.Uid == nil {
+			return nil, false
+		}
+		return *v.Uid, true
+	case "uploaded_time":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.UploadedTime, true
+	case "value":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.Value, true
+	case "vendor_name":
+		if index >= 0 || rest != "" || v.VendorName == nil {
+			return nil, false
+		}
+		return *v.VendorName, true
+	case "vulnerabilities":
+		if rest == "" {
+			if index < 0 {
+				return v.Vulnerabilities, v.Vulnerabilities != nil
+			}
+			return ocsf.Index(v.Vulnerabilities, index)
+		}
+		if index < 0 || index >= len(v.Vulnerabilities) {
+			return nil, false
+		}
+		return v.Vulnerabilities[index].Get(rest)
+	case "whois":
+		if index >= 0 || v.Whois == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Whois, true
+		}
+		return v.Whois.Get(rest)
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *OSINT) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "answers":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Answers, value)
+		}
+		elem, err := ocsf.Element(&v.Answers, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "attacks":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Attacks, value)
+		}
+		elem, err := ocsf.Element(&v.Attacks, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "autonomous_system":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.AutonomousSystem, value)
+		}
+		if v.AutonomousSystem == nil {
+			v.AutonomousSystem = &AutonomousSystem{}
+		}
+		return v.AutonomousSystem.Set(rest, value)
+	case "campaign":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Campaign, value)
+		}
+		if v.Campaign == nil {
+			v.Campaign = &Campaign{}
+		}
+		return v.Campaign.Set(rest, value)
+	case "category":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Category, value)
+	case "comment":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Comment, value)
+	case "confidence":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Confidence, value)
+	case "confidence_id":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.ConfidenceId, value)
+	case "created_time":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.CreatedTime, value)
+	case "creator":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Creator, value)
+		}
+		if v.Creator == nil {
+			v.Creator = &User{}
+		}
+		return v.Creator.Set(rest, value)
+	case "desc":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Desc, value)
+	case "detection_pattern":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.DetectionPattern, value)
+	case "detection_pattern_type":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.DetectionPatternType, value)
+	case "detection_pattern_type_id":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.DetectionPatternTypeId, value)
+	case "email":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Email, value)
+		}
+		if v.Email == nil {
+			v.Email = &Email{}
+		}
+		return v.Email.Set(rest, value)
+	case "email_auth":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.EmailAuth, value)
+		}
+		if v.EmailAuth == nil {
+			v.EmailAuth = &EmailAuthentication{}
+		}
+		return v.EmailAuth.Set(rest, value)
+	case "expiration_time":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.ExpirationTime, value)
+	case "external_uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.ExternalUid, value)
+	case "file":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.File, value)
+		}
+		if v.File == nil {
+			v.File = &File{}
+		}
+		return v.File.Set(rest, value)
+	case "intrusion_sets":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.IntrusionSets, value)
+		}
+		elem, err := ocsf.Element(&v.IntrusionSets, index)
+		if err != nil {
+			return err
+		}
+		if rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(elem, value)
+	case "kill_chain":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.KillChain, value)
+		}
+		elem, err := ocsf.Element(&v.KillChain, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "labels":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Labels, value)
+		}
+		elem, err := ocsf.Element(&v.Labels, index)
+		if err != nil {
+			return err
+		}
+		if rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(elem, value)
+	case "location":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Location, value)
+		}
+		if v.Location == nil {
+			v.Location = &GeoLocation{}
+		}
+		return v.Location.Set(rest, value)
+	case "malware":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Malware, value)
+		}
+		elem, err := ocsf.Element(&v.Malware, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "modified_time":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.ModifiedTime, value)
+	case "name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Name, value)
+	case "references":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.References, value)
+		}
+		elem, err := ocsf.Element(&v.References, index)
+		if err != nil {
+			return err
+		}
+		if rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(elem, value)
+	case "related_analytics":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.RelatedAnalytics, value)
+		}
+		elem, err := ocsf.Element(&v.RelatedAnalytics, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "reputation":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Reputation, value)
+		}
+		if v.Reputation == nil {
+			v.Reputation = &Reputation{}
+		}
+		return v.Reputation.Set(rest, value)
+	case "risk_score":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.RiskScore, value)
+	case "script":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Script, value)
+		}
+		if v.Script == nil {
+			v.Script = &Script{}
+		}
+		return v.Script.Set(rest, value)
+	case "severity":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Severity, value)
+	case "severity_id":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.SeverityId, value)
+	case "signatures":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Signatures, value)
+		}
+		elem, err := ocsf.Element(&v.Signatures, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "src_url":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.SrcUrl, value)
+	case "subdomains":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Subdomains, value)
+		}
+		elem, err := ocsf.Element(&v.Subdomains, index)
+		if err != nil {
+			return err
+		}
+		if rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(elem, value)
+	case "subnet":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Subnet, value)
+	case "threat_actor":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.ThreatActor, value)
+		}
+		if v.ThreatActor == nil {
+			v.ThreatActor = &ThreatActor{}
+		}
+		return v.ThreatActor.Set(rest, value)
+	case "tlp":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Tlp, value)
+	case "type":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Type, value)
+	case "type_id":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.TypeId, value)
+	case "uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Uid, value)
+	case "uploaded_time":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.UploadedTime, value)
+	case "value":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.Value, value)
+	case "vendor_name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.VendorName, value)
+	case "vulnerabilities":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Vulnerabilities, value)
+		}
+		elem, err := ocsf.Element(&v.Vulnerabilities, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "whois":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Whois, value)
+		}
+		if v.Whois == nil {
+			v.Whois = &WHOIS{}
+		}
+		return v.Whois.Set(rest, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *OSINT) AppendObservables(path string, observables []Observable) []Observable {
+	for i := range v.Answers {
+		observables = v.Answers[i].AppendObservables(ocsf.JoinPath(path, "answers"), observables)
+	}
+	for i := range v.Attacks {
+		observables = v.Attacks[i].AppendObservables(ocsf.JoinPath(path, "attacks"), observables)
+	}
+	if v.AutonomousSystem != nil {
+		observables = v.AutonomousSystem.AppendObservables(ocsf.JoinPath(path, "autonomous_system"), observables)
+	}
+	if v.Campaign != nil {
+		observables = v.Campaign.AppendObservables(ocsf.JoinPath(path, "campaign"), observables)
+	}
+	if v.Creator != nil {
+		observables = v.Creator.AppendObservables(ocsf.JoinPath(path, "creator"), observables)
+	}
+	if v.Email != nil {
+		observables = v.Email.AppendObservables(ocsf.JoinPath(path, "email"), observables)
+	}
+	if v.EmailAuth != nil {
+		observables = v.EmailAuth.AppendObservables(ocsf.JoinPath(path, "email_auth"), observables)
+	}
+	if v.File != nil {
+		observables = v.File.AppendObservables(ocsf.JoinPath(path, "file"), observables)
+	}
+	for i := range v.KillChain {
+		observables = v.KillChain[i].AppendObservables(ocsf.JoinPath(path, "kill_chain"), observables)
+	}
+	if v.Location != nil {
+		observables = v.Location.AppendObservables(ocsf.JoinPath(path, "location"), observables)
+	}
+	for i := range v.Malware {
+		observables = v.Malware[i].AppendObservables(ocsf.JoinPath(path, "malware"), observables)
+	}
+	for i := range v.RelatedAnalytics {
+		observables = v.RelatedAnalytics[i].AppendObservables(ocsf.JoinPath(path, "related_analytics"), observables)
+	}
+	if v.Reputation != nil {
+		observables = v.Reputation.AppendObservables(ocsf.JoinPath(path, "reputation"), observables)
+	}
+	if v.Script != nil {
+		observables = v.Script.AppendObservables(ocsf.JoinPath(path, "script"), observables)
+	}
+	for i := range v.Signatures {
+		observables = v.Signatures[i].AppendObservables(ocsf.JoinPath(path, "signatures"), observables)
+	}
+	if v.ThreatActor != nil {
+		observables = v.ThreatActor.AppendObservables(ocsf.JoinPath(path, "threat_actor"), observables)
+	}
+	for i := range v.Vulnerabilities {
+		observables = v.Vulnerabilities[i].AppendObservables(ocsf.JoinPath(path, "vulnerabilities"), observables)
+	}
+	if v.Whois != nil {
+		observables = v.Whois.AppendObservables(ocsf.JoinPath(path, "whois"), observables)
+	}
+
+	return observables
+}
+
 var OSINTFields = []arrow.Field{
-	{Name: "answers", Type: arrow.ListOf(DNSAnswerStruct), Nullable: true},
-	{Name: "attacks", Type: arrow.ListOf(MITREATTCKATLASStruct), Nullable: true},
-	{Name: "autonomous_system", Type: AutonomousSystemStruct, Nullable: true},
-	{Name: "campaign", Type: CampaignStruct, Nullable: true},
-	{Name: "category", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "comment", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "confidence", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "confidence_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "created_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
-	{Name: "creator", Type: UserStruct, Nullable: true},
-	{Name: "desc", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "detection_pattern", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "detection_pattern_type", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "detection_pattern_type_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "email", Type: EmailStruct, Nullable: true},
-	{Name: "email_auth", Type: EmailAuthenticationStruct, Nullable: true},
-	{Name: "expiration_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
-	{Name: "external_uid", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "file", Type: FileStruct, Nullable: true},
-	{Name: "intrusion_sets", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true},
-	{Name: "kill_chain", Type: arrow.ListOf(KillChainPhaseStruct), Nullable: true},
-	{Name: "labels", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true},
-	{Name: "location", Type: GeoLocationStruct, Nullable: true},
-	{Name: "malware", Type: arrow.ListOf(MalwareStruct), Nullable: true},
-	{Name: "modified_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
-	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "references", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true},
-	{Name: "related_analytics", Type: arrow.ListOf(AnalyticStruct), Nullable: true},
-	{Name: "reputation", Type: ReputationStruct, Nullable: true},
-	{Name: "risk_score", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "script", Type: ScriptStruct, Nullable: true},
-	{Name: "severity", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "severity_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "signatures", Type: arrow.ListOf(DigitalSignatureStruct), Nullable: true},
-	{Name: "src_url", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "subdomains", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true},
-	{Name: "subnet", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "threat_actor", Type: ThreatActorStruct, Nullable: true},
-	{Name: "tlp", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "type", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "type_id", Type: arrow.PrimitiveTypes.Int32, Nullable: false},
-	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "uploaded_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
-	{Name: "value", Type: arrow.BinaryTypes.String, Nullable: false},
-	{Name: "vendor_name", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "vulnerabilities", Type: arrow.ListOf(VulnerabilityDetailsStruct), Nullable: true},
-	{Name: "whois", Type: WHOISStruct, Nullable: true},
+	{Name: "answers", Type: arrow.ListOf(DNSAnswerStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("Related DNS Answers", "Any pertinent DNS answers information related to an indicator or OSINT analysis.", "optional", "")},
+	{Name: "attacks", Type: arrow.ListOf(MITREATTCKATLASStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("MITRE ATT&CK® and ATLAS™ Details", "MITRE ATT&CK Tactics, Techniques, and/or Procedures (TTPs) pertinent to an indicator or OSINT analysis.", "optional", "")},
+	{Name: "autonomous_system", Type: AutonomousSystemStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Autonomous System", "Any pertinent autonomous system information related to an indicator or OSINT analysis.", "optional", "")},
+	{Name: "campaign", Type: CampaignStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Campaign", "The campaign object describes details about the campaign that was the source of the activity.", "optional", "")},
+	{Name: "category", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Category", "Categorizes the threat indicator based on its functional or operational role.", "optional", "")},
+	{Name: "comment", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Analyst Comments", "Analyst commentary or source commentary about an indicator or OSINT analysis.", "optional", "")},
+	{Name: "confidence", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Confidence", "The confidence of an indicator being malicious and/or pertinent, normalized to the caption of the confidence_id value. In the case of 'Other', it is defined by the event source or analyst.", "optional", "")},
+	{Name: "confidence_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Confidence ID", "The normalized confidence refers to the accuracy of collected information related to the OSINT or how pertinent an indicator or analysis is to a specific event or finding. A low confidence means that the information collected or analysis conducted lacked detail or is not accurate enough to qualify an indicator as fully malicious.", "optional", "")},
+	{Name: "created_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true, Metadata: ocsf.AttributeMetadata("Created Time", "The timestamp when the indicator was initially created or identified.", "optional", "")},
+	{Name: "creator", Type: UserStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Creator", "The identifier of the user, system, or organization that contributed the indicator.", "optional", "")},
+	{Name: "desc", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Description", "A detailed explanation of the indicator, including its context, purpose, and relevance.", "optional", "")},
+	{Name: "detection_pattern", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Detection Pattern", "The specific detection pattern or signature associated with the indicator.", "optional", "")},
+	{Name: "detection_pattern_type", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Detection Pattern", "The detection pattern type, normalized to the caption of the detection_pattern_type_id value. In the case of 'Other', it is defined by the event source.", "optional", "")},
+	{Name: "detection_pattern_type_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Detection Pattern Type ID", "Specifies the type of detection pattern used to identify the associated threat indicator.", "optional", "")},
+	{Name: "email", Type: EmailStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Related Email", "Any email information pertinent to an indicator or OSINT analysis.", "optional", "")},
+	{Name: "email_auth", Type: EmailAuthenticationStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Related Email Authentication", "Any email authentication information pertinent to an indicator or OSINT analysis.", "optional", "")},
+	{Name: "expiration_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true, Metadata: ocsf.AttributeMetadata("Expiration Time", "The expiration date of the indicator, after which it is no longer considered reliable.", "optional", "")},
+	{Name: "external_uid", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("External ID", "A unique identifier assigned by an external system for cross-referencing.", "optional", "")},
+	{Name: "file", Type: FileStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Related File", "Any pertinent file information related to an indicator or OSINT analysis.", "optional", "")},
+	{Name: "intrusion_sets", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true, Metadata: ocsf.AttributeMetadata("Intrusion Sets", "A grouping of adversarial behaviors and resources believed to be associated with specific threat actors or campaigns. Intrusion sets often encompass multiple campaigns and are used to organize related activities under a common label.", "optional", "")},
+	{Name: "kill_chain", Type: arrow.ListOf(KillChainPhaseStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("Kill Chain", "Lockheed Martin Kill Chain Phases pertinent to an indicator or OSINT analysis.", "optional", "")},
+	{Name: "labels", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true, Metadata: ocsf.AttributeMetadata("Labels", "Tags or keywords associated with the indicator to enhance searchability.", "optional", "")},
+	{Name: "location", Type: GeoLocationStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Geo Location", "Any pertinent geolocation information related to an indicator or OSINT analysis.", "optional", "")},
+	{Name: "malware", Type: arrow.ListOf(MalwareStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("Malware", "A list of Malware objects, describing details about the identified malware.", "optional", "")},
+	{Name: "modified_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true, Metadata: ocsf.AttributeMetadata("Modified Time", "The timestamp of the last modification or update to the indicator.", "optional", "")},
+	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Name", "The <code>name</code> is a pointer/reference to an attribute within the OCSF event data. For example: file.name.", "optional", "")},
+	{Name: "references", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true, Metadata: ocsf.AttributeMetadata("References", "Provides a reference to an external source of information related to the CTI being represented. This may include a URL, a document, or some other type of reference that provides additional context or information about the CTI.", "optional", "")},
+	{Name: "related_analytics", Type: arrow.ListOf(AnalyticStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("Related Analytics", "Any analytics related to an indicator or OSINT analysis.", "optional", "")},
+	{Name: "reputation", Type: ReputationStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Reputation Scores", "Related reputational analysis from third-party engines and analysts for a given indicator or OSINT analysis.", "optional", "")},
+	{Name: "risk_score", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Risk Score", "A numerical representation of the threat indicator’s risk level.", "optional", "")},
+	{Name: "script", Type: ScriptStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Related Script Data", "Any pertinent script information related to an indicator or OSINT analysis.", "optional", "")},
+	{Name: "severity", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Severity", "Represents the severity level of the threat indicator, typically reflecting its potential impact or damage.", "optional", "")},
+	{Name: "severity_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Severity ID", "The normalized severity level of the threat indicator, typically reflecting its potential impact or damage.", "optional", "")},
+	{Name: "signatures", Type: arrow.ListOf(DigitalSignatureStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("Related Digital Signatures", "Any digital signatures or hashes related to an indicator or OSINT analysis.", "optional", "")},
+	{Name: "src_url", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Source URL", "The source URL of an indicator or OSINT analysis, e.g., a URL back to a TIP, report, or otherwise.", "optional", "")},
+	{Name: "subdomains", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true, Metadata: ocsf.AttributeMetadata("Related Subdomains", "Any pertinent subdomain information - such as those generated by a Domain Generation Algorithm - related to an indicator or OSINT analysis.", "optional", "")},
+	{Name: "subnet", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Related Subnet", "A CIDR or network block related to an indicator or OSINT analysis.", "optional", "")},
+	{Name: "threat_actor", Type: ThreatActorStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Threat Actor", "A threat actor is an individual or group that conducts malicious cyber activities, often with financial, political, or ideological motives.", "optional", "")},
+	{Name: "tlp", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Traffic Light Protocol", "The <a target='_blank' href='https://www.first.org/tlp/'>Traffic Light Protocol</a> was created to facilitate greater sharing of potentially sensitive information and more effective collaboration. TLP provides a simple and intuitive schema for indicating with whom potentially sensitive information can be shared.", "optional", "")},
+	{Name: "type", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Type", "The OSINT indicator type.", "optional", "")},
+	{Name: "type_id", Type: arrow.PrimitiveTypes.Int32, Nullable: false, Metadata: ocsf.AttributeMetadata("Indicator Type ID", "The OSINT indicator type ID.", "required", "")},
+	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Unique ID", "The unique identifier for the OSINT object.", "optional", "")},
+	{Name: "uploaded_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true, Metadata: ocsf.AttributeMetadata("Uploaded Time", "The timestamp indicating when the associated indicator or intelligence was added to the system or repository.", "optional", "")},
+	{Name: "value", Type: arrow.BinaryTypes.String, Nullable: false, Metadata: ocsf.AttributeMetadata("Indicator", "The actual indicator value in scope, e.g., a SHA-256 hash hexdigest or a domain name.", "required", "")},
+	{Name: "vendor_name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Vendor Name", "The vendor name of a tool which generates intelligence or provides indicators.", "optional", "")},
+	{Name: "vulnerabilities", Type: arrow.ListOf(VulnerabilityDetailsStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("Related Vulnerabilities", "Any vulnerabilities related to an indicator or OSINT analysis.", "optional", "")},
+	{Name: "whois", Type: WHOISStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("WHOIS", "Any pertinent WHOIS information related to an indicator or OSINT analysis.", "optional", "")},
 }
 
 var OSINTStruct = arrow.StructOf(OSINTFields...)
@@ -0,0 +1,68 @@
+package v1_5_0
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
+)
+
+func TestGetSet(t *testing.T) {
+	finding := NewVulnerabilityFinding(VulnerabilityFindingActivityIdCreate)
+
+	var tests = []struct {
+		path  string
+		value any
+		want  any
+	}{
+		{path: "finding_info.uid", value: "finding-1", want: "finding-1"},
+		{path: "severity_id", value: 4.0, want: int32(4)},
+		{path: "metadata.product.vendor_name", value: "Snyk", want: "Snyk"},
+		{path: "resources[0].owner.name", value: "jack", want: "jack"},
+		{path: "resources[1].uid", value: "i-123", want: "i-123"},
+		{path: "vulnerabilities[0].cve.uid", value: "CVE-2024-1234", want: "CVE-2024-1234"},
+		{path: "unmapped", value: map[string]any{"region": "us-east-1"}, want: ocsf.RawJSON(`{"region":"us-east-1"}`)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			if err := finding.Set(tt.path, tt.value); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			got, ok := finding.Get(tt.path)
+			if !ok || got != tt.want {
+				t.Errorf("got %v (%T), %v, want %v (%T)", got, got, ok, tt.want, tt.want)
+			}
+		})
+	}
+
+	if got, ok := finding.Get("class_uid"); !ok || got != int32(2002) {
+		t.Errorf("got class_uid %v, want the class set by the constructor", got)
+	}
+	if len(finding.Resources) != 2 || *finding.Resources[1].Uid != "i-123" {
+		t.Errorf("got resources %v, want two", finding.Resources)
+	}
+	if err := finding.Set("resource_name", "x"); !errors.Is(err, ocsf.ErrUnknownPath) {
+		t.Errorf("got %v for an unknown path, want ErrUnknownPath", err)
+	}
+	if err := finding.Set("resources[5].uid", "x"); err == nil {
+		t.Errorf("expected an error for an index past the end of the list")
+	}
+	if _, ok := finding.Get("resources[3].uid"); ok {
+		t.Errorf("expected no value for an index past the end of the list")
+	}
+}
+
+func TestPaths(t *testing.T) {
+	for _, path := range []string{"finding_info.uid", "resources[].owner.name", "vulnerabilities[].cve.uid", "metadata.product.vendor_name"} {
+		if !slices.Contains(VulnerabilityFindingPaths, path) {
+			t.Errorf("VulnerabilityFindingPaths does not list %s", path)
+		}
+	}
+	for _, path := range []string{"api.operation", "api.request.uid"} {
+		if !slices.Contains(APIActivityPaths, path) {
+			t.Errorf("APIActivityPaths does not list %s", path)
+		}
+	}
+}
@@ -0,0 +1,35 @@
+package v1_5_0
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestProfileAttributes(t *testing.T) {
+	var tests = []struct {
+		name     string
+		profiles []string
+		want     []string
+	}{
+		{name: "Test an event without profiles"},
+		{name: "Test an event applying the cloud profile", profiles: []string{"cloud"}, want: []string{"cloud.provider"}},
+		{name: "Test an event applying the cloud and osint profiles", profiles: []string{"cloud", "osint"}, want: []string{"cloud.provider", "osint"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			finding := NewVulnerabilityFinding(VulnerabilityFindingActivityIdCreate)
+			finding.Metadata.Profiles = tt.profiles
+
+			var got []string
+			for _, err := range finding.ValidateAt("") {
+				if err.Path == "osint" || err.Path == "cloud.provider" {
+					got = append(got, err.Path)
+				}
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("got violations at %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@
 package v1_5_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -75,28 +76,553 @@ func (v *QueryEvidence) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *QueryEvidence) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *QueryEvidence) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	if v.ConnectionInfo != nil {
+		errs = append(errs, v.ConnectionInfo.ValidateAt(ocsf.JoinPath(path, "connection_info"))...)
+	}
+	if v.File != nil {
+		errs = append(errs, v.File.ValidateAt(ocsf.JoinPath(path, "file"))...)
+	}
+	if v.Folder != nil {
+		errs = append(errs, v.Folder.ValidateAt(ocsf.JoinPath(path, "folder"))...)
+	}
+	if v.Group != nil {
+		errs = append(errs, v.Group.ValidateAt(ocsf.JoinPath(path, "group"))...)
+	}
+	if v.Job != nil {
+		errs = append(errs, v.Job.ValidateAt(ocsf.JoinPath(path, "job"))...)
+	}
+	if v.Kernel != nil {
+		errs = append(errs, v.Kernel.ValidateAt(ocsf.JoinPath(path, "kernel"))...)
+	}
+	if v.Module != nil {
+		errs = append(errs, v.Module.ValidateAt(ocsf.JoinPath(path, "module"))...)
+	}
+	for i := range v.NetworkInterfaces {
+		errs = append(errs, v.NetworkInterfaces[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "network_interfaces"), i))...)
+	}
+	if v.PeripheralDevice != nil {
+		errs = append(errs, v.PeripheralDevice.ValidateAt(ocsf.JoinPath(path, "peripheral_device"))...)
+	}
+	if v.Process != nil {
+		errs = append(errs, v.Process.ValidateAt(ocsf.JoinPath(path, "process"))...)
+	}
+	if v.RegKey != nil {
+		errs = append(errs, v.RegKey.ValidateAt(ocsf.JoinPath(path, "reg_key"))...)
+	}
+	if v.RegValue != nil {
+		errs = append(errs, v.RegValue.ValidateAt(ocsf.JoinPath(path, "reg_value"))...)
+	}
+	if v.Service != nil {
+		errs = append(errs, v.Service.ValidateAt(ocsf.JoinPath(path, "service"))...)
+	}
+	if v.Session != nil {
+		errs = append(errs, v.Session.ValidateAt(ocsf.JoinPath(path, "session"))...)
+	}
+	if v.StartupItem != nil {
+		errs = append(errs, v.StartupItem.ValidateAt(ocsf.JoinPath(path, "startup_item"))...)
+	}
+	if v.User != nil {
+		errs = append(errs, v.User.ValidateAt(ocsf.JoinPath(path, "user"))...)
+	}
+	for i := range v.Users {
+		errs = append(errs, v.Users[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "users"), i))...)
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *QueryEvidence) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "connection_info":
+		if index >= 0 || v.ConnectionInfo == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.ConnectionInfo, true
+		}
+		return v.ConnectionInfo.Get(rest)
+	case "file":
+		if index >= 0 || v.File == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.File, true
+		}
+		return v.File.Get(rest)
+	case "folder":
+		if index >= 0 || v.Folder == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Folder, true
+		}
+		return v.Folder.Get(rest)
+	case "group":
+		if index >= 0 || v.Group == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Group, true
+		}
+		return v.Group.Get(rest)
+	case "job":
+		if index >= 0 || v.Job == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Job, true
+		}
+		return v.Job.Get(rest)
+	case "kernel":
+		if index >= 0 || v.Kernel == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Kernel, true
+		}
+		return v.Kernel.Get(rest)
+	case "module":
+		if index >= 0 || v.Module == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Module, true
+		}
+		return v.Module.Get(rest)
+	case "network_interfaces":
+		if rest == "" {
+			if index < 0 {
+				return v.NetworkInterfaces, v.NetworkInterfaces != nil
+			}
+			return ocsf.Index(v.NetworkInterfaces, index)
+		}
+		if index < 0 || index >= len(v.NetworkInterfaces) {
+			return nil, false
+		}
+		return v.NetworkInterfaces[index].Get(rest)
+	case "peripheral_device":
+		if index >= 0 || v.PeripheralDevice == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.PeripheralDevice, true
+		}
+		return v.PeripheralDevice.Get(rest)
+	case "process":
+		if index >= 0 || v.Process == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Process, true
+		}
+		return v.Process.Get(rest)
+	case "query_type":
+		if index >= 0 || rest != "" || v.QueryType == nil {
+			return nil, false
+		}
+		return *v.QueryType, true
+	case "query_type_id":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.QueryTypeId, true
+	case "reg_key":
+		if index >= 0 || v.RegKey == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.RegKey, true
+		}
+		return v.RegKey.Get(rest)
+	case "reg_value":
+		if index >= 0 || v.RegValue == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.RegValue, true
+		}
+		return v.RegValue.Get(rest)
+	case "service":
+		if index >= 0 || v.Service == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Service, true
+		}
+		return v.Service.Get(rest)
+	case "session":
+		if index >= 0 || v.Session == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Session, true
+		}
+		return v.Session.Get(rest)
+	case "startup_item":
+		if index >= 0 || v.StartupItem == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.StartupItem, true
+		}
+		return v.StartupItem.Get(rest)
+	case "state":
+		if index >= 0 || rest != "" || v.State == nil {
+			return nil, false
+		}
+		return *v.State, true
+	case "tcp_state_id":
+		if index >= 0 || rest != "" || v.TcpStateId == nil {
+			return nil, false
+		}
+		return *v.TcpStateId, true
+	case "user":
+		if index >= 0 || v.User == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.User, true
+		}
+		return v.User.Get(rest)
+	case "users":
+		if rest == "" {
+			if index < 0 {
+				return v.Users, v.Users != nil
+			}
+			return ocsf.Index(v.Users, index)
+		}
+		if index < 0 || index >= len(v.Users) {
+			return nil, false
+		}
+		return v.Users[index].Get(rest)
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *QueryEvidence) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "connection_info":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.ConnectionInfo, value)
+		}
+		if v.ConnectionInfo == nil {
+			v.ConnectionInfo = &NetworkConnectionInformation{}
+		}
+		return v.ConnectionInfo.Set(rest, value)
+	case "file":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.File, value)
+		}
+		if v.File == nil {
+			v.File = &File{}
+		}
+		return v.File.Set(rest, value)
+	case "folder":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Folder, value)
+		}
+		if v.Folder == nil {
+			v.Folder = &File{}
+		}
+		return v.Folder.Set(rest, value)
+	case "group":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Group, value)
+		}
+		if v.Group == nil {
+			v.Group = &Group{}
+		}
+		return v.Group.Set(rest, value)
+	case "job":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Job, value)
+		}
+		if v.Job == nil {
+			v.Job = &Job{}
+		}
+		return v.Job.Set(rest, value)
+	case "kernel":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Kernel, value)
+		}
+		if v.Kernel == nil {
+			v.Kernel = &KernelResource{}
+		}
+		return v.Kernel.Set(rest, value)
+	case "module":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Module, value)
+		}
+		if v.Module == nil {
+			v.Module = &Module{}
+		}
+		return v.Module.Set(rest, value)
+	case "network_interfaces":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.NetworkInterfaces, value)
+		}
+		elem, err := ocsf.Element(&v.NetworkInterfaces, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "peripheral_device":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.PeripheralDevice, value)
+		}
+		if v.PeripheralDevice == nil {
+			v.PeripheralDevice = &PeripheralDevice{}
+		}
+		return v.PeripheralDevice.Set(rest, value)
+	case "process":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Process, value)
+		}
+		if v.Process == nil {
+			v.Process = &Process{}
+		}
+		return v.Process.Set(rest, value)
+	case "query_type":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.QueryType, value)
+	case "query_type_id":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.QueryTypeId, value)
+	case "reg_key":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.RegKey, value)
+		}
+		if v.RegKey == nil {
+			v.RegKey = &RegistryKey{}
+		}
+		return v.RegKey.Set(rest, value)
+	case "reg_value":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.RegValue, value)
+		}
+		if v.RegValue == nil {
+			v.RegValue = &RegistryValue{}
+		}
+		return v.RegValue.Set(rest, value)
+	case "service":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Service, value)
+		}
+		if v.Service == nil {
+			v.Service = &Service{}
+		}
+		return v.Service.Set(rest, value)
+	case "session":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Session, value)
+		}
+		if v.Session == nil {
+			v.Session = &Session{}
+		}
+		return v.Session.Set(rest, value)
+	case "startup_item":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.StartupItem, value)
+		}
+		if v.StartupItem == nil {
+			v.StartupItem = &StartupItem{}
+		}
+		return v.StartupItem.Set(rest, value)
+	case "state":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.State, value)
+	case "tcp_state_id":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.TcpStateId, value)
+	case "user":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.User, value)
+		}
+		if v.User == nil {
+			v.User = &User{}
+		}
+		return v.User.Set(rest, value)
+	case "users":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Users, value)
+		}
+		elem, err := ocsf.Element(&v.Users, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *QueryEvidence) AppendObservables(path string, observables []Observable) []Observable {
+	if v.ConnectionInfo != nil {
+		observables = v.ConnectionInfo.AppendObservables(ocsf.JoinPath(path, "connection_info"), observables)
+	}
+	if v.File != nil {
+		observables = v.File.AppendObservables(ocsf.JoinPath(path, "file"), observables)
+	}
+	if v.Folder != nil {
+		observables = v.Folder.AppendObservables(ocsf.JoinPath(path, "folder"), observables)
+	}
+	if v.Group != nil {
+		observables = v.Group.AppendObservables(ocsf.JoinPath(path, "group"), observables)
+	}
+	if v.Job != nil {
+		observables = v.Job.AppendObservables(ocsf.JoinPath(path, "job"), observables)
+	}
+	if v.Kernel != nil {
+		observables = v.Kernel.AppendObservables(ocsf.JoinPath(path, "kernel"), observables)
+	}
+	if v.Module != nil {
+		observables = v.Module.AppendObservables(ocsf.JoinPath(path, "module"), observables)
+	}
+	for i := range v.NetworkInterfaces {
+		observables = v.NetworkInterfaces[i].AppendObservables(ocsf.JoinPath(path, "network_interfaces"), observables)
+	}
+	if v.PeripheralDevice != nil {
+		observables = v.PeripheralDevice.AppendObservables(ocsf.JoinPath(path, "peripheral_device"), observables)
+	}
+	if v.Process != nil {
+		observables = v.Process.AppendObservables(ocsf.JoinPath(path, "process"), observables)
+	}
+	if v.RegKey != nil {
+		observables = v.RegKey.AppendObservables(ocsf.JoinPath(path, "reg_key"), observables)
+	}
+	if v.RegValue != nil {
+		observables = v.RegValue.AppendObservables(ocsf.JoinPath(path, "reg_value"), observables)
+	}
+	if v.Service != nil {
+		observables = v.Service.AppendObservables(ocsf.JoinPath(path, "service"), observables)
+	}
+	if v.Session != nil {
+		observables = v.Session.AppendObservables(ocsf.JoinPath(path, "session"), observables)
+	}
+	if v.StartupItem != nil {
+		observables = v.StartupItem.AppendObservables(ocsf.JoinPath(path, "startup_item"), observables)
+	}
+	if v.User != nil {
+		observables = v.User.AppendObservables(ocsf.JoinPath(path, "user"), observables)
+	}
+	for i := range v.Users {
+		observables = v.Users[i].AppendObservables(ocsf.JoinPath(path, "users"), observables)
+	}
+
+	return observables
+}
+
 var QueryEvidenceFields = []arrow.Field{
-	{Name: "connection_info", Type: NetworkConnectionInformationStruct, Nullable: true},
-	{Name: "file", Type: FileStruct, Nullable: true},
-	{Name: "folder", Type: FileStruct, Nullable: true},
-	{Name: "group", Type: GroupStruct, Nullable: true},
-	{Name: "job", Type: JobStruct, Nullable: true},
-	{Name: "kernel", Type: KernelResourceStruct, Nullable: true},
-	{Name: "module", Type: ModuleStruct, Nullable: true},
-	{Name: "network_interfaces", Type: arrow.ListOf(NetworkInterfaceStruct), Nullable: true},
-	{Name: "peripheral_device", Type: PeripheralDeviceStruct, Nullable: true},
-	{Name: "process", Type: ProcessStruct, Nullable: true},
-	{Name: "query_type", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "query_type_id", Type: arrow.PrimitiveTypes.Int32, Nullable: false},
-	{Name: "reg_key", Type: RegistryKeyStruct, Nullable: true},
-	{Name: "reg_value", Type: RegistryValueStruct, Nullable: true},
-	{Name: "service", Type: ServiceStruct, Nullable: true},
-	{Name: "session", Type: SessionStruct, Nullable: true},
-	{Name: "startup_item", Type: StartupItemStruct, Nullable: true},
-	{Name: "state", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "tcp_state_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "user", Type: UserStruct, Nullable: true},
-	{Name: "users", Type: arrow.ListOf(UserStruct), Nullable: true},
+	{Name: "connection_info", Type: NetworkConnectionInformationStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Connection Info", "The network connection information related to a Network Connection query type.", "optional", "")},
+	{Name: "file", Type: FileStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("File", "The file that is the target of the query when query_type_id indicates a File query.", "optional", "")},
+	{Name: "folder", Type: FileStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Folder", "The folder that is the target of the query when query_type_id indicates a Folder query.", "optional", "")},
+	{Name: "group", Type: GroupStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Group", "The administrative group that is the target of the query when query_type_id indicates an Admin Group query.", "optional", "")},
+	{Name: "job", Type: JobStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Job", "The job object that pertains to the event when query_type_id indicates a Job query.", "optional", "")},
+	{Name: "kernel", Type: KernelResourceStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Kernel", "The kernel object that pertains to the event when query_type_id indicates a Kernel query.", "optional", "")},
+	{Name: "module", Type: ModuleStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Module", "The module that pertains to the event when query_type_id indicates a Module query.", "optional", "")},
+	{Name: "network_interfaces", Type: arrow.ListOf(NetworkInterfaceStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("Network Interfaces", "The physical or virtual network interfaces that are associated with the device when query_type_id indicates a Network Interfaces query.", "optional", "")},
+	{Name: "peripheral_device", Type: PeripheralDeviceStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Peripheral Device", "The peripheral device that triggered the event when query_type_id indicates a Peripheral Device query.", "optional", "")},
+	{Name: "process", Type: ProcessStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Process", "The process that pertains to the event when query_type_id indicates a Process query.", "optional", "")},
+	{Name: "query_type", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Query Type", "The normalized caption of query_type_id or the source-specific query type.", "optional", "")},
+	{Name: "query_type_id", Type: arrow.PrimitiveTypes.Int32, Nullable: false, Metadata: ocsf.AttributeMetadata("Query Type ID", "The normalized type of system query performed against a device or system component.", "required", "")},
+	{Name: "reg_key", Type: RegistryKeyStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Registry Key", "The registry key object describes a Windows registry key.", "optional", "")},
+	{Name: "reg_value", Type: RegistryValueStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Registry Value", "The registry key object describes a Windows registry value.", "optional", "")},
+	{Name: "service", Type: ServiceStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Service", "The service that pertains to the event when query_type_id indicates a Service query.", "optional", "")},
+	{Name: "session", Type: SessionStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Session", "The authenticated user or service session when query_type_id indicates a Session query.", "optional", "")},
+	{Name: "startup_item", Type: StartupItemStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Startup Item", "The startup item object that pertains to the event when query_type_id indicates a Startup Item query.", "optional", "")},
+	{Name: "state", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Network Connection State", "The state of the socket, normalized to the caption of the state_id value. In the case of 'Other', it is defined by the event source.", "optional", "")},
+	{Name: "tcp_state_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("TCP State ID", "The state of the TCP socket for the network connection.", "optional", "")},
+	{Name: "user", Type: UserStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("User", "The user that pertains to the event when query_type_id indicates a User query.", "optional", "")},
+	{Name: "users", Type: arrow.ListOf(UserStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("Users", "The users that belong to the administrative group when query_type_id indicates a Users query.", "optional", "")},
 }
 
 var QueryEvidenceStruct = arrow.StructOf(QueryEvidenceFields...)
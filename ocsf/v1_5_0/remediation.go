@@ -2,6 +2,7 @@
 package v1_5_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -24,11 +25,157 @@ func (v *Remediation) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *Remediation) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *Remediation) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	for i := range v.CisControls {
+		errs = append(errs, v.CisControls[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "cis_controls"), i))...)
+	}
+	if v.Desc == "" {
+		errs.Add(ocsf.JoinPath(path, "desc"), "required attribute is missing")
+	}
+	for i := range v.KbArticleList {
+		errs = append(errs, v.KbArticleList[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "kb_article_list"), i))...)
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *Remediation) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "cis_controls":
+		if rest == "" {
+			if index < 0 {
+				return v.CisControls, v.CisControls != nil
+			}
+			return ocsf.Index(v.CisControls, index)
+		}
+		if index < 0 || index >= len(v.CisControls) {
+			return nil, false
+		}
+		return v.CisControls[index].Get(rest)
+	case "desc":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.Desc, true
+	case "kb_article_list":
+		if rest == "" {
+			if index < 0 {
+				return v.KbArticleList, v.KbArticleList != nil
+			}
+			return ocsf.Index(v.KbArticleList, index)
+		}
+		if index < 0 || index >= len(v.KbArticleList) {
+			return nil, false
+		}
+		return v.KbArticleList[index].Get(rest)
+	case "references":
+		if rest == "" {
+			if index < 0 {
+				return v.References, v.References != nil
+			}
+			return ocsf.Index(v.References, index)
+		}
+		return nil, false
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *Remediation) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "cis_controls":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.CisControls, value)
+		}
+		elem, err := ocsf.Element(&v.CisControls, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "desc":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.Desc, value)
+	case "kb_article_list":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.KbArticleList, value)
+		}
+		elem, err := ocsf.Element(&v.KbArticleList, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "references":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.References, value)
+		}
+		elem, err := ocsf.Element(&v.References, index)
+		if err != nil {
+			return err
+		}
+		if rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(elem, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *Remediation) AppendObservables(path string, observables []Observable) []Observable {
+	for i := range v.CisControls {
+		observables = v.CisControls[i].AppendObservables(ocsf.JoinPath(path, "cis_controls"), observables)
+	}
+	for i := range v.KbArticleList {
+		observables = v.KbArticleList[i].AppendObservables(ocsf.JoinPath(path, "kb_article_list"), observables)
+	}
+
+	return observables
+}
+
 var RemediationFields = []arrow.Field{
-	{Name: "cis_controls", Type: arrow.ListOf(CISControlStruct), Nullable: true},
-	{Name: "desc", Type: arrow.BinaryTypes.String, Nullable: false},
-	{Name: "kb_article_list", Type: arrow.ListOf(KBArticleStruct), Nullable: true},
-	{Name: "references", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true},
+	{Name: "cis_controls", Type: arrow.ListOf(CISControlStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("CIS Controls", "An array of Center for Internet Security (CIS) Controls that can be optionally mapped to provide additional remediation details.", "optional", "")},
+	{Name: "desc", Type: arrow.BinaryTypes.String, Nullable: false, Metadata: ocsf.AttributeMetadata("Description", "The description of the remediation strategy.", "required", "")},
+	{Name: "kb_article_list", Type: arrow.ListOf(KBArticleStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("Knowledgebase Articles", "A list of KB articles or patches related to an endpoint. A KB Article contains metadata that describes the patch or an update.", "optional", "")},
+	{Name: "references", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true, Metadata: ocsf.AttributeMetadata("References", "A list of supporting URL/s, references that help describe the remediation strategy.", "optional", "")},
 }
 
 var RemediationStruct = arrow.StructOf(RemediationFields...)
@@ -2,6 +2,7 @@
 package v1_5_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -24,11 +25,97 @@ func (v *Reputation) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *Reputation) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *Reputation) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *Reputation) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "base_score":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.BaseScore, true
+	case "provider":
+		if index >= 0 || rest != "" || v.Provider == nil {
+			return nil, false
+		}
+		return *v.Provider, true
+	case "score":
+		if index >= 0 || rest != "" || v.Score == nil {
+			return nil, false
+		}
+		return *v.Score, true
+	case "score_id":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.ScoreId, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *Reputation) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "base_score":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.BaseScore, value)
+	case "provider":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Provider, value)
+	case "score":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Score, value)
+	case "score_id":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.ScoreId, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *Reputation) AppendObservables(path string, observables []Observable) []Observable {
+
+	return observables
+}
+
 var ReputationFields = []arrow.Field{
-	{Name: "base_score", Type: arrow.PrimitiveTypes.Float64, Nullable: false},
-	{Name: "provider", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "score", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "score_id", Type: arrow.PrimitiveTypes.Int32, Nullable: false},
+	{Name: "base_score", Type: arrow.PrimitiveTypes.Float64, Nullable: false, Metadata: ocsf.AttributeMetadata("Reputation Score", "The reputation score as reported by the event source.", "required", "")},
+	{Name: "provider", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Provider", "The provider of the reputation information.", "optional", "")},
+	{Name: "score", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Reputation Score", "The reputation score, normalized to the caption of the score_id value. In the case of 'Other', it is defined by the event source.", "optional", "")},
+	{Name: "score_id", Type: arrow.PrimitiveTypes.Int32, Nullable: false, Metadata: ocsf.AttributeMetadata("Reputation Score ID", "The normalized reputation score identifier.", "required", "")},
 }
 
 var ReputationStruct = arrow.StructOf(ReputationFields...)
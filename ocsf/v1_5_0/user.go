@@ -2,6 +2,7 @@
 package v1_5_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -73,55 +74,360 @@ func (v *User) Observable() (*int, string) {
 	return &typeId, "user"
 }
 
+func (v *User) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *User) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	if v.Account != nil {
+		errs = append(errs, v.Account.ValidateAt(ocsf.JoinPath(path, "account"))...)
+	}
+	for i := range v.Groups {
+		errs = append(errs, v.Groups[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "groups"), i))...)
+	}
+	if v.Org != nil {
+		errs = append(errs, v.Org.ValidateAt(ocsf.JoinPath(path, "org"))...)
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *User) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "account":
+		if index >= 0 || v.Account == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Account, true
+		}
+		return v.Account.Get(rest)
+	case "credential_uid":
+		if index >= 0 || rest != "" || v.CredentialUid == nil {
+			return nil, false
+		}
+		return *v.CredentialUid, true
+	case "display_name":
+		if index >= 0 || rest != "" || v.DisplayName == nil {
+			return nil, false
+		}
+		return *v.DisplayName, true
+	case "domain":
+		if index >= 0 || rest != "" || v.Domain == nil {
+			return nil, false
+		}
+		return *v.Domain, true
+	case "email_addr":
+		if index >= 0 || rest != "" || v.EmailAddr == nil {
+			return nil, false
+		}
+		return *v.EmailAddr, true
+	case "forward_addr":
+		if index >= 0 || rest != "" || v.ForwardAddr == nil {
+			return nil, false
+		}
+		return *v.ForwardAddr, true
+	case "full_name":
+		if index >= 0 || rest != "" || v.FullName == nil {
+			return nil, false
+		}
+		return *v.FullName, true
+	case "groups":
+		if rest == "" {
+			if index < 0 {
+				return v.Groups, v.Groups != nil
+			}
+			return ocsf.Index(v.Groups, index)
+		}
+		if index < 0 || index >= len(v.Groups) {
+			return nil, false
+		}
+		return v.Groups[index].Get(rest)
+	case "has_mfa":
+		if index >= 0 || rest != "" || v.HasMfa == nil {
+			return nil, false
+		}
+		return *v.HasMfa, true
+	case "ldap_person":
+		if index >= 0 || rest != "" || v.LdapPerson == nil {
+			return nil, false
+		}
+		return v.LdapPerson, true
+	case "name":
+		if index >= 0 || rest != "" || v.Name == nil {
+			return nil, false
+		}
+		return *v.Name, true
+	case "org":
+		if index >= 0 || v.Org == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Org, true
+		}
+		return v.Org.Get(rest)
+	case "phone_number":
+		if index >= 0 || rest != "" || v.PhoneNumber == nil {
+			return nil, false
+		}
+		return *v.PhoneNumber, true
+	case "risk_level":
+		if index >= 0 || rest != "" || v.RiskLevel == nil {
+			return nil, false
+		}
+		return *v.RiskLevel, true
+	case "risk_level_id":
+		if index >= 0 || rest != "" || v.RiskLevelId == nil {
+			return nil, false
+		}
+		return *v.RiskLevelId, true
+	case "risk_score":
+		if index >= 0 || rest != "" || v.RiskScore == nil {
+			return nil, false
+		}
+		return *v.RiskScore, true
+	case "type":
+		if index >= 0 || rest != "" || v.Type == nil {
+			return nil, false
+		}
+		return *v.Type, true
+	case "type_id":
+		if index >= 0 || rest != "" || v.TypeId == nil {
+			return nil, false
+		}
+		return *v.TypeId, true
+	case "uid":
+		if index >= 0 || rest != "" || v.Uid == nil {
+			return nil, false
+		}
+		return *v.Uid, true
+	case "uid_alt":
+		if index >= 0 || rest != "" || v.UidAlt == nil {
+			return nil, false
+		}
+		return *v.UidAlt, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *User) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "account":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Account, value)
+		}
+		if v.Account == nil {
+			v.Account = &Account{}
+		}
+		return v.Account.Set(rest, value)
+	case "credential_uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.CredentialUid, value)
+	case "display_name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.DisplayName, value)
+	case "domain":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Domain, value)
+	case "email_addr":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.EmailAddr, value)
+	case "forward_addr":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.ForwardAddr, value)
+	case "full_name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.FullName, value)
+	case "groups":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Groups, value)
+		}
+		elem, err := ocsf.Element(&v.Groups, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "has_mfa":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.HasMfa, value)
+	case "ldap_person":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.LdapPerson, value)
+	case "name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Name, value)
+	case "org":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Org, value)
+		}
+		if v.Org == nil {
+			v.Org = &Organization{}
+		}
+		return v.Org.Set(rest, value)
+	case "phone_number":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.PhoneNumber, value)
+	case "risk_level":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.RiskLevel, value)
+	case "risk_level_id":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.RiskLevelId, value)
+	case "risk_score":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.RiskScore, value)
+	case "type":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Type, value)
+	case "type_id":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.TypeId, value)
+	case "uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Uid, value)
+	case "uid_alt":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.UidAlt, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *User) AppendObservables(path string, observables []Observable) []Observable {
+	if path != "" {
+		observables = append(observables, Observable{Name: ocsf.Ptr(path), TypeId: 21})
+	}
+	if v.Account != nil {
+		observables = v.Account.AppendObservables(ocsf.JoinPath(path, "account"), observables)
+	}
+	if v.EmailAddr != nil {
+		observables = append(observables, Observable{Name: ocsf.Ptr(ocsf.JoinPath(path, "email_addr")), TypeId: 5, Value: ocsf.Ptr(*v.EmailAddr)})
+	}
+	for i := range v.Groups {
+		observables = v.Groups[i].AppendObservables(ocsf.JoinPath(path, "groups"), observables)
+	}
+	if v.Name != nil {
+		observables = append(observables, Observable{Name: ocsf.Ptr(ocsf.JoinPath(path, "name")), TypeId: 4, Value: ocsf.Ptr(*v.Name)})
+	}
+	if v.Org != nil {
+		observables = v.Org.AppendObservables(ocsf.JoinPath(path, "org"), observables)
+	}
+
+	return observables
+}
+
 var UserFields = []arrow.Field{
-	{Name: "account", Type: AccountStruct, Nullable: true},
-	{Name: "credential_uid", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "display_name", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "domain", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "email_addr", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "forward_addr", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "full_name", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "groups", Type: arrow.ListOf(GroupStruct), Nullable: true},
-	{Name: "has_mfa", Type: arrow.FixedWidthTypes.Boolean, Nullable: true},
-	{Name: "ldap_person", Type: LDAPPersonRefStruct, Nullable: true},
-	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "org", Type: OrganizationStruct, Nullable: true},
-	{Name: "phone_number", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "risk_level", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "risk_level_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "risk_score", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "type", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "type_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "uid_alt", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "account", Type: AccountStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Account", "The user's account or the account associated with the user.", "optional", "")},
+	{Name: "credential_uid", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("User Credential ID", "The unique identifier of the user's credential. For example, AWS Access Key ID.", "optional", "")},
+	{Name: "display_name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Display Name", "The display name of the user, as reported by the product.", "optional", "")},
+	{Name: "domain", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Domain", "The domain where the user is defined. For example: the LDAP or Active Directory domain.", "optional", "")},
+	{Name: "email_addr", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Email Address", "The user's primary email address.", "optional", "")},
+	{Name: "forward_addr", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Forwarding Address", "The user's forwarding email address.", "optional", "")},
+	{Name: "full_name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Full Name", "The full name of the user, as reported by the product.", "optional", "")},
+	{Name: "groups", Type: arrow.ListOf(GroupStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("Groups", "The administrative groups to which the user belongs.", "optional", "")},
+	{Name: "has_mfa", Type: arrow.FixedWidthTypes.Boolean, Nullable: true, Metadata: ocsf.AttributeMetadata("MFA Assigned", "The user has a multi-factor or secondary-factor device assigned.", "optional", "")},
+	{Name: "ldap_person", Type: LDAPPersonRefStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("LDAP Person", "The additional LDAP attributes that describe a person.", "optional", "")},
+	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Name", "The username. For example, <code>janedoe1</code>.", "optional", "")},
+	{Name: "org", Type: OrganizationStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Organization", "Organization and org unit related to the user.", "optional", "")},
+	{Name: "phone_number", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Telephone Number", "The telephone number of the user.", "optional", "")},
+	{Name: "risk_level", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Risk Level", "The risk level, normalized to the caption of the risk_level_id value.", "optional", "")},
+	{Name: "risk_level_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Risk Level ID", "The normalized risk level id.", "optional", "")},
+	{Name: "risk_score", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Risk Score", "The risk score as reported by the event source.", "optional", "")},
+	{Name: "type", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Type", "The type of the user. For example, System, AWS IAM User, etc.", "optional", "")},
+	{Name: "type_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Type ID", "The account type identifier.", "optional", "")},
+	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Unique ID", "The unique user identifier. For example, the Windows user SID, ActiveDirectory DN or AWS user ARN.", "optional", "")},
+	{Name: "uid_alt", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Alternate ID", "The alternate user identifier. For example, the Active Directory user GUID or AWS user Principal ID.", "optional", "")},
 }
 
 var UserStruct = arrow.StructOf(UserFields...)
 
 var UserSchema = arrow.NewSchema(UserFields, nil)
 var UserClassname = "user"
-var UserRefFields = []arrow.Field{
 
-	{Name: "credential_uid", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "display_name", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "domain", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "email_addr", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "forward_addr", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "full_name", Type: arrow.BinaryTypes.String, Nullable: true},
-
-	{Name: "has_mfa", Type: arrow.FixedWidthTypes.Boolean, Nullable: true},
-
-	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true},
-
-	{Name: "phone_number", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "risk_level", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "risk_level_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "risk_score", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "type", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "type_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "uid_alt", Type: arrow.BinaryTypes.String, Nullable: true},
+var UserRefFields = []arrow.Field{
+	{Name: "credential_uid", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("User Credential ID", "The unique identifier of the user's credential. For example, AWS Access Key ID.", "optional", "")},
+	{Name: "display_name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Display Name", "The display name of the user, as reported by the product.", "optional", "")},
+	{Name: "domain", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Domain", "The domain where the user is defined. For example: the LDAP or Active Directory domain.", "optional", "")},
+	{Name: "email_addr", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Email Address", "The user's primary email address.", "optional", "")},
+	{Name: "forward_addr", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Forwarding Address", "The user's forwarding email address.", "optional", "")},
+	{Name: "full_name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Full Name", "The full name of the user, as reported by the product.", "optional", "")},
+	{Name: "has_mfa", Type: arrow.FixedWidthTypes.Boolean, Nullable: true, Metadata: ocsf.AttributeMetadata("MFA Assigned", "The user has a multi-factor or secondary-factor device assigned.", "optional", "")},
+	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Name", "The username. For example, <code>janedoe1</code>.", "optional", "")},
+	{Name: "phone_number", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Telephone Number", "The telephone number of the user.", "optional", "")},
+	{Name: "risk_level", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Risk Level", "The risk level, normalized to the caption of the risk_level_id value.", "optional", "")},
+	{Name: "risk_level_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Risk Level ID", "The normalized risk level id.", "optional", "")},
+	{Name: "risk_score", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Risk Score", "The risk score as reported by the event source.", "optional", "")},
+	{Name: "type", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Type", "The type of the user. For example, System, AWS IAM User, etc.", "optional", "")},
+	{Name: "type_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Type ID", "The account type identifier.", "optional", "")},
+	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Unique ID", "The unique user identifier. For example, the Windows user SID, ActiveDirectory DN or AWS user ARN.", "optional", "")},
+	{Name: "uid_alt", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Alternate ID", "The alternate user identifier. For example, the Active Directory user GUID or AWS user Principal ID.", "optional", "")},
 }
+
 var UserRefStruct = arrow.StructOf(UserRefFields...)
 
 type UserRef struct {
@@ -147,8 +453,6 @@ type UserRef struct {
 	// MFA Assigned: The user has a multi-factor or secondary-factor device assigned.
 	HasMfa *bool `json:"has_mfa,omitempty" parquet:"has_mfa,optional"`
 
-	// LDAP Person: The additional LDAP attributes that describe a person.
-
 	// Name: The username. For example, <code>janedoe1</code>.
 	Name *string `json:"name,omitempty" parquet:"name,optional"`
 
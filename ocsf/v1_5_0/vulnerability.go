@@ -2,6 +2,7 @@
 package v1_5_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -84,31 +85,447 @@ func (v *VulnerabilityDetails) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *VulnerabilityDetails) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *VulnerabilityDetails) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	if v.Advisory != nil {
+		errs = append(errs, v.Advisory.ValidateAt(ocsf.JoinPath(path, "advisory"))...)
+	}
+	for i := range v.AffectedCode {
+		errs = append(errs, v.AffectedCode[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "affected_code"), i))...)
+	}
+	for i := range v.AffectedPackages {
+		errs = append(errs, v.AffectedPackages[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "affected_packages"), i))...)
+	}
+	if v.Cve != nil {
+		errs = append(errs, v.Cve.ValidateAt(ocsf.JoinPath(path, "cve"))...)
+	}
+	if v.Cwe != nil {
+		errs = append(errs, v.Cwe.ValidateAt(ocsf.JoinPath(path, "cwe"))...)
+	}
+	if v.Remediation != nil {
+		errs = append(errs, v.Remediation.ValidateAt(ocsf.JoinPath(path, "remediation"))...)
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *VulnerabilityDetails) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "advisory":
+		if index >= 0 || v.Advisory == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Advisory, true
+		}
+		return v.Advisory.Get(rest)
+	case "affected_code":
+		if rest == "" {
+			if index < 0 {
+				return v.AffectedCode, v.AffectedCode != nil
+			}
+			return ocsf.Index(v.AffectedCode, index)
+		}
+		if index < 0 || index >= len(v.AffectedCode) {
+			return nil, false
+		}
+		return v.AffectedCode[index].Get(rest)
+	case "affected_packages":
+		if rest == "" {
+			if index < 0 {
+				return v.AffectedPackages, v.AffectedPackages != nil
+			}
+			return ocsf.Index(v.AffectedPackages, index)
+		}
+		if index < 0 || index >= len(v.AffectedPackages) {
+			return nil, false
+		}
+		return v.AffectedPackages[index].Get(rest)
+	case "category":
+		if index >= 0 || rest != "" || v.Category == nil {
+			return nil, false
+		}
+		return *v.Category, true
+	case "cve":
+		if index >= 0 || v.Cve == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Cve, true
+		}
+		return v.Cve.Get(rest)
+	case "cwe":
+		if index >= 0 || v.Cwe == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Cwe, true
+		}
+		return v.Cwe.Get(rest)
+	case "dependency_chain":
+		if index >= 0 || rest != "" || v.DependencyChain == nil {
+			return nil, false
+		}
+		return *v.DependencyChain, true
+	case "desc":
+		if index >= 0 || rest != "" || v.Desc == nil {
+			return nil, false
+		}
+		return *v.Desc, true
+	case "exploit_last_seen_time":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.ExploitLastSeenTime, true
+	case "exploit_ref_url":
+		if index >= 0 || rest != "" || v.ExploitRefUrl == nil {
+			return nil, false
+		}
+		return *v.ExploitRefUrl, true
+	case "exploit_requirement":
+		if index >= 0 || rest != "" || v.ExploitRequirement == nil {
+			return nil, false
+		}
+		return *v.ExploitRequirement, true
+	case "exploit_type":
+		if index >= 0 || rest != "" || v.ExploitType == nil {
+			return nil, false
+		}
+		return *v.ExploitType, true
+	case "first_seen_time":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.FirstSeenTime, true
+	case "fix_coverage":
+		if index >= 0 || rest != "" || v.FixCoverage == nil {
+			return nil, false
+		}
+		return *v.FixCoverage, true
+	case "fix_coverage_id":
+		if index >= 0 || rest != "" || v.FixCoverageId == nil {
+			return nil, false
+		}
+		return *v.FixCoverageId, true
+	case "is_exploit_available":
+		if index >= 0 || rest != "" || v.IsExploitAvailable == nil {
+			return nil, false
+		}
+		return *v.IsExploitAvailable, true
+	case "is_fix_available":
+		if index >= 0 || rest != "" || v.IsFixAvailable == nil {
+			return nil, false
+		}
+		return *v.IsFixAvailable, true
+	case "last_seen_time":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.LastSeenTime, true
+	case "references":
+		if rest == "" {
+			if index < 0 {
+				return v.References, v.References != nil
+			}
+			return ocsf.Index(v.References, index)
+		}
+		return nil, false
+	case "related_vulnerabilities":
+		if rest == "" {
+			if index < 0 {
+				return v.RelatedVulnerabilities, v.RelatedVulnerabilities != nil
+			}
+			return ocsf.Index(v.RelatedVulnerabilities, index)
+		}
+		return nil, false
+	case "remediation":
+		if index >= 0 || v.Remediation == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.Remediation, true
+		}
+		return v.Remediation.Get(rest)
+	case "severity":
+		if index >= 0 || rest != "" || v.Severity == nil {
+			return nil, false
+		}
+		return *v.Severity, true
+	case "title":
+		if index >= 0 || rest != "" || v.Title == nil {
+			return nil, false
+		}
+		return *v.Title, true
+	case "vendor_name":
+		if index >= 0 || rest != "" || v.VendorName == nil {
+			return nil, false
+		}
+		return *v.VendorName, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *VulnerabilityDetails) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "advisory":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Advisory, value)
+		}
+		if v.Advisory == nil {
+			v.Advisory = &Advisory{}
+		}
+		return v.Advisory.Set(rest, value)
+	case "affected_code":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.AffectedCode, value)
+		}
+		elem, err := ocsf.Element(&v.AffectedCode, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "affected_packages":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.AffectedPackages, value)
+		}
+		elem, err := ocsf.Element(&v.AffectedPackages, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "category":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Category, value)
+	case "cve":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Cve, value)
+		}
+		if v.Cve == nil {
+			v.Cve = &CVE{}
+		}
+		return v.Cve.Set(rest, value)
+	case "cwe":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Cwe, value)
+		}
+		if v.Cwe == nil {
+			v.Cwe = &CWE{}
+		}
+		return v.Cwe.Set(rest, value)
+	case "dependency_chain":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.DependencyChain, value)
+	case "desc":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Desc, value)
+	case "exploit_last_seen_time":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.ExploitLastSeenTime, value)
+	case "exploit_ref_url":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.ExploitRefUrl, value)
+	case "exploit_requirement":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.ExploitRequirement, value)
+	case "exploit_type":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.ExploitType, value)
+	case "first_seen_time":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.FirstSeenTime, value)
+	case "fix_coverage":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.FixCoverage, value)
+	case "fix_coverage_id":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.FixCoverageId, value)
+	case "is_exploit_available":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.IsExploitAvailable, value)
+	case "is_fix_available":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.IsFixAvailable, value)
+	case "last_seen_time":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.LastSeenTime, value)
+	case "references":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.References, value)
+		}
+		elem, err := ocsf.Element(&v.References, index)
+		if err != nil {
+			return err
+		}
+		if rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(elem, value)
+	case "related_vulnerabilities":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.RelatedVulnerabilities, value)
+		}
+		elem, err := ocsf.Element(&v.RelatedVulnerabilities, index)
+		if err != nil {
+			return err
+		}
+		if rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(elem, value)
+	case "remediation":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.Remediation, value)
+		}
+		if v.Remediation == nil {
+			v.Remediation = &Remediation{}
+		}
+		return v.Remediation.Set(rest, value)
+	case "severity":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Severity, value)
+	case "title":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Title, value)
+	case "vendor_name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.VendorName, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *VulnerabilityDetails) AppendObservables(path string, observables []Observable) []Observable {
+	if v.Advisory != nil {
+		observables = v.Advisory.AppendObservables(ocsf.JoinPath(path, "advisory"), observables)
+	}
+	for i := range v.AffectedCode {
+		observables = v.AffectedCode[i].AppendObservables(ocsf.JoinPath(path, "affected_code"), observables)
+	}
+	for i := range v.AffectedPackages {
+		observables = v.AffectedPackages[i].AppendObservables(ocsf.JoinPath(path, "affected_packages"), observables)
+	}
+	if v.Cve != nil {
+		observables = v.Cve.AppendObservables(ocsf.JoinPath(path, "cve"), observables)
+	}
+	if v.Cwe != nil {
+		observables = v.Cwe.AppendObservables(ocsf.JoinPath(path, "cwe"), observables)
+	}
+	if v.Remediation != nil {
+		observables = v.Remediation.AppendObservables(ocsf.JoinPath(path, "remediation"), observables)
+	}
+
+	return observables
+}
+
 var VulnerabilityDetailsFields = []arrow.Field{
-	{Name: "advisory", Type: AdvisoryStruct, Nullable: true},
-	{Name: "affected_code", Type: arrow.ListOf(AffectedCodeStruct), Nullable: true},
-	{Name: "affected_packages", Type: arrow.ListOf(AffectedSoftwarePackageStruct), Nullable: true},
-	{Name: "category", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "cve", Type: CVEStruct, Nullable: true},
-	{Name: "cwe", Type: CWEStruct, Nullable: true},
-	{Name: "dependency_chain", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "desc", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "exploit_last_seen_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
-	{Name: "exploit_ref_url", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "exploit_requirement", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "exploit_type", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "first_seen_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
-	{Name: "fix_coverage", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "fix_coverage_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "is_exploit_available", Type: arrow.FixedWidthTypes.Boolean, Nullable: true},
-	{Name: "is_fix_available", Type: arrow.FixedWidthTypes.Boolean, Nullable: true},
-	{Name: "last_seen_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
-	{Name: "references", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true},
-	{Name: "related_vulnerabilities", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true},
-	{Name: "remediation", Type: RemediationStruct, Nullable: true},
-	{Name: "severity", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "title", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "vendor_name", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "advisory", Type: AdvisoryStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Security Advisory", "Detail about the security advisory, that is used to publicly disclose cybersecurity vulnerabilities by a vendor.", "optional", "")},
+	{Name: "affected_code", Type: arrow.ListOf(AffectedCodeStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("Affected Code", "List of Affected Code objects that describe details about code blocks identified as vulnerable.", "optional", "")},
+	{Name: "affected_packages", Type: arrow.ListOf(AffectedSoftwarePackageStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("Affected Software Packages", "List of software packages identified as affected by a vulnerability/vulnerabilities.", "optional", "")},
+	{Name: "category", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Category", "The category of a vulnerability or weakness, as reported by the source tool, such as <code>Container Security</code> or <code>Open Source Security</code>.", "optional", "")},
+	{Name: "cve", Type: CVEStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("CVE", "Describes the Common Vulnerabilities and Exposures <a target='_blank' href='https://cve.mitre.org/'>(CVE)</a> details related to the vulnerability.", "optional", "")},
+	{Name: "cwe", Type: CWEStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("CWE", "Describes the Common Weakness Enumeration <a target='_blank' href='https://cwe.mitre.org/'>(CWE)</a> details related to the vulnerability.", "optional", "")},
+	{Name: "dependency_chain", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Dependency Chain", "Information about the chain of dependencies related to the issue as reported by an Application Security or Vulnerability Management tool. E.g., <code>serverless-offline -> @serverless/utils -> memoizee -> es5-ext</code>.", "optional", "")},
+	{Name: "desc", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Description", "The description of the vulnerability.", "optional", "")},
+	{Name: "exploit_last_seen_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true, Metadata: ocsf.AttributeMetadata("Exploit Last Seen Time", "The time when the exploit was most recently observed.", "optional", "")},
+	{Name: "exploit_ref_url", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Exploit URL", "The URL of the exploit code or Proof-of-Concept (PoC).", "optional", "")},
+	{Name: "exploit_requirement", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Exploit Requirement", "The requirement description related to any constraints around exploit execution.", "optional", "")},
+	{Name: "exploit_type", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Exploit Type", "The categorization or type of Exploit. E.g., <code>Network</code> or <code>Physical</code>.", "optional", "")},
+	{Name: "first_seen_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true, Metadata: ocsf.AttributeMetadata("First Seen", "The time when the vulnerability was first observed.", "optional", "")},
+	{Name: "fix_coverage", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Fix Coverage", "The fix coverage, normalized to the caption of the <code>fix_coverage_id</code> value.", "optional", "")},
+	{Name: "fix_coverage_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Fix Coverage ID", "The normalized identifier for fix coverage, applicable to this vulnerability. Typically useful, when there are multiple affected packages but only a subset have available fixes.", "optional", "")},
+	{Name: "is_exploit_available", Type: arrow.FixedWidthTypes.Boolean, Nullable: true, Metadata: ocsf.AttributeMetadata("Exploit Availability", "Indicates if an exploit or a PoC (proof-of-concept) is available for the reported vulnerability.", "optional", "")},
+	{Name: "is_fix_available", Type: arrow.FixedWidthTypes.Boolean, Nullable: true, Metadata: ocsf.AttributeMetadata("Fix Availability", "Indicates if a fix is available for the reported vulnerability.", "optional", "")},
+	{Name: "last_seen_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true, Metadata: ocsf.AttributeMetadata("Last Seen", "The time when the vulnerability was most recently observed.", "optional", "")},
+	{Name: "references", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true, Metadata: ocsf.AttributeMetadata("References", "A list of reference URLs with additional information about the vulnerability.", "optional", "")},
+	{Name: "related_vulnerabilities", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true, Metadata: ocsf.AttributeMetadata("Related Vulnerability IDs", "List of vulnerability IDs (e.g. CVE ID) that are related to this vulnerability.", "optional", "")},
+	{Name: "remediation", Type: RemediationStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Remediation Guidance", "The remediation recommendations on how to mitigate the identified vulnerability.", "optional", "")},
+	{Name: "severity", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Severity", "The vendor assigned severity of the vulnerability.", "optional", "")},
+	{Name: "title", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Title", "A title or a brief phrase summarizing the discovered vulnerability.", "optional", "")},
+	{Name: "vendor_name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Vendor Name", "The name of the vendor that identified the vulnerability.", "optional", "")},
 }
 
 var VulnerabilityDetailsStruct = arrow.StructOf(VulnerabilityDetailsFields...)
@@ -2,6 +2,7 @@
 package v1_5_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -60,23 +61,295 @@ func (v *WHOIS) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *WHOIS) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *WHOIS) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	if v.AutonomousSystem != nil {
+		errs = append(errs, v.AutonomousSystem.ValidateAt(ocsf.JoinPath(path, "autonomous_system"))...)
+	}
+	for i := range v.DomainContacts {
+		errs = append(errs, v.DomainContacts[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "domain_contacts"), i))...)
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *WHOIS) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "autonomous_system":
+		if index >= 0 || v.AutonomousSystem == nil {
+			return nil, false
+		}
+		if rest == "" {
+			return v.AutonomousSystem, true
+		}
+		return v.AutonomousSystem.Get(rest)
+	case "created_time":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.CreatedTime, true
+	case "dnssec_status":
+		if index >= 0 || rest != "" || v.DnssecStatus == nil {
+			return nil, false
+		}
+		return *v.DnssecStatus, true
+	case "dnssec_status_id":
+		if index >= 0 || rest != "" || v.DnssecStatusId == nil {
+			return nil, false
+		}
+		return *v.DnssecStatusId, true
+	case "domain":
+		if index >= 0 || rest != "" || v.Domain == nil {
+			return nil, false
+		}
+		return *v.Domain, true
+	case "domain_contacts":
+		if rest == "" {
+			if index < 0 {
+				return v.DomainContacts, v.DomainContacts != nil
+			}
+			return ocsf.Index(v.DomainContacts, index)
+		}
+		if index < 0 || index >= len(v.DomainContacts) {
+			return nil, false
+		}
+		return v.DomainContacts[index].Get(rest)
+	case "email_addr":
+		if index >= 0 || rest != "" || v.EmailAddr == nil {
+			return nil, false
+		}
+		return *v.EmailAddr, true
+	case "isp":
+		if index >= 0 || rest != "" || v.Isp == nil {
+			return nil, false
+		}
+		return *v.Isp, true
+	case "isp_org":
+		if index >= 0 || rest != "" || v.IspOrg == nil {
+			return nil, false
+		}
+		return *v.IspOrg, true
+	case "last_seen_time":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.LastSeenTime, true
+	case "name_servers":
+		if rest == "" {
+			if index < 0 {
+				return v.NameServers, v.NameServers != nil
+			}
+			return ocsf.Index(v.NameServers, index)
+		}
+		return nil, false
+	case "phone_number":
+		if index >= 0 || rest != "" || v.PhoneNumber == nil {
+			return nil, false
+		}
+		return *v.PhoneNumber, true
+	case "registrar":
+		if index >= 0 || rest != "" || v.Registrar == nil {
+			return nil, false
+		}
+		return *v.Registrar, true
+	case "status":
+		if index >= 0 || rest != "" || v.Status == nil {
+			return nil, false
+		}
+		return *v.Status, true
+	case "subdomains":
+		if rest == "" {
+			if index < 0 {
+				return v.Subdomains, v.Subdomains != nil
+			}
+			return ocsf.Index(v.Subdomains, index)
+		}
+		return nil, false
+	case "subnet":
+		if index >= 0 || rest != "" || v.Subnet == nil {
+			return nil, false
+		}
+		return *v.Subnet, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *WHOIS) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "autonomous_system":
+		if index >= 0 {
+			return ocsf.UnknownPath(path)
+		}
+		if rest == "" {
+			return ocsf.SetOptional(&v.AutonomousSystem, value)
+		}
+		if v.AutonomousSystem == nil {
+			v.AutonomousSystem = &AutonomousSystem{}
+		}
+		return v.AutonomousSystem.Set(rest, value)
+	case "created_time":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.CreatedTime, value)
+	case "dnssec_status":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.DnssecStatus, value)
+	case "dnssec_status_id":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.DnssecStatusId, value)
+	case "domain":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Domain, value)
+	case "domain_contacts":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.DomainContacts, value)
+		}
+		elem, err := ocsf.Element(&v.DomainContacts, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "email_addr":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.EmailAddr, value)
+	case "isp":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Isp, value)
+	case "isp_org":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.IspOrg, value)
+	case "last_seen_time":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.LastSeenTime, value)
+	case "name_servers":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.NameServers, value)
+		}
+		elem, err := ocsf.Element(&v.NameServers, index)
+		if err != nil {
+			return err
+		}
+		if rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(elem, value)
+	case "phone_number":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.PhoneNumber, value)
+	case "registrar":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Registrar, value)
+	case "status":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Status, value)
+	case "subdomains":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Subdomains, value)
+		}
+		elem, err := ocsf.Element(&v.Subdomains, index)
+		if err != nil {
+			return err
+		}
+		if rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(elem, value)
+	case "subnet":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Subnet, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *WHOIS) AppendObservables(path string, observables []Observable) []Observable {
+	if v.AutonomousSystem != nil {
+		observables = v.AutonomousSystem.AppendObservables(ocsf.JoinPath(path, "autonomous_system"), observables)
+	}
+	for i := range v.DomainContacts {
+		observables = v.DomainContacts[i].AppendObservables(ocsf.JoinPath(path, "domain_contacts"), observables)
+	}
+	if v.EmailAddr != nil {
+		observables = append(observables, Observable{Name: ocsf.Ptr(ocsf.JoinPath(path, "email_addr")), TypeId: 5, Value: ocsf.Ptr(*v.EmailAddr)})
+	}
+
+	return observables
+}
+
 var WHOISFields = []arrow.Field{
-	{Name: "autonomous_system", Type: AutonomousSystemStruct, Nullable: true},
-	{Name: "created_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
-	{Name: "dnssec_status", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "dnssec_status_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "domain", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "domain_contacts", Type: arrow.ListOf(DomainContactStruct), Nullable: true},
-	{Name: "email_addr", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "isp", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "isp_org", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "last_seen_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
-	{Name: "name_servers", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true},
-	{Name: "phone_number", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "registrar", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "status", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "subdomains", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true},
-	{Name: "subnet", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "autonomous_system", Type: AutonomousSystemStruct, Nullable: true, Metadata: ocsf.AttributeMetadata("Autonomous System", "The autonomous system information associated with a domain.", "optional", "")},
+	{Name: "created_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true, Metadata: ocsf.AttributeMetadata("Registered At", "When the domain was registered or WHOIS entry was created.", "optional", "")},
+	{Name: "dnssec_status", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("DNSSEC Status", "The normalized value of dnssec_status_id.", "optional", "")},
+	{Name: "dnssec_status_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("DNSSEC Status ID", "Describes the normalized status of DNS Security Extensions (DNSSEC) for a domain.", "optional", "")},
+	{Name: "domain", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Domain", "The domain name corresponding to the WHOIS record.", "optional", "")},
+	{Name: "domain_contacts", Type: arrow.ListOf(DomainContactStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("Domain Contacts", "An array of <code>Domain Contact</code> objects.", "optional", "")},
+	{Name: "email_addr", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Registrar Abuse Email Address", "The email address for the registrar's abuse contact", "optional", "")},
+	{Name: "isp", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("ISP Name", "The name of the Internet Service Provider (ISP).", "optional", "")},
+	{Name: "isp_org", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("ISP Org", "The organization name of the Internet Service Provider (ISP). This represents the parent organization or company that owns/operates the ISP. For example, Comcast Corporation would be the ISP org for Xfinity internet service. This attribute helps identify the ultimate provider when ISPs operate under different brand names.", "optional", "")},
+	{Name: "last_seen_time", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true, Metadata: ocsf.AttributeMetadata("Last Updated At", "When the WHOIS record was last updated or seen at.", "optional", "")},
+	{Name: "name_servers", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true, Metadata: ocsf.AttributeMetadata("Name Servers", "A collection of name servers related to a domain registration or other record.", "optional", "")},
+	{Name: "phone_number", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Registrar Abuse Phone Number", "The phone number for the registrar's abuse contact", "optional", "")},
+	{Name: "registrar", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Domain Registrar", "The domain registrar.", "optional", "")},
+	{Name: "status", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Domain Status", "The status of a domain and its ability to be transferred, e.g., <code>clientTransferProhibited</code>.", "optional", "")},
+	{Name: "subdomains", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true, Metadata: ocsf.AttributeMetadata("Subdomains", "An array of subdomain strings. Can be used to collect several subdomains such as those from Domain Generation Algorithms (DGAs).", "optional", "")},
+	{Name: "subnet", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Subnet Block", "The IP address block (CIDR) associated with a domain.", "optional", "")},
 }
 
 var WHOISStruct = arrow.StructOf(WHOISFields...)
@@ -2,6 +2,7 @@
 package v1_5_0
 
 import (
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
 	"github.com/apache/arrow-go/v18/arrow"
 )
 
@@ -63,24 +64,291 @@ func (v *WindowsService) Observable() (*int, string) {
 	return nil, ""
 }
 
+func (v *WindowsService) Validate() error {
+	return v.ValidateAt("").Err()
+}
+
+func (v *WindowsService) ValidateAt(path string) ocsf.ValidationErrors {
+	var errs ocsf.ValidationErrors
+	if v.Name == "" {
+		errs.Add(ocsf.JoinPath(path, "name"), "required attribute is missing")
+	}
+	for i := range v.Tags {
+		errs = append(errs, v.Tags[i].ValidateAt(ocsf.IndexPath(ocsf.JoinPath(path, "tags"), i))...)
+	}
+
+	return errs
+}
+
+// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+// path is unknown or the attribute is not set.
+func (v *WindowsService) Get(path string) (any, bool) {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	switch name {
+	case "cmd_line":
+		if index >= 0 || rest != "" || v.CmdLine == nil {
+			return nil, false
+		}
+		return *v.CmdLine, true
+	case "labels":
+		if rest == "" {
+			if index < 0 {
+				return v.Labels, v.Labels != nil
+			}
+			return ocsf.Index(v.Labels, index)
+		}
+		return nil, false
+	case "load_order_group":
+		if index >= 0 || rest != "" || v.LoadOrderGroup == nil {
+			return nil, false
+		}
+		return *v.LoadOrderGroup, true
+	case "name":
+		if index >= 0 || rest != "" {
+			return nil, false
+		}
+		return v.Name, true
+	case "service_category":
+		if index >= 0 || rest != "" || v.ServiceCategory == nil {
+			return nil, false
+		}
+		return *v.ServiceCategory, true
+	case "service_category_id":
+		if index >= 0 || rest != "" || v.ServiceCategoryId == nil {
+			return nil, false
+		}
+		return *v.ServiceCategoryId, true
+	case "service_dependencies":
+		if rest == "" {
+			if index < 0 {
+				return v.ServiceDependencies, v.ServiceDependencies != nil
+			}
+			return ocsf.Index(v.ServiceDependencies, index)
+		}
+		return nil, false
+	case "service_error_control":
+		if index >= 0 || rest != "" || v.ServiceErrorControl == nil {
+			return nil, false
+		}
+		return *v.ServiceErrorControl, true
+	case "service_error_control_id":
+		if index >= 0 || rest != "" || v.ServiceErrorControlId == nil {
+			return nil, false
+		}
+		return *v.ServiceErrorControlId, true
+	case "service_start_name":
+		if index >= 0 || rest != "" || v.ServiceStartName == nil {
+			return nil, false
+		}
+		return *v.ServiceStartName, true
+	case "service_start_type":
+		if index >= 0 || rest != "" || v.ServiceStartType == nil {
+			return nil, false
+		}
+		return *v.ServiceStartType, true
+	case "service_start_type_id":
+		if index >= 0 || rest != "" || v.ServiceStartTypeId == nil {
+			return nil, false
+		}
+		return *v.ServiceStartTypeId, true
+	case "service_type":
+		if index >= 0 || rest != "" || v.ServiceType == nil {
+			return nil, false
+		}
+		return *v.ServiceType, true
+	case "service_type_id":
+		if index >= 0 || rest != "" || v.ServiceTypeId == nil {
+			return nil, false
+		}
+		return *v.ServiceTypeId, true
+	case "tags":
+		if rest == "" {
+			if index < 0 {
+				return v.Tags, v.Tags != nil
+			}
+			return ocsf.Index(v.Tags, index)
+		}
+		if index < 0 || index >= len(v.Tags) {
+			return nil, false
+		}
+		return v.Tags[index].Get(rest)
+	case "uid":
+		if index >= 0 || rest != "" || v.Uid == nil {
+			return nil, false
+		}
+		return *v.Uid, true
+	case "version":
+		if index >= 0 || rest != "" || v.Version == nil {
+			return nil, false
+		}
+		return *v.Version, true
+	}
+
+	return nil, false
+}
+
+// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+// path as needed. Indexing one past the end of a list appends to it.
+func (v *WindowsService) Set(path string, value any) error {
+	name, index, rest, err := ocsf.SplitPath(path)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "cmd_line":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.CmdLine, value)
+	case "labels":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Labels, value)
+		}
+		elem, err := ocsf.Element(&v.Labels, index)
+		if err != nil {
+			return err
+		}
+		if rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(elem, value)
+	case "load_order_group":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.LoadOrderGroup, value)
+	case "name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(&v.Name, value)
+	case "service_category":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.ServiceCategory, value)
+	case "service_category_id":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.ServiceCategoryId, value)
+	case "service_dependencies":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.ServiceDependencies, value)
+		}
+		elem, err := ocsf.Element(&v.ServiceDependencies, index)
+		if err != nil {
+			return err
+		}
+		if rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetRequired(elem, value)
+	case "service_error_control":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.ServiceErrorControl, value)
+	case "service_error_control_id":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.ServiceErrorControlId, value)
+	case "service_start_name":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.ServiceStartName, value)
+	case "service_start_type":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.ServiceStartType, value)
+	case "service_start_type_id":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.ServiceStartTypeId, value)
+	case "service_type":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.ServiceType, value)
+	case "service_type_id":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.ServiceTypeId, value)
+	case "tags":
+		if index < 0 {
+			if rest != "" {
+				return ocsf.UnknownPath(path)
+			}
+			return ocsf.SetRequired(&v.Tags, value)
+		}
+		elem, err := ocsf.Element(&v.Tags, index)
+		if err != nil {
+			return err
+		}
+		if rest == "" {
+			return ocsf.SetRequired(elem, value)
+		}
+		return elem.Set(rest, value)
+	case "uid":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Uid, value)
+	case "version":
+		if index >= 0 || rest != "" {
+			return ocsf.UnknownPath(path)
+		}
+		return ocsf.SetOptional(&v.Version, value)
+	}
+
+	return ocsf.UnknownPath(path)
+}
+
+// AppendObservables appends an observable for every observable attribute and object set on v to
+// observables. path is the dotted attribute path of v within the event.
+func (v *WindowsService) AppendObservables(path string, observables []Observable) []Observable {
+	for i := range v.Tags {
+		observables = v.Tags[i].AppendObservables(ocsf.JoinPath(path, "tags"), observables)
+	}
+
+	return observables
+}
+
 var WindowsServiceFields = []arrow.Field{
-	{Name: "cmd_line", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "labels", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true},
-	{Name: "load_order_group", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: false},
-	{Name: "service_category", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "service_category_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "service_dependencies", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true},
-	{Name: "service_error_control", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "service_error_control_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "service_start_name", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "service_start_type", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "service_start_type_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "service_type", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "service_type_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
-	{Name: "tags", Type: arrow.ListOf(KeyValueobjectStruct), Nullable: true},
-	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true},
-	{Name: "version", Type: arrow.BinaryTypes.String, Nullable: true},
+	{Name: "cmd_line", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Command Line", "The full command line used to launch the service.", "optional", "")},
+	{Name: "labels", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true, Metadata: ocsf.AttributeMetadata("Labels", "The list of labels associated with the service.", "optional", "")},
+	{Name: "load_order_group", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Load Order Group", "The name of the load ordering group of which this service is a member.", "optional", "")},
+	{Name: "name", Type: arrow.BinaryTypes.String, Nullable: false, Metadata: ocsf.AttributeMetadata("Name", "The unique name of the service.", "required", "")},
+	{Name: "service_category", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Service Category", "The service category, normalized to the caption of the service_category_id value. In the case of 'Other', it is defined by the event source.", "optional", "")},
+	{Name: "service_category_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Service Category ID", "The normalized identifier of the service category.", "optional", "")},
+	{Name: "service_dependencies", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true, Metadata: ocsf.AttributeMetadata("Service Dependencies", "The names of other services upon which this service has a dependency.", "optional", "")},
+	{Name: "service_error_control", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Service Error Control", "The service error control, normalized to the caption of the <code>service_error_control_id</code> value. In the case of 'Other', it is defined by the event source.", "optional", "")},
+	{Name: "service_error_control_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Service Error Control ID", "The normalized identifier of the service error control.", "optional", "")},
+	{Name: "service_start_name", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Service Start Name", "For a user mode service, this attribute represents the name of the account under which the service is run. For a kernel mode driver, this attribute represents the object name used to load the driver.", "optional", "")},
+	{Name: "service_start_type", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Service Start Type", "The service start type, normalized to the caption of the <code>service_start_type_id</code> value. In the case of 'Other', it is defined by the event source.", "optional", "")},
+	{Name: "service_start_type_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Service Start Type ID", "The normalized identifier of the service start type.", "optional", "")},
+	{Name: "service_type", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Service Type", "The service type, normalized to the caption of the service_type_id value. In the case of 'Other', it is defined by the event source.", "optional", "")},
+	{Name: "service_type_id", Type: arrow.PrimitiveTypes.Int32, Nullable: true, Metadata: ocsf.AttributeMetadata("Service Type ID", "The normalized identifier of the service type.", "optional", "")},
+	{Name: "tags", Type: arrow.ListOf(KeyValueobjectStruct), Nullable: true, Metadata: ocsf.AttributeMetadata("Tags", "The list of tags; <code>{key:value}</code> pairs associated to the service.", "optional", "")},
+	{Name: "uid", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Unique ID", "The unique identifier of the service.", "optional", "")},
+	{Name: "version", Type: arrow.BinaryTypes.String, Nullable: true, Metadata: ocsf.AttributeMetadata("Version", "The version of the service.", "optional", "")},
 }
 
 var WindowsServiceStruct = arrow.StructOf(WindowsServiceFields...)
@@ -0,0 +1,101 @@
+package ocsf
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// enumOther is the enum value OCSF reserves for source-specific values. Its sibling caption is
+// free-form, so it is never checked against the schema caption.
+const enumOther = 99
+
+// Validator is implemented by every generated class and object. The attributes a class has from a
+// profile are only validated on events which list the profile in metadata.profiles.
+type Validator interface {
+	Validate() error
+}
+
+// ValidationError describes a single schema violation at a dotted attribute path, e.g.
+// "finding_info.uid" or "resources[2].owner.type_id".
+type ValidationError struct {
+	Path    string `json:"path"`
+	Message string `json:"message"`
+}
+
+func (e ValidationError) Error() string {
+	if e.Path == "" {
+		return e.Message
+	}
+	return fmt.Sprintf("%s: %s", e.Path, e.Message)
+}
+
+// ValidationErrors collects every violation found while validating a value.
+type ValidationErrors []ValidationError
+
+func (errs ValidationErrors) Error() string {
+	messages := make([]string, len(errs))
+	for i := range errs {
+		messages[i] = errs[i].Error()
+	}
+	return strings.Join(messages, "; ")
+}
+
+// Add records a violation at path.
+func (errs *ValidationErrors) Add(path, format string, args ...any) {
+	*errs = append(*errs, ValidationError{Path: path, Message: fmt.Sprintf(format, args...)})
+}
+
+// Err returns nil when no violations were collected, so that callers can return it as an error.
+func (errs ValidationErrors) Err() error {
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
+
+// JoinPath appends an attribute name to a dotted attribute path.
+func JoinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+// IndexPath appends a list index to an attribute path.
+func IndexPath(path string, idx int) string {
+	return path + "[" + strconv.Itoa(idx) + "]"
+}
+
+// CheckEnum records a violation when value is not one of the enum values defined by the schema.
+func CheckEnum[T ~int32 | ~int64](errs *ValidationErrors, path string, value T, captions map[T]string) {
+	if _, ok := captions[value]; !ok {
+		errs.Add(path, "value %d is not defined by the schema", value)
+	}
+}
+
+// CheckSibling records a violation when the sibling caption of an enum attribute (e.g. severity
+// for severity_id) does not match the caption defined by the schema. Captions are compared
+// case-insensitively and are not checked for the Other (99) value.
+func CheckSibling[T ~int32 | ~int64](errs *ValidationErrors, path string, value T, sibling *string, captions map[T]string) {
+	caption, ok := captions[value]
+	if !ok || sibling == nil || *sibling == "" || value == enumOther {
+		return
+	}
+
+	if !strings.EqualFold(*sibling, caption) {
+		errs.Add(path, "%q does not match %q defined for value %d", *sibling, caption, value)
+	}
+}
+
+// CountPresent returns the number of true values. Generated code uses it to check the schema's
+// at_least_one and just_one constraints.
+func CountPresent(present ...bool) int {
+	var count int
+	for _, p := range present {
+		if p {
+			count++
+		}
+	}
+	return count
+}
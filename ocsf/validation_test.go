@@ -0,0 +1,93 @@
+package ocsf
+
+import (
+	"errors"
+	"testing"
+)
+
+var severityCaptions = map[int32]string{
+	0:  "Unknown",
+	1:  "Informational",
+	4:  "High",
+	99: "Other",
+}
+
+func TestValidationErrors(t *testing.T) {
+	var errs ValidationErrors
+	if errs.Err() != nil {
+		t.Errorf("got %v for no violations, want nil", errs.Err())
+	}
+
+	errs.Add("finding_info.uid", "required attribute is not set")
+	errs.Add("", "at least one of %s must be set", "name, uid")
+
+	err := errs.Err()
+	var validationErrs ValidationErrors
+	if !errors.As(err, &validationErrs) || len(validationErrs) != 2 {
+		t.Fatalf("got %v, want two violations", err)
+	}
+	if want := "finding_info.uid: required attribute is not set; at least one of name, uid must be set"; err.Error() != want {
+		t.Errorf("got %q, want %q", err.Error(), want)
+	}
+}
+
+func TestCheckEnum(t *testing.T) {
+	var tests = []struct {
+		name  string
+		value int32
+		err   bool
+	}{
+		{name: "Test a defined value", value: 4},
+		{name: "Test the Other value", value: 99},
+		{name: "Test an undefined value", value: 7, err: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var errs ValidationErrors
+			CheckEnum(&errs, "severity_id", tt.value, severityCaptions)
+			if (len(errs) > 0) != tt.err {
+				t.Errorf("got %v, want error %v", errs, tt.err)
+			}
+			if tt.err && errs[0].Path != "severity_id" {
+				t.Errorf("got path %q, want severity_id", errs[0].Path)
+			}
+		})
+	}
+}
+
+func TestCheckSibling(t *testing.T) {
+	var tests = []struct {
+		name    string
+		value   int32
+		sibling *string
+		err     bool
+	}{
+		{name: "Test a matching caption", value: 4, sibling: Ptr("High")},
+		{name: "Test a caption in another case", value: 4, sibling: Ptr("high")},
+		{name: "Test a mismatched caption", value: 4, sibling: Ptr("Low"), err: true},
+		{name: "Test an unset caption", value: 4},
+		{name: "Test an empty caption", value: 4, sibling: Ptr("")},
+		{name: "Test a source caption of Other", value: 99, sibling: Ptr("Vendor Specific")},
+		{name: "Test an undefined value", value: 7, sibling: Ptr("High")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var errs ValidationErrors
+			CheckSibling(&errs, "severity", tt.value, tt.sibling, severityCaptions)
+			if (len(errs) > 0) != tt.err {
+				t.Errorf("got %v, want error %v", errs, tt.err)
+			}
+		})
+	}
+}
+
+func TestCountPresent(t *testing.T) {
+	if got := CountPresent(); got != 0 {
+		t.Errorf("got %d for no values, want 0", got)
+	}
+	if got := CountPresent(true, false, true); got != 2 {
+		t.Errorf("got %d, want 2", got)
+	}
+}
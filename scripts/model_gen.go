@@ -2,22 +2,37 @@ package main
 
 import (
 	"encoding/json"
+	"errors"
+	"flag"
 	"fmt"
-	"go/ast"
-	"go/parser"
-	"go/token"
+	"go/format"
+	"io"
+	"io/fs"
 	"log"
+	"net/http"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"reflect"
 	"regexp"
 	"sort"
+	"strconv"
 	"strings"
+	"sync"
 )
 
 var (
 	refTree        = make(map[string]map[string]bool)
 	refStructsUsed = make(map[string]bool)
+
+	// refAttributes holds the attributes generated as reference structs, as object.attribute. The
+	// attributes of an earlier generation of the package are read from its refsFile first, so that
+	// regenerating a package cuts its cycles where its published types do.
+	refAttributes = make(map[string]bool)
+
+	// structFields holds the fields generated for each struct of the package being generated, by
+	// struct name, so that attribute paths can be listed once every struct is known.
+	structFields = make(map[string][]GeneratedField)
 )
 
 type Observable struct {
@@ -25,44 +40,453 @@ type Observable struct {
 	TypeID int
 }
 
+// GeneratedField describes a struct field emitted for an OCSF attribute. It is collected while
+// generating the struct so that methods can be generated against the final Go types.
+type GeneratedField struct {
+	Name        string
+	Title       string
+	GoType      string
+	Required    bool
+	IsArray     bool
+	IsTimestamp bool
+	IsObject    bool
+	IsRef       bool
+	ArrowType   string
+	Tag         string
+	Attribute   map[string]interface{}
+}
+
 type GenerationSpec struct {
 	Version string
 	Package string
 	Dir     string
+
+	// Profiles lists the OCSF profiles whose attributes are kept in the generated classes.
+	// Attributes of every other profile are stripped unless the schema marks them as required.
+	Profiles []string
+
+	// Extensions lists OCSF schema extensions, either extension source directories or schema
+	// exports, which are merged into the base schema before generating.
+	Extensions []string
+
+	// RefDepth is the number of reference struct levels generated for objects which reference
+	// themselves, e.g. Process -> ProcessRef -> ProcessRef2. The deepest level drops its object
+	// attributes, or keeps them as JSON when RefJSON is set.
+	RefDepth int
+	RefJSON  bool
+
+	// FieldIDClasses lists the classes whose stable Iceberg field IDs are kept in fieldIDsDir.
+	FieldIDClasses []string
+
+	// ExportDir, when set, receives JSON Schema, Protobuf and Avro schemas of every class as
+	// <ExportDir>/<Package>/<format>/<class>.
+	ExportDir string
+}
+
+// refsFile lists the attributes of a generated package which are reference structs, one
+// object.attribute per line, e.g. "ldap_person.manager". It is kept in the package directory.
+const refsFile = "refs.txt"
+
+// fieldIDsDir holds the Iceberg field ID maps of classes, shared by every version and package so
+// that an attribute path keeps its field ID when a class is regenerated.
+const fieldIDsDir = "../ocsf/fieldids"
+
+// Extension describes an OCSF schema extension, as defined by its extension.json.
+type Extension struct {
+	Name    string `json:"name"`
+	Caption string `json:"caption"`
+	UID     int    `json:"uid"`
+	Version string `json:"version"`
 }
 
+// defaultSchemaURL is the OCSF schema server endpoint schema exports are fetched from.
+const defaultSchemaURL = "https://schema.ocsf.io/%s/export/schema"
+
 func main() {
+	versions := flag.String("versions", "1.4.0,1.5.0", "Comma-separated OCSF versions to generate. A version may name its output package, e.g. 1.6.0=v1_6_0")
+	schemaDir := flag.String("schema-dir", "schemas", "Versioned schema cache holding the schema export of each version as <version>/export.json")
+	fetch := flag.Bool("fetch", false, "Download schema exports which are missing from the schema cache")
+	schemaURL := flag.String("schema-url", defaultSchemaURL, "URL template schema exports are fetched from, formatted with the version")
+	profiles := flag.String("profiles", "", "Comma-separated OCSF profiles to include in generated classes, e.g. host,security_control")
+	extensions := flag.String("extensions", "", "Comma-separated OCSF extension directories or schema exports to merge into the extension package")
+	extensionBase := flag.String("extension-base", "1.5.0", "OCSF version the extensions are merged into")
+	refDepth := flag.Int("ref-depth", 1, "Levels of reference structs generated for self-referencing objects, e.g. 3 keeps a process's parent chain three levels deep")
+	refJSON := flag.Bool("ref-json", false, "Keep the object attributes of the deepest reference struct as JSON instead of dropping them")
+	fieldIDClasses := flag.String("field-ids", "api_activity,vulnerability_finding", "Comma-separated classes whose Iceberg field IDs are kept in ocsf/fieldids")
+	exportDir := flag.String("export-dir", "", "Directory to write JSON Schema, Protobuf and Avro schemas of every class to, e.g. ../schemas")
+	extensionPackage := flag.String("extension-package", "", "Package name of the generated extension package, defaults to <base package>_<extension name>")
+	diff := flag.String("diff", "", "Print the attribute changes between two versions instead of generating, e.g. 1.4.0,1.5.0")
+	diffClasses := flag.String("diff-classes", "", "Comma-separated classes to limit -diff to, along with the objects they use")
+	diffJSON := flag.String("diff-json", "", "Also write the -diff report as JSON to this file")
+	flag.Parse()
+
+	var selectedProfiles []string
+	if *profiles != "" {
+		selectedProfiles = strings.Split(*profiles, ",")
+	}
+
+	if *diff != "" {
+		from, to, ok := strings.Cut(*diff, ",")
+		if !ok {
+			log.Fatalf("-diff takes two versions, e.g. 1.4.0,1.5.0")
+		}
+		fromClasses, fromObjects, _, err := loadSanitizedSchema(*schemaDir, *schemaURL, from, *fetch, selectedProfiles)
+		if err != nil {
+			log.Fatalf("Failed to load schema data: %v", err)
+		}
+		toClasses, toObjects, _, err := loadSanitizedSchema(*schemaDir, *schemaURL, to, *fetch, selectedProfiles)
+		if err != nil {
+			log.Fatalf("Failed to load schema data: %v", err)
+		}
+
+		var classNames []string
+		if *diffClasses != "" {
+			classNames = strings.Split(*diffClasses, ",")
+		}
+		report := diffSchemas(from, to, fromClasses, fromObjects, toClasses, toObjects, classNames)
+		writeSchemaDiff(os.Stdout, report)
+
+		if *diffJSON != "" {
+			data, err := json.MarshalIndent(report, "", "  ")
+			if err != nil {
+				log.Fatalf("Failed to marshal diff: %v", err)
+			}
+			if err := os.WriteFile(*diffJSON, append(data, '\n'), 0644); err != nil {
+				log.Fatalf("Failed to write diff: %v", err)
+			}
+		}
+		return
+	}
+
+	var toGenerate []GenerationSpec
+	for _, version := range strings.Split(*versions, ",") {
+		version, packageName, _ := strings.Cut(strings.TrimSpace(version), "=")
+		if packageName == "" {
+			packageName = versionPackage(version)
+		}
+
+		toGenerate = append(toGenerate, GenerationSpec{
+			Version:  version,
+			Package:  packageName,
+			Dir:      "../ocsf/" + packageName,
+			Profiles: selectedProfiles,
+		})
+	}
+
+	if *extensions != "" {
+		extensionSpec, err := extensionGenerationSpec(*extensionBase, *extensionPackage, strings.Split(*extensions, ","), selectedProfiles)
+		if err != nil {
+			log.Fatalf("Failed to load extensions: %v", err)
+		}
+		toGenerate = append(toGenerate, extensionSpec)
+	}
 
-	toGenerate := []GenerationSpec{
-		{
-			Version: "1.4.0",
-			Package: "v1_4_0",
-			Dir:     "../ocsf/v1_4_0",
-		},
-		{
-			Version: "1.5.0",
-			Package: "v1_5_0",
-			Dir:     "../ocsf/v1_5_0",
-		},
+	if *refDepth < 1 {
+		log.Fatalf("-ref-depth must be at least 1")
+	}
+	for i := range toGenerate {
+		toGenerate[i].RefDepth = *refDepth
+		toGenerate[i].RefJSON = *refJSON
+		if *fieldIDClasses != "" {
+			toGenerate[i].FieldIDClasses = strings.Split(*fieldIDClasses, ",")
+		}
+		toGenerate[i].ExportDir = *exportDir
 	}
 
+	generatedClasses := make(map[string]map[string]string)
+	generatedSchemas := make(map[string]sanitizedSchema)
 	for _, genSpec := range toGenerate {
-		schema, err := loadSchema(genSpec.Version)
+		path := schemaPath(*schemaDir, genSpec.Version)
+		if *fetch {
+			err := fetchSchema(*schemaURL, genSpec.Version, path)
+			if err != nil {
+				log.Fatalf("Failed to fetch schema: %v", err)
+			}
+		}
+
+		schema, err := loadSchema(path)
 		if err != nil {
 			log.Fatalf("Failed to load schema data: %v", err)
 		}
-		classes, objects, types := sanitizeSchema(schema)
+		for _, extension := range genSpec.Extensions {
+			err = mergeExtension(schema, extension)
+			if err != nil {
+				log.Fatalf("Failed to merge extension %s: %v", extension, err)
+			}
+		}
+		classes, objects, types := sanitizeSchema(schema, genSpec.Profiles)
 
 		err = os.MkdirAll(genSpec.Dir, 0755)
 		if err != nil {
 			log.Fatalf("Failed to create directory: %v", err)
 		}
 
-		generateSchema(genSpec.Package, genSpec.Dir, classes, objects, types)
+		generateSchema(genSpec, classes, objects, types)
+
+		if len(genSpec.Extensions) == 0 {
+			generatedClasses[genSpec.Package] = classTypes(classes)
+			generatedSchemas[genSpec.Package] = sanitizedSchema{Version: genSpec.Version, Classes: classes, Objects: objects}
+		}
+	}
+
+	err := generateConverters("../ocsf/convert", toGenerate, generatedClasses)
+	if err != nil {
+		log.Fatalf("Failed to generate converters: %v", err)
+	}
+	err = generateMoves("../ocsf/convert", generatedSchemas)
+	if err != nil {
+		log.Fatalf("Failed to generate moves: %v", err)
+	}
+}
+
+// classTypes maps the OCSF class names to the names of their generated Go types.
+func classTypes(classes map[string]interface{}) map[string]string {
+	types := make(map[string]string)
+	for className, class := range classes {
+		types[className] = sanitizeCaption(class.(map[string]interface{})["caption"].(string))
+	}
+	return types
+}
+
+// generateConverters registers upgrade and downgrade conversions between consecutive generated
+// versions for every class the two versions share. Extension packages are not converted.
+func generateConverters(genDir string, specs []GenerationSpec, generatedClasses map[string]map[string]string) error {
+	var versions []GenerationSpec
+	for _, genSpec := range specs {
+		if _, ok := generatedClasses[genSpec.Package]; ok {
+			versions = append(versions, genSpec)
+		}
+	}
+	sort.Slice(versions, func(i, j int) bool {
+		return compareVersions(versions[i].Version, versions[j].Version) < 0
+	})
+
+	var imports, registrations string
+	for i, genSpec := range versions {
+		imports += fmt.Sprintf("\"github.com/Santiago-Labs/go-ocsf/ocsf/%s\"\n", genSpec.Package)
+		if i == 0 {
+			continue
+		}
+
+		older, newer := versions[i-1], genSpec
+		olderClasses, newerClasses := generatedClasses[older.Package], generatedClasses[newer.Package]
+		for _, className := range sortedStringKeys(olderClasses) {
+			newerType, ok := newerClasses[className]
+			if !ok {
+				continue
+			}
+			olderType := olderClasses[className]
+
+			registrations += fmt.Sprintf("register[%s.%s, %s.%s](%q, %q, %q)\n",
+				older.Package, olderType, newer.Package, newerType, className, older.Version, newer.Version)
+			registrations += fmt.Sprintf("register[%s.%s, %s.%s](%q, %q, %q)\n",
+				newer.Package, newerType, older.Package, olderType, className, newer.Version, older.Version)
+		}
+	}
+
+	output := fmt.Sprintf(`// autogenerated by scripts/model_gen.go. DO NOT EDIT
+package convert
+
+import (
+%s)
+
+func init() {
+%s}
+`, imports, registrations)
+
+	err := os.MkdirAll(genDir, 0755)
+	if err != nil {
+		return err
+	}
+
+	filename := genDir + "/convert_gen.go"
+	err = os.WriteFile(filename, []byte(output), 0644)
+	if err != nil {
+		return err
+	}
+
+	cmd := goimportsCmd(filename)
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("failed to gofmt file %s: %v", filename, err)
+	}
+
+	return nil
+}
+
+// sanitizedSchema holds the sanitized classes and objects of a generated version.
+type sanitizedSchema struct {
+	Version string
+	Classes map[string]interface{}
+	Objects map[string]interface{}
+}
+
+// SchemaMove is a class attribute which a newer version replaced by a list of the same object, as
+// the convert package's Move describes it.
+type SchemaMove struct {
+	Class string
+	From  string
+	To    string
+}
+
+// SchemaRemoval is a class attribute which a newer version removed without a list to move it to, as
+// the convert package's Removal describes it.
+type SchemaRemoval struct {
+	Class     string
+	Attribute string
+}
+
+// schemaMoves finds the class attributes which were removed or deprecated in the newer version. An
+// attribute of an object moves to the list of the same object which replaces it: a list attribute
+// of the class, or else a list attribute of one of the objects the class has. Attributes without a
+// single such list are returned as removals, which fail upgrades rather than being dropped.
+// Renames are not detected: a renamed attribute is a removal and an addition.
+func schemaMoves(from, to sanitizedSchema) ([]SchemaMove, []SchemaRemoval) {
+	var moves []SchemaMove
+	var removals []SchemaRemoval
+	for _, change := range diffDefinitions("class", from.Classes, to.Classes, nil) {
+		if change.Attribute == "" || change.Change != "removed" {
+			continue
+		}
+
+		if list, ok := moveTarget(from, to, change.Name, change.Attribute); ok {
+			moves = append(moves, SchemaMove{Class: change.Name, From: change.Attribute, To: list + "[]"})
+			continue
+		}
+		removals = append(removals, SchemaRemoval{Class: change.Name, Attribute: change.Attribute})
+	}
+	return moves, removals
+}
+
+// moveTarget returns the single list of the newer version which replaces a removed class attribute
+// of an object.
+func moveTarget(from, to sanitizedSchema, class, attribute string) (string, bool) {
+	fromAttributes := from.Classes[class].(map[string]interface{})["attributes"].(map[string]interface{})
+	objectType := attributeType(fromAttributes[attribute].(map[string]interface{}))
+	if _, ok := to.Objects[objectType]; !ok {
+		return "", false
+	}
+
+	toAttributes := to.Classes[class].(map[string]interface{})["attributes"].(map[string]interface{})
+	candidates := listAttributes(toAttributes, objectType)
+	if len(candidates) == 0 {
+		for _, name := range sortedKeys(toAttributes) {
+			object, ok := to.Objects[attributeType(toAttributes[name].(map[string]interface{}))].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			for _, list := range listAttributes(object["attributes"].(map[string]interface{}), objectType) {
+				candidates = append(candidates, name+"."+list)
+			}
+		}
+	}
+	if len(candidates) != 1 {
+		return "", false
+	}
+	return candidates[0], true
+}
+
+// listAttributes returns the attributes which are lists of objectType, sorted by name.
+func listAttributes(attributes map[string]interface{}, objectType string) []string {
+	var names []string
+	for _, name := range sortedKeys(attributes) {
+		if attributeType(attributes[name].(map[string]interface{})) == objectType+"[]" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// movesSource returns the source of the moves and removals tables of the convert package, which
+// list the changes between every two consecutive versions by the newer one.
+func movesSource(schemas map[string]sanitizedSchema) ([]byte, error) {
+	var versions []sanitizedSchema
+	for _, schema := range schemas {
+		versions = append(versions, schema)
+	}
+	sort.Slice(versions, func(i, j int) bool {
+		return compareVersions(versions[i].Version, versions[j].Version) < 0
+	})
+
+	var moveEntries, removalEntries string
+	for i := 1; i < len(versions); i++ {
+		moves, removals := schemaMoves(versions[i-1], versions[i])
+		if len(moves) > 0 {
+			moveEntries += fmt.Sprintf("%q: {\n", versions[i].Version)
+			for _, move := range moves {
+				moveEntries += fmt.Sprintf("{Class: %q, From: %q, To: %q},\n", move.Class, move.From, move.To)
+			}
+			moveEntries += "},\n"
+		}
+		if len(removals) > 0 {
+			removalEntries += fmt.Sprintf("%q: {\n", versions[i].Version)
+			for _, removal := range removals {
+				removalEntries += fmt.Sprintf("{Class: %q, Attribute: %q},\n", removal.Class, removal.Attribute)
+			}
+			removalEntries += "},\n"
+		}
+	}
+
+	output := fmt.Sprintf(`// autogenerated by scripts/model_gen.go. DO NOT EDIT
+package convert
+
+// moves lists the attributes which moved in each OCSF version, keyed by the version they moved in.
+var moves = map[string][]Move{
+%s}
+
+// removals lists the class attributes which were removed without a replacement in each OCSF
+// version, keyed by the version they were removed in.
+var removals = map[string][]Removal{
+%s}
+`, moveEntries, removalEntries)
+	return format.Source([]byte(output))
+}
+
+// generateMoves writes the moves and removals between the generated versions to moves_gen.go.
+func generateMoves(genDir string, schemas map[string]sanitizedSchema) error {
+	output, err := movesSource(schemas)
+	if err != nil {
+		return fmt.Errorf("failed to format moves: %v", err)
+	}
+
+	err = os.MkdirAll(genDir, 0755)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(genDir+"/moves_gen.go", output, 0644)
+}
+
+func compareVersions(a, b string) int {
+	aParts, bParts := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(aParts) && i < len(bParts); i++ {
+		aPart, _ := strconv.Atoi(aParts[i])
+		bPart, _ := strconv.Atoi(bParts[i])
+		if aPart != bPart {
+			return aPart - bPart
+		}
+	}
+	return len(aParts) - len(bParts)
+}
+
+func sortedStringKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
 	}
+	sort.Strings(keys)
+	return keys
 }
 
-func generateSchema(packageName, genDir string, classes, objects, types map[string]interface{}) {
+func generateSchema(genSpec GenerationSpec, classes, objects, types map[string]interface{}) {
+	refTree = make(map[string]map[string]bool)
+	refStructsUsed = make(map[string]bool)
+	structFields = make(map[string][]GeneratedField)
+
+	var err error
+	refAttributes, err = readRefs(filepath.Join(genSpec.Dir, refsFile))
+	if err != nil {
+		log.Fatalf("Failed to read reference attributes: %v", err)
+	}
+
 	for _, class := range sortedKeys(classes) {
 		visited := make(map[string]bool)
 		observables, err := resolveObservables(classes[class].(map[string]interface{}), objects, visited)
@@ -71,12 +495,12 @@ func generateSchema(packageName, genDir string, classes, objects, types map[stri
 		}
 
 		err = generateGoStruct(
-			packageName,
-			genDir,
+			genSpec,
 			classes[class].(map[string]interface{}),
 			objects,
 			types,
 			observables,
+			true,
 		)
 		if err != nil {
 			log.Fatalf("Failed to generate Go struct: %v", err)
@@ -85,340 +509,2664 @@ func generateSchema(packageName, genDir string, classes, objects, types map[stri
 
 	for _, object := range sortedKeys(objects) {
 		err := generateGoStruct(
-			packageName,
-			genDir,
+			genSpec,
 			objects[object].(map[string]interface{}),
 			objects,
 			types,
 			[]Observable{}, // observables should only be generated for classes.
+			false,
 		)
 		if err != nil {
 			log.Fatalf("Failed to generate Go struct: %v", err)
 		}
 	}
 
-	for usedRefStruct := range refStructsUsed {
-		err := generateRefStructs(genDir, objects[usedRefStruct].(map[string]interface{}))
-		if err != nil {
-			log.Fatalf("Failed to generate ref struct: %v", err)
-		}
+	err = generateRefStructs(genSpec, objects)
+	if err != nil {
+		log.Fatalf("Failed to generate ref struct: %v", err)
+	}
+
+	err = writeRefs(filepath.Join(genSpec.Dir, refsFile))
+	if err != nil {
+		log.Fatalf("Failed to write reference attributes: %v", err)
+	}
+
+	err = generatePaths(genSpec, classes)
+	if err != nil {
+		log.Fatalf("Failed to generate attribute paths: %v", err)
+	}
+
+	err = generateFieldIDs(genSpec, classes)
+	if err != nil {
+		log.Fatalf("Failed to generate field IDs: %v", err)
+	}
+
+	err = generateEvents(genSpec, classes)
+	if err != nil {
+		log.Fatalf("Failed to generate events: %v", err)
+	}
+
+	if genSpec.ExportDir != "" {
+		err = generateExports(genSpec, classes)
+		if err != nil {
+			log.Fatalf("Failed to export schemas: %v", err)
+		}
+	}
+
+	err = generateDecoder(genSpec, classes)
+	if err != nil {
+		log.Fatalf("Failed to generate decoder: %v", err)
+	}
+}
+
+// eventGetters maps the attributes shared by every class to the getters of the Event interface. The
+// getters are named after the attributes with a Get prefix, as the attributes' fields already use
+// their plain names.
+var eventGetters = map[string]string{
+	"activity_id":  "GetActivityId",
+	"category_uid": "GetCategoryUid",
+	"class_uid":    "GetClassUid",
+	"metadata":     "GetMetadata",
+	"severity_id":  "GetSeverityId",
+	"time":         "GetTime",
+	"type_uid":     "GetTypeUid",
+}
+
+// generateGetter generates a getter returning the value of a field, or its zero value when an
+// optional field is not set. Objects are returned as pointers.
+func generateGetter(structName, method string, field GeneratedField) string {
+	ref := "v." + field.Title
+	goType := strings.TrimPrefix(field.GoType, "*")
+
+	var body string
+	switch {
+	case field.IsObject && strings.HasPrefix(field.GoType, "*"):
+		body = "return " + ref
+	case field.IsObject:
+		body = "return &" + ref
+	case strings.HasPrefix(field.GoType, "*"):
+		body = fmt.Sprintf("if %s == nil {\nreturn %s\n}\nreturn *%s", ref, zeroValue(goType), ref)
+	default:
+		body = "return " + ref
+	}
+	if field.IsObject {
+		goType = "*" + goType
+	}
+
+	return fmt.Sprintf("func (v *%s) %s() %s {\n%s\n}\n\n", structName, method, goType, body)
+}
+
+// findField returns the generated field of a struct for an attribute.
+func findField(structName, attribute string) (GeneratedField, bool) {
+	for _, field := range structFields[structName] {
+		if field.Name == attribute {
+			return field, true
+		}
+	}
+	return GeneratedField{}, false
+}
+
+// eventClassNames returns the names of the classes which implement the Event interface, i.e. which
+// have every attribute the interface reads.
+func eventClassNames(classes map[string]interface{}) []string {
+	var names []string
+	for _, className := range sortedKeys(classes) {
+		caption := sanitizeCaption(classes[className].(map[string]interface{})["caption"].(string))
+
+		implements := true
+		for attribute := range eventGetters {
+			field, ok := findField(caption, attribute)
+			implements = implements && ok && !field.IsArray
+		}
+		if implements {
+			names = append(names, className)
+		}
+	}
+	return names
+}
+
+// findingInfoField returns the finding_info field of a class of the Findings category.
+func findingInfoField(class map[string]interface{}) (GeneratedField, bool) {
+	if class["category"] != "findings" {
+		return GeneratedField{}, false
+	}
+
+	field, ok := findField(sanitizeCaption(class["caption"].(string)), "finding_info")
+	if !ok || !field.IsObject || field.IsRef || field.IsArray {
+		return GeneratedField{}, false
+	}
+	return field, true
+}
+
+// generateEvents writes events.go, which declares the Event and Finding interfaces of the package
+// and implements them on every class.
+func generateEvents(genSpec GenerationSpec, classes map[string]interface{}) error {
+	var assertions, methods, classList, findingInfoType string
+	for _, className := range eventClassNames(classes) {
+		class := classes[className].(map[string]interface{})
+		caption := sanitizeCaption(class["caption"].(string))
+
+		assertions += fmt.Sprintf("_ Event = (*%s)(nil)\n", caption)
+		classList += fmt.Sprintf("new(%s).OCSFClass(),\n", caption)
+		methods += fmt.Sprintf(`func (v *%s) OCSFClass() ocsf.Class {
+			return ocsf.Class{Name: %sClassname, Package: %q, Version: %q, Schema: %sSchema}
+		}
+
+		`, caption, caption, genSpec.Package, genSpec.Version, caption)
+
+		for _, attribute := range sortedStringKeys(eventGetters) {
+			field, _ := findField(caption, attribute)
+			methods += generateGetter(caption, eventGetters[attribute], field)
+		}
+
+		findingInfo, ok := findingInfoField(class)
+		if !ok {
+			continue
+		}
+		findingInfoType = strings.TrimPrefix(findingInfo.GoType, "*")
+		assertions += fmt.Sprintf("_ FindingEvent = (*%s)(nil)\n", caption)
+		methods += generateGetter(caption, "GetFindingInfo", findingInfo)
+
+		uid, ok := findField(findingInfoType, "uid")
+		if !ok || uid.IsArray || strings.TrimPrefix(uid.GoType, "*") != "string" {
+			return fmt.Errorf("finding_info of %s has no string uid", className)
+		}
+		value, unset := "v.GetFindingInfo()."+uid.Title, `""`
+		if strings.HasPrefix(uid.GoType, "*") {
+			value, unset = "*"+value, "nil"
+		}
+		methods += fmt.Sprintf(`func (v *%s) GetFindingUid() string {
+			if v.GetFindingInfo() == nil || v.GetFindingInfo().%s == %s {
+				return ""
+			}
+			return %s
+		}
+
+		`, caption, uid.Title, unset, value)
+	}
+
+	var finding string
+	if findingInfoType != "" {
+		finding = fmt.Sprintf(`
+		// FindingEvent is implemented by every class of the Findings category. It is not named Finding,
+		// which is the generated finding object.
+		type FindingEvent interface {
+			Event
+			ocsf.Finding
+			GetFindingInfo() *%s
+		}
+		`, findingInfoType)
+	}
+
+	output := fmt.Sprintf(`// autogenerated by scripts/model_gen.go. DO NOT EDIT
+package %s
+
+// Event is implemented by every class.
+type Event interface {
+	ocsf.Event
+	GetMetadata() *Metadata
+}
+%s
+var (
+	%s)
+
+// Classes describes every class of the package.
+func Classes() []ocsf.Class {
+	return []ocsf.Class{
+		%s}
+}
+
+%s`, genSpec.Package, finding, assertions, classList, methods)
+
+	return writeGoFile(genSpec.Dir+"/events.go", output)
+}
+
+// generateDecoder writes decode.go, which decodes raw OCSF JSON into the class named by its
+// class_uid.
+func generateDecoder(genSpec GenerationSpec, classes map[string]interface{}) error {
+	var registry string
+	for _, className := range eventClassNames(classes) {
+		class := classes[className].(map[string]interface{})
+		uid, ok := class["uid"].(float64)
+		if !ok {
+			continue
+		}
+		caption := sanitizeCaption(class["caption"].(string))
+		registry += fmt.Sprintf("%d: func() Event { return &%s{} },\n", int(uid), caption)
+	}
+
+	output := fmt.Sprintf(`// autogenerated by scripts/model_gen.go. DO NOT EDIT
+package %s
+
+// eventClasses creates an empty event of each class by class_uid.
+var eventClasses = map[int32]func() Event{
+	%s}
+
+// Decode decodes a raw OCSF event into the class named by its class_uid, e.g. an *APIActivity for
+// class_uid 6003.
+func Decode(data []byte) (Event, error) {
+	classUID, err := ocsf.ClassUID(data)
+	if err != nil {
+		return nil, err
+	}
+
+	newEvent, ok := eventClasses[classUID]
+	if !ok {
+		return nil, fmt.Errorf("unknown class_uid %%d", classUID)
+	}
+
+	event := newEvent()
+	if err := json.Unmarshal(data, event); err != nil {
+		return nil, fmt.Errorf("failed to decode class_uid %%d: %%w", classUID, err)
+	}
+	return event, nil
+}
+
+// NewDecoder returns a decoder reading a stream of OCSF events, such as newline-delimited JSON,
+// from r. Its Decode method returns io.EOF once the stream is exhausted.
+func NewDecoder(r io.Reader) *ocsf.Decoder[Event] {
+	return ocsf.NewDecoder(r, Decode)
+}
+`, genSpec.Package, registry)
+
+	return writeGoFile(genSpec.Dir+"/decode.go", output)
+}
+
+// goimportsPath returns the path of the goimports binary which go.mod declares as a tool, building
+// it once, so that the generator does not depend on an installed goimports.
+var goimportsPath = sync.OnceValues(func() (string, error) {
+	output, err := exec.Command("go", "tool", "-n", "goimports").Output()
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(output)), nil
+})
+
+// goimportsCmd returns the command formatting filename with goimports. It runs from the file's
+// directory, so that imports are resolved in the module the file belongs to. When the binary cannot
+// be built in advance, the tool is run through go tool, which reports why.
+func goimportsCmd(filename string) *exec.Cmd {
+	cmd := exec.Command("go", "tool", "goimports", "-w", filepath.Base(filename))
+	if path, err := goimportsPath(); err == nil {
+		cmd = exec.Command(path, "-w", filepath.Base(filename))
+	}
+	cmd.Dir = filepath.Dir(filename)
+	return cmd
+}
+
+// writeGoFile writes a generated Go file and formats it with goimports.
+func writeGoFile(filename, output string) error {
+	if err := os.WriteFile(filename, []byte(output), 0644); err != nil {
+		return err
+	}
+
+	cmd := goimportsCmd(filename)
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("failed to gofmt file %s: %v", filename, err)
+	}
+
+	return nil
+}
+
+// generatePaths writes paths.go, which lists the attribute paths of every class. List attributes
+// are written with "[]", e.g. "resources[].owner.name", and take an index in Get and Set. References
+// which would recurse into an enclosing object are listed but not expanded.
+func generatePaths(genSpec GenerationSpec, classes map[string]interface{}) error {
+	var paths string
+	for _, className := range sortedKeys(classes) {
+		caption := sanitizeCaption(classes[className].(map[string]interface{})["caption"].(string))
+		if _, ok := structFields[caption]; !ok {
+			continue
+		}
+
+		paths += fmt.Sprintf("\n// %sPaths lists the attribute paths of %s, for use with Get and Set.\n", caption, caption)
+		paths += fmt.Sprintf("var %sPaths = []string{\n", caption)
+		for _, path := range structPaths(caption, "", map[string]bool{}) {
+			paths += fmt.Sprintf("%q,\n", path)
+		}
+		paths += "}\n"
+	}
+
+	output := fmt.Sprintf("// autogenerated by scripts/model_gen.go. DO NOT EDIT\npackage %s\n%s", genSpec.Package, paths)
+	return writeGoFile(genSpec.Dir+"/paths.go", output)
+}
+
+// structPaths returns the attribute paths of a generated struct below prefix. visiting holds the
+// structs being expanded, which are not expanded again.
+func structPaths(structName, prefix string, visiting map[string]bool) []string {
+	visiting[structName] = true
+	defer delete(visiting, structName)
+
+	var paths []string
+	for _, field := range structFields[structName] {
+		path := prefix + field.Name
+		paths = append(paths, path)
+		if field.IsArray {
+			path += "[]"
+		}
+
+		childName := strings.TrimPrefix(strings.TrimPrefix(field.GoType, "[]"), "*")
+		if !field.IsObject || field.IsRef || visiting[childName] {
+			continue
+		}
+		paths = append(paths, structPaths(childName, path+".", visiting)...)
+	}
+
+	return paths
+}
+
+// generateFieldIDs adds the column paths of genSpec.FieldIDClasses which are missing from their
+// field ID maps, numbering them after the highest field ID of the map. A map starts out numbered
+// like the fresh IDs Iceberg catalogs assign, and IDs are never reused, so the same column path
+// keeps its field ID across regenerations and versions.
+func generateFieldIDs(genSpec GenerationSpec, classes map[string]interface{}) error {
+	for _, className := range genSpec.FieldIDClasses {
+		class, ok := classes[className].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		path := filepath.Join(fieldIDsDir, className+".txt")
+		ids, err := readFieldIDs(path)
+		if err != nil {
+			return err
+		}
+
+		var maxID int
+		for _, id := range ids {
+			maxID = max(maxID, id)
+		}
+
+		var added string
+		for _, columnPath := range columnPaths(sanitizeCaption(class["caption"].(string)), "") {
+			if _, ok := ids[columnPath]; ok {
+				continue
+			}
+			maxID++
+			ids[columnPath] = maxID
+			added += fmt.Sprintf("%d %s\n", maxID, columnPath)
+		}
+		if added == "" {
+			continue
+		}
+
+		if err := os.MkdirAll(fieldIDsDir, 0755); err != nil {
+			return err
+		}
+		f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+		if err != nil {
+			return err
+		}
+		_, err = f.WriteString(added)
+		f.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// readFieldIDs reads a field ID map, which holds a field ID and a column path per line.
+// readRefs reads the reference attributes of a refsFile, which may not exist yet.
+func readRefs(path string) (map[string]bool, error) {
+	refs := make(map[string]bool)
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return refs, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for _, line := range strings.Split(strings.TrimSpace(string(data)), "\n") {
+		if line == "" {
+			continue
+		}
+		if _, _, ok := strings.Cut(line, "."); !ok {
+			return nil, fmt.Errorf("invalid reference attribute %q in %s", line, path)
+		}
+		refs[line] = true
+	}
+
+	return refs, nil
+}
+
+// writeRefs writes the reference attributes of the generated package to a refsFile.
+func writeRefs(path string) error {
+	var lines string
+	for _, attribute := range sortedBoolKeys(refAttributes) {
+		lines += attribute + "\n"
+	}
+	return os.WriteFile(path, []byte(lines), 0644)
+}
+
+func sortedBoolKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func readFieldIDs(path string) (map[string]int, error) {
+	ids := make(map[string]int)
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return ids, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for _, line := range strings.Split(strings.TrimSpace(string(data)), "\n") {
+		if line == "" {
+			continue
+		}
+		id, columnPath, ok := strings.Cut(line, " ")
+		n, err := strconv.Atoi(id)
+		if !ok || err != nil {
+			return nil, fmt.Errorf("invalid field ID line %q in %s", line, path)
+		}
+		ids[columnPath] = n
+	}
+
+	return ids, nil
+}
+
+// columnPaths returns the column paths of a generated struct below prefix in the order of its Arrow
+// fields, which is the order Iceberg catalogs assign fresh field IDs in. List elements are named
+// with a "[]" suffix, e.g. "resources[]", and reference structs are expanded like the Arrow types.
+func columnPaths(structName, prefix string) []string {
+	var paths []string
+	for _, field := range structFields[structName] {
+		path := prefix + field.Name
+		paths = append(paths, path)
+		if field.IsArray {
+			path += "[]"
+			paths = append(paths, path)
+		}
+
+		childName := strings.TrimPrefix(strings.TrimPrefix(field.GoType, "[]"), "*")
+		if !field.IsObject || structFields[childName] == nil {
+			continue
+		}
+		paths = append(paths, columnPaths(childName, path+".")...)
+	}
+
+	return paths
+}
+
+// generateExports writes a JSON Schema, a Protobuf and an Avro schema of every class to
+// genSpec.ExportDir. They are built from the generated fields, so they describe exactly what the
+// generated structs write, after profile stripping and reference struct truncation.
+func generateExports(genSpec GenerationSpec, classes map[string]interface{}) error {
+	dir := filepath.Join(genSpec.ExportDir, genSpec.Package)
+	for _, format := range []string{"jsonschema", "proto", "avro"} {
+		if err := os.MkdirAll(filepath.Join(dir, format), 0755); err != nil {
+			return err
+		}
+	}
+
+	for _, className := range sortedKeys(classes) {
+		class := classes[className].(map[string]interface{})
+		structName := sanitizeCaption(class["caption"].(string))
+		if _, ok := structFields[structName]; !ok {
+			continue
+		}
+		description, _ := class["description"].(string)
+
+		jsonSchema, err := json.MarshalIndent(classJSONSchema(genSpec, className, structName, description), "", "  ")
+		if err != nil {
+			return err
+		}
+		avroSchema, err := json.MarshalIndent(avroRecord(genSpec, structName, description, make(map[string]bool)), "", "  ")
+		if err != nil {
+			return err
+		}
+
+		files := map[string]string{
+			filepath.Join(dir, "jsonschema", className+".schema.json"): string(jsonSchema) + "\n",
+			filepath.Join(dir, "proto", className+".proto"):            classProto(genSpec, structName, description),
+			filepath.Join(dir, "avro", className+".avsc"):              string(avroSchema) + "\n",
+		}
+		for _, filename := range sortedStringKeys(files) {
+			if err := os.WriteFile(filename, []byte(files[filename]), 0644); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// fieldStruct returns the generated struct an object field holds, or an empty string for scalar
+// fields.
+func fieldStruct(field GeneratedField) string {
+	name := strings.TrimPrefix(strings.TrimPrefix(field.GoType, "[]"), "*")
+	if !field.IsObject || structFields[name] == nil {
+		return ""
+	}
+	return name
+}
+
+// fieldDoc returns the caption and description of a generated field on one line.
+func fieldDoc(field GeneratedField) string {
+	caption, _ := field.Attribute["caption"].(string)
+	description, _ := field.Attribute["description"].(string)
+	return oneLine(caption + ": " + description)
+}
+
+func oneLine(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// classJSONSchema returns a JSON Schema of a class, with the objects it holds as $defs.
+func classJSONSchema(genSpec GenerationSpec, className, structName, description string) map[string]interface{} {
+	defs := make(map[string]interface{})
+	var pending []string
+	schema := jsonSchemaObject(structName, &pending)
+	for len(pending) > 0 {
+		name := pending[0]
+		pending = pending[1:]
+		if _, ok := defs[name]; ok {
+			continue
+		}
+		defs[name] = jsonSchemaObject(name, &pending)
+	}
+
+	schema["$schema"] = "https://json-schema.org/draft/2020-12/schema"
+	schema["$id"] = fmt.Sprintf("https://github.com/Santiago-Labs/go-ocsf/%s/%s.schema.json", genSpec.Package, className)
+	schema["title"] = structName
+	schema["description"] = oneLine(description)
+	if len(defs) > 0 {
+		schema["$defs"] = defs
+	}
+	return schema
+}
+
+// jsonSchemaObject returns the JSON Schema of a generated struct, adding the structs it refers to
+// to pending.
+func jsonSchemaObject(structName string, pending *[]string) map[string]interface{} {
+	properties := make(map[string]interface{})
+	required := []string{}
+	for _, field := range structFields[structName] {
+		var property map[string]interface{}
+		if child := fieldStruct(field); child != "" {
+			property = map[string]interface{}{"$ref": "#/$defs/" + child}
+			*pending = append(*pending, child)
+		} else {
+			property = jsonSchemaScalar(field)
+		}
+		if field.IsArray {
+			property = map[string]interface{}{"type": "array", "items": property}
+		}
+		property["description"] = fieldDoc(field)
+
+		properties[field.Name] = property
+		if field.Required {
+			required = append(required, field.Name)
+		}
+	}
+
+	return map[string]interface{}{
+		"type":                 "object",
+		"properties":           properties,
+		"required":             required,
+		"additionalProperties": false,
+	}
+}
+
+func jsonSchemaScalar(field GeneratedField) map[string]interface{} {
+	switch strings.TrimPrefix(strings.TrimPrefix(field.GoType, "[]"), "*") {
+	case "string":
+		return map[string]interface{}{"type": "string"}
+	case "int32", "int64":
+		return map[string]interface{}{"type": "integer"}
+	case "float64":
+		return map[string]interface{}{"type": "number"}
+	case "bool":
+		return map[string]interface{}{"type": "boolean"}
+	default:
+		// JSON attributes hold any JSON value.
+		return map[string]interface{}{}
+	}
+}
+
+// classProto returns a Protobuf file of a class, with the objects it holds as nested messages.
+// Field numbers follow the attribute order of the version, so they are only stable within a
+// version.
+func classProto(genSpec GenerationSpec, structName, description string) string {
+	var nested []string
+	seen := map[string]bool{structName: true}
+	pending := []string{structName}
+	for len(pending) > 0 {
+		name := pending[0]
+		pending = pending[1:]
+		for _, field := range structFields[name] {
+			if child := fieldStruct(field); child != "" && !seen[child] {
+				seen[child] = true
+				nested = append(nested, child)
+				pending = append(pending, child)
+			}
+		}
+	}
+
+	output := fmt.Sprintf("// autogenerated by scripts/model_gen.go. DO NOT EDIT\nsyntax = \"proto3\";\n\npackage ocsf.%s;\n\n", genSpec.Package)
+	if description != "" {
+		output += fmt.Sprintf("// %s\n", oneLine(description))
+	}
+	output += fmt.Sprintf("message %s {\n", structName)
+	for _, name := range nested {
+		output += protoMessage(name, "  ")
+	}
+	output += protoFields(structName, "  ")
+	output += "}\n"
+	return output
+}
+
+func protoMessage(structName, indent string) string {
+	return fmt.Sprintf("%smessage %s {\n%s%s}\n\n", indent, structName, protoFields(structName, indent+"  "), indent)
+}
+
+func protoFields(structName, indent string) string {
+	var output string
+	for i, field := range structFields[structName] {
+		protoType := fieldStruct(field)
+		if protoType == "" {
+			switch strings.TrimPrefix(strings.TrimPrefix(field.GoType, "[]"), "*") {
+			case "int32":
+				protoType = "int32"
+			case "int64":
+				protoType = "int64"
+			case "float64":
+				protoType = "double"
+			case "bool":
+				protoType = "bool"
+			default:
+				// Strings, and JSON attributes as their encoding.
+				protoType = "string"
+			}
+		}
+
+		label := ""
+		switch {
+		case field.IsArray:
+			label = "repeated "
+		case !field.Required && fieldStruct(field) == "":
+			label = "optional "
+		}
+
+		output += fmt.Sprintf("%s// %s\n%s%s%s %s = %d;\n", indent, fieldDoc(field), indent, label, protoType, field.Name, i+1)
+	}
+	return output
+}
+
+type avroSchemaRecord struct {
+	Type      string            `json:"type"`
+	Name      string            `json:"name"`
+	Namespace string            `json:"namespace,omitempty"`
+	Doc       string            `json:"doc,omitempty"`
+	Fields    []avroSchemaField `json:"fields"`
+}
+
+type avroSchemaField struct {
+	Name    string          `json:"name"`
+	Type    interface{}     `json:"type"`
+	Doc     string          `json:"doc,omitempty"`
+	Default json.RawMessage `json:"default,omitempty"`
+}
+
+// avroRecord returns the Avro record of a generated struct. Records are defined where they are
+// first used and referred to by name afterwards, as Avro requires.
+func avroRecord(genSpec GenerationSpec, structName, description string, defined map[string]bool) avroSchemaRecord {
+	defined[structName] = true
+	record := avroSchemaRecord{
+		Type:      "record",
+		Name:      structName,
+		Namespace: "ocsf." + genSpec.Package,
+		Doc:       oneLine(description),
+		Fields:    []avroSchemaField{},
+	}
+
+	for _, field := range structFields[structName] {
+		var avroType interface{}
+		if child := fieldStruct(field); child != "" {
+			if defined[child] {
+				avroType = child
+			} else {
+				avroType = avroRecord(genSpec, child, "", defined)
+			}
+		} else {
+			avroType = avroScalar(field)
+		}
+		if field.IsArray {
+			avroType = map[string]interface{}{"type": "array", "items": avroType}
+		}
+
+		avroField := avroSchemaField{Name: field.Name, Type: avroType, Doc: fieldDoc(field)}
+		if !field.Required {
+			avroField.Type = []interface{}{"null", avroType}
+			avroField.Default = json.RawMessage("null")
+		}
+		record.Fields = append(record.Fields, avroField)
+	}
+
+	return record
+}
+
+func avroScalar(field GeneratedField) interface{} {
+	switch {
+	case field.IsTimestamp:
+		return map[string]interface{}{"type": "long", "logicalType": "timestamp-millis"}
+	case field.Attribute["type"] == "date_t":
+		return map[string]interface{}{"type": "int", "logicalType": "date"}
+	}
+
+	switch strings.TrimPrefix(strings.TrimPrefix(field.GoType, "[]"), "*") {
+	case "int32":
+		return "int"
+	case "int64":
+		return "long"
+	case "float64":
+		return "double"
+	case "bool":
+		return "boolean"
+	default:
+		// Strings, and JSON attributes as their encoding.
+		return "string"
+	}
+}
+
+// SchemaDiff lists the attribute changes of classes and objects between two schema versions.
+type SchemaDiff struct {
+	From    string         `json:"from"`
+	To      string         `json:"to"`
+	Changes []SchemaChange `json:"changes"`
+}
+
+// SchemaChange is a change of a class or object, or of one of its attributes. Change is one of
+// added, removed, retyped or requirement; From and To hold the type or requirement.
+type SchemaChange struct {
+	Kind      string `json:"kind"`
+	Name      string `json:"name"`
+	Attribute string `json:"attribute,omitempty"`
+	Change    string `json:"change"`
+	From      string `json:"from,omitempty"`
+	To        string `json:"to,omitempty"`
+}
+
+// loadSanitizedSchema loads the schema export of a version, fetching it first if asked to.
+func loadSanitizedSchema(schemaDir, schemaURL, version string, fetch bool, profiles []string) (classes, objects, types map[string]interface{}, err error) {
+	path := schemaPath(schemaDir, version)
+	if fetch {
+		if err := fetchSchema(schemaURL, version, path); err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
+	schema, err := loadSchema(path)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	classes, objects, types = sanitizeSchema(schema, profiles)
+	return classes, objects, types, nil
+}
+
+// diffSchemas diffs the classes and objects of two sanitized schemas. When classNames is not
+// empty, only those classes and the objects they reach in either version are diffed.
+func diffSchemas(from, to string, fromClasses, fromObjects, toClasses, toObjects map[string]interface{}, classNames []string) SchemaDiff {
+	diff := SchemaDiff{From: from, To: to, Changes: []SchemaChange{}}
+
+	var objectNames map[string]bool
+	if len(classNames) > 0 {
+		selected := make(map[string]bool)
+		for _, name := range classNames {
+			selected[name] = true
+		}
+		for name := range fromClasses {
+			if !selected[name] {
+				delete(fromClasses, name)
+			}
+		}
+		for name := range toClasses {
+			if !selected[name] {
+				delete(toClasses, name)
+			}
+		}
+
+		objectNames = make(map[string]bool)
+		reachableObjects(fromClasses, fromObjects, objectNames)
+		reachableObjects(toClasses, toObjects, objectNames)
+	}
+
+	diff.Changes = append(diff.Changes, diffDefinitions("class", fromClasses, toClasses, nil)...)
+	diff.Changes = append(diff.Changes, diffDefinitions("object", fromObjects, toObjects, objectNames)...)
+	return diff
+}
+
+// reachableObjects adds the objects the attributes of definitions reference, directly or through
+// other objects, to reached.
+func reachableObjects(definitions, objects map[string]interface{}, reached map[string]bool) {
+	for _, definition := range definitions {
+		attributes := definition.(map[string]interface{})["attributes"].(map[string]interface{})
+		for _, attribute := range attributes {
+			// Exports name the object in type, extension sources in object_type.
+			objectType, _ := attribute.(map[string]interface{})["object_type"].(string)
+			if objectType == "" {
+				objectType, _ = attribute.(map[string]interface{})["type"].(string)
+			}
+			object, ok := objects[objectType]
+			if !ok || reached[objectType] {
+				continue
+			}
+			reached[objectType] = true
+			reachableObjects(map[string]interface{}{objectType: object}, objects, reached)
+		}
+	}
+}
+
+func diffDefinitions(kind string, from, to map[string]interface{}, names map[string]bool) []SchemaChange {
+	all := make(map[string]interface{})
+	for name := range from {
+		all[name] = nil
+	}
+	for name := range to {
+		all[name] = nil
+	}
+
+	var changes []SchemaChange
+	for _, name := range sortedKeys(all) {
+		if names != nil && !names[name] {
+			continue
+		}
+
+		fromDefinition, inFrom := from[name].(map[string]interface{})
+		toDefinition, inTo := to[name].(map[string]interface{})
+		switch {
+		case !inFrom:
+			changes = append(changes, SchemaChange{Kind: kind, Name: name, Change: "added"})
+			continue
+		case !inTo:
+			changes = append(changes, SchemaChange{Kind: kind, Name: name, Change: "removed"})
+			continue
+		}
+
+		fromAttributes := fromDefinition["attributes"].(map[string]interface{})
+		toAttributes := toDefinition["attributes"].(map[string]interface{})
+		attributes := make(map[string]interface{})
+		for attribute := range fromAttributes {
+			attributes[attribute] = nil
+		}
+		for attribute := range toAttributes {
+			attributes[attribute] = nil
+		}
+
+		for _, attribute := range sortedKeys(attributes) {
+			fromAttribute, inFrom := fromAttributes[attribute].(map[string]interface{})
+			toAttribute, inTo := toAttributes[attribute].(map[string]interface{})
+			change := SchemaChange{Kind: kind, Name: name, Attribute: attribute}
+			switch {
+			case !inFrom:
+				change.Change = "added"
+				change.To = attributeType(toAttribute) + ", " + attributeRequirement(toAttribute)
+				changes = append(changes, change)
+			case !inTo:
+				change.Change = "removed"
+				change.From = attributeType(fromAttribute) + ", " + attributeRequirement(fromAttribute)
+				changes = append(changes, change)
+			default:
+				if attributeType(fromAttribute) != attributeType(toAttribute) {
+					change.Change = "retyped"
+					change.From, change.To = attributeType(fromAttribute), attributeType(toAttribute)
+					changes = append(changes, change)
+				}
+				if attributeRequirement(fromAttribute) != attributeRequirement(toAttribute) {
+					change.Change = "requirement"
+					change.From, change.To = attributeRequirement(fromAttribute), attributeRequirement(toAttribute)
+					changes = append(changes, change)
+				}
+			}
+		}
+	}
+	return changes
+}
+
+// attributeType describes the type of an attribute, e.g. string_t, or file[] for a list of file
+// objects.
+func attributeType(attribute map[string]interface{}) string {
+	t, _ := attribute["type"].(string)
+	if objectType, ok := attribute["object_type"].(string); ok && objectType != "" {
+		t = objectType
+	}
+	if isArray, _ := attribute["is_array"].(bool); isArray {
+		t += "[]"
+	}
+	return t
+}
+
+func attributeRequirement(attribute map[string]interface{}) string {
+	requirement, _ := attribute["requirement"].(string)
+	if requirement == "" {
+		return "optional"
+	}
+	return requirement
+}
+
+// writeSchemaDiff prints a schema diff, grouped by class and object.
+func writeSchemaDiff(w io.Writer, diff SchemaDiff) {
+	fmt.Fprintf(w, "OCSF %s -> %s: %d changes\n", diff.From, diff.To, len(diff.Changes))
+
+	var current string
+	for _, change := range diff.Changes {
+		if change.Attribute == "" {
+			fmt.Fprintf(w, "\n%s %s %s\n", change.Kind, change.Name, change.Change)
+			current = ""
+			continue
+		}
+
+		if heading := change.Kind + " " + change.Name; heading != current {
+			fmt.Fprintf(w, "\n%s\n", heading)
+			current = heading
+		}
+		switch change.Change {
+		case "added":
+			fmt.Fprintf(w, "  + %s (%s)\n", change.Attribute, change.To)
+		case "removed":
+			fmt.Fprintf(w, "  - %s (%s)\n", change.Attribute, change.From)
+		default:
+			fmt.Fprintf(w, "  ~ %s %s: %s -> %s\n", change.Attribute, change.Change, change.From, change.To)
+		}
+	}
+}
+
+// versionPackage returns the default package name of an OCSF version, e.g. v1_5_0 for 1.5.0.
+func versionPackage(version string) string {
+	return "v" + strings.NewReplacer(".", "_", "-", "_").Replace(version)
+}
+
+// schemaPath returns the path of a version's schema export in the schema cache.
+func schemaPath(schemaDir, version string) string {
+	return filepath.Join(schemaDir, version, "export.json")
+}
+
+// fetchSchema downloads the schema export of a version into the schema cache, unless it is
+// already cached.
+func fetchSchema(schemaURL, version, path string) error {
+	if _, err := os.Stat(path); err == nil {
+		return nil
+	}
+
+	url := fmt.Sprintf(schemaURL, version)
+	resp, err := http.Get(url)
+	if err != nil {
+		return fmt.Errorf("failed to fetch schema for version %s: %v", version, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to fetch schema for version %s: %s returned %s", version, url, resp.Status)
+	}
+
+	schemaData, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read schema for version %s: %v", version, err)
+	}
+
+	// Re-encode the export so that cached schemas are stable and readable in diffs.
+	var schema map[string]interface{}
+	err = json.Unmarshal(schemaData, &schema)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal schema for version %s: %v", version, err)
+	}
+	schemaData, err = json.MarshalIndent(schema, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal schema for version %s: %v", version, err)
+	}
+
+	err = os.MkdirAll(filepath.Dir(path), 0755)
+	if err != nil {
+		return fmt.Errorf("failed to create schema cache directory: %v", err)
+	}
+
+	return os.WriteFile(path, append(schemaData, '\n'), 0644)
+}
+
+func loadSchema(path string) (data map[string]interface{}, err error) {
+	schemaData, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("schema export %s is not cached, run with -fetch to download it", path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read schema data %s: %v", path, err)
+	}
+
+	err = json.Unmarshal(schemaData, &data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal schema data %s: %v", path, err)
+	}
+
+	return data, nil
+}
+
+// extensionGenerationSpec builds the spec for the package generated from the base schema merged
+// with extensions. Unless a package name is given, the package is named after the base package
+// and the first extension, e.g. v1_5_0_acme, and is generated alongside the base package.
+func extensionGenerationSpec(baseVersion, packageName string, extensions, profiles []string) (GenerationSpec, error) {
+	if packageName == "" {
+		extension, err := loadExtensionInfo(extensions[0])
+		if err != nil {
+			return GenerationSpec{}, err
+		}
+		packageName = versionPackage(baseVersion) + "_" + strings.ReplaceAll(extension.Name, "-", "_")
+	}
+
+	return GenerationSpec{
+		Version:    baseVersion,
+		Package:    packageName,
+		Dir:        "../ocsf/" + packageName,
+		Profiles:   profiles,
+		Extensions: extensions,
+	}, nil
+}
+
+// loadExtensionInfo reads the extension.json of an extension directory. For schema exports the
+// extension is taken from the first extension class.
+func loadExtensionInfo(path string) (Extension, error) {
+	var extension Extension
+	if strings.HasSuffix(path, ".json") {
+		var export map[string]interface{}
+		if err := readJSON(path, &export); err != nil {
+			return extension, err
+		}
+
+		classes, _ := export["classes"].(map[string]interface{})
+		for _, className := range sortedKeys(classes) {
+			if name, ok := classes[className].(map[string]interface{})["extension"].(string); ok {
+				extension.Name = name
+				return extension, nil
+			}
+		}
+		return extension, fmt.Errorf("no extension classes found in %s", path)
+	}
+
+	if err := readJSON(filepath.Join(path, "extension.json"), &extension); err != nil {
+		return extension, err
+	}
+	if extension.Name == "" {
+		return extension, fmt.Errorf("extension in %s has no name", path)
+	}
+
+	return extension, nil
+}
+
+// mergeExtension merges an OCSF extension into a base schema export. path is either an extension
+// source directory (extension.json, dictionary.json, categories.json, events/, objects/ and
+// profiles/) or a schema export which already contains the extension.
+func mergeExtension(schema map[string]interface{}, path string) error {
+	if strings.HasSuffix(path, ".json") {
+		return mergeExtensionExport(schema, path)
+	}
+
+	extension, err := loadExtensionInfo(path)
+	if err != nil {
+		return err
+	}
+
+	classes := schema["classes"].(map[string]interface{})
+	objects := schema["objects"].(map[string]interface{})
+	types := schema["types"].(map[string]interface{})
+	profiles := schema["profiles"].(map[string]interface{})
+
+	dictionary := baseDictionary(classes, objects)
+	var extensionDictionary map[string]interface{}
+	if err := readOptionalJSON(filepath.Join(path, "dictionary.json"), &extensionDictionary); err != nil {
+		return err
+	}
+	if attributes, ok := extensionDictionary["attributes"].(map[string]interface{}); ok {
+		for name, attribute := range attributes {
+			dictionary[name] = normalizeAttribute(attribute.(map[string]interface{}))
+		}
+	}
+	if extensionTypes, ok := extensionDictionary["types"].(map[string]interface{}); ok {
+		if attributes, ok := extensionTypes["attributes"].(map[string]interface{}); ok {
+			for name, extensionType := range attributes {
+				types[name] = extensionType
+			}
+		}
+	}
+
+	categories, err := extensionCategories(path, extension, classes)
+	if err != nil {
+		return err
+	}
+
+	profileDefinitions, err := readJSONDir(filepath.Join(path, "profiles"))
+	if err != nil {
+		return err
+	}
+	for _, profile := range profileDefinitions {
+		attributes, err := resolveAttributes(nil, profile, dictionary)
+		if err != nil {
+			return fmt.Errorf("profile %s: %v", profile["name"], err)
+		}
+		profile["attributes"] = attributes
+		profiles[profile["name"].(string)] = profile
+	}
+
+	objectDefinitions, err := readJSONDir(filepath.Join(path, "objects"))
+	if err != nil {
+		return err
+	}
+	if err := mergeExtensionDefinitions(objects, objectDefinitions, dictionary, func(object map[string]interface{}) error {
+		object["extension"] = extension.Name
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	classDefinitions, err := readJSONDir(filepath.Join(path, "events"))
+	if err != nil {
+		return err
+	}
+	return mergeExtensionDefinitions(classes, classDefinitions, dictionary, func(class map[string]interface{}) error {
+		return classifyExtensionClass(class, extension, categories)
+	})
+}
+
+// mergeExtensionExport merges the classes, objects, types and profiles of a schema export which
+// contains an extension. Extension names are exported with an "<extension>/" prefix, which is
+// removed so that the definitions replace or sit next to the base definitions.
+func mergeExtensionExport(schema map[string]interface{}, path string) error {
+	var export map[string]interface{}
+	if err := readJSON(path, &export); err != nil {
+		return err
+	}
+
+	for _, section := range []string{"classes", "objects", "types", "profiles"} {
+		definitions, ok := export[section].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		merged := schema[section].(map[string]interface{})
+		for name, definition := range definitions {
+			definition := definition.(map[string]interface{})
+			if attributes, ok := definition["attributes"].(map[string]interface{}); ok {
+				for _, attribute := range attributes {
+					attribute := attribute.(map[string]interface{})
+					if attributeType, ok := attribute["type"].(string); ok {
+						attribute["type"] = unprefixName(attributeType)
+					}
+				}
+			}
+			if definitionName, ok := definition["name"].(string); ok {
+				definition["name"] = unprefixName(definitionName)
+			}
+			merged[unprefixName(name)] = definition
+		}
+	}
+
+	return nil
+}
+
+// mergeExtensionDefinitions adds extension classes or objects to the base definitions. A definition
+// without a uid which extends a base definition of the same name patches the base definition;
+// any other definition is a new one inheriting the attributes of the definition it extends.
+func mergeExtensionDefinitions(
+	base map[string]interface{},
+	definitions []map[string]interface{},
+	dictionary map[string]interface{},
+	finalize func(definition map[string]interface{}) error,
+) error {
+	byName := make(map[string]map[string]interface{})
+	for _, definition := range definitions {
+		name, _ := definition["name"].(string)
+		if name == "" {
+			name, _ = definition["extends"].(string)
+		}
+		if name == "" {
+			return fmt.Errorf("definition has neither a name nor extends: %v", definition["caption"])
+		}
+		definition["name"] = unprefixName(name)
+		byName[definition["name"].(string)] = definition
+	}
+
+	resolved := make(map[string]bool)
+	var resolve func(name string) error
+	resolve = func(name string) error {
+		if resolved[name] {
+			return nil
+		}
+		resolved[name] = true
+
+		definition := byName[name]
+		extends, _ := definition["extends"].(string)
+		extends = unprefixName(extends)
+
+		_, hasUID := definition["uid"]
+		if extends == name && !hasUID {
+			patched, ok := base[name].(map[string]interface{})
+			if !ok {
+				return fmt.Errorf("%s patches an unknown definition", name)
+			}
+			attributes, err := resolveAttributes(patched["attributes"].(map[string]interface{}), definition, dictionary)
+			if err != nil {
+				return fmt.Errorf("%s: %v", name, err)
+			}
+			patched["attributes"] = attributes
+			if profiles, ok := definition["profiles"].([]interface{}); ok {
+				patchedProfiles, _ := patched["profiles"].([]interface{})
+				patched["profiles"] = append(patchedProfiles, profiles...)
+			}
+			return nil
+		}
+
+		var inherited map[string]interface{}
+		if extends != "" {
+			if _, ok := byName[extends]; ok {
+				if err := resolve(extends); err != nil {
+					return err
+				}
+			}
+
+			parent, ok := base[extends].(map[string]interface{})
+			if !ok && extends != "object" {
+				return fmt.Errorf("%s extends unknown definition %s", name, extends)
+			}
+			if ok {
+				inherited = deepCopy(parent["attributes"]).(map[string]interface{})
+				if _, ok := definition["profiles"]; !ok && parent["profiles"] != nil {
+					definition["profiles"] = deepCopy(parent["profiles"])
+				}
+				if _, ok := definition["constraints"]; !ok && parent["constraints"] != nil {
+					definition["constraints"] = deepCopy(parent["constraints"])
+				}
+			}
+		}
+
+		attributes, err := resolveAttributes(inherited, definition, dictionary)
+		if err != nil {
+			return fmt.Errorf("%s: %v", name, err)
+		}
+		definition["attributes"] = attributes
+		delete(definition, "extends")
+
+		if err := finalize(definition); err != nil {
+			return fmt.Errorf("%s: %v", name, err)
+		}
+		base[name] = definition
+		return nil
+	}
+
+	for _, name := range sortedDefinitionNames(byName) {
+		if err := resolve(name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// resolveAttributes overlays the attributes of an extension definition on top of the inherited
+// attributes. Attribute definitions are taken from the dictionary and may be refined by the
+// definition, e.g. to set the requirement or narrow the enum.
+func resolveAttributes(inherited, definition, dictionary map[string]interface{}) (map[string]interface{}, error) {
+	attributes := make(map[string]interface{})
+	for name, attribute := range inherited {
+		attributes[name] = attribute
+	}
+
+	own, _ := definition["attributes"].(map[string]interface{})
+	for _, name := range sortedKeys(own) {
+		// Keys such as $include reference schema source files and carry no attribute.
+		if strings.HasPrefix(name, "$") {
+			continue
+		}
+
+		var attribute map[string]interface{}
+		if existing, ok := attributes[name].(map[string]interface{}); ok {
+			attribute = deepCopy(existing).(map[string]interface{})
+		} else if entry, ok := dictionary[name].(map[string]interface{}); ok {
+			attribute = deepCopy(entry).(map[string]interface{})
+		} else {
+			attribute = make(map[string]interface{})
+		}
+
+		overrides, _ := own[name].(map[string]interface{})
+		for key, value := range normalizeAttribute(overrides) {
+			// Enum values are added to the inherited ones, e.g. to define extension activities.
+			enum, isEnum := attribute[key].(map[string]interface{})
+			if extraValues, ok := value.(map[string]interface{}); ok && isEnum && key == "enum" {
+				for enumValue, caption := range extraValues {
+					enum[enumValue] = caption
+				}
+				continue
+			}
+			attribute[key] = value
+		}
+
+		if _, ok := attribute["type"]; !ok {
+			return nil, fmt.Errorf("attribute %s is not defined in the dictionary", name)
+		}
+		if _, ok := attribute["caption"]; !ok {
+			attribute["caption"] = fieldTitle(name)
+		}
+		if _, ok := attribute["description"]; !ok {
+			attribute["description"] = attribute["caption"]
+		}
+		if _, ok := attribute["requirement"]; !ok {
+			attribute["requirement"] = "optional"
+		}
+		attributes[name] = attribute
+	}
+
+	return attributes, nil
+}
+
+// classifyExtensionClass assigns the uids of an extension class. Extension class uids are offset by
+// the extension uid: extension uid * 100000 + category uid * 1000 + class uid.
+func classifyExtensionClass(class map[string]interface{}, extension Extension, categories map[string]extensionCategory) error {
+	categoryName, _ := class["category"].(string)
+	category, ok := categories[unprefixName(categoryName)]
+	if !ok {
+		return fmt.Errorf("unknown category %q", categoryName)
+	}
+
+	uid, ok := class["uid"].(float64)
+	if !ok {
+		return fmt.Errorf("class has no uid")
+	}
+	classUID := extension.UID*100000 + (category.UID%100)*1000 + int(uid)
+	caption := class["caption"].(string)
+
+	class["uid"] = float64(classUID)
+	class["category"] = unprefixName(categoryName)
+	class["category_uid"] = float64(category.UID)
+	class["category_name"] = category.Caption
+	class["extension"] = extension.Name
+	class["extension_id"] = float64(extension.UID)
+	class["extension_version"] = extension.Version
+
+	attributes := class["attributes"].(map[string]interface{})
+	setEnum(attributes, "class_uid", map[int]string{classUID: caption})
+	setEnum(attributes, "category_uid", map[int]string{category.UID: category.Caption})
+
+	if activity, ok := attributes["activity_id"].(map[string]interface{}); ok {
+		typeCaptions := make(map[int]string)
+		for _, value := range sortedEnumValues(activity) {
+			activityCaption := activity["enum"].(map[string]interface{})[strconv.Itoa(value)].(map[string]interface{})["caption"].(string)
+			typeCaptions[classUID*100+value] = caption + ": " + activityCaption
+		}
+		setEnum(attributes, "type_uid", typeCaptions)
+	}
+
+	return nil
+}
+
+type extensionCategory struct {
+	UID     int
+	Caption string
+}
+
+// extensionCategories returns the categories extension classes may belong to: the base categories,
+// as referenced by the base classes, and the categories defined by the extension. Extension
+// category uids are offset by the extension uid: extension uid * 100 + category uid.
+func extensionCategories(path string, extension Extension, classes map[string]interface{}) (map[string]extensionCategory, error) {
+	categories := make(map[string]extensionCategory)
+	for _, class := range classes {
+		class := class.(map[string]interface{})
+		name, _ := class["category"].(string)
+		uid, ok := class["category_uid"].(float64)
+		if !ok {
+			classUID, ok := class["uid"].(float64)
+			if !ok {
+				continue
+			}
+			uid = float64(int(classUID) / 1000)
+		}
+		caption, _ := class["category_name"].(string)
+		if name != "" {
+			categories[name] = extensionCategory{UID: int(uid), Caption: caption}
+		}
+	}
+
+	var extensionDefinitions map[string]interface{}
+	if err := readOptionalJSON(filepath.Join(path, "categories.json"), &extensionDefinitions); err != nil {
+		return nil, err
+	}
+	attributes, _ := extensionDefinitions["attributes"].(map[string]interface{})
+	for name, category := range attributes {
+		category := category.(map[string]interface{})
+		uid, ok := category["uid"].(float64)
+		if !ok {
+			return nil, fmt.Errorf("category %s has no uid", name)
+		}
+		caption, _ := category["caption"].(string)
+		categories[name] = extensionCategory{UID: extension.UID*100 + int(uid), Caption: caption}
+	}
+
+	return categories, nil
+}
+
+// baseDictionary approximates the schema dictionary from the attributes of the exported classes
+// and objects, which is what extension definitions reference attributes from.
+func baseDictionary(classes, objects map[string]interface{}) map[string]interface{} {
+	dictionary := make(map[string]interface{})
+	for _, definitions := range []map[string]interface{}{objects, classes} {
+		for _, name := range sortedKeys(definitions) {
+			attributes, _ := definitions[name].(map[string]interface{})["attributes"].(map[string]interface{})
+			for attributeName, attribute := range attributes {
+				if _, ok := dictionary[attributeName]; ok {
+					continue
+				}
+
+				entry := deepCopy(attribute).(map[string]interface{})
+				for _, key := range []string{"requirement", "profile", "group", "_source"} {
+					delete(entry, key)
+				}
+				dictionary[attributeName] = entry
+			}
+		}
+	}
+
+	return dictionary
+}
+
+// normalizeAttribute converts an attribute from the schema source format to the export format,
+// where object attributes have the object name as their type.
+func normalizeAttribute(attribute map[string]interface{}) map[string]interface{} {
+	normalized := make(map[string]interface{})
+	for key, value := range attribute {
+		normalized[key] = value
+	}
+
+	if objectType, ok := normalized["object_type"].(string); ok {
+		normalized["type"] = unprefixName(objectType)
+		delete(normalized, "object_type")
+	}
+	if attributeType, ok := normalized["type"].(string); ok {
+		normalized["type"] = unprefixName(attributeType)
+	}
+
+	return normalized
+}
+
+func setEnum(attributes map[string]interface{}, name string, captions map[int]string) {
+	attribute, ok := attributes[name].(map[string]interface{})
+	if !ok {
+		return
+	}
+
+	enum := make(map[string]interface{})
+	for value, caption := range captions {
+		enum[strconv.Itoa(value)] = map[string]interface{}{"caption": caption}
+	}
+	attribute["enum"] = enum
+}
+
+// unprefixName removes the "<extension>/" prefix from an extension class, object or type name.
+func unprefixName(name string) string {
+	if idx := strings.LastIndex(name, "/"); idx != -1 {
+		return name[idx+1:]
+	}
+	return name
+}
+
+func sortedDefinitionNames(definitions map[string]map[string]interface{}) []string {
+	names := make([]string, 0, len(definitions))
+	for name := range definitions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func deepCopy(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		copied := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			copied[key] = deepCopy(item)
+		}
+		return copied
+	case []interface{}:
+		copied := make([]interface{}, len(v))
+		for i, item := range v {
+			copied[i] = deepCopy(item)
+		}
+		return copied
+	default:
+		return v
+	}
+}
+
+func readJSON(path string, v interface{}) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %v", path, err)
+	}
+
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("failed to unmarshal %s: %v", path, err)
+	}
+
+	return nil
+}
+
+func readOptionalJSON(path string, v interface{}) error {
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return nil
+	}
+	return readJSON(path, v)
+}
+
+// readJSONDir reads every JSON file below dir, in lexical order. A missing directory is empty.
+func readJSONDir(dir string) ([]map[string]interface{}, error) {
+	var definitions []map[string]interface{}
+	err := filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if os.IsNotExist(err) && path == dir {
+			return filepath.SkipDir
+		}
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() || !strings.HasSuffix(path, ".json") {
+			return nil
+		}
+
+		var definition map[string]interface{}
+		if err := readJSON(path, &definition); err != nil {
+			return err
+		}
+		definitions = append(definitions, definition)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return definitions, nil
+}
+
+func sanitizeSchema(schema map[string]interface{}, selectedProfiles []string) (classes, objects, types map[string]interface{}) {
+	profiles := schema["profiles"].(map[string]interface{})
+	classes = schema["classes"].(map[string]interface{})
+	objects = schema["objects"].(map[string]interface{})
+
+	types = schema["types"].(map[string]interface{})
+
+	selected := make(map[string]bool)
+	for _, profile := range selectedProfiles {
+		if _, ok := profiles[profile]; !ok {
+			log.Fatalf("Unknown profile %q", profile)
+		}
+		selected[profile] = true
+	}
+
+	removeProfileFields(classes, profiles, selected)
+	removeDeprecatedFields(objects)
+	removeDeprecatedFields(classes)
+	// The datetime profile consists of the "_dt" fields, so they are only kept when it is selected.
+	if !selected["datetime"] {
+		removeDTFields(objects)
+		removeDTFields(classes)
+	}
+
+	return classes, objects, types
+}
+
+func generateGoStruct(
+	genSpec GenerationSpec,
+	class, objects, types map[string]interface{},
+	observables []Observable,
+	isClass bool) error {
+	classFields, ok := class["attributes"].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	sanitizedObjectCaption := sanitizeCaption(class["caption"].(string))
+	className := class["name"].(string)
+
+	fileHeader := fmt.Sprintf(`
+// autogenerated by scripts/model_gen.go. DO NOT EDIT
+package %s
+
+import (
+	"github.com/apache/arrow-go/v18/arrow"
+)
+
+`, genSpec.Package)
+
+	arrowFields := fmt.Sprintf("var %sFields = []arrow.Field{\n", sanitizedObjectCaption)
+	goStruct := fmt.Sprintf("type %s struct {\n", sanitizedObjectCaption)
+
+	var hasObservablesField bool
+	var generatedFields []GeneratedField
+	for _, fieldName := range sortedKeys(classFields) {
+		if fieldName == "observables" {
+			hasObservablesField = true
+		}
+		fieldValue := classFields[fieldName].(map[string]interface{})
+
+		required := fieldValue["requirement"] == "required"
+		rawType := fieldValue["type"].(string)
+
+		fieldTitle := fieldTitle(fieldName)
+
+		var fieldType string
+		var arrowType string
+		var isTimestamp, isObject, isRef bool
+		if strings.HasSuffix(rawType, "_t") {
+			if rawType == "date_t" {
+				fieldType = "int32"
+				arrowType = "arrow.FixedWidthTypes.Date32"
+			} else if rawType == "timestamp_t" {
+				fieldType = "int64"
+				isTimestamp = true
+				arrowType = "arrow.FixedWidthTypes.Timestamp_ms"
+			} else {
+				var err error
+				fieldType, err = resolveOCSFType(rawType, types)
+				if err != nil {
+					log.Fatalf("Failed to resolve OCSF type: %v", err)
+				}
+				arrowType = goTypeToArrowType(fieldType)
+			}
+		} else {
+			if rawType == "object" {
+				fieldType = "ocsf.RawJSON"
+				arrowType = goTypeToArrowType(fieldType)
+			} else {
+				isObject = true
+				fieldType = sanitizeCaption(objects[rawType].(map[string]interface{})["caption"].(string))
+				if refTree[sanitizedObjectCaption] == nil {
+					refTree[sanitizedObjectCaption] = make(map[string]bool)
+				}
+				refTree[sanitizedObjectCaption][fieldType] = true
+
+				if fieldRefTree, ok := refTree[fieldType]; refAttributes[className+"."+fieldName] || ok && fieldRefTree[sanitizedObjectCaption] {
+					arrowType = fmt.Sprintf("%sRefStruct", fieldType)
+					fieldType = fmt.Sprintf("%sRef", fieldType)
+					isRef = true
+					refStructsUsed[fieldValue["type"].(string)] = true
+					refAttributes[className+"."+fieldName] = true
+				} else {
+					arrowType = fmt.Sprintf("%sStruct", fieldType)
+				}
+			}
+		}
+
+		if !required && !isTimestamp {
+			fieldType = "*" + fieldType
+		}
+
+		var extraTags string
+		if fieldValue["is_array"] == true {
+			// A bug in go-parquet causes it to not handle slices of pointers.
+			if !required {
+				fieldType = strings.ReplaceAll(fieldType, "*", "")
+			}
+			fieldType = "[]" + fieldType
+			arrowType = "arrow.ListOf(" + arrowType + ")"
+			extraTags = ",list"
+		}
+
+		if isTimestamp {
+			extraTags = ",timestamp_millis,timestamp(millisecond)"
+		}
+
+		field := GeneratedField{
+			Name:        fieldName,
+			Title:       fieldTitle,
+			GoType:      fieldType,
+			Required:    required,
+			IsArray:     fieldValue["is_array"] == true,
+			IsTimestamp: isTimestamp,
+			IsObject:    isObject,
+			IsRef:       isRef,
+			ArrowType:   arrowType,
+			Tag:         fieldTag(fieldName, required, extraTags),
+			Attribute:   fieldValue,
+		}
+		generatedFields = append(generatedFields, field)
+
+		goStruct += structField(field)
+		arrowFields += arrowField(field)
+	}
+
+	goStruct += "}\n"
+	arrowFields += "}\n"
+
+	isObservFuncBody := "return nil, \"\""
+	if obs_num, ok := class["observable"].(float64); ok {
+		isObservFuncBody = fmt.Sprintf("typeId := %d\nreturn &typeId, \"%s\"", int(obs_num), className)
+	}
+
+	isObservable := fmt.Sprintf(`func (v *%s) Observable() (*int, string) {
+		%s
+	}
+
+	`, sanitizedObjectCaption, isObservFuncBody)
+
+	goStruct += isObservable
+
+	if hasObservablesField {
+		validateObservables := fmt.Sprintf(`func (v *%s) ValidateObservables() error {
+			presentObservables := ocsf.PresentObservablesOf(v)
+			for presObsIdx := range presentObservables {
+				var found bool
+				for obsIdx := range v.Observables {
+					presObsEnum := presentObservables[presObsIdx][0].(*int)
+					if v.Observables[obsIdx].TypeId == int32(*presObsEnum) {
+						found = true
+						break
+					}
+				}
+				if !found {
+					obs := presentObservables[presObsIdx]
+					return fmt.Errorf("non-null observable %%s(%%d) not found in observables array", obs[1], *obs[0].(*int))
+				}
+			}
+			return nil
+		}
+
+		`, sanitizedObjectCaption)
+
+		goStruct += validateObservables
+	}
+
+	validate, err := generateValidate(class, generatedFields, types)
+	if err != nil {
+		return err
+	}
+	goStruct += validate
+
+	structFields[sanitizedObjectCaption] = generatedFields
+	goStruct += generateAccessors(class, generatedFields)
+
+	goStruct += generateAppendObservables(class, generatedFields, objects, types, isClass)
+	if hasObservablesField {
+		goStruct += generatePopulateObservables(class)
+	}
+
+	if isClass {
+		constructor, err := generateClassConstructor(genSpec, class, objects, types)
+		if err != nil {
+			return err
+		}
+		goStruct += constructor
+	}
+
+	arrowStruct := fmt.Sprintf("var %sStruct = arrow.StructOf(%sFields...)\n", sanitizedObjectCaption, sanitizedObjectCaption)
+	arrowSchemaDec := fmt.Sprintf("var %sSchema = arrow.NewSchema(%sFields, nil)", sanitizedObjectCaption, sanitizedObjectCaption)
+	arrowClassname := fmt.Sprintf("var %sClassname = \"%s\"\n", sanitizedObjectCaption, className)
+	finalOutput := fileHeader + "\n" + goStruct + "\n" + arrowFields + "\n" + arrowStruct + "\n" + arrowSchemaDec + "\n" + arrowClassname
+
+	filename := class["name"].(string) + ".go"
+
+	genDir := genSpec.Dir
+	err = os.WriteFile(genDir+"/"+filename, []byte(finalOutput), 0644)
+	if err != nil {
+		return err
+	}
+
+	cmd := goimportsCmd(fmt.Sprintf(genDir+"/%s", filename))
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("failed to gofmt file %s: %v", fmt.Sprintf(genDir+"/%s", filename), err)
+	}
+
+	return nil
+}
+
+// structField returns the declaration of a generated struct field, preceded by the attribute's
+// caption and description.
+func structField(field GeneratedField) string {
+	return fmt.Sprintf("\n// %s: %s\n%s %s `%s`\n",
+		field.Attribute["caption"].(string), field.Attribute["description"].(string), field.Title, field.GoType, field.Tag)
+}
+
+// arrowField returns the Arrow field of a generated struct field.
+func arrowField(field GeneratedField) string {
+	caption, _ := field.Attribute["caption"].(string)
+	description, _ := field.Attribute["description"].(string)
+	requirement, _ := field.Attribute["requirement"].(string)
+	return fmt.Sprintf("{Name: \"%s\", Type: %s, Nullable: %t, Metadata: ocsf.AttributeMetadata(%q, %q, %q, %q)},\n",
+		field.Name, field.ArrowType, !field.Required, caption, description, requirement, enumDoc(field.Attribute))
+}
+
+// enumDoc lists the enum values of an attribute for its Arrow field metadata, e.g.
+// "0: Unknown; 1: Create".
+func enumDoc(fieldValue map[string]interface{}) string {
+	enum, _ := fieldValue["enum"].(map[string]interface{})
+
+	keys := make([]string, 0, len(enum))
+	for key := range enum {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		a, errA := strconv.Atoi(keys[i])
+		b, errB := strconv.Atoi(keys[j])
+		if errA == nil && errB == nil {
+			return a < b
+		}
+		return keys[i] < keys[j]
+	})
+
+	values := make([]string, 0, len(keys))
+	for _, key := range keys {
+		caption, _ := enum[key].(map[string]interface{})["caption"].(string)
+		values = append(values, key+": "+caption)
+	}
+	return strings.Join(values, "; ")
+}
+
+// fieldTag returns the struct tag of a generated field.
+func fieldTag(fieldName string, required bool, extraTags string) string {
+	if required {
+		return fmt.Sprintf("json:\"%s\" parquet:\"%s%s\"", fieldName, fieldName, extraTags)
+	}
+	return fmt.Sprintf("json:\"%s,omitempty\" parquet:\"%s%s,optional\"", fieldName, fieldName, extraTags)
+}
+
+// refStructName returns the name of a reference struct level, e.g. ProcessRef for level 1 and
+// ProcessRef2 for level 2.
+func refStructName(structName string, level int) string {
+	if level == 1 {
+		return structName + "Ref"
+	}
+	return fmt.Sprintf("%sRef%d", structName, level)
+}
+
+// generateRefStructs appends reference structs to the files of the objects which are referenced
+// in a cycle. A reference struct copies its object, but its object attributes point to the
+// reference structs of the next level, so that every cycle ends after genSpec.RefDepth levels. The
+// deepest level drops its object attributes, or keeps them as ocsf.RawJSON with genSpec.RefJSON.
+func generateRefStructs(genSpec GenerationSpec, objects map[string]interface{}) error {
+	objectFiles := make(map[string]string)
+	for name, object := range objects {
+		objectFiles[sanitizeCaption(object.(map[string]interface{})["caption"].(string))] = name
+	}
+
+	type refLevel struct {
+		structName string
+		level      int
+	}
+
+	var queue []refLevel
+	for _, name := range sortedKeys(objects) {
+		if refStructsUsed[name] {
+			queue = append(queue, refLevel{sanitizeCaption(objects[name].(map[string]interface{})["caption"].(string)), 1})
+		}
+	}
+
+	generated := make(map[refLevel]bool)
+	refStructs := make(map[string]string)
+	for len(queue) > 0 {
+		ref := queue[0]
+		queue = queue[1:]
+		if generated[ref] {
+			continue
+		}
+		generated[ref] = true
+
+		refName := refStructName(ref.structName, ref.level)
+		goStruct := fmt.Sprintf("\ntype %s struct {\n", refName)
+		arrowFields := fmt.Sprintf("\nvar %sFields = []arrow.Field{\n", refName)
+		var refFields []GeneratedField
+		for _, field := range structFields[ref.structName] {
+			if field.IsObject {
+				child := strings.TrimSuffix(strings.TrimPrefix(strings.TrimPrefix(field.GoType, "[]"), "*"), "Ref")
+				switch {
+				case ref.level < genSpec.RefDepth:
+					childRef := refStructName(child, ref.level+1)
+					field.GoType = strings.Replace(field.GoType, strings.TrimPrefix(strings.TrimPrefix(field.GoType, "[]"), "*"), childRef, 1)
+					field.ArrowType = childRef + "Struct"
+					if field.IsArray {
+						field.ArrowType = "arrow.ListOf(" + field.ArrowType + ")"
+					}
+					queue = append(queue, refLevel{child, ref.level + 1})
+				case genSpec.RefJSON:
+					// The object, or list of objects, is kept as a single JSON column.
+					field.GoType = "*ocsf.RawJSON"
+					if field.Required {
+						field.GoType = "ocsf.RawJSON"
+					}
+					field.ArrowType = "arrow.BinaryTypes.String"
+					field.Tag = fieldTag(field.Name, field.Required, "")
+					field.IsObject, field.IsArray = false, false
+				default:
+					continue
+				}
+			}
+
+			refFields = append(refFields, field)
+			goStruct += structField(field)
+			arrowFields += arrowField(field)
+		}
+		structFields[refName] = refFields
+		goStruct += "}\n"
+		arrowFields += fmt.Sprintf("}\n\nvar %sStruct = arrow.StructOf(%sFields...)\n", refName, refName)
+
+		refStructs[objectFiles[ref.structName]] += arrowFields + goStruct
+	}
+
+	for _, name := range sortedStringKeys(refStructs) {
+		filename := genSpec.Dir + "/" + name + ".go"
+		f, err := os.OpenFile(filename, os.O_WRONLY|os.O_APPEND, 0644)
+		if err != nil {
+			return err
+		}
+		_, err = f.WriteString(refStructs[name])
+		f.Close()
+		if err != nil {
+			return err
+		}
+
+		cmd := goimportsCmd(filename)
+		if err := cmd.Run(); err != nil {
+			return fmt.Errorf("failed to gofmt file %s: %v", filename, err)
+		}
+	}
+
+	return nil
+}
+
+func sanitizeCaption(caption string) string {
+	re := regexp.MustCompile(`[^a-zA-Z0-9]+`)
+	return re.ReplaceAllString(caption, "")
+}
+
+// fieldTitle converts a snake_case OCSF attribute name into the Go field name used by the generated structs.
+func fieldTitle(fieldName string) string {
+	titleSubstrings := strings.Split(fieldName, "_")
+	for idx := range titleSubstrings {
+		titleSubstrings[idx] = strings.ToUpper(string(titleSubstrings[idx][0])) + titleSubstrings[idx][1:]
+	}
+
+	return strings.Join(titleSubstrings, "")
+}
+
+// generateClassConstructor emits the activity enum and a New<Class> constructor which fills the
+// classification attributes (class, category, activity, type and metadata version) from the schema.
+func generateClassConstructor(genSpec GenerationSpec, class, objects, types map[string]interface{}) (string, error) {
+	classFields := class["attributes"].(map[string]interface{})
+	sanitizedObjectCaption := sanitizeCaption(class["caption"].(string))
+	classCaption := class["caption"].(string)
+
+	// Profiles are listed for every class, as metadata.profiles is set by hand on classes without
+	// activity_id, which have no constructor.
+	var output string
+	classProfiles := selectedClassProfiles(genSpec, class)
+	if len(classProfiles) > 0 {
+		output += fmt.Sprintf("var %sProfiles = %s\n\n", sanitizedObjectCaption, stringSliceLiteral(classProfiles))
+	}
+
+	activityField, ok := classFields["activity_id"].(map[string]interface{})
+	if !ok {
+		return output, nil
+	}
+
+	// Exports rebuilt from generated packages have the activity_id values of only some classes.
+	// The others get the Unknown and Other values every class has, and activities which have no
+	// caption leave activity_name and type_name to the caller.
+	captions := make(map[int]string)
+	for _, value := range sortedEnumValues(activityField) {
+		captions[value] = activityField["enum"].(map[string]interface{})[strconv.Itoa(value)].(map[string]interface{})["caption"].(string)
+	}
+	knownActivities := len(captions) > 0
+	if !knownActivities {
+		captions = map[int]string{0: "Unknown", 99: "Other"}
+	}
+
+	enumType := sanitizedObjectCaption + "ActivityId"
+	activityGoType, err := resolveOCSFType(activityField["type"].(string), types)
+	if err != nil {
+		return "", err
+	}
+
+	enumValues := make([]int, 0, len(captions))
+	for value := range captions {
+		enumValues = append(enumValues, value)
+	}
+	sort.Ints(enumValues)
+	constNames := make(map[string]bool)
+
+	output += fmt.Sprintf("type %s %s\n\nconst (\n", enumType, activityGoType)
+	captionFunc := fmt.Sprintf("func (a %s) Caption() string {\nswitch a {\n", enumType)
+	for _, value := range enumValues {
+		caption := captions[value]
+
+		constName := enumType + sanitizeCaption(caption)
+		if constNames[constName] {
+			constName = fmt.Sprintf("%s%d", constName, value)
+		}
+		constNames[constName] = true
+
+		output += fmt.Sprintf("%s %s = %d\n", constName, enumType, value)
+		captionFunc += fmt.Sprintf("case %s:\nreturn %q\n", constName, caption)
+	}
+	output += ")\n\n"
+	if knownActivities {
+		captionFunc += "}\nreturn \"Other\"\n}\n\n"
+	} else {
+		captionFunc += "}\nreturn \"\"\n}\n\n"
+	}
+	output += captionFunc
+
+	// The schema gives no uid for some extension classes, whose constructors leave class_uid,
+	// category_uid and type_uid to the caller.
+	classUID, hasUID := class["uid"].(float64)
+	categoryUID := int(classUID) / 1000
+	if uid, ok := class["category_uid"].(float64); ok {
+		categoryUID = int(uid)
+	}
+	categoryName, _ := class["category_name"].(string)
+	if hasUID {
+		output += fmt.Sprintf("const (\n%sClassUid = %d\n%sCategoryUid = %d\n)\n\n", sanitizedObjectCaption, int(classUID), sanitizedObjectCaption, categoryUID)
+	}
+
+	// Assignments of the activity's caption are made only when it has one, unless every activity
+	// does.
+	captionExpr := "activity.Caption()"
+	if !knownActivities {
+		captionExpr = "caption"
+	}
+	assignments := []struct {
+		field   string
+		expr    string
+		uid     bool
+		caption bool
+	}{
+		{field: "activity_id", expr: "activity"},
+		{field: "activity_name", expr: captionExpr, caption: true},
+		{field: "category_uid", expr: fmt.Sprintf("%sCategoryUid", sanitizedObjectCaption), uid: true},
+		{field: "category_name", expr: fmt.Sprintf("%q", categoryName), uid: true},
+		{field: "class_uid", expr: fmt.Sprintf("%sClassUid", sanitizedObjectCaption), uid: true},
+		{field: "class_name", expr: fmt.Sprintf("%q", classCaption)},
+		{field: "type_uid", expr: fmt.Sprintf("%sClassUid*100 + int(activity)", sanitizedObjectCaption), uid: true},
+		{field: "type_name", expr: fmt.Sprintf("%q + %s", classCaption+": ", captionExpr), caption: true},
+	}
+
+	output += fmt.Sprintf("func New%s(activity %s) %s {\nv := %s{}\n", sanitizedObjectCaption, enumType, sanitizedObjectCaption, sanitizedObjectCaption)
+	var captioned string
+	for _, assignment := range assignments {
+		fieldValue, ok := classFields[assignment.field].(map[string]interface{})
+		if !ok || (assignment.uid && !hasUID) || (assignment.field == "category_name" && categoryName == "") {
+			continue
+		}
+
+		expr, err := goValueExpr(fieldValue, types, assignment.expr)
+		if err != nil {
+			return "", err
+		}
+		line := fmt.Sprintf("v.%s = %s\n", fieldTitle(assignment.field), expr)
+		if assignment.caption && !knownActivities {
+			captioned += line
+			continue
+		}
+		output += line
+	}
+	if captioned != "" {
+		output += fmt.Sprintf("if caption := activity.Caption(); caption != \"\" {\n%s}\n", captioned)
+	}
+
+	if metadataField, ok := classFields["metadata"].(map[string]interface{}); ok {
+		if metadataField["requirement"] == "required" {
+			output += fmt.Sprintf("v.Metadata.Version = %q\n", genSpec.Version)
+		} else {
+			output += fmt.Sprintf("v.Metadata = &Metadata{Version: %q}\n", genSpec.Version)
+		}
+
+		metadataAttributes := objects["metadata"].(map[string]interface{})["attributes"].(map[string]interface{})
+		if len(classProfiles) > 0 && metadataAttributes["profiles"] != nil {
+			output += fmt.Sprintf("v.Metadata.Profiles = %sProfiles\n", sanitizedObjectCaption)
+		}
+
+		extension, err := extensionLiteral(class, objects, types)
+		if err != nil {
+			return "", err
+		}
+		if extension != "" {
+			if metadataAttributes["extensions"] != nil {
+				output += fmt.Sprintf("v.Metadata.Extensions = []%s\n", strings.Replace(extension, "{", "{{", 1)+"}")
+			} else if extensionField, ok := metadataAttributes["extension"].(map[string]interface{}); ok {
+				if extensionField["requirement"] != "required" {
+					extension = "&" + extension
+				}
+				output += fmt.Sprintf("v.Metadata.Extension = %s\n", extension)
+			}
+		}
+	}
+	output += "return v\n}\n\n"
+
+	return output, nil
+}
+
+// generateValidate emits Validate and ValidateAt. They check required attributes, enum membership of
+// *_id attributes, sibling captions, type_uid consistency and the at_least_one/just_one constraints,
+// and recurse into nested objects. Ref structs are not validated as they carry no methods.
+func generateValidate(class map[string]interface{}, fields []GeneratedField, types map[string]interface{}) (string, error) {
+	sanitizedObjectCaption := sanitizeCaption(class["caption"].(string))
+
+	fieldsByName := make(map[string]GeneratedField)
+	for _, field := range fields {
+		fieldsByName[field.Name] = field
+	}
+
+	var enums, checks string
+	for _, field := range fields {
+		fieldEnums, fieldChecks := generateFieldValidation(sanitizedObjectCaption, field, fieldsByName)
+		enums += fieldEnums
+
+		// The attributes of a profile are only validated on events which apply it, as its required
+		// attributes are kept in the class whether or not the profile is selected.
+		profile, ok := field.Attribute["profile"].(string)
+		if metadata, hasMetadata := fieldsByName["metadata"]; ok && hasMetadata && fieldChecks != "" {
+			condition := fmt.Sprintf("slices.Contains(v.Metadata.Profiles, %q)", profile)
+			if !metadata.Required {
+				condition = "v.Metadata != nil && " + condition
+			}
+			fieldChecks = fmt.Sprintf("if %s {\n%s}\n", condition, fieldChecks)
+		}
+		checks += fieldChecks
+	}
+
+	classUID, hasClassUID := fieldsByName["class_uid"]
+	activityID, hasActivityID := fieldsByName["activity_id"]
+	typeUID, hasTypeUID := fieldsByName["type_uid"]
+	if hasClassUID && hasActivityID && hasTypeUID && classUID.Required && activityID.Required && typeUID.Required {
+		checks += `if int64(v.TypeUid) != int64(v.ClassUid)*100+int64(v.ActivityId) {
+			errs.Add(ocsf.JoinPath(path, "type_uid"), "%d does not equal class_uid*100+activity_id (%d)", v.TypeUid, int64(v.ClassUid)*100+int64(v.ActivityId))
+		}
+		`
+	}
+
+	if constraints, ok := class["constraints"].(map[string]interface{}); ok {
+		for _, constraint := range []string{"at_least_one", "just_one"} {
+			names, ok := constraints[constraint].([]interface{})
+			if !ok {
+				continue
+			}
+
+			var present, attributes []string
+			for _, name := range names {
+				field, ok := fieldsByName[name.(string)]
+				if !ok {
+					continue
+				}
+				present = append(present, presenceExpr(field))
+				attributes = append(attributes, field.Name)
+			}
+			if len(present) == 0 {
+				continue
+			}
+
+			condition, message := "== 0", "at least one of %s must be set"
+			if constraint == "just_one" {
+				condition, message = "!= 1", "exactly one of %s must be set"
+			}
+			checks += fmt.Sprintf("if ocsf.CountPresent(%s) %s {\nerrs.Add(path, %q)\n}\n",
+				strings.Join(present, ", "), condition, fmt.Sprintf(message, strings.Join(attributes, ", ")))
+		}
+	}
+
+	return enums + fmt.Sprintf(`func (v *%s) Validate() error {
+		return v.ValidateAt("").Err()
+	}
+
+	func (v *%s) ValidateAt(path string) ocsf.ValidationErrors {
+		var errs ocsf.ValidationErrors
+		%s
+		return errs
 	}
+
+	`, sanitizedObjectCaption, sanitizedObjectCaption, checks), nil
 }
 
-func loadSchema(version string) (data map[string]interface{}, err error) {
-	schemaData, err := os.ReadFile(fmt.Sprintf("%s.json", version))
-	if err != nil {
-		return nil, fmt.Errorf("failed to read schema data for version %s: %v", version, err)
+// generateFieldValidation generates the checks of one attribute for ValidateAt, and the captions of
+// its enum values which the checks use.
+func generateFieldValidation(sanitizedObjectCaption string, field GeneratedField, fieldsByName map[string]GeneratedField) (enums, checks string) {
+	path := fmt.Sprintf("ocsf.JoinPath(path, %q)", field.Name)
+	ref := "v." + field.Title
+
+	if field.IsArray {
+		if field.Required {
+			checks += fmt.Sprintf("if len(%s) == 0 {\nerrs.Add(%s, \"required attribute is missing\")\n}\n", ref, path)
+		}
+		if field.IsObject && !field.IsRef {
+			checks += fmt.Sprintf("for i := range %s {\nerrs = append(errs, %s[i].ValidateAt(ocsf.IndexPath(%s, i))...)\n}\n", ref, ref, path)
+		}
+		return enums, checks
 	}
 
-	err = json.Unmarshal(schemaData, &data)
-	if err != nil {
-		return nil, fmt.Errorf("failed to unmarshal schema data for version %s: %v", version, err)
+	if field.IsObject {
+		if field.IsRef {
+			return enums, checks
+		}
+		if field.Required {
+			checks += fmt.Sprintf("errs = append(errs, %s.ValidateAt(%s)...)\n", ref, path)
+		} else {
+			checks += fmt.Sprintf("if %s != nil {\nerrs = append(errs, %s.ValidateAt(%s)...)\n}\n", ref, ref, path)
+		}
+		return enums, checks
 	}
 
-	return data, nil
-}
+	if field.Required && (field.GoType == "string" || field.GoType == "ocsf.RawJSON" || field.IsTimestamp) {
+		checks += fmt.Sprintf("if %s == %s {\nerrs.Add(%s, \"required attribute is missing\")\n}\n", ref, zeroValue(field.GoType), path)
+	}
 
-func sanitizeSchema(schema map[string]interface{}) (classes, objects, types map[string]interface{}) {
-	profiles := schema["profiles"].(map[string]interface{})
-	classes = schema["classes"].(map[string]interface{})
-	objects = schema["objects"].(map[string]interface{})
+	goType := strings.TrimPrefix(field.GoType, "*")
+	sibling, hasSibling := field.Attribute["sibling"].(string)
+	if _, hasEnum := field.Attribute["enum"]; !hasEnum || (goType != "int32" && goType != "int64") {
+		return enums, checks
+	}
+	if !strings.HasSuffix(field.Name, "_id") && !hasSibling {
+		return enums, checks
+	}
 
-	types = schema["types"].(map[string]interface{})
+	captionsVar := sanitizedObjectCaption + field.Title + "Captions"
+	enums += fmt.Sprintf("var %s = map[%s]string{\n", captionsVar, goType)
+	for _, value := range sortedEnumValues(field.Attribute) {
+		caption := field.Attribute["enum"].(map[string]interface{})[strconv.Itoa(value)].(map[string]interface{})["caption"].(string)
+		enums += fmt.Sprintf("%d: %q,\n", value, caption)
+	}
+	enums += "}\n\n"
 
-	removeProfileFields(classes, profiles)
-	removeDeprecatedFields(objects)
-	removeDeprecatedFields(classes)
-	removeDTFields(objects)
-	removeDTFields(classes)
+	value := ref
+	if !field.Required {
+		value = "*" + ref
+	}
 
-	return classes, objects, types
-}
+	var enumChecks string
+	if strings.HasSuffix(field.Name, "_id") {
+		enumChecks += fmt.Sprintf("ocsf.CheckEnum(&errs, %s, %s, %s)\n", path, value, captionsVar)
+	}
+	if siblingField, ok := fieldsByName[sibling]; ok && strings.TrimPrefix(siblingField.GoType, "*") == "string" {
+		siblingRef := "v." + siblingField.Title
+		if siblingField.Required {
+			siblingRef = "&" + siblingRef
+		}
+		enumChecks += fmt.Sprintf("ocsf.CheckSibling(&errs, ocsf.JoinPath(path, %q), %s, %s, %s)\n", sibling, value, siblingRef, captionsVar)
+	}
 
-func generateGoStruct(
-	packageName, genDir string,
-	class, objects, types map[string]interface{},
-	observables []Observable) error {
-	classFields, ok := class["attributes"].(map[string]interface{})
-	if !ok {
-		return nil
+	if field.Required {
+		checks += enumChecks
+	} else {
+		checks += fmt.Sprintf("if %s != nil {\n%s}\n", ref, enumChecks)
 	}
 
+	return enums, checks
+}
+
+// generateAccessors generates Get and Set, which read and write attributes by dotted attribute path,
+// e.g. "finding_info.uid" or "resources[0].owner.name".
+func generateAccessors(class map[string]interface{}, fields []GeneratedField) string {
 	sanitizedObjectCaption := sanitizeCaption(class["caption"].(string))
-	className := class["name"].(string)
 
-	fileHeader := fmt.Sprintf(`
-// autogenerated by scripts/model_gen.go. DO NOT EDIT
-package %s
+	var getCases, setCases string
+	for _, field := range fields {
+		ref := "v." + field.Title
+		elemType := strings.TrimPrefix(strings.TrimPrefix(field.GoType, "[]"), "*")
+		descends := field.IsObject && !field.IsRef
+
+		getCases += fmt.Sprintf("case %q:\n", field.Name)
+		setCases += fmt.Sprintf("case %q:\n", field.Name)
+
+		switch {
+		case field.IsArray:
+			getCases += fmt.Sprintf("if rest == \"\" {\nif index < 0 {\nreturn %s, %s != nil\n}\nreturn ocsf.Index(%s, index)\n}\n", ref, ref, ref)
+			setCases += fmt.Sprintf("if index < 0 {\nif rest != \"\" {\nreturn ocsf.UnknownPath(path)\n}\nreturn ocsf.SetRequired(&%s, value)\n}\n", ref)
+			setCases += fmt.Sprintf("elem, err := ocsf.Element(&%s, index)\nif err != nil {\nreturn err\n}\n", ref)
+			if descends {
+				getCases += fmt.Sprintf("if index < 0 || index >= len(%s) {\nreturn nil, false\n}\nreturn %s[index].Get(rest)\n", ref, ref)
+				setCases += "if rest == \"\" {\nreturn ocsf.SetRequired(elem, value)\n}\nreturn elem.Set(rest, value)\n"
+			} else {
+				getCases += "return nil, false\n"
+				setCases += "if rest != \"\" {\nreturn ocsf.UnknownPath(path)\n}\nreturn ocsf.SetRequired(elem, value)\n"
+			}
 
-import (
-	"github.com/apache/arrow-go/v18/arrow"
-)
+		case strings.HasPrefix(field.GoType, "*"):
+			if descends {
+				getCases += fmt.Sprintf("if index >= 0 || %s == nil {\nreturn nil, false\n}\nif rest == \"\" {\nreturn %s, true\n}\nreturn %s.Get(rest)\n", ref, ref, ref)
+				setCases += fmt.Sprintf("if index >= 0 {\nreturn ocsf.UnknownPath(path)\n}\nif rest == \"\" {\nreturn ocsf.SetOptional(&%s, value)\n}\n", ref)
+				setCases += fmt.Sprintf("if %s == nil {\n%s = &%s{}\n}\nreturn %s.Set(rest, value)\n", ref, ref, elemType, ref)
+				continue
+			}
 
-`, packageName)
+			value := "*" + ref
+			if field.IsObject {
+				value = ref
+			}
+			getCases += fmt.Sprintf("if index >= 0 || rest != \"\" || %s == nil {\nreturn nil, false\n}\nreturn %s, true\n", ref, value)
+			setCases += fmt.Sprintf("if index >= 0 || rest != \"\" {\nreturn ocsf.UnknownPath(path)\n}\nreturn ocsf.SetOptional(&%s, value)\n", ref)
 
-	arrowFields := fmt.Sprintf("var %sFields = []arrow.Field{\n", sanitizedObjectCaption)
-	goStruct := fmt.Sprintf("type %s struct {\n", sanitizedObjectCaption)
+		default:
+			if descends {
+				getCases += fmt.Sprintf("if index >= 0 {\nreturn nil, false\n}\nif rest == \"\" {\nreturn &%s, true\n}\nreturn %s.Get(rest)\n", ref, ref)
+				setCases += fmt.Sprintf("if index >= 0 {\nreturn ocsf.UnknownPath(path)\n}\nif rest == \"\" {\nreturn ocsf.SetRequired(&%s, value)\n}\nreturn %s.Set(rest, value)\n", ref, ref)
+				continue
+			}
 
-	var hasObservablesField bool
-	for _, fieldName := range sortedKeys(classFields) {
-		if fieldName == "observables" {
-			hasObservablesField = true
+			value := ref
+			if field.IsObject {
+				value = "&" + ref
+			}
+			getCases += fmt.Sprintf("if index >= 0 || rest != \"\" {\nreturn nil, false\n}\nreturn %s, true\n", value)
+			setCases += fmt.Sprintf("if index >= 0 || rest != \"\" {\nreturn ocsf.UnknownPath(path)\n}\nreturn ocsf.SetRequired(&%s, value)\n", ref)
 		}
-		fieldValue := classFields[fieldName].(map[string]interface{})
+	}
 
-		required := fieldValue["requirement"] == "required"
-		rawType := fieldValue["type"].(string)
+	// Objects without attributes, such as the generic object, only use the attribute name.
+	split := "name, index, rest, err := ocsf.SplitPath(path)"
+	if getCases == "" {
+		split = "name, _, _, err := ocsf.SplitPath(path)"
+	}
 
-		titleSubstrings := strings.Split(fieldName, "_")
-		for idx := range titleSubstrings {
-			titleSubstrings[idx] = strings.ToUpper(string(titleSubstrings[idx][0])) + titleSubstrings[idx][1:]
+	return fmt.Sprintf(`// Get returns the value of the attribute at a dotted attribute path, e.g. "finding_info.uid" or
+	// "resources[0].owner.name". Objects are returned as pointers into v. It reports false when the
+	// path is unknown or the attribute is not set.
+	func (v *%s) Get(path string) (any, bool) {
+		%s
+		if err != nil {
+			return nil, false
 		}
 
-		fieldTitle := strings.Join(titleSubstrings, "")
+		switch name {
+		%s}
 
-		var fieldType string
-		var arrowType string
-		var isTimestamp bool
-		if strings.HasSuffix(rawType, "_t") {
-			if rawType == "date_t" {
-				fieldType = "int32"
-				arrowType = "arrow.FixedWidthTypes.Date32"
-			} else if rawType == "timestamp_t" {
-				fieldType = "int64"
-				isTimestamp = true
-				arrowType = "arrow.FixedWidthTypes.Timestamp_ms"
-			} else {
-				var err error
-				fieldType, err = resolveOCSFType(rawType, types)
-				if err != nil {
-					log.Fatalf("Failed to resolve OCSF type: %v", err)
-				}
-				arrowType = goTypeToArrowType(fieldType)
-			}
-		} else {
-			if rawType == "object" {
-				fieldType = "string"
-				arrowType = goTypeToArrowType(fieldType)
-			} else {
-				fieldType = sanitizeCaption(objects[rawType].(map[string]interface{})["caption"].(string))
-				if refTree[sanitizedObjectCaption] == nil {
-					refTree[sanitizedObjectCaption] = make(map[string]bool)
-				}
-				refTree[sanitizedObjectCaption][fieldType] = true
+		return nil, false
+	}
 
-				if fieldRefTree, ok := refTree[fieldType]; ok && fieldRefTree[sanitizedObjectCaption] {
-					arrowType = fmt.Sprintf("%sRefStruct", fieldType)
-					fieldType = fmt.Sprintf("%sRef", fieldType)
-					refStructsUsed[fieldValue["type"].(string)] = true
-				} else {
-					arrowType = fmt.Sprintf("%sStruct", fieldType)
-				}
-			}
+	// Set assigns value to the attribute at a dotted attribute path, creating the objects along the
+	// path as needed. Indexing one past the end of a list appends to it.
+	func (v *%s) Set(path string, value any) error {
+		%s
+		if err != nil {
+			return err
 		}
 
-		if !required && !isTimestamp {
-			fieldType = "*" + fieldType
+		switch name {
+		%s}
+
+		return ocsf.UnknownPath(path)
+	}
+
+	`, sanitizedObjectCaption, split, getCases, sanitizedObjectCaption, split, setCases)
+}
+
+// generateAppendObservables generates AppendObservables, which collects an observable for every
+// observable attribute and object set on a value. Scalar attributes are observable when their type
+// (e.g. ip_t) or the attribute itself defines an observable type_id, and objects are observable when
+// the object defines one.
+func generateAppendObservables(class map[string]interface{}, fields []GeneratedField, objects, types map[string]interface{}, isClass bool) string {
+	sanitizedObjectCaption := sanitizeCaption(class["caption"].(string))
+	captions := observableTypeCaptions(objects)
+
+	var appends string
+	if typeID, ok := class["observable"].(float64); ok && !isClass {
+		appends += fmt.Sprintf("if path != \"\" {\nobservables = append(observables, %s)\n}\n",
+			observableLiteral("path", int(typeID), captions, ""))
+	}
+
+	for _, field := range fields {
+		if field.IsRef || field.Name == "observables" {
+			continue
 		}
 
-		var extraTags string
-		if fieldValue["is_array"] == true {
-			// A bug in go-parquet causes it to not handle slices of pointers.
-			if !required {
-				fieldType = strings.ReplaceAll(fieldType, "*", "")
+		path := fmt.Sprintf("ocsf.JoinPath(path, %q)", field.Name)
+		ref := "v." + field.Title
+
+		if field.IsObject {
+			switch {
+			case field.IsArray:
+				appends += fmt.Sprintf("for i := range %s {\nobservables = %s[i].AppendObservables(%s, observables)\n}\n", ref, ref, path)
+			case field.Required:
+				appends += fmt.Sprintf("observables = %s.AppendObservables(%s, observables)\n", ref, path)
+			default:
+				appends += fmt.Sprintf("if %s != nil {\nobservables = %s.AppendObservables(%s, observables)\n}\n", ref, ref, path)
 			}
-			fieldType = "[]" + fieldType
-			arrowType = "arrow.ListOf(" + arrowType + ")"
-			extraTags = ",list"
+			continue
 		}
 
-		if isTimestamp {
-			extraTags = ",timestamp_millis,timestamp(millisecond)"
+		typeID, ok := attributeObservable(field.Attribute, types)
+		if !ok {
+			continue
 		}
 
-		goStruct += fmt.Sprintf("\n// %s: %s\n", fieldValue["caption"].(string), fieldValue["description"].(string))
-		if required {
-			goStruct += fmt.Sprintf("%s %s `json:\"%s\" parquet:\"%s%s\"`\n", fieldTitle, fieldType, fieldName, fieldName, extraTags)
-			arrowFields += fmt.Sprintf("{Name: \"%s\", Type: %s, Nullable: false},\n", fieldName, arrowType)
-		} else {
-			goStruct += fmt.Sprintf("%s %s `json:\"%s,omitempty\" parquet:\"%s%s,optional\"`\n", fieldTitle, fieldType, fieldName, fieldName, extraTags)
-			arrowFields += fmt.Sprintf("{Name: \"%s\", Type: %s, Nullable: true},\n", fieldName, arrowType)
+		goType := strings.TrimPrefix(strings.TrimPrefix(field.GoType, "[]"), "*")
+		switch {
+		case field.IsArray:
+			appends += fmt.Sprintf("for i := range %s {\nobservables = append(observables, %s)\n}\n",
+				ref, observableLiteral(path, typeID, captions, observableValueExpr(ref+"[i]", goType)))
+		case field.Required:
+			appends += fmt.Sprintf("observables = append(observables, %s)\n",
+				observableLiteral(path, typeID, captions, observableValueExpr(ref, goType)))
+		default:
+			appends += fmt.Sprintf("if %s != nil {\nobservables = append(observables, %s)\n}\n",
+				ref, observableLiteral(path, typeID, captions, observableValueExpr("*"+ref, goType)))
 		}
 	}
 
-	goStruct += "}\n"
-	arrowFields += "}\n"
-
-	isObservFuncBody := "return nil, \"\""
-	if obs_num, ok := class["observable"].(float64); ok {
-		isObservFuncBody = fmt.Sprintf("typeId := %d\nreturn &typeId, \"%s\"", int(obs_num), className)
-	}
-
-	isObservable := fmt.Sprintf(`func (v *%s) Observable() (*int, string) {
+	return fmt.Sprintf(`// AppendObservables appends an observable for every observable attribute and object set on v to
+	// observables. path is the dotted attribute path of v within the event.
+	func (v *%s) AppendObservables(path string, observables []Observable) []Observable {
 		%s
+		return observables
 	}
 
-	`, sanitizedObjectCaption, isObservFuncBody)
+	`, sanitizedObjectCaption, appends)
+}
 
-	goStruct += isObservable
+// generatePopulateObservables generates PopulateObservables for classes with an observables
+// attribute.
+func generatePopulateObservables(class map[string]interface{}) string {
+	return fmt.Sprintf(`// PopulateObservables adds an observable for every observable attribute and object set on the
+	// event to Observables. Observables which are already present are not added again.
+	func (v *%s) PopulateObservables() {
+		seen := make(map[string]bool)
+		for _, observable := range v.Observables {
+			seen[ocsf.ObservableKey(observable.Name, observable.TypeId, observable.Value)] = true
+		}
 
-	if hasObservablesField {
-		validateObservables := fmt.Sprintf(`func (v *%s) ValidateObservables() error {
-			presentObservables := ocsf.PresentObservablesOf(v)
-			for presObsIdx := range presentObservables {
-				var found bool
-				for obsIdx := range v.Observables {
-					presObsEnum := presentObservables[presObsIdx][0].(*int)
-					if v.Observables[obsIdx].TypeId == int32(*presObsEnum) {
-						found = true
-						break
-					}
-				}
-				if !found {
-					obs := presentObservables[presObsIdx]
-					return fmt.Errorf("non-null observable %%s(%%d) not found in observables array", obs[1], *obs[0].(*int))
-				}
+		for _, observable := range v.AppendObservables("", nil) {
+			key := ocsf.ObservableKey(observable.Name, observable.TypeId, observable.Value)
+			if seen[key] {
+				continue
 			}
-			return nil
+			seen[key] = true
+			v.Observables = append(v.Observables, observable)
 		}
-
-		`, sanitizedObjectCaption)
-
-		goStruct += validateObservables
 	}
 
-	arrowStruct := fmt.Sprintf("var %sStruct = arrow.StructOf(%sFields...)\n", sanitizedObjectCaption, sanitizedObjectCaption)
-	arrowSchemaDec := fmt.Sprintf("var %sSchema = arrow.NewSchema(%sFields, nil)", sanitizedObjectCaption, sanitizedObjectCaption)
-	arrowClassname := fmt.Sprintf("var %sClassname = \"%s\"\n", sanitizedObjectCaption, className)
-	finalOutput := fileHeader + "\n" + goStruct + "\n" + arrowFields + "\n" + arrowStruct + "\n" + arrowSchemaDec + "\n" + arrowClassname
-
-	filename := class["name"].(string) + ".go"
+	`, sanitizeCaption(class["caption"].(string)))
+}
 
-	err := os.WriteFile(genDir+"/"+filename, []byte(finalOutput), 0644)
-	if err != nil {
-		return err
+// attributeObservable returns the observable type_id of a scalar attribute, which is defined either
+// by the attribute itself (e.g. resource_details.uid) or by its type (e.g. ip_t).
+func attributeObservable(attribute, types map[string]interface{}) (int, bool) {
+	if typeID, ok := attribute["observable"].(float64); ok {
+		return int(typeID), true
 	}
 
-	cmd := exec.Command("goimports", "-w", fmt.Sprintf(genDir+"/%s", filename))
-	if err := cmd.Run(); err != nil {
-		return fmt.Errorf("failed to gofmt file %s: %v", fmt.Sprintf(genDir+"/%s", filename), err)
+	if typ, ok := types[attribute["type"].(string)].(map[string]interface{}); ok {
+		if typeID, ok := typ["observable"].(float64); ok {
+			return int(typeID), true
+		}
 	}
 
-	return nil
+	return 0, false
 }
 
-func generateRefStructs(genDir string, class map[string]interface{}) error {
-	filepath := genDir + "/" + class["name"].(string) + ".go"
+// observableTypeCaptions returns the captions of the observable type_id enum, e.g. "IP Address"
+// for 2.
+func observableTypeCaptions(objects map[string]interface{}) map[int]string {
+	captions := make(map[int]string)
+
+	observable, _ := objects["observable"].(map[string]interface{})
+	attributes, _ := observable["attributes"].(map[string]interface{})
+	typeID, _ := attributes["type_id"].(map[string]interface{})
+	enum, _ := typeID["enum"].(map[string]interface{})
+	for key, value := range enum {
+		id, err := strconv.Atoi(key)
+		if err != nil {
+			continue
+		}
+		if caption, ok := value.(map[string]interface{})["caption"].(string); ok {
+			captions[id] = caption
+		}
+	}
+
+	return captions
+}
 
-	src, err := os.ReadFile(filepath)
-	if err != nil {
-		return err
+// observableLiteral returns a composite literal of an observable named by the path expression. value
+// is an expression of the observable's string value, or empty for object observables.
+func observableLiteral(path string, typeID int, captions map[int]string, value string) string {
+	literal := fmt.Sprintf("Observable{Name: ocsf.Ptr(%s), TypeId: %d", path, typeID)
+	if caption, ok := captions[typeID]; ok {
+		literal += fmt.Sprintf(", Type: ocsf.Ptr(%q)", caption)
 	}
-	fset := token.NewFileSet()
-	file, err := parser.ParseFile(fset, filepath, src, 0)
-	if err != nil {
-		return err
+	if value != "" {
+		literal += fmt.Sprintf(", Value: ocsf.Ptr(%s)", value)
 	}
+	return literal + "}"
+}
 
-	sanitizedObjectCaption := sanitizeCaption(class["caption"].(string))
-
-	var arrowFields, goStruct string
-	ast.Inspect(file, func(n ast.Node) bool {
-		gd, ok := n.(*ast.GenDecl)
-		if !ok || gd.Tok != token.VAR {
-			return true
-		}
+// observableValueExpr returns an expression formatting a scalar attribute value as an observable
+// value.
+func observableValueExpr(expr, goType string) string {
+	if goType == "string" {
+		return expr
+	}
+	return fmt.Sprintf("ocsf.ObservableValue(%s)", expr)
+}
 
-		for _, spec := range gd.Specs {
-			vs, ok := spec.(*ast.ValueSpec)
-			if !ok || len(vs.Values) != 1 {
-				continue
-			}
+// presenceExpr returns a boolean expression reporting whether a generated field is set.
+func presenceExpr(field GeneratedField) string {
+	ref := "v." + field.Title
+	switch {
+	case field.IsArray:
+		return fmt.Sprintf("len(%s) > 0", ref)
+	case strings.HasPrefix(field.GoType, "*"):
+		return ref + " != nil"
+	case field.IsObject || field.GoType == "bool":
+		return "true"
+	default:
+		return fmt.Sprintf("%s != %s", ref, zeroValue(field.GoType))
+	}
+}
 
-			cl, ok := vs.Values[0].(*ast.CompositeLit)
-			if !ok {
-				continue
-			}
+func zeroValue(goType string) string {
+	if goType == "string" || goType == "ocsf.RawJSON" {
+		return `""`
+	}
+	return "0"
+}
 
-			if arr, ok := cl.Type.(*ast.ArrayType); ok {
-				if sel, ok := arr.Elt.(*ast.SelectorExpr); ok &&
-					sel.Sel.Name == "Field" {
+// extensionLiteral returns a composite literal of the schema extension object describing the
+// extension which defined class, or an empty string for base classes.
+func extensionLiteral(class, objects, types map[string]interface{}) (string, error) {
+	extensionName, ok := class["extension"].(string)
+	if !ok {
+		return "", nil
+	}
+	extensionObject, ok := objects["extension"].(map[string]interface{})
+	if !ok {
+		return "", nil
+	}
 
-					start := fset.Position(gd.Pos()).Offset
-					end := fset.Position(cl.Rbrace).Offset + 1
+	values := map[string]string{"name": extensionName}
+	if uid, ok := class["extension_id"].(float64); ok {
+		values["uid"] = strconv.Itoa(int(uid))
+	}
+	if version, ok := class["extension_version"].(string); ok && version != "" {
+		values["version"] = version
+	}
 
-					arrowFields = string(src[start:end])
-					return false
-				}
-			}
+	extensionAttributes := extensionObject["attributes"].(map[string]interface{})
+	var fields []string
+	for _, name := range sortedKeys(extensionAttributes) {
+		value, ok := values[name]
+		if !ok {
+			continue
 		}
-		return true
-	})
 
-	ast.Inspect(file, func(n ast.Node) bool {
-		gd, ok := n.(*ast.GenDecl)
-		if !ok || gd.Tok != token.TYPE {
-			return true
+		expr, err := goValueExpr(extensionAttributes[name].(map[string]interface{}), types, strconv.Quote(value))
+		if err != nil {
+			return "", err
 		}
+		fields = append(fields, fmt.Sprintf("%s: %s", fieldTitle(name), expr))
+	}
 
-		for _, spec := range gd.Specs {
-			ts, ok := spec.(*ast.TypeSpec)
-			if !ok || ts.Name.Name != sanitizedObjectCaption {
-				continue
-			}
-			if _, ok := ts.Type.(*ast.StructType); !ok {
-				continue
-			}
-
-			start := fset.Position(gd.Pos()).Offset
-			end := fset.Position(ts.End()).Offset
-			goStruct = string(src[start:end])
-			return false
-		}
-		return true
-	})
+	return fmt.Sprintf("%s{%s}", sanitizeCaption(extensionObject["caption"].(string)), strings.Join(fields, ", ")), nil
+}
 
-	refArrowFields := strings.Replace(arrowFields, fmt.Sprintf("%sFields", sanitizedObjectCaption), fmt.Sprintf("%sRefFields", sanitizedObjectCaption), 1)
-	refGoStruct := strings.Replace(goStruct, fmt.Sprintf("%s", sanitizedObjectCaption), fmt.Sprintf("%sRef", sanitizedObjectCaption), 1)
+// selectedClassProfiles returns the profiles of a class which were selected for generation.
+func selectedClassProfiles(genSpec GenerationSpec, class map[string]interface{}) []string {
+	classProfiles, _ := class["profiles"].([]interface{})
 
-	for refField := range refTree[sanitizedObjectCaption] {
-		refArrowFields = regexp.MustCompile(fmt.Sprintf(".*Type: .*%s(Struct|RefStruct).*", refField)).ReplaceAllString(refArrowFields, "")
-		refGoStruct = regexp.MustCompile(fmt.Sprintf(".*%s(Ref)?.*", refField)).ReplaceAllString(refGoStruct, "")
+	var selected []string
+	for _, classProfile := range classProfiles {
+		for _, profile := range genSpec.Profiles {
+			if classProfile == profile {
+				selected = append(selected, profile)
+			}
+		}
 	}
+	sort.Strings(selected)
 
-	// The replace regex will strip the type declaration from the ref struct, so we need to add it back.
-	// Go does not support negative lookaheads.
-	if !strings.HasPrefix(refGoStruct, "type") {
-		refGoStruct = fmt.Sprintf("type %sRef struct {\n%s", sanitizedObjectCaption, refGoStruct)
-	}
+	return selected
+}
 
-	refArrowFields += fmt.Sprintf("\nvar %sRefStruct = arrow.StructOf(%sRefFields...)\n", sanitizedObjectCaption, sanitizedObjectCaption)
+// stringSliceLiteral returns the Go literal of a string slice.
+func stringSliceLiteral(values []string) string {
+	quoted := make([]string, len(values))
+	for i, value := range values {
+		quoted[i] = strconv.Quote(value)
+	}
+	return fmt.Sprintf("[]string{%s}", strings.Join(quoted, ", "))
+}
 
-	f, err := os.OpenFile(
-		filepath,
-		os.O_CREATE|os.O_WRONLY|os.O_APPEND,
-		0644,
-	)
+// goValueExpr converts expr into a value assignable to a scalar struct field, casting to the
+// resolved Go type and taking its address for optional fields.
+func goValueExpr(fieldValue, types map[string]interface{}, expr string) (string, error) {
+	goType, err := resolveOCSFType(fieldValue["type"].(string), types)
 	if err != nil {
-		return err
+		return "", err
 	}
-	defer f.Close()
 
-	if _, err := f.Write([]byte(refArrowFields + refGoStruct)); err != nil {
-		return err
+	if goType != "string" {
+		expr = fmt.Sprintf("%s(%s)", goType, expr)
 	}
 
-	cmd := exec.Command("gofmt", "-w", filepath)
-	if err := cmd.Run(); err != nil {
-		return fmt.Errorf("failed to gofmt file %s: %v", filepath, err)
+	if fieldValue["requirement"] != "required" {
+		expr = fmt.Sprintf("ocsf.Ptr(%s)", expr)
 	}
 
-	return nil
+	return expr, nil
 }
 
-func sanitizeCaption(caption string) string {
-	re := regexp.MustCompile(`[^a-zA-Z0-9]+`)
-	return re.ReplaceAllString(caption, "")
+// sortedEnumValues returns the numeric keys of an attribute enum in ascending order.
+func sortedEnumValues(fieldValue map[string]interface{}) []int {
+	enum, _ := fieldValue["enum"].(map[string]interface{})
+
+	values := make([]int, 0, len(enum))
+	for key := range enum {
+		value, err := strconv.Atoi(key)
+		if err != nil {
+			log.Fatalf("Non-numeric enum value %q", key)
+		}
+		values = append(values, value)
+	}
+	sort.Ints(values)
+
+	return values
 }
 
 func removeDeprecatedFields(jsonObjects map[string]interface{}) {
@@ -448,7 +3196,9 @@ func removeDTFields(jsonObjects map[string]interface{}) {
 	}
 }
 
-func removeProfileFields(objects, profiles map[string]interface{}) {
+// removeProfileFields strips the attributes contributed by profiles which are not selected. Attributes
+// required by the class are always kept, as are attributes shared with a selected profile.
+func removeProfileFields(objects, profiles map[string]interface{}, selected map[string]bool) {
 	for objectName := range objects {
 		object := objects[objectName].(map[string]interface{})
 
@@ -456,24 +3206,36 @@ func removeProfileFields(objects, profiles map[string]interface{}) {
 			continue
 		}
 
+		objectAttributes := object["attributes"].(map[string]interface{})
 		objectProfiles := object["profiles"].([]interface{})
+
+		keep := make(map[string]bool)
 		for _, objectProfileName := range objectProfiles {
 			objectProfileName := objectProfileName.(string)
+			if !selected[objectProfileName] {
+				continue
+			}
+
 			profile := profiles[objectProfileName].(map[string]interface{})
+			for profileFieldName := range profile["attributes"].(map[string]interface{}) {
+				keep[profileFieldName] = true
+			}
+		}
 
-			objectAttributes := objects[objectName].(map[string]interface{})["attributes"].(map[string]interface{})
+		for _, objectProfileName := range objectProfiles {
+			objectProfileName := objectProfileName.(string)
+			profile := profiles[objectProfileName].(map[string]interface{})
 
 			profileFields := profile["attributes"].(map[string]interface{})
 			for profileFieldName := range profileFields {
 				objectProfileAttr, ok := objectAttributes[profileFieldName]
-				if !ok || objectProfileAttr.(map[string]interface{})["requirement"] == "required" {
+				if !ok || keep[profileFieldName] || objectProfileAttr.(map[string]interface{})["requirement"] == "required" {
 					continue
 				}
 				delete(objectAttributes, profileFieldName)
 			}
 		}
 	}
-	return
 }
 
 func resolveOCSFType(targetType string, types map[string]interface{}) (string, error) {
@@ -492,7 +3254,7 @@ func resolveOCSFType(targetType string, types map[string]interface{}) (string, e
 	case "Long":
 		return reflect.TypeOf(int64(0)).String(), nil
 	case "JSON":
-		return "string", nil
+		return "ocsf.RawJSON", nil
 	case "Integer":
 		return "int32", nil
 	case "Float":
@@ -556,7 +3318,7 @@ func goTypeToArrowType(targetType string) string {
 	targetType = strings.TrimPrefix(targetType, "*")
 
 	switch targetType {
-	case "string":
+	case "string", "ocsf.RawJSON":
 		arrowType := "arrow.BinaryTypes.String"
 		if isList {
 			return "arrow.ListOf(" + arrowType + ")"
@@ -0,0 +1,561 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "Rewrite the golden files of the tests")
+
+// fixtureSchemaDir holds small schema exports in the format of the OCSF schema server, which cover
+// the attribute kinds the generator handles without the size of a real export.
+var fixtureSchemaDir = filepath.Join("testdata", "schemas")
+
+// generateFixture generates the fixture schema of version into a package of a module in a temporary
+// directory, which requires this module through a replace directive so that the generated code can
+// be built and tested, and returns the module directory and the package directory. The JSON Schema,
+// Protobuf and Avro exports are written to the package's testdata directory.
+func generateFixture(t *testing.T, version string, genSpec GenerationSpec) (string, string) {
+	t.Helper()
+
+	schema, err := loadSchema(schemaPath(fixtureSchemaDir, version))
+	if err != nil {
+		t.Fatalf("failed to load fixture schema: %v", err)
+	}
+	for _, extension := range genSpec.Extensions {
+		if err := mergeExtension(schema, extension); err != nil {
+			t.Fatalf("failed to merge extension %s: %v", extension, err)
+		}
+	}
+	classes, objects, types := sanitizeSchema(schema, genSpec.Profiles)
+
+	dir := t.TempDir()
+	writeFixtureModule(t, dir)
+
+	genSpec.Version = version
+	if genSpec.Package == "" {
+		genSpec.Package = versionPackage(version)
+	}
+	genSpec.Dir = filepath.Join(dir, genSpec.Package)
+	// The exports are written next to the package, so that its tests can read them.
+	genSpec.ExportDir = filepath.Join(genSpec.Dir, "testdata")
+	if genSpec.RefDepth == 0 {
+		genSpec.RefDepth = 1
+	}
+	if err := os.MkdirAll(genSpec.Dir, 0755); err != nil {
+		t.Fatalf("failed to create package directory: %v", err)
+	}
+
+	generateSchema(genSpec, classes, objects, types)
+	return dir, genSpec.Dir
+}
+
+// writeFixtureModule writes the go.mod and go.sum of the module fixtures are generated into. It
+// requires the same dependencies as this module, and this module itself from the working tree.
+func writeFixtureModule(t *testing.T, dir string) {
+	t.Helper()
+
+	root, err := filepath.Abs("..")
+	if err != nil {
+		t.Fatal(err)
+	}
+	goMod, err := os.ReadFile(filepath.Join(root, "go.mod"))
+	if err != nil {
+		t.Fatalf("failed to read go.mod: %v", err)
+	}
+	goSum, err := os.ReadFile(filepath.Join(root, "go.sum"))
+	if err != nil {
+		t.Fatalf("failed to read go.sum: %v", err)
+	}
+
+	const modulePath = "github.com/Santiago-Labs/go-ocsf"
+	fixtureMod := strings.Replace(string(goMod), "module "+modulePath+"\n", "module fixture\n", 1)
+	fixtureMod += fmt.Sprintf("\nrequire %s v0.0.0-00010101000000-000000000000\n\nreplace %s => %s\n", modulePath, modulePath, root)
+
+	if err := os.WriteFile(filepath.Join(dir, "go.mod"), []byte(fixtureMod), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "go.sum"), goSum, 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestGeneratedPackage generates the fixture schema and runs the tests in testdata/generated, which
+// exercise the generated methods, against the generated package. The tests in
+// testdata/generated_refs run against a package generated with deeper reference structs, and those in
+// testdata/generated_acme against a package generated with the acme extension fixture.
+func TestGeneratedPackage(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping the generated package tests in short mode")
+	}
+
+	profiles := []string{"cloud", "host", "security_control"}
+	var tests = []struct {
+		name    string
+		version string
+		tests   string
+		genSpec GenerationSpec
+	}{
+		{name: "Test the default generation", version: "1.4.0", tests: "generated", genSpec: GenerationSpec{Profiles: profiles}},
+		{name: "Test deeper reference structs", version: "1.4.0", tests: "generated_refs", genSpec: GenerationSpec{Profiles: profiles, RefDepth: 3, RefJSON: true}},
+		{name: "Test an extension", version: "1.5.0", tests: "generated_acme", genSpec: GenerationSpec{Profiles: profiles, Extensions: []string{filepath.Join("testdata", "extensions", "acme")}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			moduleDir, dir := generateFixture(t, tt.version, tt.genSpec)
+
+			tests, err := filepath.Glob(filepath.Join("testdata", tt.tests, "*_test.go"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, test := range tests {
+				data, err := os.ReadFile(test)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if err := os.WriteFile(filepath.Join(dir, filepath.Base(test)), data, 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			cmd := exec.Command("go", "test", "./"+filepath.Base(dir))
+			cmd.Dir = moduleDir
+			output, err := cmd.CombinedOutput()
+			if err != nil {
+				t.Fatalf("generated package tests failed: %v\n%s", err, output)
+			}
+		})
+	}
+}
+
+// TestBaselineFields checks that the committed packages keep every exported struct field of the
+// packages first published from the OCSF schema, listed in testdata/baseline, with its type. The
+// only type change allowed is a string attribute of the object or JSON type becoming ocsf.RawJSON.
+func TestBaselineFields(t *testing.T) {
+	for _, pkg := range []string{"v1_4_0", "v1_5_0"} {
+		t.Run(pkg, func(t *testing.T) {
+			fields := make(map[string]string)
+			packages, err := parser.ParseDir(token.NewFileSet(), filepath.Join("..", "ocsf", pkg), func(info fs.FileInfo) bool {
+				return !strings.HasSuffix(info.Name(), "_test.go")
+			}, 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, file := range packages[pkg].Files {
+				ast.Inspect(file, func(node ast.Node) bool {
+					spec, ok := node.(*ast.TypeSpec)
+					if !ok {
+						return true
+					}
+					if structType, ok := spec.Type.(*ast.StructType); ok {
+						for _, field := range structType.Fields.List {
+							for _, name := range field.Names {
+								fields[spec.Name.Name+"."+name.Name] = types.ExprString(field.Type)
+							}
+						}
+					}
+					return false
+				})
+			}
+
+			baseline, err := os.ReadFile(filepath.Join("testdata", "baseline", pkg+".txt"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, line := range strings.Split(strings.TrimSpace(string(baseline)), "\n") {
+				field, want, _ := strings.Cut(line, " ")
+				got, ok := fields[field]
+				switch {
+				case !ok:
+					t.Errorf("%s is missing", field)
+				case got != want && strings.ReplaceAll(want, "string", "ocsf.RawJSON") != got:
+					t.Errorf("%s has type %s, want %s", field, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestClassProfiles(t *testing.T) {
+	schema, err := loadSchema(schemaPath(fixtureSchemaDir, "1.4.0"))
+	if err != nil {
+		t.Fatalf("failed to load fixture schema: %v", err)
+	}
+	genSpec := GenerationSpec{Version: "1.4.0", Profiles: []string{"security_control", "cloud", "host"}}
+	classes, objects, types := sanitizeSchema(schema, genSpec.Profiles)
+
+	// A class without activity_id has no constructor, but still lists its profiles.
+	noActivity := deepCopy(classes["vulnerability_finding"]).(map[string]interface{})
+	delete(noActivity["attributes"].(map[string]interface{}), "activity_id")
+
+	var tests = []struct {
+		name  string
+		class map[string]interface{}
+		want  string
+	}{
+		{name: "Test a class with a constructor", class: classes["vulnerability_finding"].(map[string]interface{}), want: `[]string{"cloud", "host", "security_control"}`},
+		{name: "Test a class without activity_id", class: noActivity, want: `[]string{"cloud", "host", "security_control"}`},
+		{name: "Test a class with some profiles selected", class: classes["api_activity"].(map[string]interface{}), want: `[]string{"cloud", "host"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, err := generateClassConstructor(genSpec, tt.class, objects, types)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			structName := sanitizeCaption(tt.class["caption"].(string))
+			want := fmt.Sprintf("var %sProfiles = %s\n", structName, tt.want)
+			if !strings.Contains(output, want) {
+				t.Errorf("got\n%s\nwant it to contain %q", output, want)
+			}
+			if _, err := parser.ParseFile(token.NewFileSet(), "", "package p\n"+output, 0); err != nil {
+				t.Errorf("generated code does not parse: %v\n%s", err, output)
+			}
+		})
+	}
+}
+
+func TestClassConstructor(t *testing.T) {
+	schema, err := loadSchema(schemaPath(fixtureSchemaDir, "1.4.0"))
+	if err != nil {
+		t.Fatalf("failed to load fixture schema: %v", err)
+	}
+	genSpec := GenerationSpec{Version: "1.4.0"}
+	classes, objects, types := sanitizeSchema(schema, nil)
+
+	// Exports rebuilt from generated packages lack the activity_id values of most classes, and
+	// the uid of the Windows extension classes.
+	noEnum := deepCopy(classes["api_activity"]).(map[string]interface{})
+	delete(noEnum["attributes"].(map[string]interface{})["activity_id"].(map[string]interface{}), "enum")
+	noUID := deepCopy(noEnum).(map[string]interface{})
+	delete(noUID, "uid")
+
+	var tests = []struct {
+		name     string
+		class    map[string]interface{}
+		contains []string
+		excludes []string
+	}{
+		{
+			name:     "Test a class with activities",
+			class:    classes["api_activity"].(map[string]interface{}),
+			contains: []string{"APIActivityActivityIdUpdate APIActivityActivityId = 3", "v.ActivityName = ocsf.Ptr(activity.Caption())", "v.TypeUid = int64(APIActivityClassUid*100 + int(activity))", "}\nreturn \"Other\"\n}"},
+		},
+		{
+			name:     "Test a class without activities",
+			class:    noEnum,
+			contains: []string{"APIActivityActivityIdUnknown APIActivityActivityId = 0", "APIActivityActivityIdOther APIActivityActivityId = 99", "if caption := activity.Caption(); caption != \"\" {", "v.TypeUid = int64(APIActivityClassUid*100 + int(activity))"},
+			excludes: []string{"APIActivityActivityIdUpdate", "}\nreturn \"Other\"\n}"},
+		},
+		{
+			name:     "Test a class without a uid",
+			class:    noUID,
+			contains: []string{"func NewAPIActivity(activity APIActivityActivityId) APIActivity {", "v.ClassName = ocsf.Ptr(\"API Activity\")"},
+			excludes: []string{"APIActivityClassUid", "v.CategoryUid", "v.TypeUid"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, err := generateClassConstructor(genSpec, tt.class, objects, types)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			for _, want := range tt.contains {
+				if !strings.Contains(output, want) {
+					t.Errorf("got\n%s\nwant it to contain %q", output, want)
+				}
+			}
+			for _, exclude := range tt.excludes {
+				if strings.Contains(output, exclude) {
+					t.Errorf("got\n%s\nwant it not to contain %q", output, exclude)
+				}
+			}
+			if _, err := parser.ParseFile(token.NewFileSet(), "", "package p\n"+output, 0); err != nil {
+				t.Errorf("generated code does not parse: %v\n%s", err, output)
+			}
+		})
+	}
+}
+
+// TestMergeExtension merges the acme extension fixture into the fixture schema and compares the
+// definitions it adds or changes with testdata/extensions/acme.golden.json.
+func TestMergeExtension(t *testing.T) {
+	schema, err := loadSchema(schemaPath(fixtureSchemaDir, "1.5.0"))
+	if err != nil {
+		t.Fatalf("failed to load fixture schema: %v", err)
+	}
+	if err := mergeExtension(schema, filepath.Join("testdata", "extensions", "acme")); err != nil {
+		t.Fatalf("failed to merge extension: %v", err)
+	}
+
+	merged := map[string]interface{}{
+		"classes": map[string]interface{}{
+			"widget_activity":       schema["classes"].(map[string]interface{})["widget_activity"],
+			"vulnerability_finding": schema["classes"].(map[string]interface{})["vulnerability_finding"],
+		},
+		"objects": map[string]interface{}{
+			"widget": schema["objects"].(map[string]interface{})["widget"],
+			"device": schema["objects"].(map[string]interface{})["device"],
+		},
+		"types": map[string]interface{}{
+			"serial_t": schema["types"].(map[string]interface{})["serial_t"],
+		},
+	}
+	got, err := json.MarshalIndent(merged, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got = append(got, '\n')
+
+	golden := filepath.Join("testdata", "extensions", "acme.golden.json")
+	if *update {
+		if err := os.WriteFile(golden, got, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatalf("failed to read golden file, run with -update to create it: %v", err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("merged extension does not match %s, run with -update to rewrite it:\n%s", golden, got)
+	}
+}
+
+func TestClassifyExtensionClass(t *testing.T) {
+	extension := Extension{Name: "acme", UID: 9, Version: "1.0.0"}
+	categories := map[string]extensionCategory{
+		"application": {UID: 6, Caption: "Application Activity"},
+		"gadgets":     {UID: 901, Caption: "Gadget Activity"},
+	}
+
+	var tests = []struct {
+		name        string
+		category    string
+		uid         float64
+		classUID    int
+		categoryUID int
+		err         bool
+	}{
+		{name: "Test a base category", category: "application", uid: 3, classUID: 906003, categoryUID: 6},
+		{name: "Test an extension category", category: "gadgets", uid: 3, classUID: 901003, categoryUID: 901},
+		{name: "Test a prefixed category", category: "acme/gadgets", uid: 4, classUID: 901004, categoryUID: 901},
+		{name: "Test an unknown category", category: "widgets", uid: 3, err: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			class := map[string]interface{}{
+				"caption":  "Widget Activity",
+				"category": tt.category,
+				"uid":      tt.uid,
+				"attributes": map[string]interface{}{
+					"activity_id":  map[string]interface{}{"type": "integer_t", "enum": map[string]interface{}{"1": map[string]interface{}{"caption": "Create"}}},
+					"class_uid":    map[string]interface{}{"type": "integer_t"},
+					"category_uid": map[string]interface{}{"type": "integer_t"},
+					"type_uid":     map[string]interface{}{"type": "long_t"},
+				},
+			}
+
+			err := classifyExtensionClass(class, extension, categories)
+			if tt.err {
+				if err == nil {
+					t.Errorf("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if class["uid"] != float64(tt.classUID) || class["category_uid"] != float64(tt.categoryUID) {
+				t.Errorf("got uid %v and category_uid %v, want %d and %d", class["uid"], class["category_uid"], tt.classUID, tt.categoryUID)
+			}
+
+			typeUIDs := class["attributes"].(map[string]interface{})["type_uid"].(map[string]interface{})["enum"].(map[string]interface{})
+			if caption := typeUIDs[fmt.Sprint(tt.classUID*100+1)]; caption == nil {
+				t.Errorf("got type_uid enum %v, want %d", typeUIDs, tt.classUID*100+1)
+			}
+		})
+	}
+}
+
+// TestDiffSchemas compares the 1.4.0 and 1.5.0 fixture schemas and compares the text and JSON
+// reports with the golden files in testdata/diff.
+func TestDiffSchemas(t *testing.T) {
+	fromClasses, fromObjects, _, err := loadSanitizedSchema(fixtureSchemaDir, "", "1.4.0", false, nil)
+	if err != nil {
+		t.Fatalf("failed to load fixture schema: %v", err)
+	}
+	toClasses, toObjects, _, err := loadSanitizedSchema(fixtureSchemaDir, "", "1.5.0", false, nil)
+	if err != nil {
+		t.Fatalf("failed to load fixture schema: %v", err)
+	}
+
+	var tests = []struct {
+		name     string
+		classes  []string
+		golden   string
+		contains []string
+		excludes []string
+	}{
+		{
+			name:     "Test every class",
+			golden:   "all",
+			contains: []string{"vulnerability.cwe_uid", "api.version", "finding_info.title", "service.name"},
+		},
+		{
+			name:     "Test a selected class",
+			classes:  []string{"vulnerability_finding"},
+			golden:   "vulnerability_finding",
+			contains: []string{"vulnerability.cwe_uid", "finding_info.title"},
+			excludes: []string{"api.version"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report := diffSchemas("1.4.0", "1.5.0", fromClasses, fromObjects, toClasses, toObjects, tt.classes)
+
+			var text bytes.Buffer
+			writeSchemaDiff(&text, report)
+			data, err := json.MarshalIndent(report, "", "  ")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var paths []string
+			for _, change := range report.Changes {
+				if change.Attribute != "" {
+					paths = append(paths, change.Name+"."+change.Attribute)
+				}
+			}
+			for _, path := range tt.contains {
+				if !slices.Contains(paths, path) {
+					t.Errorf("got changes %v, want %s", paths, path)
+				}
+			}
+			for _, path := range tt.excludes {
+				if slices.Contains(paths, path) {
+					t.Errorf("got changes %v, want %s left out", paths, path)
+				}
+			}
+
+			for golden, got := range map[string][]byte{
+				filepath.Join("testdata", "diff", tt.golden+".txt"):  text.Bytes(),
+				filepath.Join("testdata", "diff", tt.golden+".json"): append(data, '\n'),
+			} {
+				if *update {
+					if err := os.WriteFile(golden, got, 0644); err != nil {
+						t.Fatal(err)
+					}
+				}
+				want, err := os.ReadFile(golden)
+				if err != nil {
+					t.Fatalf("failed to read golden file, run with -update to create it: %v", err)
+				}
+				if !bytes.Equal(got, want) {
+					t.Errorf("diff does not match %s, run with -update to rewrite it:\n%s", golden, got)
+				}
+			}
+		})
+	}
+}
+
+func TestMovesSource(t *testing.T) {
+	schemas := make(map[string]sanitizedSchema)
+	for _, version := range []string{"1.4.0", "1.5.0"} {
+		classes, objects, _, err := loadSanitizedSchema("schemas", "", version, false, nil)
+		if err != nil {
+			t.Fatalf("failed to load schema %s: %v", version, err)
+		}
+		schemas[version] = sanitizedSchema{Version: version, Classes: classes, Objects: objects}
+	}
+
+	moves, removals := schemaMoves(schemas["1.4.0"], schemas["1.5.0"])
+	want := []SchemaMove{
+		{Class: "authorize_session", From: "group", To: "user.groups[]"},
+		{Class: "user_access", From: "resource", To: "resources[]"},
+	}
+	if !reflect.DeepEqual(moves, want) {
+		t.Errorf("got moves %+v, want %+v", moves, want)
+	}
+	wantRemovals := []SchemaRemoval{
+		{Class: "cloud_resources_inventory_info", Attribute: "container"},
+		{Class: "config_state", Attribute: "cis_benchmark_result"},
+		{Class: "security_finding", Attribute: "cis_csc"},
+	}
+	if !reflect.DeepEqual(removals, wantRemovals) {
+		t.Errorf("got removals %+v, want %+v", removals, wantRemovals)
+	}
+
+	// The moves table of the convert package is generated from the cached exports.
+	got, err := movesSource(schemas)
+	if err != nil {
+		t.Fatal(err)
+	}
+	generated, err := os.ReadFile(filepath.Join("..", "ocsf", "convert", "moves_gen.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, generated) {
+		t.Errorf("moves_gen.go is out of date, regenerate it:\n%s", got)
+	}
+}
+
+func TestFetchSchema(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Path != "/1.5.0/export/schema" {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprint(w, `{"version":"1.5.0","classes":{},"objects":{}}`)
+	}))
+	defer server.Close()
+
+	schemaDir := t.TempDir()
+	schemaURL := server.URL + "/%s/export/schema"
+
+	if _, err := loadSchema(schemaPath(schemaDir, "1.5.0")); err == nil || !strings.Contains(err.Error(), "-fetch") {
+		t.Errorf("got %v for an uncached version, want a hint to use -fetch", err)
+	}
+
+	path := schemaPath(schemaDir, "1.5.0")
+	for range 2 {
+		if err := fetchSchema(schemaURL, "1.5.0", path); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if requests != 1 {
+		t.Errorf("got %d requests, want the cached export reused", requests)
+	}
+	schema, err := loadSchema(path)
+	if err != nil || schema["version"] != "1.5.0" {
+		t.Errorf("got %v, %v, want the cached 1.5.0 export", schema, err)
+	}
+
+	if err := fetchSchema(schemaURL, "9.9.9", schemaPath(schemaDir, "9.9.9")); err == nil {
+		t.Errorf("expected an error for a version the server does not have")
+	}
+}
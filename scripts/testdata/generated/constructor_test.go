@@ -0,0 +1,29 @@
+package v1_4_0
+
+import "testing"
+
+func TestNewAPIActivity(t *testing.T) {
+	activity := NewAPIActivity(APIActivityActivityIdUpdate)
+
+	if activity.ActivityId != 3 || *activity.ActivityName != "Update" {
+		t.Errorf("got activity %d %q, want 3 Update", activity.ActivityId, *activity.ActivityName)
+	}
+	if activity.ClassUid != APIActivityClassUid || *activity.ClassName != "API Activity" {
+		t.Errorf("got class %d %q, want 6003 API Activity", activity.ClassUid, *activity.ClassName)
+	}
+	if activity.CategoryUid != APIActivityCategoryUid || *activity.CategoryName != "Application Activity" {
+		t.Errorf("got category %d %q, want 6 Application Activity", activity.CategoryUid, *activity.CategoryName)
+	}
+	if activity.TypeUid != 600303 || *activity.TypeName != "API Activity: Update" {
+		t.Errorf("got type %d %q, want 600303 API Activity: Update", activity.TypeUid, *activity.TypeName)
+	}
+	if activity.Metadata.Version != "1.4.0" {
+		t.Errorf("got metadata.version %q, want 1.4.0", activity.Metadata.Version)
+	}
+}
+
+func TestActivityCaption(t *testing.T) {
+	if caption := APIActivityActivityId(42).Caption(); caption != "Other" {
+		t.Errorf("got caption %q for an unknown activity, want Other", caption)
+	}
+}
@@ -0,0 +1,54 @@
+package v1_4_0
+
+import (
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestDecode(t *testing.T) {
+	event, err := Decode([]byte(`{"class_uid": 2002, "activity_id": 1, "finding_info": {"uid": "finding-1"}}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	finding, ok := event.(*VulnerabilityFinding)
+	if !ok {
+		t.Fatalf("got %T, want *VulnerabilityFinding", event)
+	}
+	if finding.FindingInfo.Uid != "finding-1" {
+		t.Errorf("got finding_info.uid %q, want finding-1", finding.FindingInfo.Uid)
+	}
+
+	for _, data := range []string{`{"class_uid": 9999}`, `{"activity_id": 1}`, `{"class_uid": "2002"}`, `[`} {
+		if _, err := Decode([]byte(data)); err == nil {
+			t.Errorf("expected an error decoding %s", data)
+		}
+	}
+}
+
+func TestNewDecoder(t *testing.T) {
+	stream := `{"class_uid": 6003, "api": {"operation": "GetObject"}}
+{"class_uid": 2002, "finding_info": {"uid": "finding-1"}}
+{"class_uid": 9999}
+`
+	decoder := NewDecoder(strings.NewReader(stream))
+
+	var classes []int32
+	for {
+		event, err := decoder.Decode()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			if !strings.Contains(err.Error(), "event 3") {
+				t.Errorf("got %v, want an error for the third event", err)
+			}
+			continue
+		}
+		classes = append(classes, event.GetClassUid())
+	}
+
+	if len(classes) != 2 || classes[0] != APIActivityClassUid || classes[1] != VulnerabilityFindingClassUid {
+		t.Errorf("got classes %v, want 6003 and 2002", classes)
+	}
+}
@@ -0,0 +1,70 @@
+package v1_4_0
+
+import (
+	"testing"
+
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
+)
+
+func TestClasses(t *testing.T) {
+	var tests = []struct {
+		name   string
+		class  ocsf.Class
+		schema any
+	}{
+		{name: "Test an activity", class: ocsf.Class{Name: "api_activity", Package: "v1_4_0", Version: "1.4.0"}, schema: APIActivitySchema},
+		{name: "Test a finding", class: ocsf.Class{Name: "vulnerability_finding", Package: "v1_4_0", Version: "1.4.0"}, schema: VulnerabilityFindingSchema},
+	}
+
+	classes := Classes()
+	if len(classes) != len(tests) {
+		t.Fatalf("got %d classes, want %d", len(classes), len(tests))
+	}
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := classes[i]
+			if got.Name != tt.class.Name || got.Package != tt.class.Package || got.Version != tt.class.Version {
+				t.Errorf("got %s %s %s, want %s %s %s", got.Name, got.Package, got.Version, tt.class.Name, tt.class.Package, tt.class.Version)
+			}
+			if any(got.Schema) != tt.schema {
+				t.Errorf("got a different schema for %s", got.Name)
+			}
+		})
+	}
+}
+
+func TestEvent(t *testing.T) {
+	finding := VulnerabilityFinding{
+		ActivityId:  2,
+		CategoryUid: VulnerabilityFindingCategoryUid,
+		ClassUid:    VulnerabilityFindingClassUid,
+		SeverityId:  4,
+		Time:        1700000000000,
+		TypeUid:     200202,
+		FindingInfo: FindingInformation{Uid: "finding-1"},
+	}
+
+	var event Event = &finding
+	if event.GetClassUid() != 2002 || event.GetCategoryUid() != 2 || event.GetActivityId() != 2 || event.GetTypeUid() != 200202 {
+		t.Errorf("got class %d, category %d, activity %d and type %d", event.GetClassUid(), event.GetCategoryUid(), event.GetActivityId(), event.GetTypeUid())
+	}
+	if event.GetSeverityId() != 4 || event.GetTime() != 1700000000000 {
+		t.Errorf("got severity %d and time %d", event.GetSeverityId(), event.GetTime())
+	}
+	event.GetMetadata().Version = "1.4.0"
+	if finding.Metadata.Version != "1.4.0" {
+		t.Errorf("GetMetadata does not return the event's metadata")
+	}
+
+	findingEvent, ok := event.(FindingEvent)
+	if !ok {
+		t.Fatalf("%T does not implement FindingEvent", event)
+	}
+	if findingEvent.GetFindingUid() != "finding-1" || findingEvent.GetFindingInfo().Uid != "finding-1" {
+		t.Errorf("got finding uid %q", findingEvent.GetFindingUid())
+	}
+
+	if _, ok := any(&APIActivity{}).(FindingEvent); ok {
+		t.Errorf("APIActivity implements FindingEvent, want only the Findings category")
+	}
+}
@@ -0,0 +1,290 @@
+package v1_4_0
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
+)
+
+// exportDir holds the exports generated alongside the package by the fixture harness.
+var exportDir = filepath.Join("testdata", "v1_4_0")
+
+func exportedAPIActivity(t *testing.T) map[string]any {
+	t.Helper()
+
+	activity := validAPIActivity()
+	activity.Resources = []ResourceDetails{{Uid: ocsf.Ptr("bucket"), Owner: &User{Name: ocsf.Ptr("alice")}}}
+	unmapped := ocsf.RawJSON(`{"region":"us-east-1"}`)
+	activity.Unmapped = &unmapped
+
+	data, err := json.Marshal(activity)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var event map[string]any
+	if err := json.Unmarshal(data, &event); err != nil {
+		t.Fatal(err)
+	}
+	return event
+}
+
+// structTypes returns the generated structs reachable from t by name.
+func structTypes(t reflect.Type, types map[string]reflect.Type) map[string]reflect.Type {
+	for t.Kind() == reflect.Pointer || t.Kind() == reflect.Slice {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct || types[t.Name()] != nil {
+		return types
+	}
+	types[t.Name()] = t
+	for i := range t.NumField() {
+		structTypes(t.Field(i).Type, types)
+	}
+	return types
+}
+
+// jsonFields returns the fields of a generated struct by JSON name, and whether they are omitted
+// when empty.
+func jsonFields(t reflect.Type) map[string]bool {
+	fields := make(map[string]bool)
+	for i := range t.NumField() {
+		name, options, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+		fields[name] = options == "omitempty"
+	}
+	return fields
+}
+
+// validateJSONSchema checks value against the subset of JSON Schema the generator writes.
+func validateJSONSchema(schema map[string]any, defs map[string]any, path string, value any) []string {
+	if ref, ok := schema["$ref"].(string); ok {
+		return validateJSONSchema(defs[strings.TrimPrefix(ref, "#/$defs/")].(map[string]any), defs, path, value)
+	}
+
+	var errs []string
+	switch schema["type"] {
+	case "object":
+		object, ok := value.(map[string]any)
+		if !ok {
+			return []string{path + ": not an object"}
+		}
+		properties := schema["properties"].(map[string]any)
+		for _, name := range schema["required"].([]any) {
+			if _, ok := object[name.(string)]; !ok {
+				errs = append(errs, ocsf.JoinPath(path, name.(string))+": required")
+			}
+		}
+		for name, v := range object {
+			property, ok := properties[name]
+			if !ok {
+				errs = append(errs, ocsf.JoinPath(path, name)+": not allowed")
+				continue
+			}
+			errs = append(errs, validateJSONSchema(property.(map[string]any), defs, ocsf.JoinPath(path, name), v)...)
+		}
+	case "array":
+		list, ok := value.([]any)
+		if !ok {
+			return []string{path + ": not an array"}
+		}
+		for i, v := range list {
+			errs = append(errs, validateJSONSchema(schema["items"].(map[string]any), defs, fmt.Sprintf("%s[%d]", path, i), v)...)
+		}
+	case "string":
+		if _, ok := value.(string); !ok {
+			errs = append(errs, path+": not a string")
+		}
+	case "integer":
+		if n, ok := value.(float64); !ok || n != float64(int64(n)) {
+			errs = append(errs, path+": not an integer")
+		}
+	case "number":
+		if _, ok := value.(float64); !ok {
+			errs = append(errs, path+": not a number")
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			errs = append(errs, path+": not a boolean")
+		}
+	}
+	return errs
+}
+
+func TestJSONSchemaExport(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join(exportDir, "jsonschema", "api_activity.schema.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var schema map[string]any
+	if err := json.Unmarshal(data, &schema); err != nil {
+		t.Fatalf("invalid JSON Schema: %v", err)
+	}
+	defs, _ := schema["$defs"].(map[string]any)
+
+	var tests = []struct {
+		name   string
+		modify func(map[string]any)
+		errs   []string
+	}{
+		{name: "Test an event written by the generated struct", modify: func(map[string]any) {}},
+		{name: "Test a missing required attribute", modify: func(v map[string]any) { delete(v, "time") }, errs: []string{"time: required"}},
+		{name: "Test an unknown attribute", modify: func(v map[string]any) { v["colour"] = "red" }, errs: []string{"colour: not allowed"}},
+		{name: "Test a nested attribute of the wrong type", modify: func(v map[string]any) { v["api"].(map[string]any)["operation"] = 1.0 }, errs: []string{"api.operation: not a string"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := exportedAPIActivity(t)
+			tt.modify(event)
+			errs := validateJSONSchema(schema, defs, "", event)
+			if strings.Join(errs, "\n") != strings.Join(tt.errs, "\n") {
+				t.Errorf("got %v, want %v", errs, tt.errs)
+			}
+		})
+	}
+}
+
+func TestAvroExport(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join(exportDir, "avro", "api_activity.avsc"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var record map[string]any
+	if err := json.Unmarshal(data, &record); err != nil {
+		t.Fatalf("invalid Avro schema: %v", err)
+	}
+
+	types := structTypes(reflect.TypeFor[APIActivity](), make(map[string]reflect.Type))
+	defined := make(map[string]bool)
+
+	// checkRecord compares a record, and the records it defines, with the generated struct of the
+	// same name. Named types must be defined before they are used.
+	var checkRecord func(record map[string]any)
+	var checkType func(path string, avroType any)
+	checkRecord = func(record map[string]any) {
+		name := record["name"].(string)
+		if defined[name] {
+			t.Errorf("record %s is defined twice", name)
+		}
+		defined[name] = true
+		if record["namespace"] != "ocsf.v1_4_0" {
+			t.Errorf("got namespace %v for %s", record["namespace"], name)
+		}
+
+		fields := jsonFields(types[name])
+		for _, f := range record["fields"].([]any) {
+			field := f.(map[string]any)
+			fieldName := field["name"].(string)
+			optional, ok := fields[fieldName]
+			if !ok {
+				t.Errorf("%s.%s is not a field of the struct", name, fieldName)
+				continue
+			}
+			delete(fields, fieldName)
+
+			fieldType := field["type"]
+			if union, ok := fieldType.([]any); ok {
+				if len(union) != 2 || union[0] != "null" || string(mustMarshal(t, field["default"])) != "null" {
+					t.Errorf("%s.%s has union %v, want null first with a null default", name, fieldName, union)
+				}
+				fieldType = union[1]
+			} else if optional {
+				t.Errorf("%s.%s is omitted when empty, but not nullable", name, fieldName)
+			}
+			checkType(name+"."+fieldName, fieldType)
+		}
+		for fieldName := range fields {
+			t.Errorf("%s.%s is missing from the record", name, fieldName)
+		}
+	}
+	checkType = func(path string, avroType any) {
+		switch avroType := avroType.(type) {
+		case string:
+			switch avroType {
+			case "string", "int", "long", "double", "boolean":
+			default:
+				if !defined[avroType] {
+					t.Errorf("%s refers to %s before it is defined", path, avroType)
+				}
+			}
+		case map[string]any:
+			switch avroType["type"] {
+			case "record":
+				checkRecord(avroType)
+			case "array":
+				checkType(path, avroType["items"])
+			}
+		}
+	}
+	checkRecord(record)
+}
+
+func mustMarshal(t *testing.T, v any) []byte {
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+var protoLine = regexp.MustCompile(`^\s*(?:(message) (\w+) \{|(\})|(?:optional |repeated )?(\w+) (\w+) = (\d+);)$`)
+
+func TestProtoExport(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join(exportDir, "proto", "api_activity.proto"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	types := structTypes(reflect.TypeFor[APIActivity](), make(map[string]reflect.Type))
+	var messages []string
+	numbers := make(map[string][]int)
+	for _, line := range strings.Split(string(data), "\n") {
+		match := protoLine.FindStringSubmatch(line)
+		switch {
+		case match == nil:
+			if trimmed := strings.TrimSpace(line); trimmed != "" && !strings.HasPrefix(trimmed, "//") && !strings.HasPrefix(trimmed, "syntax") && !strings.HasPrefix(trimmed, "package") {
+				t.Errorf("unexpected line %q", line)
+			}
+		case match[1] == "message":
+			if types[match[2]] == nil {
+				t.Errorf("message %s is not a generated struct", match[2])
+			}
+			messages = append(messages, match[2])
+		case match[3] == "}":
+			messages = messages[:len(messages)-1]
+		default:
+			message := messages[len(messages)-1]
+			if _, ok := jsonFields(types[message])[match[5]]; !ok {
+				t.Errorf("%s.%s is not a field of the struct", message, match[5])
+			}
+			if types[match[4]] == nil && !slices.Contains([]string{"string", "int32", "int64", "double", "bool"}, match[4]) {
+				t.Errorf("%s.%s has unknown type %s", message, match[5], match[4])
+			}
+			number, _ := strconv.Atoi(match[6])
+			numbers[message] = append(numbers[message], number)
+		}
+	}
+	if len(messages) != 0 {
+		t.Errorf("messages %v are not closed", messages)
+	}
+
+	// Fields are numbered in order from 1, once per message.
+	for message, fields := range numbers {
+		if len(fields) != len(jsonFields(types[message])) {
+			t.Errorf("%s has %d fields, want %d", message, len(fields), len(jsonFields(types[message])))
+		}
+		for i, number := range fields {
+			if number != i+1 {
+				t.Errorf("%s has field number %d at position %d", message, number, i+1)
+			}
+		}
+	}
+}
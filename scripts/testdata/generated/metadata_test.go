@@ -0,0 +1,71 @@
+package v1_4_0
+
+import (
+	"testing"
+
+	"github.com/Santiago-Labs/go-ocsf/datastore"
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
+)
+
+func TestFieldMetadata(t *testing.T) {
+	var tests = []struct {
+		name  string
+		field string
+		want  map[string]string
+	}{
+		{name: "Test an enum attribute", field: "activity_id", want: map[string]string{
+			ocsf.MetadataCaption:     "Activity ID",
+			ocsf.MetadataDescription: "Activity ID description.",
+			ocsf.MetadataRequirement: "required",
+			ocsf.MetadataEnum:        "0: Unknown; 1: Create; 2: Read; 3: Update; 4: Delete; 99: Other",
+		}},
+		{name: "Test an attribute without enum", field: "unmapped", want: map[string]string{
+			ocsf.MetadataCaption:     "Unmapped Data",
+			ocsf.MetadataDescription: "Unmapped Data description.",
+			ocsf.MetadataRequirement: "optional",
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fields, _ := APIActivitySchema.FieldsByName(tt.field)
+			if len(fields) != 1 {
+				t.Fatalf("got %d %s fields, want 1", len(fields), tt.field)
+			}
+			got := fields[0].Metadata.ToMap()
+			if len(got) != len(tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			for key, value := range tt.want {
+				if got[key] != value {
+					t.Errorf("got %s %q, want %q", key, got[key], value)
+				}
+			}
+		})
+	}
+}
+
+func TestIcebergDocs(t *testing.T) {
+	schema, err := datastore.ArrowSchemaToIceberg(APIActivitySchema)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	field, ok := schema.FindFieldByName("activity_id")
+	if !ok {
+		t.Fatalf("activity_id is missing")
+	}
+	want := "Activity ID: Activity ID description. (required) Values: 0: Unknown; 1: Create; 2: Read; 3: Update; 4: Delete; 99: Other."
+	if field.Doc != want {
+		t.Errorf("got doc %q, want %q", field.Doc, want)
+	}
+
+	// Nested attributes are documented from the metadata of their object's fields.
+	field, ok = schema.FindFieldByName("api.operation")
+	if !ok {
+		t.Fatalf("api.operation is missing")
+	}
+	if field.Doc != "Operation: Operation description. (required)" {
+		t.Errorf("got doc %q for api.operation", field.Doc)
+	}
+}
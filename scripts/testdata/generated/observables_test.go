@@ -0,0 +1,48 @@
+package v1_4_0
+
+import (
+	"testing"
+
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
+)
+
+func TestPopulateObservables(t *testing.T) {
+	activity := NewAPIActivity(APIActivityActivityIdRead)
+	activity.SrcEndpoint = NetworkEndpoint{Ip: ocsf.Ptr("10.0.0.1"), Hostname: ocsf.Ptr("web-1")}
+	activity.Actor.User = &User{Name: ocsf.Ptr("jack"), EmailAddr: ocsf.Ptr("jack@example.com")}
+	activity.Resources = []ResourceDetails{{Uid: ocsf.Ptr("i-123")}}
+	// Observables which are already present are kept and not added again.
+	activity.Observables = []Observable{{Name: ocsf.Ptr("src_endpoint.ip"), TypeId: 2, Value: ocsf.Ptr("10.0.0.1")}}
+
+	activity.PopulateObservables()
+	activity.PopulateObservables()
+
+	want := map[string]int32{
+		"src_endpoint":          20,
+		"src_endpoint.ip":       2,
+		"src_endpoint.hostname": 1,
+		"actor.user":            21,
+		"actor.user.name":       4,
+		"actor.user.email_addr": 5,
+		"resources.uid":         10,
+	}
+	got := make(map[string]int32)
+	for _, observable := range activity.Observables {
+		if _, ok := got[*observable.Name]; ok {
+			t.Errorf("got observable %s twice", *observable.Name)
+		}
+		got[*observable.Name] = observable.TypeId
+	}
+	for name, typeID := range want {
+		if got[name] != typeID {
+			t.Errorf("got observable %s with type_id %d, want %d", name, got[name], typeID)
+		}
+	}
+	if len(got) != len(want) {
+		t.Errorf("got observables %v, want %v", got, want)
+	}
+
+	if err := activity.ValidateObservables(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,63 @@
+package v1_4_0
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
+)
+
+func TestGetSet(t *testing.T) {
+	var finding VulnerabilityFinding
+
+	var tests = []struct {
+		path  string
+		value any
+		want  any
+	}{
+		{path: "finding_info.uid", value: "finding-1", want: "finding-1"},
+		{path: "severity_id", value: 4.0, want: int32(4)},
+		{path: "type_uid", value: 200201, want: int64(200201)},
+		{path: "resources[0].owner.name", value: "jack", want: "jack"},
+		{path: "resources[1].uid", value: "i-123", want: "i-123"},
+		{path: "vulnerabilities[0].fixed_versions[0]", value: "1.2.3", want: "1.2.3"},
+		{path: "unmapped", value: map[string]any{"region": "us-east-1"}, want: ocsf.RawJSON(`{"region":"us-east-1"}`)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			if err := finding.Set(tt.path, tt.value); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			got, ok := finding.Get(tt.path)
+			if !ok || got != tt.want {
+				t.Errorf("got %v (%T), %v, want %v (%T)", got, got, ok, tt.want, tt.want)
+			}
+		})
+	}
+
+	if len(finding.Resources) != 2 || *finding.Resources[1].Uid != "i-123" {
+		t.Errorf("got resources %v, want two", finding.Resources)
+	}
+	if err := finding.Set("title", "x"); !errors.Is(err, ocsf.ErrUnknownPath) {
+		t.Errorf("got %v for an unknown path, want ErrUnknownPath", err)
+	}
+	if err := finding.Set("resources[5].uid", "x"); err == nil {
+		t.Errorf("expected an error for an index past the end of the list")
+	}
+	if err := finding.Set("type_uid", float64(1<<63)); err == nil {
+		t.Errorf("expected an error for a value past the int64 range")
+	}
+	if _, ok := finding.Get("resources[3].uid"); ok {
+		t.Errorf("expected no value for an index past the end of the list")
+	}
+}
+
+func TestPaths(t *testing.T) {
+	for _, path := range []string{"finding_info.uid", "resources[].owner.name", "vulnerabilities[].cve_uid"} {
+		if !slices.Contains(VulnerabilityFindingPaths, path) {
+			t.Errorf("VulnerabilityFindingPaths does not list %s", path)
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package v1_4_0
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestProfiles(t *testing.T) {
+	finding := NewVulnerabilityFinding(VulnerabilityFindingActivityIdCreate)
+
+	want := []string{"cloud", "host", "security_control"}
+	if !slices.Equal(VulnerabilityFindingProfiles, want) {
+		t.Errorf("got profiles %q, want %q", VulnerabilityFindingProfiles, want)
+	}
+	if !slices.Equal(finding.Metadata.Profiles, want) {
+		t.Errorf("got metadata.profiles %q, want %q", finding.Metadata.Profiles, want)
+	}
+}
+
+func TestProfileAttributes(t *testing.T) {
+	// The attributes of selected profiles are generated, and those of the datetime profile, which
+	// is not selected, are not.
+	var tests = []struct {
+		name  string
+		paths []string
+		path  string
+		want  bool
+	}{
+		{name: "Test a security_control attribute", paths: VulnerabilityFindingPaths, path: "policy_name", want: true},
+		{name: "Test a host attribute", paths: VulnerabilityFindingPaths, path: "device.hostname", want: true},
+		{name: "Test an attribute of a profile which is not selected", paths: VulnerabilityFindingPaths, path: "time_dt"},
+		{name: "Test an attribute of a profile the class does not have", paths: APIActivityPaths, path: "policy_name"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := slices.Contains(tt.paths, tt.path); got != tt.want {
+				t.Errorf("got %s generated %t, want %t", tt.path, got, tt.want)
+			}
+		})
+	}
+
+	finding := NewVulnerabilityFinding(VulnerabilityFindingActivityIdCreate)
+	if err := finding.Set("policy_name", "deny-all"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if finding.PolicyName == nil || *finding.PolicyName != "deny-all" {
+		t.Errorf("got policy_name %v, want deny-all", finding.PolicyName)
+	}
+}
@@ -0,0 +1,19 @@
+package v1_4_0
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestProcessRef(t *testing.T) {
+	// With the default depth of one level, the reference struct drops its object attributes.
+	ref := reflect.TypeFor[ProcessRef]()
+	for _, name := range []string{"ParentProcess", "File", "User"} {
+		if _, ok := ref.FieldByName(name); ok {
+			t.Errorf("ProcessRef has %s, want the object attributes dropped", name)
+		}
+	}
+	if _, ok := ref.FieldByName("Name"); !ok {
+		t.Errorf("ProcessRef is missing Name")
+	}
+}
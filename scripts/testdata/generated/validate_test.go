@@ -0,0 +1,66 @@
+package v1_4_0
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
+)
+
+var _ ocsf.Validator = (*APIActivity)(nil)
+
+func validAPIActivity() APIActivity {
+	activity := NewAPIActivity(APIActivityActivityIdRead)
+	activity.Time = 1700000000000
+	activity.SeverityId = 1
+	activity.Severity = ocsf.Ptr("Informational")
+	activity.Actor.AppName = ocsf.Ptr("console")
+	activity.Api.Operation = "GetObject"
+	activity.Cloud.Provider = "AWS"
+	activity.Metadata.Product.Name = ocsf.Ptr("CloudTrail")
+	activity.SrcEndpoint.Ip = ocsf.Ptr("10.0.0.1")
+	return activity
+}
+
+func TestValidate(t *testing.T) {
+	var tests = []struct {
+		name   string
+		modify func(*APIActivity)
+		paths  []string
+	}{
+		{name: "Test a valid event", modify: func(*APIActivity) {}},
+		{name: "Test a missing required attribute", modify: func(v *APIActivity) { v.Time = 0 }, paths: []string{"time"}},
+		{name: "Test an undefined enum value", modify: func(v *APIActivity) { v.SeverityId = 7; v.Severity = nil }, paths: []string{"severity_id"}},
+		{name: "Test a mismatched sibling", modify: func(v *APIActivity) { v.Severity = ocsf.Ptr("Critical") }, paths: []string{"severity"}},
+		{name: "Test an inconsistent type_uid", modify: func(v *APIActivity) { v.TypeUid = 600301; v.TypeName = nil }, paths: []string{"type_uid"}},
+		{name: "Test an at_least_one constraint", modify: func(v *APIActivity) { v.Actor.AppName = nil }, paths: []string{"actor"}},
+		{name: "Test a profile the event does not apply", modify: func(v *APIActivity) { v.Metadata.Profiles = []string{"host"}; v.Cloud.Provider = "" }},
+		{name: "Test a profile the event applies", modify: func(v *APIActivity) { v.Cloud.Provider = "" }, paths: []string{"cloud.provider"}},
+		{name: "Test a nested required attribute", modify: func(v *APIActivity) { v.Resources = []ResourceDetails{{Owner: &User{TypeId: ocsf.Ptr(int32(5))}}} }, paths: []string{"resources[0].owner.type_id"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			activity := validAPIActivity()
+			tt.modify(&activity)
+
+			err := activity.Validate()
+			var errs ocsf.ValidationErrors
+			if err != nil && !errors.As(err, &errs) {
+				t.Fatalf("got %T, want ocsf.ValidationErrors", err)
+			}
+			var paths []string
+			for _, e := range errs {
+				paths = append(paths, e.Path)
+			}
+			if len(paths) != len(tt.paths) {
+				t.Fatalf("got violations %v, want at %v", errs, tt.paths)
+			}
+			for i := range paths {
+				if paths[i] != tt.paths[i] {
+					t.Errorf("got violations %v, want at %v", errs, tt.paths)
+				}
+			}
+		})
+	}
+}
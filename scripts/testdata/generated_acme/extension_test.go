@@ -0,0 +1,58 @@
+package v1_5_0
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
+)
+
+func TestExtensionClass(t *testing.T) {
+	activity := NewWidgetActivity(WidgetActivityActivityIdSpin)
+	if activity.ClassUid != 901003 || activity.CategoryUid != 901 || activity.TypeUid != 90100305 {
+		t.Errorf("got class_uid %d, category_uid %d and type_uid %d", activity.ClassUid, activity.CategoryUid, activity.TypeUid)
+	}
+	if *activity.TypeName != "Widget Activity: Spin" {
+		t.Errorf("got type_name %q", *activity.TypeName)
+	}
+	want := []SchemaExtension{{Name: "acme", Uid: "9", Version: "1.0.0"}}
+	if !reflect.DeepEqual(activity.Metadata.Extensions, want) {
+		t.Errorf("got metadata.extensions %+v, want %+v", activity.Metadata.Extensions, want)
+	}
+
+	activity.GadgetKindId = ocsf.Ptr(int32(7))
+	var found bool
+	for _, err := range activity.ValidateAt("") {
+		found = found || err.Path == "gadget_kind_id"
+	}
+	if !found {
+		t.Errorf("expected gadget_kind_id 7 to be rejected")
+	}
+}
+
+func TestExtensionObservables(t *testing.T) {
+	activity := NewWidgetActivity(WidgetActivityActivityIdSpin)
+	activity.Widget = Widget{WidgetSerial: "W-1"}
+	activity.PopulateObservables()
+
+	got := make(map[string]int32)
+	for _, observable := range activity.Observables {
+		got[*observable.Name] = observable.TypeId
+	}
+	if got["widget"] != 9902 || got["widget.widget_serial"] != 9901 {
+		t.Errorf("got observables %v, want widget 9902 and widget.widget_serial 9901", got)
+	}
+}
+
+func TestExtendedAttributes(t *testing.T) {
+	var finding VulnerabilityFinding
+	if err := finding.Set("tenant_id", "t-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := finding.Set("device.tenant_id", "t-2"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if *finding.TenantId != "t-1" || *finding.Device.TenantId != "t-2" {
+		t.Errorf("got tenant_id %q and device.tenant_id %q", *finding.TenantId, *finding.Device.TenantId)
+	}
+}
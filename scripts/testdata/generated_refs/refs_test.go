@@ -0,0 +1,61 @@
+package v1_4_0
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/apache/arrow-go/v18/arrow"
+)
+
+const lineage = `{"name":"sh","parent_process":{"name":"bash","parent_process":{"name":"sshd","parent_process":{"name":"init","parent_process":{"name":"kernel"},"user":{"name":"root"}}}}}`
+
+func TestProcessLineage(t *testing.T) {
+	var process Process
+	if err := json.Unmarshal([]byte(lineage), &process); err != nil {
+		t.Fatalf("failed to decode process: %v", err)
+	}
+
+	deepest := process.ParentProcess.ParentProcess.ParentProcess
+	if *deepest.Name != "init" {
+		t.Errorf("got %q at the third level, want init", *deepest.Name)
+	}
+	if *deepest.ParentProcess != `{"name":"kernel"}` || *deepest.User != `{"name":"root"}` {
+		t.Errorf("got parent %s and user %s, want the objects of the deepest level kept as JSON", *deepest.ParentProcess, *deepest.User)
+	}
+
+	data, err := json.Marshal(process)
+	if err != nil {
+		t.Fatalf("failed to encode process: %v", err)
+	}
+	if string(data) != lineage {
+		t.Errorf("got\n%s\nwant\n%s", data, lineage)
+	}
+}
+
+func TestProcessRefFields(t *testing.T) {
+	var tests = []struct {
+		name   string
+		fields []arrow.Field
+		want   arrow.DataType
+	}{
+		{name: "Test the first level", fields: ProcessFields, want: ProcessRefStruct},
+		{name: "Test the second level", fields: ProcessRefFields, want: ProcessRef2Struct},
+		{name: "Test the third level", fields: ProcessRef2Fields, want: ProcessRef3Struct},
+		{name: "Test the deepest level", fields: ProcessRef3Fields, want: arrow.BinaryTypes.String},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, field := range tt.fields {
+				if field.Name != "parent_process" {
+					continue
+				}
+				if !arrow.TypeEqual(field.Type, tt.want) {
+					t.Errorf("got parent_process %s, want %s", field.Type, tt.want)
+				}
+				return
+			}
+			t.Errorf("parent_process is missing")
+		})
+	}
+}
@@ -0,0 +1,259 @@
+// Package checkpoint persists the high-water marks syncers resume from, such as the time of the
+// last synced event, the last S3 key read or an export UUID, so that syncs are incremental and an
+// interrupted sync continues where it stopped.
+package checkpoint
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Santiago-Labs/go-ocsf/datastore"
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
+	"github.com/apache/iceberg-go"
+	"github.com/apache/iceberg-go/catalog"
+	"github.com/apache/iceberg-go/table"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/aws-sdk-go-v2/service/s3tables"
+	"github.com/samsarahq/go/oops"
+)
+
+// Dir is the local directory, or S3 key prefix, the checkpoints are kept in, one file or object
+// per key.
+var Dir = filepath.Join("state", "checkpoints")
+
+// PropertyPrefix prefixes the keys of the checkpoints kept as Iceberg table properties.
+const PropertyPrefix = "go-ocsf.checkpoint."
+
+// Store persists checkpoints by key, e.g. "salesforce/LoginEvent".
+type Store interface {
+	// Get returns the checkpoint of key, or "" when none was recorded.
+	Get(ctx context.Context, key string) (string, error)
+	// Set records the checkpoint of key.
+	Set(ctx context.Context, key, value string) error
+}
+
+// NewStore returns the store matching where storageOpts stores the events of class: properties of
+// the class's S3 Tables table, an object in the S3 bucket, or a local file. Keys are kept under the
+// state namespace when it is set.
+func NewStore(ctx context.Context, storageOpts datastore.StorageOpts, class ocsf.Class) (Store, error) {
+	store, err := newStore(ctx, storageOpts, class)
+	if err != nil || storageOpts.StateNamespace == "" {
+		return store, err
+	}
+	return &namespacedStore{Store: store, prefix: storageOpts.StateNamespace + "/"}, nil
+}
+
+func newStore(ctx context.Context, storageOpts datastore.StorageOpts, class ocsf.Class) (Store, error) {
+	tables := storageOpts.IsParquet && storageOpts.TableBucketArn != ""
+	if !tables && storageOpts.BucketName == "" {
+		return &FileStore{Dir: Dir}, nil
+	}
+
+	cfg, err := config.LoadDefaultConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error loading AWS config: %v", err)
+	}
+
+	if tables {
+		s3TablesClient := s3tables.NewFromConfig(cfg)
+		cat, err := datastore.NewS3TablesCatalog(ctx, storageOpts.TableBucketArn, s3TablesClient)
+		if err != nil {
+			return nil, err
+		}
+		// Syncs may record checkpoints before they store their first events.
+		if err := cat.SetupTable(ctx, s3TablesClient, class); err != nil {
+			return nil, oops.Wrapf(err, "failed to setup table")
+		}
+		return &TableStore{Catalog: cat, Table: datastore.ClassTable(class)}, nil
+	}
+
+	return &S3Store{Client: s3.NewFromConfig(cfg), Bucket: storageOpts.BucketName, Prefix: filepath.ToSlash(Dir)}, nil
+}
+
+// namespacedStore prefixes the keys of a store.
+type namespacedStore struct {
+	Store
+	prefix string
+}
+
+func (s *namespacedStore) Get(ctx context.Context, key string) (string, error) {
+	return s.Store.Get(ctx, s.prefix+key)
+}
+
+func (s *namespacedStore) Set(ctx context.Context, key, value string) error {
+	return s.Store.Set(ctx, s.prefix+key, value)
+}
+
+// GetTime returns the checkpoint of key as a time, or the zero time when none was recorded.
+func GetTime(ctx context.Context, store Store, key string) (time.Time, error) {
+	value, err := store.Get(ctx, key)
+	if err != nil || value == "" {
+		return time.Time{}, err
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return time.Time{}, oops.Wrapf(err, "invalid time checkpoint %s", key)
+	}
+	return t, nil
+}
+
+// SetTime records t as the checkpoint of key.
+func SetTime(ctx context.Context, store Store, key string, t time.Time) error {
+	return store.Set(ctx, key, t.UTC().Format(time.RFC3339Nano))
+}
+
+// AdvanceTime records t as the checkpoint of key unless the recorded checkpoint is later, so that
+// syncing an older range never moves a checkpoint back.
+func AdvanceTime(ctx context.Context, store Store, key string, t time.Time) error {
+	current, err := GetTime(ctx, store, key)
+	if err != nil {
+		return err
+	}
+	if !t.After(current) {
+		return nil
+	}
+	return SetTime(ctx, store, key, t)
+}
+
+// FileStore keeps each checkpoint in a file of Dir named after its key, so that stores of
+// different syncers and processes never overwrite each other's checkpoints.
+type FileStore struct {
+	Dir string
+}
+
+func (s *FileStore) filename(key string) string {
+	return filepath.Join(s.Dir, url.PathEscape(key))
+}
+
+func (s *FileStore) Get(ctx context.Context, key string) (string, error) {
+	data, err := os.ReadFile(s.filename(key))
+	if errors.Is(err, os.ErrNotExist) {
+		return "", nil
+	}
+	if err != nil {
+		return "", oops.Wrapf(err, "failed to read checkpoint %s", key)
+	}
+	return string(data), nil
+}
+
+func (s *FileStore) Set(ctx context.Context, key, value string) error {
+	if err := os.MkdirAll(s.Dir, 0755); err != nil {
+		return oops.Wrapf(err, "failed to create checkpoint directory")
+	}
+
+	// Write a temporary file first, so that an interrupted sync never leaves a truncated
+	// checkpoint, and concurrent writers never share one.
+	tmp, err := os.CreateTemp(s.Dir, ".checkpoint-*")
+	if err != nil {
+		return oops.Wrapf(err, "failed to create checkpoint file")
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.WriteString(value); err != nil {
+		tmp.Close()
+		return oops.Wrapf(err, "failed to write checkpoint %s", key)
+	}
+	if err := tmp.Close(); err != nil {
+		return oops.Wrapf(err, "failed to write checkpoint %s", key)
+	}
+	return os.Rename(tmp.Name(), s.filename(key))
+}
+
+// S3Store keeps each checkpoint in an S3 object under Prefix named after its key.
+type S3Store struct {
+	Client *s3.Client
+	Bucket string
+	Prefix string
+}
+
+func (s *S3Store) key(key string) string {
+	return path.Join(s.Prefix, url.PathEscape(key))
+}
+
+func (s *S3Store) Get(ctx context.Context, key string) (string, error) {
+	result, err := s.Client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.Bucket),
+		Key:    aws.String(s.key(key)),
+	})
+	var noSuchKey *types.NoSuchKey
+	if errors.As(err, &noSuchKey) {
+		return "", nil
+	}
+	if err != nil {
+		return "", oops.Wrapf(err, "failed to get checkpoint %s from S3", key)
+	}
+	defer result.Body.Close()
+
+	data, err := io.ReadAll(result.Body)
+	if err != nil {
+		return "", oops.Wrapf(err, "failed to read checkpoint %s", key)
+	}
+	return string(data), nil
+}
+
+func (s *S3Store) Set(ctx context.Context, key, value string) error {
+	_, err := s.Client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:      aws.String(s.Bucket),
+		Key:         aws.String(s.key(key)),
+		Body:        strings.NewReader(value),
+		ContentType: aws.String("text/plain"),
+	})
+	if err != nil {
+		return oops.Wrapf(err, "failed to put checkpoint %s to S3", key)
+	}
+	return nil
+}
+
+// TableStore keeps the checkpoints as properties of an Iceberg table, next to the events they
+// describe.
+type TableStore struct {
+	Catalog *datastore.S3TablesCatalog
+	Table   table.Identifier
+
+	mu sync.Mutex
+}
+
+func (s *TableStore) Get(ctx context.Context, key string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tbl, err := s.Catalog.Catalog.LoadTable(ctx, s.Table, s.Catalog.Props)
+	if errors.Is(err, catalog.ErrNoSuchTable) {
+		return "", nil
+	}
+	if err != nil {
+		return "", oops.Wrapf(err, "failed to load table")
+	}
+	return tbl.Properties()[PropertyPrefix+key], nil
+}
+
+func (s *TableStore) Set(ctx context.Context, key, value string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tbl, err := s.Catalog.Catalog.LoadTable(ctx, s.Table, s.Catalog.Props)
+	if err != nil {
+		return oops.Wrapf(err, "failed to load table")
+	}
+
+	_, _, err = s.Catalog.Catalog.CommitTable(ctx, tbl, nil, []table.Update{
+		table.NewSetPropertiesUpdate(iceberg.Properties{PropertyPrefix + key: value}),
+	})
+	if err != nil {
+		return oops.Wrapf(err, "failed to set checkpoint property")
+	}
+	return nil
+}
@@ -0,0 +1,149 @@
+package checkpoint
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Santiago-Labs/go-ocsf/datastore"
+	"github.com/apache/iceberg-go"
+	"github.com/apache/iceberg-go/catalog"
+	"github.com/apache/iceberg-go/table"
+)
+
+func TestFileStore(t *testing.T) {
+	ctx := context.Background()
+	dir := filepath.Join(t.TempDir(), "state", "checkpoints")
+	store := &FileStore{Dir: dir}
+
+	value, err := store.Get(ctx, "salesforce/Login")
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if value != "" {
+		t.Errorf("Get before Set = %q, want empty", value)
+	}
+
+	if err := store.Set(ctx, "salesforce/Login", "2025-01-02T03:04:05.000Z"); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+	if err := store.Set(ctx, "cloudtrail/bucket/AWSLogs/1/CloudTrail/us-east-1", "key"); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+
+	// A new store reads the checkpoints the previous one wrote.
+	store = &FileStore{Dir: dir}
+	value, err = store.Get(ctx, "salesforce/Login")
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if value != "2025-01-02T03:04:05.000Z" {
+		t.Errorf("Get = %q, want the value set", value)
+	}
+	value, err = store.Get(ctx, "cloudtrail/bucket/AWSLogs/1/CloudTrail/us-east-1")
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if value != "key" {
+		t.Errorf("Get = %q, want the value set", value)
+	}
+}
+
+func TestTime(t *testing.T) {
+	ctx := context.Background()
+	store := &FileStore{Dir: t.TempDir()}
+
+	got, err := GetTime(ctx, store, "gcpauditlog/project")
+	if err != nil {
+		t.Fatalf("GetTime: %v", err)
+	}
+	if !got.IsZero() {
+		t.Errorf("GetTime before SetTime = %v, want zero", got)
+	}
+
+	want := time.Date(2025, 1, 2, 3, 4, 5, 6000, time.FixedZone("PST", -8*60*60))
+	if err := SetTime(ctx, store, "gcpauditlog/project", want); err != nil {
+		t.Fatalf("SetTime: %v", err)
+	}
+	got, err = GetTime(ctx, store, "gcpauditlog/project")
+	if err != nil {
+		t.Fatalf("GetTime: %v", err)
+	}
+	if !got.Equal(want) {
+		t.Errorf("GetTime = %v, want %v", got, want)
+	}
+
+	if err := store.Set(ctx, "gcpauditlog/project", "yesterday"); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+	if _, err := GetTime(ctx, store, "gcpauditlog/project"); err == nil {
+		t.Error("GetTime of an invalid time succeeded")
+	}
+}
+
+func TestFileStoreConcurrent(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	// Stores of different syncers share the directory, and write their checkpoints concurrently.
+	var wg sync.WaitGroup
+	for i := range 20 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			store := &FileStore{Dir: dir}
+			if err := store.Set(ctx, fmt.Sprintf("source%d/key", i), strconv.Itoa(i)); err != nil {
+				t.Errorf("Set: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	store := &FileStore{Dir: dir}
+	for i := range 20 {
+		value, err := store.Get(ctx, fmt.Sprintf("source%d/key", i))
+		if err != nil {
+			t.Fatalf("Get: %v", err)
+		}
+		if value != strconv.Itoa(i) {
+			t.Errorf("Get source%d/key = %q, want %q", i, value, strconv.Itoa(i))
+		}
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("ReadDir: %v", err)
+	}
+	if len(entries) != 20 {
+		t.Errorf("got %d files, want one per key and no temporary files", len(entries))
+	}
+}
+
+// missingTables is a catalog without tables.
+type missingTables struct {
+	catalog.Catalog
+}
+
+func (missingTables) LoadTable(ctx context.Context, ident table.Identifier, props iceberg.Properties) (*table.Table, error) {
+	return nil, catalog.ErrNoSuchTable
+}
+
+func TestTableStoreMissingTable(t *testing.T) {
+	store := &TableStore{
+		Catalog: &datastore.S3TablesCatalog{Catalog: missingTables{}},
+		Table:   table.Identifier{"ocsf_data", "api_activity"},
+	}
+
+	value, err := store.Get(context.Background(), "salesforce/Login")
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if value != "" {
+		t.Errorf("Get = %q, want empty", value)
+	}
+}
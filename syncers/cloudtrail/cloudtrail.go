@@ -8,6 +8,7 @@ import (
 	"io"
 	"log/slog"
 	"path"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -15,8 +16,13 @@ import (
 	"sync"
 
 	"github.com/Santiago-Labs/go-ocsf/datastore"
+	schema "github.com/Santiago-Labs/go-ocsf/ocsf"
+	"github.com/Santiago-Labs/go-ocsf/ocsf/eventuid"
 	ocsf "github.com/Santiago-Labs/go-ocsf/ocsf/v1_4_0"
+	"github.com/Santiago-Labs/go-ocsf/syncers"
+	"github.com/Santiago-Labs/go-ocsf/syncers/checkpoint"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/samsarahq/go/oops"
 	"golang.org/x/sync/errgroup"
@@ -25,8 +31,24 @@ import (
 const (
 	defaultWorkers   = 10
 	defaultBatchSize = 1000
+	defaultLookback  = 30 * 24 * time.Hour
+	// resumeOverlap is how far before a region's checkpoint the next sync lists files again, so
+	// that files which were uploaded but not yet listed when the checkpoint was taken are synced.
+	resumeOverlap = time.Hour
 )
 
+// Config configures the CloudTrail syncer, which reads the bucket with the default AWS config.
+type Config struct {
+	Bucket string `yaml:"bucket" env:"CLOUDTRAIL_BUCKET_NAME" flag:"bucket-name" usage:"CloudTrail bucket name"`
+}
+
+func (c *Config) Validate() error {
+	if c.Bucket == "" {
+		return fmt.Errorf("the CloudTrail bucket name must be set")
+	}
+	return nil
+}
+
 // Syncer pulls CloudTrail *.json.gz files from S3 and republishes them as OCSF.
 type Syncer struct {
 	bucket    string     // trail bucket name
@@ -34,27 +56,37 @@ type Syncer struct {
 	ds        datastore.Datastore[ocsf.APIActivity]
 	workers   int
 	batchSize int
+
+	checkpoints checkpoint.Store
+	window      syncers.TimeWindow
 }
 
-// New creates a new Syncer.  The bucket *must* contain standard CloudTrail keys
-// (AWSLogs/<acct>/CloudTrail/<region>/<yyyy>/<mm>/<dd>/…json.gz).
+// New creates a new Syncer of the events within window.  The bucket *must* contain standard
+// CloudTrail keys (AWSLogs/<acct>/CloudTrail/<region>/<yyyy>/<mm>/<dd>/…json.gz).
 func NewSyncer(
 	ctx context.Context,
 	s3client *s3.Client,
 	bucket string,
 	storage datastore.StorageOpts,
-) (*Syncer, error) {
+	window syncers.TimeWindow,
+) (syncers.DataSync, error) {
 
-	ds, err := datastore.SetupStorage[ocsf.APIActivity](ctx, storage)
+	ds, err := syncers.SetupAPIActivityStorage(ctx, storage)
 	if err != nil {
 		return nil, fmt.Errorf("setup datastore: %w", err)
 	}
+	checkpoints, err := syncers.NewAPIActivityCheckpoints(ctx, storage)
+	if err != nil {
+		return nil, fmt.Errorf("setup checkpoints: %w", err)
+	}
 	return &Syncer{
-		s3:        s3client,
-		bucket:    bucket,
-		ds:        ds,
-		workers:   defaultWorkers,
-		batchSize: defaultBatchSize,
+		s3:          s3client,
+		bucket:      bucket,
+		ds:          ds,
+		workers:     defaultWorkers,
+		batchSize:   defaultBatchSize,
+		checkpoints: checkpoints,
+		window:      window,
 	}, nil
 }
 
@@ -62,28 +94,69 @@ func NewSyncer(
 // PUBLIC API
 // ---------------------------------------------------------------------------
 
-// Sync streams every historical object plus today's keys into the datastore.
+// Sync streams every object written since the last sync, or in the last 30 days on the first
+// sync, into the datastore. Each region is checkpointed with the upload time of the newest file
+// synced once every batch was saved, and the next sync syncs the files uploaded after it, even
+// when their keys sort before the keys already synced. Files uploaded within resumeOverlap before
+// the checkpoint are listed again and skipped when the checkpoint has them. When the window has a
+// start, the events within the window are synced instead, in chunks when it is a backfill.
 func (s *Syncer) Sync(ctx context.Context) error {
-	processID := fmt.Sprintf("PROC-%d", time.Now().UnixNano())
-
 	slog.Info("CloudTrail sync – discovery", "bucket", s.bucket)
 	accRegs, err := s.discoverAccountsAndRegions(ctx)
 	if err != nil {
 		return err
 	}
 
-	cutoff := time.Now().UTC().AddDate(0, 0, -30)
-	cutoffDay := time.Date(cutoff.Year(), cutoff.Month(), cutoff.Day(),
+	progress, err := s.loadProgress(ctx, accRegs)
+	if err != nil {
+		return err
+	}
+
+	since, until := s.window.Range(time.Time{}, defaultLookback, time.Now().UTC())
+	if s.window.Backfill > 0 {
+		err = syncers.Backfill(ctx, s.checkpoints, "cloudtrail/"+s.bucket, since, until, s.window.Backfill,
+			func(ctx context.Context, since, until time.Time) error {
+				return s.syncRange(ctx, accRegs, since, until, false, progress)
+			})
+	} else {
+		// Regions resume after their checkpoint unless the window starts elsewhere.
+		err = s.syncRange(ctx, accRegs, since, until, s.window.Since.IsZero(), progress)
+	}
+	if err != nil {
+		return err
+	}
+
+	slog.Info("CloudTrail sync finished")
+	return nil
+}
+
+// syncRange syncs the days from since to until of every region, or the files uploaded after the
+// region's checkpoint when resume is set. Unless resuming, only events in [since, until) are kept.
+// It fails when any day failed, without checkpointing the regions of those days.
+func (s *Syncer) syncRange(ctx context.Context, accRegs map[string][]string, since, until time.Time, resume bool, progress *progress) error {
+	processID := fmt.Sprintf("PROC-%d", time.Now().UnixNano())
+
+	since, until = since.UTC(), until.UTC()
+	cutoffDay := time.Date(since.Year(), since.Month(), since.Day(),
+		0, 0, 0, 0, time.UTC)
+	lastDay := time.Date(until.Year(), until.Month(), until.Day(),
 		0, 0, 0, 0, time.UTC)
+	// events before since are only dropped when every region starts at since
+	from, to := since, until
+	if resume {
+		from, to = time.Time{}, s.window.Until
+	}
 
-	workCh := make(chan string, 100)              // day prefixes
+	workCh := make(chan dayWork, 100)             // day prefixes
 	commitCh := make(chan []ocsf.APIActivity, 64) // batches to write
 	errCh := make(chan error, 1)
 
-	if err := s.enqueueAllDays(ctx, accRegs, cutoffDay, workCh); err != nil {
-		return err
-	}
-	close(workCh) // enqueue is done
+	// enqueue while the workers run, there may be more days than the channel holds
+	var enqueueErr error
+	go func() {
+		defer close(workCh) // enqueue is done
+		enqueueErr = s.enqueueAllDays(ctx, accRegs, cutoffDay, lastDay, resume, progress, workCh)
+	}()
 
 	// ❶ single committer goroutine with WaitGroup - uses original context
 	var committerWG sync.WaitGroup
@@ -115,11 +188,10 @@ func (s *Syncer) Sync(ctx context.Context) error {
 
 			for day := range workCh {
 				processedDays++
-				if err := s.syncDay(workerCtx, day, commitCh); err != nil {
+				if err := s.syncDay(workerCtx, day, from, to, resume, progress, commitCh); err != nil {
 					failedDays++
-					workerErrors = append(workerErrors, fmt.Errorf("day %s: %w", day, err))
-
-					continue
+					workerErrors = append(workerErrors, fmt.Errorf("day %s: %w", day.prefix, err))
+					progress.fail(day.root)
 				}
 			}
 
@@ -148,8 +220,18 @@ func (s *Syncer) Sync(ctx context.Context) error {
 	if workerErr != nil {
 		return fmt.Errorf("worker errors occurred: %w", workerErr)
 	}
+	if enqueueErr != nil {
+		return enqueueErr
+	}
 
-	slog.Info("CloudTrail sync finished")
+	if s.window.Incremental() {
+		if err := progress.save(ctx, s.checkpoints); err != nil {
+			return err
+		}
+	}
+	if failed := progress.failures(); len(failed) > 0 {
+		return fmt.Errorf("failed to sync some days of %s", strings.Join(failed, ", "))
+	}
 	return nil
 }
 
@@ -179,12 +261,29 @@ func (s *Syncer) discoverAccountsAndRegions(ctx context.Context) (map[string][]s
 	return out, it.Err()
 }
 
-// enqueueAllDays emits one prefix per calendar-day into work chan.
-func (s *Syncer) enqueueAllDays(ctx context.Context, acc map[string][]string, cutoff time.Time, work chan<- string) error {
+// regionRoot is the key prefix of the CloudTrail logs of one account and region.
+func regionRoot(acct, region string) string {
+	return fmt.Sprintf("AWSLogs/%s/CloudTrail/%s/", acct, region)
+}
+
+// dayWork is one calendar-day prefix of a region to sync.
+type dayWork struct {
+	root   string
+	prefix string
+}
+
+// enqueueAllDays emits one prefix per calendar-day up to lastDay into work chan, from cutoff, or
+// from the day files were last synced in the region when resuming from its checkpoint.
+func (s *Syncer) enqueueAllDays(ctx context.Context, acc map[string][]string, defaultCutoff, lastDay time.Time, resume bool, progress *progress, work chan<- dayWork) error {
 	slog.Info("Enqueuing days", "accounts", acc)
 	for acct, regions := range acc {
 		for _, region := range regions {
-			root := fmt.Sprintf("AWSLogs/%s/CloudTrail/%s/", acct, region)
+			root := regionRoot(acct, region)
+			cutoff := defaultCutoff
+			if from := progress.from(root); resume && !from.IsZero() {
+				cutoff = time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+			}
+
 			yearIter := s.prefixIter(ctx, root, "")
 			for yearIter.Next() {
 				yr := yearIter.Suffix()
@@ -222,11 +321,11 @@ func (s *Syncer) enqueueAllDays(ctx context.Context, acc map[string][]string, cu
 
 						dayDate := time.Date(yrInt, time.Month(moInt), dayInt,
 							0, 0, 0, 0, time.UTC)
-						if dayDate.Before(cutoff) {
+						if dayDate.Before(cutoff) || dayDate.After(lastDay) {
 							continue
 						}
 
-						work <- prefix
+						work <- dayWork{root: root, prefix: prefix}
 					}
 					if err := dayIter.Err(); err != nil {
 						return err
@@ -244,6 +343,172 @@ func (s *Syncer) enqueueAllDays(ctx context.Context, acc map[string][]string, cu
 	return nil
 }
 
+// keyDay returns the day of a key under root, which is yyyy/mm/dd/… below it.
+func keyDay(root, key string) (time.Time, bool) {
+	rest := strings.TrimPrefix(key, root)
+	if key == "" || rest == key || len(rest) < len("2006/01/02") {
+		return time.Time{}, false
+	}
+	day, err := time.Parse("2006/01/02", rest[:len("2006/01/02")])
+	if err != nil {
+		return time.Time{}, false
+	}
+	return day, true
+}
+
+// ---------------------------------------------------------------------------
+// CHECKPOINTS
+// ---------------------------------------------------------------------------
+
+// regionCheckpoint is the checkpoint of one region: the upload time of the newest file synced, and
+// the files uploaded within resumeOverlap before it by key, which the next sync lists again.
+type regionCheckpoint struct {
+	Time  time.Time            `json:"time"`
+	Files map[string]time.Time `json:"files,omitempty"`
+}
+
+// progress tracks the files synced in each region. A region's checkpoint only advances when none
+// of its days failed, since its days are synced concurrently and out of order.
+type progress struct {
+	bucket string
+	start  map[string]regionCheckpoint // root → checkpoint at the start of the sync
+
+	mu     sync.Mutex
+	synced map[string]map[string]time.Time // root → key → upload time of the files synced
+	failed map[string]bool
+}
+
+// checkpointKey is the key of the checkpoint of the region under root in bucket.
+func checkpointKey(bucket, root string) string {
+	return "cloudtrail/" + bucket + "/" + strings.TrimSuffix(root, "/")
+}
+
+// loadProgress reads the checkpoint of every region.
+func (s *Syncer) loadProgress(ctx context.Context, acc map[string][]string) (*progress, error) {
+	p := &progress{
+		bucket: s.bucket,
+		start:  map[string]regionCheckpoint{},
+		synced: map[string]map[string]time.Time{},
+		failed: map[string]bool{},
+	}
+	if s.checkpoints == nil {
+		return p, nil
+	}
+
+	for acct, regions := range acc {
+		for _, region := range regions {
+			root := regionRoot(acct, region)
+			value, err := s.checkpoints.Get(ctx, checkpointKey(s.bucket, root))
+			if err != nil {
+				return nil, oops.Wrapf(err, "read checkpoint of %s", root)
+			}
+			p.start[root] = parseCheckpoint(root, value)
+		}
+	}
+	return p, nil
+}
+
+// parseCheckpoint parses the checkpoint of the region under root. Checkpoints written before
+// regions were checkpointed by time hold the last key synced, and resume from the start of its day.
+func parseCheckpoint(root, value string) regionCheckpoint {
+	var checkpoint regionCheckpoint
+	if value == "" || json.Unmarshal([]byte(value), &checkpoint) == nil {
+		return checkpoint
+	}
+	if day, ok := keyDay(root, value); ok {
+		return regionCheckpoint{Time: day.Add(resumeOverlap)}
+	}
+	return regionCheckpoint{}
+}
+
+// from returns the upload time from which the files of the region under root are synced when
+// resuming, or the zero time when it has no checkpoint.
+func (p *progress) from(root string) time.Time {
+	start := p.start[root]
+	if start.Time.IsZero() {
+		return time.Time{}
+	}
+	return start.Time.Add(-resumeOverlap)
+}
+
+// skip reports whether a file was synced before: when resuming, files uploaded before the overlap
+// and the files of the overlap which the checkpoint has.
+func (p *progress) skip(root, key string, uploaded time.Time, resume bool) bool {
+	if !resume {
+		return false
+	}
+	if from := p.from(root); uploaded.Before(from) {
+		return true
+	}
+	_, ok := p.start[root].Files[key]
+	return ok
+}
+
+func (p *progress) sync(root, key string, uploaded time.Time) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.synced[root] == nil {
+		p.synced[root] = map[string]time.Time{}
+	}
+	p.synced[root][key] = uploaded
+}
+
+func (p *progress) fail(root string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.failed[root] = true
+}
+
+// failures returns the regions which had a failed day.
+func (p *progress) failures() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	roots := make([]string, 0, len(p.failed))
+	for root := range p.failed {
+		roots = append(roots, strings.TrimSuffix(root, "/"))
+	}
+	sort.Strings(roots)
+	return roots
+}
+
+// save checkpoints every region which synced files and had no failed day.
+func (p *progress) save(ctx context.Context, store checkpoint.Store) error {
+	if store == nil {
+		return nil
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for root, synced := range p.synced {
+		if p.failed[root] {
+			continue
+		}
+
+		next := regionCheckpoint{Time: p.start[root].Time, Files: map[string]time.Time{}}
+		for _, uploaded := range synced {
+			if uploaded.After(next.Time) {
+				next.Time = uploaded
+			}
+		}
+		for _, files := range []map[string]time.Time{p.start[root].Files, synced} {
+			for key, uploaded := range files {
+				if !uploaded.Before(next.Time.Add(-resumeOverlap)) {
+					next.Files[key] = uploaded
+				}
+			}
+		}
+
+		value, err := json.Marshal(next)
+		if err != nil {
+			return oops.Wrapf(err, "encode checkpoint of %s", root)
+		}
+		if err := store.Set(ctx, checkpointKey(p.bucket, root), string(value)); err != nil {
+			return oops.Wrapf(err, "write checkpoint of %s", root)
+		}
+	}
+	return nil
+}
+
 // prefixIter is a helper that yields "folders" one level below base.
 type prefixIter struct {
 	ctx    context.Context
@@ -317,25 +582,31 @@ func (p *prefixIter) reset()         { p.i++ }
 // PER-DAY PROCESSING
 // ---------------------------------------------------------------------------
 
+// syncDay syncs the objects of one day which were not synced before, keeping the events in
+// [since, until) when set, and records the files it read in progress.
 func (s *Syncer) syncDay(
 	ctx context.Context,
-	dayPrefix string,
+	day dayWork,
+	since, until time.Time,
+	resume bool,
+	progress *progress,
 	commitCh chan<- []ocsf.APIActivity) error {
 
-	slog.Info("Syncing day", "dayPrefix", dayPrefix)
+	slog.Info("Syncing day", "dayPrefix", day.prefix)
 
-	it := s.objectIter(ctx, dayPrefix)
+	it := s.objectIter(ctx, day.prefix)
 	buf := make([]ocsf.APIActivity, 0, s.batchSize)
 
 	for it.Next() {
-		key := it.Key()
-		if !strings.HasSuffix(key, ".json.gz") {
+		key, uploaded := it.Key(), it.LastModified()
+		if !strings.HasSuffix(key, ".json.gz") || progress.skip(day.root, key, uploaded, resume) {
 			continue
 		}
 
-		if err := s.processFile(ctx, key, &buf); err != nil {
+		if err := s.processFile(ctx, key, since, until, &buf); err != nil {
 			return err
 		}
+		progress.sync(day.root, key, uploaded)
 
 		if len(buf) >= s.batchSize {
 			out := make([]ocsf.APIActivity, len(buf))
@@ -363,7 +634,7 @@ type objectIter struct {
 	bucket string
 	prefix string
 	token  *string
-	cur    []string
+	cur    []types.Object
 	seen   map[string]bool
 	i      int
 	err    error
@@ -374,11 +645,12 @@ func (s *Syncer) objectIter(ctx context.Context, pfx string) *objectIter {
 }
 func (o *objectIter) Next() bool {
 	for o.err == nil && o.i >= len(o.cur) {
-		out, err := o.s3.ListObjectsV2(o.ctx, &s3.ListObjectsV2Input{
+		input := &s3.ListObjectsV2Input{
 			Bucket:            &o.bucket,
 			Prefix:            &o.prefix,
 			ContinuationToken: o.token,
-		})
+		}
+		out, err := o.s3.ListObjectsV2(o.ctx, input)
 		if err != nil {
 			o.err = err
 			return false
@@ -386,7 +658,7 @@ func (o *objectIter) Next() bool {
 		o.cur = o.cur[:0]
 		for _, obj := range out.Contents {
 			if !o.seen[*obj.Key] {
-				o.cur = append(o.cur, *obj.Key)
+				o.cur = append(o.cur, obj)
 				o.seen[*obj.Key] = true
 			}
 		}
@@ -407,15 +679,18 @@ func (o *objectIter) Next() bool {
 	o.i++
 	return true
 }
-func (o *objectIter) Key() string { return o.cur[o.i-1] }
-func (o *objectIter) Err() error  { return o.err }
-func (o *objectIter) reset()      { o.i++ }
+func (o *objectIter) Key() string { return *o.cur[o.i-1].Key }
+func (o *objectIter) LastModified() time.Time {
+	return aws.TimeValue(o.cur[o.i-1].LastModified)
+}
+func (o *objectIter) Err() error { return o.err }
+func (o *objectIter) reset()     { o.i++ }
 
 // ---------------------------------------------------------------------------
 // FILE → OCSF CONVERSION
 // ---------------------------------------------------------------------------
 
-func (s *Syncer) processFile(ctx context.Context, key string, buf *[]ocsf.APIActivity) error {
+func (s *Syncer) processFile(ctx context.Context, key string, since, until time.Time, buf *[]ocsf.APIActivity) error {
 	slog.Info("Processing file", "key", key)
 	obj, err := s.s3.GetObject(ctx, &s3.GetObjectInput{Bucket: &s.bucket, Key: &key})
 	if err != nil {
@@ -440,6 +715,12 @@ func (s *Syncer) processFile(ctx context.Context, key string, buf *[]ocsf.APIAct
 			slog.Warn("convert", "key", key, "err", err)
 			continue
 		}
+		if !since.IsZero() && evt.Time < since.UnixMilli() {
+			continue
+		}
+		if !until.IsZero() && evt.Time >= until.UnixMilli() {
+			continue
+		}
 		*buf = append(*buf, evt)
 	}
 	return nil
@@ -447,46 +728,23 @@ func (s *Syncer) processFile(ctx context.Context, key string, buf *[]ocsf.APIAct
 
 func (s *Syncer) ToOCSF(ctx context.Context, event CloudtrailEvent) (ocsf.APIActivity, error) {
 	// Parse the event data for OCSF conversion
-	classUID := 6003
-	categoryUID := 6
-	categoryName := "Application Activity"
-	className := "API Activity"
-
-	var activityID int
-	var activityName string
-	var typeUID int
-	var typeName string
+	var activityID ocsf.APIActivityActivityId
 
 	// Determine the activity type based on the event name
 	eventName := event.EventName
 	if strings.HasPrefix(eventName, "Create") || strings.HasPrefix(eventName, "Add") ||
 		strings.HasPrefix(eventName, "Put") || strings.HasPrefix(eventName, "Insert") {
-		activityID = 1
-		activityName = "create"
-		typeUID = classUID*100 + activityID
-		typeName = "API Activity: Create"
+		activityID = ocsf.APIActivityActivityIdCreate
 	} else if strings.HasPrefix(eventName, "Get") || strings.HasPrefix(eventName, "Describe") ||
 		strings.HasPrefix(eventName, "List") || strings.HasPrefix(eventName, "Search") {
-		activityID = 2
-		activityName = "read"
-		typeUID = classUID*100 + activityID
-		typeName = "API Activity: Read"
+		activityID = ocsf.APIActivityActivityIdRead
 	} else if strings.HasPrefix(eventName, "Update") || strings.HasPrefix(eventName, "Modify") ||
 		strings.HasPrefix(eventName, "Set") {
-		activityID = 3
-		activityName = "update"
-		typeUID = classUID*100 + activityID
-		typeName = "API Activity: Update"
+		activityID = ocsf.APIActivityActivityIdUpdate
 	} else if strings.HasPrefix(eventName, "Delete") || strings.HasPrefix(eventName, "Remove") {
-		activityID = 4
-		activityName = "delete"
-		typeUID = classUID*100 + activityID
-		typeName = "API Activity: Delete"
+		activityID = ocsf.APIActivityActivityIdDelete
 	} else {
-		activityID = 0
-		activityName = "unknown"
-		typeUID = classUID*100 + activityID
-		typeName = "API Activity: Unknown"
+		activityID = ocsf.APIActivityActivityIdUnknown
 	}
 
 	// Map event success to OCSF status
@@ -541,6 +799,9 @@ func (s *Syncer) ToOCSF(ctx context.Context, event CloudtrailEvent) (ocsf.APIAct
 			Name: stringPtr(event.EventSource),
 		},
 	}
+	if event.RequestID != "" {
+		api.Request = &ocsf.RequestElements{Uid: event.RequestID}
+	}
 
 	// Parse resource information
 	var resources []ocsf.ResourceDetails
@@ -575,41 +836,43 @@ func (s *Syncer) ToOCSF(ctx context.Context, event CloudtrailEvent) (ocsf.APIAct
 	}
 
 	// Create the OCSF API Activity
-	activity := ocsf.APIActivity{
-		ActivityId:   int32(activityID),
-		ActivityName: &activityName,
-		Actor:        actor,
-		Api:          api,
-		CategoryName: &categoryName,
-		CategoryUid:  int32(categoryUID),
-		ClassName:    &className,
-		ClassUid:     int32(classUID),
-		Status:       &status,
-		StatusId:     int32Ptr(int32(statusID)),
-		Cloud: ocsf.Cloud{
-			Provider: "AWS",
-			Region:   stringPtr(event.AwsRegion),
-			Account: &ocsf.Account{
-				TypeId: int32Ptr(10), // AWS Account
-				Type:   stringPtr("AWS Account"),
-				Uid:    stringPtr(event.RecipientAccountID),
-			},
+	activity := ocsf.NewAPIActivity(activityID)
+	activity.Actor = actor
+	activity.Api = api
+	activity.Status = &status
+	activity.StatusId = int32Ptr(int32(statusID))
+	activity.Cloud = ocsf.Cloud{
+		Provider: "AWS",
+		Region:   stringPtr(event.AwsRegion),
+		Account: &ocsf.Account{
+			TypeId: int32Ptr(10), // AWS Account
+			Type:   stringPtr("AWS Account"),
+			Uid:    stringPtr(event.RecipientAccountID),
 		},
+	}
+	activity.Resources = resources
+	activity.Severity = &severity
+	activity.SeverityId = int32(severityID)
+	activity.Metadata.Product = ocsf.Product{
+		Name:       stringPtr("CloudTrail"),
+		VendorName: stringPtr("AWS"),
+	}
+	activity.SrcEndpoint = srcEndpoint
+	activity.Time = ts.UnixMilli()
+	activity.TimezoneOffset = int32Ptr(0)
 
-		Resources:  resources,
-		Severity:   &severity,
-		SeverityId: int32(severityID),
-
-		Metadata: ocsf.Metadata{
-			CorrelationUid: stringPtr(event.EventID),
-		},
+	// The event ID has no OCSF attribute, as metadata.uid is computed by eventuid.
+	unmapped, err := schema.NewRawJSON(map[string]string{"eventID": event.EventID})
+	if err != nil {
+		return ocsf.APIActivity{}, fmt.Errorf("failed to encode unmapped attributes: %w", err)
+	}
+	activity.Unmapped = &unmapped
 
-		SrcEndpoint:    srcEndpoint,
-		Time:           ts.UnixMilli(),
-		TypeName:       &typeName,
-		TypeUid:        int64(typeUID),
-		TimezoneOffset: int32Ptr(0),
+	uid, err := eventuid.UID(&activity, event.EventID)
+	if err != nil {
+		return ocsf.APIActivity{}, fmt.Errorf("failed to compute event UID: %w", err)
 	}
+	activity.Metadata.Uid = &uid
 
 	return activity, nil
 }
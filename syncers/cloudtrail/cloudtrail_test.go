@@ -0,0 +1,123 @@
+package cloudtrail
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Santiago-Labs/go-ocsf/ocsf/convert"
+	ocsf "github.com/Santiago-Labs/go-ocsf/ocsf/v1_4_0"
+	"github.com/Santiago-Labs/go-ocsf/ocsf/v1_5_0"
+	"github.com/Santiago-Labs/go-ocsf/syncers/checkpoint"
+)
+
+func TestProgress(t *testing.T) {
+	ctx := context.Background()
+	store := &checkpoint.FileStore{Dir: t.TempDir()}
+	s := &Syncer{bucket: "trail", checkpoints: store}
+	acc := map[string][]string{"1": {"us-east-1", "eu-west-1"}}
+	root, failedRoot := regionRoot("1", "us-east-1"), regionRoot("1", "eu-west-1")
+	day := root + "2025/01/02/"
+	noon := time.Date(2025, 1, 2, 12, 0, 0, 0, time.UTC)
+
+	p, err := s.loadProgress(ctx, acc)
+	if err != nil {
+		t.Fatalf("loadProgress: %v", err)
+	}
+	p.sync(root, day+"b.json.gz", noon.Add(-2*time.Hour))
+	p.sync(root, day+"c.json.gz", noon)
+	p.sync(failedRoot, day+"a.json.gz", noon)
+	p.fail(failedRoot)
+	if err := p.save(ctx, store); err != nil {
+		t.Fatalf("save: %v", err)
+	}
+	if failed := p.failures(); len(failed) != 1 || failed[0] != "AWSLogs/1/CloudTrail/eu-west-1" {
+		t.Errorf("failures = %v", failed)
+	}
+
+	p, err = s.loadProgress(ctx, acc)
+	if err != nil {
+		t.Fatalf("loadProgress: %v", err)
+	}
+	if from := p.from(failedRoot); !from.IsZero() {
+		t.Errorf("a region with a failed day was checkpointed from %s", from)
+	}
+	if from, want := p.from(root), noon.Add(-resumeOverlap); !from.Equal(want) {
+		t.Errorf("from = %s, want %s", from, want)
+	}
+
+	for _, tt := range []struct {
+		key      string
+		uploaded time.Time
+		skip     bool
+	}{
+		{day + "b.json.gz", noon.Add(-2 * time.Hour), true},
+		{day + "c.json.gz", noon, true},
+		// uploaded late, with a key before the files synced
+		{day + "a.json.gz", noon.Add(-time.Minute), false},
+		{day + "d.json.gz", noon.Add(time.Minute), false},
+	} {
+		if skip := p.skip(root, tt.key, tt.uploaded, true); skip != tt.skip {
+			t.Errorf("skip(%s) = %v, want %v", tt.key, skip, tt.skip)
+		}
+		if p.skip(root, tt.key, tt.uploaded, false) {
+			t.Errorf("skip(%s) outside a resumed sync", tt.key)
+		}
+	}
+}
+
+func TestParseCheckpoint(t *testing.T) {
+	root := regionRoot("1", "us-east-1")
+
+	// Checkpoints of the last key synced resume from the start of its day.
+	p := &progress{start: map[string]regionCheckpoint{
+		root: parseCheckpoint(root, root+"2025/01/02/1_CloudTrail_us-east-1_20250102T1200Z_x.json.gz"),
+	}}
+	if from, want := p.from(root), time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC); !from.Equal(want) {
+		t.Errorf("from = %s, want %s", from, want)
+	}
+
+	if checkpoint := parseCheckpoint(root, ""); !checkpoint.Time.IsZero() {
+		t.Errorf("parseCheckpoint of no checkpoint = %+v", checkpoint)
+	}
+}
+
+// TestToOCSFValidates checks that the activities the syncer produces pass validation, so that
+// storage with -reject-invalid keeps them, both as written and converted to 1.5.0.
+func TestToOCSFValidates(t *testing.T) {
+	userName, accountID, errorCode := "jack", "123456789012", "AccessDenied"
+	event := CloudtrailEvent{
+		EventID:            "event",
+		RequestID:          "request",
+		EventTime:          time.Date(2025, 1, 2, 12, 0, 0, 0, time.UTC),
+		EventSource:        "s3.amazonaws.com",
+		EventName:          "GetObject",
+		AwsRegion:          "us-east-1",
+		SourceIP:           "10.0.0.1",
+		UserIdentity:       UserIdentity{UserName: &userName, AccountID: &accountID},
+		Resources:          []ResourceRef{{ARN: "arn:aws:s3:::bucket/key", Type: "AWS::S3::Object"}},
+		RecipientAccountID: accountID,
+	}
+	failed := event
+	failed.ErrorCode = &errorCode
+
+	for name, event := range map[string]CloudtrailEvent{"success": event, "failure": failed} {
+		t.Run(name, func(t *testing.T) {
+			activity, err := (&Syncer{}).ToOCSF(context.Background(), event)
+			if err != nil {
+				t.Fatalf("ToOCSF: %v", err)
+			}
+			if err := activity.Validate(); err != nil {
+				t.Errorf("1.4.0 activity: %v", err)
+			}
+
+			upgraded, _, err := convert.Convert[ocsf.APIActivity, v1_5_0.APIActivity](activity)
+			if err != nil {
+				t.Fatalf("failed to convert to 1.5.0: %v", err)
+			}
+			if err := upgraded.Validate(); err != nil {
+				t.Errorf("1.5.0 activity: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package cloudtrail
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Santiago-Labs/go-ocsf/syncers"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+)
+
+func init() {
+	syncers.Register(syncers.Plugin{
+		Name:      "cloudtrail",
+		Source:    "CloudTrail",
+		Classes:   syncers.APIActivityClasses,
+		NewConfig: func() syncers.Config { return &Config{} },
+		New: func(ctx context.Context, cfg syncers.Config, opts syncers.Options) (syncers.DataSync, error) {
+			awsConfig, err := config.LoadDefaultConfig(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("load AWS config: %w", err)
+			}
+			return NewSyncer(ctx, s3.NewFromConfig(awsConfig), cfg.(*Config).Bucket, opts.Storage, opts.Window)
+		},
+	})
+}
@@ -23,6 +23,7 @@ type CloudtrailEvent struct {
 	// ---- required-ish core fields ---------------------------------
 	EventVersion string    `json:"eventVersion"`
 	EventID      string    `json:"eventID"`
+	RequestID    string    `json:"requestID,omitempty"`
 	EventTime    time.Time `json:"eventTime"`
 	EventSource  string    `json:"eventSource"`
 	EventName    string    `json:"eventName"`
@@ -10,17 +10,51 @@ import (
 
 	"github.com/Santiago-Labs/go-ocsf/clients/gcp"
 	"github.com/Santiago-Labs/go-ocsf/datastore"
+	schema "github.com/Santiago-Labs/go-ocsf/ocsf"
+	"github.com/Santiago-Labs/go-ocsf/ocsf/eventuid"
 	ocsf "github.com/Santiago-Labs/go-ocsf/ocsf/v1_4_0"
+	"github.com/Santiago-Labs/go-ocsf/syncers"
+	"github.com/Santiago-Labs/go-ocsf/syncers/checkpoint"
 	"google.golang.org/api/iterator"
 )
 
+// Config configures the GCP audit log syncer.
+type Config struct {
+	ProjectID string `yaml:"project_id" env:"GCP_PROJECT_ID" usage:"GCP project to read audit logs from"`
+	Filter    string `yaml:"filter" flag:"filter" usage:"Cloud Logging filter the audit logs must also match, e.g. protoPayload.serviceName=\"iam.googleapis.com\""`
+}
+
+func (c *Config) Validate() error {
+	if c.ProjectID == "" {
+		return errors.New("GCP_PROJECT_ID must be set")
+	}
+	return nil
+}
+
 type GCPAuditLogSyncer struct {
-	datastore datastore.Datastore[ocsf.APIActivity]
-	projectID string
-	client    *gcp.Client
+	datastore   datastore.Datastore[ocsf.APIActivity]
+	checkpoints checkpoint.Store
+	projectID   string
+	client      *gcp.Client
+	window      syncers.TimeWindow
+	filter      string
+}
+
+// defaultLookback is how far back the first sync of a project reads audit logs.
+const defaultLookback = 7 * 24 * time.Hour
+
+// NewGCPAuditLogSyncer creates a syncer of the audit logs of projectID within window.
+func NewGCPAuditLogSyncer(ctx context.Context, projectID string, storageOpts datastore.StorageOpts, window syncers.TimeWindow) (syncers.DataSync, error) {
+	syncer, err := newSyncer(ctx, projectID, "", storageOpts, window)
+	if err != nil {
+		return nil, err
+	}
+	return syncer, nil
 }
 
-func NewGCPAuditLogSyncer(ctx context.Context, projectID string, storageOpts datastore.StorageOpts) (*GCPAuditLogSyncer, error) {
+// newSyncer creates a syncer of the audit logs of projectID within window which also match
+// filter, when it is set.
+func newSyncer(ctx context.Context, projectID, filter string, storageOpts datastore.StorageOpts, window syncers.TimeWindow) (*GCPAuditLogSyncer, error) {
 	if projectID == "" {
 		return nil, errors.New("projectID is required it can be set via the GCP_PROJECT_ID environment variable")
 	}
@@ -30,32 +64,69 @@ func NewGCPAuditLogSyncer(ctx context.Context, projectID string, storageOpts dat
 		return nil, fmt.Errorf("failed to create GCP client: %w", err)
 	}
 
-	dataStoreInst, err := datastore.SetupStorage[ocsf.APIActivity](ctx, storageOpts)
+	dataStoreInst, err := syncers.SetupAPIActivityStorage(ctx, storageOpts)
 	if err != nil {
 		return nil, fmt.Errorf("failed to setup datastore: %w", err)
 	}
 
+	checkpoints, err := syncers.NewAPIActivityCheckpoints(ctx, storageOpts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to setup checkpoints: %w", err)
+	}
+
 	return &GCPAuditLogSyncer{
-		datastore: dataStoreInst,
-		projectID: projectID,
-		client:    client,
+		datastore:   dataStoreInst,
+		checkpoints: checkpoints,
+		projectID:   projectID,
+		client:      client,
+		window:      window,
+		filter:      filter,
 	}, nil
 }
 
 func (s *GCPAuditLogSyncer) Sync(ctx context.Context) error {
 	slog.Info("syncing GCP audit logs")
 
-	// TODO: Make the time range of logs configurable. Default to 1 week for now.
-	lastWeek := time.Now().Add(-24 * 7 * time.Hour).Format(time.RFC3339)
+	// Resume from the newest log saved by the last sync. Logs sharing its timestamp are read again,
+	// and are deduplicated by their metadata.uid.
+	last, err := checkpoint.GetTime(ctx, s.checkpoints, s.checkpointKey())
+	if err != nil {
+		return fmt.Errorf("failed to read checkpoint: %w", err)
+	}
+	since, until := s.window.Range(last, defaultLookback, time.Now())
+
+	if s.window.Backfill > 0 {
+		err = syncers.Backfill(ctx, s.checkpoints, s.checkpointKey(), since, until, s.window.Backfill, s.syncRange)
+	} else {
+		err = s.syncRange(ctx, since, until)
+	}
+	if err != nil {
+		return err
+	}
+
+	slog.Info("Finished GCP audit log sync")
+	return nil
+}
+
+func (s *GCPAuditLogSyncer) checkpointKey() string {
+	return "gcpauditlog/" + s.projectID
+}
 
+// syncRange saves the audit logs with a timestamp in [since, until).
+func (s *GCPAuditLogSyncer) syncRange(ctx context.Context, since, until time.Time) error {
 	filter := fmt.Sprintf(`protoPayload.@type = "type.googleapis.com/google.cloud.audit.AuditLog" AND
-	timestamp >= "%s"
-	`, lastWeek)
+	timestamp >= "%s" AND
+	timestamp < "%s"
+	`, since.UTC().Format(time.RFC3339Nano), until.UTC().Format(time.RFC3339Nano))
+	if s.filter != "" {
+		filter += fmt.Sprintf(" AND (%s)", s.filter)
+	}
 
 	it := s.client.ListAuditLogsIterator(ctx, filter)
 
 	var savedActivities, foundActivities int
 	var activitiesToSave []ocsf.APIActivity
+	var newest time.Time
 	const batchSize = 1000
 
 	for {
@@ -74,6 +145,9 @@ func (s *GCPAuditLogSyncer) Sync(ctx context.Context) error {
 		}
 
 		activitiesToSave = append(activitiesToSave, activity)
+		if log.Timestamp.After(newest) {
+			newest = log.Timestamp
+		}
 
 		// Save in batches of batchSize
 		if len(activitiesToSave) >= batchSize {
@@ -83,6 +157,11 @@ func (s *GCPAuditLogSyncer) Sync(ctx context.Context) error {
 			}
 			savedActivities += len(activitiesToSave)
 			activitiesToSave = nil // Reset the slice
+
+			// Logs are listed oldest first, so every log up to the newest one is saved.
+			if err := s.advanceCheckpoint(ctx, newest); err != nil {
+				return err
+			}
 		}
 	}
 
@@ -93,9 +172,24 @@ func (s *GCPAuditLogSyncer) Sync(ctx context.Context) error {
 			return fmt.Errorf("failed to save remaining API activities: %w", err)
 		}
 		savedActivities += len(activitiesToSave)
+
+		if err := s.advanceCheckpoint(ctx, newest); err != nil {
+			return err
+		}
 	}
 
-	slog.Info("Finished GCP audit log sync", "saved_activities", savedActivities, "found_activities", foundActivities)
+	slog.Info("Synced GCP audit logs", "since", since, "until", until, "saved_activities", savedActivities, "found_activities", foundActivities)
+	return nil
+}
+
+// advanceCheckpoint records newest as the checkpoint, unless the window ends before now.
+func (s *GCPAuditLogSyncer) advanceCheckpoint(ctx context.Context, newest time.Time) error {
+	if !s.window.Incremental() {
+		return nil
+	}
+	if err := checkpoint.AdvanceTime(ctx, s.checkpoints, s.checkpointKey(), newest); err != nil {
+		return fmt.Errorf("failed to write checkpoint: %w", err)
+	}
 	return nil
 }
 
@@ -103,46 +197,23 @@ func (s *GCPAuditLogSyncer) Sync(ctx context.Context) error {
 func (s *GCPAuditLogSyncer) ToOCSF(ctx context.Context, log *gcp.AuditLog) (ocsf.APIActivity, error) {
 	// Parse the timestamp
 	methodName := log.AuditLog.MethodName
-	classUID := 6003
-	categoryUID := 6
-	categoryName := "Application Activity"
-	className := "API Activity"
-
-	var activityID int
-	var activityName string
-	var typeUID int
-	var typeName string
+	var activityID ocsf.APIActivityActivityId
 
 	// Check for common method name patterns
 	if strings.Contains(methodName, "create") || strings.Contains(methodName, "Create") ||
 		strings.Contains(methodName, "insert") || strings.Contains(methodName, "Insert") ||
 		strings.Contains(methodName, "cloudsql.instances.automatedBackup") {
-		activityID = 1
-		activityName = "create"
-		typeUID = classUID*100 + activityID
-		typeName = "API Activity: Create"
+		activityID = ocsf.APIActivityActivityIdCreate
 	} else if strings.Contains(methodName, "get") || strings.Contains(methodName, "Get") ||
 		strings.Contains(methodName, "list") || strings.Contains(methodName, "List") {
-		activityID = 2
-		activityName = "read"
-		typeUID = classUID*100 + activityID
-		typeName = "API Activity: Read"
+		activityID = ocsf.APIActivityActivityIdRead
 	} else if strings.Contains(methodName, "update") || strings.Contains(methodName, "Update") ||
 		strings.Contains(methodName, "modify") || strings.Contains(methodName, "Modify") {
-		activityID = 3
-		activityName = "update"
-		typeUID = classUID*100 + activityID
-		typeName = "API Activity: Update"
+		activityID = ocsf.APIActivityActivityIdUpdate
 	} else if strings.Contains(methodName, "delete") || strings.Contains(methodName, "Delete") {
-		activityID = 4
-		activityName = "delete"
-		typeUID = classUID*100 + activityID
-		typeName = "API Activity: Delete"
+		activityID = ocsf.APIActivityActivityIdDelete
 	} else {
-		activityID = 0
-		activityName = "unknown"
-		typeUID = classUID*100 + activityID
-		typeName = "API Activity: Unknown"
+		activityID = ocsf.APIActivityActivityIdUnknown
 	}
 
 	status, statusID := mapGCPStatusToOCSFStatus(int32(log.Log.Severity))
@@ -221,32 +292,34 @@ func (s *GCPAuditLogSyncer) ToOCSF(ctx context.Context, log *gcp.AuditLog) (ocsf
 	}
 
 	// Create the API Activity
-	activity := ocsf.APIActivity{
-		ActivityId:   int32(activityID),
-		ActivityName: &activityName,
-		Actor:        actor,
-		Api:          api,
-		CategoryName: &categoryName,
-		CategoryUid:  int32(categoryUID),
-		ClassName:    &className,
-		ClassUid:     int32(classUID),
-		Status:       &status,
-		StatusId:     int32Ptr(int32(statusID)),
-
-		Resources:  resources,
-		Severity:   &severity,
-		SeverityId: int32(severityID),
-
-		Metadata: ocsf.Metadata{
-			CorrelationUid: stringPtr(log.ID),
-		},
-
-		SrcEndpoint:    srcEndpoint,
-		Time:           ts.UnixMilli(),
-		TypeName:       &typeName,
-		TypeUid:        int64(typeUID),
-		TimezoneOffset: int32Ptr(0),
+	activity := ocsf.NewAPIActivity(activityID)
+	activity.Actor = actor
+	activity.Api = api
+	activity.Status = &status
+	activity.StatusId = int32Ptr(int32(statusID))
+	activity.Resources = resources
+	activity.Severity = &severity
+	activity.SeverityId = int32(severityID)
+	activity.Metadata.Product = ocsf.Product{
+		Name:       stringPtr("Cloud Audit Logs"),
+		VendorName: stringPtr("Google"),
+	}
+	activity.SrcEndpoint = srcEndpoint
+	activity.Time = ts.UnixMilli()
+	activity.TimezoneOffset = int32Ptr(0)
+
+	// The insert ID of the log entry has no OCSF attribute.
+	unmapped, err := schema.NewRawJSON(map[string]string{"insertId": log.ID})
+	if err != nil {
+		return ocsf.APIActivity{}, fmt.Errorf("failed to encode unmapped attributes: %w", err)
+	}
+	activity.Unmapped = &unmapped
+
+	uid, err := eventuid.UID(&activity, log.ID)
+	if err != nil {
+		return ocsf.APIActivity{}, fmt.Errorf("failed to compute event UID: %w", err)
 	}
+	activity.Metadata.Uid = &uid
 
 	return activity, nil
 }
@@ -0,0 +1,24 @@
+package gcpauditlog
+
+import (
+	"context"
+
+	"github.com/Santiago-Labs/go-ocsf/syncers"
+)
+
+func init() {
+	syncers.Register(syncers.Plugin{
+		Name:      "gcp-audit-log",
+		Source:    "GCP AuditLog",
+		Classes:   syncers.APIActivityClasses,
+		NewConfig: func() syncers.Config { return &Config{} },
+		New: func(ctx context.Context, cfg syncers.Config, opts syncers.Options) (syncers.DataSync, error) {
+			c := cfg.(*Config)
+			syncer, err := newSyncer(ctx, c.ProjectID, c.Filter, opts.Storage, opts.Window)
+			if err != nil {
+				return nil, err
+			}
+			return syncer, nil
+		},
+	})
+}
@@ -8,29 +8,44 @@ import (
 	"time"
 
 	"github.com/Santiago-Labs/go-ocsf/datastore"
+	"github.com/Santiago-Labs/go-ocsf/ocsf/eventuid"
 	ocsf "github.com/Santiago-Labs/go-ocsf/ocsf/v1_4_0"
+	"github.com/Santiago-Labs/go-ocsf/syncers/lifecycle"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/inspector2"
 	"github.com/aws/aws-sdk-go-v2/service/inspector2/types"
 	"github.com/samsarahq/go/oops"
 )
 
+// InspectorConfig configures the Inspector syncer, which reads the findings of the AWS account and
+// region of the default AWS config.
+type InspectorConfig struct{}
+
+func (c *InspectorConfig) Validate() error { return nil }
+
 type InspectorOCSFSyncer struct {
 	inspectorClient *inspector2.Client
 	datastore       datastore.Datastore[ocsf.VulnerabilityFinding]
+	tracker         *lifecycle.Tracker[ocsf.VulnerabilityFinding, *ocsf.VulnerabilityFinding]
 }
 
 // NewInspectorOCSFSyncer creates a new InspectorOCSFSyncer
 // It initializes the Inspector client and datastore.
 func NewInspectorOCSFSyncer(ctx context.Context, inspectorClient *inspector2.Client, storageOpts datastore.StorageOpts) (DataSync, error) {
-	dataStoreInst, err := datastore.SetupStorage[ocsf.VulnerabilityFinding](ctx, storageOpts)
+	dataStoreInst, err := SetupVulnerabilityFindingStorage(ctx, storageOpts)
 	if err != nil {
 		return nil, fmt.Errorf("failed to setup datastore: %w", err)
 	}
 
+	lifecycleStore, err := lifecycle.NewStore(ctx, storageOpts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to setup lifecycle store: %w", err)
+	}
+
 	return &InspectorOCSFSyncer{
 		inspectorClient: inspectorClient,
 		datastore:       dataStoreInst,
+		tracker:         lifecycle.NewTracker[ocsf.VulnerabilityFinding]("inspector", lifecycleStore),
 	}, nil
 }
 
@@ -39,6 +54,9 @@ func NewInspectorOCSFSyncer(ctx context.Context, inspectorClient *inspector2.Cli
 func (s *InspectorOCSFSyncer) Sync(ctx context.Context) error {
 	slog.Info("syncing Inspector data")
 
+	// Collect every page first, so that the lifecycle tracker sees the whole snapshot.
+	var findingsToSave []ocsf.VulnerabilityFinding
+	var partial bool
 	var nextToken *string
 	for {
 		inspectorFindingsOutput, err := s.inspectorClient.ListFindings(
@@ -58,22 +76,17 @@ func (s *InspectorOCSFSyncer) Sync(ctx context.Context) error {
 
 		slog.Info("Inspector findings", "num_findings", len(inspectorFindingsOutput.Findings))
 
-		var findingsToSave []ocsf.VulnerabilityFinding
-
 		for _, inspectorFinding := range inspectorFindingsOutput.Findings {
 			finding, err := s.ToOCSF(ctx, inspectorFinding)
 			if err != nil {
-				return oops.Wrapf(err, "failed to build OCSF finding")
+				slog.Warn("failed to build OCSF finding", "error", err)
+				partial = true
+				continue
 			}
 
 			findingsToSave = append(findingsToSave, finding)
 		}
 
-		err = s.datastore.Save(ctx, findingsToSave)
-		if err != nil {
-			return oops.Wrapf(err, "failed to save findings")
-		}
-
 		if inspectorFindingsOutput.NextToken == nil {
 			break
 		}
@@ -81,6 +94,24 @@ func (s *InspectorOCSFSyncer) Sync(ctx context.Context) error {
 		nextToken = inspectorFindingsOutput.NextToken
 	}
 
+	track := s.tracker.Track
+	if partial {
+		track = s.tracker.TrackPartial
+	}
+	findingsToSave, state, err := track(ctx, time.Now(), findingsToSave)
+	if err != nil {
+		return oops.Wrapf(err, "failed to track finding lifecycle")
+	}
+
+	err = s.datastore.Save(ctx, findingsToSave)
+	if err != nil {
+		return oops.Wrapf(err, "failed to save findings")
+	}
+
+	if err := s.tracker.Commit(ctx, state); err != nil {
+		return oops.Wrapf(err, "failed to commit finding lifecycle")
+	}
+
 	slog.Info("Finished Inspector sync")
 	return nil
 }
@@ -92,7 +123,7 @@ func (s *InspectorOCSFSyncer) ToOCSF(ctx context.Context, inspectorFinding types
 	createdAt := inspectorFinding.FirstObservedAt
 	var endTime *time.Time
 
-	if status == string(types.FindingStatusClosed) {
+	if statusID == findingStatusResolved {
 		endTime = inspectorFinding.UpdatedAt
 	}
 
@@ -165,33 +196,17 @@ func (s *InspectorOCSFSyncer) ToOCSF(ctx context.Context, inspectorFinding types
 		},
 	}
 
-	var activityID int32
-	var activityName string
-	var typeUID int64
-	var typeName string
+	var activity ocsf.VulnerabilityFindingActivityId
 	var eventTime time.Time
-	className := "Vulnerability Finding"
-	categoryUID := int32(2)
-	categoryName := "Findings"
-	classUID := int32(2002)
 
 	if inspectorFinding.UpdatedAt == nil || inspectorFinding.UpdatedAt == inspectorFinding.FirstObservedAt {
-		activityID = int32(1)
-		activityName = "Create"
-		typeUID = int64(classUID)*100 + int64(activityID)
-		typeName = "Vulnerability Finding: Create"
+		activity = ocsf.VulnerabilityFindingActivityIdCreate
 		eventTime = *createdAt
-	} else if status == "Closed" {
-		activityID = int32(3)
-		activityName = "Close"
-		typeUID = int64(classUID)*100 + int64(activityID)
-		typeName = "Vulnerability Finding: Close"
+	} else if statusID == findingStatusResolved {
+		activity = ocsf.VulnerabilityFindingActivityIdClose
 		eventTime = *endTime
 	} else {
-		activityID = int32(2)
-		activityName = "Update"
-		typeUID = int64(classUID)*100 + int64(activityID)
-		typeName = "Vulnerability Finding: Update"
+		activity = ocsf.VulnerabilityFindingActivityIdUpdate
 		if inspectorFinding.UpdatedAt != nil {
 			eventTime = *inspectorFinding.UpdatedAt
 		} else {
@@ -201,14 +216,6 @@ func (s *InspectorOCSFSyncer) ToOCSF(ctx context.Context, inspectorFinding types
 
 	productName := "Inspector"
 
-	metadata := ocsf.Metadata{
-		Product: ocsf.Product{
-			Name:       &productName,
-			VendorName: &vendorName,
-		},
-		Version: "1.4.0",
-	}
-
 	firstSeenTimeInt := firstSeenTime.UnixMilli()
 	modifiedTimeInt := modifiedTime.UnixMilli()
 	endTimeInt := endTime.UnixMilli()
@@ -226,28 +233,28 @@ func (s *InspectorOCSFSyncer) ToOCSF(ctx context.Context, inspectorFinding types
 		Types:         []string{"Vulnerability"},
 	}
 
-	finding := ocsf.VulnerabilityFinding{
-		Time:            eventTimeInt,
-		StartTime:       firstSeenTimeInt,
-		EndTime:         endTimeInt,
-		ActivityId:      activityID,
-		ActivityName:    &activityName,
-		CategoryUid:     categoryUID,
-		CategoryName:    &categoryName,
-		ClassUid:        classUID,
-		ClassName:       &className,
-		Message:         inspectorFinding.Description,
-		Metadata:        metadata,
-		Resources:       mapInspectorResources(inspectorFinding),
-		Status:          &status,
-		StatusId:        &statusID,
-		TypeUid:         typeUID,
-		TypeName:        &typeName,
-		Vulnerabilities: vulnerabilities,
-		FindingInfo:     findingInfo,
-		SeverityId:      int32(severityID),
-		Severity:        &severity,
+	finding := ocsf.NewVulnerabilityFinding(activity)
+	finding.Time = eventTimeInt
+	finding.StartTime = firstSeenTimeInt
+	finding.EndTime = endTimeInt
+	finding.Message = inspectorFinding.Description
+	finding.Metadata.Product = ocsf.Product{
+		Name:       &productName,
+		VendorName: &vendorName,
+	}
+	finding.Resources = mapInspectorResources(inspectorFinding)
+	finding.Status = &status
+	finding.StatusId = &statusID
+	finding.Vulnerabilities = vulnerabilities
+	finding.FindingInfo = findingInfo
+	finding.SeverityId = int32(severityID)
+	finding.Severity = &severity
+
+	uid, err := eventuid.UID(&finding, *inspectorFinding.FindingArn)
+	if err != nil {
+		return ocsf.VulnerabilityFinding{}, fmt.Errorf("failed to compute event UID: %w", err)
 	}
+	finding.Metadata.Uid = &uid
 
 	return finding, nil
 }
@@ -285,7 +292,7 @@ func mapInspectorStatus(status types.FindingStatus) (string, int32) {
 	case types.FindingStatusSuppressed:
 		return "suppressed", 3
 	case types.FindingStatusClosed:
-		return "closed", 4
+		return "Resolved", 4
 	default:
 		return "unknown", 0
 	}
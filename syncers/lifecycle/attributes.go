@@ -0,0 +1,112 @@
+package lifecycle
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
+)
+
+// attribute is the value of an attribute path of a finding.
+type attribute struct {
+	path  string
+	value any
+}
+
+// getInt returns the integer attribute at path of the generated struct finding points to, or 0
+// when it is not set. Attributes the class does not have are not set.
+func getInt(finding any, path string) int64 {
+	value := indirect(field(reflect.ValueOf(finding), path, false))
+	switch value.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return value.Int()
+	}
+	return 0
+}
+
+// getString returns the string attribute at path of the generated struct finding points to, or
+// "" when it is not set.
+func getString(finding any, path string) string {
+	value := indirect(field(reflect.ValueOf(finding), path, false))
+	if value.Kind() == reflect.String {
+		return value.String()
+	}
+	return ""
+}
+
+// set sets the attribute at path of the generated struct finding points to, allocating the
+// objects on the way. value is converted to the attribute's type, e.g. from int32 to *int64.
+func set(finding any, path string, value any) error {
+	dst := field(reflect.ValueOf(finding), path, true)
+	if !dst.IsValid() {
+		return ocsf.UnknownPath(path)
+	}
+	if dst.Kind() == reflect.Pointer {
+		dst.Set(reflect.New(dst.Type().Elem()))
+		dst = dst.Elem()
+	}
+
+	src := reflect.ValueOf(value)
+	if (src.Kind() == reflect.String) != (dst.Kind() == reflect.String) || !src.CanConvert(dst.Type()) {
+		return fmt.Errorf("cannot set %s of type %s to %T", path, dst.Type(), value)
+	}
+	dst.Set(src.Convert(dst.Type()))
+	return nil
+}
+
+// field returns the field at an attribute path of the struct value points to, or the zero Value
+// when there is none. Nil objects on the way are allocated when alloc is set.
+func field(value reflect.Value, path string, alloc bool) reflect.Value {
+	for _, name := range strings.Split(path, ".") {
+		for value.Kind() == reflect.Pointer {
+			if value.IsNil() {
+				if !alloc {
+					return reflect.Value{}
+				}
+				value.Set(reflect.New(value.Type().Elem()))
+			}
+			value = value.Elem()
+		}
+		if value.Kind() != reflect.Struct {
+			return reflect.Value{}
+		}
+		i, ok := jsonFields(value.Type())[name]
+		if !ok {
+			return reflect.Value{}
+		}
+		value = value.Field(i)
+	}
+	return value
+}
+
+// indirect follows pointers, returning the zero Value for nil.
+func indirect(value reflect.Value) reflect.Value {
+	for value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			return reflect.Value{}
+		}
+		value = value.Elem()
+	}
+	return value
+}
+
+// fieldsByType caches the field indexes of struct types by JSON name.
+var fieldsByType sync.Map
+
+func jsonFields(t reflect.Type) map[string]int {
+	if fields, ok := fieldsByType.Load(t); ok {
+		return fields.(map[string]int)
+	}
+
+	fields := make(map[string]int)
+	for i := range t.NumField() {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+		if name != "" && name != "-" {
+			fields[name] = i
+		}
+	}
+	fieldsByType.Store(t, fields)
+	return fields
+}
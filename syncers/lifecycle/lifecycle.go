@@ -0,0 +1,385 @@
+// Package lifecycle tracks findings across syncs. Sources report a snapshot of their current
+// findings, and the tracker compares it with the findings it saw before to emit the Create,
+// Update, Close and Reopen activities, including for findings which disappeared from the source.
+package lifecycle
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
+	"github.com/Santiago-Labs/go-ocsf/ocsf/eventuid"
+	"github.com/samsarahq/go/oops"
+)
+
+// Finding activities. OCSF has no Reopen activity, so reopened findings are reported as Other
+// with the activity name Reopen.
+const (
+	ActivityCreate int32 = 1
+	ActivityUpdate int32 = 2
+	ActivityClose  int32 = 3
+	ActivityReopen int32 = 99
+)
+
+var activityNames = map[int32]string{
+	ActivityCreate: "Create",
+	ActivityUpdate: "Update",
+	ActivityClose:  "Close",
+	ActivityReopen: "Reopen",
+}
+
+// statusResolved is the status_id of findings which are no longer open, and statusResolvedCaption
+// its caption.
+const (
+	statusResolved        int32 = 4
+	statusResolvedCaption       = "Resolved"
+)
+
+// DefaultRetention is how long trackers remember closed findings by default.
+const DefaultRetention = 90 * 24 * time.Hour
+
+// ignoredChanges are the attributes which change without the finding changing, either on every
+// sync or because the tracker sets them.
+var ignoredChanges = append([]string{
+	"activity_id",
+	"activity_name",
+	"type_uid",
+	"type_name",
+	"start_time",
+	"end_time",
+	"finding_info.first_seen_time",
+	"vulnerabilities[].first_seen_time",
+	"vulnerabilities[].last_seen_time",
+}, ocsf.VolatilePaths...)
+
+// templatePaths are the attributes every finding of a source shares, which findings that
+// disappeared from the source are closed with when their state has no finding.
+var templatePaths = []string{
+	"class_uid",
+	"class_name",
+	"category_uid",
+	"category_name",
+	"metadata.product",
+	"metadata.version",
+	"metadata.profiles",
+	"metadata.extensions",
+}
+
+// State is the state of the findings of one source, by finding_info.uid.
+type State struct {
+	Findings map[string]*FindingState `json:"findings"`
+	// Template holds the templatePaths of the last finding the source reported, for the findings
+	// of states saved before FindingState kept the finding.
+	Template json.RawMessage `json:"template,omitempty"`
+}
+
+func newState() *State {
+	return &State{Findings: make(map[string]*FindingState)}
+}
+
+// FindingState is the state of one finding as of its last activity.
+type FindingState struct {
+	// Hash is the hash of the finding's attributes, leaving out the ignored changes.
+	Hash         string `json:"hash"`
+	StartTime    int64  `json:"start_time"`
+	EndTime      int64  `json:"end_time,omitempty"`
+	ModifiedTime int64  `json:"modified_time"`
+	Closed       bool   `json:"closed"`
+	// Finding is the last activity of an open finding, which it is closed with when it disappears
+	// from the source. It is dropped once the finding is closed.
+	Finding json.RawMessage `json:"finding,omitempty"`
+}
+
+// Tracker emits the activities of the findings of one source, which are of the generated finding
+// class T of any OCSF version, e.g. v1_5_0.VulnerabilityFinding.
+type Tracker[T any, PT interface {
+	*T
+	ocsf.Finding
+}] struct {
+	source string
+	store  Store
+	// Retention is how long closed findings are remembered once the source no longer reports
+	// them. A finding which is reported again after it was forgotten is created again.
+	Retention time.Duration
+}
+
+// NewTracker returns a tracker of the findings of source, e.g. "snyk", persisting their state in
+// store.
+func NewTracker[T any, PT interface {
+	*T
+	ocsf.Finding
+}](source string, store Store) *Tracker[T, PT] {
+	return &Tracker[T, PT]{source: source, store: store, Retention: DefaultRetention}
+}
+
+// Track compares snapshot, every finding the source currently reports, with the findings seen by
+// earlier syncs, and returns the findings which changed with their activity set:
+//
+//   - Create for new findings,
+//   - Update for open findings which changed,
+//   - Close for findings which were resolved or are no longer reported, and
+//   - Reopen for closed findings which are open again.
+//
+// start_time is kept from the first sync which saw a finding, end_time is set when it closes,
+// and finding_info.modified_time is set to now, unless the source reports a later time, whenever
+// it changes. Unchanged findings are left out. The new state is returned rather than saved, so
+// that it is only committed once the findings are.
+func (t *Tracker[T, PT]) Track(ctx context.Context, now time.Time, snapshot []T) ([]T, *State, error) {
+	return t.track(ctx, now, snapshot, true)
+}
+
+// TrackPartial is Track for snapshots which may be missing findings the source still reports,
+// e.g. because they were filtered out or could not be read. It does not close missing findings.
+func (t *Tracker[T, PT]) TrackPartial(ctx context.Context, now time.Time, snapshot []T) ([]T, *State, error) {
+	return t.track(ctx, now, snapshot, false)
+}
+
+// Commit saves the state returned by Track, once the findings it returned are stored.
+func (t *Tracker[T, PT]) Commit(ctx context.Context, state *State) error {
+	return t.store.Save(ctx, t.source, state)
+}
+
+func (t *Tracker[T, PT]) track(ctx context.Context, now time.Time, snapshot []T, closeMissing bool) ([]T, *State, error) {
+	state, err := t.store.Load(ctx, t.source)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	nowMilli := now.UnixMilli()
+	var changed []T
+	seen := make(map[string]bool)
+	for _, finding := range snapshot {
+		f := PT(&finding)
+		uid := f.GetFindingUid()
+		seen[uid] = true
+		statusID := getInt(f, "status_id")
+		closed := statusID == int64(statusResolved)
+
+		hash, err := hashFinding(finding)
+		if err != nil {
+			return nil, nil, oops.Wrapf(err, "failed to hash finding %s", uid)
+		}
+
+		previous, ok := state.Findings[uid]
+		var activity int32
+		switch {
+		case !ok && closed:
+			activity = ActivityClose
+		case !ok:
+			activity = ActivityCreate
+		case previous.Closed && !closed:
+			activity = ActivityReopen
+		case !previous.Closed && closed:
+			activity = ActivityClose
+		case previous.Hash == hash:
+			continue
+		default:
+			activity = ActivityUpdate
+		}
+
+		next := &FindingState{Hash: hash, Closed: closed}
+		if ok {
+			next.StartTime = previous.StartTime
+		} else {
+			next.StartTime = firstTime(getInt(f, "start_time"), getInt(f, "finding_info.created_time"), getInt(f, "finding_info.first_seen_time"), nowMilli)
+		}
+		modifiedTime := getInt(f, "finding_info.modified_time")
+		if activity == ActivityCreate {
+			next.ModifiedTime = firstTime(modifiedTime, next.StartTime)
+		} else {
+			next.ModifiedTime = max(modifiedTime, nowMilli)
+			if ok {
+				next.ModifiedTime = max(next.ModifiedTime, previous.ModifiedTime)
+			}
+		}
+		if closed {
+			next.EndTime = firstTime(getInt(f, "end_time"), nowMilli)
+		}
+
+		if err := setActivity(f, activity, next, nowMilli); err != nil {
+			return nil, nil, oops.Wrapf(err, "failed to set the activity of finding %s", uid)
+		}
+		if !closed {
+			if next.Finding, err = json.Marshal(finding); err != nil {
+				return nil, nil, oops.Wrapf(err, "failed to marshal finding %s", uid)
+			}
+		}
+		state.Findings[uid] = next
+		changed = append(changed, finding)
+	}
+
+	if len(snapshot) > 0 {
+		state.Template, err = template(snapshot[len(snapshot)-1])
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	// Close the open findings the source no longer reports.
+	for uid, previous := range state.Findings {
+		if !closeMissing || seen[uid] || previous.Closed {
+			continue
+		}
+
+		finding, err := t.closed(previous.Finding, state.Template, uid)
+		if err != nil {
+			return nil, nil, oops.Wrapf(err, "failed to close finding %s", uid)
+		}
+		next := &FindingState{
+			Hash:         previous.Hash,
+			StartTime:    previous.StartTime,
+			EndTime:      nowMilli,
+			ModifiedTime: nowMilli,
+			Closed:       true,
+		}
+		if err := setActivity(PT(&finding), ActivityClose, next, nowMilli); err != nil {
+			return nil, nil, oops.Wrapf(err, "failed to set the activity of finding %s", uid)
+		}
+		state.Findings[uid] = next
+		changed = append(changed, finding)
+	}
+
+	// Forget the findings which have been closed for longer than the retention. Findings the source
+	// still reports are kept, however long ago they closed, so that they are not closed again by
+	// the next sync.
+	for uid, finding := range state.Findings {
+		if seen[uid] {
+			continue
+		}
+		if finding.Closed && t.Retention > 0 && now.Sub(time.UnixMilli(finding.EndTime)) > t.Retention {
+			delete(state.Findings, uid)
+		}
+	}
+
+	return changed, state, nil
+}
+
+// closed returns the resolved finding uid, built from its last activity, or from the template of
+// its source when the state has no finding.
+func (t *Tracker[T, PT]) closed(last, tmpl json.RawMessage, uid string) (T, error) {
+	var finding T
+	switch {
+	case len(last) > 0:
+		if err := json.Unmarshal(last, &finding); err != nil {
+			return finding, oops.Wrapf(err, "failed to decode the last activity")
+		}
+	case len(tmpl) > 0:
+		if err := json.Unmarshal(tmpl, &finding); err != nil {
+			return finding, oops.Wrapf(err, "failed to decode finding template")
+		}
+	}
+
+	f := PT(&finding)
+	if err := set(f, "finding_info.uid", uid); err != nil {
+		return finding, err
+	}
+	if err := set(f, "status_id", statusResolved); err != nil {
+		return finding, err
+	}
+	if err := set(f, "status", statusResolvedCaption); err != nil {
+		return finding, err
+	}
+
+	eventUID, err := eventuid.UID(f, "")
+	if err != nil {
+		return finding, err
+	}
+	return finding, set(f, "metadata.uid", eventUID)
+}
+
+// setActivity sets the activity and the lifecycle times of a finding.
+func setActivity(finding ocsf.Finding, activity int32, state *FindingState, now int64) error {
+	className := getString(finding, "class_name")
+	if className == "" {
+		className = finding.OCSFClass().Name
+	}
+	activityName := activityNames[activity]
+	attributes := []attribute{
+		{"activity_id", activity},
+		{"activity_name", activityName},
+		{"type_uid", int64(finding.GetClassUid())*100 + int64(activity)},
+		{"type_name", fmt.Sprintf("%s: %s", className, activityName)},
+		{"start_time", state.StartTime},
+		{"end_time", state.EndTime},
+		{"finding_info.modified_time", state.ModifiedTime},
+	}
+	if activity != ActivityCreate || finding.GetTime() == 0 {
+		attributes = append(attributes, attribute{"time", now})
+	}
+
+	for _, a := range attributes {
+		if err := set(finding, a.path, a.value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// hashFinding hashes the attributes of finding which are set, leaving out the ignored changes.
+func hashFinding[T any](finding T) (string, error) {
+	var zero T
+	attributes, err := ocsf.Diff(zero, finding, ignoredChanges...)
+	if err != nil {
+		return "", err
+	}
+	data, err := json.Marshal(attributes)
+	if err != nil {
+		return "", oops.Wrapf(err, "failed to marshal finding attributes")
+	}
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:16]), nil
+}
+
+// template returns the templatePaths of finding.
+func template(finding any) (json.RawMessage, error) {
+	data, err := json.Marshal(finding)
+	if err != nil {
+		return nil, oops.Wrapf(err, "failed to marshal finding")
+	}
+	var attributes map[string]json.RawMessage
+	if err := json.Unmarshal(data, &attributes); err != nil {
+		return nil, oops.Wrapf(err, "failed to decode finding")
+	}
+
+	tmpl := make(map[string]any)
+	for _, path := range templatePaths {
+		name, child, nested := strings.Cut(path, ".")
+		value, ok := attributes[name]
+		if !ok {
+			continue
+		}
+		if !nested {
+			tmpl[name] = value
+			continue
+		}
+
+		var object map[string]json.RawMessage
+		if err := json.Unmarshal(value, &object); err != nil {
+			return nil, oops.Wrapf(err, "failed to decode %s", name)
+		}
+		if childValue, ok := object[child]; ok {
+			parent, _ := tmpl[name].(map[string]json.RawMessage)
+			if parent == nil {
+				parent = make(map[string]json.RawMessage)
+				tmpl[name] = parent
+			}
+			parent[child] = childValue
+		}
+	}
+	return json.Marshal(tmpl)
+}
+
+// firstTime returns the first of times which is set.
+func firstTime(times ...int64) int64 {
+	for _, t := range times {
+		if t != 0 {
+			return t
+		}
+	}
+	return 0
+}
@@ -0,0 +1,299 @@
+package lifecycle
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Santiago-Labs/go-ocsf/ocsf/v1_4_0"
+	"github.com/Santiago-Labs/go-ocsf/ocsf/v1_5_0"
+)
+
+var (
+	open, resolved = int32(1), statusResolved
+	vendor         = "Test"
+)
+
+func finding(uid string, statusID *int32, title string) v1_4_0.VulnerabilityFinding {
+	className := "Vulnerability Finding"
+	return v1_4_0.VulnerabilityFinding{
+		ClassUid:    2002,
+		ClassName:   &className,
+		CategoryUid: 2,
+		StatusId:    statusID,
+		FindingInfo: v1_4_0.FindingInformation{Uid: uid, Title: &title},
+		Metadata: v1_4_0.Metadata{
+			Product: v1_4_0.Product{VendorName: &vendor},
+			Version: "1.4.0",
+		},
+	}
+}
+
+// activities returns the activity of each finding by uid.
+func activities[T any](findings []T, activity func(T) (string, int32)) map[string]int32 {
+	got := make(map[string]int32)
+	for _, f := range findings {
+		uid, id := activity(f)
+		got[uid] = id
+	}
+	return got
+}
+
+func activityOf(f v1_4_0.VulnerabilityFinding) (string, int32) {
+	return f.FindingInfo.Uid, f.ActivityId
+}
+
+func checkActivities(t *testing.T, got, want map[string]int32) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("got activities %v, want %v", got, want)
+	}
+	for uid, activity := range want {
+		if got[uid] != activity {
+			t.Errorf("got activities %v, want %v", got, want)
+		}
+	}
+}
+
+func TestTrack(t *testing.T) {
+	ctx := context.Background()
+	tracker := NewTracker[v1_4_0.VulnerabilityFinding]("test", &FileStore{Dir: t.TempDir()})
+
+	var tests = []struct {
+		name       string
+		snapshot   []v1_4_0.VulnerabilityFinding
+		activities map[string]int32
+	}{
+		{name: "Test new findings", snapshot: []v1_4_0.VulnerabilityFinding{finding("a", &open, "A"), finding("b", &open, "B")}, activities: map[string]int32{"a": ActivityCreate, "b": ActivityCreate}},
+		{name: "Test unchanged and changed findings", snapshot: []v1_4_0.VulnerabilityFinding{finding("a", &open, "A"), finding("b", &open, "B2")}, activities: map[string]int32{"b": ActivityUpdate}},
+		{name: "Test resolved and missing findings", snapshot: []v1_4_0.VulnerabilityFinding{finding("a", &resolved, "A")}, activities: map[string]int32{"a": ActivityClose, "b": ActivityClose}},
+		{name: "Test reopened findings", snapshot: []v1_4_0.VulnerabilityFinding{finding("b", &open, "B2")}, activities: map[string]int32{"b": ActivityReopen}},
+	}
+
+	start := time.UnixMilli(1000)
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now := start.Add(time.Duration(i) * time.Hour)
+			changed, state, err := tracker.Track(ctx, now, tt.snapshot)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if err := tracker.Commit(ctx, state); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			for _, f := range changed {
+				if f.StartTime != start.UnixMilli() {
+					t.Errorf("got start_time %d for %s, want %d", f.StartTime, f.FindingInfo.Uid, start.UnixMilli())
+				}
+				if closed := f.ActivityId == ActivityClose; closed != (f.EndTime == now.UnixMilli()) {
+					t.Errorf("got end_time %d for activity %d of %s", f.EndTime, f.ActivityId, f.FindingInfo.Uid)
+				}
+				if f.FindingInfo.ModifiedTime != now.UnixMilli() && f.ActivityId != ActivityCreate {
+					t.Errorf("got modified_time %d for %s, want %d", f.FindingInfo.ModifiedTime, f.FindingInfo.Uid, now.UnixMilli())
+				}
+				if want := int64(2002*100) + int64(f.ActivityId); f.TypeUid != want {
+					t.Errorf("got type_uid %d for %s, want %d", f.TypeUid, f.FindingInfo.Uid, want)
+				}
+			}
+			checkActivities(t, activities(changed, activityOf), tt.activities)
+		})
+	}
+}
+
+func TestTrackClose(t *testing.T) {
+	ctx := context.Background()
+	tracker := NewTracker[v1_4_0.VulnerabilityFinding]("test", &FileStore{Dir: t.TempDir()})
+
+	a := finding("a", &open, "A")
+	a.SeverityId = 4
+	a.Vulnerabilities = []v1_4_0.VulnerabilityDetails{{Cve: &v1_4_0.CVE{Uid: "CVE-2024-1234"}}}
+	_, state, err := tracker.Track(ctx, time.UnixMilli(1000), []v1_4_0.VulnerabilityFinding{a})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := tracker.Commit(ctx, state); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	changed, state, err := tracker.Track(ctx, time.UnixMilli(2000), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(changed) != 1 {
+		t.Fatalf("got %d findings, want 1", len(changed))
+	}
+
+	closed := changed[0]
+	if closed.FindingInfo.Uid != "a" || closed.ActivityId != ActivityClose || *closed.ActivityName != "Close" {
+		t.Errorf("got finding %s with activity %d, want a closed", closed.FindingInfo.Uid, closed.ActivityId)
+	}
+	if *closed.StatusId != statusResolved || *closed.Status != "Resolved" {
+		t.Errorf("got status %d %q, want %d \"Resolved\"", *closed.StatusId, *closed.Status, statusResolved)
+	}
+	if closed.TypeUid != 200203 || *closed.TypeName != "Vulnerability Finding: Close" {
+		t.Errorf("got type %d %q, want 200203 \"Vulnerability Finding: Close\"", closed.TypeUid, *closed.TypeName)
+	}
+	if closed.ClassUid != 2002 || closed.CategoryUid != 2 || *closed.Metadata.Product.VendorName != vendor || closed.Metadata.Version != "1.4.0" {
+		t.Errorf("got class %d, category %d and metadata %+v, want them from the last activity", closed.ClassUid, closed.CategoryUid, closed.Metadata)
+	}
+	if *closed.FindingInfo.Title != "A" || closed.SeverityId != 4 || len(closed.Vulnerabilities) != 1 || closed.Vulnerabilities[0].Cve.Uid != "CVE-2024-1234" {
+		t.Errorf("got title %q, severity %d and vulnerabilities %+v, want them from the last activity", *closed.FindingInfo.Title, closed.SeverityId, closed.Vulnerabilities)
+	}
+	if len(state.Findings["a"].Finding) != 0 {
+		t.Errorf("got the finding kept in the state of a closed finding")
+	}
+	if closed.Metadata.Uid == nil || *closed.Metadata.Uid == "" {
+		t.Errorf("got no metadata.uid")
+	}
+	if closed.StartTime != 1000 || closed.EndTime != 2000 || closed.Time != 2000 {
+		t.Errorf("got start_time %d, end_time %d and time %d, want 1000, 2000 and 2000", closed.StartTime, closed.EndTime, closed.Time)
+	}
+}
+
+func TestTrackFailedSave(t *testing.T) {
+	ctx := context.Background()
+	tracker := NewTracker[v1_4_0.VulnerabilityFinding]("test", &FileStore{Dir: t.TempDir()})
+	snapshot := []v1_4_0.VulnerabilityFinding{finding("a", &open, "A")}
+
+	// The findings failed to save, so the state is not committed.
+	changed, _, err := tracker.Track(ctx, time.UnixMilli(1000), snapshot)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	checkActivities(t, activities(changed, activityOf), map[string]int32{"a": ActivityCreate})
+
+	changed, state, err := tracker.Track(ctx, time.UnixMilli(2000), snapshot)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	checkActivities(t, activities(changed, activityOf), map[string]int32{"a": ActivityCreate})
+	if err := tracker.Commit(ctx, state); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	changed, _, err = tracker.Track(ctx, time.UnixMilli(3000), snapshot)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	checkActivities(t, activities(changed, activityOf), map[string]int32{})
+}
+
+func TestTrackPartial(t *testing.T) {
+	ctx := context.Background()
+	tracker := NewTracker[v1_4_0.VulnerabilityFinding]("test", &FileStore{Dir: t.TempDir()})
+
+	_, state, err := tracker.Track(ctx, time.UnixMilli(1000), []v1_4_0.VulnerabilityFinding{finding("a", &open, "A"), finding("b", &open, "B")})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := tracker.Commit(ctx, state); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	changed, state, err := tracker.TrackPartial(ctx, time.UnixMilli(2000), []v1_4_0.VulnerabilityFinding{finding("a", &resolved, "A")})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	checkActivities(t, activities(changed, activityOf), map[string]int32{"a": ActivityClose})
+	if state.Findings["b"].Closed {
+		t.Errorf("got b closed by a partial snapshot")
+	}
+}
+
+func TestTrackRetention(t *testing.T) {
+	ctx := context.Background()
+	tracker := NewTracker[v1_4_0.VulnerabilityFinding]("test", &FileStore{Dir: t.TempDir()})
+	tracker.Retention = time.Hour
+
+	var tests = []struct {
+		name       string
+		now        time.Time
+		snapshot   []v1_4_0.VulnerabilityFinding
+		activities map[string]int32
+		remembered bool
+	}{
+		{name: "Test a new finding", now: time.UnixMilli(0), snapshot: []v1_4_0.VulnerabilityFinding{finding("a", &open, "A")}, activities: map[string]int32{"a": ActivityCreate}, remembered: true},
+		{name: "Test a closed finding", now: time.UnixMilli(0).Add(time.Minute), activities: map[string]int32{"a": ActivityClose}, remembered: true},
+		{name: "Test a finding closed within the retention", now: time.UnixMilli(0).Add(time.Hour), activities: map[string]int32{}, remembered: true},
+		{name: "Test a finding closed for longer than the retention", now: time.UnixMilli(0).Add(2 * time.Hour), activities: map[string]int32{}, remembered: false},
+		{name: "Test a forgotten finding reported again", now: time.UnixMilli(0).Add(3 * time.Hour), snapshot: []v1_4_0.VulnerabilityFinding{finding("a", &open, "A")}, activities: map[string]int32{"a": ActivityCreate}, remembered: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changed, state, err := tracker.Track(ctx, tt.now, tt.snapshot)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if err := tracker.Commit(ctx, state); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			checkActivities(t, activities(changed, activityOf), tt.activities)
+			if _, ok := state.Findings["a"]; ok != tt.remembered {
+				t.Errorf("got a remembered %t, want %t", ok, tt.remembered)
+			}
+		})
+	}
+}
+
+func TestTrackRetentionReported(t *testing.T) {
+	ctx := context.Background()
+	tracker := NewTracker[v1_4_0.VulnerabilityFinding]("test", &FileStore{Dir: t.TempDir()})
+	tracker.Retention = time.Hour
+
+	// The source still reports a finding which was resolved long before the retention.
+	a := finding("a", &resolved, "A")
+	a.EndTime = 1000
+	now := time.UnixMilli(1000).Add(24 * time.Hour)
+	for i, want := range []map[string]int32{{"a": ActivityClose}, {}, {}} {
+		changed, state, err := tracker.Track(ctx, now.Add(time.Duration(i)*time.Hour), []v1_4_0.VulnerabilityFinding{a})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if err := tracker.Commit(ctx, state); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		checkActivities(t, activities(changed, activityOf), want)
+		if _, ok := state.Findings["a"]; !ok {
+			t.Errorf("got a forgotten by sync %d while the source reports it", i)
+		}
+	}
+}
+
+func TestTrackVersions(t *testing.T) {
+	ctx := context.Background()
+	tracker := NewTracker[v1_5_0.VulnerabilityFinding]("test", &FileStore{Dir: t.TempDir()})
+
+	title, className := "A", "Vulnerability Finding"
+	snapshot := []v1_5_0.VulnerabilityFinding{{
+		ClassUid:    2002,
+		ClassName:   &className,
+		StatusId:    &open,
+		FindingInfo: v1_5_0.FindingInformation{Uid: "a", Title: &title},
+		Metadata:    v1_5_0.Metadata{Product: v1_5_0.Product{VendorName: &vendor}, Version: "1.5.0"},
+	}}
+	activityOf := func(f v1_5_0.VulnerabilityFinding) (string, int32) {
+		return f.FindingInfo.Uid, f.ActivityId
+	}
+
+	changed, state, err := tracker.Track(ctx, time.UnixMilli(1000), snapshot)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	checkActivities(t, activities(changed, activityOf), map[string]int32{"a": ActivityCreate})
+	if err := tracker.Commit(ctx, state); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	changed, _, err = tracker.Track(ctx, time.UnixMilli(2000), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	checkActivities(t, activities(changed, activityOf), map[string]int32{"a": ActivityClose})
+	if closed := changed[0]; *closed.Status != "Resolved" || closed.Metadata.Version != "1.5.0" || *closed.TypeName != "Vulnerability Finding: Close" {
+		t.Errorf("got status %q, version %q and type %q", *closed.Status, closed.Metadata.Version, *closed.TypeName)
+	}
+}
@@ -0,0 +1,141 @@
+package lifecycle
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/Santiago-Labs/go-ocsf/datastore"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/samsarahq/go/oops"
+)
+
+// StateDir is the directory, or S3 key prefix, the state of each source is kept in as
+// <source>.json.
+var StateDir = filepath.Join("state", "lifecycle")
+
+// Store persists the finding state of each source between syncs.
+type Store interface {
+	// Load returns the state of source, which is empty before its first sync.
+	Load(ctx context.Context, source string) (*State, error)
+	// Save replaces the state of source.
+	Save(ctx context.Context, source string, state *State) error
+}
+
+// NewStore returns the store matching storageOpts: the S3 bucket the events are stored in, or
+// the local StateDir otherwise, under the state namespace when it is set.
+func NewStore(ctx context.Context, storageOpts datastore.StorageOpts) (Store, error) {
+	dir := filepath.Join(StateDir, storageOpts.StateNamespace)
+	if storageOpts.BucketName == "" {
+		return &FileStore{Dir: dir}, nil
+	}
+
+	cfg, err := config.LoadDefaultConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error loading AWS config: %v", err)
+	}
+	return &S3Store{Client: s3.NewFromConfig(cfg), Bucket: storageOpts.BucketName, Prefix: filepath.ToSlash(dir)}, nil
+}
+
+// FileStore keeps the state of each source in a local file.
+type FileStore struct {
+	Dir string
+}
+
+func (s *FileStore) Load(ctx context.Context, source string) (*State, error) {
+	data, err := os.ReadFile(filepath.Join(s.Dir, source+".json"))
+	if errors.Is(err, os.ErrNotExist) {
+		return newState(), nil
+	}
+	if err != nil {
+		return nil, oops.Wrapf(err, "failed to read lifecycle state")
+	}
+	return decodeState(data)
+}
+
+func (s *FileStore) Save(ctx context.Context, source string, state *State) error {
+	data, err := json.Marshal(state)
+	if err != nil {
+		return oops.Wrapf(err, "failed to marshal lifecycle state")
+	}
+	if err := os.MkdirAll(s.Dir, 0755); err != nil {
+		return oops.Wrapf(err, "failed to create lifecycle state directory")
+	}
+
+	// Write a temporary file first, so that a failed sync never leaves a truncated state behind.
+	filename := filepath.Join(s.Dir, source+".json")
+	if err := os.WriteFile(filename+".tmp", data, 0644); err != nil {
+		return oops.Wrapf(err, "failed to write lifecycle state")
+	}
+	return os.Rename(filename+".tmp", filename)
+}
+
+// S3Store keeps the state of each source in an S3 object.
+type S3Store struct {
+	Client *s3.Client
+	Bucket string
+	Prefix string
+}
+
+func (s *S3Store) key(source string) string {
+	return path.Join(s.Prefix, source+".json")
+}
+
+func (s *S3Store) Load(ctx context.Context, source string) (*State, error) {
+	result, err := s.Client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.Bucket),
+		Key:    aws.String(s.key(source)),
+	})
+	var noSuchKey *types.NoSuchKey
+	if errors.As(err, &noSuchKey) {
+		return newState(), nil
+	}
+	if err != nil {
+		return nil, oops.Wrapf(err, "failed to get lifecycle state from S3")
+	}
+	defer result.Body.Close()
+
+	data, err := io.ReadAll(result.Body)
+	if err != nil {
+		return nil, oops.Wrapf(err, "failed to read lifecycle state")
+	}
+	return decodeState(data)
+}
+
+func (s *S3Store) Save(ctx context.Context, source string, state *State) error {
+	data, err := json.Marshal(state)
+	if err != nil {
+		return oops.Wrapf(err, "failed to marshal lifecycle state")
+	}
+
+	_, err = s.Client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:      aws.String(s.Bucket),
+		Key:         aws.String(s.key(source)),
+		Body:        bytes.NewReader(data),
+		ContentType: aws.String("application/json"),
+	})
+	if err != nil {
+		return oops.Wrapf(err, "failed to put lifecycle state to S3")
+	}
+	return nil
+}
+
+func decodeState(data []byte) (*State, error) {
+	state := newState()
+	if err := json.Unmarshal(data, state); err != nil {
+		return nil, oops.Wrapf(err, "failed to parse lifecycle state")
+	}
+	if state.Findings == nil {
+		state.Findings = make(map[string]*FindingState)
+	}
+	return state, nil
+}
@@ -0,0 +1,348 @@
+// Package pipeline runs registered syncers as described by a YAML or JSON pipeline file, e.g.
+//
+//	ocsf_version: 1.5.0
+//	sinks:
+//	  - name: lake
+//	    format: parquet
+//	    location: s3://my-security-lake
+//	    partitioning: day
+//	sources:
+//	  - name: snyk-prod
+//	    type: snyk
+//	    config:
+//	      api_key: ${SNYK_PROD_API_KEY}
+//	      organization_id: my-org
+//	  - name: cloudtrail
+//	    type: cloudtrail
+//	    since: 30d
+//	    backfill: 7d
+//	    config:
+//	      bucket: my-trail
+//
+// References to environment variables such as ${SNYK_PROD_API_KEY} are replaced by their values,
+// so that credentials are kept out of the file.
+//
+// Sources have no generic filters. The events of a source are only narrowed by the settings of
+// syncers which support it in config, e.g. the severity and state of tenable.
+package pipeline
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/Santiago-Labs/go-ocsf/datastore"
+	"github.com/Santiago-Labs/go-ocsf/syncers"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"gopkg.in/yaml.v3"
+)
+
+// Pipeline is a set of sources synced into sinks.
+type Pipeline struct {
+	// OCSFVersion is the OCSF version events are stored as, 1.4.0 when empty.
+	OCSFVersion string   `yaml:"ocsf_version"`
+	Sinks       []Sink   `yaml:"sinks"`
+	Sources     []Source `yaml:"sources"`
+}
+
+// Sink is where and how the events of sources are stored.
+type Sink struct {
+	Name string `yaml:"name"`
+	// Format is "parquet" or "json".
+	Format string `yaml:"format"`
+	// Location is an S3 bucket as s3://<bucket>, an S3 Tables bucket ARN, or empty for the local
+	// data directory.
+	Location string `yaml:"location"`
+	// Partitioning is datastore.PartitioningNone or datastore.PartitioningDay.
+	Partitioning  string `yaml:"partitioning"`
+	RejectInvalid bool   `yaml:"reject_invalid"`
+}
+
+// Source is a registered syncer and its settings.
+type Source struct {
+	// Name identifies the source, and separates its state and checkpoints from the other sources
+	// of the same type.
+	Name string `yaml:"name"`
+	// Type is the name the syncer is registered as, e.g. "snyk".
+	Type string `yaml:"type"`
+	// Sink is the name of the sink the source is stored in, which may be omitted when there is
+	// only one.
+	Sink string `yaml:"sink"`
+	// Config holds the settings of the syncer's config by their yaml tag. Settings which are not
+	// set default to their environment variable. Filters are settings of the syncers which support
+	// them, as sources have no filters of their own.
+	Config yaml.Node `yaml:"config"`
+	// Since, Until and Backfill are the time window of event syncers, as the --since, --until and
+	// --backfill flags.
+	Since    string `yaml:"since"`
+	Until    string `yaml:"until"`
+	Backfill string `yaml:"backfill"`
+
+	plugin syncers.Plugin
+	config syncers.Config
+	window syncers.TimeWindow
+}
+
+// Load reads and validates the pipeline file at path.
+func Load(path string) (*Pipeline, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open pipeline: %w", err)
+	}
+	defer f.Close()
+
+	p, err := Parse(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return p, nil
+}
+
+// Parse reads and validates a pipeline, replacing the environment variables it references.
+func Parse(r io.Reader) (*Pipeline, error) {
+	var doc yaml.Node
+	if err := yaml.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("failed to parse pipeline: %w", err)
+	}
+	if err := expandEnv(&doc); err != nil {
+		return nil, err
+	}
+
+	var p Pipeline
+	if err := decodeStrict(&doc, &p); err != nil {
+		return nil, fmt.Errorf("failed to parse pipeline: %w", err)
+	}
+	if err := p.validate(time.Now().UTC()); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// validate checks every source and sink, decoding the configs of the sources and parsing their
+// time windows relative to now.
+func (p *Pipeline) validate(now time.Time) error {
+	switch p.OCSFVersion {
+	case "", syncers.OCSFVersion1_4_0, syncers.OCSFVersion1_5_0:
+	default:
+		return fmt.Errorf("unsupported OCSF version %q, use %s or %s", p.OCSFVersion, syncers.OCSFVersion1_4_0, syncers.OCSFVersion1_5_0)
+	}
+
+	if len(p.Sinks) == 0 {
+		return errors.New("no sinks defined")
+	}
+	sinks := map[string]bool{}
+	for i, sink := range p.Sinks {
+		if sink.Name == "" {
+			return fmt.Errorf("sinks[%d]: name must be set", i)
+		}
+		if sinks[sink.Name] {
+			return fmt.Errorf("sink %s is defined twice", sink.Name)
+		}
+		sinks[sink.Name] = true
+
+		if _, err := sink.storageOpts(); err != nil {
+			return fmt.Errorf("sink %s: %w", sink.Name, err)
+		}
+	}
+
+	if len(p.Sources) == 0 {
+		return errors.New("no sources defined")
+	}
+	sources := map[string]bool{}
+	for i := range p.Sources {
+		source := &p.Sources[i]
+		if source.Name == "" {
+			return fmt.Errorf("sources[%d]: name must be set", i)
+		}
+		if sources[source.Name] {
+			return fmt.Errorf("source %s is defined twice", source.Name)
+		}
+		sources[source.Name] = true
+
+		if err := p.validateSource(source, now); err != nil {
+			return fmt.Errorf("source %s: %w", source.Name, err)
+		}
+	}
+	return nil
+}
+
+func (p *Pipeline) validateSource(source *Source, now time.Time) error {
+	plugin, ok := syncers.Lookup(source.Type)
+	if !ok {
+		return fmt.Errorf("unknown syncer type %q, see go-ocsf syncers", source.Type)
+	}
+	source.plugin = plugin
+
+	switch {
+	case source.Sink == "" && len(p.Sinks) > 1:
+		return errors.New("sink must be set when there are several sinks")
+	case source.Sink != "":
+		if _, ok := p.sink(source.Sink); !ok {
+			return fmt.Errorf("unknown sink %q", source.Sink)
+		}
+	}
+
+	cfg := plugin.NewConfig()
+	if err := syncers.LoadEnv(cfg); err != nil {
+		return err
+	}
+	if !source.Config.IsZero() {
+		if err := decodeStrict(&source.Config, cfg); err != nil {
+			return fmt.Errorf("invalid config: %w", err)
+		}
+	}
+	if err := cfg.Validate(); err != nil {
+		return fmt.Errorf("invalid config: %w", err)
+	}
+	source.config = cfg
+
+	window, err := syncers.ParseTimeWindow(source.Since, source.Until, source.Backfill, now)
+	if err != nil {
+		return err
+	}
+	source.window = window
+	return nil
+}
+
+// sink returns the sink named name, or the only sink when name is empty.
+func (p *Pipeline) sink(name string) (Sink, bool) {
+	if name == "" && len(p.Sinks) == 1 {
+		return p.Sinks[0], true
+	}
+	for _, sink := range p.Sinks {
+		if sink.Name == name {
+			return sink, true
+		}
+	}
+	return Sink{}, false
+}
+
+// Run syncs every source once, in order. A source which fails does not stop the others, and the
+// errors of all failed sources are returned.
+func (p *Pipeline) Run(ctx context.Context) error {
+	var errs []error
+	for _, source := range p.Sources {
+		slog.Info("running source", "source", source.Name, "type", source.Type)
+		if err := p.runSource(ctx, source); err != nil {
+			slog.Error("source failed", "source", source.Name, "error", err)
+			errs = append(errs, fmt.Errorf("source %s: %w", source.Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (p *Pipeline) runSource(ctx context.Context, source Source) error {
+	sink, _ := p.sink(source.Sink)
+	storageOpts, err := sink.storageOpts()
+	if err != nil {
+		return err
+	}
+	storageOpts.OCSFVersion = p.OCSFVersion
+	storageOpts.StateNamespace = source.Name
+
+	syncer, err := source.plugin.New(ctx, source.config, syncers.Options{Storage: storageOpts, Window: source.window})
+	if err != nil {
+		return fmt.Errorf("failed to create %s syncer: %w", source.Type, err)
+	}
+	return syncer.Sync(ctx)
+}
+
+// storageOpts returns the storage options of the sink.
+func (s Sink) storageOpts() (datastore.StorageOpts, error) {
+	opts := datastore.StorageOpts{
+		Partitioning:  s.Partitioning,
+		RejectInvalid: s.RejectInvalid,
+	}
+
+	switch s.Format {
+	case "parquet":
+		opts.IsParquet = true
+	case "json":
+		opts.IsJSON = true
+	default:
+		return opts, fmt.Errorf("unsupported format %q, use parquet or json", s.Format)
+	}
+
+	switch {
+	case s.Location == "":
+	case strings.HasPrefix(s.Location, "s3://"):
+		opts.BucketName = strings.TrimSuffix(strings.TrimPrefix(s.Location, "s3://"), "/")
+		if opts.BucketName == "" || strings.Contains(opts.BucketName, "/") {
+			return opts, fmt.Errorf("invalid location %q, use s3://<bucket>", s.Location)
+		}
+	case arn.IsARN(s.Location):
+		bucketArn, err := arn.Parse(s.Location)
+		name, isBucket := strings.CutPrefix(bucketArn.Resource, "bucket/")
+		if err != nil || bucketArn.Service != "s3tables" || bucketArn.Region == "" || !isBucket || name == "" || strings.Contains(name, "/") {
+			return opts, fmt.Errorf("invalid location %q, use an S3 Tables bucket ARN, arn:aws:s3tables:<region>:<account>:bucket/<name>", s.Location)
+		}
+		if !opts.IsParquet {
+			return opts, errors.New("S3 Tables buckets only store parquet")
+		}
+		opts.TableBucketArn = s.Location
+	default:
+		return opts, fmt.Errorf("unsupported location %q, use s3://<bucket>, an S3 Tables bucket ARN, or leave it empty for the local data directory", s.Location)
+	}
+
+	switch s.Partitioning {
+	case "", datastore.PartitioningNone:
+	case datastore.PartitioningDay:
+		if opts.TableBucketArn != "" {
+			return opts, fmt.Errorf("%s partitioning is not supported by S3 Tables buckets", s.Partitioning)
+		}
+	default:
+		return opts, fmt.Errorf("unsupported partitioning %q, use %s or %s", s.Partitioning, datastore.PartitioningNone, datastore.PartitioningDay)
+	}
+
+	return opts, nil
+}
+
+// decodeStrict decodes node into v, rejecting the fields v does not have.
+func decodeStrict(node *yaml.Node, v any) error {
+	data, err := yaml.Marshal(node)
+	if err != nil {
+		return err
+	}
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	return dec.Decode(v)
+}
+
+// envReference matches references to environment variables, e.g. ${SNYK_API_KEY}.
+var envReference = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+
+// expandEnv replaces the environment variables referenced by the scalars of node, failing when
+// one is not set.
+func expandEnv(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		if !envReference.MatchString(node.Value) {
+			return nil
+		}
+		var err error
+		node.Value = envReference.ReplaceAllStringFunc(node.Value, func(ref string) string {
+			name := envReference.FindStringSubmatch(ref)[1]
+			value, ok := os.LookupEnv(name)
+			if !ok && err == nil {
+				err = fmt.Errorf("line %d: environment variable %s is not set", node.Line, name)
+			}
+			return value
+		})
+		// Resolve the type of the value again, so that references can set bool and int settings.
+		node.Tag = ""
+		return err
+	}
+
+	for _, child := range node.Content {
+		if err := expandEnv(child); err != nil {
+			return err
+		}
+	}
+	return nil
+}
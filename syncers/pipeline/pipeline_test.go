@@ -0,0 +1,135 @@
+package pipeline
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Santiago-Labs/go-ocsf/datastore"
+	"github.com/Santiago-Labs/go-ocsf/syncers"
+)
+
+const testPipeline = `
+ocsf_version: 1.5.0
+sinks:
+  - name: local
+    format: json
+  - name: lake
+    format: parquet
+    location: s3://my-lake
+    partitioning: day
+sources:
+  - name: snyk-prod
+    type: snyk
+    sink: lake
+    config:
+      api_key: ${TEST_PIPELINE_SNYK_KEY}
+      organization_id: prod
+  - name: snyk-dev
+    type: snyk
+    sink: local
+    config:
+      organization_id: dev
+  - name: tenable
+    type: tenable
+    sink: lake
+    since: 30d
+    backfill: 7d
+    config:
+      severity: high,critical
+`
+
+func TestParse(t *testing.T) {
+	t.Setenv("TEST_PIPELINE_SNYK_KEY", "prod-key")
+	t.Setenv("SNYK_API_KEY", "env-key")
+	t.Setenv("TENABLE_API_KEY", "access")
+	t.Setenv("TENABLE_SECRET_KEY", "secret")
+
+	p, err := Parse(strings.NewReader(testPipeline))
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+
+	// Settings come from the file, referenced variables, or the syncer's environment variables.
+	prod := p.Sources[0].config.(*syncers.SnykConfig)
+	if prod.APIKey != "prod-key" || prod.OrganizationID != "prod" {
+		t.Errorf("snyk-prod config = %+v", prod)
+	}
+	dev := p.Sources[1].config.(*syncers.SnykConfig)
+	if dev.APIKey != "env-key" || dev.OrganizationID != "dev" {
+		t.Errorf("snyk-dev config = %+v", dev)
+	}
+	if window := p.Sources[2].window; window.Since.IsZero() || window.Backfill == 0 {
+		t.Errorf("tenable window = %+v, want since and backfill set", window)
+	}
+
+	sink, _ := p.sink("lake")
+	opts, err := sink.storageOpts()
+	if err != nil {
+		t.Fatalf("storageOpts: %v", err)
+	}
+	if !opts.IsParquet || opts.BucketName != "my-lake" || opts.Partitioning != datastore.PartitioningDay {
+		t.Errorf("lake storage = %+v", opts)
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	t.Setenv("SNYK_API_KEY", "key")
+	t.Setenv("SNYK_ORGANIZATION_ID", "org")
+
+	tests := map[string]string{
+		"unknown type": `
+sinks: [{name: local, format: json}]
+sources: [{name: a, type: nope}]`,
+		"unknown setting": `
+sinks: [{name: local, format: json}]
+sources: [{name: a, type: snyk, config: {token: x}}]`,
+		"unset variable": `
+sinks: [{name: local, format: json}]
+sources: [{name: a, type: snyk, config: {api_key: "${TEST_PIPELINE_UNSET}"}}]`,
+		"unknown sink": `
+sinks: [{name: local, format: json}]
+sources: [{name: a, type: snyk, sink: lake}]`,
+		"ambiguous sink": `
+sinks: [{name: a, format: json}, {name: b, format: parquet}]
+sources: [{name: a, type: snyk}]`,
+		"duplicate source": `
+sinks: [{name: local, format: json}]
+sources: [{name: a, type: snyk}, {name: a, type: snyk}]`,
+		"table ARN": `
+sinks: [{name: lake, format: parquet, location: "arn:aws:s3tables:us-east-1:123456789012:bucket/lake/table/t"}]
+sources: [{name: a, type: snyk}]`,
+		"S3 bucket ARN": `
+sinks: [{name: lake, format: parquet, location: "arn:aws:s3:::lake"}]
+sources: [{name: a, type: snyk}]`,
+		"partitioned table bucket": `
+sinks: [{name: lake, format: parquet, location: "arn:aws:s3tables:us-east-1:123456789012:bucket/lake", partitioning: day}]
+sources: [{name: a, type: snyk}]`,
+		"unknown partitioning": `
+sinks: [{name: lake, format: parquet, location: s3://my-lake, partitioning: hour}]
+sources: [{name: a, type: snyk}]`,
+		"JSON table bucket": `
+sinks: [{name: lake, format: json, location: "arn:aws:s3tables:us-east-1:123456789012:bucket/lake"}]
+sources: [{name: a, type: snyk}]`,
+		"invalid window": `
+sinks: [{name: local, format: json}]
+sources: [{name: a, type: snyk, since: 1d, until: 2d}]`,
+	}
+	for name, config := range tests {
+		if _, err := Parse(strings.NewReader(config)); err == nil {
+			t.Errorf("%s: Parse succeeded", name)
+		}
+	}
+}
+
+func TestSinkTableBucket(t *testing.T) {
+	const bucketArn = "arn:aws:s3tables:us-east-1:123456789012:bucket/lake"
+	sink := Sink{Name: "lake", Format: "parquet", Location: bucketArn}
+
+	opts, err := sink.storageOpts()
+	if err != nil {
+		t.Fatalf("storageOpts: %v", err)
+	}
+	if !opts.IsParquet || opts.TableBucketArn != bucketArn || opts.BucketName != "" {
+		t.Errorf("lake storage = %+v", opts)
+	}
+}
@@ -0,0 +1,108 @@
+package syncers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Santiago-Labs/go-ocsf/clients/snyk"
+	"github.com/Santiago-Labs/go-ocsf/clients/tenable"
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
+	"github.com/Santiago-Labs/go-ocsf/ocsf/v1_4_0"
+	"github.com/Santiago-Labs/go-ocsf/ocsf/v1_5_0"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/inspector2"
+	"github.com/aws/aws-sdk-go-v2/service/securityhub"
+)
+
+// VulnerabilityFindingClasses returns the classes of the findings syncers store in ocsfVersion,
+// for Plugin.Classes.
+func VulnerabilityFindingClasses(ocsfVersion string) []ocsf.Class {
+	if ocsfVersion == OCSFVersion1_5_0 {
+		return []ocsf.Class{new(v1_5_0.VulnerabilityFinding).OCSFClass()}
+	}
+	return []ocsf.Class{new(v1_4_0.VulnerabilityFinding).OCSFClass()}
+}
+
+// APIActivityClasses returns the classes of the API activities syncers store in ocsfVersion, for
+// Plugin.Classes.
+func APIActivityClasses(ocsfVersion string) []ocsf.Class {
+	if ocsfVersion == OCSFVersion1_5_0 {
+		return []ocsf.Class{new(v1_5_0.APIActivity).OCSFClass()}
+	}
+	return []ocsf.Class{new(v1_4_0.APIActivity).OCSFClass()}
+}
+
+func init() {
+	Register(Plugin{
+		Name:      "snyk",
+		Source:    "Snyk",
+		Classes:   VulnerabilityFindingClasses,
+		NewConfig: func() Config { return &SnykConfig{} },
+		New: func(ctx context.Context, cfg Config, opts Options) (DataSync, error) {
+			c := cfg.(*SnykConfig)
+			snykClient, err := snyk.NewClient(c.APIKey, c.OrganizationID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create Snyk client: %w", err)
+			}
+			return NewSnykOCSFSyncer(ctx, snykClient, opts.Storage)
+		},
+	})
+
+	Register(Plugin{
+		Name:      "tenable",
+		Source:    "Tenable",
+		Classes:   VulnerabilityFindingClasses,
+		NewConfig: func() Config { return &TenableConfig{} },
+		New: func(ctx context.Context, cfg Config, opts Options) (DataSync, error) {
+			c := cfg.(*TenableConfig)
+			tenableClient, err := tenable.NewClient(c.AccessKey, c.SecretKey)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create Tenable client: %w", err)
+			}
+			return newTenableOCSFSyncer(ctx, tenableClient, opts.Storage, c.filters())
+		},
+	})
+
+	Register(Plugin{
+		Name:      "security-hub",
+		Source:    "SecurityHub",
+		Classes:   VulnerabilityFindingClasses,
+		NewConfig: func() Config { return &SecurityHubConfig{} },
+		New: func(ctx context.Context, cfg Config, opts Options) (DataSync, error) {
+			awsConfig, err := config.LoadDefaultConfig(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to load AWS config: %w", err)
+			}
+			return NewSecurityHubOCSFSyncer(ctx, securityhub.NewFromConfig(awsConfig), opts.Storage)
+		},
+	})
+
+	Register(Plugin{
+		Name:      "inspector",
+		Source:    "Inspector",
+		Classes:   VulnerabilityFindingClasses,
+		NewConfig: func() Config { return &InspectorConfig{} },
+		New: func(ctx context.Context, cfg Config, opts Options) (DataSync, error) {
+			awsConfig, err := config.LoadDefaultConfig(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to load AWS config: %w", err)
+			}
+			return NewInspectorOCSFSyncer(ctx, inspector2.NewFromConfig(awsConfig), opts.Storage)
+		},
+	})
+
+	Register(Plugin{
+		Name:      "salesforce",
+		Source:    "Salesforce event log",
+		Classes:   APIActivityClasses,
+		NewConfig: func() Config { return &SalesforceConfig{Operation: "Login"} },
+		New: func(ctx context.Context, cfg Config, opts Options) (DataSync, error) {
+			c := cfg.(*SalesforceConfig)
+			salesforceSyncer, err := NewSalesforceSyncer(ctx, c.Username, c.Password, c.SecurityToken, opts.Storage, opts.Window)
+			if err != nil {
+				return nil, err
+			}
+			return salesforceSyncer.WithOperation(c.Operation), nil
+		},
+	})
+}
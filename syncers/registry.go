@@ -0,0 +1,179 @@
+package syncers
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/Santiago-Labs/go-ocsf/datastore"
+	"github.com/Santiago-Labs/go-ocsf/ocsf"
+)
+
+// Config is the configuration of a syncer. Configs are pointers to structs whose string, bool and
+// int fields are bound to environment variables by their env tag and to flags by their flag tag,
+// described by their usage tag, and named in pipeline files by their yaml tag, e.g.
+//
+//	APIKey string `yaml:"api_key" env:"SNYK_API_KEY" usage:"Snyk API key"`
+type Config interface {
+	// Validate reports missing or invalid settings.
+	Validate() error
+}
+
+// Options are the settings shared by every syncer.
+type Options struct {
+	Storage datastore.StorageOpts
+	// Window is the time window of event syncers, which finding syncers ignore.
+	Window TimeWindow
+}
+
+// Plugin is a syncer which can be run by name.
+type Plugin struct {
+	// Name identifies the syncer, e.g. "snyk", and prefixes its flags.
+	Name string
+	// Source names the source the syncer reads in help text, e.g. "Snyk".
+	Source string
+	// Classes returns the OCSF classes the syncer stores in an OCSF version, e.g. "1.5.0".
+	Classes func(ocsfVersion string) []ocsf.Class
+	// NewConfig returns a config with its defaults set.
+	NewConfig func() Config
+	// New returns the syncer of a validated config created by NewConfig.
+	New func(ctx context.Context, cfg Config, opts Options) (DataSync, error)
+}
+
+var (
+	pluginsMu sync.Mutex
+	plugins   = map[string]Plugin{}
+)
+
+// Register makes a syncer available by its name. It is called from the init function of the
+// syncer's package, and panics when the name is already registered.
+func Register(p Plugin) {
+	pluginsMu.Lock()
+	defer pluginsMu.Unlock()
+
+	if _, ok := plugins[p.Name]; ok {
+		panic(fmt.Sprintf("syncer %s registered twice", p.Name))
+	}
+	plugins[p.Name] = p
+}
+
+// Lookup returns the syncer registered as name.
+func Lookup(name string) (Plugin, bool) {
+	pluginsMu.Lock()
+	defer pluginsMu.Unlock()
+
+	p, ok := plugins[name]
+	return p, ok
+}
+
+// Plugins returns every registered syncer by name.
+func Plugins() []Plugin {
+	pluginsMu.Lock()
+	defer pluginsMu.Unlock()
+
+	out := make([]Plugin, 0, len(plugins))
+	for _, p := range plugins {
+		out = append(out, p)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
+
+// Setting is a field of a config bound to an environment variable or a flag.
+type Setting struct {
+	Field string
+	// Key names the setting in pipeline files.
+	Key   string
+	Env   string
+	Flag  string
+	Usage string
+
+	value reflect.Value
+}
+
+// Settings returns the fields of cfg which have an env or flag tag.
+func Settings(cfg Config) []Setting {
+	v := reflect.ValueOf(cfg).Elem()
+	var settings []Setting
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		env, flagName := field.Tag.Get("env"), field.Tag.Get("flag")
+		if env == "" && flagName == "" {
+			continue
+		}
+		settings = append(settings, Setting{
+			Field: field.Name,
+			Key:   field.Tag.Get("yaml"),
+			Env:   env,
+			Flag:  flagName,
+			Usage: field.Tag.Get("usage"),
+			value: v.Field(i),
+		})
+	}
+	return settings
+}
+
+// LoadEnv sets the fields of cfg whose environment variable is set.
+func LoadEnv(cfg Config) error {
+	for _, s := range Settings(cfg) {
+		if s.Env == "" {
+			continue
+		}
+		value, ok := os.LookupEnv(s.Env)
+		if !ok {
+			continue
+		}
+		if err := s.set(value); err != nil {
+			return fmt.Errorf("%s: %w", s.Env, err)
+		}
+	}
+	return nil
+}
+
+// BindFlags defines a flag named prefix-<flag tag> on fs for the fields of cfg with a flag tag,
+// which defaults to the field's current value.
+func BindFlags(fs *flag.FlagSet, prefix string, cfg Config) {
+	for _, s := range Settings(cfg) {
+		if s.Flag == "" {
+			continue
+		}
+		name := prefix + "-" + s.Flag
+		switch ptr := s.value.Addr().Interface().(type) {
+		case *string:
+			fs.StringVar(ptr, name, *ptr, s.Usage)
+		case *bool:
+			fs.BoolVar(ptr, name, *ptr, s.Usage)
+		case *int:
+			fs.IntVar(ptr, name, *ptr, s.Usage)
+		default:
+			panic(fmt.Sprintf("unsupported type %s of config field %s", s.value.Type(), s.Field))
+		}
+	}
+}
+
+func (s Setting) set(value string) error {
+	switch s.value.Kind() {
+	case reflect.String:
+		s.value.SetString(value)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		s.value.SetBool(b)
+	case reflect.Int:
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return err
+		}
+		s.value.SetInt(int64(n))
+	default:
+		return fmt.Errorf("unsupported type %s of config field %s", s.value.Type(), s.Field)
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package syncers
+
+import (
+	"flag"
+	"testing"
+)
+
+type testConfig struct {
+	Token   string `env:"TEST_SYNCER_TOKEN" usage:"token"`
+	Region  string `env:"TEST_SYNCER_REGION" flag:"region" usage:"region"`
+	Workers int    `flag:"workers" usage:"workers"`
+	Ignored string
+}
+
+func (c *testConfig) Validate() error { return nil }
+
+func TestBindings(t *testing.T) {
+	t.Setenv("TEST_SYNCER_TOKEN", "secret")
+	t.Setenv("TEST_SYNCER_REGION", "us-east-1")
+
+	cfg := &testConfig{Workers: 4, Ignored: "default"}
+	if err := LoadEnv(cfg); err != nil {
+		t.Fatalf("LoadEnv: %v", err)
+	}
+
+	// Flags default to the environment, and override it when set.
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	BindFlags(fs, "test", cfg)
+	if err := fs.Parse([]string{"--test-workers=8"}); err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+
+	want := testConfig{Token: "secret", Region: "us-east-1", Workers: 8, Ignored: "default"}
+	if *cfg != want {
+		t.Errorf("config = %+v, want %+v", *cfg, want)
+	}
+
+	if err := fs.Parse([]string{"--test-region=eu-west-1"}); err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if cfg.Region != "eu-west-1" {
+		t.Errorf("Region = %q, want the flag's value", cfg.Region)
+	}
+
+	if len(Settings(cfg)) != 3 {
+		t.Errorf("Settings = %+v, want the 3 tagged fields", Settings(cfg))
+	}
+}
+
+func TestPlugins(t *testing.T) {
+	for _, name := range []string{"inspector", "salesforce", "security-hub", "snyk", "tenable"} {
+		p, ok := Lookup(name)
+		if !ok {
+			t.Errorf("syncer %s is not registered", name)
+			continue
+		}
+		for _, version := range []string{OCSFVersion1_4_0, OCSFVersion1_5_0} {
+			classes := p.Classes(version)
+			if len(classes) == 0 {
+				t.Errorf("syncer %s has no classes in %s", name, version)
+				continue
+			}
+			if classes[0].Version != version {
+				t.Errorf("syncer %s stores %s in %s, want %s", name, classes[0].Name, classes[0].Version, version)
+			}
+		}
+	}
+
+	// Required settings are validated before a syncer is created.
+	p, _ := Lookup("snyk")
+	if err := p.NewConfig().Validate(); err == nil {
+		t.Error("empty Snyk config is valid")
+	}
+}
@@ -12,7 +12,9 @@ import (
 
 	"github.com/Santiago-Labs/go-ocsf/clients/salesforce"
 	"github.com/Santiago-Labs/go-ocsf/datastore"
+	"github.com/Santiago-Labs/go-ocsf/ocsf/eventuid"
 	ocsf "github.com/Santiago-Labs/go-ocsf/ocsf/v1_4_0"
+	"github.com/Santiago-Labs/go-ocsf/syncers/checkpoint"
 )
 
 type LogFileRecord struct {
@@ -23,14 +25,39 @@ type LogFileRecord struct {
 	CreatedDate string
 }
 
+// SalesforceConfig configures the Salesforce syncer.
+type SalesforceConfig struct {
+	Username      string `yaml:"username" env:"SALESFORCE_USERNAME" usage:"Salesforce username"`
+	Password      string `yaml:"password" env:"SALESFORCE_PASSWORD" usage:"Salesforce password"`
+	SecurityToken string `yaml:"security_token" env:"SALESFORCE_SECURITY_TOKEN" usage:"Salesforce security token"`
+	Operation     string `yaml:"operation" flag:"operation" usage:"Salesforce event log type to sync"`
+}
+
+func (c *SalesforceConfig) Validate() error {
+	if c.Username == "" || c.Password == "" {
+		return fmt.Errorf("SALESFORCE_USERNAME and SALESFORCE_PASSWORD must be set")
+	}
+	for _, op := range salesforce.SFOperations {
+		if c.Operation == op {
+			return nil
+		}
+	}
+	return fmt.Errorf("operation must be one of %v, got '%s'", salesforce.SFOperations, c.Operation)
+}
+
 // SalesforceSyncer implements the BaseConnector interface for SalesForce Event Log
 type SalesforceSyncer struct {
-	client    *salesforce.Client
-	Operation string
-	pointer   string
-	datastore datastore.Datastore[ocsf.APIActivity]
+	client      *salesforce.Client
+	Operation   string
+	pointer     string
+	datastore   datastore.Datastore[ocsf.APIActivity]
+	checkpoints checkpoint.Store
+	window      TimeWindow
 }
 
+// salesforceLookback is how far back the first sync of an operation reads event logs.
+const salesforceLookback = 7 * 24 * time.Hour
+
 // NewConnector creates a new SalesForce Event Log connector
 func NewConnector(identity, key, token string) *SalesforceSyncer {
 	return &SalesforceSyncer{
@@ -38,36 +65,52 @@ func NewConnector(identity, key, token string) *SalesforceSyncer {
 	}
 }
 
+// NewSalesforceSyncer creates a new SalesForce Event Log connector which saves the API activities
+// within window to the datastore configured by storageOpts.
+func NewSalesforceSyncer(ctx context.Context, identity, key, token string, storageOpts datastore.StorageOpts, window TimeWindow) (*SalesforceSyncer, error) {
+	dataStoreInst, err := SetupAPIActivityStorage(ctx, storageOpts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to setup datastore: %w", err)
+	}
+
+	checkpoints, err := NewAPIActivityCheckpoints(ctx, storageOpts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to setup checkpoints: %w", err)
+	}
+
+	return &SalesforceSyncer{
+		client:      salesforce.New(identity, key, token),
+		datastore:   dataStoreInst,
+		checkpoints: checkpoints,
+		window:      window,
+	}, nil
+}
+
 // Sync gathers EventLogs from the SalesForce Cloud API
-func (c *SalesforceSyncer) Sync() error {
+func (c *SalesforceSyncer) Sync(ctx context.Context) error {
 	// Authenticate with Salesforce
 	err := c.client.Authenticate()
 	if err != nil {
 		return fmt.Errorf("failed to authenticate with Salesforce: %w", err)
 	}
 
-	// If no pointer is stored, set the pointer to a week ago
-	now := time.Now().UTC()
-	var pointer string
-
-	storedPointer, err := c.GetPointer()
+	// Resume after the pointer, or start a week ago if no pointer is stored
+	var last time.Time
+	storedPointer, err := c.GetPointer(ctx)
 	if err != nil {
-		if errors.Is(err, salesforce.ErrNotFound) {
-			// Set the pointer to a week ago.
-			pointer = now.Add(-7 * 24 * time.Hour).Format(salesforce.SFTimestampFormat)
-			c.SetPointer(pointer)
-		} else {
+		if !errors.Is(err, salesforce.ErrNotFound) {
 			return err
 		}
 	} else {
-		pointer = storedPointer
-	}
-
-	// Parse pointer into a time.Time object
-	pointerTime, err := time.Parse(salesforce.SFTimestampFormat, pointer)
-	if err != nil {
-		return fmt.Errorf("invalid pointer timestamp format: %v", err)
+		// Parse pointer into a time.Time object
+		pointerTime, err := time.Parse(salesforce.SFTimestampFormat, storedPointer)
+		if err != nil {
+			return fmt.Errorf("invalid pointer timestamp format: %v", err)
+		}
+		// Entries are compared by the second, skip the pointer's second
+		last = pointerTime.Truncate(time.Second).Add(time.Second)
 	}
+	since, until := c.window.Range(last, salesforceLookback, time.Now().UTC())
 
 	// Validate operation
 	validOperation := false
@@ -82,6 +125,17 @@ func (c *SalesforceSyncer) Sync() error {
 		return fmt.Errorf("operation must be one of %v, got '%s'", salesforce.SFOperations, c.Operation)
 	}
 
+	if c.window.Backfill > 0 {
+		if c.checkpoints == nil {
+			return fmt.Errorf("backfill requires a connector created with NewSalesforceSyncer")
+		}
+		return Backfill(ctx, c.checkpoints, c.pointerKey(), since, until, c.window.Backfill, c.syncRange)
+	}
+	return c.syncRange(ctx, since, until)
+}
+
+// syncRange gathers the EventLog entries with a timestamp in [since, until)
+func (c *SalesforceSyncer) syncRange(ctx context.Context, since, until time.Time) error {
 	// Fetch log files
 	logFiles := make(map[string][]LogFileRecord)
 	nextRecordsURL := ""
@@ -93,8 +147,9 @@ func (c *SalesforceSyncer) Sync() error {
 		if nextRecordsURL != "" {
 			records, err = c.client.QueryMore(nextRecordsURL)
 		} else {
-			pointerDate := pointerTime.Format("2006-01-02T00:00:00.00Z")
-			query := fmt.Sprintf(salesforce.SOQLEventLogFile, c.Operation, pointerDate)
+			sinceDate := since.UTC().Format("2006-01-02T00:00:00.00Z")
+			untilDate := until.UTC().Format("2006-01-02T00:00:00.00Z")
+			query := fmt.Sprintf(salesforce.SOQLEventLogFileRange, c.Operation, sinceDate, untilDate)
 			records, err = c.client.QueryAll(query)
 		}
 
@@ -141,7 +196,7 @@ func (c *SalesforceSyncer) Sync() error {
 		for _, logFile := range logFiles[logType] {
 			// Fetch log file content
 			url := fmt.Sprintf("https://%s/%s", c.client.Instance, logFile.LogFile)
-			req, err := http.NewRequest("GET", url, nil)
+			req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 			if err != nil {
 				return fmt.Errorf("unable to create request for event log: %v", err)
 			}
@@ -184,13 +239,13 @@ func (c *SalesforceSyncer) Sync() error {
 					entry[header] = record[i]
 				}
 
-				// Skip if the entry is BEFORE the known pointer
+				// Skip if the entry is outside of the range
 				entryTime, err := time.Parse(salesforce.SFTimestampFormat, entry["TIMESTAMP_DERIVED"])
 				if err != nil {
 					continue
 				}
 
-				if entryTime.Unix() <= pointerTime.Unix() {
+				if entryTime.Before(since) || !entryTime.Before(until) {
 					continue
 				}
 
@@ -198,7 +253,9 @@ func (c *SalesforceSyncer) Sync() error {
 			}
 
 			if len(entries) > 0 {
-				c.Save(entries)
+				if err := c.Save(ctx, entries); err != nil {
+					return err
+				}
 			}
 		}
 	}
@@ -209,11 +266,6 @@ func (c *SalesforceSyncer) Sync() error {
 // ToOCSF converts a Salesforce event log entry into an OCSF API activity.
 func (c *SalesforceSyncer) ToOCSF(entry map[string]string) (ocsf.APIActivity, error) {
 	// Define OCSF class and category constants
-	classUID := 6003
-	categoryUID := 6
-	categoryName := "Application Activity"
-	className := "API Activity"
-
 	// Extract method/operation name from entry
 	methodName := entry["OPERATION"]
 	if methodName == "" {
@@ -224,42 +276,24 @@ func (c *SalesforceSyncer) ToOCSF(entry map[string]string) (ocsf.APIActivity, er
 	}
 
 	// Map operation to OCSF activity type
-	var activityID int
-	var activityName string
-	var typeUID int
-	var typeName string
+	var activityID ocsf.APIActivityActivityId
 
 	// Check for common method name patterns
 	if strings.Contains(strings.ToLower(methodName), "create") ||
 		strings.Contains(strings.ToLower(methodName), "insert") {
-		activityID = 1
-		activityName = "Create"
-		typeUID = classUID*100 + activityID
-		typeName = "API Activity: Create"
+		activityID = ocsf.APIActivityActivityIdCreate
 	} else if strings.Contains(strings.ToLower(methodName), "get") ||
 		strings.Contains(strings.ToLower(methodName), "list") ||
 		strings.Contains(strings.ToLower(methodName), "view") ||
 		strings.Contains(strings.ToLower(methodName), "select") {
-		activityID = 2
-		activityName = "Read"
-		typeUID = classUID*100 + activityID
-		typeName = "API Activity: Read"
+		activityID = ocsf.APIActivityActivityIdRead
 	} else if strings.Contains(strings.ToLower(methodName), "update") ||
 		strings.Contains(strings.ToLower(methodName), "modify") {
-		activityID = 3
-		activityName = "Update"
-		typeUID = classUID*100 + activityID
-		typeName = "API Activity: Update"
+		activityID = ocsf.APIActivityActivityIdUpdate
 	} else if strings.Contains(strings.ToLower(methodName), "delete") {
-		activityID = 4
-		activityName = "Delete"
-		typeUID = classUID*100 + activityID
-		typeName = "API Activity: Delete"
+		activityID = ocsf.APIActivityActivityIdDelete
 	} else {
-		activityID = 0
-		activityName = "Unknown"
-		typeUID = classUID*100 + activityID
-		typeName = "API Activity: Unknown"
+		activityID = ocsf.APIActivityActivityIdUnknown
 	}
 
 	// Determine status and severity
@@ -322,6 +356,9 @@ func (c *SalesforceSyncer) ToOCSF(entry map[string]string) (ocsf.APIActivity, er
 			Name: stringPtr("Salesforce"),
 		},
 	}
+	if entry["REQUEST_ID"] != "" {
+		api.Request = &ocsf.RequestElements{Uid: entry["REQUEST_ID"]}
+	}
 
 	// Create source endpoint
 	var srcEndpoint ocsf.NetworkEndpoint
@@ -349,32 +386,27 @@ func (c *SalesforceSyncer) ToOCSF(entry map[string]string) (ocsf.APIActivity, er
 	}
 
 	// Create the API Activity
-	activity := ocsf.APIActivity{
-		ActivityId:   int32(activityID),
-		ActivityName: &activityName,
-		Actor:        actor,
-		Api:          api,
-		CategoryName: &categoryName,
-		CategoryUid:  int32(categoryUID),
-		ClassName:    &className,
-		ClassUid:     int32(classUID),
-		Status:       &status,
-		StatusId:     int32Ptr(int32(statusID)),
-
-		Resources:  resources,
-		Severity:   &severity,
-		SeverityId: int32(severityID),
-
-		Metadata: ocsf.Metadata{
-			CorrelationUid: stringPtr(entry["REQUEST_ID"]),
-		},
+	activity := ocsf.NewAPIActivity(activityID)
+	activity.Actor = actor
+	activity.Api = api
+	activity.Status = &status
+	activity.StatusId = int32Ptr(int32(statusID))
+	activity.Resources = resources
+	activity.Severity = &severity
+	activity.SeverityId = int32(severityID)
+	activity.Metadata.Product = ocsf.Product{
+		Name:       stringPtr("Event Monitoring"),
+		VendorName: stringPtr("Salesforce"),
+	}
+	activity.SrcEndpoint = srcEndpoint
+	activity.Time = ts.UnixMilli()
+	activity.TimezoneOffset = int32Ptr(0)
 
-		SrcEndpoint:    srcEndpoint,
-		Time:           ts.UnixMilli(),
-		TypeName:       &typeName,
-		TypeUid:        int64(typeUID),
-		TimezoneOffset: int32Ptr(0),
+	uid, err := eventuid.UID(&activity, entry["REQUEST_ID"])
+	if err != nil {
+		return ocsf.APIActivity{}, fmt.Errorf("failed to compute event UID: %w", err)
 	}
+	activity.Metadata.Uid = &uid
 
 	return activity, nil
 }
@@ -389,7 +421,7 @@ func int32Ptr(i int32) *int32 {
 }
 
 // Save stores the collected entries
-func (c *SalesforceSyncer) Save(entries []map[string]string) error {
+func (c *SalesforceSyncer) Save(ctx context.Context, entries []map[string]string) error {
 	var activities []ocsf.APIActivity
 
 	for _, entry := range entries {
@@ -404,31 +436,68 @@ func (c *SalesforceSyncer) Save(entries []map[string]string) error {
 
 	// Save activities to datastore
 	if len(activities) > 0 {
-		if err := c.datastore.Save(context.Background(), activities); err != nil {
+		if err := c.datastore.Save(ctx, activities); err != nil {
 			return fmt.Errorf("failed to save API activities: %w", err)
 		}
 	}
 
-	// Update pointer to the latest timestamp if entries exist
-	if len(entries) > 0 {
-		latestEntry := entries[len(entries)-1]
-		c.SetPointer(latestEntry["TIMESTAMP_DERIVED"])
+	// Update pointer to the latest timestamp if entries exist, unless syncing a past window
+	if !c.window.Incremental() {
+		return nil
+	}
+	var latest time.Time
+	for _, entry := range entries {
+		entryTime, err := time.Parse(salesforce.SFTimestampFormat, entry["TIMESTAMP_DERIVED"])
+		if err == nil && entryTime.After(latest) {
+			latest = entryTime
+		}
+	}
+	if !latest.IsZero() {
+		current, err := c.GetPointer(ctx)
+		if err != nil && !errors.Is(err, salesforce.ErrNotFound) {
+			return err
+		}
+		currentTime, err := time.Parse(salesforce.SFTimestampFormat, current)
+		if err != nil || latest.After(currentTime) {
+			return c.SetPointer(ctx, latest.Format(salesforce.SFTimestampFormat))
+		}
 	}
 
 	return nil
 }
 
-// GetPointer returns the current pointer
-func (c *SalesforceSyncer) GetPointer() (string, error) {
-	if c.pointer == "" {
-		return "", fmt.Errorf("pointer not found")
+// pointerKey is the checkpoint key of the pointer of the connector's operation.
+func (c *SalesforceSyncer) pointerKey() string {
+	return "salesforce/" + c.Operation
+}
+
+// GetPointer returns the current pointer, which is persisted in the checkpoint store when the
+// connector has one. It returns salesforce.ErrNotFound before the first sync.
+func (c *SalesforceSyncer) GetPointer(ctx context.Context) (string, error) {
+	pointer := c.pointer
+	if c.checkpoints != nil {
+		var err error
+		pointer, err = c.checkpoints.Get(ctx, c.pointerKey())
+		if err != nil {
+			return "", fmt.Errorf("failed to read pointer: %w", err)
+		}
+	}
+
+	if pointer == "" {
+		return "", fmt.Errorf("%w: pointer not found", salesforce.ErrNotFound)
 	}
-	return c.pointer, nil
+	return pointer, nil
 }
 
 // SetPointer sets the current pointer
-func (c *SalesforceSyncer) SetPointer(pointer string) {
+func (c *SalesforceSyncer) SetPointer(ctx context.Context, pointer string) error {
 	c.pointer = pointer
+	if c.checkpoints != nil {
+		if err := c.checkpoints.Set(ctx, c.pointerKey(), pointer); err != nil {
+			return fmt.Errorf("failed to write pointer: %w", err)
+		}
+	}
+	return nil
 }
 
 // WithOperation sets the operation for the connector
@@ -7,29 +7,44 @@ import (
 	"time"
 
 	"github.com/Santiago-Labs/go-ocsf/datastore"
+	"github.com/Santiago-Labs/go-ocsf/ocsf/eventuid"
 	ocsf "github.com/Santiago-Labs/go-ocsf/ocsf/v1_4_0"
+	"github.com/Santiago-Labs/go-ocsf/syncers/lifecycle"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/securityhub"
 	"github.com/aws/aws-sdk-go-v2/service/securityhub/types"
 	"github.com/samsarahq/go/oops"
 )
 
+// SecurityHubConfig configures the SecurityHub syncer, which reads the findings of the AWS account
+// and region of the default AWS config.
+type SecurityHubConfig struct{}
+
+func (c *SecurityHubConfig) Validate() error { return nil }
+
 type SecurityHubOCSFSyncer struct {
 	securityHubClient *securityhub.Client
 	datastore         datastore.Datastore[ocsf.VulnerabilityFinding]
+	tracker           *lifecycle.Tracker[ocsf.VulnerabilityFinding, *ocsf.VulnerabilityFinding]
 }
 
 // NewSecurityHubOCSFSyncer creates a new SecurityHubOCSFSyncer
 // It initializes the SecurityHub client and datastore.
 func NewSecurityHubOCSFSyncer(ctx context.Context, securityHubClient *securityhub.Client, storageOpts datastore.StorageOpts) (DataSync, error) {
-	dataStoreInst, err := datastore.SetupStorage[ocsf.VulnerabilityFinding](ctx, storageOpts)
+	dataStoreInst, err := SetupVulnerabilityFindingStorage(ctx, storageOpts)
 	if err != nil {
 		return nil, fmt.Errorf("failed to setup datastore: %w", err)
 	}
 
+	lifecycleStore, err := lifecycle.NewStore(ctx, storageOpts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to setup lifecycle store: %w", err)
+	}
+
 	return &SecurityHubOCSFSyncer{
 		securityHubClient: securityHubClient,
 		datastore:         dataStoreInst,
+		tracker:           lifecycle.NewTracker[ocsf.VulnerabilityFinding]("securityhub", lifecycleStore),
 	}, nil
 }
 
@@ -38,6 +53,9 @@ func NewSecurityHubOCSFSyncer(ctx context.Context, securityHubClient *securityhu
 func (s *SecurityHubOCSFSyncer) Sync(ctx context.Context) error {
 	slog.Info("syncing SecurityHub data")
 
+	// Collect every page first, so that the lifecycle tracker sees the whole snapshot.
+	var findingsToSave []ocsf.VulnerabilityFinding
+	var partial bool
 	var nextToken *string
 	for {
 		securityHubFindingsOutput, err := s.securityHubClient.GetFindings(
@@ -59,21 +77,17 @@ func (s *SecurityHubOCSFSyncer) Sync(ctx context.Context) error {
 
 		slog.Info("SecurityHub findings", "num_findings", len(securityHubFindingsOutput.Findings))
 
-		var findingsToSave []ocsf.VulnerabilityFinding
 		for _, securityHubFinding := range securityHubFindingsOutput.Findings {
 			finding, err := s.ToOCSF(ctx, securityHubFinding)
 			if err != nil {
-				return oops.Wrapf(err, "failed to build OCSF finding")
+				slog.Warn("failed to build OCSF finding", "error", err)
+				partial = true
+				continue
 			}
 
 			findingsToSave = append(findingsToSave, finding)
 		}
 
-		err = s.datastore.Save(ctx, findingsToSave)
-		if err != nil {
-			return oops.Wrapf(err, "failed to save findings")
-		}
-
 		if securityHubFindingsOutput.NextToken == nil {
 			break
 		}
@@ -81,6 +95,24 @@ func (s *SecurityHubOCSFSyncer) Sync(ctx context.Context) error {
 		nextToken = securityHubFindingsOutput.NextToken
 	}
 
+	track := s.tracker.Track
+	if partial {
+		track = s.tracker.TrackPartial
+	}
+	findingsToSave, state, err := track(ctx, time.Now(), findingsToSave)
+	if err != nil {
+		return oops.Wrapf(err, "failed to track finding lifecycle")
+	}
+
+	err = s.datastore.Save(ctx, findingsToSave)
+	if err != nil {
+		return oops.Wrapf(err, "failed to save findings")
+	}
+
+	if err := s.tracker.Commit(ctx, state); err != nil {
+		return oops.Wrapf(err, "failed to commit finding lifecycle")
+	}
+
 	slog.Info("Finished SecurityHub sync")
 	return nil
 }
@@ -99,7 +131,7 @@ func (s *SecurityHubOCSFSyncer) ToOCSF(ctx context.Context, securityHubFinding t
 	}
 
 	var endTime *time.Time
-	if status == "Closed" {
+	if statusID == findingStatusResolved {
 		if securityHubFinding.UpdatedAt != nil {
 			parsedTime, err := time.Parse(time.RFC3339, *securityHubFinding.UpdatedAt)
 			if err == nil {
@@ -176,33 +208,17 @@ func (s *SecurityHubOCSFSyncer) ToOCSF(ctx context.Context, securityHubFinding t
 		},
 	}
 
-	var activityID int32
-	var activityName string
-	var typeUID int64
-	var typeName string
+	var activity ocsf.VulnerabilityFindingActivityId
 	var eventTime time.Time
-	className := "Vulnerability Finding"
-	categoryUID := int32(2)
-	categoryName := "Findings"
-	classUID := int32(2002)
 
 	if securityHubFinding.UpdatedAt == securityHubFinding.CreatedAt {
-		activityID = int32(1)
-		activityName = "Create"
-		typeUID = int64(classUID)*100 + int64(activityID)
-		typeName = "Vulnerability Finding: Create"
+		activity = ocsf.VulnerabilityFindingActivityIdCreate
 		eventTime = *createdAt
-	} else if status == "Closed" {
-		activityID = int32(3)
-		activityName = "Close"
-		typeUID = int64(classUID)*100 + int64(activityID)
-		typeName = "Vulnerability Finding: Close"
+	} else if statusID == findingStatusResolved {
+		activity = ocsf.VulnerabilityFindingActivityIdClose
 		eventTime = *endTime
 	} else {
-		activityID = int32(2)
-		activityName = "Update"
-		typeUID = int64(classUID)*100 + int64(activityID)
-		typeName = "Vulnerability Finding: Update"
+		activity = ocsf.VulnerabilityFindingActivityIdUpdate
 		parsedTime, err := time.Parse(time.RFC3339, *securityHubFinding.UpdatedAt)
 		if err != nil {
 			return ocsf.VulnerabilityFinding{}, oops.Wrapf(err, "failed to parse time")
@@ -212,14 +228,6 @@ func (s *SecurityHubOCSFSyncer) ToOCSF(ctx context.Context, securityHubFinding t
 
 	productName := "SecurityHub"
 
-	metadata := ocsf.Metadata{
-		Product: ocsf.Product{
-			Name:       &productName,
-			VendorName: &vendorName,
-		},
-		Version: "1.4.0",
-	}
-
 	var modifiedTime *time.Time
 	if securityHubFinding.UpdatedAt != nil {
 		parsedTime, err := time.Parse(time.RFC3339, *securityHubFinding.UpdatedAt)
@@ -250,28 +258,28 @@ func (s *SecurityHubOCSFSyncer) ToOCSF(ctx context.Context, securityHubFinding t
 		Types:         []string{"Vulnerability"},
 	}
 
-	finding := ocsf.VulnerabilityFinding{
-		Time:            eventTime.UnixMilli(),
-		StartTime:       createdTimeInt,
-		EndTime:         endTimeInt,
-		ActivityId:      activityID,
-		ActivityName:    &activityName,
-		CategoryUid:     categoryUID,
-		CategoryName:    &categoryName,
-		ClassUid:        classUID,
-		ClassName:       &className,
-		Message:         securityHubFinding.Description,
-		Metadata:        metadata,
-		Resources:       mapSecurityHubResources(securityHubFinding),
-		Status:          &status,
-		StatusId:        &statusID,
-		TypeUid:         typeUID,
-		TypeName:        &typeName,
-		Vulnerabilities: vulnerabilities,
-		FindingInfo:     findingInfo,
-		SeverityId:      int32(severityID),
-		Severity:        &severity,
+	finding := ocsf.NewVulnerabilityFinding(activity)
+	finding.Time = eventTime.UnixMilli()
+	finding.StartTime = createdTimeInt
+	finding.EndTime = endTimeInt
+	finding.Message = securityHubFinding.Description
+	finding.Metadata.Product = ocsf.Product{
+		Name:       &productName,
+		VendorName: &vendorName,
+	}
+	finding.Resources = mapSecurityHubResources(securityHubFinding)
+	finding.Status = &status
+	finding.StatusId = &statusID
+	finding.Vulnerabilities = vulnerabilities
+	finding.FindingInfo = findingInfo
+	finding.SeverityId = int32(severityID)
+	finding.Severity = &severity
+
+	uid, err := eventuid.UID(&finding, *securityHubFinding.Id)
+	if err != nil {
+		return ocsf.VulnerabilityFinding{}, fmt.Errorf("failed to compute event UID: %w", err)
 	}
+	finding.Metadata.Uid = &uid
 
 	return finding, nil
 }
@@ -314,7 +322,7 @@ func mapSecurityHubStatus(workflow *types.Workflow) (string, int32) {
 	case types.WorkflowStatusSuppressed:
 		return "suppressed", 3
 	case types.WorkflowStatusResolved:
-		return "closed", 4
+		return "Resolved", 4
 	default:
 		return "unknown", 0
 	}
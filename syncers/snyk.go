@@ -8,7 +8,9 @@ import (
 
 	"github.com/Santiago-Labs/go-ocsf/clients/snyk"
 	"github.com/Santiago-Labs/go-ocsf/datastore"
+	"github.com/Santiago-Labs/go-ocsf/ocsf/eventuid"
 	ocsf "github.com/Santiago-Labs/go-ocsf/ocsf/v1_4_0"
+	"github.com/Santiago-Labs/go-ocsf/syncers/lifecycle"
 	"github.com/samsarahq/go/oops"
 )
 
@@ -16,20 +18,39 @@ type DataSync interface {
 	Sync(ctx context.Context) error
 }
 
+// SnykConfig configures the Snyk syncer.
+type SnykConfig struct {
+	APIKey         string `yaml:"api_key" env:"SNYK_API_KEY" usage:"Snyk API key"`
+	OrganizationID string `yaml:"organization_id" env:"SNYK_ORGANIZATION_ID" usage:"Snyk organization ID"`
+}
+
+func (c *SnykConfig) Validate() error {
+	if c.APIKey == "" || c.OrganizationID == "" {
+		return fmt.Errorf("SNYK_API_KEY and SNYK_ORGANIZATION_ID must be set")
+	}
+	return nil
+}
+
 type SnykOCSFSyncer struct {
 	snykClient *snyk.Client
 	datastore  datastore.Datastore[ocsf.VulnerabilityFinding]
 	org        *snyk.Org
+	tracker    *lifecycle.Tracker[ocsf.VulnerabilityFinding, *ocsf.VulnerabilityFinding]
 }
 
 // NewSnykOCSFSyncer creates a new SnykOCSFSyncer
 // It initializes the Snyk client and datastore, and fetches the organization details.
 func NewSnykOCSFSyncer(ctx context.Context, snykClient *snyk.Client, storageOpts datastore.StorageOpts) (DataSync, error) {
-	dataStoreInst, err := datastore.SetupStorage[ocsf.VulnerabilityFinding](ctx, storageOpts)
+	dataStoreInst, err := SetupVulnerabilityFindingStorage(ctx, storageOpts)
 	if err != nil {
 		return nil, fmt.Errorf("failed to setup datastore: %w", err)
 	}
 
+	lifecycleStore, err := lifecycle.NewStore(ctx, storageOpts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to setup lifecycle store: %w", err)
+	}
+
 	org, err := snykClient.GetOrg(ctx)
 	if err != nil {
 		return nil, oops.Wrapf(err, "failed to fetch org")
@@ -39,6 +60,7 @@ func NewSnykOCSFSyncer(ctx context.Context, snykClient *snyk.Client, storageOpts
 		snykClient: snykClient,
 		datastore:  dataStoreInst,
 		org:        org,
+		tracker:    lifecycle.NewTracker[ocsf.VulnerabilityFinding]("snyk", lifecycleStore),
 	}, nil
 }
 
@@ -60,6 +82,7 @@ func (s *SnykOCSFSyncer) Sync(ctx context.Context) error {
 	}
 
 	var findingsToSave []ocsf.VulnerabilityFinding
+	var partial bool
 	for _, issue := range issues {
 		project, err := s.snykClient.GetProject(ctx, issue.Relationships.ScanItem.Data.ID)
 		if err != nil {
@@ -68,17 +91,32 @@ func (s *SnykOCSFSyncer) Sync(ctx context.Context) error {
 
 		finding, err := s.ToOCSF(ctx, issue, project)
 		if err != nil {
-			return oops.Wrapf(err, "failed to build OCSF finding")
+			slog.Warn("failed to build OCSF finding", "error", err)
+			partial = true
+			continue
 		}
 
 		findingsToSave = append(findingsToSave, finding)
 	}
 
+	track := s.tracker.Track
+	if partial {
+		track = s.tracker.TrackPartial
+	}
+	findingsToSave, state, err := track(ctx, time.Now(), findingsToSave)
+	if err != nil {
+		return oops.Wrapf(err, "failed to track finding lifecycle")
+	}
+
 	err = s.datastore.Save(ctx, findingsToSave)
 	if err != nil {
 		return oops.Wrapf(err, "failed to save findings")
 	}
 
+	if err := s.tracker.Commit(ctx, state); err != nil {
+		return oops.Wrapf(err, "failed to commit finding lifecycle")
+	}
+
 	slog.Info("Finished Snyk sync")
 	return nil
 }
@@ -90,12 +128,12 @@ func (s *SnykOCSFSyncer) ToOCSF(ctx context.Context, issue snyk.Issue, project *
 	createdAt := issue.Attributes.CreatedAt
 	updatedAt := issue.Attributes.UpdatedAt
 	var endTime *time.Time
-	if status == "Closed" {
+	if statusID == findingStatusResolved {
 		endTime = &updatedAt
 	}
 
 	var lastSeenTime *time.Time
-	if status == "Open" {
+	if statusID != findingStatusResolved {
 		lastSeenTime = &updatedAt
 	} else {
 		// This technically isn't correct because its when the issue was closed,
@@ -186,46 +224,22 @@ func (s *SnykOCSFSyncer) ToOCSF(ctx context.Context, issue snyk.Issue, project *
 		Type: &resourceType,
 	}
 
-	var activityID int32
-	var activityName string
-	var typeUID int64
-	var typeName string
+	var activity ocsf.VulnerabilityFindingActivityId
 	var eventTime time.Time
-	className := "Vulnerability Finding"
-	categoryUID := int32(2)
-	categoryName := "Findings"
-	classUID := int32(2002)
 
 	if createdAt.Equal(updatedAt) {
-		activityID = int32(1)
-		activityName = "Create"
-		typeUID = int64(classUID)*100 + int64(activityID)
-		typeName = "Vulnerability Finding: Create"
+		activity = ocsf.VulnerabilityFindingActivityIdCreate
 		eventTime = createdAt
-	} else if status == "Closed" {
-		activityID = int32(3)
-		activityName = "Close"
-		typeUID = int64(classUID)*100 + int64(activityID)
-		typeName = "Vulnerability Finding: Close"
+	} else if statusID == findingStatusResolved {
+		activity = ocsf.VulnerabilityFindingActivityIdClose
 		eventTime = *endTime
 	} else {
-		activityID = int32(2)
-		activityName = "Update"
-		typeUID = int64(classUID)*100 + int64(activityID)
-		typeName = "Vulnerability Finding: Update"
+		activity = ocsf.VulnerabilityFindingActivityIdUpdate
 		eventTime = *lastSeenTime
 	}
 
 	productName := "Snyk"
 
-	metadata := ocsf.Metadata{
-		Product: ocsf.Product{
-			Name:       &productName,
-			VendorName: &vendorName,
-		},
-		Version: "1.4.0",
-	}
-
 	var modifiedTimeInt int64
 	if !updatedAt.Equal(createdAt) {
 		modifiedTimeInt = updatedAt.UnixMilli()
@@ -248,28 +262,28 @@ func (s *SnykOCSFSyncer) ToOCSF(ctx context.Context, issue snyk.Issue, project *
 		Types:         []string{"Vulnerability"},
 	}
 
-	finding := ocsf.VulnerabilityFinding{
-		Time:            eventTime.UnixMilli(),
-		StartTime:       createdTimeInt,
-		EndTime:         endTimeInt,
-		ActivityId:      activityID,
-		ActivityName:    &activityName,
-		CategoryUid:     categoryUID,
-		CategoryName:    &categoryName,
-		ClassUid:        classUID,
-		ClassName:       &className,
-		Message:         &issue.Attributes.Description,
-		Metadata:        metadata,
-		Resources:       []ocsf.ResourceDetails{resource},
-		Status:          &status,
-		StatusId:        &statusID,
-		TypeUid:         typeUID,
-		TypeName:        &typeName,
-		Vulnerabilities: vulnerabilities,
-		FindingInfo:     findingInfo,
-		SeverityId:      int32(severityID),
-		Severity:        &severity,
+	finding := ocsf.NewVulnerabilityFinding(activity)
+	finding.Time = eventTime.UnixMilli()
+	finding.StartTime = createdTimeInt
+	finding.EndTime = endTimeInt
+	finding.Message = &issue.Attributes.Description
+	finding.Metadata.Product = ocsf.Product{
+		Name:       &productName,
+		VendorName: &vendorName,
+	}
+	finding.Resources = []ocsf.ResourceDetails{resource}
+	finding.Status = &status
+	finding.StatusId = &statusID
+	finding.Vulnerabilities = vulnerabilities
+	finding.FindingInfo = findingInfo
+	finding.SeverityId = int32(severityID)
+	finding.Severity = &severity
+
+	uid, err := eventuid.UID(&finding, issue.ID)
+	if err != nil {
+		return ocsf.VulnerabilityFinding{}, fmt.Errorf("failed to compute event UID: %w", err)
 	}
+	finding.Metadata.Uid = &uid
 
 	return finding, nil
 }
@@ -298,7 +312,7 @@ func mapSnykSeverity(snykSeverity string) (string, int) {
 func mapSnykStatus(snykStatus string) (string, int32) {
 	switch snykStatus {
 	case "resolved":
-		return "closed", 4
+		return "Resolved", 4
 	default:
 		return "open", 1
 	}
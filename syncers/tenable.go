@@ -4,30 +4,116 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"strings"
 	"time"
 
 	"github.com/Santiago-Labs/go-ocsf/clients/tenable"
 	"github.com/Santiago-Labs/go-ocsf/datastore"
+	"github.com/Santiago-Labs/go-ocsf/ocsf/eventuid"
 	ocsf "github.com/Santiago-Labs/go-ocsf/ocsf/v1_4_0"
+	"github.com/Santiago-Labs/go-ocsf/syncers/checkpoint"
+	"github.com/Santiago-Labs/go-ocsf/syncers/lifecycle"
 	"github.com/samsarahq/go/oops"
 )
 
+// TenableConfig configures the Tenable syncer.
+type TenableConfig struct {
+	AccessKey string `yaml:"access_key" env:"TENABLE_API_KEY" usage:"Tenable API access key"`
+	SecretKey string `yaml:"secret_key" env:"TENABLE_SECRET_KEY" usage:"Tenable API secret key"`
+	Severity  string `yaml:"severity" flag:"severity" usage:"Comma-separated severities to sync, e.g. high,critical. Defaults to low,medium,high,critical"`
+	State     string `yaml:"state" flag:"state" usage:"Comma-separated vulnerability states to sync, e.g. open,reopened. Defaults to open,reopened,fixed"`
+}
+
+func (c *TenableConfig) Validate() error {
+	if c.AccessKey == "" || c.SecretKey == "" {
+		return fmt.Errorf("TENABLE_API_KEY and TENABLE_SECRET_KEY must be set")
+	}
+	for _, severity := range splitList(c.Severity) {
+		switch severity {
+		case "info", "low", "medium", "high", "critical":
+		default:
+			return fmt.Errorf("unknown Tenable severity %q", severity)
+		}
+	}
+	for _, state := range splitList(c.State) {
+		switch state {
+		case "open", "reopened", "fixed":
+		default:
+			return fmt.Errorf("unknown Tenable state %q", state)
+		}
+	}
+	return nil
+}
+
+// filters returns the export filters of the config, leaving the client's defaults for the
+// filters which are not set.
+func (c *TenableConfig) filters() map[string]interface{} {
+	filters := map[string]interface{}{}
+	if severities := splitList(c.Severity); len(severities) > 0 {
+		filters["severity"] = severities
+	}
+	if states := splitList(c.State); len(states) > 0 {
+		filters["state"] = states
+	}
+	return filters
+}
+
+// splitList splits a comma-separated list, ignoring empty items.
+func splitList(list string) []string {
+	var items []string
+	for _, item := range strings.Split(list, ",") {
+		if item = strings.ToLower(strings.TrimSpace(item)); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
 // TenableOCSFSyncer is responsible for syncing Tenable vulnerability findings to OCSF format
 type TenableOCSFSyncer struct {
 	tenableClient *tenable.Client
 	datastore     datastore.Datastore[ocsf.VulnerabilityFinding]
+	tracker       *lifecycle.Tracker[ocsf.VulnerabilityFinding, *ocsf.VulnerabilityFinding]
+	checkpoints   checkpoint.Store
+	filters       map[string]interface{}
 }
 
+// tenableExportCheckpoint is the checkpoint of the export a sync reads, which is cleared once the
+// sync finishes so that an interrupted sync resumes the same export.
+const tenableExportCheckpoint = "tenable/export"
+
 // NewTenableOCSFSyncer creates a new TenableOCSFSyncer
 func NewTenableOCSFSyncer(ctx context.Context, tenableClient *tenable.Client, storageOpts datastore.StorageOpts) (DataSync, error) {
-	dataStoreInst, err := datastore.SetupStorage[ocsf.VulnerabilityFinding](ctx, storageOpts)
+	syncer, err := newTenableOCSFSyncer(ctx, tenableClient, storageOpts, nil)
+	if err != nil {
+		return nil, err
+	}
+	return syncer, nil
+}
+
+// newTenableOCSFSyncer creates a TenableOCSFSyncer of the findings matching the export filters.
+func newTenableOCSFSyncer(ctx context.Context, tenableClient *tenable.Client, storageOpts datastore.StorageOpts, filters map[string]interface{}) (*TenableOCSFSyncer, error) {
+	dataStoreInst, err := SetupVulnerabilityFindingStorage(ctx, storageOpts)
 	if err != nil {
 		return nil, fmt.Errorf("failed to setup datastore: %w", err)
 	}
 
+	lifecycleStore, err := lifecycle.NewStore(ctx, storageOpts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to setup lifecycle store: %w", err)
+	}
+
+	checkpoints, err := NewVulnerabilityFindingCheckpoints(ctx, storageOpts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to setup checkpoints: %w", err)
+	}
+
 	return &TenableOCSFSyncer{
 		tenableClient: tenableClient,
 		datastore:     dataStoreInst,
+		tracker:       lifecycle.NewTracker[ocsf.VulnerabilityFinding]("tenable", lifecycleStore),
+		checkpoints:   checkpoints,
+		filters:       filters,
 	}, nil
 }
 
@@ -35,9 +121,7 @@ func NewTenableOCSFSyncer(ctx context.Context, tenableClient *tenable.Client, st
 func (s *TenableOCSFSyncer) Sync(ctx context.Context) error {
 	slog.Info("syncing Tenable data")
 
-	filters := map[string]interface{}{}
-
-	findings, err := s.tenableClient.GetAllFindingsFromExport(ctx, filters)
+	findings, err := s.exportFindings(ctx)
 	if err != nil {
 		return oops.Wrapf(err, "failed to get all findings")
 	}
@@ -45,24 +129,76 @@ func (s *TenableOCSFSyncer) Sync(ctx context.Context) error {
 	slog.Info("found Tenable findings", "num_findings", len(findings))
 
 	var findingsToSave []ocsf.VulnerabilityFinding
+	// Filtered exports leave out findings which are still open, so they do not close them.
+	partial := len(s.filters) > 0
 	for _, finding := range findings {
 		ocsfFinding, err := s.ToOCSF(ctx, finding)
 		if err != nil {
-			return oops.Wrapf(err, "failed to build OCSF finding")
+			slog.Warn("failed to build OCSF finding", "error", err)
+			partial = true
+			continue
 		}
 
 		findingsToSave = append(findingsToSave, ocsfFinding)
 	}
 
+	track := s.tracker.Track
+	if partial {
+		track = s.tracker.TrackPartial
+	}
+	findingsToSave, state, err := track(ctx, time.Now(), findingsToSave)
+	if err != nil {
+		return oops.Wrapf(err, "failed to track finding lifecycle")
+	}
+
 	err = s.datastore.Save(ctx, findingsToSave)
 	if err != nil {
 		return oops.Wrapf(err, "failed to save findings")
 	}
 
+	if err := s.tracker.Commit(ctx, state); err != nil {
+		return oops.Wrapf(err, "failed to commit finding lifecycle")
+	}
+
+	if err := s.checkpoints.Set(ctx, tenableExportCheckpoint, ""); err != nil {
+		return oops.Wrapf(err, "failed to clear export checkpoint")
+	}
+
 	slog.Info("Finished Tenable sync")
 	return nil
 }
 
+// exportFindings returns the findings of the export an interrupted sync started, or of a new
+// export when there is none or it can no longer be read.
+func (s *TenableOCSFSyncer) exportFindings(ctx context.Context) ([]tenable.Finding, error) {
+	exportUUID, err := s.checkpoints.Get(ctx, tenableExportCheckpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	if exportUUID != "" {
+		findings, err := s.tenableClient.GetFindingsFromExport(ctx, exportUUID)
+		if err == nil {
+			return findings, nil
+		}
+		slog.Warn("failed to resume Tenable export, starting a new one", "export_uuid", exportUUID, "error", err)
+	}
+
+	filters := map[string]interface{}{}
+	for name, value := range s.filters {
+		filters[name] = value
+	}
+	exportUUID, err = s.tenableClient.ExportVulnerabilities(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkpoints.Set(ctx, tenableExportCheckpoint, exportUUID); err != nil {
+		return nil, err
+	}
+
+	return s.tenableClient.GetFindingsFromExport(ctx, exportUUID)
+}
+
 // ToOCSF converts a Tenable finding into an OCSF vulnerability finding
 func (s *TenableOCSFSyncer) ToOCSF(ctx context.Context, finding tenable.Finding) (ocsf.VulnerabilityFinding, error) {
 	severity, severityID := mapTenableSeverity(finding.SeverityID)
@@ -91,7 +227,7 @@ func (s *TenableOCSFSyncer) ToOCSF(ctx context.Context, finding tenable.Finding)
 	}
 
 	var endTime *time.Time
-	if status == "Closed" {
+	if statusID == findingStatusResolved {
 		endTime = &lastSeenTime
 	}
 
@@ -168,46 +304,22 @@ func (s *TenableOCSFSyncer) ToOCSF(ctx context.Context, finding tenable.Finding)
 		Type: &resourceType,
 	}
 
-	var activityID int32
-	var activityName string
-	var typeUID int64
-	var typeName string
+	var activity ocsf.VulnerabilityFindingActivityId
 	var eventTime time.Time
-	className := "Vulnerability Finding"
-	categoryUID := int32(2)
-	categoryName := "Findings"
-	classUID := int32(2002)
 
 	if finding.FirstFound == finding.LastFound {
-		activityID = int32(1)
-		activityName = "Create"
-		typeUID = int64(classUID)*100 + int64(activityID)
-		typeName = "Vulnerability Finding: Create"
+		activity = ocsf.VulnerabilityFindingActivityIdCreate
 		eventTime = firstSeenTime
-	} else if status == "Closed" {
-		activityID = int32(3)
-		activityName = "Close"
-		typeUID = int64(classUID)*100 + int64(activityID)
-		typeName = "Vulnerability Finding: Close"
+	} else if statusID == findingStatusResolved {
+		activity = ocsf.VulnerabilityFindingActivityIdClose
 		eventTime = lastSeenTime
 	} else {
-		activityID = int32(2)
-		activityName = "Update"
-		typeUID = int64(classUID)*100 + int64(activityID)
-		typeName = "Vulnerability Finding: Update"
+		activity = ocsf.VulnerabilityFindingActivityIdUpdate
 		eventTime = lastSeenTime
 	}
 
 	productName := "Tenable"
 
-	metadata := ocsf.Metadata{
-		Product: ocsf.Product{
-			Name:       &productName,
-			VendorName: &vendorName,
-		},
-		Version: "1.1.0",
-	}
-
 	var modifiedTimeInt int64
 	if !lastSeenTime.IsZero() {
 		modifiedTimeInt = lastSeenTime.UnixMilli()
@@ -230,28 +342,28 @@ func (s *TenableOCSFSyncer) ToOCSF(ctx context.Context, finding tenable.Finding)
 		Types:         []string{"Vulnerability"},
 	}
 
-	ocsfFinding := ocsf.VulnerabilityFinding{
-		Time:            eventTime.UnixMilli(),
-		StartTime:       firstSeenTimeInt,
-		EndTime:         endTimeInt,
-		ActivityId:      activityID,
-		ActivityName:    &activityName,
-		CategoryUid:     categoryUID,
-		CategoryName:    &categoryName,
-		ClassUid:        classUID,
-		ClassName:       &className,
-		Message:         &finding.Plugin.Description,
-		Metadata:        metadata,
-		Resources:       []ocsf.ResourceDetails{resource},
-		Status:          &status,
-		StatusId:        &statusID,
-		TypeUid:         typeUID,
-		TypeName:        &typeName,
-		Vulnerabilities: vulnerabilities,
-		FindingInfo:     findingInfo,
-		SeverityId:      int32(severityID),
-		Severity:        &severity,
+	ocsfFinding := ocsf.NewVulnerabilityFinding(activity)
+	ocsfFinding.Time = eventTime.UnixMilli()
+	ocsfFinding.StartTime = firstSeenTimeInt
+	ocsfFinding.EndTime = endTimeInt
+	ocsfFinding.Message = &finding.Plugin.Description
+	ocsfFinding.Metadata.Product = ocsf.Product{
+		Name:       &productName,
+		VendorName: &vendorName,
+	}
+	ocsfFinding.Resources = []ocsf.ResourceDetails{resource}
+	ocsfFinding.Status = &status
+	ocsfFinding.StatusId = &statusID
+	ocsfFinding.Vulnerabilities = vulnerabilities
+	ocsfFinding.FindingInfo = findingInfo
+	ocsfFinding.SeverityId = int32(severityID)
+	ocsfFinding.Severity = &severity
+
+	uid, err := eventuid.UID(&ocsfFinding, findingID)
+	if err != nil {
+		return ocsf.VulnerabilityFinding{}, fmt.Errorf("failed to compute event UID: %w", err)
 	}
+	ocsfFinding.Metadata.Uid = &uid
 
 	return ocsfFinding, nil
 }
@@ -278,7 +390,7 @@ func mapTenableSeverity(tenableSeverity int) (string, int) {
 func mapTenableState(tenableState string) (string, int32) {
 	switch tenableState {
 	case "fixed":
-		return "closed", 2
+		return "Resolved", 4
 	default:
 		return "open", 1
 	}
@@ -0,0 +1,75 @@
+package syncers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Santiago-Labs/go-ocsf/clients/tenable"
+	ocsf "github.com/Santiago-Labs/go-ocsf/ocsf/v1_4_0"
+	"github.com/Santiago-Labs/go-ocsf/syncers/lifecycle"
+)
+
+func TestTenableLifecycle(t *testing.T) {
+	ctx := context.Background()
+	s := &TenableOCSFSyncer{}
+	tracker := lifecycle.NewTracker[ocsf.VulnerabilityFinding]("tenable", &lifecycle.FileStore{Dir: t.TempDir()})
+
+	finding := tenable.Finding{
+		Asset:      tenable.Asset{UUID: "asset", HostName: "host"},
+		Plugin:     tenable.Plugin{Name: "Plugin", Description: "Plugin description"},
+		SeverityID: 3,
+		FirstFound: "2025-01-01T00:00:00Z",
+		LastFound:  "2025-01-01T00:00:00Z",
+		State:      "open",
+		FindingID:  "1",
+	}
+	fixed := finding
+	fixed.LastFound = "2025-01-02T00:00:00Z"
+	fixed.State = "fixed"
+
+	now := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+	var tests = []struct {
+		name     string
+		now      time.Time
+		finding  tenable.Finding
+		activity int32
+	}{
+		{name: "Test an open finding", now: now, finding: finding, activity: lifecycle.ActivityCreate},
+		{name: "Test a fixed finding", now: now.Add(time.Hour), finding: fixed, activity: lifecycle.ActivityClose},
+		{name: "Test a fixed finding reported again", now: now.Add(2 * time.Hour), finding: fixed},
+		{name: "Test a fixed finding reported after the retention", now: now.Add(lifecycle.DefaultRetention + time.Hour), finding: fixed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ocsfFinding, err := s.ToOCSF(ctx, tt.finding)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			changed, state, err := tracker.Track(ctx, tt.now, []ocsf.VulnerabilityFinding{ocsfFinding})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if err := tracker.Commit(ctx, state); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if tt.activity == 0 {
+				if len(changed) != 0 {
+					t.Fatalf("got %d findings, want none", len(changed))
+				}
+				return
+			}
+			if len(changed) != 1 || changed[0].ActivityId != tt.activity {
+				t.Fatalf("got findings %+v, want one with activity %d", changed, tt.activity)
+			}
+			if tt.activity == lifecycle.ActivityClose && (*changed[0].StatusId != 4 || *changed[0].Status != "Resolved") {
+				t.Errorf("got status %d %q, want 4 \"Resolved\"", *changed[0].StatusId, *changed[0].Status)
+			}
+			if tt.activity == lifecycle.ActivityClose && changed[0].EndTime != now.UnixMilli() {
+				t.Errorf("got end_time %d, want the time the finding was last found, %d", changed[0].EndTime, now.UnixMilli())
+			}
+		})
+	}
+}
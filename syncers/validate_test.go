@@ -0,0 +1,66 @@
+package syncers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Santiago-Labs/go-ocsf/clients/snyk"
+	"github.com/Santiago-Labs/go-ocsf/clients/tenable"
+	"github.com/Santiago-Labs/go-ocsf/ocsf/convert"
+	ocsf "github.com/Santiago-Labs/go-ocsf/ocsf/v1_4_0"
+	"github.com/Santiago-Labs/go-ocsf/ocsf/v1_5_0"
+)
+
+// TestFindingsValidate checks that the findings the syncers produce pass validation, so that
+// storage with -reject-invalid keeps them, both as written and converted to 1.5.0.
+func TestFindingsValidate(t *testing.T) {
+	ctx := context.Background()
+	updated := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	tenableFinding, err := (&TenableOCSFSyncer{}).ToOCSF(ctx, tenable.Finding{
+		Asset:      tenable.Asset{UUID: "asset", HostName: "host"},
+		Plugin:     tenable.Plugin{Name: "Plugin", Description: "Plugin description"},
+		SeverityID: 3,
+		FirstFound: "2025-01-01T00:00:00Z",
+		LastFound:  "2025-01-02T00:00:00Z",
+		State:      "open",
+		FindingID:  "1",
+	})
+	if err != nil {
+		t.Fatalf("failed to convert the Tenable finding: %v", err)
+	}
+
+	snykSyncer := &SnykOCSFSyncer{org: &snyk.Org{ID: "org"}}
+	snykFinding, err := snykSyncer.ToOCSF(ctx, snyk.Issue{
+		ID: "issue",
+		Attributes: snyk.IssueAttributes{
+			CreatedAt:              updated.Add(-24 * time.Hour),
+			UpdatedAt:              updated,
+			EffectiveSeverityLevel: "high",
+			Key:                    "key",
+			Status:                 "open",
+			Title:                  "Prototype Pollution",
+			Type:                   "package_vulnerability",
+		},
+	}, &snyk.Project{ID: "project"})
+	if err != nil {
+		t.Fatalf("failed to convert the Snyk issue: %v", err)
+	}
+
+	for name, finding := range map[string]ocsf.VulnerabilityFinding{"Tenable": tenableFinding, "Snyk": snykFinding} {
+		t.Run(name, func(t *testing.T) {
+			if err := finding.Validate(); err != nil {
+				t.Errorf("1.4.0 finding: %v", err)
+			}
+
+			upgraded, _, err := convert.Convert[ocsf.VulnerabilityFinding, v1_5_0.VulnerabilityFinding](finding)
+			if err != nil {
+				t.Fatalf("failed to convert to 1.5.0: %v", err)
+			}
+			if err := upgraded.Validate(); err != nil {
+				t.Errorf("1.5.0 finding: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package syncers
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/Santiago-Labs/go-ocsf/datastore"
+	"github.com/Santiago-Labs/go-ocsf/ocsf/convert"
+	"github.com/Santiago-Labs/go-ocsf/ocsf/v1_4_0"
+	"github.com/Santiago-Labs/go-ocsf/ocsf/v1_5_0"
+	"github.com/Santiago-Labs/go-ocsf/syncers/checkpoint"
+	"github.com/samsarahq/go/oops"
+)
+
+// OCSF versions syncers can store events as.
+const (
+	OCSFVersion1_4_0 = "1.4.0"
+	OCSFVersion1_5_0 = "1.5.0"
+)
+
+// findingStatusResolved is the status_id of findings which are resolved, which syncers close.
+const findingStatusResolved int32 = 4
+
+// SetupVulnerabilityFindingStorage sets up the datastore for vulnerability findings in the OCSF
+// version selected by storageOpts. Syncers build v1_4_0 findings, which are converted when
+// another version is selected.
+func SetupVulnerabilityFindingStorage(ctx context.Context, storageOpts datastore.StorageOpts) (datastore.Datastore[v1_4_0.VulnerabilityFinding], error) {
+	return setupVersionedStorage[v1_4_0.VulnerabilityFinding, v1_5_0.VulnerabilityFinding](ctx, storageOpts)
+}
+
+// SetupAPIActivityStorage sets up the datastore for API activities in the OCSF version selected
+// by storageOpts. Syncers build v1_4_0 activities, which are converted when another version is
+// selected.
+func SetupAPIActivityStorage(ctx context.Context, storageOpts datastore.StorageOpts) (datastore.Datastore[v1_4_0.APIActivity], error) {
+	return setupVersionedStorage[v1_4_0.APIActivity, v1_5_0.APIActivity](ctx, storageOpts)
+}
+
+// NewAPIActivityCheckpoints returns the checkpoint store of syncers of API activities, which keeps
+// the checkpoints next to the activities stored with storageOpts.
+func NewAPIActivityCheckpoints(ctx context.Context, storageOpts datastore.StorageOpts) (checkpoint.Store, error) {
+	return checkpoint.NewStore(ctx, storageOpts, APIActivityClasses(storageOpts.OCSFVersion)[0])
+}
+
+// NewVulnerabilityFindingCheckpoints returns the checkpoint store of syncers of vulnerability
+// findings, which keeps the checkpoints next to the findings stored with storageOpts.
+func NewVulnerabilityFindingCheckpoints(ctx context.Context, storageOpts datastore.StorageOpts) (checkpoint.Store, error) {
+	return checkpoint.NewStore(ctx, storageOpts, VulnerabilityFindingClasses(storageOpts.OCSFVersion)[0])
+}
+
+func setupVersionedStorage[T, V any](ctx context.Context, storageOpts datastore.StorageOpts) (datastore.Datastore[T], error) {
+	switch storageOpts.OCSFVersion {
+	case "", OCSFVersion1_4_0:
+		return datastore.SetupStorage[T](ctx, storageOpts)
+	case OCSFVersion1_5_0:
+		store, err := datastore.SetupStorage[V](ctx, storageOpts)
+		if err != nil {
+			return nil, err
+		}
+		return &convertingDatastore[T, V]{datastore: store}, nil
+	default:
+		return nil, fmt.Errorf("unsupported OCSF version %q", storageOpts.OCSFVersion)
+	}
+}
+
+// convertingDatastore converts events to another OCSF version before saving them.
+type convertingDatastore[T, V any] struct {
+	datastore datastore.Datastore[V]
+}
+
+func (d *convertingDatastore[T, V]) Save(ctx context.Context, items []T) error {
+	converted, err := convertItems[T, V](items)
+	if err != nil {
+		return err
+	}
+	return d.datastore.Save(ctx, converted)
+}
+
+func (d *convertingDatastore[T, V]) WriteBatch(ctx context.Context, items []T) error {
+	converted, err := convertItems[T, V](items)
+	if err != nil {
+		return err
+	}
+	return d.datastore.WriteBatch(ctx, converted)
+}
+
+// convertItems converts a batch of events to another OCSF version at once.
+func convertItems[T, V any](items []T) ([]V, error) {
+	converted, reports, err := convert.ConvertBatch[T, V](items)
+	if err != nil {
+		return nil, oops.Wrapf(err, "failed to convert events")
+	}
+
+	for _, report := range reports {
+		for _, dropped := range report.Dropped {
+			slog.Warn("dropped attribute while converting event", "class", report.Class, "to", report.To, "path", dropped.Path)
+		}
+	}
+	return converted, nil
+}
@@ -0,0 +1,158 @@
+package syncers
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/Santiago-Labs/go-ocsf/syncers/checkpoint"
+	"github.com/samsarahq/go/oops"
+)
+
+// TimeWindow is the range of event times an event syncer reads. The zero window reads from the
+// syncer's checkpoint, or its default lookback on the first sync, until now.
+type TimeWindow struct {
+	// Since is the time of the oldest event to read. When set, the checkpoint is ignored.
+	Since time.Time
+	// Until is the time after the newest event to read, now when zero. Windows which end before
+	// now do not advance the checkpoint.
+	Until time.Time
+	// Backfill is the size of the chunks the window is read in, each of which is checkpointed
+	// once it is saved, so that an interrupted backfill resumes after the last saved chunk. Zero
+	// reads the window at once.
+	Backfill time.Duration
+}
+
+// Incremental reports whether the window ends now, so that syncing it advances the checkpoint.
+func (w TimeWindow) Incremental() bool {
+	return w.Until.IsZero()
+}
+
+// Range returns the times the window starts and ends at, given the syncer's checkpoint, which is
+// zero before the first sync, and its default lookback.
+func (w TimeWindow) Range(checkpoint time.Time, lookback time.Duration, now time.Time) (since, until time.Time) {
+	switch {
+	case !w.Since.IsZero():
+		since = w.Since
+	case !checkpoint.IsZero():
+		since = checkpoint
+	default:
+		since = now.Add(-lookback)
+	}
+
+	until = w.Until
+	if until.IsZero() {
+		until = now
+	}
+	return since, until
+}
+
+// relativeTime matches durations in days or weeks, which time.ParseDuration does not support.
+var relativeTime = regexp.MustCompile(`^(\d+)([dw])$`)
+
+// ParseTime parses a --since or --until value: an RFC 3339 time, a date, or a duration before now
+// such as "90m", "12h", "30d" or "2w".
+func ParseTime(value string, now time.Time) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse(time.DateOnly, value); err == nil {
+		return t, nil
+	}
+
+	d, err := ParseDuration(value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid time %q: must be an RFC 3339 time, a date or a duration such as 7d", value)
+	}
+	return now.Add(-d), nil
+}
+
+// ParseDuration parses a positive duration, which may be in days ("30d") or weeks ("2w").
+func ParseDuration(value string) (time.Duration, error) {
+	var d time.Duration
+	if m := relativeTime.FindStringSubmatch(value); m != nil {
+		n, err := strconv.Atoi(m[1])
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration %q: %w", value, err)
+		}
+		d = time.Duration(n) * 24 * time.Hour
+		if m[2] == "w" {
+			d *= 7
+		}
+	} else {
+		var err error
+		d, err = time.ParseDuration(value)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	if d <= 0 {
+		return 0, fmt.Errorf("invalid duration %q: must be positive", value)
+	}
+	return d, nil
+}
+
+// ParseTimeWindow parses the since, until and backfill settings of a window, each of which may
+// be empty.
+func ParseTimeWindow(since, until, backfill string, now time.Time) (TimeWindow, error) {
+	var window TimeWindow
+	var err error
+	if since != "" {
+		if window.Since, err = ParseTime(since, now); err != nil {
+			return window, fmt.Errorf("since: %w", err)
+		}
+	}
+	if until != "" {
+		if window.Until, err = ParseTime(until, now); err != nil {
+			return window, fmt.Errorf("until: %w", err)
+		}
+	}
+	if backfill != "" {
+		if window.Backfill, err = ParseDuration(backfill); err != nil {
+			return window, fmt.Errorf("backfill: %w", err)
+		}
+	}
+	if !window.Since.IsZero() && !window.Until.IsZero() && !window.Since.Before(window.Until) {
+		return window, fmt.Errorf("since must be before until")
+	}
+	return window, nil
+}
+
+// Backfill syncs [since, until) in chunks of size, oldest first, by calling sync for each chunk.
+// The end of every synced chunk is recorded under key+"/backfill", and a backfill which overlaps
+// the recorded time resumes after it. The record is cleared once the last chunk is synced.
+func Backfill(ctx context.Context, store checkpoint.Store, key string, since, until time.Time, size time.Duration, sync func(ctx context.Context, since, until time.Time) error) error {
+	backfillKey := key + "/backfill"
+	done, err := checkpoint.GetTime(ctx, store, backfillKey)
+	if err != nil {
+		return oops.Wrapf(err, "failed to read backfill checkpoint")
+	}
+	if done.After(since) && done.Before(until) {
+		slog.Info("resuming backfill", "key", key, "since", done)
+		since = done
+	}
+
+	for start := since; start.Before(until); start = start.Add(size) {
+		end := start.Add(size)
+		if end.After(until) {
+			end = until
+		}
+
+		slog.Info("backfilling", "key", key, "since", start, "until", end)
+		if err := sync(ctx, start, end); err != nil {
+			return err
+		}
+		if err := checkpoint.SetTime(ctx, store, backfillKey, end); err != nil {
+			return oops.Wrapf(err, "failed to write backfill checkpoint")
+		}
+	}
+
+	if err := store.Set(ctx, backfillKey, ""); err != nil {
+		return oops.Wrapf(err, "failed to clear backfill checkpoint")
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package syncers
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Santiago-Labs/go-ocsf/syncers/checkpoint"
+)
+
+func TestParseTime(t *testing.T) {
+	now := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		value string
+		want  time.Time
+	}{
+		{"2025-01-02T03:04:05Z", time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)},
+		{"2025-01-02", time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)},
+		{"90m", now.Add(-90 * time.Minute)},
+		{"30d", now.AddDate(0, 0, -30)},
+		{"2w", now.AddDate(0, 0, -14)},
+	}
+	for _, tt := range tests {
+		got, err := ParseTime(tt.value, now)
+		if err != nil {
+			t.Errorf("ParseTime(%q): %v", tt.value, err)
+			continue
+		}
+		if !got.Equal(tt.want) {
+			t.Errorf("ParseTime(%q) = %v, want %v", tt.value, got, tt.want)
+		}
+	}
+
+	for _, value := range []string{"", "yesterday", "-7d", "0d"} {
+		if _, err := ParseTime(value, now); err == nil {
+			t.Errorf("ParseTime(%q) succeeded", value)
+		}
+	}
+}
+
+func TestBackfill(t *testing.T) {
+	ctx := context.Background()
+	store := &checkpoint.FileStore{Dir: t.TempDir()}
+	since := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	until := time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC)
+	chunk := 4 * 24 * time.Hour
+
+	// The second chunk fails, so the backfill stops after the first one.
+	var synced [][2]time.Time
+	failing := errors.New("failed")
+	err := Backfill(ctx, store, "test", since, until, chunk, func(ctx context.Context, since, until time.Time) error {
+		if len(synced) == 1 {
+			return failing
+		}
+		synced = append(synced, [2]time.Time{since, until})
+		return nil
+	})
+	if !errors.Is(err, failing) {
+		t.Fatalf("Backfill = %v, want the chunk's error", err)
+	}
+
+	// Running it again resumes at the second chunk, and the last chunk ends at until.
+	err = Backfill(ctx, store, "test", since, until, chunk, func(ctx context.Context, since, until time.Time) error {
+		synced = append(synced, [2]time.Time{since, until})
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Backfill: %v", err)
+	}
+
+	want := [][2]time.Time{
+		{since, since.Add(chunk)},
+		{since.Add(chunk), since.Add(2 * chunk)},
+		{since.Add(2 * chunk), until},
+	}
+	if len(synced) != len(want) {
+		t.Fatalf("synced %v, want %v", synced, want)
+	}
+	for i := range want {
+		if !synced[i][0].Equal(want[i][0]) || !synced[i][1].Equal(want[i][1]) {
+			t.Errorf("chunk %d = %v, want %v", i, synced[i], want[i])
+		}
+	}
+
+	done, err := checkpoint.GetTime(ctx, store, "test/backfill")
+	if err != nil {
+		t.Fatalf("GetTime: %v", err)
+	}
+	if !done.IsZero() {
+		t.Errorf("backfill checkpoint = %v after the backfill finished, want none", done)
+	}
+}